@@ -17,9 +17,11 @@
 package ecdsa
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
 	"math/big"
 	"testing"
 
@@ -65,6 +67,158 @@ func TestECDSA(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+// privateKeyFromHex returns the private key of big-endian hex encoded scalar
+func privateKeyFromHex(t *testing.T, scalar string) *PrivateKey {
+	x, ok := new(big.Int).SetString(scalar, 16)
+	if !ok {
+		t.Fatalf("invalid scalar %s", scalar)
+	}
+	privKey := new(PrivateKey)
+	x.FillBytes(privKey.scalar[:])
+	privKey.PublicKey.A.ScalarMultiplicationBase(x)
+	return privKey
+}
+
+func TestSignRFC6979(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing ECDSA")
+	sig, err := privKey.SignRFC6979(msg, sha256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := privKey.PublicKey.Verify(sig, msg, sha256.New()); err != nil || !ok {
+		t.Fatal("RFC 6979 signature should verify")
+	}
+	sig2, err := privKey.SignRFC6979(msg, sha256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sig, sig2) {
+		t.Fatal("RFC 6979 signature should be deterministic")
+	}
+	sig2, err = privKey.SignRFC6979([]byte("other message"), sha256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(sig[:sizeFr], sig2[:sizeFr]) {
+		t.Fatal("RFC 6979 nonces should depend on the message")
+	}
+}
+
+func TestDER(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing ECDSA")
+	sigBin, err := privKey.Sign(msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+	der := sig.BytesDER()
+	var decoded Signature
+	n, err := decoded.SetBytesDER(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(der) || decoded != sig {
+		t.Fatal("SetBytesDER(BytesDER()) should stay the same")
+	}
+	if ok, err := privKey.PublicKey.VerifyDER(der, msg, sha256.New()); err != nil || !ok {
+		t.Fatal("DER signature should verify")
+	}
+
+	// small values, with and without the sign byte
+	der = []byte{0x30, 0x07, 0x02, 0x01, 0x01, 0x02, 0x02, 0x00, 0x80}
+	if _, err := decoded.SetBytesDER(der); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decoded.BytesDER(), der) {
+		t.Fatalf("BytesDER = %x, want %x", decoded.BytesDER(), der)
+	}
+
+	orderBytes := order.Bytes()
+	orderDER := append([]byte{0x02, byte(len(orderBytes) + 1), 0x00}, orderBytes...)
+	for name, invalid := range map[string][]byte{
+		"empty":              {},
+		"not a sequence":     {0x31, 0x06, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01},
+		"long form length":   {0x30, 0x81, 0x06, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01},
+		"indefinite length":  {0x30, 0x80, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01, 0x00, 0x00},
+		"truncated":          {0x30, 0x06, 0x02, 0x01, 0x01, 0x02, 0x01},
+		"missing s":          {0x30, 0x03, 0x02, 0x01, 0x01},
+		"extra integer":      {0x30, 0x09, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01, 0x02, 0x01, 0x01},
+		"leading zero":       {0x30, 0x07, 0x02, 0x02, 0x00, 0x01, 0x02, 0x01, 0x01},
+		"negative r":         {0x30, 0x06, 0x02, 0x01, 0xff, 0x02, 0x01, 0x01},
+		"zero s":             {0x30, 0x06, 0x02, 0x01, 0x01, 0x02, 0x01, 0x00},
+		"empty integer":      {0x30, 0x05, 0x02, 0x00, 0x02, 0x01, 0x01},
+		"wrong integer type": {0x30, 0x06, 0x03, 0x01, 0x01, 0x02, 0x01, 0x01},
+		"r = order":          append(append([]byte{0x30, byte(len(orderDER) + 3)}, orderDER...), 0x02, 0x01, 0x01),
+	} {
+		if _, err := decoded.SetBytesDER(invalid); err == nil {
+			t.Fatalf("%s: SetBytesDER should fail on %x", name, invalid)
+		}
+	}
+
+	// trailing data is returned by SetBytesDER, and rejected by VerifyDER
+	der = append(sig.BytesDER(), 0x00)
+	if n, err := decoded.SetBytesDER(der); err != nil || n != len(der)-1 {
+		t.Fatal("SetBytesDER should read the signature only")
+	}
+	if _, err := privKey.PublicKey.VerifyDER(der, msg, sha256.New()); err != errInvalidDER {
+		t.Fatal("VerifyDER should reject trailing data")
+	}
+}
+
+func TestVerifyLowS(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing ECDSA")
+	sigBin, err := privKey.Sign(msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+	sig.NormalizeS()
+	pub := &privKey.PublicKey
+	if ok, err := pub.VerifyLowS(sig.Bytes(), msg, sha256.New()); err != nil || !ok {
+		t.Fatal("low s signature should verify with VerifyLowS")
+	}
+	if ok, err := pub.VerifyDERLowS(sig.BytesDER(), msg, sha256.New()); err != nil || !ok {
+		t.Fatal("low s signature should verify with VerifyDERLowS")
+	}
+	if ok, err := pub.VerifyLowS(sig.Bytes(), []byte("wrong message"), sha256.New()); err != nil || ok {
+		t.Fatal("signature of another message should not verify")
+	}
+
+	// {r, r_mod-s} is valid, but only accepted by Verify and VerifyDER
+	s := new(big.Int).SetBytes(sig.S[:])
+	s.Sub(order, s).FillBytes(sig.S[:])
+	if ok, err := pub.Verify(sig.Bytes(), msg, sha256.New()); err != nil || !ok {
+		t.Fatal("high s signature should verify with Verify")
+	}
+	if ok, err := pub.VerifyDER(sig.BytesDER(), msg, sha256.New()); err != nil || !ok {
+		t.Fatal("high s signature should verify with VerifyDER")
+	}
+	if ok, err := pub.VerifyLowS(sig.Bytes(), msg, sha256.New()); err != errHighS || ok {
+		t.Fatal("VerifyLowS should reject a high s")
+	}
+	if ok, err := pub.VerifyDERLowS(sig.BytesDER(), msg, sha256.New()); err != errHighS || ok {
+		t.Fatal("VerifyDERLowS should reject a high s")
+	}
+}
+
 func TestNonMalleability(t *testing.T) {
 
 	// buffer too big
@@ -139,6 +293,26 @@ func TestNoZeros(t *testing.T) {
 	})
 }
 
+func TestSignTiming(t *testing.T) {
+	// class 0: private key 1, class 1: random private keys
+	const n = 1 << 10
+	var keys [2][n]*PrivateKey
+	for i := 0; i < n; i++ {
+		var err error
+		if keys[1][i], err = GenerateKey(rand.Reader); err != nil {
+			t.Fatal(err)
+		}
+		keys[0][i] = new(PrivateKey)
+		keys[0][i].scalar[sizeFr-1] = 1
+	}
+	msg := []byte("testing ECDSA")
+	testutils.ConstantTime(t, n, func(c, i int) {
+		if _, err := keys[c][i].Sign(msg, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 // ------------------------------------------------------------
 // benches
 
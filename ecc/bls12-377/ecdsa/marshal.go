@@ -20,6 +20,8 @@ import (
 	"crypto/subtle"
 	"errors"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"golang.org/x/crypto/cryptobyte"
+	"golang.org/x/crypto/cryptobyte/asn1"
 	"io"
 	"math/big"
 )
@@ -28,6 +30,9 @@ var errWrongSize = errors.New("wrong size buffer")
 var errRBiggerThanRMod = errors.New("r >= r_mod")
 var errSBiggerThanRMod = errors.New("s >= r_mod")
 var errZero = errors.New("zero value")
+var errInvalidDER = errors.New("invalid DER encoding")
+var errHighS = errors.New("s > (r_mod-1)/2")
+var errInvalidV = errors.New("invalid recovery information v")
 
 // Bytes returns the binary representation of the public key
 // follows https://tools.ietf.org/html/rfc8032#section-3.1
@@ -134,3 +139,69 @@ func (sig *Signature) SetBytes(buf []byte) (int, error) {
 	n += sizeFr
 	return n, nil
 }
+
+// IsLowS returns true if s ≤ (r_mod-1)/2. Of the two valid signatures {r, s}
+// and {r, r_mod-s} of a message, exactly one has a low s.
+func (sig *Signature) IsLowS() bool {
+	var halfOrder big.Int
+	halfOrder.Rsh(order, 1)
+	return new(big.Int).SetBytes(sig.S[:]).Cmp(&halfOrder) <= 0
+}
+
+// NormalizeS sets s to r_mod-s if s is not low, so that the signature can't be
+// malleated. It returns true if s was changed.
+func (sig *Signature) NormalizeS() bool {
+	if sig.IsLowS() {
+		return false
+	}
+	s := new(big.Int).SetBytes(sig.S[:])
+	s.Sub(order, s).FillBytes(sig.S[:])
+	return true
+}
+
+// BytesDER returns the ASN.1 DER encoding of sig
+//
+//	ECDSA-Sig-Value ::= SEQUENCE { r INTEGER, s INTEGER }
+//
+// as in SEC 1, Version 2.0, Appendix C.8, used by X.509 and crypto/ecdsa.
+func (sig *Signature) BytesDER() []byte {
+	var b cryptobyte.Builder
+	b.AddASN1(asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1BigInt(new(big.Int).SetBytes(sig.R[:]))
+		b.AddASN1BigInt(new(big.Int).SetBytes(sig.S[:]))
+	})
+	return b.BytesOrPanic()
+}
+
+// SetBytesDER sets sig from its ASN.1 DER encoding, see BytesDER. Only the
+// strict DER encoding is accepted, and r, s must be in [1, r_mod[.
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytesDER(buf []byte) (int, error) {
+	input := cryptobyte.String(buf)
+	var inner cryptobyte.String
+	r, s := new(big.Int), new(big.Int)
+	if !input.ReadASN1(&inner, asn1.SEQUENCE) ||
+		!inner.ReadASN1Integer(r) ||
+		!inner.ReadASN1Integer(s) ||
+		!inner.Empty() {
+		return 0, errInvalidDER
+	}
+
+	// S, R < R_mod (to avoid malleability)
+	if r.Sign() < 0 || s.Sign() < 0 {
+		return 0, errInvalidDER
+	}
+	if r.Sign() == 0 || s.Sign() == 0 {
+		return 0, errZero
+	}
+	if r.Cmp(order) >= 0 {
+		return 0, errRBiggerThanRMod
+	}
+	if s.Cmp(order) >= 0 {
+		return 0, errSBiggerThanRMod
+	}
+
+	r.FillBytes(sig.R[:])
+	s.FillBytes(sig.S[:])
+	return len(buf) - len(input), nil
+}
@@ -47,6 +47,14 @@
 //	q[base10] = 8444461749428370424248824938781546531375899335154063827935233455917409239041
 //	q[base16] = 0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a11800000000001
 //
+// # Constant time
+//
+// AddCT, SubCT, DoubleCT, NegCT, MulCT, SquareCT, ExpCT and InverseCT are the constant time
+// versions of Add, Sub, Double, Neg, Mul, Square, Exp and Inverse, to use on secret values; with
+// Select, they don't branch on the value of their operands. SetBigIntCT converts a secret big.Int
+// in [0, 2^Bits) without branching on it. The other methods are faster but not constant time:
+// Add, Mul, ... conditionally subtract the modulus.
+//
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
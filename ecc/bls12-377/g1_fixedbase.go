@@ -0,0 +1,330 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// ErrInvalidWindow is returned when building a fixed-base table with a window size
+// outside of [1, 16].
+var ErrInvalidWindow = errors.New("the window size of a fixed-base table must be in [1, 16]")
+
+// errInvalidRowSize is returned when reading a fixed-base table whose rows do
+// not match its window size.
+var errInvalidRowSize = errors.New("invalid fixed-base table: wrong row size")
+
+// signedDigits writes k in base 2ʷ with signed digits dᵢ ∈ [-2ʷ⁻¹+1, 2ʷ⁻¹], such
+// that k = ∑ dᵢ 2ʷⁱ, without branching on k.
+func signedDigits(digits []int, k *[fr.Limbs]uint64, w int) {
+	mask := uint64(1)<<w - 1
+	var carry uint64
+	for i := range digits {
+		// bits [w⋅i, w⋅i+w) of k
+		pos := i * w
+		word, shift := pos/64, pos%64
+		var d uint64
+		if word < fr.Limbs {
+			d = k[word] >> shift
+			if shift+w > 64 && word+1 < fr.Limbs {
+				d |= k[word+1] << (64 - shift)
+			}
+		}
+		d = (d & mask) + carry
+		carry = (d + 1<<(w-1) - 1) >> w
+		digits[i] = int(d) - int(carry<<w)
+	}
+}
+
+// abs returns |d| and 1 if d < 0, 0 otherwise, without branching on d.
+func abs(d int) (uint64, uint64) {
+	sign := uint64(d) >> 63
+	return (uint64(d) ^ -sign) + sign, sign
+}
+
+// scalarWordsCT returns the words of s mod r in regular form, least significant
+// first. If s has at most fr.Bits bits then s < 2r, and it is reduced with a
+// conditional subtraction which doesn't branch on s. Otherwise, s is first
+// reduced with big.Int.Mod, which is not constant time: the callers of the
+// constant time scalar multiplications should pass scalars in [0, r).
+func scalarWordsCT(s *big.Int) [fr.Limbs]uint64 {
+	if s.Sign() < 0 || s.BitLen() > fr.Bits {
+		s = new(big.Int).Mod(s, fr.Modulus())
+	}
+	var buf [fr.Limbs * 8]byte
+	var k, q, t [fr.Limbs]uint64
+	s.FillBytes(buf[:])
+	for i := range k {
+		k[i] = binary.BigEndian.Uint64(buf[len(buf)-8*(i+1):])
+	}
+	fr.Modulus().FillBytes(buf[:])
+	for i := range q {
+		q[i] = binary.BigEndian.Uint64(buf[len(buf)-8*(i+1):])
+	}
+
+	// k ⩾ r → k -= r
+	var b uint64
+	for i := range k {
+		t[i], b = bits.Sub64(k[i], q[i], b)
+	}
+	m := b - 1
+	for i := range k {
+		k[i] ^= m & (k[i] ^ t[i])
+	}
+	return k
+}
+
+// G1FixedBaseTable stores multiples of a fixed point of G1 to speed up the
+// scalar multiplications by this point.
+//
+// The scalar s is written in base 2ʷ with signed digits dᵢ ∈ [-2ʷ⁻¹+1, 2ʷ⁻¹], so
+// that [s]P = ∑ [dᵢ]([2ʷⁱ]P). The table stores the points [j⋅2ʷⁱ]P for 1 ⩽ j ⩽ 2ʷ⁻¹
+// and each window i: a scalar multiplication costs one addition per window and no
+// doubling. The table has (log₂(r)/w+1)⋅2ʷ⁻¹ points, so that the window size w
+// trades memory for speed.
+type G1FixedBaseTable struct {
+	window int
+	table  [][]G1Affine // table[i][j] = [(j+1)⋅2ʷⁱ]base
+}
+
+// NewG1FixedBaseTable computes the table of the multiples of base for windows of
+// the given size, in [1, 16].
+func NewG1FixedBaseTable(base *G1Affine, window int) (*G1FixedBaseTable, error) {
+	if window < 1 || window > 16 {
+		return nil, ErrInvalidWindow
+	}
+	t := &G1FixedBaseTable{window: window}
+	nbWindows := fr.Bits/window + 1
+	rowSize := 1 << (window - 1)
+
+	points := make([]G1Jac, nbWindows*rowSize)
+	var rowBase G1Jac
+	rowBase.FromAffine(base)
+	for i := 0; i < nbWindows; i++ {
+		row := points[i*rowSize : (i+1)*rowSize]
+		row[0] = rowBase
+		for j := 1; j < rowSize; j++ {
+			row[j].Set(&row[j-1]).AddAssign(&rowBase)
+		}
+		// [2ʷ⁽ⁱ⁺¹⁾]base = [2]([2ʷ⁻¹⋅2ʷⁱ]base)
+		rowBase.Double(&row[rowSize-1])
+	}
+	affine := BatchJacobianToAffineG1(points)
+	t.table = make([][]G1Affine, nbWindows)
+	for i := range t.table {
+		t.table[i] = affine[i*rowSize : (i+1)*rowSize : (i+1)*rowSize]
+	}
+	return t, nil
+}
+
+// Base returns the point of which the table stores the multiples.
+func (t *G1FixedBaseTable) Base() G1Affine {
+	return t.table[0][0]
+}
+
+// Window returns the size in bits of the windows of the table.
+func (t *G1FixedBaseTable) Window() int {
+	return t.window
+}
+
+// digits returns the signed digits of s mod r in base 2ʷ.
+func (t *G1FixedBaseTable) digits(s *big.Int) []int {
+	var e fr.Element
+	k := e.SetBigInt(s).Bits()
+	digits := make([]int, len(t.table))
+	signedDigits(digits, &k, t.window)
+	return digits
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]P where P is the base
+// of the table.
+func (p *G1Jac) ScalarMultiplicationFixedBase(t *G1FixedBaseTable, s *big.Int) *G1Jac {
+	var res g1JacExtended
+	t.mul(&res, t.digits(s))
+	return p.fromJacExtended(&res)
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]P where P is the base
+// of the table.
+func (p *G1Affine) ScalarMultiplicationFixedBase(t *G1FixedBaseTable, s *big.Int) *G1Affine {
+	var res g1JacExtended
+	t.mul(&res, t.digits(s))
+	return p.fromJacExtended(&res)
+}
+
+// mul sets res to ∑ [dᵢ]([2ʷⁱ]P).
+func (t *G1FixedBaseTable) mul(res *g1JacExtended, digits []int) {
+	res.setInfinity()
+	if t.table[0][0].IsInfinity() {
+		return
+	}
+	for i, d := range digits {
+		if d > 0 {
+			res.addMixed(&t.table[i][d-1])
+		} else if d < 0 {
+			res.subMixed(&t.table[i][-d-1])
+		}
+	}
+}
+
+// BatchScalarMultiplication computes and returns the points [sᵢ]P where P is the
+// base of the table.
+func (t *G1FixedBaseTable) BatchScalarMultiplication(scalars []fr.Element) []G1Affine {
+	res := make([]G1Jac, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		var p g1JacExtended
+		digits := make([]int, len(t.table))
+		for i := start; i < end; i++ {
+			k := scalars[i].Bits()
+			signedDigits(digits, &k, t.window)
+			t.mul(&p, digits)
+			res[i].fromJacExtended(&p)
+		}
+	})
+	return BatchJacobianToAffineG1(res)
+}
+
+// ScalarMultiplicationFixedBaseCT computes and returns p = [s]P where P is the base
+// of the table, in constant time with respect to s.
+//
+// The whole table is read, and the running time only depends on its size. It is
+// slower than ScalarMultiplicationFixedBase, and should be used when s is secret.
+func (p *G1Jac) ScalarMultiplicationFixedBaseCT(t *G1FixedBaseTable, s *big.Int) *G1Jac {
+	var res g1ProjCT
+	res.mulFixedBaseCT(t, s)
+	return p.fromProjCT(&res)
+}
+
+// ScalarMultiplicationFixedBaseCT computes and returns p = [s]P where P is the base
+// of the table, in constant time with respect to s.
+//
+// The whole table is read, and the running time only depends on its size. It is
+// slower than ScalarMultiplicationFixedBase, and should be used when s is secret.
+func (p *G1Affine) ScalarMultiplicationFixedBaseCT(t *G1FixedBaseTable, s *big.Int) *G1Affine {
+	var res g1ProjCT
+	res.mulFixedBaseCT(t, s)
+	return p.fromProjCT(&res)
+}
+
+// mulFixedBaseCT sets p to [s]P where P is the base of the table. All the entries
+// of a row are read for each digit, and the complete addition formulas are used.
+func (p *g1ProjCT) mulFixedBaseCT(t *G1FixedBaseTable, s *big.Int) *g1ProjCT {
+	var res, q g1ProjCT
+	res.Y.SetOne()
+	if t.table[0][0].IsInfinity() {
+		*p = res
+		return p
+	}
+
+	var b3, one, negY fp.Element
+	b3.Double(&bCurveCoeff).Add(&b3, &bCurveCoeff)
+	one.SetOne()
+
+	k := scalarWordsCT(s)
+	digits := make([]int, len(t.table))
+	signedDigits(digits, &k, t.window)
+	for i, d := range digits {
+		dAbs, sign := abs(d)
+
+		// q = [|d|⋅2ʷⁱ]P, or the point at infinity (0,1,0) if d = 0
+		q.X.SetZero()
+		q.Y.SetOne()
+		q.Z.SetZero()
+		for j := range t.table[i] {
+			c := int(dAbs ^ uint64(j+1))
+			e := &t.table[i][j]
+			q.X.Select(c, &e.X, &q.X)
+			q.Y.Select(c, &e.Y, &q.Y)
+			q.Z.Select(c, &one, &q.Z)
+		}
+		// q = -q if d < 0
+		negY.NegCT(&q.Y)
+		q.Y.Select(int(sign), &q.Y, &negY)
+
+		res.addComplete(&res, &q, &b3)
+	}
+
+	*p = res
+	return p
+}
+
+// WriteTo writes the window size and the points of the table, with the raw
+// (uncompressed) encoding.
+func (t *G1FixedBaseTable) WriteTo(w io.Writer) (int64, error) {
+	enc := NewEncoder(w, RawEncoding())
+	if err := enc.Encode(uint64(t.window)); err != nil {
+		return enc.BytesWritten(), err
+	}
+	for i := range t.table {
+		if err := enc.Encode(t.table[i]); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom reads a table written by WriteTo. The points are checked to be in the
+// subgroup, but not to be the multiples of the base: the table must come from a
+// trusted source.
+func (t *G1FixedBaseTable) ReadFrom(r io.Reader) (int64, error) {
+	dec := NewDecoder(r)
+	var window uint64
+	if err := dec.Decode(&window); err != nil {
+		return dec.BytesRead(), err
+	}
+	if window < 1 || window > 16 {
+		return dec.BytesRead(), ErrInvalidWindow
+	}
+	t.window = int(window)
+	t.table = make([][]G1Affine, fr.Bits/t.window+1)
+	for i := range t.table {
+		if err := dec.Decode(&t.table[i]); err != nil {
+			return dec.BytesRead(), err
+		}
+		if len(t.table[i]) != 1<<(t.window-1) {
+			return dec.BytesRead(), errInvalidRowSize
+		}
+	}
+	return dec.BytesRead(), nil
+}
+
+// g1GenTableWindow is the window size of the table of the multiples of the
+// generator, used by ScalarMultiplicationBase.
+const g1GenTableWindow = 6
+
+var (
+	g1GenTableOnce sync.Once
+	g1GenTable     *G1FixedBaseTable
+)
+
+// generatorTableG1 returns the table of the multiples of the generator,
+// computed on first use.
+func generatorTableG1() *G1FixedBaseTable {
+	g1GenTableOnce.Do(func() {
+		g1GenTable, _ = NewG1FixedBaseTable(&g1GenAff, g1GenTableWindow)
+	})
+	return g1GenTable
+}
@@ -0,0 +1,214 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// commitment key re-used across tests
+var testCk CommitmentKey
+
+func init() {
+	var err error
+	testCk, err = NewCommitmentKey(32, []byte("ipa test"))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomVector(size int) []fr.Element {
+	res := make([]fr.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestNewCommitmentKeyDeterministic(t *testing.T) {
+	assert := require.New(t)
+
+	ck, err := NewCommitmentKey(8, []byte("ipa test"))
+	assert.NoError(err)
+	for i := range ck.G {
+		assert.True(ck.G[i].Equal(&testCk.G[i]))
+		assert.True(ck.H[i].Equal(&testCk.H[i]))
+	}
+	assert.True(ck.Q.Equal(&testCk.Q))
+
+	other, err := NewCommitmentKey(8, []byte("other domain"))
+	assert.NoError(err)
+	assert.False(other.G[0].Equal(&ck.G[0]))
+}
+
+func TestCommitHomomorphism(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 13
+	v1, v2 := randomVector(size), randomVector(size)
+	var r1, r2, s fr.Element
+	r1.SetRandom()
+	r2.SetRandom()
+	s.SetRandom()
+
+	c1, err := testCk.Commit(v1, r1)
+	assert.NoError(err)
+	c2, err := testCk.Commit(v2, r2)
+	assert.NoError(err)
+	assert.NoError(testCk.VerifyCommitment(&c1, v1, r1))
+	assert.Error(testCk.VerifyCommitment(&c1, v1, r2))
+
+	// c1 + c2 opens to v1 + v2 with r1 + r2
+	sum := make([]fr.Element, size)
+	for i := range sum {
+		sum[i].Add(&v1[i], &v2[i])
+	}
+	var rSum fr.Element
+	rSum.Add(&r1, &r2)
+	cSum := Add(&c1, &c2)
+	assert.NoError(testCk.VerifyCommitment(&cSum, sum, rSum))
+
+	// s·c1 opens to s·v1 with s·r1
+	scaled := make([]fr.Element, size)
+	for i := range scaled {
+		scaled[i].Mul(&v1[i], &s)
+	}
+	var rScaled fr.Element
+	rScaled.Mul(&r1, &s)
+	cScaled := Scale(&c1, s)
+	assert.NoError(testCk.VerifyCommitment(&cScaled, scaled, rScaled))
+
+	_, err = testCk.Commit(randomVector(len(testCk.G)+1), r1)
+	assert.ErrorIs(err, ErrCommitmentKeyTooSmall)
+}
+
+func TestInnerProductProof(t *testing.T) {
+	for _, size := range []int{1, 2, 8, 32} {
+		assert := require.New(t)
+
+		a, b := randomVector(size), randomVector(size)
+		G, H := testCk.G[:size], testCk.H[:size]
+
+		// P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩·U
+		var P, t1 Digest
+		_, err := P.MultiExp(G, a, ecc.MultiExpConfig{})
+		assert.NoError(err)
+		_, err = t1.MultiExp(H, b, ecc.MultiExpConfig{})
+		assert.NoError(err)
+		P.Add(&P, &t1)
+		t1 = Scale(&testCk.U, innerProduct(a, b))
+		P.Add(&P, &t1)
+
+		statement := P.Marshal()
+		proof, err := ProveInnerProduct(G, H, &testCk.U, a, b, sha256.New(), statement)
+		assert.NoError(err)
+		assert.NoError(VerifyInnerProduct(G, H, &testCk.U, &P, &proof, sha256.New(), statement))
+
+		// wrong statement, bound to the challenges
+		if size > 1 {
+			assert.Error(VerifyInnerProduct(G, H, &testCk.U, &P, &proof, sha256.New(), []byte("wrong")))
+		}
+
+		// tampered proof
+		proof.A.Double(&proof.A)
+		assert.ErrorIs(VerifyInnerProduct(G, H, &testCk.U, &P, &proof, sha256.New(), statement), ErrVerifyInnerProduct)
+
+		t.Run("serialization", testutils.SerializationRoundTrip(&proof))
+	}
+}
+
+func TestOpeningProof(t *testing.T) {
+	for _, size := range []int{1, 4, 32} {
+		assert := require.New(t)
+
+		// commit to a polynomial and open it at a point
+		p := randomVector(size)
+		var r, x fr.Element
+		r.SetRandom()
+		x.SetRandom()
+		powers := make([]fr.Element, size)
+		powers[0].SetOne()
+		for i := 1; i < size; i++ {
+			powers[i].Mul(&powers[i-1], &x)
+		}
+
+		digest, err := testCk.Commit(p, r)
+		assert.NoError(err)
+
+		proof, v, err := Open(&testCk, &digest, p, r, powers, sha256.New())
+		assert.NoError(err)
+		expected := innerProduct(p, powers)
+		assert.True(v.Equal(&expected))
+		assert.NoError(Verify(&testCk, &digest, powers, v, &proof, sha256.New()))
+
+		// wrong claimed value
+		var wrong fr.Element
+		wrong.SetOne().Add(&wrong, &v)
+		assert.Error(Verify(&testCk, &digest, powers, wrong, &proof, sha256.New()))
+
+		// wrong commitment
+		other, err := testCk.Commit(p, wrong)
+		assert.NoError(err)
+		assert.Error(Verify(&testCk, &other, powers, v, &proof, sha256.New()))
+
+		// tampered proof
+		proof.Z1.Double(&proof.Z1)
+		assert.ErrorIs(Verify(&testCk, &digest, powers, v, &proof, sha256.New()), ErrVerifyOpeningProof)
+
+		t.Run("serialization", testutils.SerializationRoundTrip(&proof))
+	}
+}
+
+func TestCommitmentKeySerialization(t *testing.T) {
+	ck, err := NewCommitmentKey(4, []byte("serialization"))
+	require.NoError(t, err)
+	t.Run("compressed", testutils.SerializationRoundTrip(&ck))
+	t.Run("raw", testutils.SerializationRoundTripRaw(&ck))
+}
+
+func BenchmarkOpen(b *testing.B) {
+	const size = 32
+	p, powers := randomVector(size), randomVector(size)
+	var r fr.Element
+	r.SetRandom()
+	digest, _ := testCk.Commit(p, r)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = Open(&testCk, &digest, p, r, powers, sha256.New())
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	const size = 32
+	p, powers := randomVector(size), randomVector(size)
+	var r fr.Element
+	r.SetRandom()
+	digest, _ := testCk.Commit(p, r)
+	proof, v, _ := Open(&testCk, &digest, p, r, powers, sha256.New())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(&testCk, &digest, powers, v, &proof, sha256.New())
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidSRS          = errors.New("invalid SRS")
+	ErrInvalidContribution = errors.New("invalid contribution")
+	ErrNoContribution      = errors.New("no contribution")
+)
+
+// contributionDST is the domain separation tag used to hash the challenge of a contribution to G₂
+const contributionDST = "GNARK-CRYPTO-KZG-POWERS-OF-TAU-CONTRIBUTION"
+
+// Contribution is the public trace of a contribution to a powers-of-tau ceremony, which updates
+// the secret τ of an SRS to x⋅τ for a secret x known only to the contributor.
+//
+// The proof of knowledge of x follows the BGM17 update proofs: R ∈ G₂ is hashed from the
+// previous [τ]G₁ and [x]G₁, and e([x]G₁, R) = e(G₁, [x]R), e([x⋅τ]G₁, R) = e([τ]G₁, [x]R).
+//
+// implements io.ReaderFrom and io.WriterTo
+type Contribution struct {
+	UpdatedTau bls12377.G1Affine // [x⋅τ]G₁, i.e. Pk.G1[1] after the contribution
+	XG1        bls12377.G1Affine // [x]G₁
+	XR         bls12377.G2Affine // [x]R
+}
+
+// Contribute updates srs in place with a fresh random secret x, that is [τⁱ]G₁ ← [(x⋅τ)ⁱ]G₁
+// and [τ]G₂ ← [x⋅τ]G₂, and returns the trace of the contribution.
+//
+// A ceremony starts from the SRS of τ = 1, i.e. NewSRS(size, big.NewInt(1)); its participants
+// contribute in turn, and the final SRS is checked with VerifyContributions.
+func Contribute(srs *SRS) (Contribution, error) {
+	var x fr.Element
+	if _, err := x.SetRandom(); err != nil {
+		return Contribution{}, err
+	}
+	c, err := contribute(srs, &x)
+	x.SetZero()
+	return c, err
+}
+
+func contribute(srs *SRS, x *fr.Element) (Contribution, error) {
+	var c Contribution
+	if len(srs.Pk.G1) < 2 {
+		return c, ErrMinSRSSize
+	}
+	if x.IsZero() {
+		return c, ErrInvalidContribution
+	}
+
+	var bx big.Int
+	x.BigInt(&bx)
+	c.XG1.ScalarMultiplicationBase(&bx)
+	r, err := contributionChallenge(&srs.Pk.G1[1], &c.XG1)
+	if err != nil {
+		return c, err
+	}
+	c.XR.ScalarMultiplication(&r, &bx)
+
+	// [τⁱ]G₁ ← [xⁱ][τⁱ]G₁
+	parallel.Execute(len(srs.Pk.G1), func(start, end int) {
+		var xi fr.Element
+		var bxi big.Int
+		xi.Exp(*x, big.NewInt(int64(start)))
+		points := make([]bls12377.G1Jac, end-start)
+		for i := start; i < end; i++ {
+			xi.BigInt(&bxi)
+			points[i-start].FromAffine(&srs.Pk.G1[i])
+			points[i-start].ScalarMultiplication(&points[i-start], &bxi)
+			xi.Mul(&xi, x)
+		}
+		copy(srs.Pk.G1[start:end], bls12377.BatchJacobianToAffineG1(points))
+	})
+
+	srs.Vk.G2[1].ScalarMultiplication(&srs.Vk.G2[1], &bx)
+	srs.Vk.Lines[1] = bls12377.PrecomputeLines(srs.Vk.G2[1])
+	c.UpdatedTau = srs.Pk.G1[1]
+
+	return c, nil
+}
+
+// VerifyContribution checks that c updates the secret of an SRS whose [τ]G₁ is previousTau,
+// with a proof of knowledge of the update.
+func VerifyContribution(previousTau *bls12377.G1Affine, c *Contribution) error {
+	if c.XG1.IsInfinity() || c.UpdatedTau.IsInfinity() || previousTau.IsInfinity() ||
+		!c.XG1.IsInSubGroup() || !c.UpdatedTau.IsInSubGroup() || !c.XR.IsInSubGroup() {
+		return ErrInvalidContribution
+	}
+	r, err := contributionChallenge(previousTau, &c.XG1)
+	if err != nil {
+		return err
+	}
+
+	_, _, g1, _ := bls12377.Generators()
+	var negG1, negPreviousTau bls12377.G1Affine
+	negG1.Neg(&g1)
+	negPreviousTau.Neg(previousTau)
+
+	// proof of knowledge of x: e([x]G₁, R) = e(G₁, [x]R)
+	ok, err := bls12377.PairingCheck([]bls12377.G1Affine{c.XG1, negG1}, []bls12377.G2Affine{r, c.XR})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidContribution
+	}
+
+	// update: e([x⋅τ]G₁, R) = e([τ]G₁, [x]R)
+	ok, err = bls12377.PairingCheck([]bls12377.G1Affine{c.UpdatedTau, negPreviousTau}, []bls12377.G2Affine{r, c.XR})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidContribution
+	}
+	return nil
+}
+
+// VerifyContributions checks that srs results from the chain of contributions, applied in
+// order to the SRS of τ = 1, and that srs is well-formed (see CheckSRS).
+func VerifyContributions(srs *SRS, contributions []Contribution) error {
+	if len(contributions) == 0 {
+		return ErrNoContribution
+	}
+	_, _, tau, _ := bls12377.Generators()
+	for i := range contributions {
+		if err := VerifyContribution(&tau, &contributions[i]); err != nil {
+			return err
+		}
+		tau = contributions[i].UpdatedTau
+	}
+	if len(srs.Pk.G1) < 2 || !srs.Pk.G1[1].Equal(&tau) {
+		return ErrInvalidContribution
+	}
+	return CheckSRS(srs)
+}
+
+// CheckSRS checks that srs is well-formed, that is its points are in the prime order subgroups,
+// its generators are the ones of the curve, and srs.Pk.G1 are the successive powers of the τ of
+// srs.Vk.G2[1]. It is meant to verify SRS from untrusted sources, such as ceremony transcripts.
+func CheckSRS(srs *SRS) error {
+	n := len(srs.Pk.G1)
+	if n < 2 {
+		return ErrMinSRSSize
+	}
+	_, _, g1, g2 := bls12377.Generators()
+	if !srs.Pk.G1[0].Equal(&g1) || !srs.Vk.G1.Equal(&g1) || !srs.Vk.G2[0].Equal(&g2) ||
+		srs.Pk.G1[1].IsInfinity() || !srs.Vk.G2[1].IsInSubGroup() {
+		return ErrInvalidSRS
+	}
+	var nbErrs uint64
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			if !srs.Pk.G1[i].IsInSubGroup() {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return ErrInvalidSRS
+	}
+
+	var negG1 bls12377.G1Affine
+	negG1.Neg(&g1)
+
+	// e([τ]G₁, G₂) = e(G₁, [τ]G₂)
+	ok, err := bls12377.PairingCheck([]bls12377.G1Affine{srs.Pk.G1[1], negG1}, srs.Vk.G2[:])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidSRS
+	}
+
+	// for a random ρ, e(∑ρⁱ[τⁱ]G₁, [τ]G₂) = e(∑ρⁱ[τⁱ⁺¹]G₁, G₂)
+	rho := make([]fr.Element, n-1)
+	rho[0].SetOne()
+	if n > 2 {
+		if _, err = rho[1].SetRandom(); err != nil {
+			return err
+		}
+		for i := 2; i < len(rho); i++ {
+			rho[i].Mul(&rho[i-1], &rho[1])
+		}
+	}
+	var a, b bls12377.G1Affine
+	if _, err = a.MultiExp(srs.Pk.G1[:n-1], rho, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if _, err = b.MultiExp(srs.Pk.G1[1:], rho, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	b.Neg(&b)
+	ok, err = bls12377.PairingCheck([]bls12377.G1Affine{a, b}, []bls12377.G2Affine{srs.Vk.G2[1], srs.Vk.G2[0]})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidSRS
+	}
+	return nil
+}
+
+// contributionChallenge returns the point R ∈ G₂ of a contribution, hashed from the previous
+// [τ]G₁ and the contributor's [x]G₁
+func contributionChallenge(previousTau, xG1 *bls12377.G1Affine) (bls12377.G2Affine, error) {
+	bTau := previousTau.Bytes()
+	bX := xG1.Bytes()
+	msg := make([]byte, 0, len(bTau)+len(bX))
+	msg = append(msg, bTau[:]...)
+	msg = append(msg, bX[:]...)
+	return bls12377.HashToG2(msg, []byte(contributionDST))
+}
+
+// WriteTo writes binary encoding of the Contribution
+func (c *Contribution) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+	toEncode := []interface{}{&c.UpdatedTau, &c.XG1, &c.XR}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes Contribution data from reader.
+func (c *Contribution) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+	toDecode := []interface{}{&c.UpdatedTau, &c.XG1, &c.XR}
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+	return dec.BytesRead(), nil
+}
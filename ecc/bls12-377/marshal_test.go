@@ -19,6 +19,7 @@ package bls12377
 import (
 	"bytes"
 	crand "crypto/rand"
+	"fmt"
 	"io"
 	"math/big"
 	"math/rand/v2"
@@ -28,6 +29,7 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 
+	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
@@ -163,6 +165,94 @@ func TestEncoder(t *testing.T) {
 
 }
 
+func TestEncoderWithEncoding(t *testing.T) {
+	t.Parallel()
+
+	var inA uint64
+	var inB fr.Element
+	var inC fp.Element
+	var inD, inE G1Affine
+	var inF G2Affine
+	var inG []G1Affine
+	var inH []G2Affine
+	var inJ []fr.Element
+
+	inA = rand.Uint64() //#nosec G404 weak rng is fine here
+	inB.SetRandom()
+	inC.SetRandom()
+	inD.ScalarMultiplication(&g1GenAff, new(big.Int).SetUint64(rand.Uint64())) //#nosec G404 weak rng is fine here
+	// inE --> infinity
+	inF.ScalarMultiplication(&g2GenAff, new(big.Int).SetUint64(rand.Uint64())) //#nosec G404 weak rng is fine here
+	inG = []G1Affine{inD, inE, inD}
+	inH = []G2Affine{inF, {}}
+	inJ = make([]fr.Element, 3)
+	inJ[1].SetRandom()
+
+	for _, e := range []ecc.Encoding{ecc.ArkworksEncoding, ecc.SEC1Encoding} {
+		for _, raw := range []bool{false, true} {
+			toEncode := []interface{}{inA, &inB, &inC, &inD, &inE, inG, inJ}
+			if e == ecc.ArkworksEncoding {
+				toEncode = append(toEncode, &inF, inH)
+			}
+
+			var buf bytes.Buffer
+			encOptions := []func(*Encoder){WithEncoding(e)}
+			decOptions := []func(*Decoder){WithDecoding(e)}
+			if raw {
+				encOptions = append(encOptions, RawEncoding())
+				decOptions = append(decOptions, RawDecoding())
+			}
+			enc := NewEncoder(&buf, encOptions...)
+			for _, v := range toEncode {
+				if err := enc.Encode(v); err != nil {
+					t.Fatal(e, err)
+				}
+			}
+
+			dec := NewDecoder(&buf, decOptions...)
+			var outA uint64
+			var outB fr.Element
+			var outC fp.Element
+			var outD, outE G1Affine
+			var outF G2Affine
+			var outG []G1Affine
+			var outH []G2Affine
+			var outJ []fr.Element
+			toDecode := []interface{}{&outA, &outB, &outC, &outD, &outE, &outG, &outJ}
+			if e == ecc.ArkworksEncoding {
+				toDecode = append(toDecode, &outF, &outH)
+			}
+			for _, v := range toDecode {
+				if err := dec.Decode(v); err != nil {
+					t.Fatal(e, err)
+				}
+			}
+
+			if inA != outA || !inB.Equal(&outB) || !inC.Equal(&outC) {
+				t.Fatal(e, "decode(encode(uint64, Element)) failed")
+			}
+			if !inD.Equal(&outD) || !inE.Equal(&outE) {
+				t.Fatal(e, "decode(encode(G1Affine)) failed")
+			}
+			if !reflect.DeepEqual(inG, outG) || !reflect.DeepEqual(inJ, outJ) {
+				t.Fatal(e, "decode(encode(slice)) failed")
+			}
+			if e == ecc.ArkworksEncoding && (!inF.Equal(&outF) || !reflect.DeepEqual(inH, outH)) {
+				t.Fatal(e, "decode(encode(G2Affine)) failed")
+			}
+			if enc.BytesWritten() != dec.BytesRead() {
+				t.Fatal(e, "bytes read don't match bytes written")
+			}
+		}
+	}
+
+	// arkworks doesn't define the encoding of all types
+	enc := NewEncoder(io.Discard, WithEncoding(ecc.ArkworksEncoding))
+	if err := enc.Encode([][]uint64{{1}}); err == nil {
+		t.Fatal("expected an error for an unsupported type")
+	}
+}
+
 func TestIsCompressed(t *testing.T) {
 	t.Parallel()
 	var g1Inf, g1 G1Affine
@@ -342,6 +432,75 @@ func TestG1AffineSerialization(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG1AffineSerializationWithEncoding(t *testing.T) {
+	t.Parallel()
+	encodings := []ecc.Encoding{ecc.GnarkEncoding, ecc.ArkworksEncoding, ecc.SEC1Encoding}
+
+	// the ZCash encoding is only defined for BLS12-381
+	var inf G1Affine
+	if _, err := inf.BytesWithEncoding(ecc.ZCashEncoding, true); err != ErrUnsupportedEncoding {
+		t.Fatal("expected ErrUnsupportedEncoding, got", err)
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	for _, e := range encodings {
+		for _, compressed := range []bool{true, false} {
+			// round trip serialization of infinity
+			var p1, p2 G1Affine
+			p2.X.SetRandom()
+			p2.Y.SetRandom()
+			buf, err := p1.BytesWithEncoding(e, compressed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			n, err := p2.SetBytesWithEncoding(e, compressed, buf)
+			if err != nil {
+				t.Fatal(e, err)
+			}
+			if n != len(buf) {
+				t.Fatal(e, "invalid number of bytes consumed in buffer")
+			}
+			if !p2.IsInfinity() {
+				t.Fatal(e, "deserialization of infinity point is not infinity")
+			}
+
+			properties := gopter.NewProperties(parameters)
+
+			properties.Property(fmt.Sprintf("[G1] Affine SetBytesWithEncoding(BytesWithEncoding) should stay the same (%s, compressed=%v)", e, compressed), prop.ForAll(
+				func(a fp.Element) bool {
+					var start, end G1Affine
+					var ab big.Int
+					a.BigInt(&ab)
+					start.ScalarMultiplication(&g1GenAff, &ab)
+
+					buf, err := start.BytesWithEncoding(e, compressed)
+					if err != nil {
+						return false
+					}
+					n, err := end.SetBytesWithEncoding(e, compressed, buf)
+					if err != nil || n != len(buf) {
+						return false
+					}
+					// a truncated encoding is rejected
+					if _, err := end.SetBytesWithEncoding(e, compressed, buf[:len(buf)-1]); err == nil {
+						return false
+					}
+					return start.Equal(&end)
+				},
+				GenFp(),
+			))
+
+			properties.TestingRun(t, gopter.ConsoleReporter(false))
+		}
+	}
+}
+
 func TestG2AffineInvalidBitMask(t *testing.T) {
 	t.Parallel()
 	var buf [SizeOfG2AffineCompressed]byte
@@ -455,6 +614,75 @@ func TestG2AffineSerialization(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG2AffineSerializationWithEncoding(t *testing.T) {
+	t.Parallel()
+	encodings := []ecc.Encoding{ecc.GnarkEncoding, ecc.ArkworksEncoding}
+
+	// the ZCash encoding is only defined for BLS12-381
+	var inf G2Affine
+	if _, err := inf.BytesWithEncoding(ecc.ZCashEncoding, true); err != ErrUnsupportedEncoding {
+		t.Fatal("expected ErrUnsupportedEncoding, got", err)
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	for _, e := range encodings {
+		for _, compressed := range []bool{true, false} {
+			// round trip serialization of infinity
+			var p1, p2 G2Affine
+			p2.X.SetRandom()
+			p2.Y.SetRandom()
+			buf, err := p1.BytesWithEncoding(e, compressed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			n, err := p2.SetBytesWithEncoding(e, compressed, buf)
+			if err != nil {
+				t.Fatal(e, err)
+			}
+			if n != len(buf) {
+				t.Fatal(e, "invalid number of bytes consumed in buffer")
+			}
+			if !p2.IsInfinity() {
+				t.Fatal(e, "deserialization of infinity point is not infinity")
+			}
+
+			properties := gopter.NewProperties(parameters)
+
+			properties.Property(fmt.Sprintf("[G2] Affine SetBytesWithEncoding(BytesWithEncoding) should stay the same (%s, compressed=%v)", e, compressed), prop.ForAll(
+				func(a fp.Element) bool {
+					var start, end G2Affine
+					var ab big.Int
+					a.BigInt(&ab)
+					start.ScalarMultiplication(&g2GenAff, &ab)
+
+					buf, err := start.BytesWithEncoding(e, compressed)
+					if err != nil {
+						return false
+					}
+					n, err := end.SetBytesWithEncoding(e, compressed, buf)
+					if err != nil || n != len(buf) {
+						return false
+					}
+					// a truncated encoding is rejected
+					if _, err := end.SetBytesWithEncoding(e, compressed, buf[:len(buf)-1]); err == nil {
+						return false
+					}
+					return start.Equal(&end)
+				},
+				GenFp(),
+			))
+
+			properties.TestingRun(t, gopter.ConsoleReporter(false))
+		}
+	}
+}
+
 // define Gopters generators
 
 // GenFr generates an Fr element
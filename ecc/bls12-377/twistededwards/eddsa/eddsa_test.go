@@ -17,6 +17,7 @@
 package eddsa
 
 import (
+	"crypto"
 	"crypto/sha256"
 	"math/big"
 	"math/rand"
@@ -76,7 +77,8 @@ func TestNonMalleability(t *testing.T) {
 		frMod := fr.Modulus()
 		r := big.NewInt(1)
 		r.Add(frMod, r)
-		buf := r.Bytes()
+		buf := make([]byte, sizeFr)
+		r.FillBytes(buf)
 		for i := 0; i < sizeFr; i++ {
 			bsig[sizeFr-1-i] = buf[i]
 		}
@@ -94,9 +96,8 @@ func TestNonMalleability(t *testing.T) {
 		o := big.NewInt(1)
 		cp := twistededwards.GetEdwardsCurve()
 		o.Add(&cp.Order, o)
-		buf := o.Bytes()
-		copy(bsig[sizeFr:], buf[:])
-		big.NewInt(1).FillBytes(bsig[:sizeFr])
+		o.FillBytes(bsig[sizeFr:])
+		bsig[0] = 1 // R is read in little endian
 
 		var sig Signature
 		_, err := sig.SetBytes(bsig)
@@ -264,6 +265,79 @@ func TestEddsaSHA256(t *testing.T) {
 
 }
 
+func TestCryptoSigner(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := privKey.CryptoSigner(sha256.New)
+	if !privKey.PublicKey.EqualPublicKey(signer.Public()) {
+		t.Fatal("wrong public key")
+	}
+
+	sig, err := signer.Sign(r, []byte("message"), crypto.Hash(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := privKey.PublicKey.Verify(sig, []byte("message"), sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("Verify correct signature should return true")
+	}
+
+	if _, err := signer.Sign(r, make([]byte, 32), crypto.SHA256); err == nil {
+		t.Fatal("signing a pre-hashed message should fail")
+	}
+}
+
+func TestJWK(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub PublicKey
+	if _, err := pub.SetBytesJWK(privKey.PublicKey.BytesJWK()); err != nil {
+		t.Fatal(err)
+	}
+	if !pub.Equal(&privKey.PublicKey) {
+		t.Fatal("Error SetBytesJWK(BytesJWK(.))")
+	}
+
+	var priv PrivateKey
+	if _, err := priv.SetBytesJWK(privKey.BytesJWK()); err != nil {
+		t.Fatal(err)
+	}
+	if string(priv.Bytes()) != string(privKey.Bytes()) {
+		t.Fatal("Error SetBytesJWK(BytesJWK(.))")
+	}
+
+	// the public key doesn't match the private scalar
+	other, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mismatched := PrivateKey{PublicKey: other.PublicKey, scalar: privKey.scalar, randSrc: privKey.randSrc}
+	if _, err := priv.SetBytesJWK(mismatched.BytesJWK()); err == nil {
+		t.Fatal("mismatched public key should be rejected")
+	}
+
+	// a key of another curve
+	if _, err := pub.SetBytesJWK([]byte(`{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`)); err == nil {
+		t.Fatal("key of another curve should be rejected")
+	}
+}
+
 // benchmarks
 
 func BenchmarkVerify(b *testing.B) {
@@ -0,0 +1,215 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package elgamal
+
+import (
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+)
+
+var (
+	ErrInvalidPoint      = errors.New("point is not in the prime subgroup")
+	ErrInvalidCiphertext = errors.New("invalid ciphertext encoding")
+)
+
+const (
+	sizePoint      = fr.Bytes
+	sizeCiphertext = 2 * sizePoint
+)
+
+// PublicKey is an ElGamal public key A = [x]B.
+type PublicKey struct {
+	A twistededwards.PointAffine
+}
+
+// PrivateKey is an ElGamal secret key x.
+type PrivateKey struct {
+	PublicKey PublicKey // copy of the associated public key
+	scalar    big.Int   // secret scalar x
+}
+
+// Ciphertext is the encryption (C₁, C₂) = ([r]B, [m]B + [r]A) of a message m.
+type Ciphertext struct {
+	C1, C2 twistededwards.PointAffine
+}
+
+// GenerateKey generates a key pair, with randomness read from r.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	params := twistededwards.GetEdwardsCurve()
+	var privKey PrivateKey
+	if err := randomScalar(r, &privKey.scalar); err != nil {
+		return nil, err
+	}
+	privKey.PublicKey.A.ScalarMultiplicationCT(&params.Base, &privKey.scalar)
+	return &privKey, nil
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() *PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Encrypt returns an encryption of m, with randomness read from r.
+func (pk *PublicKey) Encrypt(r io.Reader, m *big.Int) (Ciphertext, error) {
+	var randomness big.Int
+	if err := randomScalar(r, &randomness); err != nil {
+		return Ciphertext{}, err
+	}
+	ct := pk.EncryptWithRandomness(m, &randomness)
+	randomness.SetUint64(0)
+	return ct, nil
+}
+
+// EncryptWithRandomness returns the encryption ([r]B, [m]B + [r]A) of m. The
+// randomness r must be uniformly random and used once; it is exposed for
+// proofs about the ciphertext, such as ballot validity proofs.
+func (pk *PublicKey) EncryptWithRandomness(m, randomness *big.Int) Ciphertext {
+	params := twistededwards.GetEdwardsCurve()
+	var ct Ciphertext
+	var mB twistededwards.PointAffine
+	ct.C1.ScalarMultiplicationCT(&params.Base, randomness)
+	ct.C2.ScalarMultiplicationCT(&pk.A, randomness)
+	mB.ScalarMultiplication(&params.Base, reduce(m))
+	ct.C2.Add(&ct.C2, &mB)
+	return ct
+}
+
+// Rerandomize returns a fresh encryption of the message of ct, unlinkable to
+// ct, with randomness read from r.
+func (pk *PublicKey) Rerandomize(r io.Reader, ct *Ciphertext) (Ciphertext, error) {
+	zero, err := pk.Encrypt(r, new(big.Int))
+	if err != nil {
+		return Ciphertext{}, err
+	}
+	var res Ciphertext
+	res.Add(ct, &zero)
+	return res, nil
+}
+
+// Decrypt returns [m]B, where m is the message of ct. The message itself is
+// recovered with DLogTable.DLog when it is small.
+func (privKey *PrivateKey) Decrypt(ct *Ciphertext) twistededwards.PointAffine {
+	var res twistededwards.PointAffine
+	// [m]B = C₂ - [x]C₁
+	res.ScalarMultiplicationCT(&ct.C1, &privKey.scalar)
+	res.Neg(&res)
+	res.Add(&res, &ct.C2)
+	return res
+}
+
+// Add sets ct to a + b, an encryption of the sum of the messages of a and b,
+// and returns ct.
+func (ct *Ciphertext) Add(a, b *Ciphertext) *Ciphertext {
+	ct.C1.Add(&a.C1, &b.C1)
+	ct.C2.Add(&a.C2, &b.C2)
+	return ct
+}
+
+// Sub sets ct to a - b, an encryption of the difference of the messages of a
+// and b, and returns ct.
+func (ct *Ciphertext) Sub(a, b *Ciphertext) *Ciphertext {
+	var negB Ciphertext
+	negB.C1.Neg(&b.C1)
+	negB.C2.Neg(&b.C2)
+	return ct.Add(a, &negB)
+}
+
+// ScalarMultiplication sets ct to [s]a, an encryption of s times the message
+// of a, and returns ct.
+func (ct *Ciphertext) ScalarMultiplication(a *Ciphertext, s *big.Int) *Ciphertext {
+	ct.C1.ScalarMultiplication(&a.C1, reduce(s))
+	ct.C2.ScalarMultiplication(&a.C2, reduce(s))
+	return ct
+}
+
+// Sum returns an encryption of the sum of the messages of cts, for instance
+// the tally of encrypted ballots.
+func Sum(cts []Ciphertext) Ciphertext {
+	var res Ciphertext
+	res.C1.Y.SetOne()
+	res.C2.Y.SetOne()
+	for i := range cts {
+		res.Add(&res, &cts[i])
+	}
+	return res
+}
+
+// Equal returns true if ct and other are the same ciphertext.
+func (ct *Ciphertext) Equal(other *Ciphertext) bool {
+	return ct.C1.Equal(&other.C1) && ct.C2.Equal(&other.C2)
+}
+
+// Bytes returns the compressed points C₁ || C₂.
+func (ct *Ciphertext) Bytes() []byte {
+	res := make([]byte, 0, sizeCiphertext)
+	res = append(res, ct.C1.Marshal()...)
+	return append(res, ct.C2.Marshal()...)
+}
+
+// SetBytes sets ct from its encoding C₁ || C₂, and checks that the points are
+// in the prime subgroup. It returns the number of bytes read from buf.
+func (ct *Ciphertext) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizeCiphertext {
+		return 0, ErrInvalidCiphertext
+	}
+	var res Ciphertext
+	if _, err := res.C1.SetBytes(buf[:sizePoint]); err != nil {
+		return 0, err
+	}
+	if _, err := res.C2.SetBytes(buf[sizePoint:sizeCiphertext]); err != nil {
+		return 0, err
+	}
+	if !isInSubGroup(&res.C1) || !isInSubGroup(&res.C2) {
+		return 0, ErrInvalidPoint
+	}
+	*ct = res
+	return sizeCiphertext, nil
+}
+
+// isInSubGroup returns true if p is on the curve and in the prime subgroup
+func isInSubGroup(p *twistededwards.PointAffine) bool {
+	return p.IsInSubGroup()
+}
+
+// reduce returns s mod the order of the prime subgroup
+func reduce(s *big.Int) *big.Int {
+	params := twistededwards.GetEdwardsCurve()
+	return new(big.Int).Mod(s, &params.Order)
+}
+
+// randomScalar sets res to a non-zero random integer modulo the order of the
+// prime subgroup
+func randomScalar(r io.Reader, res *big.Int) error {
+	params := twistededwards.GetEdwardsCurve()
+	// 128 extra bits make the bias of the reduction negligible
+	buf := make([]byte, (params.Order.BitLen()+7)/8+16)
+	for {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return err
+		}
+		res.SetBytes(buf).Mod(res, &params.Order)
+		if res.Sign() != 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package elgamal
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards/frost"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards/sigma"
+)
+
+var (
+	ErrInvalidDecryptionShare = errors.New("invalid decryption share")
+	ErrInvalidShares          = errors.New("not enough, or duplicate, decryption shares")
+)
+
+// decryptionShareDomain binds the proofs of the decryption shares to this use
+const decryptionShareDomain = "ElGamal-BLS12-377-TWISTEDEDWARDS-decryption-share"
+
+// DecryptionShare is the partial decryption D = [sᵢ]C₁ of a ciphertext by the
+// trustee of identifier ID, with a proof that sᵢ is the discrete logarithm of
+// its public share.
+type DecryptionShare struct {
+	ID    uint32
+	D     twistededwards.PointAffine
+	Proof sigma.Proof
+}
+
+// PublicKeyFromCommitment returns the public key of the trustees sharing the
+// secret key committed to by c.
+func PublicKeyFromCommitment(c frost.Commitment) PublicKey {
+	return PublicKey{A: c.GroupKey()}
+}
+
+// NewDecryptionShare returns the decryption share of ct by the trustee holding
+// the key share k. hf is the hash function of the Fiat-Shamir transform of the
+// proof; it must hash arbitrary byte strings (e.g. SHA-256, not MiMC).
+//
+// C₁ must be in the prime subgroup: with a small order component, D would leak
+// sᵢ modulo the cofactor.
+func NewDecryptionShare(k *frost.KeyShare, ct *Ciphertext, hf hash.Hash) (DecryptionShare, error) {
+	params := twistededwards.GetEdwardsCurve()
+	if !isInSubGroup(&ct.C1) {
+		return DecryptionShare{}, ErrInvalidPoint
+	}
+	share := DecryptionShare{ID: k.ID}
+	var secret big.Int
+	k.Secret.BigInt(&secret)
+	share.D.ScalarMultiplicationCT(&ct.C1, &secret)
+
+	publicShare := k.Commitment.PublicShare(k.ID)
+	statement := sigma.DLEQ(&params.Base, &publicShare, &ct.C1, &share.D)
+	var err error
+	share.Proof, err = sigma.Prove(&statement, []big.Int{secret}, hf, proofContext(k.ID))
+	secret.SetUint64(0)
+	if err != nil {
+		return DecryptionShare{}, err
+	}
+	return share, nil
+}
+
+// VerifyDecryptionShare checks the proof of a decryption share of ct against
+// the public share of its trustee, derived from the commitment c to the key
+// shares.
+func VerifyDecryptionShare(c frost.Commitment, ct *Ciphertext, share *DecryptionShare, hf hash.Hash) error {
+	params := twistededwards.GetEdwardsCurve()
+	if share.ID == 0 || len(c) == 0 {
+		return ErrInvalidDecryptionShare
+	}
+	if !isInSubGroup(&ct.C1) || !isInSubGroup(&share.D) {
+		return ErrInvalidPoint
+	}
+	publicShare := c.PublicShare(share.ID)
+	statement := sigma.DLEQ(&params.Base, &publicShare, &ct.C1, &share.D)
+	if err := sigma.Verify(&statement, &share.Proof, hf, proofContext(share.ID)); err != nil {
+		return ErrInvalidDecryptionShare
+	}
+	return nil
+}
+
+// CombineDecryptionShares returns [m]B, where m is the message of ct, from at
+// least threshold decryption shares of distinct trustees. The shares must have
+// been checked with VerifyDecryptionShare.
+func CombineDecryptionShares(c frost.Commitment, ct *Ciphertext, shares []DecryptionShare) (twistededwards.PointAffine, error) {
+	if len(shares) < c.Threshold() {
+		return twistededwards.PointAffine{}, ErrInvalidShares
+	}
+	if !isInSubGroup(&ct.C2) {
+		return twistededwards.PointAffine{}, ErrInvalidPoint
+	}
+	sorted := make([]DecryptionShare, len(shares))
+	copy(sorted, shares)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+	ids := make([]uint32, len(sorted))
+	for i := range sorted {
+		if sorted[i].ID == 0 || (i > 0 && sorted[i].ID == sorted[i-1].ID) {
+			return twistededwards.PointAffine{}, ErrInvalidShares
+		}
+		ids[i] = sorted[i].ID
+	}
+
+	// [x]C₁ = ∑ [λᵢ]Dᵢ, and [m]B = C₂ - [x]C₁
+	var res, t twistededwards.PointAffine
+	res.Y.SetOne()
+	for i := range sorted {
+		lambda := lagrangeCoefficient(ids, i)
+		t.ScalarMultiplication(&sorted[i].D, &lambda)
+		res.Add(&res, &t)
+	}
+	res.Neg(&res)
+	res.Add(&res, &ct.C2)
+	return res, nil
+}
+
+// lagrangeCoefficient returns λᵢ = ∏ⱼ≠ᵢ idⱼ/(idⱼ-idᵢ), the coefficient of the
+// share i in the interpolation at 0
+func lagrangeCoefficient(ids []uint32, i int) big.Int {
+	params := twistededwards.GetEdwardsCurve()
+	var num, den, t big.Int
+	num.SetUint64(1)
+	den.SetUint64(1)
+	xi := new(big.Int).SetUint64(uint64(ids[i]))
+	for j := range ids {
+		if j == i {
+			continue
+		}
+		t.SetUint64(uint64(ids[j]))
+		num.Mul(&num, &t).Mod(&num, &params.Order)
+		t.Sub(&t, xi)
+		den.Mul(&den, &t).Mod(&den, &params.Order)
+	}
+	den.ModInverse(&den, &params.Order)
+	num.Mul(&num, &den).Mod(&num, &params.Order)
+	return num
+}
+
+// proofContext returns the data bound by the proof of the decryption share of
+// the trustee id
+func proofContext(id uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte(decryptionShareDomain), id)
+}
@@ -0,0 +1,783 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b0cff680)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_110      = _10 + _100
+	//	_1010     = _100 + _110
+	//	_1100     = _10 + _1010
+	//	_1101     = 1 + _1100
+	//	_10000    = _100 + _1100
+	//	_10010    = _10 + _10000
+	//	_11110    = _1100 + _10010
+	//	_101011   = _1101 + _11110
+	//	_1010110  = 2*_101011
+	//	_1010111  = 1 + _1010110
+	//	_1011011  = _100 + _1010111
+	//	_1111001  = _11110 + _1011011
+	//	_1111011  = _10 + _1111001
+	//	_10001011 = _10000 + _1111011
+	//	_10010101 = _1010 + _10001011
+	//	_10011101 = _10010 + _10001011
+	//	_10100101 = _10000 + _10010101
+	//	_10101011 = _110 + _10100101
+	//	_10101111 = _100 + _10101011
+	//	_11000001 = _10010 + _10101111
+	//	_11000011 = _10 + _11000001
+	//	_11001111 = _1100 + _11000011
+	//	_11010001 = _10 + _11001111
+	//	_11010011 = _10 + _11010001
+	//	_11100101 = _10010 + _11010011
+	//	_11101001 = _100 + _11100101
+	//	_11101011 = _10 + _11101001
+	//	i49       = ((_1010111 + _11010011) << 7 + _1011011) << 10 + _10101011
+	//	i78       = ((i49 << 8 + _11010011) << 9 + _10001011) << 10
+	//	i98       = ((_10100101 + i78) << 7 + _101011) << 10 + _11000001
+	//	i127      = ((i98 << 9 + _11010001) << 10 + _11010001) << 8
+	//	i146      = ((_11100101 + i127) << 8 + _11000011) << 8 + _1111011
+	//	i188      = ((i146 << 19 + _10100101) << 10 + _10011101) << 11
+	//	i210      = ((_11101011 + i188) << 8 + _110 + _11101011) << 11
+	//	i232      = ((_1111001 + i210) << 11 + _10101111) << 8 + _11101011
+	//	i258      = ((i232 << 8 + _10010101) << 7 + _1010111) << 9
+	//	i279      = ((_11101001 + i258) << 6 + _101011) << 12 + _11001111
+	//	return      ((i279 << 7 + _1111011) << 2 + 1) << 7
+	//
+	// Operations: 243 squares 54 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19 Element
+	// Step 1: t5 = x^0x2
+	t5.Square(&x)
+
+	// Step 2: t2 = x^0x4
+	t2.Square(t5)
+
+	// Step 3: t8 = x^0x6
+	t8.Mul(t5, t2)
+
+	// Step 4: t4 = x^0xa
+	t4.Mul(t2, t8)
+
+	// Step 5: t0 = x^0xc
+	t0.Mul(t5, t4)
+
+	// Step 6: t1 = x^0xd
+	t1.Mul(&x, t0)
+
+	// Step 7: t6 = x^0x10
+	t6.Mul(t2, t0)
+
+	// Step 8: t12 = x^0x12
+	t12.Mul(t5, t6)
+
+	// Step 9: z = x^0x1e
+	z.Mul(t0, t12)
+
+	// Step 10: t1 = x^0x2b
+	t1.Mul(t1, z)
+
+	// Step 11: t3 = x^0x56
+	t3.Square(t1)
+
+	// Step 12: t3 = x^0x57
+	t3.Mul(&x, t3)
+
+	// Step 13: t18 = x^0x5b
+	t18.Mul(t2, t3)
+
+	// Step 14: t7 = x^0x79
+	t7.Mul(z, t18)
+
+	// Step 15: z = x^0x7b
+	z.Mul(t5, t7)
+
+	// Step 16: t15 = x^0x8b
+	t15.Mul(t6, z)
+
+	// Step 17: t4 = x^0x95
+	t4.Mul(t4, t15)
+
+	// Step 18: t9 = x^0x9d
+	t9.Mul(t12, t15)
+
+	// Step 19: t10 = x^0xa5
+	t10.Mul(t6, t4)
+
+	// Step 20: t17 = x^0xab
+	t17.Mul(t8, t10)
+
+	// Step 21: t6 = x^0xaf
+	t6.Mul(t2, t17)
+
+	// Step 22: t14 = x^0xc1
+	t14.Mul(t12, t6)
+
+	// Step 23: t11 = x^0xc3
+	t11.Mul(t5, t14)
+
+	// Step 24: t0 = x^0xcf
+	t0.Mul(t0, t11)
+
+	// Step 25: t13 = x^0xd1
+	t13.Mul(t5, t0)
+
+	// Step 26: t16 = x^0xd3
+	t16.Mul(t5, t13)
+
+	// Step 27: t12 = x^0xe5
+	t12.Mul(t12, t16)
+
+	// Step 28: t2 = x^0xe9
+	t2.Mul(t2, t12)
+
+	// Step 29: t5 = x^0xeb
+	t5.Mul(t5, t2)
+
+	// Step 30: t19 = x^0x12a
+	t19.Mul(t3, t16)
+
+	// Step 37: t19 = x^0x9500
+	for s := 0; s < 7; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 38: t18 = x^0x955b
+	t18.Mul(t18, t19)
+
+	// Step 48: t18 = x^0x2556c00
+	for s := 0; s < 10; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 49: t17 = x^0x2556cab
+	t17.Mul(t17, t18)
+
+	// Step 57: t17 = x^0x2556cab00
+	for s := 0; s < 8; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 58: t16 = x^0x2556cabd3
+	t16.Mul(t16, t17)
+
+	// Step 67: t16 = x^0x4aad957a600
+	for s := 0; s < 9; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 68: t15 = x^0x4aad957a68b
+	t15.Mul(t15, t16)
+
+	// Step 78: t15 = x^0x12ab655e9a2c00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 79: t15 = x^0x12ab655e9a2ca5
+	t15.Mul(t10, t15)
+
+	// Step 86: t15 = x^0x955b2af4d165280
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 87: t15 = x^0x955b2af4d1652ab
+	t15.Mul(t1, t15)
+
+	// Step 97: t15 = x^0x2556cabd34594aac00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 98: t14 = x^0x2556cabd34594aacc1
+	t14.Mul(t14, t15)
+
+	// Step 107: t14 = x^0x4aad957a68b295598200
+	for s := 0; s < 9; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 108: t14 = x^0x4aad957a68b2955982d1
+	t14.Mul(t13, t14)
+
+	// Step 118: t14 = x^0x12ab655e9a2ca55660b4400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 119: t13 = x^0x12ab655e9a2ca55660b44d1
+	t13.Mul(t13, t14)
+
+	// Step 127: t13 = x^0x12ab655e9a2ca55660b44d100
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 128: t12 = x^0x12ab655e9a2ca55660b44d1e5
+	t12.Mul(t12, t13)
+
+	// Step 136: t12 = x^0x12ab655e9a2ca55660b44d1e500
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 137: t11 = x^0x12ab655e9a2ca55660b44d1e5c3
+	t11.Mul(t11, t12)
+
+	// Step 145: t11 = x^0x12ab655e9a2ca55660b44d1e5c300
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 146: t11 = x^0x12ab655e9a2ca55660b44d1e5c37b
+	t11.Mul(z, t11)
+
+	// Step 165: t11 = x^0x955b2af4d1652ab305a268f2e1bd80000
+	for s := 0; s < 19; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 166: t10 = x^0x955b2af4d1652ab305a268f2e1bd800a5
+	t10.Mul(t10, t11)
+
+	// Step 176: t10 = x^0x2556cabd34594aacc1689a3cb86f60029400
+	for s := 0; s < 10; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 177: t9 = x^0x2556cabd34594aacc1689a3cb86f6002949d
+	t9.Mul(t9, t10)
+
+	// Step 188: t9 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 189: t9 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8eb
+	t9.Mul(t5, t9)
+
+	// Step 197: t9 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8eb00
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 198: t8 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8eb06
+	t8.Mul(t8, t9)
+
+	// Step 199: t8 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf1
+	t8.Mul(t5, t8)
+
+	// Step 210: t8 = x^0x955b2af4d1652ab305a268f2e1bd800a527475f8800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 211: t7 = x^0x955b2af4d1652ab305a268f2e1bd800a527475f8879
+	t7.Mul(t7, t8)
+
+	// Step 222: t7 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c800
+	for s := 0; s < 11; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 223: t6 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8af
+	t6.Mul(t6, t7)
+
+	// Step 231: t6 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8af00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 232: t5 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb
+	t5.Mul(t5, t6)
+
+	// Step 240: t5 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb00
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 241: t4 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb95
+	t4.Mul(t4, t5)
+
+	// Step 248: t4 = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5ca80
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 249: t3 = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad7
+	t3.Mul(t3, t4)
+
+	// Step 258: t3 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb95ae00
+	for s := 0; s < 9; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 259: t2 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb95aee9
+	t2.Mul(t2, t3)
+
+	// Step 265: t2 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba40
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 266: t1 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b
+	t1.Mul(t1, t2)
+
+	// Step 278: t1 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b000
+	for s := 0; s < 12; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 279: t0 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b0cf
+	t0.Mul(t0, t1)
+
+	// Step 286: t0 = x^0x955b2af4d1652ab305a268f2e1bd800a527475f887915fd72b5dd3586780
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 287: z = x^0x955b2af4d1652ab305a268f2e1bd800a527475f887915fd72b5dd35867fb
+	z.Mul(z, t0)
+
+	// Step 289: z = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad774d619fec
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+
+	// Step 290: z = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad774d619fed
+	z.Mul(&x, z)
+
+	// Step 297: z = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b0cff680
+	for s := 0; s < 7; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad774d619fecff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_1000     = 2*_100
+	//	_1010     = _10 + _1000
+	//	_1100     = _10 + _1010
+	//	_1101     = 1 + _1100
+	//	_1110     = 1 + _1101
+	//	_10010    = _100 + _1110
+	//	_11110    = _1100 + _10010
+	//	_101011   = _1101 + _11110
+	//	_1010110  = 2*_101011
+	//	_1010111  = 1 + _1010110
+	//	_1011011  = _100 + _1010111
+	//	_1111001  = _11110 + _1011011
+	//	_1111011  = _10 + _1111001
+	//	_10001011 = _10010 + _1111001
+	//	_10010101 = _1010 + _10001011
+	//	_10011101 = _1000 + _10010101
+	//	_10100101 = _1000 + _10011101
+	//	_10101011 = _1110 + _10011101
+	//	_10101111 = _100 + _10101011
+	//	_11000001 = _10010 + _10101111
+	//	_11000011 = _10 + _11000001
+	//	_11001111 = _1100 + _11000011
+	//	_11010001 = _10 + _11001111
+	//	_11010011 = _10 + _11010001
+	//	_11100101 = _10010 + _11010011
+	//	_11101001 = _100 + _11100101
+	//	_11101011 = _10 + _11101001
+	//	_11110001 = _1000 + _11101001
+	//	i50       = ((_1010111 + _11010011) << 7 + _1011011) << 10 + _10101011
+	//	i79       = ((i50 << 8 + _11010011) << 9 + _10001011) << 10
+	//	i99       = ((_10100101 + i79) << 7 + _101011) << 10 + _11000001
+	//	i128      = ((i99 << 9 + _11010001) << 10 + _11010001) << 8
+	//	i147      = ((_11100101 + i128) << 8 + _11000011) << 8 + _1111011
+	//	i189      = ((i147 << 19 + _10100101) << 10 + _10011101) << 11
+	//	i211      = ((_11101011 + i189) << 8 + _11110001) << 11 + _1111001
+	//	i240      = ((i211 << 11 + _10101111) << 8 + _11101011) << 8
+	//	i259      = ((_10010101 + i240) << 7 + _1010111) << 9 + _11101001
+	//	i286      = ((i259 << 6 + _101011) << 12 + _11001111) << 7
+	//	return      (_1111011 + i286) << 10 + _11110001 + _1110
+	//
+	// Operations: 245 squares 54 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20 Element
+	// Step 1: t7 = x^0x2
+	t7.Square(&x)
+
+	// Step 2: t4 = x^0x4
+	t4.Square(t7)
+
+	// Step 3: t0 = x^0x8
+	t0.Square(t4)
+
+	// Step 4: t6 = x^0xa
+	t6.Mul(t7, t0)
+
+	// Step 5: t2 = x^0xc
+	t2.Mul(t7, t6)
+
+	// Step 6: t3 = x^0xd
+	t3.Mul(&x, t2)
+
+	// Step 7: z = x^0xe
+	z.Mul(&x, t3)
+
+	// Step 8: t13 = x^0x12
+	t13.Mul(t4, z)
+
+	// Step 9: t1 = x^0x1e
+	t1.Mul(t2, t13)
+
+	// Step 10: t3 = x^0x2b
+	t3.Mul(t3, t1)
+
+	// Step 11: t5 = x^0x56
+	t5.Square(t3)
+
+	// Step 12: t5 = x^0x57
+	t5.Mul(&x, t5)
+
+	// Step 13: t19 = x^0x5b
+	t19.Mul(t4, t5)
+
+	// Step 14: t9 = x^0x79
+	t9.Mul(t1, t19)
+
+	// Step 15: t1 = x^0x7b
+	t1.Mul(t7, t9)
+
+	// Step 16: t16 = x^0x8b
+	t16.Mul(t13, t9)
+
+	// Step 17: t6 = x^0x95
+	t6.Mul(t6, t16)
+
+	// Step 18: t10 = x^0x9d
+	t10.Mul(t0, t6)
+
+	// Step 19: t11 = x^0xa5
+	t11.Mul(t0, t10)
+
+	// Step 20: t18 = x^0xab
+	t18.Mul(z, t10)
+
+	// Step 21: t8 = x^0xaf
+	t8.Mul(t4, t18)
+
+	// Step 22: t15 = x^0xc1
+	t15.Mul(t13, t8)
+
+	// Step 23: t12 = x^0xc3
+	t12.Mul(t7, t15)
+
+	// Step 24: t2 = x^0xcf
+	t2.Mul(t2, t12)
+
+	// Step 25: t14 = x^0xd1
+	t14.Mul(t7, t2)
+
+	// Step 26: t17 = x^0xd3
+	t17.Mul(t7, t14)
+
+	// Step 27: t13 = x^0xe5
+	t13.Mul(t13, t17)
+
+	// Step 28: t4 = x^0xe9
+	t4.Mul(t4, t13)
+
+	// Step 29: t7 = x^0xeb
+	t7.Mul(t7, t4)
+
+	// Step 30: t0 = x^0xf1
+	t0.Mul(t0, t4)
+
+	// Step 31: t20 = x^0x12a
+	t20.Mul(t5, t17)
+
+	// Step 38: t20 = x^0x9500
+	for s := 0; s < 7; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 39: t19 = x^0x955b
+	t19.Mul(t19, t20)
+
+	// Step 49: t19 = x^0x2556c00
+	for s := 0; s < 10; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 50: t18 = x^0x2556cab
+	t18.Mul(t18, t19)
+
+	// Step 58: t18 = x^0x2556cab00
+	for s := 0; s < 8; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 59: t17 = x^0x2556cabd3
+	t17.Mul(t17, t18)
+
+	// Step 68: t17 = x^0x4aad957a600
+	for s := 0; s < 9; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 69: t16 = x^0x4aad957a68b
+	t16.Mul(t16, t17)
+
+	// Step 79: t16 = x^0x12ab655e9a2c00
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 80: t16 = x^0x12ab655e9a2ca5
+	t16.Mul(t11, t16)
+
+	// Step 87: t16 = x^0x955b2af4d165280
+	for s := 0; s < 7; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 88: t16 = x^0x955b2af4d1652ab
+	t16.Mul(t3, t16)
+
+	// Step 98: t16 = x^0x2556cabd34594aac00
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 99: t15 = x^0x2556cabd34594aacc1
+	t15.Mul(t15, t16)
+
+	// Step 108: t15 = x^0x4aad957a68b295598200
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 109: t15 = x^0x4aad957a68b2955982d1
+	t15.Mul(t14, t15)
+
+	// Step 119: t15 = x^0x12ab655e9a2ca55660b4400
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 120: t14 = x^0x12ab655e9a2ca55660b44d1
+	t14.Mul(t14, t15)
+
+	// Step 128: t14 = x^0x12ab655e9a2ca55660b44d100
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 129: t13 = x^0x12ab655e9a2ca55660b44d1e5
+	t13.Mul(t13, t14)
+
+	// Step 137: t13 = x^0x12ab655e9a2ca55660b44d1e500
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 138: t12 = x^0x12ab655e9a2ca55660b44d1e5c3
+	t12.Mul(t12, t13)
+
+	// Step 146: t12 = x^0x12ab655e9a2ca55660b44d1e5c300
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 147: t12 = x^0x12ab655e9a2ca55660b44d1e5c37b
+	t12.Mul(t1, t12)
+
+	// Step 166: t12 = x^0x955b2af4d1652ab305a268f2e1bd80000
+	for s := 0; s < 19; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 167: t11 = x^0x955b2af4d1652ab305a268f2e1bd800a5
+	t11.Mul(t11, t12)
+
+	// Step 177: t11 = x^0x2556cabd34594aacc1689a3cb86f60029400
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 178: t10 = x^0x2556cabd34594aacc1689a3cb86f6002949d
+	t10.Mul(t10, t11)
+
+	// Step 189: t10 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e800
+	for s := 0; s < 11; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 190: t10 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8eb
+	t10.Mul(t7, t10)
+
+	// Step 198: t10 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8eb00
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 199: t10 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf1
+	t10.Mul(t0, t10)
+
+	// Step 210: t10 = x^0x955b2af4d1652ab305a268f2e1bd800a527475f8800
+	for s := 0; s < 11; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 211: t9 = x^0x955b2af4d1652ab305a268f2e1bd800a527475f8879
+	t9.Mul(t9, t10)
+
+	// Step 222: t9 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 223: t8 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8af
+	t8.Mul(t8, t9)
+
+	// Step 231: t8 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8af00
+	for s := 0; s < 8; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 232: t7 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb
+	t7.Mul(t7, t8)
+
+	// Step 240: t7 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 241: t6 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb95
+	t6.Mul(t6, t7)
+
+	// Step 248: t6 = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5ca80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 249: t5 = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad7
+	t5.Mul(t5, t6)
+
+	// Step 258: t5 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb95ae00
+	for s := 0; s < 9; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 259: t4 = x^0x4aad957a68b2955982d1347970dec005293a3afc43c8afeb95aee9
+	t4.Mul(t4, t5)
+
+	// Step 265: t4 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba40
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 266: t3 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b
+	t3.Mul(t3, t4)
+
+	// Step 278: t3 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b000
+	for s := 0; s < 12; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 279: t2 = x^0x12ab655e9a2ca55660b44d1e5c37b0014a4e8ebf10f22bfae56bba6b0cf
+	t2.Mul(t2, t3)
+
+	// Step 286: t2 = x^0x955b2af4d1652ab305a268f2e1bd800a527475f887915fd72b5dd3586780
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 287: t1 = x^0x955b2af4d1652ab305a268f2e1bd800a527475f887915fd72b5dd35867fb
+	t1.Mul(t1, t2)
+
+	// Step 297: t1 = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad774d619fec00
+	for s := 0; s < 10; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 298: t0 = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad774d619fecf1
+	t0.Mul(t0, t1)
+
+	// Step 299: z = x^0x2556cabd34594aacc1689a3cb86f6002949d1d7e21e457f5cad774d619fecff
+	z.Mul(z, t0)
+
+	return z
+}
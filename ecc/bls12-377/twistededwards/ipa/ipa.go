@@ -0,0 +1,734 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidSize           = errors.New("size must be a non-zero power of two")
+	ErrCommitmentKeyTooSmall = errors.New("commitment key is too small for the vector")
+	ErrSizeMismatch          = errors.New("vectors and bases must have the same length")
+	ErrInvalidProofSize      = errors.New("proof does not have the expected number of rounds")
+	ErrZeroChallenge         = errors.New("fiat-shamir challenge is zero")
+	ErrVerifyCommitment      = errors.New("commitment does not match the opening")
+	ErrVerifyInnerProduct    = errors.New("can't verify inner-product argument")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+	ErrInvalidPoint          = errors.New("point is not in the prime subgroup")
+)
+
+// Digest is a Pedersen vector commitment.
+type Digest = twistededwards.PointAffine
+
+// CommitmentKey holds the bases of the commitment scheme. The discrete
+// logarithms relating the bases are unknown.
+//
+// Scalars are integers modulo the order of the prime subgroup of the curve,
+// represented as big.Int in [0, order).
+type CommitmentKey struct {
+	G []twistededwards.PointAffine // bases for the committed vector
+	H []twistededwards.PointAffine // second vector of bases, for two-vector inner-product arguments
+	Q twistededwards.PointAffine   // blinding base
+	U twistededwards.PointAffine   // base carrying the inner product in the arguments
+}
+
+// NewCommitmentKey derives a commitment key for vectors of up to size
+// elements. The bases are hashed to the prime subgroup of the curve, using
+// domainSeparator as domain separation tag, so that the setup is transparent
+// and reproducible.
+func NewCommitmentKey(size int, domainSeparator []byte) (CommitmentKey, error) {
+	if size <= 0 {
+		return CommitmentKey{}, ErrInvalidSize
+	}
+	var ck CommitmentKey
+	var err error
+	if ck.G, err = HashToBases("G", size, domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	if ck.H, err = HashToBases("H", size, domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	if ck.Q, err = hashToPoint([]byte("Q"), domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	if ck.U, err = hashToPoint([]byte("U"), domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	return ck, nil
+}
+
+// HashToBases returns n points of the prime subgroup whose discrete
+// logarithms are unknown. The i-th point is derived from label || i, with i
+// encoded on 4 bytes in big endian.
+func HashToBases(label string, n int, domainSeparator []byte) ([]twistededwards.PointAffine, error) {
+	res := make([]twistededwards.PointAffine, n)
+	errs := make([]error, n)
+	parallel.Execute(n, func(start, end int) {
+		msg := make([]byte, len(label)+4)
+		copy(msg, label)
+		for i := start; i < end; i++ {
+			binary.BigEndian.PutUint32(msg[len(label):], uint32(i))
+			res[i], errs[i] = hashToPoint(msg, domainSeparator)
+		}
+	})
+	for i := range errs {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+	}
+	return res, nil
+}
+
+// hashToPoint maps msg to the prime subgroup with a try-and-increment
+// method: a y coordinate is hashed to the field until it lies on the curve,
+// and the point is multiplied by the cofactor.
+func hashToPoint(msg, domainSeparator []byte) (twistededwards.PointAffine, error) {
+	params := twistededwards.GetEdwardsCurve()
+	var cofactor big.Int
+	params.Cofactor.BigInt(&cofactor)
+
+	buf := make([]byte, len(msg)+1)
+	copy(buf, msg)
+	var one, num, den fr.Element
+	one.SetOne()
+	for ctr := 0; ctr < 256; ctr++ {
+		buf[len(msg)] = byte(ctr)
+		y, err := fr.Hash(buf, domainSeparator, 1)
+		if err != nil {
+			return twistededwards.PointAffine{}, err
+		}
+		// x² = (1 - y²) / (a - d·y²)
+		num.Square(&y[0])
+		den.Mul(&num, &params.D)
+		num.Sub(&one, &num)
+		den.Sub(&params.A, &den)
+		if den.IsZero() {
+			continue
+		}
+		num.Div(&num, &den)
+		if num.Legendre() != 1 {
+			continue
+		}
+		var p twistededwards.PointAffine
+		p.Y.Set(&y[0])
+		p.X.Sqrt(&num)
+		if p.X.LexicographicallyLargest() {
+			p.X.Neg(&p.X)
+		}
+		p.ScalarMultiplication(&p, &cofactor)
+		if !p.IsZero() {
+			return p, nil
+		}
+	}
+	return twistededwards.PointAffine{}, errors.New("failed to hash to the curve")
+}
+
+// Commit returns ⟨values, G⟩ + blinding·Q. The blinding must be sampled
+// uniformly at random (see [RandomScalar]) for the commitment to be hiding.
+func (ck *CommitmentKey) Commit(values []big.Int, blinding *big.Int) (Digest, error) {
+	if len(values) > len(ck.G) {
+		return Digest{}, ErrCommitmentKeyTooSmall
+	}
+	res := msm(ck.G[:len(values)], values)
+	var q Digest
+	q.ScalarMultiplication(&ck.Q, blinding)
+	res.Add(&res, &q)
+	return res, nil
+}
+
+// VerifyCommitment checks that commitment opens to values with the given
+// blinding.
+func (ck *CommitmentKey) VerifyCommitment(commitment *Digest, values []big.Int, blinding *big.Int) error {
+	expected, err := ck.Commit(values, blinding)
+	if err != nil {
+		return err
+	}
+	if !expected.Equal(commitment) {
+		return ErrVerifyCommitment
+	}
+	return nil
+}
+
+// Add returns a+b. If a commits to v₁ with blinding r₁ and b commits to v₂
+// with blinding r₂, then a+b commits to v₁+v₂ with blinding r₁+r₂.
+func Add(a, b *Digest) Digest {
+	var res Digest
+	res.Add(a, b)
+	return res
+}
+
+// Scale returns s·a. If a commits to v with blinding r, then s·a commits to
+// s·v with blinding s·r.
+func Scale(a *Digest, s *big.Int) Digest {
+	var res Digest
+	res.ScalarMultiplication(a, s)
+	return res
+}
+
+// RandomScalar returns a uniformly random integer modulo the order of the
+// prime subgroup.
+func RandomScalar() (*big.Int, error) {
+	params := twistededwards.GetEdwardsCurve()
+	return rand.Int(rand.Reader, &params.Order)
+}
+
+// InnerProductProof proves knowledge of vectors a, b of size n such that
+// P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩·U. It is not hiding: it is meant to be used as
+// a sub-protocol.
+type InnerProductProof struct {
+	L, R []twistededwards.PointAffine // cross terms, one pair per round
+	A, B big.Int                      // a and b folded down to a single element
+}
+
+// OpeningProof proves that a commitment C = ⟨a, G⟩ + r·Q to a vector a of
+// size n satisfies ⟨a, b⟩ = v for a public vector b. It is zero-knowledge:
+// nothing but v is revealed about a.
+type OpeningProof struct {
+	L, R   []twistededwards.PointAffine // blinded cross terms, one pair per round
+	T      twistededwards.PointAffine   // commitment of the final Schnorr proof
+	Z1, Z2 big.Int                      // responses of the final Schnorr proof
+}
+
+// ProveInnerProduct computes an [InnerProductProof] for the vectors a, b
+// over the bases G, H and U.
+//
+// The Fiat-Shamir challenges are derived from the proof elements and from
+// dataTranscript, which must bind the statement, that is P or the public
+// values it is computed from.
+func ProveInnerProduct(G, H []twistededwards.PointAffine, U *twistededwards.PointAffine, a, b []big.Int, hf hash.Hash, dataTranscript ...[]byte) (InnerProductProof, error) {
+	n := len(a)
+	if n == 0 || n&(n-1) != 0 {
+		return InnerProductProof{}, ErrInvalidSize
+	}
+	if len(b) != n || len(G) != n || len(H) != n {
+		return InnerProductProof{}, ErrSizeMismatch
+	}
+	order := order()
+	nbRounds := bits.TrailingZeros(uint(n))
+	fs, err := newTranscript(hf, nbRounds, dataTranscript...)
+	if err != nil {
+		return InnerProductProof{}, err
+	}
+
+	// work on copies, the slices are folded in place
+	a, b = copyScalars(a), copyScalars(b)
+
+	proof := InnerProductProof{
+		L: make([]twistededwards.PointAffine, nbRounds),
+		R: make([]twistededwards.PointAffine, nbRounds),
+	}
+	var cross Digest
+	for j := 0; j < nbRounds; j++ {
+		m := n >> 1
+		aLo, aHi := a[:m], a[m:n]
+		bLo, bHi := b[:m], b[m:n]
+		gLo, gHi := G[:m], G[m:n]
+		hLo, hHi := H[:m], H[m:n]
+
+		// L = ⟨a_lo, G_hi⟩ + ⟨b_hi, H_lo⟩ + ⟨a_lo, b_hi⟩·U
+		proof.L[j] = msm(gHi, aLo)
+		cross = msm(hLo, bHi)
+		proof.L[j].Add(&proof.L[j], &cross)
+		cross.ScalarMultiplication(U, innerProduct(aLo, bHi, order))
+		proof.L[j].Add(&proof.L[j], &cross)
+
+		// R = ⟨a_hi, G_lo⟩ + ⟨b_lo, H_hi⟩ + ⟨a_hi, b_lo⟩·U
+		proof.R[j] = msm(gLo, aHi)
+		cross = msm(hHi, bLo)
+		proof.R[j].Add(&proof.R[j], &cross)
+		cross.ScalarMultiplication(U, innerProduct(aHi, bLo, order))
+		proof.R[j].Add(&proof.R[j], &cross)
+
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j])
+		if err != nil {
+			return InnerProductProof{}, err
+		}
+		xInv := new(big.Int).ModInverse(x, order)
+
+		// a' = x·a_lo + x⁻¹·a_hi, b' = x⁻¹·b_lo + x·b_hi
+		// G' = x⁻¹·G_lo + x·G_hi, H' = x·H_lo + x⁻¹·H_hi
+		foldScalars(aLo, aHi, x, xInv, order)
+		foldScalars(bLo, bHi, xInv, x, order)
+		G = foldBases(gLo, gHi, xInv, x)
+		H = foldBases(hLo, hHi, x, xInv)
+		a, b = aLo, bLo
+		n = m
+	}
+
+	proof.A.Set(&a[0])
+	proof.B.Set(&b[0])
+	return proof, nil
+}
+
+// VerifyInnerProduct checks that proof is a valid [InnerProductProof] for
+// P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩·U. dataTranscript must be the same as the one
+// given to the prover.
+func VerifyInnerProduct(G, H []twistededwards.PointAffine, U, P *twistededwards.PointAffine, proof *InnerProductProof, hf hash.Hash, dataTranscript ...[]byte) error {
+	n := len(G)
+	if len(H) != n {
+		return ErrSizeMismatch
+	}
+	challenges, err := proof.Challenges(n, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	if err = checkSubGroup([]Digest{*P}, proof.L, proof.R); err != nil {
+		return err
+	}
+	order := order()
+	s, sInv := FoldingScalars(challenges)
+
+	// a·⟨s, G⟩ + b·⟨s⁻¹, H⟩ + a·b·U = P + ∑ⱼ (xⱼ²·Lⱼ + xⱼ⁻²·Rⱼ)
+	for i := 0; i < n; i++ {
+		s[i].Mul(&s[i], &proof.A).Mod(&s[i], order)
+		sInv[i].Mul(&sInv[i], &proof.B).Mod(&sInv[i], order)
+	}
+	lhs := msm(G, s)
+	t := msm(H, sInv)
+	lhs.Add(&lhs, &t)
+	ab := new(big.Int).Mul(&proof.A, &proof.B)
+	t.ScalarMultiplication(U, ab.Mod(ab, order))
+	lhs.Add(&lhs, &t)
+
+	rhs := foldCrossTerms(P, proof.L, proof.R, challenges)
+	if !lhs.Equal(&rhs) {
+		return ErrVerifyInnerProduct
+	}
+	return nil
+}
+
+// Challenges recomputes the Fiat-Shamir challenges x₀, …, x_{k-1} of an
+// [InnerProductProof] on vectors of size n.
+func (proof *InnerProductProof) Challenges(n int, hf hash.Hash, dataTranscript ...[]byte) ([]big.Int, error) {
+	if n == 0 || n&(n-1) != 0 {
+		return nil, ErrInvalidSize
+	}
+	nbRounds := bits.TrailingZeros(uint(n))
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return nil, ErrInvalidProofSize
+	}
+	fs, err := newTranscript(hf, nbRounds, dataTranscript...)
+	if err != nil {
+		return nil, err
+	}
+	challenges := make([]big.Int, nbRounds)
+	for j := range challenges {
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j])
+		if err != nil {
+			return nil, err
+		}
+		challenges[j].Set(x)
+	}
+	return challenges, nil
+}
+
+// FoldingScalars returns s and s⁻¹ (coordinate-wise) such that, given the
+// round challenges, the bases folded by the prover are G' = ⟨s, G⟩ and
+// H' = ⟨s⁻¹, H⟩.
+func FoldingScalars(challenges []big.Int) (s, sInv []big.Int) {
+	order := order()
+	n := 1 << len(challenges)
+	s = make([]big.Int, n)
+	sInv = make([]big.Int, n)
+	s[0].SetUint64(1)
+	sInv[0].SetUint64(1)
+
+	// round j fixes the j-th most significant bit of the index: the low half
+	// is multiplied by xⱼ⁻¹ and the high half by xⱼ.
+	var xInv big.Int
+	for j, size := 0, 1; j < len(challenges); j, size = j+1, size<<1 {
+		x := &challenges[j]
+		xInv.ModInverse(x, order)
+		for i := size - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], x).Mod(&s[2*i+1], order)
+			s[2*i].Mul(&s[i], &xInv).Mod(&s[2*i], order)
+			sInv[2*i+1].Mul(&sInv[i], &xInv).Mod(&sInv[2*i+1], order)
+			sInv[2*i].Mul(&sInv[i], x).Mod(&sInv[2*i], order)
+		}
+	}
+	return s, sInv
+}
+
+// Open computes a zero-knowledge proof that commitment = ck.Commit(a, blinding)
+// satisfies ⟨a, b⟩ = v, and returns v. The size of a must be a power of two.
+func Open(ck *CommitmentKey, commitment *Digest, a []big.Int, blinding *big.Int, b []big.Int, hf hash.Hash, dataTranscript ...[]byte) (OpeningProof, *big.Int, error) {
+	n := len(a)
+	if n == 0 || n&(n-1) != 0 {
+		return OpeningProof{}, nil, ErrInvalidSize
+	}
+	if len(b) != n {
+		return OpeningProof{}, nil, ErrSizeMismatch
+	}
+	if n > len(ck.G) {
+		return OpeningProof{}, nil, ErrCommitmentKeyTooSmall
+	}
+	order := order()
+	v := innerProduct(a, b, order)
+	nbRounds := bits.TrailingZeros(uint(n))
+	fs := fiatshamir.NewTranscript(hf, openingChallengeNames(nbRounds)...)
+
+	// U' = ξ·U, P = C + v·U' = ⟨a, G⟩ + ⟨a, b⟩·U' + r·Q
+	xi, err := deriveOpeningChallenge(fs, commitment, b, v, dataTranscript...)
+	if err != nil {
+		return OpeningProof{}, nil, err
+	}
+	var uPrime Digest
+	uPrime.ScalarMultiplication(&ck.U, xi)
+
+	G := ck.G[:n]
+	a, b = copyScalars(a), copyScalars(b)
+	rho := new(big.Int).Set(blinding)
+
+	proof := OpeningProof{
+		L: make([]twistededwards.PointAffine, nbRounds),
+		R: make([]twistededwards.PointAffine, nbRounds),
+	}
+	var t Digest
+	for j := 0; j < nbRounds; j++ {
+		m := n >> 1
+		aLo, aHi := a[:m], a[m:n]
+		bLo, bHi := b[:m], b[m:n]
+		gLo, gHi := G[:m], G[m:n]
+
+		l, err := RandomScalar()
+		if err != nil {
+			return OpeningProof{}, nil, err
+		}
+		r, err := RandomScalar()
+		if err != nil {
+			return OpeningProof{}, nil, err
+		}
+
+		// L = ⟨a_lo, G_hi⟩ + ⟨a_lo, b_hi⟩·U' + l·Q
+		proof.L[j] = msm(gHi, aLo)
+		t.ScalarMultiplication(&uPrime, innerProduct(aLo, bHi, order))
+		proof.L[j].Add(&proof.L[j], &t)
+		t.ScalarMultiplication(&ck.Q, l)
+		proof.L[j].Add(&proof.L[j], &t)
+
+		// R = ⟨a_hi, G_lo⟩ + ⟨a_hi, b_lo⟩·U' + r·Q
+		proof.R[j] = msm(gLo, aHi)
+		t.ScalarMultiplication(&uPrime, innerProduct(aHi, bLo, order))
+		proof.R[j].Add(&proof.R[j], &t)
+		t.ScalarMultiplication(&ck.Q, r)
+		proof.R[j].Add(&proof.R[j], &t)
+
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j])
+		if err != nil {
+			return OpeningProof{}, nil, err
+		}
+		xInv := new(big.Int).ModInverse(x, order)
+
+		// the blinding of P' = x²·L + P + x⁻²·R is x²·l + ρ + x⁻²·r
+		l.Mul(l, x).Mul(l, x)
+		r.Mul(r, xInv).Mul(r, xInv)
+		rho.Add(rho, l).Add(rho, r).Mod(rho, order)
+
+		foldScalars(aLo, aHi, x, xInv, order)
+		foldScalars(bLo, bHi, xInv, x, order)
+		G = foldBases(gLo, gHi, xInv, x)
+		a, b = aLo, bLo
+		n = m
+	}
+
+	// prove knowledge of a, ρ such that P_f = a·(G_f + b_f·U') + ρ·Q
+	k1, err := RandomScalar()
+	if err != nil {
+		return OpeningProof{}, nil, err
+	}
+	k2, err := RandomScalar()
+	if err != nil {
+		return OpeningProof{}, nil, err
+	}
+	base := finalBase(&G[0], &uPrime, &b[0])
+	proof.T.ScalarMultiplication(&base, k1)
+	t.ScalarMultiplication(&ck.Q, k2)
+	proof.T.Add(&proof.T, &t)
+	c, err := deriveChallenge(fs, "c", &proof.T)
+	if err != nil {
+		return OpeningProof{}, nil, err
+	}
+	proof.Z1.Mul(c, &a[0]).Add(&proof.Z1, k1).Mod(&proof.Z1, order)
+	proof.Z2.Mul(c, rho).Add(&proof.Z2, k2).Mod(&proof.Z2, order)
+
+	return proof, v, nil
+}
+
+// Verify checks that proof is a valid [OpeningProof] of ⟨a, b⟩ = v for the
+// vector a committed in commitment. dataTranscript must be the same as the
+// one given to the prover.
+func Verify(ck *CommitmentKey, commitment *Digest, b []big.Int, v *big.Int, proof *OpeningProof, hf hash.Hash, dataTranscript ...[]byte) error {
+	n := len(b)
+	if n == 0 || n&(n-1) != 0 {
+		return ErrInvalidSize
+	}
+	if n > len(ck.G) {
+		return ErrCommitmentKeyTooSmall
+	}
+	nbRounds := bits.TrailingZeros(uint(n))
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return ErrInvalidProofSize
+	}
+	if err := checkSubGroup([]Digest{*commitment, proof.T}, proof.L, proof.R); err != nil {
+		return err
+	}
+	order := order()
+	fs := fiatshamir.NewTranscript(hf, openingChallengeNames(nbRounds)...)
+	xi, err := deriveOpeningChallenge(fs, commitment, b, v, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	challenges := make([]big.Int, nbRounds)
+	for j := range challenges {
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j])
+		if err != nil {
+			return err
+		}
+		challenges[j].Set(x)
+	}
+	c, err := deriveChallenge(fs, "c", &proof.T)
+	if err != nil {
+		return err
+	}
+	s, _ := FoldingScalars(challenges)
+	bFolded := innerProduct(s, b, order)
+
+	// with U' = ξ·U and P_f = C + v·U' + ∑ⱼ (xⱼ²·Lⱼ + xⱼ⁻²·Rⱼ), check that
+	// z₁·(⟨s, G⟩ + b_f·U') + z₂·Q = T + c·P_f
+	var uPrime, t Digest
+	uPrime.ScalarMultiplication(&ck.U, xi)
+	gFolded := msm(ck.G[:n], s)
+	lhs := finalBase(&gFolded, &uPrime, bFolded)
+	lhs.ScalarMultiplication(&lhs, &proof.Z1)
+	t.ScalarMultiplication(&ck.Q, &proof.Z2)
+	lhs.Add(&lhs, &t)
+
+	t.ScalarMultiplication(&uPrime, v)
+	t.Add(&t, commitment)
+	rhs := foldCrossTerms(&t, proof.L, proof.R, challenges)
+	rhs.ScalarMultiplication(&rhs, c)
+	rhs.Add(&rhs, &proof.T)
+
+	if !lhs.Equal(&rhs) {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// checkSubGroup returns ErrInvalidPoint if one of the points is not on the
+// curve or not in the prime subgroup. The verification equations only hold
+// up to the cofactor otherwise: a commitment shifted by a small order point
+// would be accepted with a non-negligible probability, and the scalar
+// multiplication may assume its input is in the prime subgroup.
+func checkSubGroup(points ...[]Digest) error {
+	for i := range points {
+		for j := range points[i] {
+			if !points[i][j].IsInSubGroup() {
+				return ErrInvalidPoint
+			}
+		}
+	}
+	return nil
+}
+
+// finalBase returns g + b·u
+func finalBase(g, u *Digest, b *big.Int) Digest {
+	var res Digest
+	res.ScalarMultiplication(u, b)
+	res.Add(&res, g)
+	return res
+}
+
+// foldCrossTerms returns P + ∑ⱼ (xⱼ²·Lⱼ + xⱼ⁻²·Rⱼ)
+func foldCrossTerms(P *Digest, L, R []Digest, challenges []big.Int) Digest {
+	order := order()
+	var res, t Digest
+	res.Set(P)
+	var x2, xInv2 big.Int
+	for j := range challenges {
+		x2.Mul(&challenges[j], &challenges[j]).Mod(&x2, order)
+		xInv2.ModInverse(&x2, order)
+		t.ScalarMultiplication(&L[j], &x2)
+		res.Add(&res, &t)
+		t.ScalarMultiplication(&R[j], &xInv2)
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// msm returns ⟨scalars, points⟩
+func msm(points []Digest, scalars []big.Int) Digest {
+	var res Digest
+	res.Y.SetOne()
+	if len(points) == 0 {
+		return res
+	}
+
+	var identity, acc twistededwards.PointExtended
+	identity.FromAffine(&res)
+	acc.Set(&identity)
+	var lock sync.Mutex
+	parallel.Execute(len(points), func(start, end int) {
+		var p, sum twistededwards.PointExtended
+		sum.Set(&identity)
+		for i := start; i < end; i++ {
+			p.FromAffine(&points[i])
+			sum.Add(&sum, p.ScalarMultiplication(&p, &scalars[i]))
+		}
+		lock.Lock()
+		acc.Add(&acc, &sum)
+		lock.Unlock()
+	})
+	res.FromExtended(&acc)
+	return res
+}
+
+// foldScalars sets lo[i] = sLo·lo[i] + sHi·hi[i]
+func foldScalars(lo, hi []big.Int, sLo, sHi, order *big.Int) {
+	var t big.Int
+	for i := range lo {
+		t.Mul(&hi[i], sHi)
+		lo[i].Mul(&lo[i], sLo).Add(&lo[i], &t).Mod(&lo[i], order)
+	}
+}
+
+// foldBases returns the vector sLo·lo[i] + sHi·hi[i]
+func foldBases(lo, hi []Digest, sLo, sHi *big.Int) []Digest {
+	res := make([]Digest, len(lo))
+	parallel.Execute(len(lo), func(start, end int) {
+		var t Digest
+		for i := start; i < end; i++ {
+			res[i].ScalarMultiplication(&lo[i], sLo)
+			t.ScalarMultiplication(&hi[i], sHi)
+			res[i].Add(&res[i], &t)
+		}
+	})
+	return res
+}
+
+// innerProduct returns ⟨a, b⟩ mod order
+func innerProduct(a, b []big.Int, order *big.Int) *big.Int {
+	var res, t big.Int
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res.Mod(&res, order)
+}
+
+func copyScalars(s []big.Int) []big.Int {
+	res := make([]big.Int, len(s))
+	for i := range s {
+		res[i].Set(&s[i])
+	}
+	return res
+}
+
+func order() *big.Int {
+	params := twistededwards.GetEdwardsCurve()
+	return &params.Order
+}
+
+// challengeNames returns the names of the challenges of an inner-product
+// argument with nbRounds rounds.
+func challengeNames(nbRounds int) []string {
+	res := make([]string, nbRounds)
+	for j := range res {
+		res[j] = "x" + strconv.Itoa(j)
+	}
+	return res
+}
+
+// newTranscript returns the transcript of an inner-product argument with
+// nbRounds rounds, with the first challenge bound to dataTranscript.
+func newTranscript(hf hash.Hash, nbRounds int, dataTranscript ...[]byte) (*fiatshamir.Transcript, error) {
+	fs := fiatshamir.NewTranscript(hf, challengeNames(nbRounds)...)
+	if nbRounds == 0 {
+		return fs, nil
+	}
+	return fs, bindAll(fs, "x0", dataTranscript...)
+}
+
+// openingChallengeNames returns the names of the challenges of an opening
+// proof with nbRounds rounds.
+func openingChallengeNames(nbRounds int) []string {
+	res := make([]string, 0, nbRounds+2)
+	res = append(res, "xi")
+	res = append(res, challengeNames(nbRounds)...)
+	return append(res, "c")
+}
+
+// deriveOpeningChallenge binds the opening statement and returns ξ.
+func deriveOpeningChallenge(fs *fiatshamir.Transcript, commitment *Digest, b []big.Int, v *big.Int, dataTranscript ...[]byte) (*big.Int, error) {
+	if err := fs.Bind("xi", commitment.Marshal()); err != nil {
+		return nil, err
+	}
+	for i := range b {
+		if err := fs.Bind("xi", b[i].Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	if err := fs.Bind("xi", v.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := bindAll(fs, "xi", dataTranscript...); err != nil {
+		return nil, err
+	}
+	return deriveChallenge(fs, "xi")
+}
+
+// deriveChallenge binds the points to the challenge named id, and returns it
+// reduced modulo the order of the prime subgroup.
+func deriveChallenge(fs *fiatshamir.Transcript, id string, points ...*Digest) (*big.Int, error) {
+	for _, p := range points {
+		if err := fs.Bind(id, p.Marshal()); err != nil {
+			return nil, err
+		}
+	}
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return nil, err
+	}
+	x := new(big.Int).SetBytes(b)
+	x.Mod(x, order())
+	if x.Sign() == 0 {
+		return nil, ErrZeroChallenge
+	}
+	return x, nil
+}
+
+func bindAll(fs *fiatshamir.Transcript, id string, data ...[]byte) error {
+	for i := range data {
+		if err := fs.Bind(id, data[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
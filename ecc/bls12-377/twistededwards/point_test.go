@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -709,10 +710,91 @@ func TestOps(t *testing.T) {
 		genS1,
 	))
 
+	properties.Property("constant-time scalar multiplication should match ScalarMultiplication", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var base PointAffine
+			base.Double(&params.Base)
+			var baseProj PointProj
+			baseProj.FromAffine(&base)
+
+			var negS big.Int
+			negS.Neg(&s)
+			for _, k := range []*big.Int{&s, &negS, big.NewInt(0), &params.Order} {
+				var p1, p2, p3 PointAffine
+				var pProj PointProj
+				p1.ScalarMultiplication(&base, k)
+				p2.ScalarMultiplicationCT(&base, k)
+				pProj.ScalarMultiplicationCT(&baseProj, k)
+				p3.FromProj(&pProj)
+				if !p1.Equal(&p2) || !p1.Equal(&p3) {
+					return false
+				}
+			}
+			return true
+		},
+		genS1,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
 
+func TestIsInSubGroup(t *testing.T) {
+	t.Parallel()
+	params := GetEdwardsCurve()
+
+	var p, q, torsion PointAffine
+	p.ScalarMultiplication(&params.Base, big.NewInt(42))
+	if !p.IsInSubGroup() {
+		t.Fatal("[42]Base should be in the prime subgroup")
+	}
+	q.setInfinity()
+	if !q.IsInSubGroup() {
+		t.Fatal("O should be in the prime subgroup")
+	}
+
+	// (0, -1) has order 2
+	torsion.X.SetZero()
+	torsion.Y.SetOne().Neg(&torsion.Y)
+	if !torsion.IsOnCurve() || torsion.IsInSubGroup() {
+		t.Fatal("(0, -1) should be on the curve and out of the prime subgroup")
+	}
+	q.Add(&p, &torsion)
+	if q.IsInSubGroup() {
+		t.Fatal("[42]Base + (0, -1) should be out of the prime subgroup")
+	}
+
+	q.Set(&p)
+	q.X.Double(&q.X)
+	if q.IsInSubGroup() {
+		t.Fatal("a point off the curve should be out of the prime subgroup")
+	}
+}
+
+func TestScalarMultiplicationCTTiming(t *testing.T) {
+	params := GetEdwardsCurve()
+
+	// class 0: s = 1, class 1: random s
+	const n = 1 << 11
+	var scalars [2][n]big.Int
+	for i := 0; i < n; i++ {
+		s, err := rand.Int(rand.Reader, &params.Order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scalars[1][i].Set(s)
+		scalars[0][i].SetUint64(1)
+	}
+	var base, p PointProj
+	base.FromAffine(&params.Base)
+	testutils.ConstantTime(t, n, func(c, i int) {
+		p.ScalarMultiplicationCT(&base, &scalars[c][i])
+	})
+}
+
 func TestMarshal(t *testing.T) {
 	t.Parallel()
 	initOnce.Do(initCurveParams)
@@ -826,6 +908,21 @@ func BenchmarkScalarMulProjective(b *testing.B) {
 	}
 }
 
+func BenchmarkScalarMulProjectiveCT(b *testing.B) {
+	params := GetEdwardsCurve()
+	var a PointProj
+	var s big.Int
+	a.FromAffine(&params.Base)
+	s.SetString("52435875175126190479447705081859658376581184513", 10)
+
+	var ct PointProj
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		ct.ScalarMultiplicationCT(&a, &s)
+	}
+}
+
 func BenchmarkNeg(b *testing.B) {
 	params := GetEdwardsCurve()
 	var s big.Int
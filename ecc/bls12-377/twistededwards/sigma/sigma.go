@@ -0,0 +1,389 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package sigma
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrNoStatement         = errors.New("no statement to prove")
+	ErrInvalidWitnessIndex = errors.New("witness index out of range")
+	ErrInvalidPoint        = errors.New("point is not in the prime subgroup")
+	ErrInvalidBranch       = errors.New("branch index out of range")
+	ErrInvalidWitness      = errors.New("witness doesn't satisfy the statement")
+	ErrProofShape          = errors.New("proof doesn't match the statement")
+	ErrVerifyProof         = errors.New("can't verify sigma proof")
+)
+
+// Term is the product x[Witness]·Base of a secret scalar of the witness
+// vector x and a public point.
+type Term struct {
+	Witness int
+	Base    twistededwards.PointAffine
+}
+
+// Equation states that Image = Σ Terms.
+type Equation struct {
+	Image twistededwards.PointAffine
+	Terms []Term
+}
+
+// Statement is a conjunction (AND) of linear equations over a vector of
+// NbWitnesses secret scalars, shared by all the equations.
+//
+// Scalars are integers modulo the order of the prime subgroup of the curve,
+// represented as big.Int in [0, order).
+type Statement struct {
+	NbWitnesses int
+	Equations   []Equation
+}
+
+// Proof is a non-interactive proof of knowledge of a witness of a statement,
+// or of one of the statements of a disjunction (OR). It holds one challenge
+// and one vector of responses per branch of the disjunction; the commitments
+// of the sigma protocol are recomputed by the verifier.
+type Proof struct {
+	Challenges []big.Int
+	Responses  [][]big.Int
+}
+
+// DLog returns the statement Y = x·G, whose proof is a Schnorr proof of
+// knowledge of the discrete logarithm x.
+func DLog(G, Y *twistededwards.PointAffine) Statement {
+	return Statement{
+		NbWitnesses: 1,
+		Equations: []Equation{
+			{Image: *Y, Terms: []Term{
+				{Witness: 0, Base: *G},
+			}},
+		},
+	}
+}
+
+// DLEQ returns the statement Y = x·G ∧ Z = x·H, whose proof is a
+// Chaum-Pedersen proof of equality of discrete logarithms.
+func DLEQ(G, Y, H, Z *twistededwards.PointAffine) Statement {
+	return Statement{
+		NbWitnesses: 1,
+		Equations: []Equation{
+			{Image: *Y, Terms: []Term{
+				{Witness: 0, Base: *G},
+			}},
+			{Image: *Z, Terms: []Term{
+				{Witness: 0, Base: *H},
+			}},
+		},
+	}
+}
+
+// Representation returns the statement Y = Σ xᵢ·bases[i], for instance the
+// knowledge of the opening of a Pedersen commitment.
+func Representation(bases []twistededwards.PointAffine, Y *twistededwards.PointAffine) Statement {
+	terms := make([]Term, len(bases))
+	for i := range bases {
+		terms[i] = Term{Witness: i, Base: bases[i]}
+	}
+	return Statement{
+		NbWitnesses: len(bases),
+		Equations: []Equation{
+			{Image: *Y, Terms: terms},
+		},
+	}
+}
+
+// And returns the conjunction of statements. The witnesses of the statements
+// are independent: the witness of the result is the concatenation of their
+// witnesses. Equations sharing witnesses are expressed directly with a
+// [Statement].
+func And(statements ...Statement) Statement {
+	var res Statement
+	for _, st := range statements {
+		for _, eq := range st.Equations {
+			terms := make([]Term, len(eq.Terms))
+			for i := range eq.Terms {
+				terms[i] = Term{Witness: eq.Terms[i].Witness + res.NbWitnesses, Base: eq.Terms[i].Base}
+			}
+			res.Equations = append(res.Equations, Equation{Image: eq.Image, Terms: terms})
+		}
+		res.NbWitnesses += st.NbWitnesses
+	}
+	return res
+}
+
+// IsSatisfied returns true if witness satisfies all the equations of st.
+func (st *Statement) IsSatisfied(witness []big.Int) bool {
+	if len(witness) != st.NbWitnesses || st.check() != nil {
+		return false
+	}
+	one := big.NewInt(1)
+	for i := range st.Equations {
+		// Σ xᵢ·Gᵢ - Y must be the identity
+		p := st.Equations[i].eval(witness, one)
+		if !p.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// RandomScalar returns a uniformly random integer modulo the order of the
+// prime subgroup.
+func RandomScalar() (*big.Int, error) {
+	return rand.Int(rand.Reader, order())
+}
+
+// Prove returns a proof of knowledge of witness for st.
+//
+// The Fiat-Shamir challenge binds the statement, the commitments of the sigma
+// protocol and dataTranscript, which can be used as a context or to bind the
+// proof to a message.
+func Prove(st *Statement, witness []big.Int, hf hash.Hash, dataTranscript ...[]byte) (Proof, error) {
+	return ProveOr([]Statement{*st}, 0, witness, hf, dataTranscript...)
+}
+
+// Verify checks a proof of knowledge of a witness for st.
+func Verify(st *Statement, proof *Proof, hf hash.Hash, dataTranscript ...[]byte) error {
+	return VerifyOr([]Statement{*st}, proof, hf, dataTranscript...)
+}
+
+// ProveOr returns a proof of knowledge of a witness for one of statements,
+// without revealing which one (Cramer-Damgård-Schoenmakers disjunction).
+// witness satisfies statements[index]. Together with the conjunctions of a
+// [Statement], this allows proving any AND/OR formula written in disjunctive
+// normal form.
+func ProveOr(statements []Statement, index int, witness []big.Int, hf hash.Hash, dataTranscript ...[]byte) (Proof, error) {
+	if len(statements) == 0 {
+		return Proof{}, ErrNoStatement
+	}
+	if index < 0 || index >= len(statements) {
+		return Proof{}, ErrInvalidBranch
+	}
+	for i := range statements {
+		if err := statements[i].check(); err != nil {
+			return Proof{}, err
+		}
+	}
+	if !statements[index].IsSatisfied(witness) {
+		return Proof{}, ErrInvalidWitness
+	}
+
+	proof := Proof{
+		Challenges: make([]big.Int, len(statements)),
+		Responses:  make([][]big.Int, len(statements)),
+	}
+	commitments := make([][]twistededwards.PointAffine, len(statements))
+
+	// the other branches are simulated with random challenges and responses
+	for k := range statements {
+		proof.Responses[k] = make([]big.Int, statements[k].NbWitnesses)
+		if k == index {
+			continue
+		}
+		if err := randomScalars(proof.Challenges[k : k+1]); err != nil {
+			return Proof{}, err
+		}
+		if err := randomScalars(proof.Responses[k]); err != nil {
+			return Proof{}, err
+		}
+		commitments[k] = statements[k].commitments(proof.Responses[k], &proof.Challenges[k])
+	}
+
+	// commitments of the real branch: Aⱼ = Σ rᵢ·Gⱼᵢ
+	nonces := make([]big.Int, statements[index].NbWitnesses)
+	if err := randomScalars(nonces); err != nil {
+		return Proof{}, err
+	}
+	commitments[index] = statements[index].commitments(nonces, new(big.Int))
+
+	c, err := deriveChallenge(statements, commitments, hf, dataTranscript...)
+	if err != nil {
+		return Proof{}, err
+	}
+
+	// c_index = c - Σ_{k≠index} c_k, and zᵢ = rᵢ + c_index·xᵢ
+	r := order()
+	for k := range proof.Challenges {
+		if k != index {
+			c.Sub(c, &proof.Challenges[k])
+		}
+	}
+	proof.Challenges[index].Mod(c, r)
+	for i := range nonces {
+		proof.Responses[index][i].Mul(&proof.Challenges[index], &witness[i]).
+			Add(&proof.Responses[index][i], &nonces[i]).
+			Mod(&proof.Responses[index][i], r)
+	}
+
+	return proof, nil
+}
+
+// VerifyOr checks a proof of knowledge of a witness for one of statements.
+func VerifyOr(statements []Statement, proof *Proof, hf hash.Hash, dataTranscript ...[]byte) error {
+	if len(statements) == 0 {
+		return ErrNoStatement
+	}
+	if len(proof.Challenges) != len(statements) || len(proof.Responses) != len(statements) {
+		return ErrProofShape
+	}
+	commitments := make([][]twistededwards.PointAffine, len(statements))
+	for k := range statements {
+		if err := statements[k].check(); err != nil {
+			return err
+		}
+		if len(proof.Responses[k]) != statements[k].NbWitnesses {
+			return ErrProofShape
+		}
+		// Aⱼ = Σ zᵢ·Gⱼᵢ - c·Yⱼ
+		commitments[k] = statements[k].commitments(proof.Responses[k], &proof.Challenges[k])
+	}
+
+	c, err := deriveChallenge(statements, commitments, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	for k := range proof.Challenges {
+		c.Sub(c, &proof.Challenges[k])
+	}
+	if c.Mod(c, order()).Sign() != 0 {
+		return ErrVerifyProof
+	}
+	return nil
+}
+
+// check returns an error if a term of st refers to a witness out of range,
+// or if a point of st is not in the prime subgroup. A small order component in
+// a point would let a prover without a witness pass the verification with a
+// non-negligible probability.
+func (st *Statement) check() error {
+	if len(st.Equations) == 0 {
+		return ErrNoStatement
+	}
+	for i := range st.Equations {
+		if !isInSubGroup(&st.Equations[i].Image) {
+			return ErrInvalidPoint
+		}
+		for _, t := range st.Equations[i].Terms {
+			if t.Witness < 0 || t.Witness >= st.NbWitnesses {
+				return ErrInvalidWitnessIndex
+			}
+			if !isInSubGroup(&t.Base) {
+				return ErrInvalidPoint
+			}
+		}
+	}
+	return nil
+}
+
+// isInSubGroup returns true if p is on the curve and in the prime subgroup.
+func isInSubGroup(p *twistededwards.PointAffine) bool {
+	return p.IsInSubGroup()
+}
+
+// commitments returns the points Σ zᵢ·Gⱼᵢ - c·Yⱼ of all the equations of st.
+func (st *Statement) commitments(z []big.Int, c *big.Int) []twistededwards.PointAffine {
+	res := make([]twistededwards.PointAffine, len(st.Equations))
+	for j := range st.Equations {
+		res[j] = st.Equations[j].eval(z, c)
+	}
+	return res
+}
+
+// eval returns Σ z[tᵢ.Witness]·tᵢ.Base - c·Image.
+func (eq *Equation) eval(z []big.Int, c *big.Int) twistededwards.PointAffine {
+	var acc, p twistededwards.PointExtended
+	var res twistededwards.PointAffine
+	res.Y.SetOne()
+	acc.FromAffine(&res)
+	for _, t := range eq.Terms {
+		p.FromAffine(&t.Base)
+		acc.Add(&acc, p.ScalarMultiplication(&p, &z[t.Witness]))
+	}
+	var minusC big.Int
+	minusC.Neg(c).Mod(&minusC, order())
+	p.FromAffine(&eq.Image)
+	acc.Add(&acc, p.ScalarMultiplication(&p, &minusC))
+	res.FromExtended(&acc)
+	return res
+}
+
+// deriveChallenge returns the Fiat-Shamir challenge of the disjunction of
+// statements with the given commitments.
+func deriveChallenge(statements []Statement, commitments [][]twistededwards.PointAffine, hf hash.Hash, dataTranscript ...[]byte) (*big.Int, error) {
+	fs := fiatshamir.NewTranscript(hf, "c")
+	for i := range dataTranscript {
+		if err := fs.Bind("c", dataTranscript[i]); err != nil {
+			return nil, err
+		}
+	}
+	for k := range statements {
+		if err := fs.Bind("c", statements[k].bytes()); err != nil {
+			return nil, err
+		}
+		for j := range commitments[k] {
+			if err := fs.Bind("c", commitments[k][j].Marshal()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b, err := fs.ComputeChallenge("c")
+	if err != nil {
+		return nil, err
+	}
+	c := new(big.Int).SetBytes(b)
+	return c.Mod(c, order()), nil
+}
+
+// bytes returns an unambiguous encoding of st, bound by the transcript.
+func (st *Statement) bytes() []byte {
+	res := binary.BigEndian.AppendUint32(nil, uint32(st.NbWitnesses))
+	res = binary.BigEndian.AppendUint32(res, uint32(len(st.Equations)))
+	for i := range st.Equations {
+		res = append(res, st.Equations[i].Image.Marshal()...)
+		res = binary.BigEndian.AppendUint32(res, uint32(len(st.Equations[i].Terms)))
+		for _, t := range st.Equations[i].Terms {
+			res = binary.BigEndian.AppendUint32(res, uint32(t.Witness))
+			res = append(res, t.Base.Marshal()...)
+		}
+	}
+	return res
+}
+
+// randomScalars sets the scalars to uniformly random integers modulo the
+// order of the prime subgroup.
+func randomScalars(scalars []big.Int) error {
+	for i := range scalars {
+		s, err := RandomScalar()
+		if err != nil {
+			return err
+		}
+		scalars[i].Set(s)
+	}
+	return nil
+}
+
+func order() *big.Int {
+	params := twistededwards.GetEdwardsCurve()
+	return &params.Order
+}
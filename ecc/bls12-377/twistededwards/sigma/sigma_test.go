@@ -0,0 +1,269 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package sigma
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func randomScalar() big.Int {
+	x, err := RandomScalar()
+	if err != nil {
+		panic(err)
+	}
+	return *x
+}
+
+// scale returns x·p
+func scale(p *twistededwards.PointAffine, x big.Int) twistededwards.PointAffine {
+	var res twistededwards.PointAffine
+	res.ScalarMultiplication(p, &x)
+	return res
+}
+
+// randomPoint returns a point whose discrete logarithm is unknown to the tests
+func randomPoint() twistededwards.PointAffine {
+	params := twistededwards.GetEdwardsCurve()
+	return scale(&params.Base, randomScalar())
+}
+
+func TestDLog(t *testing.T) {
+	assert := require.New(t)
+
+	G := randomPoint()
+	x := randomScalar()
+	Y := scale(&G, x)
+	st := DLog(&G, &Y)
+	assert.True(st.IsSatisfied([]big.Int{x}))
+
+	proof, err := Prove(&st, []big.Int{x}, sha256.New(), []byte("context"))
+	assert.NoError(err)
+	assert.NoError(Verify(&st, &proof, sha256.New(), []byte("context")))
+
+	// wrong context
+	assert.ErrorIs(Verify(&st, &proof, sha256.New(), []byte("other context")), ErrVerifyProof)
+
+	// wrong statement
+	other := DLog(&G, &G)
+	assert.ErrorIs(Verify(&other, &proof, sha256.New(), []byte("context")), ErrVerifyProof)
+
+	// tampered proof
+	proof.Responses[0][0].Add(&proof.Responses[0][0], big.NewInt(1))
+	assert.ErrorIs(Verify(&st, &proof, sha256.New(), []byte("context")), ErrVerifyProof)
+
+	// wrong witness
+	_, err = Prove(&st, []big.Int{randomScalar()}, sha256.New())
+	assert.ErrorIs(err, ErrInvalidWitness)
+}
+
+func TestDLEQ(t *testing.T) {
+	assert := require.New(t)
+
+	G, H := randomPoint(), randomPoint()
+	x := randomScalar()
+	Y, Z := scale(&G, x), scale(&H, x)
+	st := DLEQ(&G, &Y, &H, &Z)
+
+	proof, err := Prove(&st, []big.Int{x}, sha256.New())
+	assert.NoError(err)
+	assert.NoError(Verify(&st, &proof, sha256.New()))
+
+	// different discrete logarithms
+	Z = scale(&H, randomScalar())
+	st = DLEQ(&G, &Y, &H, &Z)
+	assert.ErrorIs(Verify(&st, &proof, sha256.New()), ErrVerifyProof)
+	_, err = Prove(&st, []big.Int{x}, sha256.New())
+	assert.ErrorIs(err, ErrInvalidWitness)
+}
+
+func TestAnd(t *testing.T) {
+	assert := require.New(t)
+
+	// knowledge of the opening (v, r) of C = v·G + r·H and of x with Y = x·G
+	G, H := randomPoint(), randomPoint()
+	v, r, x := randomScalar(), randomScalar(), randomScalar()
+	C, t1 := scale(&G, v), scale(&H, r)
+	C.Add(&C, &t1)
+	Y := scale(&G, x)
+	opening := Representation([]twistededwards.PointAffine{G, H}, &C)
+	dlog := DLog(&G, &Y)
+	st := And(opening, dlog)
+	assert.Equal(3, st.NbWitnesses)
+
+	witness := []big.Int{v, r, x}
+	proof, err := Prove(&st, witness, sha256.New())
+	assert.NoError(err)
+	assert.NoError(Verify(&st, &proof, sha256.New()))
+
+	// the same witness shared by two equations: C = v·G + r·H and Y = v·G
+	shared := opening
+	Y = scale(&G, v)
+	shared.Equations = append(shared.Equations, Equation{Image: Y, Terms: []Term{
+		{Witness: 0, Base: G},
+	}})
+	proof, err = Prove(&shared, []big.Int{v, r}, sha256.New())
+	assert.NoError(err)
+	assert.NoError(Verify(&shared, &proof, sha256.New()))
+
+	shared.Equations[1].Terms[0].Witness = 2
+	_, err = Prove(&shared, []big.Int{v, r}, sha256.New())
+	assert.ErrorIs(err, ErrInvalidWitnessIndex)
+}
+
+func TestOr(t *testing.T) {
+	assert := require.New(t)
+
+	// knowledge of the discrete logarithm of one of the Yₖ = xₖ·G
+	const nbBranches = 3
+	G := randomPoint()
+	statements := make([]Statement, nbBranches)
+	witnesses := make([]big.Int, nbBranches)
+	for k := range statements {
+		witnesses[k] = randomScalar()
+		Y := scale(&G, witnesses[k])
+		statements[k] = DLog(&G, &Y)
+	}
+
+	for index := range statements {
+		proof, err := ProveOr(statements, index, []big.Int{witnesses[index]}, sha256.New())
+		assert.NoError(err)
+		assert.NoError(VerifyOr(statements, &proof, sha256.New()))
+
+		// the challenges must sum to the Fiat-Shamir challenge
+		proof.Challenges[(index+1)%nbBranches].SetUint64(1)
+		assert.ErrorIs(VerifyOr(statements, &proof, sha256.New()), ErrVerifyProof)
+	}
+
+	// the witness must satisfy the statement of the chosen branch
+	_, err := ProveOr(statements, 0, []big.Int{witnesses[1]}, sha256.New())
+	assert.ErrorIs(err, ErrInvalidWitness)
+	_, err = ProveOr(statements, nbBranches, []big.Int{witnesses[0]}, sha256.New())
+	assert.ErrorIs(err, ErrInvalidBranch)
+
+	// proof of another disjunction
+	proof, err := ProveOr(statements[:2], 0, []big.Int{witnesses[0]}, sha256.New())
+	assert.NoError(err)
+	assert.ErrorIs(VerifyOr(statements, &proof, sha256.New()), ErrProofShape)
+}
+
+func TestInvalidPoints(t *testing.T) {
+	assert := require.New(t)
+
+	G := randomPoint()
+	x := randomScalar()
+	Y := scale(&G, x)
+	valid := DLog(&G, &Y)
+	proof, err := Prove(&valid, []big.Int{x}, sha256.New())
+	assert.NoError(err)
+
+	// a point off the curve
+	offCurve := Y
+	offCurve.Y.Double(&offCurve.Y)
+	assert.False(offCurve.IsOnCurve())
+	st := DLog(&G, &offCurve)
+	assert.ErrorIs(Verify(&st, &proof, sha256.New()), ErrInvalidPoint)
+
+	// the torsion-shifted statement Y + T = x·G + T, with T = (0, -1) of order
+	// 2, has no witness: without the subgroup check, the honest proof with x
+	// would pass half of the time
+	var T, shifted twistededwards.PointAffine
+	T.X.SetZero()
+	T.Y.SetOne().Neg(&T.Y)
+	assert.True(T.IsOnCurve())
+	shifted.Add(&Y, &T)
+	st = DLog(&G, &shifted)
+	_, err = Prove(&st, []big.Int{x}, sha256.New())
+	assert.ErrorIs(err, ErrInvalidPoint)
+	assert.ErrorIs(Verify(&st, &proof, sha256.New()), ErrInvalidPoint)
+	assert.ErrorIs(VerifyOr([]Statement{valid, st}, &Proof{
+		Challenges: []big.Int{proof.Challenges[0], proof.Challenges[0]},
+		Responses:  [][]big.Int{proof.Responses[0], proof.Responses[0]},
+	}, sha256.New()), ErrInvalidPoint)
+
+	// a base with a torsion component
+	var shiftedG twistededwards.PointAffine
+	shiftedG.Add(&G, &T)
+	st = DLog(&shiftedG, &Y)
+	assert.ErrorIs(Verify(&st, &proof, sha256.New()), ErrInvalidPoint)
+}
+
+func TestProofSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	G := randomPoint()
+	statements := make([]Statement, 2)
+	x := randomScalar()
+	for k := range statements {
+		Y := scale(&G, x)
+		statements[k] = And(DLog(&G, &Y), DLog(&G, &Y))
+	}
+	proof, err := ProveOr(statements, 1, []big.Int{x, x}, sha256.New())
+	assert.NoError(err)
+
+	t.Run("serialization", testutils.SerializationRoundTrip(&proof))
+
+	// non-canonical scalar
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	b := buf.Bytes()
+	for i := 8; i < 8+sizeScalar; i++ {
+		b[i] = 0xff
+	}
+	var decoded Proof
+	_, err = decoded.ReadFrom(bytes.NewReader(b))
+	assert.Error(err)
+
+	// truncated proof
+	_, err = decoded.ReadFrom(bytes.NewReader(b[:len(b)-1]))
+	assert.Error(err)
+}
+
+func BenchmarkProve(b *testing.B) {
+	G := randomPoint()
+	x := randomScalar()
+	Y := scale(&G, x)
+	st := DLog(&G, &Y)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Prove(&st, []big.Int{x}, sha256.New())
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	G := randomPoint()
+	x := randomScalar()
+	Y := scale(&G, x)
+	st := DLog(&G, &Y)
+	proof, err := Prove(&st, []big.Int{x}, sha256.New())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(&st, &proof, sha256.New())
+	}
+}
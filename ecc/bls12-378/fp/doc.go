@@ -47,6 +47,14 @@
 //	q[base10] = 605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940417
 //	q[base16] = 0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a20000000001
 //
+// # Constant time
+//
+// AddCT, SubCT, DoubleCT, NegCT, MulCT, SquareCT, ExpCT and InverseCT are the constant time
+// versions of Add, Sub, Double, Neg, Mul, Square, Exp and Inverse, to use on secret values; with
+// Select, they don't branch on the value of their operands. SetBigIntCT converts a secret big.Int
+// in [0, 2^Bits) without branching on it. The other methods are faster but not constant time:
+// Add, Mul, ... conditionally subtract the modulus.
+//
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
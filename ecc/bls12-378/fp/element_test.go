@@ -504,6 +504,141 @@ func TestElementInverseExp(t *testing.T) {
 
 }
 
+func TestElementExpCT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+
+	properties.Property("ExpCT(x, k) == Exp(x, k)", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			c.ExpCT(a.element, &b.bigint)
+			d.Exp(a.element, &b.bigint)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("ExpCT(x, -k) == Exp(x, -k)", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var nb big.Int
+			nb.Neg(&b.bigint)
+			var c, d Element
+			c.ExpCT(a.element, &nb)
+			d.Exp(a.element, &nb)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("ExpCT(x, k) == Exp(x, k) for k ⩾ 2^384", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var k big.Int
+			k.Lsh(&b.bigint, 384).Add(&k, &b.bigint)
+			var c, d Element
+			c.ExpCT(a.element, &k)
+			d.Exp(a.element, &k)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementInverseCT(t *testing.T) {
+	invCTMatchInv := func(a testPairElement) bool {
+		var b Element
+		b.InverseCT(&a.element)
+		a.element.Inverse(&a.element)
+		return a.element.Equal(&b)
+	}
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("InverseCT == Inverse", prop.ForAll(invCTMatchInv, genA))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	parameters.MinSuccessfulTests = 1
+	properties = gopter.NewProperties(parameters)
+	properties.Property("InverseCT(0) == 0", prop.ForAll(invCTMatchInv, ggen.OneConstOf(testPairElement{})))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementCT(t *testing.T) {
+	// ctMatch checks that the constant time operations match the default ones
+	ctMatch := func(a, b *Element) bool {
+		var c, d Element
+		ok := c.Add(a, b).Equal(d.AddCT(a, b))
+		ok = ok && c.Sub(a, b).Equal(d.SubCT(a, b))
+		ok = ok && c.Double(a).Equal(d.DoubleCT(a))
+		ok = ok && c.Neg(a).Equal(d.NegCT(a))
+		ok = ok && c.Mul(a, b).Equal(d.MulCT(a, b))
+		ok = ok && c.Square(a).Equal(d.SquareCT(a))
+		return ok
+	}
+
+	t.Parallel()
+	for i := range staticTestValues {
+		for j := range staticTestValues {
+			if !ctMatch(&staticTestValues[i], &staticTestValues[j]) {
+				t.Fatalf("CT operations don't match on %s and %s", staticTestValues[i].String(), staticTestValues[j].String())
+			}
+		}
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("CT operations == default operations", prop.ForAll(
+		func(a, b testPairElement) bool {
+			return ctMatch(&a.element, &b.element)
+		},
+		genA, genA,
+	))
+
+	properties.Property("SetBigIntCT(x) == SetBigInt(x)", prop.ForAll(
+		func(a testPairElement) bool {
+			var c, d Element
+			ok := c.SetBigInt(&a.bigint).Equal(d.SetBigIntCT(&a.bigint))
+
+			// x + q < 2^Bits must be reduced
+			var v big.Int
+			v.Add(&a.bigint, Modulus())
+			if v.BitLen() <= Bits {
+				ok = ok && c.Equal(d.SetBigIntCT(&v))
+			}
+
+			// negative and larger values go through big.Int.Mod
+			v.Neg(&a.bigint)
+			ok = ok && c.SetBigInt(&v).Equal(d.SetBigIntCT(&v))
+			v.Lsh(&a.bigint, Bits+1)
+			ok = ok && c.SetBigInt(&v).Equal(d.SetBigIntCT(&v))
+			return ok
+		},
+		genA,
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func mulByConstant(z *Element, c uint8) {
 	var y Element
 	y.SetUint64(uint64(c))
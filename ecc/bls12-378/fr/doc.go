@@ -47,6 +47,14 @@
 //	q[base10] = 14883435066912132899950318861128167269793560281114003360875131245101026639873
 //	q[base16] = 0x20e7b9c8ef7b2eb187787fb4e3dbb0ffeae77f3da09400013291440000000001
 //
+// # Constant time
+//
+// AddCT, SubCT, DoubleCT, NegCT, MulCT, SquareCT, ExpCT and InverseCT are the constant time
+// versions of Add, Sub, Double, Neg, Mul, Square, Exp and Inverse, to use on secret values; with
+// Select, they don't branch on the value of their operands. SetBigIntCT converts a secret big.Int
+// in [0, 2^Bits) without branching on it. The other methods are faster but not constant time:
+// Add, Mul, ... conditionally subtract the modulus.
+//
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
@@ -799,6 +799,385 @@ func (z *Element) Exp(x Element, k *big.Int) *Element {
 	return z
 }
 
+// ExpCT z = xᵏ (mod q), in constant time: the sequence of operations and memory
+// accesses doesn't depend on x, nor on k beyond its number of words when it
+// doesn't fit on 4 words. If k < 0, x is inverted with InverseCT and
+// the sign of k leaks.
+func (z *Element) ExpCT(x Element, k *big.Int) *Element {
+	// |k| on 64-bit words, padded to 4 words
+	kBits := k.Bits()
+	n := (len(kBits)*bits.UintSize + 63) / 64
+	if n < 4 {
+		n = 4
+	}
+	e := make([]uint64, n)
+	for i, w := range kBits {
+		e[i*bits.UintSize/64] |= uint64(w) << (uint(i*bits.UintSize) % 64)
+	}
+
+	if k.Sign() == -1 {
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.InverseCT(&x)
+	}
+	return z.expCT(&x, e)
+}
+
+// InverseCT z = x⁻¹ (mod q) = x^(q-2) (mod q), in constant time.
+// It is much slower than Inverse, which must not be used on secret values.
+//
+// if x == 0, sets and returns z = x
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.expCT(x, qMinusTwo[:])
+}
+
+// qMinusTwo is q-2, the exponent of InverseCT
+var qMinusTwo = [4]uint64{
+	3643768340310130687,
+	16926637627159085057,
+	9761692607219216639,
+	2371068001496280753,
+}
+
+// expCT sets z = xᵉ (mod q), e being given on 64-bit words (little endian).
+// It uses fixed windows of 4 bits over all the words of e, and each lookup in the
+// table of the powers of x reads all its entries.
+func (z *Element) expCT(x *Element, e []uint64) *Element {
+	// table[i] = xⁱ
+	var table [16]Element
+	table[0].SetOne()
+	table[1].Set(x)
+	for i := 2; i < 16; i++ {
+		table[i].MulCT(&table[i-1], x)
+	}
+
+	var res, t Element
+	res.SetOne()
+	for i := len(e)*64 - 4; i >= 0; i -= 4 {
+		res.SquareCT(&res).
+			SquareCT(&res).
+			SquareCT(&res).
+			SquareCT(&res)
+
+		w := (e[i/64] >> (uint(i) % 64)) & 15
+		t.Set(&table[0])
+		for j := 1; j < 16; j++ {
+			// w ^ j = 0 iff w = j
+			t.Select(int(w^uint64(j)), &table[j], &t)
+		}
+		res.MulCT(&res, &t)
+	}
+
+	return z.Set(&res)
+}
+
+// AddCT z = x + y (mod q), in constant time: unlike Add, it doesn't branch on
+// the values of x and y.
+func (z *Element) AddCT(x, y *Element) *Element {
+
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], _ = bits.Add64(x[3], y[3], carry)
+
+	// if z ⩾ q → z -= q, without branching on z
+	{
+		var b uint64
+		var t Element
+		t[0], b = bits.Sub64(z[0], q0, 0)
+		t[1], b = bits.Sub64(z[1], q1, b)
+		t[2], b = bits.Sub64(z[2], q2, b)
+		t[3], b = bits.Sub64(z[3], q3, b)
+		// the last subtraction borrows iff z < q
+		m := b - 1
+		z[0] ^= m & (z[0] ^ t[0])
+		z[1] ^= m & (z[1] ^ t[1])
+		z[2] ^= m & (z[2] ^ t[2])
+		z[3] ^= m & (z[3] ^ t[3])
+	}
+	return z
+}
+
+// DoubleCT z = x + x (mod q), in constant time.
+func (z *Element) DoubleCT(x *Element) *Element {
+
+	var carry uint64
+	z[0], carry = bits.Add64(x[0], x[0], 0)
+	z[1], carry = bits.Add64(x[1], x[1], carry)
+	z[2], carry = bits.Add64(x[2], x[2], carry)
+	z[3], _ = bits.Add64(x[3], x[3], carry)
+
+	// if z ⩾ q → z -= q, without branching on z
+	{
+		var b uint64
+		var t Element
+		t[0], b = bits.Sub64(z[0], q0, 0)
+		t[1], b = bits.Sub64(z[1], q1, b)
+		t[2], b = bits.Sub64(z[2], q2, b)
+		t[3], b = bits.Sub64(z[3], q3, b)
+		// the last subtraction borrows iff z < q
+		m := b - 1
+		z[0] ^= m & (z[0] ^ t[0])
+		z[1] ^= m & (z[1] ^ t[1])
+		z[2] ^= m & (z[2] ^ t[2])
+		z[3] ^= m & (z[3] ^ t[3])
+	}
+	return z
+}
+
+// SubCT z = x - y (mod q), in constant time.
+func (z *Element) SubCT(x, y *Element) *Element {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+
+	// if we underflowed, z += q, without branching on b
+	m := -b
+	var c uint64
+	z[0], c = bits.Add64(z[0], q0&m, 0)
+	z[1], c = bits.Add64(z[1], q1&m, c)
+	z[2], c = bits.Add64(z[2], q2&m, c)
+	z[3], _ = bits.Add64(z[3], q3&m, c)
+	return z
+}
+
+// NegCT z = q - x, in constant time.
+func (z *Element) NegCT(x *Element) *Element {
+	// m = 0 if x = 0, all ones otherwise
+	nz := x[0] | x[1] | x[2] | x[3]
+	m := uint64(int64(nz|-nz) >> 63)
+	var borrow uint64
+	z[0], borrow = bits.Sub64(q0, x[0], 0)
+	z[1], borrow = bits.Sub64(q1, x[1], borrow)
+	z[2], borrow = bits.Sub64(q2, x[2], borrow)
+	z[3], _ = bits.Sub64(q3, x[3], borrow)
+	z[0] &= m
+	z[1] &= m
+	z[2] &= m
+	z[3] &= m
+	return z
+}
+
+// MulCT z = x * y (mod q), in constant time: unlike Mul, it never uses the
+// assembly implementation and doesn't branch on the final reduction.
+//
+// x and y must be less than q
+func (z *Element) MulCT(x, y *Element) *Element {
+	// see Mul for algorithm documentation
+
+	var t0, t1, t2, t3 uint64
+	var u0, u1, u2, u3 uint64
+	{
+		var c0, c1, c2 uint64
+		v := x[0]
+		u0, t0 = bits.Mul64(v, y[0])
+		u1, t1 = bits.Mul64(v, y[1])
+		u2, t2 = bits.Mul64(v, y[2])
+		u3, t3 = bits.Mul64(v, y[3])
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		c2, _ = bits.Add64(u3, 0, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+
+		t2, c0 = bits.Add64(0, c1, c0)
+		u3, _ = bits.Add64(u3, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t2, c0 = bits.Add64(t3, t2, 0)
+		t3, _ = bits.Add64(u3, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[1]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		c2, _ = bits.Add64(u3, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+
+		t2, c0 = bits.Add64(0, c1, c0)
+		u3, _ = bits.Add64(u3, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t2, c0 = bits.Add64(t3, t2, 0)
+		t3, _ = bits.Add64(u3, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[2]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		c2, _ = bits.Add64(u3, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+
+		t2, c0 = bits.Add64(0, c1, c0)
+		u3, _ = bits.Add64(u3, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t2, c0 = bits.Add64(t3, t2, 0)
+		t3, _ = bits.Add64(u3, c2, c0)
+
+	}
+	{
+		var c0, c1, c2 uint64
+		v := x[3]
+		u0, c1 = bits.Mul64(v, y[0])
+		t0, c0 = bits.Add64(c1, t0, 0)
+		u1, c1 = bits.Mul64(v, y[1])
+		t1, c0 = bits.Add64(c1, t1, c0)
+		u2, c1 = bits.Mul64(v, y[2])
+		t2, c0 = bits.Add64(c1, t2, c0)
+		u3, c1 = bits.Mul64(v, y[3])
+		t3, c0 = bits.Add64(c1, t3, c0)
+
+		c2, _ = bits.Add64(0, 0, c0)
+		t1, c0 = bits.Add64(u0, t1, 0)
+		t2, c0 = bits.Add64(u1, t2, c0)
+		t3, c0 = bits.Add64(u2, t3, c0)
+		c2, _ = bits.Add64(u3, c2, c0)
+
+		m := qInvNeg * t0
+
+		u0, c1 = bits.Mul64(m, q0)
+		_, c0 = bits.Add64(t0, c1, 0)
+		u1, c1 = bits.Mul64(m, q1)
+		t0, c0 = bits.Add64(t1, c1, c0)
+		u2, c1 = bits.Mul64(m, q2)
+		t1, c0 = bits.Add64(t2, c1, c0)
+		u3, c1 = bits.Mul64(m, q3)
+
+		t2, c0 = bits.Add64(0, c1, c0)
+		u3, _ = bits.Add64(u3, 0, c0)
+		t0, c0 = bits.Add64(u0, t0, 0)
+		t1, c0 = bits.Add64(u1, t1, c0)
+		t2, c0 = bits.Add64(u2, t2, c0)
+		c2, _ = bits.Add64(c2, 0, c0)
+		t2, c0 = bits.Add64(t3, t2, 0)
+		t3, _ = bits.Add64(u3, c2, c0)
+
+	}
+	z[0] = t0
+	z[1] = t1
+	z[2] = t2
+	z[3] = t3
+
+	// if z ⩾ q → z -= q, without branching on z
+	{
+		var b uint64
+		var t Element
+		t[0], b = bits.Sub64(z[0], q0, 0)
+		t[1], b = bits.Sub64(z[1], q1, b)
+		t[2], b = bits.Sub64(z[2], q2, b)
+		t[3], b = bits.Sub64(z[3], q3, b)
+		// the last subtraction borrows iff z < q
+		m := b - 1
+		z[0] ^= m & (z[0] ^ t[0])
+		z[1] ^= m & (z[1] ^ t[1])
+		z[2] ^= m & (z[2] ^ t[2])
+		z[3] ^= m & (z[3] ^ t[3])
+	}
+	return z
+}
+
+// SquareCT z = x * x (mod q), in constant time.
+//
+// x must be less than q
+func (z *Element) SquareCT(x *Element) *Element {
+	return z.MulCT(x, x)
+}
+
+// SetBigIntCT sets z to v (mod q) and returns z. Unlike SetBigInt, it doesn't
+// branch on v if 0 ⩽ v < 2^Bits: v < 2q is reduced with a conditional
+// subtraction and converted to Montgomery form with MulCT. A negative or larger
+// v is first reduced with big.Int.Mod, which is not constant time.
+func (z *Element) SetBigIntCT(v *big.Int) *Element {
+	if v.Sign() < 0 || v.BitLen() > Bits {
+		v = new(big.Int).Mod(v, &_modulus)
+	}
+	var buf [Bytes]byte
+	v.FillBytes(buf[:])
+	z[0] = binary.BigEndian.Uint64(buf[24:])
+	z[1] = binary.BigEndian.Uint64(buf[16:])
+	z[2] = binary.BigEndian.Uint64(buf[8:])
+	z[3] = binary.BigEndian.Uint64(buf[0:])
+
+	// if z ⩾ q → z -= q, without branching on z
+	{
+		var b uint64
+		var t Element
+		t[0], b = bits.Sub64(z[0], q0, 0)
+		t[1], b = bits.Sub64(z[1], q1, b)
+		t[2], b = bits.Sub64(z[2], q2, b)
+		t[3], b = bits.Sub64(z[3], q3, b)
+		// the last subtraction borrows iff z < q
+		m := b - 1
+		z[0] ^= m & (z[0] ^ t[0])
+		z[1] ^= m & (z[1] ^ t[1])
+		z[2] ^= m & (z[2] ^ t[2])
+		z[3] ^= m & (z[3] ^ t[3])
+	}
+	return z.MulCT(z, &rSquare)
+}
+
 // rSquare where r is the Montgommery constant
 // see section 2.3.2 of Tolga Acar's thesis
 // https://www.microsoft.com/en-us/research/wp-content/uploads/1998/06/97Acar.pdf
@@ -0,0 +1,457 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// Mixed radix domains have a cardinality n which is not a power of 2, but a
+// divisor of r-1 made of small primes (e.g. n = 3·2ᵏ). The FFT is a Cooley-Tukey
+// FFT where n = p₀·p₁·…·pₖ₋₁, and the radices pᵢ are chosen such that the
+// sequence is a palindrome. The "bit reversed" order of a mixed radix domain is
+// the digit reversal in the mixed base (p₀, …, pₖ₋₁); since the radices form a
+// palindrome, it is an involution, like the bit-reversal permutation.
+
+// maxSmoothPrime is the largest prime factor allowed in the cardinality of
+// a mixed radix domain.
+const maxSmoothPrime = 256
+
+// parallelMixedRadixThreshold is the size of a stage above which the mixed radix
+// butterflies are parallelized.
+const parallelMixedRadixThreshold = 1 << 10
+
+var errNoSmoothCardinality = errors.New("no subgroup of 𝔽ᵣˣ with a smooth order large enough")
+
+var (
+	smoothOrderOnce    sync.Once
+	smoothOrderFactors [][2]uint64 // (p, e) such that pᵉ divides r-1, p < maxSmoothPrime
+
+	radicesCache sync.Map // map[uint64][]uint64
+)
+
+// initSmoothOrder computes the factorization of the smooth part of r-1.
+func initSmoothOrder() {
+	rMinusOne := fr.Modulus()
+	rMinusOne.Sub(rMinusOne, big.NewInt(1))
+
+	var q, m, bp big.Int
+	for p := uint64(2); p < maxSmoothPrime; p++ {
+		if len(factorize(p)) != 1 {
+			continue
+		}
+		bp.SetUint64(p)
+		e := uint64(0)
+		for {
+			q.QuoRem(rMinusOne, &bp, &m)
+			if m.Sign() != 0 {
+				break
+			}
+			rMinusOne.Set(&q)
+			e++
+		}
+		if e != 0 {
+			smoothOrderFactors = append(smoothOrderFactors, [2]uint64{p, e})
+		}
+	}
+}
+
+// smoothCardinality returns the smallest divisor of r-1 greater or equal to m
+// whose prime factors are all smaller than maxSmoothPrime.
+func smoothCardinality(m uint64) (uint64, error) {
+	smoothOrderOnce.Do(initSmoothOrder)
+	if m <= 1 {
+		return 1, nil
+	}
+
+	best := uint64(0)
+	var walk func(i int, acc uint64)
+	walk = func(i int, acc uint64) {
+		if acc >= m {
+			if best == 0 || acc < best {
+				best = acc
+			}
+			return
+		}
+		if i == len(smoothOrderFactors) {
+			return
+		}
+		p, e := smoothOrderFactors[i][0], smoothOrderFactors[i][1]
+		for j := uint64(0); j <= e; j++ {
+			walk(i+1, acc)
+			if acc > math.MaxUint64/p {
+				return
+			}
+			acc *= p
+		}
+	}
+	walk(0, 1)
+
+	if best == 0 {
+		return 0, errNoSmoothCardinality
+	}
+	return best, nil
+}
+
+// mixedRadixGenerator returns an element of order n, n dividing r-1.
+func mixedRadixGenerator(n uint64) (fr.Element, error) {
+	rMinusOne := fr.Modulus()
+	rMinusOne.Sub(rMinusOne, big.NewInt(1))
+
+	var res fr.Element
+	res.SetOne()
+
+	var m big.Int
+	for _, f := range groupFactors(factorize(n)) {
+		pe := uint64(1)
+		for i := uint64(0); i < f[1]; i++ {
+			pe *= f[0]
+		}
+		var expo big.Int
+		expo.QuoRem(rMinusOne, new(big.Int).SetUint64(pe), &m)
+		if m.Sign() != 0 {
+			return fr.Element{}, errNoSmoothCardinality
+		}
+
+		// find g = xᵉˣᵖᵒ of order exactly pᵉ, that is such that g^(pᵉ⁻¹) ≠ 1
+		var x, g, t fr.Element
+		for c := uint64(2); ; c++ {
+			x.SetUint64(c)
+			g.Exp(x, &expo)
+			t.Exp(g, new(big.Int).SetUint64(pe/f[0]))
+			if !t.IsOne() {
+				break
+			}
+		}
+		res.Mul(&res, &g)
+	}
+
+	return res, nil
+}
+
+// factorize returns the prime factors of n, with multiplicity, in increasing order.
+func factorize(n uint64) []uint64 {
+	var res []uint64
+	for p := uint64(2); p*p <= n; p++ {
+		for n%p == 0 {
+			res = append(res, p)
+			n /= p
+		}
+	}
+	if n > 1 {
+		res = append(res, n)
+	}
+	return res
+}
+
+// groupFactors groups the sorted prime factors in pairs (p, e).
+func groupFactors(factors []uint64) [][2]uint64 {
+	var res [][2]uint64
+	for _, p := range factors {
+		if len(res) != 0 && res[len(res)-1][0] == p {
+			res[len(res)-1][1]++
+		} else {
+			res = append(res, [2]uint64{p, 1})
+		}
+	}
+	return res
+}
+
+// radices returns the radices used by the FFT on a domain of cardinality n.
+// The radices form a palindrome: each prime pᵉ contributes ⌊e/2⌋ times p on
+// each side, and the primes with an odd exponent are multiplied together in
+// the middle.
+func radices(n uint64) []uint64 {
+	if r, ok := radicesCache.Load(n); ok {
+		return r.([]uint64)
+	}
+
+	var left []uint64
+	middle := uint64(1)
+	for _, f := range groupFactors(factorize(n)) {
+		for i := uint64(0); i < f[1]/2; i++ {
+			left = append(left, f[0])
+		}
+		if f[1]%2 == 1 {
+			middle *= f[0]
+		}
+	}
+	res := make([]uint64, 0, 2*len(left)+1)
+	res = append(res, left...)
+	if middle != 1 {
+		res = append(res, middle)
+	}
+	for i := len(left) - 1; i >= 0; i-- {
+		res = append(res, left[i])
+	}
+
+	radicesCache.Store(n, res)
+	return res
+}
+
+// digitReverse returns the index of i after the digit reversal permutation in
+// the mixed base given by radices.
+func digitReverse(i uint64, radices []uint64) uint64 {
+	var res uint64
+	for _, p := range radices {
+		res = res*p + i%p
+		i /= p
+	}
+	return res
+}
+
+// BitReverseIndex returns the index of i after applying BitReverse on a vector
+// of size n. If n is a power of 2, it is the bit-reversal permutation, otherwise
+// it is the digit reversal used by the mixed radix domains.
+func BitReverseIndex(i, n uint64) uint64 {
+	if n&(n-1) == 0 {
+		return bits.Reverse64(i) >> (64 - bits.TrailingZeros64(n))
+	}
+	return digitReverse(i, radices(n))
+}
+
+// digitReverseVector applies the digit reversal permutation to v.
+func digitReverseVector(v []fr.Element) {
+	r := radices(uint64(len(v)))
+	for i := uint64(0); i < uint64(len(v)); i++ {
+		iRev := digitReverse(i, r)
+		if iRev > i {
+			v[i], v[iRev] = v[iRev], v[i]
+		}
+	}
+}
+
+// fftMixedRadix computes the (inverse) discrete Fourier transform of a on a mixed
+// radix domain. It follows the same conventions as FFT and FFTInverse.
+func (domain *Domain) fftMixedRadix(a []fr.Element, decimation Decimation, inverse bool, opt fftConfig) {
+	if uint64(len(a)) != domain.Cardinality {
+		panic("len(a) must be equal to the cardinality of the domain")
+	}
+
+	var twiddles []fr.Element
+	if domain.withPrecompute {
+		twiddles = domain.twiddles[0]
+		if inverse {
+			twiddles = domain.twiddlesInv[0]
+		}
+	} else {
+		twiddles = make([]fr.Element, len(a))
+		if inverse {
+			BuildExpTable(domain.GeneratorInv, twiddles)
+		} else {
+			BuildExpTable(domain.Generator, twiddles)
+		}
+	}
+
+	// the (inverse) coset table, in the order of the input (resp. output)
+	var cosetTable []fr.Element
+	if opt.coset {
+		if domain.withPrecompute {
+			cosetTable = domain.cosetTable
+			if inverse {
+				cosetTable = domain.cosetTableInv
+			}
+		} else {
+			cosetTable = make([]fr.Element, len(a))
+			if inverse {
+				BuildExpTable(domain.FrMultiplicativeGenInv, cosetTable)
+			} else {
+				BuildExpTable(domain.FrMultiplicativeGen, cosetTable)
+			}
+		}
+	}
+	reversed := (decimation == DIT) != inverse
+	idx := func(i int) int {
+		if reversed {
+			return int(digitReverse(uint64(i), domain.radices))
+		}
+		return i
+	}
+
+	if !inverse && opt.coset {
+		parallel.Execute(len(a), func(start, end int) {
+			for i := start; i < end; i++ {
+				a[i].Mul(&a[i], &cosetTable[idx(i)])
+			}
+		}, opt.nbTasks)
+	}
+
+	switch decimation {
+	case DIF:
+		difFFTMixedRadix(a, domain.radices, twiddles, 1, opt.nbTasks)
+	case DIT:
+		ditFFTMixedRadix(a, domain.radices, twiddles, 1, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+
+	if !inverse {
+		return
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].Mul(&a[i], &domain.CardinalityInv)
+			if opt.coset {
+				a[i].Mul(&a[i], &cosetTable[idx(i)])
+			}
+		}
+	}, opt.nbTasks)
+}
+
+// difFFTMixedRadix computes the DFT of a, where the output is in digit reversed order.
+// twiddles contains the powers of the N-th root of unity ω, and the root of unity
+// of order len(a) is ω^stride.
+func difFFTMixedRadix(a []fr.Element, radices []uint64, twiddles []fr.Element, stride, nbTasks int) {
+	if len(radices) == 0 {
+		return
+	}
+	p := int(radices[0])
+	m := len(a) / p
+
+	if m < parallelMixedRadixThreshold || nbTasks == 1 {
+		difButterfliesMixedRadix(a, p, twiddles, stride, 0, m)
+		for k2 := 0; k2 < p; k2++ {
+			difFFTMixedRadix(a[k2*m:(k2+1)*m], radices[1:], twiddles, stride*p, 1)
+		}
+		return
+	}
+
+	parallel.Execute(m, func(start, end int) {
+		difButterfliesMixedRadix(a, p, twiddles, stride, start, end)
+	}, nbTasks)
+	blockTasks := max(nbTasks/p, 1)
+	parallel.Execute(p, func(start, end int) {
+		for k2 := start; k2 < end; k2++ {
+			difFFTMixedRadix(a[k2*m:(k2+1)*m], radices[1:], twiddles, stride*p, blockTasks)
+		}
+	}, nbTasks)
+}
+
+// difButterfliesMixedRadix computes, for j₁ in [start, end),
+// yₖ₂[j₁] = ωₙʲ¹ᵏ² Σⱼ₂ x[j₁+m·j₂]ωₚʲ²ᵏ² and stores it at index k₂·m+j₁,
+// where m = len(a)/p. Then Xₚₖ₁₊ₖ₂ = DFTₘ(yₖ₂)ₖ₁.
+func difButterfliesMixedRadix(a []fr.Element, p int, twiddles []fr.Element, stride, start, end int) {
+	n := len(twiddles)
+	m := len(a) / p
+
+	if p == 2 {
+		for j1 := start; j1 < end; j1++ {
+			fr.Butterfly(&a[j1], &a[j1+m])
+			if j1 != 0 {
+				a[j1+m].Mul(&a[j1+m], &twiddles[stride*j1])
+			}
+		}
+		return
+	}
+
+	wp := stride * m // ωₚ = ωʷᵖ
+	var buf [16]fr.Element
+	x := buf[:]
+	if p > len(buf) {
+		x = make([]fr.Element, p)
+	}
+	var t fr.Element
+	for j1 := start; j1 < end; j1++ {
+		for j2 := 0; j2 < p; j2++ {
+			x[j2] = a[j1+m*j2]
+		}
+		for k2 := 0; k2 < p; k2++ {
+			acc := x[0]
+			for j2 := 1; j2 < p; j2++ {
+				t.Mul(&x[j2], &twiddles[(wp*j2*k2)%n])
+				acc.Add(&acc, &t)
+			}
+			a[k2*m+j1].Mul(&acc, &twiddles[(stride*j1*k2)%n])
+		}
+	}
+}
+
+// ditFFTMixedRadix computes the DFT of a, where the input is in digit reversed order.
+// twiddles contains the powers of the N-th root of unity ω, and the root of unity
+// of order len(a) is ω^stride.
+func ditFFTMixedRadix(a []fr.Element, radices []uint64, twiddles []fr.Element, stride, nbTasks int) {
+	if len(radices) == 0 {
+		return
+	}
+	p := int(radices[0])
+	m := len(a) / p
+
+	if m < parallelMixedRadixThreshold || nbTasks == 1 {
+		for j2 := 0; j2 < p; j2++ {
+			ditFFTMixedRadix(a[j2*m:(j2+1)*m], radices[1:], twiddles, stride*p, 1)
+		}
+		ditButterfliesMixedRadix(a, p, twiddles, stride, 0, m)
+		return
+	}
+
+	blockTasks := max(nbTasks/p, 1)
+	parallel.Execute(p, func(start, end int) {
+		for j2 := start; j2 < end; j2++ {
+			ditFFTMixedRadix(a[j2*m:(j2+1)*m], radices[1:], twiddles, stride*p, blockTasks)
+		}
+	}, nbTasks)
+	parallel.Execute(m, func(start, end int) {
+		ditButterfliesMixedRadix(a, p, twiddles, stride, start, end)
+	}, nbTasks)
+}
+
+// ditButterfliesMixedRadix computes, for k₁ in [start, end),
+// Xₖ₁₊ₘₖ₂ = Σⱼ₂ ωₚʲ²ᵏ² ωₙʲ²ᵏ¹ Yⱼ₂[k₁], where m = len(a)/p and Yⱼ₂ = DFTₘ(x[p·j₁+j₂])
+// is stored at indices [j₂·m, (j₂+1)·m).
+func ditButterfliesMixedRadix(a []fr.Element, p int, twiddles []fr.Element, stride, start, end int) {
+	n := len(twiddles)
+	m := len(a) / p
+
+	if p == 2 {
+		for k1 := start; k1 < end; k1++ {
+			if k1 != 0 {
+				a[k1+m].Mul(&a[k1+m], &twiddles[stride*k1])
+			}
+			fr.Butterfly(&a[k1], &a[k1+m])
+		}
+		return
+	}
+
+	wp := stride * m // ωₚ = ωʷᵖ
+	var buf [16]fr.Element
+	x := buf[:]
+	if p > len(buf) {
+		x = make([]fr.Element, p)
+	}
+	var t fr.Element
+	for k1 := start; k1 < end; k1++ {
+		for j2 := 0; j2 < p; j2++ {
+			x[j2].Mul(&a[j2*m+k1], &twiddles[(stride*j2*k1)%n])
+		}
+		for k2 := 0; k2 < p; k2++ {
+			acc := x[0]
+			for j2 := 1; j2 < p; j2++ {
+				t.Mul(&x[j2], &twiddles[(wp*j2*k2)%n])
+				acc.Add(&acc, &t)
+			}
+			a[k2*m+k1] = acc
+		}
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"encoding/binary"
 	"io"
 	"math/big"
-	"math/bits"
 	"runtime"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
@@ -170,22 +169,32 @@ func (p *Polynomial) Evaluate(x fr.Element) fr.Element {
 	}
 
 	var g fr.Element
+	gen := generator(p.size)
 	if p.shift <= 5 {
-		gen, err := fft.Generator(uint64(p.size))
-		if err != nil {
-			panic(err)
-		}
 		g = smallExp(gen, p.shift)
 		x.Mul(&x, &g)
 		return p.polynomial.evaluate(x)
 	}
 
 	bs := big.NewInt(int64(p.shift))
-	g = *g.Exp(g, bs)
+	g.Exp(gen, bs)
 	x.Mul(&x, &g)
 	return p.polynomial.evaluate(x)
 }
 
+// generator returns the generator of the domain of size n, n being a power
+// of 2 or the cardinality of a mixed radix domain.
+func generator(n int) fr.Element {
+	if n&(n-1) == 0 {
+		gen, err := fft.Generator(uint64(n))
+		if err != nil {
+			panic(err)
+		}
+		return gen
+	}
+	return fft.NewDomain(uint64(n), fft.WithMixedRadix(), fft.WithoutPrecompute()).Generator
+}
+
 // Clone returns a deep copy of p. The underlying polynomial is cloned;
 // see also ShallowClone to perform a ShallowClone on the underlying polynomial.
 // If capacity is provided, the new coefficient slice capacity will be set accordingly.
@@ -210,8 +219,7 @@ func (p *Polynomial) GetCoeff(i int) fr.Element {
 	if p.polynomial.Form.Layout == Regular {
 		return (*p.coefficients)[(i+rho*p.shift)%n]
 	} else {
-		nn := uint64(64 - bits.TrailingZeros(uint(n)))
-		iRev := bits.Reverse64(uint64((i+rho*p.shift)%n)) >> nn
+		iRev := fft.BitReverseIndex(uint64((i+rho*p.shift)%n), uint64(n))
 		return (*p.coefficients)[iRev]
 	}
 
@@ -264,9 +272,9 @@ func (p *polynomial) evaluate(x fr.Element) fr.Element {
 			r.Mul(&r, &x).Add(&r, &(*p.coefficients)[i])
 		}
 	} else {
-		nn := uint64(64 - bits.TrailingZeros(uint(p.coefficients.Len())))
+		n := uint64(p.coefficients.Len())
 		for i := p.coefficients.Len() - 1; i >= 0; i-- {
-			iRev := bits.Reverse64(uint64(i)) >> nn
+			iRev := fft.BitReverseIndex(uint64(i), n)
 			r.Mul(&r, &x).Add(&r, &(*p.coefficients)[iRev])
 		}
 	}
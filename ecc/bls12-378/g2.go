@@ -75,11 +75,10 @@ func (p *G2Affine) ScalarMultiplication(a *G2Affine, s *big.Int) *G2Affine {
 
 // ScalarMultiplicationBase computes and returns p = [s]g
 // where g is the affine point generating the prime subgroup.
+//
+// It uses a table of multiples of g, computed on first use.
 func (p *G2Affine) ScalarMultiplicationBase(s *big.Int) *G2Affine {
-	var _p G2Jac
-	_p.mulGLV(&g2Gen, s)
-	p.FromJacobian(&_p)
-	return p
+	return p.ScalarMultiplicationFixedBase(generatorTableG2(), s)
 }
 
 // Add adds two points in affine coordinates.
@@ -450,9 +449,10 @@ func (p *G2Jac) ScalarMultiplication(q *G2Jac, s *big.Int) *G2Jac {
 
 // ScalarMultiplicationBase computes and returns p = [s]g
 // where g is the prime subgroup generator.
+//
+// It uses a table of multiples of g, computed on first use.
 func (p *G2Jac) ScalarMultiplicationBase(s *big.Int) *G2Jac {
-	return p.mulGLV(&g2Gen, s)
-
+	return p.ScalarMultiplicationFixedBase(generatorTableG2(), s)
 }
 
 // String converts p to affine coordinates and returns its string representation E(x,y) or "O" if it is infinity.
@@ -534,6 +534,216 @@ func (p *G2Jac) mulWindowed(q *G2Jac, s *big.Int) *G2Jac {
 
 }
 
+// -------------------------------------------------------------------------------------------------
+// Constant-time scalar multiplication
+
+// g2ProjCT is a point in homogeneous projective coordinates (x=X/Z, y=Y/Z),
+// used by the constant-time scalar multiplication. The point at infinity is (0,1,0).
+type g2ProjCT struct {
+	X, Y, Z fptower.E2
+}
+
+// ScalarMultiplicationCT computes and returns p = [s]a where p and a are affine
+// points, in constant time with respect to s and a.
+//
+// a must be in the prime order subgroup. The scalar is reduced modulo r, and the
+// running time only depends on the bit length of r. It is slower than
+// ScalarMultiplication, and should be used when s is secret.
+func (p *G2Affine) ScalarMultiplicationCT(a *G2Affine, s *big.Int) *G2Affine {
+	var _a G2Jac
+	_a.FromAffine(a)
+	var res g2ProjCT
+	res.mulCT(&_a, s)
+	return p.fromProjCT(&res)
+}
+
+// ScalarMultiplicationBaseCT computes and returns p = [s]g where g is the affine
+// point generating the prime subgroup, in constant time with respect to s.
+func (p *G2Affine) ScalarMultiplicationBaseCT(s *big.Int) *G2Affine {
+	return p.ScalarMultiplicationFixedBaseCT(generatorTableG2(), s)
+}
+
+// ScalarMultiplicationCT computes and returns p = [s]q where p and q are Jacobian
+// points, in constant time with respect to s and q.
+//
+// q must be in the prime order subgroup. The scalar is reduced modulo r, and the
+// running time only depends on the bit length of r. It is slower than
+// ScalarMultiplication, and should be used when s is secret.
+func (p *G2Jac) ScalarMultiplicationCT(q *G2Jac, s *big.Int) *G2Jac {
+	var res g2ProjCT
+	res.mulCT(q, s)
+	return p.fromProjCT(&res)
+}
+
+// ScalarMultiplicationBaseCT computes and returns p = [s]g where g is the prime
+// subgroup generator, in constant time with respect to s.
+func (p *G2Jac) ScalarMultiplicationBaseCT(s *big.Int) *G2Jac {
+	return p.ScalarMultiplicationFixedBaseCT(generatorTableG2(), s)
+}
+
+// fromProjCT converts q from projective to affine coordinates, with a
+// constant-time inversion. The point at infinity (Z=0) is mapped to (0,0).
+func (p *G2Affine) fromProjCT(q *g2ProjCT) *G2Affine {
+	var zInv fptower.E2
+	zInv.InverseCT(&q.Z)
+	p.X.MulCT(&q.X, &zInv)
+	p.Y.MulCT(&q.Y, &zInv)
+	return p
+}
+
+// fromProjCT converts q from projective to Jacobian coordinates:
+// (X,Y,Z) → (XZ,YZ²,Z).
+func (p *G2Jac) fromProjCT(q *g2ProjCT) *G2Jac {
+	var zz fptower.E2
+	zz.SquareCT(&q.Z)
+	p.X.MulCT(&q.X, &q.Z)
+	p.Y.MulCT(&q.Y, &zz)
+	p.Z.Set(&q.Z)
+	// the result is infinity only if q is infinity or s = 0 mod r: the branch
+	// leaks nothing more than the result itself.
+	if p.Z.IsZero() {
+		p.X.SetOne()
+		p.Y.SetOne()
+	}
+	return p
+}
+
+// fromJacobian converts q from Jacobian to projective coordinates:
+// (X,Y,Z) → (XZ,Y,Z³). The point at infinity (1,1,0) is mapped to (0,1,0).
+func (p *g2ProjCT) fromJacobian(q *G2Jac) *g2ProjCT {
+	var zz fptower.E2
+	zz.SquareCT(&q.Z)
+	p.X.MulCT(&q.X, &q.Z)
+	p.Y.Set(&q.Y)
+	p.Z.MulCT(&zz, &q.Z)
+	return p
+}
+
+// selectCT sets p to q0 if c = 0 and to q1 otherwise, without branching on c.
+func (p *g2ProjCT) selectCT(c int, q0, q1 *g2ProjCT) *g2ProjCT {
+	p.X.A0.Select(c, &q0.X.A0, &q1.X.A0)
+	p.X.A1.Select(c, &q0.X.A1, &q1.X.A1)
+	p.Y.A0.Select(c, &q0.Y.A0, &q1.Y.A0)
+	p.Y.A1.Select(c, &q0.Y.A1, &q1.Y.A1)
+	p.Z.A0.Select(c, &q0.Z.A0, &q1.Z.A0)
+	p.Z.A1.Select(c, &q0.Z.A1, &q1.Z.A1)
+	return p
+}
+
+// mulCT sets p to [s]q with a 4-bits fixed-window method. The table of the
+// multiples of q is read entirely at each step and the complete addition
+// formulas are used, so that neither the sequence of operations nor the memory
+// accesses depend on s.
+func (p *g2ProjCT) mulCT(q *G2Jac, s *big.Int) *g2ProjCT {
+	var b3 fptower.E2
+	b3.DoubleCT(&bTwistCurveCoeff).AddCT(&b3, &bTwistCurveCoeff)
+
+	// table[i] = [i]q
+	var table [16]g2ProjCT
+	table[0].Y.SetOne()
+	table[1].fromJacobian(q)
+	for i := 2; i < len(table); i++ {
+		table[i].addComplete(&table[i-1], &table[1], &b3)
+	}
+
+	k := scalarWordsCT(s)
+
+	var res, t g2ProjCT
+	res.Y.SetOne()
+	for i := (fr.Bits+3)/4 - 1; i >= 0; i-- {
+		for j := 0; j < 4; j++ {
+			res.doubleComplete(&res, &b3)
+		}
+		w := (k[i/16] >> (4 * (i % 16))) & 0xf
+		t = table[0]
+		for j := 1; j < len(table); j++ {
+			t.selectCT(int(w^uint64(j)), &table[j], &t)
+		}
+		res.addComplete(&res, &t, &b3)
+	}
+	p.Set(&res)
+	return p
+}
+
+// Set sets p to q.
+func (p *g2ProjCT) Set(q *g2ProjCT) *g2ProjCT {
+	p.X, p.Y, p.Z = q.X, q.Y, q.Z
+	return p
+}
+
+// addComplete sets p to q+r with the complete addition formulas for a = 0
+// curves, where b3 = 3b. The inputs must be of odd order.
+//
+// https://eprint.iacr.org/2015/1060.pdf, algorithm 7
+func (p *g2ProjCT) addComplete(q, r *g2ProjCT, b3 *fptower.E2) *g2ProjCT {
+	var t0, t1, t2, t3, t4, X3, Y3, Z3 fptower.E2
+	t0.MulCT(&q.X, &r.X)
+	t1.MulCT(&q.Y, &r.Y)
+	t2.MulCT(&q.Z, &r.Z)
+	t3.AddCT(&q.X, &q.Y)
+	t4.AddCT(&r.X, &r.Y)
+	t3.MulCT(&t3, &t4)
+	t4.AddCT(&t0, &t1)
+	t3.SubCT(&t3, &t4)
+	t4.AddCT(&q.Y, &q.Z)
+	X3.AddCT(&r.Y, &r.Z)
+	t4.MulCT(&t4, &X3)
+	X3.AddCT(&t1, &t2)
+	t4.SubCT(&t4, &X3)
+	X3.AddCT(&q.X, &q.Z)
+	Y3.AddCT(&r.X, &r.Z)
+	X3.MulCT(&X3, &Y3)
+	Y3.AddCT(&t0, &t2)
+	Y3.SubCT(&X3, &Y3)
+	X3.DoubleCT(&t0)
+	t0.AddCT(&X3, &t0)
+	t2.MulCT(&t2, b3)
+	Z3.AddCT(&t1, &t2)
+	t1.SubCT(&t1, &t2)
+	Y3.MulCT(&Y3, b3)
+	X3.MulCT(&t4, &Y3)
+	t2.MulCT(&t3, &t1)
+	X3.SubCT(&t2, &X3)
+	Y3.MulCT(&Y3, &t0)
+	t1.MulCT(&t1, &Z3)
+	Y3.AddCT(&t1, &Y3)
+	t0.MulCT(&t0, &t3)
+	Z3.MulCT(&Z3, &t4)
+	Z3.AddCT(&Z3, &t0)
+
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
+// doubleComplete sets p to [2]q with the complete doubling formulas for a = 0
+// curves, where b3 = 3b.
+//
+// https://eprint.iacr.org/2015/1060.pdf, algorithm 9
+func (p *g2ProjCT) doubleComplete(q *g2ProjCT, b3 *fptower.E2) *g2ProjCT {
+	var t0, t1, t2, X3, Y3, Z3 fptower.E2
+	t0.SquareCT(&q.Y)
+	Z3.DoubleCT(&t0)
+	Z3.DoubleCT(&Z3)
+	Z3.DoubleCT(&Z3)
+	t1.MulCT(&q.Y, &q.Z)
+	t2.SquareCT(&q.Z)
+	t2.MulCT(&t2, b3)
+	X3.MulCT(&t2, &Z3)
+	Y3.AddCT(&t0, &t2)
+	Z3.MulCT(&t1, &Z3)
+	t1.DoubleCT(&t2)
+	t2.AddCT(&t1, &t2)
+	t0.SubCT(&t0, &t2)
+	Y3.MulCT(&t0, &Y3)
+	Y3.AddCT(&X3, &Y3)
+	t1.MulCT(&q.X, &q.Y)
+	X3.MulCT(&t0, &t1)
+	X3.DoubleCT(&X3)
+
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
 // psi sets p to ψ(q) = u o π o u⁻¹ where u:E'→E is the isomorphism from the twist to the curve E and π is the Frobenius map.
 func (p *G2Jac) psi(q *G2Jac) *G2Jac {
 	p.Set(q)
@@ -0,0 +1,268 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/internal/fptower"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// G2FixedBaseTable stores multiples of a fixed point of G2 to speed up the
+// scalar multiplications by this point.
+//
+// The scalar s is written in base 2ʷ with signed digits dᵢ ∈ [-2ʷ⁻¹+1, 2ʷ⁻¹], so
+// that [s]P = ∑ [dᵢ]([2ʷⁱ]P). The table stores the points [j⋅2ʷⁱ]P for 1 ⩽ j ⩽ 2ʷ⁻¹
+// and each window i: a scalar multiplication costs one addition per window and no
+// doubling. The table has (log₂(r)/w+1)⋅2ʷ⁻¹ points, so that the window size w
+// trades memory for speed.
+type G2FixedBaseTable struct {
+	window int
+	table  [][]G2Affine // table[i][j] = [(j+1)⋅2ʷⁱ]base
+}
+
+// NewG2FixedBaseTable computes the table of the multiples of base for windows of
+// the given size, in [1, 16].
+func NewG2FixedBaseTable(base *G2Affine, window int) (*G2FixedBaseTable, error) {
+	if window < 1 || window > 16 {
+		return nil, ErrInvalidWindow
+	}
+	t := &G2FixedBaseTable{window: window}
+	nbWindows := fr.Bits/window + 1
+	rowSize := 1 << (window - 1)
+
+	points := make([]G2Jac, nbWindows*rowSize)
+	var rowBase G2Jac
+	rowBase.FromAffine(base)
+	for i := 0; i < nbWindows; i++ {
+		row := points[i*rowSize : (i+1)*rowSize]
+		row[0] = rowBase
+		for j := 1; j < rowSize; j++ {
+			row[j].Set(&row[j-1]).AddAssign(&rowBase)
+		}
+		// [2ʷ⁽ⁱ⁺¹⁾]base = [2]([2ʷ⁻¹⋅2ʷⁱ]base)
+		rowBase.Double(&row[rowSize-1])
+	}
+	affine := make([]G2Affine, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			affine[i].FromJacobian(&points[i])
+		}
+	})
+	t.table = make([][]G2Affine, nbWindows)
+	for i := range t.table {
+		t.table[i] = affine[i*rowSize : (i+1)*rowSize : (i+1)*rowSize]
+	}
+	return t, nil
+}
+
+// Base returns the point of which the table stores the multiples.
+func (t *G2FixedBaseTable) Base() G2Affine {
+	return t.table[0][0]
+}
+
+// Window returns the size in bits of the windows of the table.
+func (t *G2FixedBaseTable) Window() int {
+	return t.window
+}
+
+// digits returns the signed digits of s mod r in base 2ʷ.
+func (t *G2FixedBaseTable) digits(s *big.Int) []int {
+	var e fr.Element
+	k := e.SetBigInt(s).Bits()
+	digits := make([]int, len(t.table))
+	signedDigits(digits, &k, t.window)
+	return digits
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]P where P is the base
+// of the table.
+func (p *G2Jac) ScalarMultiplicationFixedBase(t *G2FixedBaseTable, s *big.Int) *G2Jac {
+	var res g2JacExtended
+	t.mul(&res, t.digits(s))
+	return p.fromJacExtended(&res)
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]P where P is the base
+// of the table.
+func (p *G2Affine) ScalarMultiplicationFixedBase(t *G2FixedBaseTable, s *big.Int) *G2Affine {
+	var res g2JacExtended
+	t.mul(&res, t.digits(s))
+	return p.fromJacExtended(&res)
+}
+
+// mul sets res to ∑ [dᵢ]([2ʷⁱ]P).
+func (t *G2FixedBaseTable) mul(res *g2JacExtended, digits []int) {
+	res.setInfinity()
+	if t.table[0][0].IsInfinity() {
+		return
+	}
+	for i, d := range digits {
+		if d > 0 {
+			res.addMixed(&t.table[i][d-1])
+		} else if d < 0 {
+			res.subMixed(&t.table[i][-d-1])
+		}
+	}
+}
+
+// BatchScalarMultiplication computes and returns the points [sᵢ]P where P is the
+// base of the table.
+func (t *G2FixedBaseTable) BatchScalarMultiplication(scalars []fr.Element) []G2Affine {
+	res := make([]G2Affine, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		var p g2JacExtended
+		digits := make([]int, len(t.table))
+		for i := start; i < end; i++ {
+			k := scalars[i].Bits()
+			signedDigits(digits, &k, t.window)
+			t.mul(&p, digits)
+			res[i].fromJacExtended(&p)
+		}
+	})
+	return res
+}
+
+// ScalarMultiplicationFixedBaseCT computes and returns p = [s]P where P is the base
+// of the table, in constant time with respect to s.
+//
+// The whole table is read, and the running time only depends on its size. It is
+// slower than ScalarMultiplicationFixedBase, and should be used when s is secret.
+func (p *G2Jac) ScalarMultiplicationFixedBaseCT(t *G2FixedBaseTable, s *big.Int) *G2Jac {
+	var res g2ProjCT
+	res.mulFixedBaseCT(t, s)
+	return p.fromProjCT(&res)
+}
+
+// ScalarMultiplicationFixedBaseCT computes and returns p = [s]P where P is the base
+// of the table, in constant time with respect to s.
+//
+// The whole table is read, and the running time only depends on its size. It is
+// slower than ScalarMultiplicationFixedBase, and should be used when s is secret.
+func (p *G2Affine) ScalarMultiplicationFixedBaseCT(t *G2FixedBaseTable, s *big.Int) *G2Affine {
+	var res g2ProjCT
+	res.mulFixedBaseCT(t, s)
+	return p.fromProjCT(&res)
+}
+
+// mulFixedBaseCT sets p to [s]P where P is the base of the table. All the entries
+// of a row are read for each digit, and the complete addition formulas are used.
+func (p *g2ProjCT) mulFixedBaseCT(t *G2FixedBaseTable, s *big.Int) *g2ProjCT {
+	var res, q g2ProjCT
+	res.Y.SetOne()
+	if t.table[0][0].IsInfinity() {
+		*p = res
+		return p
+	}
+
+	var b3, one, negY fptower.E2
+	b3.Double(&bTwistCurveCoeff).Add(&b3, &bTwistCurveCoeff)
+	one.SetOne()
+
+	k := scalarWordsCT(s)
+	digits := make([]int, len(t.table))
+	signedDigits(digits, &k, t.window)
+	for i, d := range digits {
+		dAbs, sign := abs(d)
+
+		// q = [|d|⋅2ʷⁱ]P, or the point at infinity (0,1,0) if d = 0
+		q.X.SetZero()
+		q.Y.SetOne()
+		q.Z.SetZero()
+		for j := range t.table[i] {
+			c := int(dAbs ^ uint64(j+1))
+			e := &t.table[i][j]
+			q.X.A0.Select(c, &e.X.A0, &q.X.A0)
+			q.Y.A0.Select(c, &e.Y.A0, &q.Y.A0)
+			q.Z.A0.Select(c, &one.A0, &q.Z.A0)
+			q.X.A1.Select(c, &e.X.A1, &q.X.A1)
+			q.Y.A1.Select(c, &e.Y.A1, &q.Y.A1)
+			q.Z.A1.Select(c, &one.A1, &q.Z.A1)
+		}
+		// q = -q if d < 0
+		negY.NegCT(&q.Y)
+		q.Y.A0.Select(int(sign), &q.Y.A0, &negY.A0)
+		q.Y.A1.Select(int(sign), &q.Y.A1, &negY.A1)
+
+		res.addComplete(&res, &q, &b3)
+	}
+
+	*p = res
+	return p
+}
+
+// WriteTo writes the window size and the points of the table, with the raw
+// (uncompressed) encoding.
+func (t *G2FixedBaseTable) WriteTo(w io.Writer) (int64, error) {
+	enc := NewEncoder(w, RawEncoding())
+	if err := enc.Encode(uint64(t.window)); err != nil {
+		return enc.BytesWritten(), err
+	}
+	for i := range t.table {
+		if err := enc.Encode(t.table[i]); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom reads a table written by WriteTo. The points are checked to be in the
+// subgroup, but not to be the multiples of the base: the table must come from a
+// trusted source.
+func (t *G2FixedBaseTable) ReadFrom(r io.Reader) (int64, error) {
+	dec := NewDecoder(r)
+	var window uint64
+	if err := dec.Decode(&window); err != nil {
+		return dec.BytesRead(), err
+	}
+	if window < 1 || window > 16 {
+		return dec.BytesRead(), ErrInvalidWindow
+	}
+	t.window = int(window)
+	t.table = make([][]G2Affine, fr.Bits/t.window+1)
+	for i := range t.table {
+		if err := dec.Decode(&t.table[i]); err != nil {
+			return dec.BytesRead(), err
+		}
+		if len(t.table[i]) != 1<<(t.window-1) {
+			return dec.BytesRead(), errInvalidRowSize
+		}
+	}
+	return dec.BytesRead(), nil
+}
+
+// g2GenTableWindow is the window size of the table of the multiples of the
+// generator, used by ScalarMultiplicationBase.
+const g2GenTableWindow = 6
+
+var (
+	g2GenTableOnce sync.Once
+	g2GenTable     *G2FixedBaseTable
+)
+
+// generatorTableG2 returns the table of the multiples of the generator,
+// computed on first use.
+func generatorTableG2() *G2FixedBaseTable {
+	g2GenTableOnce.Do(func() {
+		g2GenTable, _ = NewG2FixedBaseTable(&g2GenAff, g2GenTableWindow)
+	})
+	return g2GenTable
+}
@@ -0,0 +1,249 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestG2FixedBaseTable(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	// a base point which is not the generator
+	var base G2Affine
+	base.ScalarMultiplication(&g2GenAff, big.NewInt(7))
+
+	windows := []int{1, 3, 4, 8}
+	tables := make([]*G2FixedBaseTable, len(windows))
+	for i, w := range windows {
+		var err error
+		if tables[i], err = NewG2FixedBaseTable(&base, w); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	properties.Property("[BLS12-378] ScalarMultiplicationFixedBase and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+			var scalar, negScalar big.Int
+			s.BigInt(&scalar)
+			negScalar.Neg(&scalar)
+			var expected [2]G2Affine
+			expected[0].ScalarMultiplication(&base, &scalar)
+			expected[1].Neg(&expected[0])
+			for l, k := range []*big.Int{&scalar, &negScalar} {
+				expected := expected[l]
+				for _, table := range tables {
+					var op1, op2 G2Jac
+					var op3, op4 G2Affine
+					op1.ScalarMultiplicationFixedBase(table, k)
+					op2.ScalarMultiplicationFixedBaseCT(table, k)
+					op3.ScalarMultiplicationFixedBase(table, k)
+					op4.ScalarMultiplicationFixedBaseCT(table, k)
+					if !op3.Equal(&expected) || !op4.Equal(&expected) {
+						return false
+					}
+					var e1, e2 G2Affine
+					e1.FromJacobian(&op1)
+					e2.FromJacobian(&op2)
+					if !e1.Equal(&expected) || !e2.Equal(&expected) {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-378] ScalarMultiplicationFixedBase should handle the point at infinity and the zero scalar", prop.ForAll(
+		func(s fr.Element) bool {
+			var scalar big.Int
+			s.BigInt(&scalar)
+
+			var inf G2Affine
+			infTable, err := NewG2FixedBaseTable(&inf, 4)
+			if err != nil {
+				return false
+			}
+
+			var op1, op2, op3, op4 G2Jac
+			op1.ScalarMultiplicationFixedBase(infTable, &scalar)
+			op2.ScalarMultiplicationFixedBaseCT(infTable, &scalar)
+			op3.ScalarMultiplicationFixedBase(tables[2], fr.Modulus())
+			op4.ScalarMultiplicationFixedBaseCT(tables[2], fr.Modulus())
+
+			return op1.Equal(&g2Infinity) && op2.Equal(&g2Infinity) &&
+				op3.Equal(&g2Infinity) && op4.Equal(&g2Infinity)
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-378] G2FixedBaseTable.BatchScalarMultiplication should be consistent with individual scalar multiplications", prop.ForAll(
+		func(mixer fr.Element) bool {
+			const nbSamples = 10
+			var scalars [nbSamples]fr.Element
+			for i := range scalars {
+				scalars[i].SetUint64(uint64(i)).Mul(&scalars[i], &mixer)
+			}
+			for _, table := range tables {
+				result := table.BatchScalarMultiplication(scalars[:])
+				if len(result) != nbSamples {
+					return false
+				}
+				for i := range result {
+					var expected G2Affine
+					var b big.Int
+					expected.ScalarMultiplication(&base, scalars[i].BigInt(&b))
+					if !result[i].Equal(&expected) {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	for _, w := range []int{0, 17} {
+		if _, err := NewG2FixedBaseTable(&base, w); err != ErrInvalidWindow {
+			t.Fatalf("window %d: expected ErrInvalidWindow, got %v", w, err)
+		}
+	}
+}
+
+func TestG2FixedBaseTableSerialization(t *testing.T) {
+	t.Parallel()
+
+	var base G2Affine
+	base.ScalarMultiplication(&g2GenAff, big.NewInt(3))
+
+	for _, w := range []int{1, 5} {
+		table, err := NewG2FixedBaseTable(&base, w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		written, err := table.WriteTo(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var reconstructed G2FixedBaseTable
+		read, err := reconstructed.ReadFrom(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if written != read {
+			t.Fatalf("window %d: %d bytes written, %d bytes read", w, written, read)
+		}
+		if reconstructed.Window() != w {
+			t.Fatalf("window %d: read window %d", w, reconstructed.Window())
+		}
+		rBase := reconstructed.Base()
+		if !rBase.Equal(&base) {
+			t.Fatalf("window %d: wrong base", w)
+		}
+
+		var s fr.Element
+		if _, err := s.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		var scalar big.Int
+		s.BigInt(&scalar)
+		var p1, p2 G2Affine
+		p1.ScalarMultiplicationFixedBase(table, &scalar)
+		p2.ScalarMultiplicationFixedBase(&reconstructed, &scalar)
+		if !p1.Equal(&p2) {
+			t.Fatalf("window %d: the tables differ", w)
+		}
+	}
+}
+
+func TestG2AffineScalarMultiplicationFixedBaseCTTiming(t *testing.T) {
+	// class 0: s = 1, class 1: random s
+	const n = 1 << 11
+	var scalars [2][n]big.Int
+	for i := 0; i < n; i++ {
+		var s fr.Element
+		if _, err := s.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		s.BigInt(&scalars[1][i])
+		scalars[0][i].SetUint64(1)
+	}
+	table := generatorTableG2()
+	var p G2Jac
+	testutils.ConstantTime(t, n, func(c, i int) {
+		p.ScalarMultiplicationFixedBaseCT(table, &scalars[c][i])
+	})
+}
+
+func BenchmarkG2JacScalarMultiplicationFixedBase(b *testing.B) {
+	var scalar big.Int
+	scalar.SetString("5243587517512619047944770508185965837690552500527637822603658699938581184513", 10)
+	scalar.Mod(&scalar, fr.Modulus())
+
+	for _, w := range []int{4, 6, 8} {
+		table, err := NewG2FixedBaseTable(&g2GenAff, w)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var p G2Jac
+		b.Run(fmt.Sprintf("window=%d", w), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				p.ScalarMultiplicationFixedBase(table, &scalar)
+			}
+		})
+		b.Run(fmt.Sprintf("window=%d constant time", w), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				p.ScalarMultiplicationFixedBaseCT(table, &scalar)
+			}
+		})
+	}
+}
+
+func BenchmarkG2FixedBaseTable(b *testing.B) {
+	for _, w := range []int{4, 6, 8} {
+		b.Run(fmt.Sprintf("window=%d", w), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				_, _ = NewG2FixedBaseTable(&g2GenAff, w)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/internal/fptower"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -499,9 +500,77 @@ func TestG2AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-378] ScalarMultiplicationCT and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var q G2Jac
+			var qAff G2Affine
+			q.ScalarMultiplication(&g2Gen, big.NewInt(5))
+			qAff.FromJacobian(&q)
+
+			var scalar, negScalar big.Int
+			s.BigInt(&scalar)
+			negScalar.Neg(&scalar)
+			for _, k := range []*big.Int{&scalar, &negScalar} {
+				var op1, op2, op3, op4 G2Jac
+				op1.ScalarMultiplication(&q, k)
+				op2.ScalarMultiplicationCT(&q, k)
+				op3.ScalarMultiplicationBase(k)
+				op4.ScalarMultiplicationBaseCT(k)
+
+				var op5, op6 G2Affine
+				op5.FromJacobian(&op1)
+				op6.ScalarMultiplicationCT(&qAff, k)
+
+				if !op1.Equal(&op2) || !op3.Equal(&op4) || !op5.Equal(&op6) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-378] ScalarMultiplicationCT should handle the point at infinity and the zero scalar", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var scalar big.Int
+			s.BigInt(&scalar)
+
+			var op1, op2 G2Jac
+			op1.ScalarMultiplicationCT(&g2Infinity, &scalar)
+			op2.ScalarMultiplicationCT(&g2Gen, fr.Modulus())
+
+			var op3, inf G2Affine
+			op3.ScalarMultiplicationCT(&inf, &scalar)
+
+			return op1.Equal(&g2Infinity) && op1.Z.IsZero() && op1.X.IsOne() && op1.Y.IsOne() &&
+				op2.Equal(&g2Infinity) && op3.IsInfinity()
+		},
+		genScalar,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG2AffineScalarMultiplicationCTTiming(t *testing.T) {
+	// class 0: s = 1, class 1: random s
+	const n = 1 << 11
+	var scalars [2][n]big.Int
+	for i := 0; i < n; i++ {
+		var s fr.Element
+		if _, err := s.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		s.BigInt(&scalars[1][i])
+		scalars[0][i].SetUint64(1)
+	}
+	var p G2Jac
+	testutils.ConstantTime(t, n, func(c, i int) {
+		p.ScalarMultiplicationCT(&g2Gen, &scalars[c][i])
+	})
+}
+
 func TestG2AffineCofactorCleaning(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -724,6 +793,14 @@ func BenchmarkG2JacScalarMultiplication(b *testing.B) {
 		}
 	})
 
+	var ct G2Jac
+	b.Run("constant time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			ct.ScalarMultiplicationCT(&g2Gen, &scalar)
+		}
+	})
+
 }
 
 func BenchmarkG2AffineCofactorClearing(b *testing.B) {
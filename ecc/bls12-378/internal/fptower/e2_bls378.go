@@ -94,6 +94,44 @@ func (z *E2) Inverse(x *E2) *E2 {
 	return z
 }
 
+// MulCT sets z to the E2-product of x,y in constant time, returns z
+func (z *E2) MulCT(x, y *E2) *E2 {
+	var a, b, c fp.Element
+	a.AddCT(&x.A0, &x.A1)
+	b.AddCT(&y.A0, &y.A1)
+	a.MulCT(&a, &b)
+	b.MulCT(&x.A0, &y.A0)
+	c.MulCT(&x.A1, &y.A1)
+	z.A1.SubCT(&a, &b).SubCT(&z.A1, &c)
+	// z.A0 = b - 5c
+	var t fp.Element
+	t.DoubleCT(&c).DoubleCT(&t).AddCT(&t, &c)
+	z.A0.SubCT(&b, &t)
+	return z
+}
+
+// SquareCT sets z to the E2-product of x,x in constant time, returns z
+func (z *E2) SquareCT(x *E2) *E2 {
+	return z.MulCT(x, x)
+}
+
+// InverseCT sets z to the E2-inverse of x in constant time, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) InverseCT(x *E2) *E2 {
+	// Algorithm 8 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, tmp fp.Element
+	t0.SquareCT(&x.A0)
+	t1.SquareCT(&x.A1)
+	tmp.DoubleCT(&t1).DoubleCT(&tmp).AddCT(&tmp, &t1)
+	t0.AddCT(&t0, &tmp)
+	t1.InverseCT(&t0)
+	z.A0.MulCT(&x.A0, &t1)
+	z.A1.MulCT(&x.A1, &t1).NegCT(&z.A1)
+
+	return z
+}
+
 // norm sets x to the norm of z
 func (z *E2) norm(x *fp.Element) {
 	var tmp fp.Element
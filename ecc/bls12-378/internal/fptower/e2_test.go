@@ -257,6 +257,38 @@ func TestE2Ops(t *testing.T) {
 		genB,
 	))
 
+	properties.Property("[BLS12-378] InverseCT should output the same result as Inverse", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Inverse(a)
+			c.InverseCT(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[BLS12-378] AddCT, SubCT, DoubleCT and NegCT should output the same results as Add, Sub, Double and Neg", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			ok := c.Add(a, b).Equal(d.AddCT(a, b))
+			ok = ok && c.Sub(a, b).Equal(d.SubCT(a, b))
+			ok = ok && c.Double(a).Equal(d.DoubleCT(a))
+			ok = ok && c.Neg(a).Equal(d.NegCT(a))
+			return ok
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[BLS12-378] MulCT and SquareCT should output the same results as Mul and Square", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			return c.Mul(a, b).Equal(d.MulCT(a, b)) && c.Square(a).Equal(d.SquareCT(a))
+		},
+		genA,
+		genB,
+	))
+
 	properties.Property("[BLS12-378] BatchInvertE2 should output the same result as Inverse", prop.ForAll(
 		func(a, b, c *E2) bool {
 
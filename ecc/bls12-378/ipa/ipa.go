@@ -0,0 +1,651 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidSize           = errors.New("size must be a non-zero power of two")
+	ErrCommitmentKeyTooSmall = errors.New("commitment key is too small for the vector")
+	ErrSizeMismatch          = errors.New("vectors and bases must have the same length")
+	ErrInvalidProofSize      = errors.New("proof does not have the expected number of rounds")
+	ErrZeroChallenge         = errors.New("fiat-shamir challenge is zero")
+	ErrVerifyCommitment      = errors.New("commitment does not match the opening")
+	ErrVerifyInnerProduct    = errors.New("can't verify inner-product argument")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+)
+
+// Digest is a Pedersen vector commitment.
+type Digest = curve.G1Affine
+
+// CommitmentKey holds the bases of the commitment scheme. The discrete
+// logarithms relating the bases are unknown.
+type CommitmentKey struct {
+	G []curve.G1Affine // bases for the committed vector
+	H []curve.G1Affine // second vector of bases, for two-vector inner-product arguments
+	Q curve.G1Affine   // blinding base
+	U curve.G1Affine   // base carrying the inner product in the arguments
+}
+
+// NewCommitmentKey derives a commitment key for vectors of up to size
+// elements. The bases are obtained with [curve.HashToG1], using
+// domainSeparator as domain separation tag, so that the setup is transparent
+// and reproducible.
+func NewCommitmentKey(size int, domainSeparator []byte) (CommitmentKey, error) {
+	if size <= 0 {
+		return CommitmentKey{}, ErrInvalidSize
+	}
+	var ck CommitmentKey
+	var err error
+	if ck.G, err = HashToBases("G", size, domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	if ck.H, err = HashToBases("H", size, domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	if ck.Q, err = curve.HashToG1([]byte("Q"), domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	if ck.U, err = curve.HashToG1([]byte("U"), domainSeparator); err != nil {
+		return CommitmentKey{}, err
+	}
+	return ck, nil
+}
+
+// HashToBases returns n points of G1 whose discrete logarithms are unknown.
+// The i-th point is HashToG1(label || i, domainSeparator), with i encoded on
+// 4 bytes in big endian.
+func HashToBases(label string, n int, domainSeparator []byte) ([]curve.G1Affine, error) {
+	res := make([]curve.G1Affine, n)
+	errs := make([]error, n)
+	parallel.Execute(n, func(start, end int) {
+		msg := make([]byte, len(label)+4)
+		copy(msg, label)
+		for i := start; i < end; i++ {
+			binary.BigEndian.PutUint32(msg[len(label):], uint32(i))
+			res[i], errs[i] = curve.HashToG1(msg, domainSeparator)
+		}
+	})
+	for i := range errs {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+	}
+	return res, nil
+}
+
+// Commit returns ⟨values, G⟩ + blinding·Q. The blinding must be sampled
+// uniformly at random (see [fr.Element.SetRandom]) for the commitment to be
+// hiding.
+func (ck *CommitmentKey) Commit(values []fr.Element, blinding fr.Element) (Digest, error) {
+	if len(values) > len(ck.G) {
+		return Digest{}, ErrCommitmentKeyTooSmall
+	}
+	bases := make([]curve.G1Affine, 0, len(values)+1)
+	bases = append(bases, ck.G[:len(values)]...)
+	bases = append(bases, ck.Q)
+	scalars := make([]fr.Element, 0, len(values)+1)
+	scalars = append(scalars, values...)
+	scalars = append(scalars, blinding)
+
+	var res Digest
+	if _, err := res.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return Digest{}, err
+	}
+	return res, nil
+}
+
+// VerifyCommitment checks that commitment opens to values with the given
+// blinding.
+func (ck *CommitmentKey) VerifyCommitment(commitment *Digest, values []fr.Element, blinding fr.Element) error {
+	expected, err := ck.Commit(values, blinding)
+	if err != nil {
+		return err
+	}
+	if !expected.Equal(commitment) {
+		return ErrVerifyCommitment
+	}
+	return nil
+}
+
+// Add returns a+b. If a commits to v₁ with blinding r₁ and b commits to v₂
+// with blinding r₂, then a+b commits to v₁+v₂ with blinding r₁+r₂.
+func Add(a, b *Digest) Digest {
+	var res Digest
+	res.Add(a, b)
+	return res
+}
+
+// Scale returns s·a. If a commits to v with blinding r, then s·a commits to
+// s·v with blinding s·r.
+func Scale(a *Digest, s fr.Element) Digest {
+	var res Digest
+	var bs big.Int
+	s.BigInt(&bs)
+	res.ScalarMultiplication(a, &bs)
+	return res
+}
+
+// InnerProductProof proves knowledge of vectors a, b of size n such that
+// P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩·U. It is not hiding: it is meant to be used as
+// a sub-protocol, for instance by range proofs.
+type InnerProductProof struct {
+	L, R []curve.G1Affine // cross terms, one pair per round
+	A, B fr.Element       // a and b folded down to a single element
+}
+
+// OpeningProof proves that a commitment C = ⟨a, G⟩ + r·Q to a vector a of
+// size n satisfies ⟨a, b⟩ = v for a public vector b. It is zero-knowledge:
+// nothing but v is revealed about a.
+type OpeningProof struct {
+	L, R   []curve.G1Affine // blinded cross terms, one pair per round
+	T      curve.G1Affine   // commitment of the final Schnorr proof
+	Z1, Z2 fr.Element       // responses of the final Schnorr proof
+}
+
+// ProveInnerProduct computes an [InnerProductProof] for the vectors a, b
+// over the bases G, H and U.
+//
+// The Fiat-Shamir challenges are derived from the proof elements and from
+// dataTranscript, which must bind the statement, that is P or the public
+// values it is computed from.
+func ProveInnerProduct(G, H []curve.G1Affine, U *curve.G1Affine, a, b []fr.Element, hf hash.Hash, dataTranscript ...[]byte) (InnerProductProof, error) {
+	n := len(a)
+	if n == 0 || n&(n-1) != 0 {
+		return InnerProductProof{}, ErrInvalidSize
+	}
+	if len(b) != n || len(G) != n || len(H) != n {
+		return InnerProductProof{}, ErrSizeMismatch
+	}
+	nbRounds := bits.TrailingZeros(uint(n))
+	fs, err := newTranscript(hf, nbRounds, dataTranscript...)
+	if err != nil {
+		return InnerProductProof{}, err
+	}
+
+	// work on copies, the slices are folded in place
+	a = append([]fr.Element(nil), a...)
+	b = append([]fr.Element(nil), b...)
+
+	proof := InnerProductProof{
+		L: make([]curve.G1Affine, nbRounds),
+		R: make([]curve.G1Affine, nbRounds),
+	}
+	bases := make([]curve.G1Affine, n+1)
+	scalars := make([]fr.Element, n+1)
+
+	for j := 0; j < nbRounds; j++ {
+		m := n >> 1
+		aLo, aHi := a[:m], a[m:n]
+		bLo, bHi := b[:m], b[m:n]
+		gLo, gHi := G[:m], G[m:n]
+		hLo, hHi := H[:m], H[m:n]
+
+		// L = ⟨a_lo, G_hi⟩ + ⟨b_hi, H_lo⟩ + ⟨a_lo, b_hi⟩·U
+		copy(bases, gHi)
+		copy(bases[m:], hLo)
+		bases[n] = *U
+		copy(scalars, aLo)
+		copy(scalars[m:], bHi)
+		scalars[n] = innerProduct(aLo, bHi)
+		if _, err := proof.L[j].MultiExp(bases[:n+1], scalars[:n+1], ecc.MultiExpConfig{}); err != nil {
+			return InnerProductProof{}, err
+		}
+
+		// R = ⟨a_hi, G_lo⟩ + ⟨b_lo, H_hi⟩ + ⟨a_hi, b_lo⟩·U
+		copy(bases, gLo)
+		copy(bases[m:], hHi)
+		copy(scalars, aHi)
+		copy(scalars[m:], bLo)
+		scalars[n] = innerProduct(aHi, bLo)
+		if _, err := proof.R[j].MultiExp(bases[:n+1], scalars[:n+1], ecc.MultiExpConfig{}); err != nil {
+			return InnerProductProof{}, err
+		}
+
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j])
+		if err != nil {
+			return InnerProductProof{}, err
+		}
+		var xInv fr.Element
+		xInv.Inverse(&x)
+
+		// a' = x·a_lo + x⁻¹·a_hi, b' = x⁻¹·b_lo + x·b_hi
+		// G' = x⁻¹·G_lo + x·G_hi, H' = x·H_lo + x⁻¹·H_hi
+		foldScalars(aLo, aHi, &x, &xInv)
+		foldScalars(bLo, bHi, &xInv, &x)
+		G = foldBases(gLo, gHi, &xInv, &x)
+		H = foldBases(hLo, hHi, &x, &xInv)
+		a, b = aLo, bLo
+		n = m
+	}
+
+	proof.A, proof.B = a[0], b[0]
+	return proof, nil
+}
+
+// VerifyInnerProduct checks that proof is a valid [InnerProductProof] for
+// P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩·U. dataTranscript must be the same as the one
+// given to the prover.
+func VerifyInnerProduct(G, H []curve.G1Affine, U, P *curve.G1Affine, proof *InnerProductProof, hf hash.Hash, dataTranscript ...[]byte) error {
+	n := len(G)
+	if len(H) != n {
+		return ErrSizeMismatch
+	}
+	challenges, err := proof.Challenges(n, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	s, sInv := FoldingScalars(challenges)
+
+	// ⟨a·s, G⟩ + ⟨b·s⁻¹, H⟩ + a·b·U - ∑ⱼ (xⱼ²·Lⱼ + xⱼ⁻²·Rⱼ) - P must vanish
+	nbRounds := len(challenges)
+	bases := make([]curve.G1Affine, 0, 2*n+2*nbRounds+2)
+	scalars := make([]fr.Element, 2*n+2*nbRounds+2)
+	bases = append(bases, G...)
+	bases = append(bases, H...)
+	bases = append(bases, proof.L...)
+	bases = append(bases, proof.R...)
+	bases = append(bases, *U, *P)
+	for i := 0; i < n; i++ {
+		scalars[i].Mul(&proof.A, &s[i])
+		scalars[n+i].Mul(&proof.B, &sInv[i])
+	}
+	setCrossTermsScalars(scalars[2*n:2*n+2*nbRounds], challenges)
+	scalars[2*n+2*nbRounds].Mul(&proof.A, &proof.B)
+	scalars[2*n+2*nbRounds+1].SetOne().Neg(&scalars[2*n+2*nbRounds+1])
+
+	var check curve.G1Affine
+	if _, err := check.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.IsInfinity() {
+		return ErrVerifyInnerProduct
+	}
+	return nil
+}
+
+// Challenges recomputes the Fiat-Shamir challenges x₀, …, x_{k-1} of an
+// [InnerProductProof] on vectors of size n. It lets callers that embed the
+// argument in a larger protocol merge its verification equation with their
+// own, see [FoldingScalars].
+func (proof *InnerProductProof) Challenges(n int, hf hash.Hash, dataTranscript ...[]byte) ([]fr.Element, error) {
+	if n == 0 || n&(n-1) != 0 {
+		return nil, ErrInvalidSize
+	}
+	nbRounds := bits.TrailingZeros(uint(n))
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return nil, ErrInvalidProofSize
+	}
+	fs, err := newTranscript(hf, nbRounds, dataTranscript...)
+	if err != nil {
+		return nil, err
+	}
+	challenges := make([]fr.Element, nbRounds)
+	for j := range challenges {
+		var err error
+		challenges[j], err = deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return challenges, nil
+}
+
+// FoldingScalars returns s and s⁻¹ (coordinate-wise) such that, given the
+// round challenges, the bases folded by the prover are G' = ⟨s, G⟩ and
+// H' = ⟨s⁻¹, H⟩. The expected value of P is then
+//
+//	a·⟨s, G⟩ + b·⟨s⁻¹, H⟩ + a·b·U - ∑ⱼ (xⱼ²·Lⱼ + xⱼ⁻²·Rⱼ)
+func FoldingScalars(challenges []fr.Element) (s, sInv []fr.Element) {
+	challengesInv := fr.BatchInvert(challenges)
+	n := 1 << len(challenges)
+	s = make([]fr.Element, n)
+	sInv = make([]fr.Element, n)
+	s[0].SetOne()
+	sInv[0].SetOne()
+
+	// round j fixes the j-th most significant bit of the index: the low half
+	// is multiplied by xⱼ⁻¹ and the high half by xⱼ.
+	for j, size := 0, 1; j < len(challenges); j, size = j+1, size<<1 {
+		for i := size - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], &challenges[j])
+			s[2*i].Mul(&s[i], &challengesInv[j])
+			sInv[2*i+1].Mul(&sInv[i], &challengesInv[j])
+			sInv[2*i].Mul(&sInv[i], &challenges[j])
+		}
+	}
+	return s, sInv
+}
+
+// Open computes a zero-knowledge proof that commitment = ck.Commit(a, blinding)
+// satisfies ⟨a, b⟩ = v, and returns v. The size of a must be a power of two.
+// For a polynomial commitment, a holds the coefficients and b the powers of
+// the evaluation point.
+func Open(ck *CommitmentKey, commitment *Digest, a []fr.Element, blinding fr.Element, b []fr.Element, hf hash.Hash, dataTranscript ...[]byte) (OpeningProof, fr.Element, error) {
+	n := len(a)
+	if n == 0 || n&(n-1) != 0 {
+		return OpeningProof{}, fr.Element{}, ErrInvalidSize
+	}
+	if len(b) != n {
+		return OpeningProof{}, fr.Element{}, ErrSizeMismatch
+	}
+	if n > len(ck.G) {
+		return OpeningProof{}, fr.Element{}, ErrCommitmentKeyTooSmall
+	}
+	v := innerProduct(a, b)
+	nbRounds := bits.TrailingZeros(uint(n))
+	fs := fiatshamir.NewTranscript(hf, openingChallengeNames(nbRounds)...)
+
+	// U' = ξ·U, P = C + v·U' = ⟨a, G⟩ + ⟨a, b⟩·U' + r·Q
+	xi, err := deriveOpeningChallenge(fs, commitment, b, &v, dataTranscript...)
+	if err != nil {
+		return OpeningProof{}, fr.Element{}, err
+	}
+	var uPrime curve.G1Affine
+	var bXi big.Int
+	uPrime.ScalarMultiplication(&ck.U, xi.BigInt(&bXi))
+
+	G := ck.G[:n]
+	a = append([]fr.Element(nil), a...)
+	b = append([]fr.Element(nil), b...)
+
+	proof := OpeningProof{
+		L: make([]curve.G1Affine, nbRounds),
+		R: make([]curve.G1Affine, nbRounds),
+	}
+	bases := make([]curve.G1Affine, n/2+2)
+	scalars := make([]fr.Element, n/2+2)
+
+	for j := 0; j < nbRounds; j++ {
+		m := n >> 1
+		aLo, aHi := a[:m], a[m:n]
+		bLo, bHi := b[:m], b[m:n]
+		gLo, gHi := G[:m], G[m:n]
+
+		var l, r fr.Element
+		if _, err := l.SetRandom(); err != nil {
+			return OpeningProof{}, fr.Element{}, err
+		}
+		if _, err := r.SetRandom(); err != nil {
+			return OpeningProof{}, fr.Element{}, err
+		}
+
+		// L = ⟨a_lo, G_hi⟩ + ⟨a_lo, b_hi⟩·U' + l·Q
+		copy(bases, gHi)
+		bases[m], bases[m+1] = uPrime, ck.Q
+		copy(scalars, aLo)
+		scalars[m], scalars[m+1] = innerProduct(aLo, bHi), l
+		if _, err := proof.L[j].MultiExp(bases[:m+2], scalars[:m+2], ecc.MultiExpConfig{}); err != nil {
+			return OpeningProof{}, fr.Element{}, err
+		}
+
+		// R = ⟨a_hi, G_lo⟩ + ⟨a_hi, b_lo⟩·U' + r·Q
+		copy(bases, gLo)
+		copy(scalars, aHi)
+		scalars[m], scalars[m+1] = innerProduct(aHi, bLo), r
+		if _, err := proof.R[j].MultiExp(bases[:m+2], scalars[:m+2], ecc.MultiExpConfig{}); err != nil {
+			return OpeningProof{}, fr.Element{}, err
+		}
+
+		x, err := deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j])
+		if err != nil {
+			return OpeningProof{}, fr.Element{}, err
+		}
+		var xInv, x2, xInv2 fr.Element
+		xInv.Inverse(&x)
+		x2.Square(&x)
+		xInv2.Square(&xInv)
+
+		// the blinding of P' = x²·L + P + x⁻²·R is x²·l + r + x⁻²·r
+		l.Mul(&l, &x2)
+		r.Mul(&r, &xInv2)
+		blinding.Add(&blinding, &l).Add(&blinding, &r)
+
+		foldScalars(aLo, aHi, &x, &xInv)
+		foldScalars(bLo, bHi, &xInv, &x)
+		G = foldBases(gLo, gHi, &xInv, &x)
+		a, b = aLo, bLo
+		n = m
+	}
+
+	// prove knowledge of a, ρ such that P_f = a·(G_f + b_f·U') + ρ·Q
+	var k1, k2 fr.Element
+	if _, err := k1.SetRandom(); err != nil {
+		return OpeningProof{}, fr.Element{}, err
+	}
+	if _, err := k2.SetRandom(); err != nil {
+		return OpeningProof{}, fr.Element{}, err
+	}
+	var k1b fr.Element
+	k1b.Mul(&k1, &b[0])
+	if _, err := proof.T.MultiExp([]curve.G1Affine{G[0], uPrime, ck.Q}, []fr.Element{k1, k1b, k2}, ecc.MultiExpConfig{}); err != nil {
+		return OpeningProof{}, fr.Element{}, err
+	}
+	c, err := deriveChallenge(fs, "c", &proof.T)
+	if err != nil {
+		return OpeningProof{}, fr.Element{}, err
+	}
+	proof.Z1.Mul(&c, &a[0]).Add(&proof.Z1, &k1)
+	proof.Z2.Mul(&c, &blinding).Add(&proof.Z2, &k2)
+
+	return proof, v, nil
+}
+
+// Verify checks that proof is a valid [OpeningProof] of ⟨a, b⟩ = v for the
+// vector a committed in commitment. dataTranscript must be the same as the
+// one given to the prover.
+func Verify(ck *CommitmentKey, commitment *Digest, b []fr.Element, v fr.Element, proof *OpeningProof, hf hash.Hash, dataTranscript ...[]byte) error {
+	n := len(b)
+	if n == 0 || n&(n-1) != 0 {
+		return ErrInvalidSize
+	}
+	if n > len(ck.G) {
+		return ErrCommitmentKeyTooSmall
+	}
+	nbRounds := bits.TrailingZeros(uint(n))
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return ErrInvalidProofSize
+	}
+	fs := fiatshamir.NewTranscript(hf, openingChallengeNames(nbRounds)...)
+	xi, err := deriveOpeningChallenge(fs, commitment, b, &v, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	challenges := make([]fr.Element, nbRounds)
+	for j := range challenges {
+		if challenges[j], err = deriveChallenge(fs, "x"+strconv.Itoa(j), &proof.L[j], &proof.R[j]); err != nil {
+			return err
+		}
+	}
+	c, err := deriveChallenge(fs, "c", &proof.T)
+	if err != nil {
+		return err
+	}
+	s, _ := FoldingScalars(challenges)
+	bFolded := innerProduct(s, b)
+
+	// with P_f = C + v·ξ·U + ∑ⱼ (xⱼ²·Lⱼ + xⱼ⁻²·Rⱼ), check that
+	// z₁·⟨s, G⟩ + z₁·b_f·ξ·U + z₂·Q - T - c·P_f vanishes
+	bases := make([]curve.G1Affine, 0, n+2*nbRounds+4)
+	scalars := make([]fr.Element, n+2*nbRounds+4)
+	bases = append(bases, ck.G[:n]...)
+	bases = append(bases, proof.L...)
+	bases = append(bases, proof.R...)
+	bases = append(bases, ck.U, ck.Q, *commitment, proof.T)
+	for i := 0; i < n; i++ {
+		scalars[i].Mul(&proof.Z1, &s[i])
+	}
+	var minusC fr.Element
+	minusC.Neg(&c)
+	setCrossTermsScalars(scalars[n:n+2*nbRounds], challenges)
+	for i := n; i < n+2*nbRounds; i++ {
+		scalars[i].Mul(&scalars[i], &c)
+	}
+	offset := n + 2*nbRounds
+	var cv fr.Element
+	cv.Mul(&c, &v)
+	scalars[offset].Mul(&proof.Z1, &bFolded).Sub(&scalars[offset], &cv).Mul(&scalars[offset], &xi)
+	scalars[offset+1].Set(&proof.Z2)
+	scalars[offset+2].Set(&minusC)
+	scalars[offset+3].SetOne().Neg(&scalars[offset+3])
+
+	var check curve.G1Affine
+	if _, err := check.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.IsInfinity() {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// setCrossTermsScalars sets res to (-x₀², …, -x_{k-1}², -x₀⁻², …, -x_{k-1}⁻²),
+// the coefficients of the L and R terms in the verification equations.
+func setCrossTermsScalars(res []fr.Element, challenges []fr.Element) {
+	nbRounds := len(challenges)
+	for j := range challenges {
+		res[j].Square(&challenges[j])
+	}
+	copy(res[nbRounds:], fr.BatchInvert(res[:nbRounds]))
+	for j := range res {
+		res[j].Neg(&res[j])
+	}
+}
+
+// foldScalars sets lo[i] = sLo·lo[i] + sHi·hi[i]
+func foldScalars(lo, hi []fr.Element, sLo, sHi *fr.Element) {
+	parallel.Execute(len(lo), func(start, end int) {
+		var t fr.Element
+		for i := start; i < end; i++ {
+			t.Mul(&hi[i], sHi)
+			lo[i].Mul(&lo[i], sLo).Add(&lo[i], &t)
+		}
+	})
+}
+
+// foldBases returns the vector sLo·lo[i] + sHi·hi[i]
+func foldBases(lo, hi []curve.G1Affine, sLo, sHi *fr.Element) []curve.G1Affine {
+	var bLo, bHi big.Int
+	sLo.BigInt(&bLo)
+	sHi.BigInt(&bHi)
+	res := make([]curve.G1Jac, len(lo))
+	parallel.Execute(len(lo), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].JointScalarMultiplication(&lo[i], &hi[i], &bLo, &bHi)
+		}
+	})
+	return curve.BatchJacobianToAffineG1(res)
+}
+
+// innerProduct returns ⟨a, b⟩
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// challengeNames returns the names of the challenges of an inner-product
+// argument with nbRounds rounds.
+func challengeNames(nbRounds int) []string {
+	res := make([]string, nbRounds)
+	for j := range res {
+		res[j] = "x" + strconv.Itoa(j)
+	}
+	return res
+}
+
+// newTranscript returns the transcript of an inner-product argument with
+// nbRounds rounds, with the first challenge bound to dataTranscript.
+func newTranscript(hf hash.Hash, nbRounds int, dataTranscript ...[]byte) (*fiatshamir.Transcript, error) {
+	fs := fiatshamir.NewTranscript(hf, challengeNames(nbRounds)...)
+	if nbRounds == 0 {
+		return fs, nil
+	}
+	return fs, bindAll(fs, "x0", dataTranscript...)
+}
+
+// openingChallengeNames returns the names of the challenges of an opening
+// proof with nbRounds rounds.
+func openingChallengeNames(nbRounds int) []string {
+	res := make([]string, 0, nbRounds+2)
+	res = append(res, "xi")
+	res = append(res, challengeNames(nbRounds)...)
+	return append(res, "c")
+}
+
+// deriveOpeningChallenge binds the opening statement and returns ξ.
+func deriveOpeningChallenge(fs *fiatshamir.Transcript, commitment *Digest, b []fr.Element, v *fr.Element, dataTranscript ...[]byte) (fr.Element, error) {
+	if err := fs.Bind("xi", commitment.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range b {
+		if err := fs.Bind("xi", b[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	if err := fs.Bind("xi", v.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	if err := bindAll(fs, "xi", dataTranscript...); err != nil {
+		return fr.Element{}, err
+	}
+	return deriveChallenge(fs, "xi")
+}
+
+// deriveChallenge binds the points to the challenge named id, and returns it.
+func deriveChallenge(fs *fiatshamir.Transcript, id string, points ...*curve.G1Affine) (fr.Element, error) {
+	for _, p := range points {
+		if err := fs.Bind(id, p.Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var x fr.Element
+	x.SetBytes(b)
+	if x.IsZero() {
+		return fr.Element{}, ErrZeroChallenge
+	}
+	return x, nil
+}
+
+func bindAll(fs *fiatshamir.Transcript, id string, data ...[]byte) error {
+	for i := range data {
+		if err := fs.Bind(id, data[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
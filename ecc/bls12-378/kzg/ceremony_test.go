@@ -0,0 +1,122 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+)
+
+func TestContributions(t *testing.T) {
+	const size = 17
+	const nbContributions = 3
+
+	srs, err := NewSRS(size, big.NewInt(1))
+	require.NoError(t, err)
+	contributions := make([]Contribution, nbContributions)
+	for i := range contributions {
+		contributions[i], err = Contribute(srs)
+		require.NoError(t, err)
+	}
+	require.NoError(t, VerifyContributions(srs, contributions))
+
+	t.Run("commitment", func(t *testing.T) {
+		f := randomPolynomial(size)
+		var point fr.Element
+		point.SetRandom()
+		digest, err := Commit(f, srs.Pk)
+		require.NoError(t, err)
+		proof, err := Open(f, point, srs.Pk)
+		require.NoError(t, err)
+		require.NoError(t, Verify(&digest, &proof, point, srs.Vk))
+	})
+
+	t.Run("no contribution", func(t *testing.T) {
+		require.ErrorIs(t, VerifyContributions(srs, nil), ErrNoContribution)
+	})
+
+	t.Run("wrong order", func(t *testing.T) {
+		swapped := append([]Contribution{}, contributions...)
+		swapped[0], swapped[1] = swapped[1], swapped[0]
+		require.Error(t, VerifyContributions(srs, swapped))
+	})
+
+	t.Run("missing contribution", func(t *testing.T) {
+		require.Error(t, VerifyContributions(srs, contributions[1:]))
+		require.Error(t, VerifyContributions(srs, contributions[:nbContributions-1]))
+	})
+
+	t.Run("tampered proof of knowledge", func(t *testing.T) {
+		tampered := append([]Contribution{}, contributions...)
+		tampered[1].XR.Double(&tampered[1].XR)
+		require.ErrorIs(t, VerifyContributions(srs, tampered), ErrInvalidContribution)
+	})
+
+	t.Run("tampered srs", func(t *testing.T) {
+		tampered := *srs
+		tampered.Pk.G1 = append(tampered.Pk.G1[:0:0], srs.Pk.G1...)
+		tampered.Pk.G1[size/2].Double(&tampered.Pk.G1[size/2])
+		require.ErrorIs(t, VerifyContributions(&tampered, contributions), ErrInvalidSRS)
+	})
+}
+
+func TestVerifyContribution(t *testing.T) {
+	srs, err := NewSRS(4, big.NewInt(1))
+	require.NoError(t, err)
+	previousTau := srs.Pk.G1[1]
+	c, err := Contribute(srs)
+	require.NoError(t, err)
+	require.NoError(t, VerifyContribution(&previousTau, &c))
+
+	// the proof of knowledge is bound to the previous [τ]G₁
+	require.ErrorIs(t, VerifyContribution(&c.XG1, &c), ErrInvalidContribution)
+
+	// a contribution with x = 0 would erase the secret
+	var zero fr.Element
+	_, err = contribute(srs, &zero)
+	require.ErrorIs(t, err, ErrInvalidContribution)
+}
+
+func TestCheckSRS(t *testing.T) {
+	require.NoError(t, CheckSRS(testSrs))
+
+	tampered := *testSrs
+	tampered.Pk.G1 = append(testSrs.Pk.G1[:0:0], testSrs.Pk.G1...)
+	tampered.Pk.G1[0], tampered.Pk.G1[1] = tampered.Pk.G1[1], tampered.Pk.G1[0]
+	require.ErrorIs(t, CheckSRS(&tampered), ErrInvalidSRS)
+
+	tampered.Pk.G1 = append(testSrs.Pk.G1[:0:0], testSrs.Pk.G1...)
+	tampered.Pk.G1 = append(tampered.Pk.G1, tampered.Pk.G1[len(tampered.Pk.G1)-1])
+	require.ErrorIs(t, CheckSRS(&tampered), ErrInvalidSRS)
+
+	tampered.Pk.G1 = testSrs.Pk.G1
+	tampered.Vk.G2[1].Double(&tampered.Vk.G2[1])
+	require.ErrorIs(t, CheckSRS(&tampered), ErrInvalidSRS)
+}
+
+func TestSerializationContribution(t *testing.T) {
+	srs, err := NewSRS(4, big.NewInt(1))
+	require.NoError(t, err)
+	c, err := Contribute(srs)
+	require.NoError(t, err)
+	t.Run("contribution round-trip", testutils.SerializationRoundTrip(&c))
+}
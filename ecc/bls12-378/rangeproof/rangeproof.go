@@ -0,0 +1,518 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package rangeproof
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/ipa"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidNbBits        = errors.New("number of bits must be a power of two between 1 and 64")
+	ErrInvalidAggregation   = errors.New("number of values must be a non-zero power of two not larger than the maximum aggregation")
+	ErrValueOutOfRange      = errors.New("value is out of range")
+	ErrInvalidNbBlindings   = errors.New("number of blindings must match the number of values")
+	ErrInvalidNbCommitments = errors.New("number of commitment vectors must match the number of proofs")
+	ErrVerifyRangeProof     = errors.New("can't verify range proof")
+)
+
+// Params are the public parameters of the range proofs. They are derived
+// deterministically from a domain separation tag, see [NewParams].
+type Params struct {
+	NbBits         int               // proofs show that values are in [0, 2^NbBits)
+	MaxAggregation int               // maximum number of values in an aggregated proof
+	B              curve.G1Affine    // value base of the Pedersen commitments
+	Ck             ipa.CommitmentKey // vector bases of size NbBits·MaxAggregation; Ck.Q is the blinding base of the commitments
+}
+
+// Proof is an aggregated range proof for m values.
+type Proof struct {
+	A, S           curve.G1Affine        // commitments to the bits of the values and to the blinding vectors
+	T1, T2         curve.G1Affine        // commitments to the coefficients of t(X) = ⟨l(X), r(X)⟩
+	TauX, Mu, THat fr.Element            // blinding of t(x), blinding of A + x·S, and t(x)
+	IPA            ipa.InnerProductProof // proof of ⟨l(x), r(x)⟩ = t(x)
+}
+
+// NewParams returns the parameters for range proofs of values in
+// [0, 2^nbBits), aggregated by at most maxAggregation values. nbBits and
+// maxAggregation must be powers of two, and nbBits at most 64.
+func NewParams(nbBits, maxAggregation int, domainSeparator []byte) (Params, error) {
+	if nbBits <= 0 || nbBits > 64 || nbBits&(nbBits-1) != 0 {
+		return Params{}, ErrInvalidNbBits
+	}
+	if maxAggregation <= 0 || maxAggregation&(maxAggregation-1) != 0 {
+		return Params{}, ErrInvalidAggregation
+	}
+	params := Params{
+		NbBits:         nbBits,
+		MaxAggregation: maxAggregation,
+	}
+	var err error
+	if params.B, err = curve.HashToG1([]byte("B"), domainSeparator); err != nil {
+		return Params{}, err
+	}
+	if params.Ck, err = ipa.NewCommitmentKey(nbBits*maxAggregation, domainSeparator); err != nil {
+		return Params{}, err
+	}
+	return params, nil
+}
+
+// Commit returns the Pedersen commitment v·B + γ·Q to the value v. γ must be
+// sampled uniformly at random.
+func (params *Params) Commit(v uint64, gamma fr.Element) curve.G1Affine {
+	var res curve.G1Affine
+	var bv big.Int
+	bv.SetUint64(v)
+	res.ScalarMultiplication(&params.B, &bv)
+	var blinding curve.G1Affine
+	var bGamma big.Int
+	blinding.ScalarMultiplication(&params.Ck.Q, gamma.BigInt(&bGamma))
+	return *res.Add(&res, &blinding)
+}
+
+// Prove returns an aggregated proof that each value lies in
+// [0, 2^params.NbBits), along with the commitments Vⱼ = valuesⱼ·B + gammasⱼ·Q.
+// The number of values must be a power of two, at most params.MaxAggregation.
+func Prove(params *Params, values []uint64, gammas []fr.Element, hf hash.Hash) (Proof, []curve.G1Affine, error) {
+	m, n := len(values), params.NbBits
+	if m == 0 || m&(m-1) != 0 || m > params.MaxAggregation {
+		return Proof{}, nil, ErrInvalidAggregation
+	}
+	if len(gammas) != m {
+		return Proof{}, nil, ErrInvalidNbBlindings
+	}
+	for _, v := range values {
+		if n < 64 && v>>n != 0 {
+			return Proof{}, nil, ErrValueOutOfRange
+		}
+	}
+	N := n * m
+	G, H := params.Ck.G[:N], params.Ck.H[:N]
+
+	commitments := make([]curve.G1Affine, m)
+	for j := range values {
+		commitments[j] = params.Commit(values[j], gammas[j])
+	}
+
+	var proof Proof
+	fs := fiatshamir.NewTranscript(hf, "y", "z", "x", "w")
+
+	// aL holds the bits of the values, aR = aL - 1
+	aL := make([]fr.Element, N)
+	aR := make([]fr.Element, N)
+	var one fr.Element
+	one.SetOne()
+	for j, v := range values {
+		for k := 0; k < n; k++ {
+			aL[j*n+k].SetUint64((v >> k) & 1)
+			aR[j*n+k].Sub(&aL[j*n+k], &one)
+		}
+	}
+
+	// A = ⟨aL, G⟩ + ⟨aR, H⟩ + α·Q and S = ⟨sL, G⟩ + ⟨sR, H⟩ + ρ·Q
+	var alpha, rho fr.Element
+	sL, sR := make([]fr.Element, N), make([]fr.Element, N)
+	for i := 0; i < N; i++ {
+		if _, err := sL[i].SetRandom(); err != nil {
+			return Proof{}, nil, err
+		}
+		if _, err := sR[i].SetRandom(); err != nil {
+			return Proof{}, nil, err
+		}
+	}
+	if _, err := alpha.SetRandom(); err != nil {
+		return Proof{}, nil, err
+	}
+	if _, err := rho.SetRandom(); err != nil {
+		return Proof{}, nil, err
+	}
+	if err := vectorCommit(&proof.A, G, H, &params.Ck.Q, aL, aR, &alpha); err != nil {
+		return Proof{}, nil, err
+	}
+	if err := vectorCommit(&proof.S, G, H, &params.Ck.Q, sL, sR, &rho); err != nil {
+		return Proof{}, nil, err
+	}
+
+	y, z, err := deriveYZ(fs, params, commitments, &proof)
+	if err != nil {
+		return Proof{}, nil, err
+	}
+
+	// l(X) = (aL - z·1) + sL·X
+	// r(X) = yᴺ ∘ (aR + z·1 + sR·X) + ∑ⱼ z²⁺ʲ·(0ʲⁿ ‖ 2ⁿ ‖ 0⁽ᵐ⁻ʲ⁻¹⁾ⁿ)
+	yPow := powers(&y, N)
+	zPow := powers(&z, m+3) // zPow[2+j] = z²⁺ʲ
+	twoPow := powersOfTwo(n)
+	l0, r0, r1 := make([]fr.Element, N), make([]fr.Element, N), make([]fr.Element, N)
+	var t fr.Element
+	for j := 0; j < m; j++ {
+		for k := 0; k < n; k++ {
+			i := j*n + k
+			l0[i].Sub(&aL[i], &z)
+			r0[i].Add(&aR[i], &z).Mul(&r0[i], &yPow[i])
+			t.Mul(&zPow[2+j], &twoPow[k])
+			r0[i].Add(&r0[i], &t)
+			r1[i].Mul(&sR[i], &yPow[i])
+		}
+	}
+
+	// t(X) = ⟨l(X), r(X)⟩ = t₀ + t₁·X + t₂·X²
+	var t1, t2 fr.Element
+	t1 = innerProduct(l0, r1)
+	t = innerProduct(sL, r0)
+	t1.Add(&t1, &t)
+	t2 = innerProduct(sL, r1)
+
+	var tau1, tau2 fr.Element
+	if _, err := tau1.SetRandom(); err != nil {
+		return Proof{}, nil, err
+	}
+	if _, err := tau2.SetRandom(); err != nil {
+		return Proof{}, nil, err
+	}
+	if _, err := proof.T1.MultiExp([]curve.G1Affine{params.B, params.Ck.Q}, []fr.Element{t1, tau1}, ecc.MultiExpConfig{}); err != nil {
+		return Proof{}, nil, err
+	}
+	if _, err := proof.T2.MultiExp([]curve.G1Affine{params.B, params.Ck.Q}, []fr.Element{t2, tau2}, ecc.MultiExpConfig{}); err != nil {
+		return Proof{}, nil, err
+	}
+
+	x, err := deriveX(fs, &proof)
+	if err != nil {
+		return Proof{}, nil, err
+	}
+
+	// l = l(x), r = r(x), t̂ = ⟨l, r⟩
+	l, r := l0, r0
+	for i := 0; i < N; i++ {
+		t.Mul(&sL[i], &x)
+		l[i].Add(&l[i], &t)
+		t.Mul(&r1[i], &x)
+		r[i].Add(&r[i], &t)
+	}
+	proof.THat = innerProduct(l, r)
+
+	// τₓ = τ₂·x² + τ₁·x + ∑ⱼ z²⁺ʲ·γⱼ and μ = α + ρ·x
+	proof.TauX.Mul(&tau2, &x).Add(&proof.TauX, &tau1).Mul(&proof.TauX, &x)
+	for j := range gammas {
+		t.Mul(&zPow[2+j], &gammas[j])
+		proof.TauX.Add(&proof.TauX, &t)
+	}
+	proof.Mu.Mul(&rho, &x).Add(&proof.Mu, &alpha)
+
+	w, wBytes, err := deriveW(fs, &proof)
+	if err != nil {
+		return Proof{}, nil, err
+	}
+
+	// run the inner-product argument on G, H' = y⁻ᴺ ∘ H and U' = w·U
+	var uPrime curve.G1Affine
+	var bw big.Int
+	uPrime.ScalarMultiplication(&params.Ck.U, w.BigInt(&bw))
+	var yInv fr.Element
+	yInv.Inverse(&y)
+	hPrime := scaleBases(H, powers(&yInv, N))
+	if proof.IPA, err = ipa.ProveInnerProduct(G, hPrime, &uPrime, l, r, hf, wBytes); err != nil {
+		return Proof{}, nil, err
+	}
+
+	return proof, commitments, nil
+}
+
+// Verify checks an aggregated range proof for the given commitments.
+func Verify(params *Params, commitments []curve.G1Affine, proof *Proof, hf hash.Hash) error {
+	return BatchVerify(params, [][]curve.G1Affine{commitments}, []Proof{*proof}, hf)
+}
+
+// BatchVerify checks many range proofs at once, proofs[i] being a proof for
+// commitments[i]. The verification equations of all the proofs are combined
+// with random coefficients into a single multi-scalar multiplication.
+func BatchVerify(params *Params, commitments [][]curve.G1Affine, proofs []Proof, hf hash.Hash) error {
+	if len(commitments) != len(proofs) {
+		return ErrInvalidNbCommitments
+	}
+	n := params.NbBits
+	maxN := 0
+	for i := range commitments {
+		m := len(commitments[i])
+		if m == 0 || m&(m-1) != 0 || m > params.MaxAggregation {
+			return ErrInvalidAggregation
+		}
+		if n*m > maxN {
+			maxN = n * m
+		}
+	}
+
+	// scalars of the bases shared by all the proofs
+	gScalars := make([]fr.Element, maxN)
+	hScalars := make([]fr.Element, maxN)
+	var bScalar, qScalar, uScalar fr.Element
+
+	// per-proof bases and scalars
+	var points []curve.G1Affine
+	var scalars []fr.Element
+
+	twoPow := powersOfTwo(n)
+	var sumTwoPow fr.Element // 2ⁿ - 1
+	for k := range twoPow {
+		sumTwoPow.Add(&sumTwoPow, &twoPow[k])
+	}
+
+	var e, c, t, u fr.Element
+	for p := range proofs {
+		proof := &proofs[p]
+		V := commitments[p]
+		m := len(V)
+		N := n * m
+
+		fs := fiatshamir.NewTranscript(hf, "y", "z", "x", "w")
+		y, z, err := deriveYZ(fs, params, V, proof)
+		if err != nil {
+			return err
+		}
+		x, err := deriveX(fs, proof)
+		if err != nil {
+			return err
+		}
+		w, wBytes, err := deriveW(fs, proof)
+		if err != nil {
+			return err
+		}
+		challenges, err := proof.IPA.Challenges(N, hf, wBytes)
+		if err != nil {
+			return err
+		}
+		s, sInv := ipa.FoldingScalars(challenges)
+
+		// e weights the proof in the batch, c the polynomial identity within the proof
+		if len(proofs) == 1 {
+			e.SetOne()
+		} else if _, err := e.SetRandom(); err != nil {
+			return err
+		}
+		if _, err := c.SetRandom(); err != nil {
+			return err
+		}
+		var ec fr.Element
+		ec.Mul(&e, &c)
+
+		var yInv fr.Element
+		yInv.Inverse(&y)
+		yPow := powers(&y, N)
+		yInvPow := powers(&yInv, N)
+		zPow := powers(&z, m+3)
+
+		// G: e·(a·sᵢ + z)
+		// H: e·(b·sᵢ⁻¹·y⁻ⁱ - z - z²⁺ʲ·2ᵏ·y⁻ⁱ)
+		var ez, eA, eB fr.Element
+		ez.Mul(&e, &z)
+		eA.Mul(&e, &proof.IPA.A)
+		eB.Mul(&e, &proof.IPA.B)
+		for j := 0; j < m; j++ {
+			for k := 0; k < n; k++ {
+				i := j*n + k
+				t.Mul(&eA, &s[i]).Add(&t, &ez)
+				gScalars[i].Add(&gScalars[i], &t)
+
+				t.Mul(&eB, &sInv[i])
+				u.Mul(&e, &zPow[2+j]).Mul(&u, &twoPow[k])
+				t.Sub(&t, &u).Mul(&t, &yInvPow[i]).Sub(&t, &ez)
+				hScalars[i].Add(&hScalars[i], &t)
+			}
+		}
+
+		// B: e·c·(t̂ - δ(y, z)) with δ(y, z) = (z - z²)·⟨1, yᴺ⟩ - ∑ⱼ z³⁺ʲ·⟨1, 2ⁿ⟩
+		var delta, sumY fr.Element
+		for i := range yPow {
+			sumY.Add(&sumY, &yPow[i])
+		}
+		delta.Sub(&z, &zPow[2]).Mul(&delta, &sumY)
+		for j := 0; j < m; j++ {
+			t.Mul(&zPow[2+j], &z).Mul(&t, &sumTwoPow)
+			delta.Sub(&delta, &t)
+		}
+		t.Sub(&proof.THat, &delta).Mul(&t, &ec)
+		bScalar.Add(&bScalar, &t)
+
+		// Q: e·(μ + c·τₓ)
+		t.Mul(&ec, &proof.TauX)
+		u.Mul(&e, &proof.Mu)
+		qScalar.Add(&qScalar, &t).Add(&qScalar, &u)
+
+		// U: e·w·(a·b - t̂)
+		t.Mul(&proof.IPA.A, &proof.IPA.B).Sub(&t, &proof.THat).Mul(&t, &w).Mul(&t, &e)
+		uScalar.Add(&uScalar, &t)
+
+		// A: -e, S: -e·x, T₁: -e·c·x, T₂: -e·c·x², Vⱼ: -e·c·z²⁺ʲ
+		points = append(points, proof.A, proof.S, proof.T1, proof.T2)
+		var minusE, minusEC fr.Element
+		minusE.Neg(&e)
+		minusEC.Neg(&ec)
+		t.Mul(&minusE, &x)
+		u.Mul(&minusEC, &x)
+		scalars = append(scalars, minusE, t, u)
+		u.Mul(&u, &x)
+		scalars = append(scalars, u)
+		for j := range V {
+			points = append(points, V[j])
+			t.Mul(&minusEC, &zPow[2+j])
+			scalars = append(scalars, t)
+		}
+
+		// Lₖ: -e·xₖ², Rₖ: -e·xₖ⁻²
+		x2 := make([]fr.Element, len(challenges))
+		for k := range challenges {
+			x2[k].Square(&challenges[k])
+		}
+		x2Inv := fr.BatchInvert(x2)
+		for k := range challenges {
+			points = append(points, proof.IPA.L[k], proof.IPA.R[k])
+			t.Mul(&minusE, &x2[k])
+			u.Mul(&minusE, &x2Inv[k])
+			scalars = append(scalars, t, u)
+		}
+	}
+
+	points = append(points, params.Ck.G[:maxN]...)
+	points = append(points, params.Ck.H[:maxN]...)
+	points = append(points, params.B, params.Ck.Q, params.Ck.U)
+	scalars = append(scalars, gScalars...)
+	scalars = append(scalars, hScalars...)
+	scalars = append(scalars, bScalar, qScalar, uScalar)
+
+	var check curve.G1Affine
+	if _, err := check.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.IsInfinity() {
+		return ErrVerifyRangeProof
+	}
+	return nil
+}
+
+// vectorCommit sets res = ⟨a, G⟩ + ⟨b, H⟩ + r·Q
+func vectorCommit(res *curve.G1Affine, G, H []curve.G1Affine, Q *curve.G1Affine, a, b []fr.Element, r *fr.Element) error {
+	bases := make([]curve.G1Affine, 0, len(G)+len(H)+1)
+	bases = append(bases, G...)
+	bases = append(bases, H...)
+	bases = append(bases, *Q)
+	scalars := make([]fr.Element, 0, len(a)+len(b)+1)
+	scalars = append(scalars, a...)
+	scalars = append(scalars, b...)
+	scalars = append(scalars, *r)
+	_, err := res.MultiExp(bases, scalars, ecc.MultiExpConfig{})
+	return err
+}
+
+// scaleBases returns (scalars[i]·bases[i])ᵢ
+func scaleBases(bases []curve.G1Affine, scalars []fr.Element) []curve.G1Affine {
+	res := make([]curve.G1Jac, len(bases))
+	parallel.Execute(len(bases), func(start, end int) {
+		var s big.Int
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&bases[i])
+			res[i].ScalarMultiplication(&res[i], scalars[i].BigInt(&s))
+		}
+	})
+	return curve.BatchJacobianToAffineG1(res)
+}
+
+// deriveYZ binds the statement, A and S, and returns the challenges y and z.
+func deriveYZ(fs *fiatshamir.Transcript, params *Params, commitments []curve.G1Affine, proof *Proof) (y, z fr.Element, err error) {
+	var sizes [8]byte
+	binary.BigEndian.PutUint32(sizes[:4], uint32(params.NbBits))
+	binary.BigEndian.PutUint32(sizes[4:], uint32(len(commitments)))
+	toBind := [][]byte{sizes[:], params.B.Marshal(), params.Ck.Q.Marshal()}
+	for i := range commitments {
+		toBind = append(toBind, commitments[i].Marshal())
+	}
+	toBind = append(toBind, proof.A.Marshal(), proof.S.Marshal())
+	if y, _, err = deriveChallenge(fs, "y", toBind...); err != nil {
+		return
+	}
+	z, _, err = deriveChallenge(fs, "z")
+	return
+}
+
+// deriveX binds T₁ and T₂, and returns the challenge x.
+func deriveX(fs *fiatshamir.Transcript, proof *Proof) (fr.Element, error) {
+	x, _, err := deriveChallenge(fs, "x", proof.T1.Marshal(), proof.T2.Marshal())
+	return x, err
+}
+
+// deriveW binds τₓ, μ and t̂, and returns the challenge w and its binary
+// form, which seeds the transcript of the inner-product argument.
+func deriveW(fs *fiatshamir.Transcript, proof *Proof) (fr.Element, []byte, error) {
+	return deriveChallenge(fs, "w", proof.TauX.Marshal(), proof.Mu.Marshal(), proof.THat.Marshal())
+}
+
+func deriveChallenge(fs *fiatshamir.Transcript, id string, toBind ...[]byte) (fr.Element, []byte, error) {
+	for i := range toBind {
+		if err := fs.Bind(id, toBind[i]); err != nil {
+			return fr.Element{}, nil, err
+		}
+	}
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return fr.Element{}, nil, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, nil, ipa.ErrZeroChallenge
+	}
+	return res, b, nil
+}
+
+// powers returns [1, x, x², …, xⁿ⁻¹]
+func powers(x *fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], x)
+	}
+	return res
+}
+
+// powersOfTwo returns [1, 2, 4, …, 2ⁿ⁻¹]
+func powersOfTwo(n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Double(&res[i-1])
+	}
+	return res
+}
+
+// innerProduct returns ⟨a, b⟩
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
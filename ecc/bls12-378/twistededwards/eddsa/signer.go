@@ -0,0 +1,53 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"crypto"
+	"errors"
+	"hash"
+	"io"
+)
+
+var errPreHashed = errors.New("eddsa can't sign a pre-hashed message")
+
+// CryptoSigner returns an adapter of privKey to the crypto.Signer interface of
+// the standard library. As with crypto/ed25519, the message is signed as is
+// (opts.HashFunc() must be 0): it is hashed with a new instance of hFunc
+// (e.g. hash.MIMC_BLS12_378.New) for each signature. The public key is a
+// *PublicKey.
+func (privKey *PrivateKey) CryptoSigner(hFunc func() hash.Hash) crypto.Signer {
+	return &cryptoSigner{privKey: privKey, hFunc: hFunc}
+}
+
+type cryptoSigner struct {
+	privKey *PrivateKey
+	hFunc   func() hash.Hash
+}
+
+// Public returns the public key of the signer.
+func (s *cryptoSigner) Public() crypto.PublicKey {
+	return s.privKey.Public()
+}
+
+// Sign signs message, see PrivateKey.Sign. rand is not used.
+func (s *cryptoSigner) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts != nil && opts.HashFunc() != 0 {
+		return nil, errPreHashed
+	}
+	return s.privKey.Sign(message, s.hFunc())
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package elgamal
+
+import (
+	"errors"
+	"math"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/twistededwards"
+)
+
+var (
+	ErrInvalidBound = errors.New("bound must be positive")
+	ErrDLogNotFound = errors.New("discrete logarithm is out of the range of the table")
+)
+
+// DLogTable is a precomputed table of baby steps, to compute small discrete
+// logarithms in base B with the baby-step giant-step algorithm. A table for
+// the bound n holds ⌈√n⌉ points, and a lookup costs up to ⌈√n⌉ additions.
+//
+// The table is read-only once built, and can be shared between goroutines.
+type DLogTable struct {
+	bound     uint64
+	m         uint64                                // number of baby steps
+	babySteps map[twistededwards.PointAffine]uint64 // [j]B → j, for j < m
+	giantStep twistededwards.PointAffine            // [-m]B
+}
+
+// NewDLogTable returns a table to compute discrete logarithms in [0, bound).
+func NewDLogTable(bound uint64) (*DLogTable, error) {
+	if bound == 0 {
+		return nil, ErrInvalidBound
+	}
+	m := uint64(math.Ceil(math.Sqrt(float64(bound))))
+	for m*m < bound {
+		m++
+	}
+	params := twistededwards.GetEdwardsCurve()
+
+	t := &DLogTable{
+		bound:     bound,
+		m:         m,
+		babySteps: make(map[twistededwards.PointAffine]uint64, m),
+	}
+	var p twistededwards.PointAffine
+	p.Y.SetOne()
+	for j := uint64(0); j < m; j++ {
+		t.babySteps[p] = j
+		p.Add(&p, &params.Base)
+	}
+	// p = [m]B
+	t.giantStep.Neg(&p)
+	return t, nil
+}
+
+// Bound returns the bound of the table: the discrete logarithms in
+// [0, Bound()) can be computed.
+func (t *DLogTable) Bound() uint64 {
+	return t.bound
+}
+
+// DLog returns m in [0, Bound()) such that p = [m]B, or ErrDLogNotFound.
+func (t *DLogTable) DLog(p *twistededwards.PointAffine) (uint64, error) {
+	// the logarithm is i·m + j, for the first i such that p - [i·m]B = [j]B
+	var q twistededwards.PointAffine
+	q.Set(p)
+	for i := uint64(0); i*t.m < t.bound; i++ {
+		if j, ok := t.babySteps[q]; ok {
+			if res := i*t.m + j; res < t.bound {
+				return res, nil
+			}
+			return 0, ErrDLogNotFound
+		}
+		q.Add(&q, &t.giantStep)
+	}
+	return 0, ErrDLogNotFound
+}
@@ -0,0 +1,918 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7d58596)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10000    = 1 + _1111
+	//	_10001    = 1 + _10000
+	//	_10101    = _101 + _10000
+	//	_10111    = _10 + _10101
+	//	_11011    = _1011 + _10000
+	//	_11101    = _10 + _11011
+	//	_111010   = 2*_11101
+	//	_111111   = _101 + _111010
+	//	_11111100 = _111111 << 2
+	//	_11111111 = _11 + _11111100
+	//	i32       = ((_1011 + _11111100) << 6 + _1111) << 4 + _111
+	//	i51       = ((i32 << 5 + _111) << 7 + _10001) << 5
+	//	i64       = ((_11011 + i51) << 5 + _11011) << 5 + _11011
+	//	i83       = ((i64 << 7 + _10111) << 5 + _1011) << 5
+	//	i98       = ((_11 + i83) << 9 + _11101) << 3 + _111
+	//	i122      = ((i98 << 12 + _11111111) << 5 + _1101) << 5
+	//	i138      = ((_111 + i122) << 7 + _1111) << 6 + _11011
+	//	i158      = ((i138 << 4 + _1011) << 12 + _11111111) << 2
+	//	i171      = ((_11 + i158) << 6 + _11011) << 4 + _1101
+	//	i188      = ((i171 << 5 + _1001) << 5 + _101) << 5
+	//	i209      = ((_11 + i188) << 10 + _10101) << 8 + _111111
+	//	i229      = ((i209 << 6 + _11101) << 5 + _10101) << 7
+	//	i244      = ((_11101 + i229) << 7 + _10101) << 5 + _1001
+	//	i265      = ((i244 << 2 + 1) << 10 + _1101) << 7
+	//	i277      = ((_10111 + i265) << 5 + _10111) << 4 + _1101
+	//	i298      = ((i277 << 5 + _1011) << 8 + _1011) << 6
+	//	return      2*(_1011 + i298)
+	//
+	// Operations: 244 squares 56 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: t6 = x^0x3
+	t6.Mul(&x, t4)
+
+	// Step 3: t7 = x^0x5
+	t7.Mul(t4, t6)
+
+	// Step 4: t11 = x^0x7
+	t11.Mul(t4, t7)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(t4, t11)
+
+	// Step 6: z = x^0xb
+	z.Mul(t4, t2)
+
+	// Step 7: t0 = x^0xd
+	t0.Mul(t4, z)
+
+	// Step 8: t10 = x^0xf
+	t10.Mul(t4, t0)
+
+	// Step 9: t5 = x^0x10
+	t5.Mul(&x, t10)
+
+	// Step 10: t12 = x^0x11
+	t12.Mul(&x, t5)
+
+	// Step 11: t3 = x^0x15
+	t3.Mul(t7, t5)
+
+	// Step 12: t1 = x^0x17
+	t1.Mul(t4, t3)
+
+	// Step 13: t8 = x^0x1b
+	t8.Mul(z, t5)
+
+	// Step 14: t4 = x^0x1d
+	t4.Mul(t4, t8)
+
+	// Step 15: t5 = x^0x3a
+	t5.Square(t4)
+
+	// Step 16: t5 = x^0x3f
+	t5.Mul(t7, t5)
+
+	// Step 18: t13 = x^0xfc
+	t13.Square(t5)
+	for s := 1; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 19: t9 = x^0xff
+	t9.Mul(t6, t13)
+
+	// Step 20: t13 = x^0x107
+	t13.Mul(z, t13)
+
+	// Step 26: t13 = x^0x41c0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 27: t13 = x^0x41cf
+	t13.Mul(t10, t13)
+
+	// Step 31: t13 = x^0x41cf0
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 32: t13 = x^0x41cf7
+	t13.Mul(t11, t13)
+
+	// Step 37: t13 = x^0x839ee0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 38: t13 = x^0x839ee7
+	t13.Mul(t11, t13)
+
+	// Step 45: t13 = x^0x41cf7380
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 46: t12 = x^0x41cf7391
+	t12.Mul(t12, t13)
+
+	// Step 51: t12 = x^0x839ee7220
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 52: t12 = x^0x839ee723b
+	t12.Mul(t8, t12)
+
+	// Step 57: t12 = x^0x1073dce4760
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 58: t12 = x^0x1073dce477b
+	t12.Mul(t8, t12)
+
+	// Step 63: t12 = x^0x20e7b9c8ef60
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 64: t12 = x^0x20e7b9c8ef7b
+	t12.Mul(t8, t12)
+
+	// Step 71: t12 = x^0x1073dce477bd80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 72: t12 = x^0x1073dce477bd97
+	t12.Mul(t1, t12)
+
+	// Step 77: t12 = x^0x20e7b9c8ef7b2e0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 78: t12 = x^0x20e7b9c8ef7b2eb
+	t12.Mul(z, t12)
+
+	// Step 83: t12 = x^0x41cf7391def65d60
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 84: t12 = x^0x41cf7391def65d63
+	t12.Mul(t6, t12)
+
+	// Step 93: t12 = x^0x839ee723bdecbac600
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 94: t12 = x^0x839ee723bdecbac61d
+	t12.Mul(t4, t12)
+
+	// Step 97: t12 = x^0x41cf7391def65d630e8
+	for s := 0; s < 3; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 98: t12 = x^0x41cf7391def65d630ef
+	t12.Mul(t11, t12)
+
+	// Step 110: t12 = x^0x41cf7391def65d630ef000
+	for s := 0; s < 12; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 111: t12 = x^0x41cf7391def65d630ef0ff
+	t12.Mul(t9, t12)
+
+	// Step 116: t12 = x^0x839ee723bdecbac61de1fe0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 117: t12 = x^0x839ee723bdecbac61de1fed
+	t12.Mul(t0, t12)
+
+	// Step 122: t12 = x^0x1073dce477bd9758c3bc3fda0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 123: t11 = x^0x1073dce477bd9758c3bc3fda7
+	t11.Mul(t11, t12)
+
+	// Step 130: t11 = x^0x839ee723bdecbac61de1fed380
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 131: t10 = x^0x839ee723bdecbac61de1fed38f
+	t10.Mul(t10, t11)
+
+	// Step 137: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 138: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3db
+	t10.Mul(t8, t10)
+
+	// Step 142: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3db0
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 143: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb
+	t10.Mul(z, t10)
+
+	// Step 155: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb000
+	for s := 0; s < 12; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 156: t9 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ff
+	t9.Mul(t9, t10)
+
+	// Step 158: t9 = x^0x839ee723bdecbac61de1fed38f6ec3fc
+	for s := 0; s < 2; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 159: t9 = x^0x839ee723bdecbac61de1fed38f6ec3ff
+	t9.Mul(t6, t9)
+
+	// Step 165: t9 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffc0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 166: t8 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdb
+	t8.Mul(t8, t9)
+
+	// Step 170: t8 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdb0
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 171: t8 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd
+	t8.Mul(t0, t8)
+
+	// Step 176: t8 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a0
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 177: t8 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a9
+	t8.Mul(t2, t8)
+
+	// Step 182: t8 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f520
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 183: t7 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525
+	t7.Mul(t7, t8)
+
+	// Step 188: t7 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 189: t6 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3
+	t6.Mul(t6, t7)
+
+	// Step 199: t6 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c00
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 200: t6 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c15
+	t6.Mul(t3, t6)
+
+	// Step 208: t6 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c1500
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 209: t5 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c153f
+	t5.Mul(t5, t6)
+
+	// Step 215: t5 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fc0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 216: t5 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdd
+	t5.Mul(t4, t5)
+
+	// Step 221: t5 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fba0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 222: t5 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb5
+	t5.Mul(t3, t5)
+
+	// Step 229: t5 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda80
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 230: t4 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d
+	t4.Mul(t4, t5)
+
+	// Step 237: t4 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e80
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 238: t3 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e95
+	t3.Mul(t3, t4)
+
+	// Step 243: t3 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a0
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 244: t2 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a9
+	t2.Mul(t2, t3)
+
+	// Step 246: t2 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c153f76a74aa4
+	for s := 0; s < 2; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 247: t2 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c153f76a74aa5
+	t2.Mul(&x, t2)
+
+	// Step 257: t2 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a9400
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 258: t2 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d
+	t2.Mul(t0, t2)
+
+	// Step 265: t2 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e954a0680
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 266: t2 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e954a0697
+	t2.Mul(t1, t2)
+
+	// Step 271: t2 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2e0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 272: t1 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7
+	t1.Mul(t1, t2)
+
+	// Step 276: t1 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f70
+	for s := 0; s < 4; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 277: t0 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7d
+	t0.Mul(t0, t1)
+
+	// Step 282: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efa0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 283: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab
+	t0.Mul(z, t0)
+
+	// Step 291: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab00
+	for s := 0; s < 8; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 292: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab0b
+	t0.Mul(z, t0)
+
+	// Step 298: t0 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e954a0697beac2c0
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 299: z = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e954a0697beac2cb
+	z.Mul(z, t0)
+
+	// Step 300: z = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7d58596
+	z.Square(z)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab0b2b)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10000    = 1 + _1111
+	//	_10001    = 1 + _10000
+	//	_10101    = _101 + _10000
+	//	_10111    = _10 + _10101
+	//	_11011    = _1011 + _10000
+	//	_11101    = _10 + _11011
+	//	_111010   = 2*_11101
+	//	_111111   = _101 + _111010
+	//	_11111100 = _111111 << 2
+	//	_11111111 = _11 + _11111100
+	//	i32       = ((_1011 + _11111100) << 6 + _1111) << 4 + _111
+	//	i51       = ((i32 << 5 + _111) << 7 + _10001) << 5
+	//	i64       = ((_11011 + i51) << 5 + _11011) << 5 + _11011
+	//	i83       = ((i64 << 7 + _10111) << 5 + _1011) << 5
+	//	i98       = ((_11 + i83) << 9 + _11101) << 3 + _111
+	//	i122      = ((i98 << 12 + _11111111) << 5 + _1101) << 5
+	//	i138      = ((_111 + i122) << 7 + _1111) << 6 + _11011
+	//	i158      = ((i138 << 4 + _1011) << 12 + _11111111) << 2
+	//	i171      = ((_11 + i158) << 6 + _11011) << 4 + _1101
+	//	i188      = ((i171 << 5 + _1001) << 5 + _101) << 5
+	//	i209      = ((_11 + i188) << 10 + _10101) << 8 + _111111
+	//	i229      = ((i209 << 6 + _11101) << 5 + _10101) << 7
+	//	i244      = ((_11101 + i229) << 7 + _10101) << 5 + _1001
+	//	i265      = ((i244 << 2 + 1) << 10 + _1101) << 7
+	//	i277      = ((_10111 + i265) << 5 + _10111) << 4 + _1101
+	//	i299      = ((i277 << 5 + _1011) << 8 + _1011) << 7
+	//	return      2*(_10101 + i299) + 1
+	//
+	// Operations: 245 squares 57 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: t6 = x^0x3
+	t6.Mul(&x, t4)
+
+	// Step 3: t7 = x^0x5
+	t7.Mul(t4, t6)
+
+	// Step 4: t11 = x^0x7
+	t11.Mul(t4, t7)
+
+	// Step 5: t3 = x^0x9
+	t3.Mul(t4, t11)
+
+	// Step 6: t0 = x^0xb
+	t0.Mul(t4, t3)
+
+	// Step 7: t1 = x^0xd
+	t1.Mul(t4, t0)
+
+	// Step 8: t10 = x^0xf
+	t10.Mul(t4, t1)
+
+	// Step 9: t5 = x^0x10
+	t5.Mul(&x, t10)
+
+	// Step 10: t12 = x^0x11
+	t12.Mul(&x, t5)
+
+	// Step 11: z = x^0x15
+	z.Mul(t7, t5)
+
+	// Step 12: t2 = x^0x17
+	t2.Mul(t4, z)
+
+	// Step 13: t8 = x^0x1b
+	t8.Mul(t0, t5)
+
+	// Step 14: t4 = x^0x1d
+	t4.Mul(t4, t8)
+
+	// Step 15: t5 = x^0x3a
+	t5.Square(t4)
+
+	// Step 16: t5 = x^0x3f
+	t5.Mul(t7, t5)
+
+	// Step 18: t13 = x^0xfc
+	t13.Square(t5)
+	for s := 1; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 19: t9 = x^0xff
+	t9.Mul(t6, t13)
+
+	// Step 20: t13 = x^0x107
+	t13.Mul(t0, t13)
+
+	// Step 26: t13 = x^0x41c0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 27: t13 = x^0x41cf
+	t13.Mul(t10, t13)
+
+	// Step 31: t13 = x^0x41cf0
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 32: t13 = x^0x41cf7
+	t13.Mul(t11, t13)
+
+	// Step 37: t13 = x^0x839ee0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 38: t13 = x^0x839ee7
+	t13.Mul(t11, t13)
+
+	// Step 45: t13 = x^0x41cf7380
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 46: t12 = x^0x41cf7391
+	t12.Mul(t12, t13)
+
+	// Step 51: t12 = x^0x839ee7220
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 52: t12 = x^0x839ee723b
+	t12.Mul(t8, t12)
+
+	// Step 57: t12 = x^0x1073dce4760
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 58: t12 = x^0x1073dce477b
+	t12.Mul(t8, t12)
+
+	// Step 63: t12 = x^0x20e7b9c8ef60
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 64: t12 = x^0x20e7b9c8ef7b
+	t12.Mul(t8, t12)
+
+	// Step 71: t12 = x^0x1073dce477bd80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 72: t12 = x^0x1073dce477bd97
+	t12.Mul(t2, t12)
+
+	// Step 77: t12 = x^0x20e7b9c8ef7b2e0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 78: t12 = x^0x20e7b9c8ef7b2eb
+	t12.Mul(t0, t12)
+
+	// Step 83: t12 = x^0x41cf7391def65d60
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 84: t12 = x^0x41cf7391def65d63
+	t12.Mul(t6, t12)
+
+	// Step 93: t12 = x^0x839ee723bdecbac600
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 94: t12 = x^0x839ee723bdecbac61d
+	t12.Mul(t4, t12)
+
+	// Step 97: t12 = x^0x41cf7391def65d630e8
+	for s := 0; s < 3; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 98: t12 = x^0x41cf7391def65d630ef
+	t12.Mul(t11, t12)
+
+	// Step 110: t12 = x^0x41cf7391def65d630ef000
+	for s := 0; s < 12; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 111: t12 = x^0x41cf7391def65d630ef0ff
+	t12.Mul(t9, t12)
+
+	// Step 116: t12 = x^0x839ee723bdecbac61de1fe0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 117: t12 = x^0x839ee723bdecbac61de1fed
+	t12.Mul(t1, t12)
+
+	// Step 122: t12 = x^0x1073dce477bd9758c3bc3fda0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 123: t11 = x^0x1073dce477bd9758c3bc3fda7
+	t11.Mul(t11, t12)
+
+	// Step 130: t11 = x^0x839ee723bdecbac61de1fed380
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 131: t10 = x^0x839ee723bdecbac61de1fed38f
+	t10.Mul(t10, t11)
+
+	// Step 137: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 138: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3db
+	t10.Mul(t8, t10)
+
+	// Step 142: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3db0
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 143: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb
+	t10.Mul(t0, t10)
+
+	// Step 155: t10 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb000
+	for s := 0; s < 12; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 156: t9 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ff
+	t9.Mul(t9, t10)
+
+	// Step 158: t9 = x^0x839ee723bdecbac61de1fed38f6ec3fc
+	for s := 0; s < 2; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 159: t9 = x^0x839ee723bdecbac61de1fed38f6ec3ff
+	t9.Mul(t6, t9)
+
+	// Step 165: t9 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffc0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 166: t8 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdb
+	t8.Mul(t8, t9)
+
+	// Step 170: t8 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdb0
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 171: t8 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd
+	t8.Mul(t1, t8)
+
+	// Step 176: t8 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a0
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 177: t8 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a9
+	t8.Mul(t3, t8)
+
+	// Step 182: t8 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f520
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 183: t7 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525
+	t7.Mul(t7, t8)
+
+	// Step 188: t7 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 189: t6 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3
+	t6.Mul(t6, t7)
+
+	// Step 199: t6 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c00
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 200: t6 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c15
+	t6.Mul(z, t6)
+
+	// Step 208: t6 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c1500
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 209: t5 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c153f
+	t5.Mul(t5, t6)
+
+	// Step 215: t5 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fc0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 216: t5 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdd
+	t5.Mul(t4, t5)
+
+	// Step 221: t5 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fba0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 222: t5 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb5
+	t5.Mul(z, t5)
+
+	// Step 229: t5 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda80
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 230: t4 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d
+	t4.Mul(t4, t5)
+
+	// Step 237: t4 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e80
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 238: t4 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e95
+	t4.Mul(z, t4)
+
+	// Step 243: t4 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 244: t3 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a9
+	t3.Mul(t3, t4)
+
+	// Step 246: t3 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c153f76a74aa4
+	for s := 0; s < 2; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 247: t3 = x^0x41cf7391def65d630ef0ff69c7b761ffb7a928c153f76a74aa5
+	t3.Mul(&x, t3)
+
+	// Step 257: t3 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a9400
+	for s := 0; s < 10; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 258: t3 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d
+	t3.Mul(t1, t3)
+
+	// Step 265: t3 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e954a0680
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 266: t3 = x^0x839ee723bdecbac61de1fed38f6ec3ff6f525182a7eed4e954a0697
+	t3.Mul(t2, t3)
+
+	// Step 271: t3 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2e0
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 272: t2 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7
+	t2.Mul(t2, t3)
+
+	// Step 276: t2 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f70
+	for s := 0; s < 4; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 277: t1 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7d
+	t1.Mul(t1, t2)
+
+	// Step 282: t1 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efa0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 283: t1 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab
+	t1.Mul(t0, t1)
+
+	// Step 291: t1 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 292: t0 = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab0b
+	t0.Mul(t0, t1)
+
+	// Step 299: t0 = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7d58580
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 300: z = x^0x1073dce477bd9758c3bc3fda71edd87fedea4a3054fdda9d2a940d2f7d58595
+	z.Mul(z, t0)
+
+	// Step 301: z = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab0b2a
+	z.Square(z)
+
+	// Step 302: z = x^0x20e7b9c8ef7b2eb187787fb4e3dbb0ffdbd49460a9fbb53a55281a5efab0b2b
+	z.Mul(&x, z)
+
+	return z
+}
@@ -0,0 +1,880 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// mul(res, x, y *Element) sets res = x·y·R⁻¹ (mod q)
+
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $0x818a92affbf4ca99, R7
+	MOVD $0x4aa5034bdf561657, R14
+	MOVD $0xfb7a928c153f76a7, R15
+	MOVD $0x30ef0ff69c7b761f, R16
+	MOVD $0x041cf7391def65d6, R17
+	LDP  0(R0), (R10, R11)
+	LDP  16(R0), (R12, R13)
+
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R8
+	MUL   R10, R8, R2
+	MUL   R11, R8, R3
+	MUL   R12, R8, R4
+	MUL   R13, R8, R5
+	UMULH R13, R8, R6
+	UMULH R10, R8, R9
+	ADDS  R9, R3, R3
+	UMULH R11, R8, R9
+	ADCS  R9, R4, R4
+	UMULH R12, R8, R9
+	ADCS  R9, R5, R5
+	ADC   ZR, R6, R6
+	MUL   R2, R7, R8
+	MUL   R14, R8, R9
+	ADDS  R9, R2, R2
+	MUL   R15, R8, R9
+	ADCS  R9, R3, R3
+	MUL   R16, R8, R9
+	ADCS  R9, R4, R4
+	MUL   R17, R8, R9
+	ADCS  R9, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R14, R8, R9
+	ADDS  R9, R3, R3
+	UMULH R15, R8, R9
+	ADCS  R9, R4, R4
+	UMULH R16, R8, R9
+	ADCS  R9, R5, R5
+	UMULH R17, R8, R9
+	ADC   R9, R6, R6
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R8
+	MUL   R10, R8, R9
+	ADDS  R9, R3, R3
+	MUL   R11, R8, R9
+	ADCS  R9, R4, R4
+	MUL   R12, R8, R9
+	ADCS  R9, R5, R5
+	MUL   R13, R8, R9
+	ADCS  R9, R6, R6
+	ADC   ZR, R2, R2
+	UMULH R10, R8, R9
+	ADDS  R9, R4, R4
+	UMULH R11, R8, R9
+	ADCS  R9, R5, R5
+	UMULH R12, R8, R9
+	ADCS  R9, R6, R6
+	UMULH R13, R8, R9
+	ADC   R9, R2, R2
+	MUL   R3, R7, R8
+	MUL   R14, R8, R9
+	ADDS  R9, R3, R3
+	MUL   R15, R8, R9
+	ADCS  R9, R4, R4
+	MUL   R16, R8, R9
+	ADCS  R9, R5, R5
+	MUL   R17, R8, R9
+	ADCS  R9, R6, R6
+	ADC   ZR, R2, R2
+	UMULH R14, R8, R9
+	ADDS  R9, R4, R4
+	UMULH R15, R8, R9
+	ADCS  R9, R5, R5
+	UMULH R16, R8, R9
+	ADCS  R9, R6, R6
+	UMULH R17, R8, R9
+	ADC   R9, R2, R2
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R8
+	MUL   R10, R8, R9
+	ADDS  R9, R4, R4
+	MUL   R11, R8, R9
+	ADCS  R9, R5, R5
+	MUL   R12, R8, R9
+	ADCS  R9, R6, R6
+	MUL   R13, R8, R9
+	ADCS  R9, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R10, R8, R9
+	ADDS  R9, R5, R5
+	UMULH R11, R8, R9
+	ADCS  R9, R6, R6
+	UMULH R12, R8, R9
+	ADCS  R9, R2, R2
+	UMULH R13, R8, R9
+	ADC   R9, R3, R3
+	MUL   R4, R7, R8
+	MUL   R14, R8, R9
+	ADDS  R9, R4, R4
+	MUL   R15, R8, R9
+	ADCS  R9, R5, R5
+	MUL   R16, R8, R9
+	ADCS  R9, R6, R6
+	MUL   R17, R8, R9
+	ADCS  R9, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R14, R8, R9
+	ADDS  R9, R5, R5
+	UMULH R15, R8, R9
+	ADCS  R9, R6, R6
+	UMULH R16, R8, R9
+	ADCS  R9, R2, R2
+	UMULH R17, R8, R9
+	ADC   R9, R3, R3
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R8
+	MUL   R10, R8, R9
+	ADDS  R9, R5, R5
+	MUL   R11, R8, R9
+	ADCS  R9, R6, R6
+	MUL   R12, R8, R9
+	ADCS  R9, R2, R2
+	MUL   R13, R8, R9
+	ADCS  R9, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R10, R8, R9
+	ADDS  R9, R6, R6
+	UMULH R11, R8, R9
+	ADCS  R9, R2, R2
+	UMULH R12, R8, R9
+	ADCS  R9, R3, R3
+	UMULH R13, R8, R9
+	ADC   R9, R4, R4
+	MUL   R5, R7, R8
+	MUL   R14, R8, R9
+	ADDS  R9, R5, R5
+	MUL   R15, R8, R9
+	ADCS  R9, R6, R6
+	MUL   R16, R8, R9
+	ADCS  R9, R2, R2
+	MUL   R17, R8, R9
+	ADCS  R9, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R14, R8, R9
+	ADDS  R9, R6, R6
+	UMULH R15, R8, R9
+	ADCS  R9, R2, R2
+	UMULH R16, R8, R9
+	ADCS  R9, R3, R3
+	UMULH R17, R8, R9
+	ADC   R9, R4, R4
+
+	// t = t - q if t >= q
+	SUBS  R14, R6, R9
+	SBCS  R15, R2, R9
+	SBCS  R16, R3, R9
+	SBCS  R17, R4, R9
+	CSETM CS, R8
+	AND   R8, R14, R9
+	SUBS  R9, R6, R6
+	AND   R8, R15, R9
+	SBCS  R9, R2, R2
+	AND   R8, R16, R9
+	SBCS  R9, R3, R3
+	AND   R8, R17, R9
+	SBCS  R9, R4, R4
+	MOVD  res+0(FP), R0
+	STP   (R6, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	RET
+
+// fromMont(res *Element) sets res = res·R⁻¹ (mod q)
+
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	MOVD  $0x818a92affbf4ca99, R6
+	MOVD  $0x4aa5034bdf561657, R9
+	MOVD  $0xfb7a928c153f76a7, R10
+	MOVD  $0x30ef0ff69c7b761f, R11
+	MOVD  $0x041cf7391def65d6, R12
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	MOVD  ZR, R5
+	MUL   R1, R6, R7
+	MUL   R9, R7, R8
+	ADDS  R8, R1, R1
+	MUL   R10, R7, R8
+	ADCS  R8, R2, R2
+	MUL   R11, R7, R8
+	ADCS  R8, R3, R3
+	MUL   R12, R7, R8
+	ADCS  R8, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R9, R7, R8
+	ADDS  R8, R2, R2
+	UMULH R10, R7, R8
+	ADCS  R8, R3, R3
+	UMULH R11, R7, R8
+	ADCS  R8, R4, R4
+	UMULH R12, R7, R8
+	ADC   R8, R5, R5
+	MUL   R2, R6, R7
+	MUL   R9, R7, R8
+	ADDS  R8, R2, R2
+	MUL   R10, R7, R8
+	ADCS  R8, R3, R3
+	MUL   R11, R7, R8
+	ADCS  R8, R4, R4
+	MUL   R12, R7, R8
+	ADCS  R8, R5, R5
+	ADC   ZR, R1, R1
+	UMULH R9, R7, R8
+	ADDS  R8, R3, R3
+	UMULH R10, R7, R8
+	ADCS  R8, R4, R4
+	UMULH R11, R7, R8
+	ADCS  R8, R5, R5
+	UMULH R12, R7, R8
+	ADC   R8, R1, R1
+	MUL   R3, R6, R7
+	MUL   R9, R7, R8
+	ADDS  R8, R3, R3
+	MUL   R10, R7, R8
+	ADCS  R8, R4, R4
+	MUL   R11, R7, R8
+	ADCS  R8, R5, R5
+	MUL   R12, R7, R8
+	ADCS  R8, R1, R1
+	ADC   ZR, R2, R2
+	UMULH R9, R7, R8
+	ADDS  R8, R4, R4
+	UMULH R10, R7, R8
+	ADCS  R8, R5, R5
+	UMULH R11, R7, R8
+	ADCS  R8, R1, R1
+	UMULH R12, R7, R8
+	ADC   R8, R2, R2
+	MUL   R4, R6, R7
+	MUL   R9, R7, R8
+	ADDS  R8, R4, R4
+	MUL   R10, R7, R8
+	ADCS  R8, R5, R5
+	MUL   R11, R7, R8
+	ADCS  R8, R1, R1
+	MUL   R12, R7, R8
+	ADCS  R8, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R9, R7, R8
+	ADDS  R8, R5, R5
+	UMULH R10, R7, R8
+	ADCS  R8, R1, R1
+	UMULH R11, R7, R8
+	ADCS  R8, R2, R2
+	UMULH R12, R7, R8
+	ADC   R8, R3, R3
+
+	// t = t - q if t >= q
+	SUBS  R9, R5, R8
+	SBCS  R10, R1, R8
+	SBCS  R11, R2, R8
+	SBCS  R12, R3, R8
+	CSETM CS, R7
+	AND   R7, R9, R8
+	SUBS  R8, R5, R5
+	AND   R7, R10, R8
+	SBCS  R8, R1, R1
+	AND   R7, R11, R8
+	SBCS  R8, R2, R2
+	AND   R7, R12, R8
+	SBCS  R8, R3, R3
+	STP   (R5, R1), 0(R0)
+	STP   (R2, R3), 16(R0)
+	RET
+
+// reduce(res *Element) sets res = res - q if res >= q
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $0x4aa5034bdf561657, R7
+	MOVD $0xfb7a928c153f76a7, R8
+	MOVD $0x30ef0ff69c7b761f, R9
+	MOVD $0x041cf7391def65d6, R10
+	LDP  0(R0), (R1, R2)
+	LDP  16(R0), (R3, R4)
+
+	// t = t - q if t >= q
+	SUBS  R7, R1, R6
+	SBCS  R8, R2, R6
+	SBCS  R9, R3, R6
+	SBCS  R10, R4, R6
+	CSETM CS, R5
+	AND   R5, R7, R6
+	SUBS  R6, R1, R1
+	AND   R5, R8, R6
+	SBCS  R6, R2, R2
+	AND   R5, R9, R6
+	SBCS  R6, R3, R3
+	AND   R5, R10, R6
+	SBCS  R6, R4, R4
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD $0x4aa5034bdf561657, R9
+	MOVD $0xfb7a928c153f76a7, R10
+	MOVD $0x30ef0ff69c7b761f, R11
+	MOVD $0x041cf7391def65d6, R12
+
+	// t = a + b, not reduced
+	LDP  0(R0), (R2, R3)
+	LDP  16(R0), (R4, R5)
+	MOVD 0(R1), R6
+	ADDS R6, R2, R2
+	MOVD 8(R1), R6
+	ADCS R6, R3, R3
+	MOVD 16(R1), R6
+	ADCS R6, R4, R4
+	MOVD 24(R1), R6
+	ADCS R6, R5, R5
+
+	// b = a - b
+	MOVD 0(R0), R6
+	MOVD 0(R1), R8
+	SUBS R8, R6, R6
+	MOVD R6, 0(R1)
+	MOVD 8(R0), R6
+	MOVD 8(R1), R8
+	SBCS R8, R6, R6
+	MOVD R6, 8(R1)
+	MOVD 16(R0), R6
+	MOVD 16(R1), R8
+	SBCS R8, R6, R6
+	MOVD R6, 16(R1)
+	MOVD 24(R0), R6
+	MOVD 24(R1), R8
+	SBCS R8, R6, R6
+	MOVD R6, 24(R1)
+
+	// b = b + q if there was a borrow
+	CSETM CC, R7
+	MOVD  0(R1), R6
+	AND   R7, R9, R8
+	ADDS  R8, R6, R6
+	MOVD  R6, 0(R1)
+	MOVD  8(R1), R6
+	AND   R7, R10, R8
+	ADCS  R8, R6, R6
+	MOVD  R6, 8(R1)
+	MOVD  16(R1), R6
+	AND   R7, R11, R8
+	ADCS  R8, R6, R6
+	MOVD  R6, 16(R1)
+	MOVD  24(R1), R6
+	AND   R7, R12, R8
+	ADCS  R8, R6, R6
+	MOVD  R6, 24(R1)
+
+	// t = t - q if t >= q
+	SUBS  R9, R2, R8
+	SBCS  R10, R3, R8
+	SBCS  R11, R4, R8
+	SBCS  R12, R5, R8
+	CSETM CS, R7
+	AND   R7, R9, R8
+	SUBS  R8, R2, R2
+	AND   R7, R10, R8
+	SBCS  R8, R3, R3
+	AND   R7, R11, R8
+	SBCS  R8, R4, R4
+	AND   R7, R12, R8
+	SBCS  R8, R5, R5
+	STP   (R2, R3), 0(R0)
+	STP   (R4, R5), 16(R0)
+	RET
+
+// addVecARM64(res, a, b *Element, n uint64) res[i] = a[i] + b[i]
+
+TEXT ·addVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x4aa5034bdf561657, R11
+	MOVD $0xfb7a928c153f76a7, R12
+	MOVD $0x30ef0ff69c7b761f, R13
+	MOVD $0x041cf7391def65d6, R14
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	MOVD 0(R2), R8
+	ADDS R8, R4, R4
+	MOVD 8(R2), R8
+	ADCS R8, R5, R5
+	MOVD 16(R2), R8
+	ADCS R8, R6, R6
+	MOVD 24(R2), R8
+	ADCS R8, R7, R7
+
+	// t = t - q if t >= q
+	SUBS  R11, R4, R10
+	SBCS  R12, R5, R10
+	SBCS  R13, R6, R10
+	SBCS  R14, R7, R10
+	CSETM CS, R9
+	AND   R9, R11, R10
+	SUBS  R10, R4, R4
+	AND   R9, R12, R10
+	SBCS  R10, R5, R5
+	AND   R9, R13, R10
+	SBCS  R10, R6, R6
+	AND   R9, R14, R10
+	SBCS  R10, R7, R7
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	ADD   $32, R0, R0
+	ADD   $32, R1, R1
+	ADD   $32, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// subVecARM64(res, a, b *Element, n uint64) res[i] = a[i] - b[i]
+
+TEXT ·subVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x4aa5034bdf561657, R11
+	MOVD $0xfb7a928c153f76a7, R12
+	MOVD $0x30ef0ff69c7b761f, R13
+	MOVD $0x041cf7391def65d6, R14
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	MOVD 0(R2), R8
+	SUBS R8, R4, R4
+	MOVD 8(R2), R8
+	SBCS R8, R5, R5
+	MOVD 16(R2), R8
+	SBCS R8, R6, R6
+	MOVD 24(R2), R8
+	SBCS R8, R7, R7
+
+	// t = t + q if there was a borrow
+	CSETM CC, R9
+	AND   R9, R11, R10
+	ADDS  R10, R4, R4
+	AND   R9, R12, R10
+	ADCS  R10, R5, R5
+	AND   R9, R13, R10
+	ADCS  R10, R6, R6
+	AND   R9, R14, R10
+	ADCS  R10, R7, R7
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	ADD   $32, R0, R0
+	ADD   $32, R1, R1
+	ADD   $32, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// scalarMulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b
+
+TEXT ·scalarMulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x818a92affbf4ca99, R9
+	MOVD $0x4aa5034bdf561657, R16
+	MOVD $0xfb7a928c153f76a7, R17
+	MOVD $0x30ef0ff69c7b761f, R19
+	MOVD $0x041cf7391def65d6, R20
+	LDP  0(R2), (R12, R13)
+	LDP  16(R2), (R14, R15)
+	CBZ  R3, done
+
+loop:
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R10
+	MUL   R12, R10, R4
+	MUL   R13, R10, R5
+	MUL   R14, R10, R6
+	MUL   R15, R10, R7
+	UMULH R15, R10, R8
+	UMULH R12, R10, R11
+	ADDS  R11, R5, R5
+	UMULH R13, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R14, R10, R11
+	ADCS  R11, R7, R7
+	ADC   ZR, R8, R8
+	MUL   R4, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R4, R4
+	MUL   R17, R10, R11
+	ADCS  R11, R5, R5
+	MUL   R19, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R20, R10, R11
+	ADCS  R11, R7, R7
+	ADC   ZR, R8, R8
+	UMULH R16, R10, R11
+	ADDS  R11, R5, R5
+	UMULH R17, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R19, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R20, R10, R11
+	ADC   R11, R8, R8
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R5, R5
+	MUL   R13, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R14, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R15, R10, R11
+	ADCS  R11, R8, R8
+	ADC   ZR, R4, R4
+	UMULH R12, R10, R11
+	ADDS  R11, R6, R6
+	UMULH R13, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R14, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R15, R10, R11
+	ADC   R11, R4, R4
+	MUL   R5, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R5, R5
+	MUL   R17, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R19, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R20, R10, R11
+	ADCS  R11, R8, R8
+	ADC   ZR, R4, R4
+	UMULH R16, R10, R11
+	ADDS  R11, R6, R6
+	UMULH R17, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R19, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R20, R10, R11
+	ADC   R11, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R6, R6
+	MUL   R13, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R14, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R15, R10, R11
+	ADCS  R11, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R12, R10, R11
+	ADDS  R11, R7, R7
+	UMULH R13, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R14, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R15, R10, R11
+	ADC   R11, R5, R5
+	MUL   R6, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R6, R6
+	MUL   R17, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R19, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R20, R10, R11
+	ADCS  R11, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R16, R10, R11
+	ADDS  R11, R7, R7
+	UMULH R17, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R19, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R20, R10, R11
+	ADC   R11, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R7, R7
+	MUL   R13, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R14, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R15, R10, R11
+	ADCS  R11, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R12, R10, R11
+	ADDS  R11, R8, R8
+	UMULH R13, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R14, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R15, R10, R11
+	ADC   R11, R6, R6
+	MUL   R7, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R7, R7
+	MUL   R17, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R19, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R20, R10, R11
+	ADCS  R11, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R16, R10, R11
+	ADDS  R11, R8, R8
+	UMULH R17, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R19, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R20, R10, R11
+	ADC   R11, R6, R6
+
+	// t = t - q if t >= q
+	SUBS  R16, R8, R11
+	SBCS  R17, R4, R11
+	SBCS  R19, R5, R11
+	SBCS  R20, R6, R11
+	CSETM CS, R10
+	AND   R10, R16, R11
+	SUBS  R11, R8, R8
+	AND   R10, R17, R11
+	SBCS  R11, R4, R4
+	AND   R10, R19, R11
+	SBCS  R11, R5, R5
+	AND   R10, R20, R11
+	SBCS  R11, R6, R6
+	STP   (R8, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	ADD   $32, R0, R0
+	ADD   $32, R1, R1
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// mulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b[i]
+
+TEXT ·mulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x818a92affbf4ca99, R9
+	MOVD $0x4aa5034bdf561657, R16
+	MOVD $0xfb7a928c153f76a7, R17
+	MOVD $0x30ef0ff69c7b761f, R19
+	MOVD $0x041cf7391def65d6, R20
+	CBZ  R3, done
+
+loop:
+	LDP 0(R1), (R12, R13)
+	LDP 16(R1), (R14, R15)
+
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R2), R10
+	MUL   R12, R10, R4
+	MUL   R13, R10, R5
+	MUL   R14, R10, R6
+	MUL   R15, R10, R7
+	UMULH R15, R10, R8
+	UMULH R12, R10, R11
+	ADDS  R11, R5, R5
+	UMULH R13, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R14, R10, R11
+	ADCS  R11, R7, R7
+	ADC   ZR, R8, R8
+	MUL   R4, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R4, R4
+	MUL   R17, R10, R11
+	ADCS  R11, R5, R5
+	MUL   R19, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R20, R10, R11
+	ADCS  R11, R7, R7
+	ADC   ZR, R8, R8
+	UMULH R16, R10, R11
+	ADDS  R11, R5, R5
+	UMULH R17, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R19, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R20, R10, R11
+	ADC   R11, R8, R8
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R2), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R5, R5
+	MUL   R13, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R14, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R15, R10, R11
+	ADCS  R11, R8, R8
+	ADC   ZR, R4, R4
+	UMULH R12, R10, R11
+	ADDS  R11, R6, R6
+	UMULH R13, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R14, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R15, R10, R11
+	ADC   R11, R4, R4
+	MUL   R5, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R5, R5
+	MUL   R17, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R19, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R20, R10, R11
+	ADCS  R11, R8, R8
+	ADC   ZR, R4, R4
+	UMULH R16, R10, R11
+	ADDS  R11, R6, R6
+	UMULH R17, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R19, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R20, R10, R11
+	ADC   R11, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R2), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R6, R6
+	MUL   R13, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R14, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R15, R10, R11
+	ADCS  R11, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R12, R10, R11
+	ADDS  R11, R7, R7
+	UMULH R13, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R14, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R15, R10, R11
+	ADC   R11, R5, R5
+	MUL   R6, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R6, R6
+	MUL   R17, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R19, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R20, R10, R11
+	ADCS  R11, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R16, R10, R11
+	ADDS  R11, R7, R7
+	UMULH R17, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R19, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R20, R10, R11
+	ADC   R11, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R2), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R7, R7
+	MUL   R13, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R14, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R15, R10, R11
+	ADCS  R11, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R12, R10, R11
+	ADDS  R11, R8, R8
+	UMULH R13, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R14, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R15, R10, R11
+	ADC   R11, R6, R6
+	MUL   R7, R9, R10
+	MUL   R16, R10, R11
+	ADDS  R11, R7, R7
+	MUL   R17, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R19, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R20, R10, R11
+	ADCS  R11, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R16, R10, R11
+	ADDS  R11, R8, R8
+	UMULH R17, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R19, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R20, R10, R11
+	ADC   R11, R6, R6
+
+	// t = t - q if t >= q
+	SUBS  R16, R8, R11
+	SBCS  R17, R4, R11
+	SBCS  R19, R5, R11
+	SBCS  R20, R6, R11
+	CSETM CS, R10
+	AND   R10, R16, R11
+	SUBS  R11, R8, R8
+	AND   R10, R17, R11
+	SBCS  R11, R4, R4
+	AND   R10, R19, R11
+	SBCS  R11, R5, R5
+	AND   R10, R20, R11
+	SBCS  R11, R6, R6
+	STP   (R8, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	ADD   $32, R0, R0
+	ADD   $32, R1, R1
+	ADD   $32, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
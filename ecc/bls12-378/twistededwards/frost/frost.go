@@ -0,0 +1,386 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+	"sort"
+
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-378/fr" // base field of the curve
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/twistededwards/fr"
+)
+
+var (
+	ErrNotEnoughSigners         = errors.New("not enough signers")
+	ErrInvalidSigningSet        = errors.New("signing commitments must have distinct identifiers, including the signer's")
+	ErrNonceUsed                = errors.New("nonce already used")
+	ErrInvalidSignatureShare    = errors.New("invalid signature share")
+	ErrInvalidSignatureEncoding = errors.New("invalid signature encoding")
+	errHashNeeded               = errors.New("hFunc cannot be nil. We need a hash for Fiat-Shamir")
+)
+
+// a signature R || S is encoded as in eddsa, S on sizeFr bytes
+const (
+	sizeFr        = fp.Bytes
+	sizeSignature = 2 * sizeFr
+)
+
+// SigningCommitment is published by a signer in the first round of signing.
+type SigningCommitment struct {
+	ID      uint32
+	Hiding  twistededwards.PointAffine // [d]B
+	Binding twistededwards.PointAffine // [e]B
+}
+
+// SigningNonce holds the secret nonces d, e of a SigningCommitment. It must be
+// kept secret, and can be used for a single signature.
+type SigningNonce struct {
+	Commitment SigningCommitment
+
+	hiding, binding fr.Element
+	used            bool
+}
+
+// SignatureShare is the contribution of a signer to a signature.
+type SignatureShare struct {
+	ID uint32
+	Z  fr.Element
+}
+
+// Commit runs the first round of signing: it samples the nonces of the next
+// signature. The returned nonce must be kept secret and its commitment sent to
+// the coordinator.
+func (k *KeyShare) Commit(r io.Reader) (*SigningNonce, error) {
+	params := twistededwards.GetEdwardsCurve()
+	res := &SigningNonce{Commitment: SigningCommitment{ID: k.ID}}
+	if err := k.generateNonce(r, &res.hiding); err != nil {
+		return nil, err
+	}
+	if err := k.generateNonce(r, &res.binding); err != nil {
+		return nil, err
+	}
+	var b big.Int
+	res.Commitment.Hiding.ScalarMultiplicationCT(&params.Base, res.hiding.BigInt(&b))
+	res.Commitment.Binding.ScalarMultiplicationCT(&params.Base, res.binding.BigInt(&b))
+	b.SetUint64(0)
+	return res, nil
+}
+
+// generateNonce is nonce_generate of RFC 9591: it sets res to
+// H3(random_bytes(32) || SerializeScalar(secret)), so that a weak source of
+// randomness doesn't leak the secret share. H3 is hashToScalar with the label
+// "nonce", and SerializeScalar the big-endian encoding of fr.Element.
+func (k *KeyShare) generateNonce(r io.Reader, res *fr.Element) error {
+	var random [32]byte
+	if _, err := io.ReadFull(r, random[:]); err != nil {
+		return err
+	}
+	secret := k.Secret.Bytes()
+	*res = hashToScalar("nonce", random[:], secret[:])
+	for i := range secret {
+		secret[i] = 0
+	}
+	if res.IsZero() {
+		return errors.New("zero nonce")
+	}
+	return nil
+}
+
+// Sign runs the second round of signing: it returns the signature share of
+// message for the set of signers of commitments, chosen by the coordinator.
+// The nonce is erased and can't be used again.
+//
+// The challenge of the signature is H(R, A, message), as in eddsa.
+func (k *KeyShare) Sign(message []byte, nonce *SigningNonce, commitments []SigningCommitment, hFunc hash.Hash) (SignatureShare, error) {
+	if nonce.used {
+		return SignatureShare{}, ErrNonceUsed
+	}
+	if nonce.Commitment.ID != k.ID {
+		return SignatureShare{}, ErrInvalidSigningSet
+	}
+	ctx, err := newSigningContext(k.Commitment, message, commitments, hFunc)
+	if err != nil {
+		return SignatureShare{}, err
+	}
+	i, ok := ctx.index(k.ID)
+	if !ok || !ctx.commitments[i].Hiding.Equal(&nonce.Commitment.Hiding) || !ctx.commitments[i].Binding.Equal(&nonce.Commitment.Binding) {
+		return SignatureShare{}, ErrInvalidSigningSet
+	}
+
+	// z = d + e⋅ρ + λ⋅s⋅c, in constant time since s, d and e are secret
+	res := SignatureShare{ID: k.ID}
+	lambda := ctx.lagrangeCoefficient(i)
+	res.Z.MulCT(&lambda, &k.Secret).
+		MulCT(&res.Z, &ctx.challenge)
+	var t fr.Element
+	t.MulCT(&nonce.binding, &ctx.bindingFactors[i])
+	res.Z.AddCT(&res.Z, &t).
+		AddCT(&res.Z, &nonce.hiding)
+
+	t.SetZero()
+	nonce.hiding.SetZero()
+	nonce.binding.SetZero()
+	nonce.used = true
+
+	return res, nil
+}
+
+// VerifySignatureShare checks the signature share of a signer, with the public
+// key of its share derived from c.
+func VerifySignatureShare(c Commitment, message []byte, commitments []SigningCommitment, share *SignatureShare, hFunc hash.Hash) error {
+	ctx, err := newSigningContext(c, message, commitments, hFunc)
+	if err != nil {
+		return err
+	}
+	i, ok := ctx.index(share.ID)
+	if !ok {
+		return ErrInvalidSigningSet
+	}
+	return ctx.verifyShare(c, i, share)
+}
+
+// Aggregate combines the signature shares of the signers of commitments into a
+// signature of message, encoded as an eddsa signature. The shares are checked if
+// the signature is invalid, and the first invalid one is reported.
+func Aggregate(c Commitment, message []byte, commitments []SigningCommitment, shares []SignatureShare, hFunc hash.Hash) ([]byte, error) {
+	ctx, err := newSigningContext(c, message, commitments, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) != len(ctx.commitments) {
+		return nil, ErrInvalidSigningSet
+	}
+	params := twistededwards.GetEdwardsCurve()
+	sorted := make([]*SignatureShare, len(shares))
+	var s fr.Element
+	for j := range shares {
+		i, ok := ctx.index(shares[j].ID)
+		if !ok || sorted[i] != nil {
+			return nil, ErrInvalidSigningSet
+		}
+		sorted[i] = &shares[j]
+		s.Add(&s, &shares[j].Z)
+	}
+
+	res := make([]byte, sizeSignature)
+	bR := ctx.r.Bytes()
+	copy(res[:sizeFr], bR[:])
+	bS := s.Bytes()
+	copy(res[sizeSignature-len(bS):], bS[:])
+
+	// [s]B = R + [c]A
+	var lhs, rhs twistededwards.PointAffine
+	var b big.Int
+	groupKey := c.GroupKey()
+	lhs.ScalarMultiplication(&params.Base, s.BigInt(&b))
+	rhs.ScalarMultiplication(&groupKey, ctx.challenge.BigInt(&b)).
+		Add(&rhs, &ctx.r)
+	if !lhs.Equal(&rhs) {
+		for i := range sorted {
+			if err := ctx.verifyShare(c, i, sorted[i]); err != nil {
+				return nil, err
+			}
+		}
+		return nil, ErrInvalidSignatureShare
+	}
+
+	return res, nil
+}
+
+// Verify verifies a signature of message for the public key groupKey, with the
+// verification equation of eddsa: [h][S]B = [h](R + [H(R, A, M)]A), h being the
+// cofactor of the curve.
+func Verify(groupKey *twistededwards.PointAffine, sig, message []byte, hFunc hash.Hash) (bool, error) {
+	if hFunc == nil {
+		return false, errHashNeeded
+	}
+	if !groupKey.IsOnCurve() {
+		return false, ErrInvalidPoint
+	}
+	if len(sig) != sizeSignature {
+		return false, ErrInvalidSignatureEncoding
+	}
+	params := twistededwards.GetEdwardsCurve()
+	var r twistededwards.PointAffine
+	if _, err := r.SetBytes(sig[:sizeFr]); err != nil {
+		return false, err
+	}
+	var s big.Int
+	s.SetBytes(sig[sizeFr:])
+	if s.Cmp(fr.Modulus()) >= 0 {
+		return false, ErrInvalidSignatureEncoding
+	}
+
+	c, err := challenge(&r, groupKey, message, hFunc)
+	if err != nil {
+		return false, err
+	}
+
+	var lhs, rhs twistededwards.PointAffine
+	var bCofactor, bC big.Int
+	params.Cofactor.BigInt(&bCofactor)
+	lhs.ScalarMultiplication(&params.Base, &s).
+		ScalarMultiplication(&lhs, &bCofactor)
+	rhs.ScalarMultiplication(groupKey, c.BigInt(&bC)).
+		Add(&rhs, &r).
+		ScalarMultiplication(&rhs, &bCofactor)
+	return lhs.Equal(&rhs), nil
+}
+
+// signingContext holds the values shared by the signers of a message
+type signingContext struct {
+	commitments    []SigningCommitment        // sorted by identifier
+	bindingFactors []fr.Element               // ρᵢ
+	r              twistededwards.PointAffine // R = ∑ Dᵢ + [ρᵢ]Eᵢ
+	challenge      fr.Element                 // H(R, A, M)
+}
+
+func newSigningContext(c Commitment, message []byte, commitments []SigningCommitment, hFunc hash.Hash) (*signingContext, error) {
+	if hFunc == nil {
+		return nil, errHashNeeded
+	}
+	if len(c) == 0 {
+		return nil, ErrInvalidThreshold
+	}
+	if len(commitments) < c.Threshold() {
+		return nil, ErrNotEnoughSigners
+	}
+	ctx := &signingContext{
+		commitments:    append([]SigningCommitment{}, commitments...),
+		bindingFactors: make([]fr.Element, len(commitments)),
+	}
+	sort.Slice(ctx.commitments, func(i, j int) bool {
+		return ctx.commitments[i].ID < ctx.commitments[j].ID
+	})
+	for i := range ctx.commitments {
+		if ctx.commitments[i].ID == 0 || (i > 0 && ctx.commitments[i].ID == ctx.commitments[i-1].ID) {
+			return nil, ErrInvalidSigningSet
+		}
+		if !isInSubGroup(&ctx.commitments[i].Hiding) || !isInSubGroup(&ctx.commitments[i].Binding) {
+			return nil, ErrInvalidPoint
+		}
+	}
+
+	// ρᵢ = H(A, H(M), H(commitments), i)
+	groupKey := c.GroupKey()
+	bGroupKey := groupKey.Bytes()
+	hMessage := sha256.Sum256(message)
+	h := sha256.New()
+	var bID [4]byte
+	for i := range ctx.commitments {
+		binary.BigEndian.PutUint32(bID[:], ctx.commitments[i].ID)
+		bD := ctx.commitments[i].Hiding.Bytes()
+		bE := ctx.commitments[i].Binding.Bytes()
+		h.Write(bID[:])
+		h.Write(bD[:])
+		h.Write(bE[:])
+	}
+	hCommitments := h.Sum(nil)
+	for i := range ctx.commitments {
+		binary.BigEndian.PutUint32(bID[:], ctx.commitments[i].ID)
+		ctx.bindingFactors[i] = hashToScalar("rho", bGroupKey[:], hMessage[:], hCommitments, bID[:])
+	}
+
+	ctx.r.X.SetZero()
+	ctx.r.Y.SetOne()
+	var b big.Int
+	for i := range ctx.commitments {
+		var p twistededwards.PointAffine
+		p.ScalarMultiplication(&ctx.commitments[i].Binding, ctx.bindingFactors[i].BigInt(&b)).
+			Add(&p, &ctx.commitments[i].Hiding)
+		ctx.r.Add(&ctx.r, &p)
+	}
+
+	var err error
+	if ctx.challenge, err = challenge(&ctx.r, &groupKey, message, hFunc); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// index returns the position of the signer id in the sorted commitments
+func (ctx *signingContext) index(id uint32) (int, bool) {
+	i := sort.Search(len(ctx.commitments), func(i int) bool {
+		return ctx.commitments[i].ID >= id
+	})
+	return i, i < len(ctx.commitments) && ctx.commitments[i].ID == id
+}
+
+// lagrangeCoefficient returns λᵢ = ∏ⱼ≠ᵢ j/(j-i) for the set of signers. The
+// identifiers are public, and so is λᵢ.
+func (ctx *signingContext) lagrangeCoefficient(i int) fr.Element {
+	var num, den, xi, xj fr.Element
+	num.SetOne()
+	den.SetOne()
+	xi.SetUint64(uint64(ctx.commitments[i].ID))
+	for j := range ctx.commitments {
+		if j == i {
+			continue
+		}
+		xj.SetUint64(uint64(ctx.commitments[j].ID))
+		num.Mul(&num, &xj)
+		xj.Sub(&xj, &xi)
+		den.Mul(&den, &xj)
+	}
+	den.Inverse(&den)
+	return *num.Mul(&num, &den)
+}
+
+// verifyShare checks [z]B = Dᵢ + [ρᵢ]Eᵢ + [λᵢ⋅c]Yᵢ for the signer i
+func (ctx *signingContext) verifyShare(c Commitment, i int, share *SignatureShare) error {
+	params := twistededwards.GetEdwardsCurve()
+	publicShare := c.PublicShare(share.ID)
+	lambda := ctx.lagrangeCoefficient(i)
+	lambda.Mul(&lambda, &ctx.challenge)
+
+	var lhs, rhs, t twistededwards.PointAffine
+	var b big.Int
+	lhs.ScalarMultiplication(&params.Base, share.Z.BigInt(&b))
+	rhs.ScalarMultiplication(&publicShare, lambda.BigInt(&b))
+	t.ScalarMultiplication(&ctx.commitments[i].Binding, ctx.bindingFactors[i].BigInt(&b))
+	rhs.Add(&rhs, &t).
+		Add(&rhs, &ctx.commitments[i].Hiding)
+	if !lhs.Equal(&rhs) {
+		return ErrInvalidSignatureShare
+	}
+	return nil
+}
+
+// challenge returns H(R, A, M) mod the order of the prime subgroup, where the
+// coordinates are written as in eddsa
+func challenge(r, groupKey *twistededwards.PointAffine, message []byte, hFunc hash.Hash) (fr.Element, error) {
+	hFunc.Reset()
+	rX := r.X.Bytes()
+	rY := r.Y.Bytes()
+	aX := groupKey.X.Bytes()
+	aY := groupKey.Y.Bytes()
+	toWrite := [][]byte{rX[:], rY[:], aX[:], aY[:], message}
+	for _, bytes := range toWrite {
+		if _, err := hFunc.Write(bytes); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	var res fr.Element
+	res.SetBytes(hFunc.Sum(nil))
+	return res, nil
+}
@@ -79,3 +79,10 @@ func mulByA(x *fr.Element) {
 	x.Neg(x)
 	fr.MulBy5(x)
 }
+
+// mulByACT multiplies x by a in constant time
+func mulByACT(x *fr.Element) {
+	var t fr.Element
+	t.DoubleCT(x).DoubleCT(&t).AddCT(&t, x)
+	x.NegCT(&t)
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ecvrf provides a verifiable random function on bls12-381's bandersnatch
+// curve, designed to be verified in a SNARK circuit over bls12-381's scalar field.
+//
+// A VRF is the public-key version of a keyed hash: only the owner of the private
+// key can compute the hash β of an input α, but anyone can check it with the
+// public key and the proof π returned by Prove.
+//
+// The construction is ECVRF (RFC 9381) with the hashes replaced by MiMC, which is
+// cheap in-circuit:
+//
+//   - H = encode_to_curve(Y, α) hashes MiMC(0x01, Y, α, ctr) to the y coordinate
+//     of a point for the smallest counter ctr such that it is on the curve, and
+//     multiplies it by the cofactor (try-and-increment);
+//   - the challenge is c = MiMC(0x02, Y, H, Γ, U, V), a whole field element;
+//   - the output is β = MiMC(0x03, [cofactor]Γ).
+//
+// As with eddsa, α is hashed with MiMC: it must be a sequence of big-endian
+// encoded field elements.
+//
+// The scalar multiplications by the secret key and the nonce are constant time,
+// but the response s = k + c⋅x is computed modulo the order of the subgroup with
+// math/big, which is not: it may leak information on x and k through timing.
+//
+// See https://www.rfc-editor.org/rfc/rfc9381
+package ecvrf
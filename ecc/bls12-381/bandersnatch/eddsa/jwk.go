@@ -0,0 +1,133 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+)
+
+// The keys are represented as JSON Web Keys (RFC 7517) with the "OKP" key type
+// of RFC 8037, as Ed25519 keys:
+//
+//	{"kty": "OKP", "crv": JWKCurve, "x": base64url(PublicKey.Bytes()), "d": base64url(scalar||randSrc)}
+//
+// where "d" is only present for private keys. There is no registered JOSE
+// curve name, nor OID, for the twisted Edwards curves, so the keys are tagged
+// with the unregistered name JWKCurve.
+
+const (
+	// JWKCurve is the "crv" parameter of the JSON Web Keys of the package.
+	JWKCurve = "twistededwards-bls12-381"
+
+	jwkKeyType = "OKP"
+)
+
+var errInvalidJWK = errors.New("invalid JSON Web Key")
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	D   string `json:"d,omitempty"`
+}
+
+// BytesJWK returns the JSON Web Key representation of pk.
+func (pk *PublicKey) BytesJWK() []byte {
+	res, _ := json.Marshal(jwk{
+		Kty: jwkKeyType,
+		Crv: JWKCurve,
+		X:   base64.RawURLEncoding.EncodeToString(pk.Bytes()),
+	})
+	return res
+}
+
+// SetBytesJWK sets pk from its JSON Web Key representation. It returns the
+// number of bytes read from buf.
+func (pk *PublicKey) SetBytesJWK(buf []byte) (int, error) {
+	var key jwk
+	if err := json.Unmarshal(buf, &key); err != nil {
+		return 0, err
+	}
+	if err := pk.setJWK(&key); err != nil {
+		return 0, err
+	}
+	return len(buf), nil
+}
+
+func (pk *PublicKey) setJWK(key *jwk) error {
+	if key.Kty != jwkKeyType || key.Crv != JWKCurve {
+		return errInvalidJWK
+	}
+	x, err := base64.RawURLEncoding.DecodeString(key.X)
+	if err != nil || len(x) != sizePublicKey {
+		return errInvalidJWK
+	}
+	_, err = pk.SetBytes(x)
+	return err
+}
+
+// BytesJWK returns the JSON Web Key representation of privKey, including its
+// public key.
+func (privKey *PrivateKey) BytesJWK() []byte {
+	b := privKey.Bytes()
+	res, _ := json.Marshal(jwk{
+		Kty: jwkKeyType,
+		Crv: JWKCurve,
+		X:   base64.RawURLEncoding.EncodeToString(b[:sizePublicKey]),
+		D:   base64.RawURLEncoding.EncodeToString(b[sizePublicKey:]),
+	})
+	return res
+}
+
+// SetBytesJWK sets privKey from its JSON Web Key representation, and checks
+// that the public key matches the private scalar. It returns the number of
+// bytes read from buf.
+func (privKey *PrivateKey) SetBytesJWK(buf []byte) (int, error) {
+	var key jwk
+	if err := json.Unmarshal(buf, &key); err != nil {
+		return 0, err
+	}
+	var res PrivateKey
+	if err := res.PublicKey.setJWK(&key); err != nil {
+		return 0, err
+	}
+	d, err := base64.RawURLEncoding.DecodeString(key.D)
+	if err != nil || len(d) != sizePrivateKey-sizePublicKey {
+		return 0, errInvalidJWK
+	}
+	copy(res.scalar[:], d[:sizeFr])
+	copy(res.randSrc[:], d[sizeFr:])
+
+	var scalar big.Int
+	var pub twistededwards.PointAffine
+	c := twistededwards.GetEdwardsCurve()
+	scalar.SetBytes(res.scalar[:])
+	pub.ScalarMultiplicationCT(&c.Base, &scalar)
+	scalar.SetUint64(0)
+	if subtle.ConstantTimeCompare(pub.Marshal(), res.PublicKey.A.Marshal()) != 1 {
+		return 0, errInvalidJWK
+	}
+
+	*privKey = res
+	return len(buf), nil
+}
@@ -179,6 +179,21 @@ func (p *PointAffine) IsOnCurve() bool {
 	return lhs.Equal(&rhs)
 }
 
+// IsInSubGroup checks if a point is on the twisted Edwards curve and in the
+// prime order subgroup, i.e. [order]p = O. The multiplication by the order
+// doesn't use the endomorphism, which only acts as a scalar on the subgroup.
+func (p *PointAffine) IsInSubGroup() bool {
+	if !p.IsOnCurve() {
+		return false
+	}
+	initOnce.Do(initCurveParams)
+
+	var q PointProj
+	q.FromAffine(p)
+	q.scalarMulWindowed(&q, &curveParams.Order)
+	return q.IsZero()
+}
+
 // Neg sets p to -p1 and returns it
 func (p *PointAffine) Neg(p1 *PointAffine) *PointAffine {
 	p.X.Neg(&p1.X)
@@ -266,6 +281,24 @@ func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *Po
 	return p
 }
 
+// ScalarMultiplicationCT scalar multiplication of a point p1 in affine
+// coordinates with a scalar in big.Int, in constant time with respect to the
+// scalar. See PointProj.ScalarMultiplicationCT.
+func (p *PointAffine) ScalarMultiplicationCT(p1 *PointAffine, scalar *big.Int) *PointAffine {
+
+	var p1Proj, resProj PointProj
+	p1Proj.FromAffine(p1)
+	resProj.ScalarMultiplicationCT(&p1Proj, scalar)
+
+	// Z ≠ 0 since the formulas are complete
+	var I fr.Element
+	I.InverseCT(&resProj.Z)
+	p.X.MulCT(&resProj.X, &I)
+	p.Y.MulCT(&resProj.Y, &I)
+
+	return p
+}
+
 // setInfinity sets p to O (0:1)
 func (p *PointAffine) setInfinity() *PointAffine {
 	p.X.SetZero()
@@ -419,6 +452,57 @@ func (p *PointProj) Add(p1, p2 *PointProj) *PointProj {
 	return p
 }
 
+// doubleCT is Double with the constant time field operations
+func (p *PointProj) doubleCT(p1 *PointProj) *PointProj {
+
+	var B, C, D, E, F, H, J fr.Element
+
+	B.AddCT(&p1.X, &p1.Y).SquareCT(&B)
+	C.SquareCT(&p1.X)
+	D.SquareCT(&p1.Y)
+	E.Set(&C)
+	mulByACT(&E)
+	F.AddCT(&E, &D)
+	H.SquareCT(&p1.Z)
+	J.SubCT(&F, &H).SubCT(&J, &H)
+	p.X.SubCT(&B, &C).
+		SubCT(&p.X, &D).
+		MulCT(&p.X, &J)
+	p.Y.SubCT(&E, &D).MulCT(&p.Y, &F)
+	p.Z.MulCT(&F, &J)
+
+	return p
+}
+
+// addCT is Add with the constant time field operations
+func (p *PointProj) addCT(p1, p2 *PointProj) *PointProj {
+	initOnce.Do(initCurveParams)
+
+	var A, B, C, D, E, F, G, H, I fr.Element
+	A.MulCT(&p1.Z, &p2.Z)
+	B.SquareCT(&A)
+	C.MulCT(&p1.X, &p2.X)
+	D.MulCT(&p1.Y, &p2.Y)
+	E.MulCT(&curveParams.D, &C).MulCT(&E, &D)
+	F.SubCT(&B, &E)
+	G.AddCT(&B, &E)
+	H.AddCT(&p1.X, &p1.Y)
+	I.AddCT(&p2.X, &p2.Y)
+	p.X.MulCT(&H, &I).
+		SubCT(&p.X, &C).
+		SubCT(&p.X, &D).
+		MulCT(&p.X, &A).
+		MulCT(&p.X, &F)
+	mulByACT(&C)
+	C.NegCT(&C)
+	p.Y.AddCT(&D, &C).
+		MulCT(&p.Y, &A).
+		MulCT(&p.Y, &G)
+	p.Z.MulCT(&F, &G)
+
+	return p
+}
+
 // scalarMulWindowed scalar multiplication of a point
 // p1 in projective coordinates with a scalar in big.Int
 // using the windowed double-and-add method.
@@ -456,6 +540,75 @@ func (p *PointProj) ScalarMultiplication(p1 *PointProj, scalar *big.Int) *PointP
 	return p.scalarMulGLV(p1, scalar)
 }
 
+// ScalarMultiplicationCT scalar multiplication of a point p1 in projective
+// coordinates with a scalar in big.Int, in constant time with respect to the
+// scalar.
+//
+// p1 must be in the prime order subgroup: the scalar is reduced modulo its order,
+// and the running time only depends on the bit length of the order. It uses a
+// 4-bits fixed-window method with the unified addition formulas and the constant
+// time field operations, reading the whole table of the multiples of p1 at each
+// step. It is slower than ScalarMultiplication, and should be used when the
+// scalar is secret.
+//
+// A scalar with at most as many bits as the order is reduced without branching
+// on it. A negative or larger scalar is first reduced with big.Int.Mod, which is
+// not constant time.
+func (p *PointProj) ScalarMultiplicationCT(p1 *PointProj, scalar *big.Int) *PointProj {
+	initOnce.Do(initCurveParams)
+
+	// table[i] = [i]p1
+	var table [16]PointProj
+	table[0].setInfinity()
+	table[1].Set(p1)
+	for i := 2; i < len(table); i++ {
+		table[i].addCT(&table[i-1], &table[1])
+	}
+
+	// big-endian encoding of the scalar on a fixed number of bytes
+	k := scalar
+	if k.Sign() < 0 || k.BitLen() > curveParams.Order.BitLen() {
+		k = new(big.Int).Mod(scalar, &curveParams.Order)
+	}
+	buf := make([]byte, (curveParams.Order.BitLen()+7)/8)
+	order := make([]byte, len(buf))
+	k.FillBytes(buf)
+	curveParams.Order.FillBytes(order)
+
+	// k < 2⋅order: if k ⩾ order → k -= order, without branching on k
+	t := make([]byte, len(buf))
+	var borrow uint
+	for i := len(buf) - 1; i >= 0; i-- {
+		d := uint(buf[i]) - uint(order[i]) - borrow
+		t[i] = byte(d)
+		borrow = (d >> 8) & 1
+	}
+	m := byte(borrow) - 1
+	for i := range buf {
+		buf[i] ^= m & (buf[i] ^ t[i])
+	}
+
+	var res, q PointProj
+	res.setInfinity()
+	for i := 0; i < 2*len(buf); i++ {
+		for j := 0; j < 4; j++ {
+			res.doubleCT(&res)
+		}
+		w := (buf[i/2] >> (4 * (1 - i%2))) & 0xf
+		q.Set(&table[0])
+		for j := 1; j < len(table); j++ {
+			c := int(w ^ byte(j))
+			q.X.Select(c, &table[j].X, &q.X)
+			q.Y.Select(c, &table[j].Y, &q.Y)
+			q.Z.Select(c, &table[j].Z, &q.Z)
+		}
+		res.addCT(&res, &q)
+	}
+
+	p.Set(&res)
+	return p
+}
+
 // ------- Extended coordinates
 
 // Set sets p to p1 and return it
@@ -0,0 +1,44 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package sigma provides non-interactive zero-knowledge proofs of
+// knowledge of discrete logarithm relations (sigma protocols).
+//
+// A [Statement] is a conjunction of linear equations
+//
+//	Yⱼ = Σᵢ xᵢ·Gⱼᵢ
+//
+// over public points and a vector x of secret scalars. It covers, among
+// others, Schnorr proofs of knowledge of a discrete logarithm ([DLog]),
+// Chaum-Pedersen proofs of equality of discrete logarithms ([DLEQ]) and
+// openings of Pedersen commitments ([Representation]). Statements are
+// composed with [And], and [ProveOr] proves the knowledge of a witness of one
+// of several statements without revealing which one, following Cramer,
+// Damgård and Schoenmakers.
+//
+// Proofs are made non-interactive with the Fiat-Shamir heuristic: the
+// challenge binds the statements, the commitments and an optional
+// dataTranscript. A proof holds only the challenges and the responses, the
+// commitments are recomputed by the verifier.
+//
+// The points of the statements must be on the curve and in the prime order
+// subgroup, otherwise proving and verifying fail with [ErrInvalidPoint]: with a
+// small order component, a proof could pass without a witness.
+//
+// See Cramer, Damgård and Schoenmakers, "Proofs of partial knowledge and
+// simplified design of witness hiding protocols" (CRYPTO 1994), and chapter 19
+// of https://toc.cryptobook.us for a description of the protocols.
+package sigma
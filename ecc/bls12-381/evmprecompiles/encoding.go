@@ -0,0 +1,138 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evmprecompiles
+
+import (
+	"errors"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+)
+
+var (
+	// ErrInvalidInputLength is returned when the length of the input is not
+	// valid for the precompile.
+	ErrInvalidInputLength = errors.New("invalid input length")
+	// ErrInvalidFieldElement is returned when a base field element is not
+	// smaller than the modulus, or when its top 16 bytes are not zero.
+	ErrInvalidFieldElement = errors.New("invalid field element encoding")
+	// ErrPointNotOnCurve is returned when a point is not on the curve.
+	ErrPointNotOnCurve = errors.New("point not on curve")
+	// ErrPointNotInSubGroup is returned when a point is not in the r-torsion.
+	ErrPointNotInSubGroup = errors.New("point not in the correct subgroup")
+)
+
+// UnmarshalG1 sets p from its 128-byte EIP-2537 encoding x||y, checking that it
+// is on the curve and in the r-torsion. (0,0) encodes the point at infinity.
+func UnmarshalG1(p *bls12381.G1Affine, buf []byte) error {
+	return unmarshalG1(p, buf, true)
+}
+
+func unmarshalG1(p *bls12381.G1Affine, buf []byte, subGroupCheck bool) error {
+	if len(buf) != sizeG1 {
+		return ErrInvalidInputLength
+	}
+	if err := setFp(&p.X, buf[:sizeFp]); err != nil {
+		return err
+	}
+	if err := setFp(&p.Y, buf[sizeFp:]); err != nil {
+		return err
+	}
+	// (0,0) is never on the curve, it is the infinity point by convention
+	if p.IsInfinity() {
+		return nil
+	}
+	if !p.IsOnCurve() {
+		return ErrPointNotOnCurve
+	}
+	if subGroupCheck && !p.IsInSubGroup() {
+		return ErrPointNotInSubGroup
+	}
+	return nil
+}
+
+// MarshalG1 returns the 128-byte EIP-2537 encoding x||y of p.
+func MarshalG1(p *bls12381.G1Affine) []byte {
+	res := make([]byte, sizeG1)
+	putFp(res[:sizeFp], &p.X)
+	putFp(res[sizeFp:], &p.Y)
+	return res
+}
+
+// UnmarshalG2 sets p from its 256-byte EIP-2537 encoding x.A0||x.A1||y.A0||y.A1,
+// checking that it is on the curve and in the r-torsion. (0,0) encodes the
+// point at infinity.
+func UnmarshalG2(p *bls12381.G2Affine, buf []byte) error {
+	return unmarshalG2(p, buf, true)
+}
+
+func unmarshalG2(p *bls12381.G2Affine, buf []byte, subGroupCheck bool) error {
+	if len(buf) != sizeG2 {
+		return ErrInvalidInputLength
+	}
+	if err := setFp2(&p.X, buf[:sizeFp2]); err != nil {
+		return err
+	}
+	if err := setFp2(&p.Y, buf[sizeFp2:]); err != nil {
+		return err
+	}
+	if p.IsInfinity() {
+		return nil
+	}
+	if !p.IsOnCurve() {
+		return ErrPointNotOnCurve
+	}
+	if subGroupCheck && !p.IsInSubGroup() {
+		return ErrPointNotInSubGroup
+	}
+	return nil
+}
+
+// MarshalG2 returns the 256-byte EIP-2537 encoding x.A0||x.A1||y.A0||y.A1 of p.
+func MarshalG2(p *bls12381.G2Affine) []byte {
+	res := make([]byte, sizeG2)
+	for i, e := range []*fp.Element{&p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1} {
+		putFp(res[i*sizeFp:(i+1)*sizeFp], e)
+	}
+	return res
+}
+
+// setFp sets z from a 64-byte big-endian integer, whose top 16 bytes must be
+// zero and which must be smaller than the field modulus.
+func setFp(z *fp.Element, buf []byte) error {
+	for i := 0; i < sizeFp-fp.Bytes; i++ {
+		if buf[i] != 0 {
+			return ErrInvalidFieldElement
+		}
+	}
+	if err := z.SetBytesCanonical(buf[sizeFp-fp.Bytes : sizeFp]); err != nil {
+		return ErrInvalidFieldElement
+	}
+	return nil
+}
+
+// setFp2 sets z from its 128-byte encoding A0||A1.
+func setFp2(z *bls12381.E2, buf []byte) error {
+	if err := setFp(&z.A0, buf[:sizeFp]); err != nil {
+		return err
+	}
+	return setFp(&z.A1, buf[sizeFp:sizeFp2])
+}
+
+// putFp writes the 64-byte encoding of e in buf.
+func putFp(buf []byte, e *fp.Element) {
+	b := e.Bytes()
+	copy(buf[sizeFp-fp.Bytes:sizeFp], b[:])
+}
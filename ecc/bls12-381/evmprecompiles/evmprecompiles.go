@@ -0,0 +1,262 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package evmprecompiles implements the bls12-381 precompiled contracts of the
+// Ethereum Virtual Machine, as specified in EIP-2537:
+//
+//   - G1Add, address 0x0b
+//   - G1MSM, address 0x0c
+//   - G2Add, address 0x0d
+//   - G2MSM, address 0x0e
+//   - PairingCheck, address 0x0f
+//   - MapFpToG1, address 0x10
+//   - MapFp2ToG2, address 0x11
+//
+// with the byte encodings, input checks and gas costs used by the execution
+// clients. Base field elements are 64-byte big-endian integers (the top 16
+// bytes must be zero) smaller than the modulus. Fp2 elements are encoded as
+// A0||A1, G1 points as x||y and G2 points as x.A0||x.A1||y.A0||y.A1. The point
+// at infinity is encoded with zeros. Scalars are 32-byte big-endian integers,
+// that may be larger than the group order.
+//
+// Points must be on the curve. The MSM and pairing inputs must also be in the
+// r-torsion, while the additions do not check it.
+//
+// Every function returns an error exactly when the precompile call fails (and
+// consumes all the gas supplied to it).
+//
+// # See also
+//
+// https://eips.ethereum.org/EIPS/eip-2537
+package evmprecompiles
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+const (
+	// G1AddGas is the gas cost of G1Add
+	G1AddGas = 375
+	// G2AddGas is the gas cost of G2Add
+	G2AddGas = 600
+	// G1MulGas is the gas cost of a single G1 scalar multiplication in G1MSM
+	G1MulGas = 12000
+	// G2MulGas is the gas cost of a single G2 scalar multiplication in G2MSM
+	G2MulGas = 22500
+	// PairingBaseGas is the constant part of the gas cost of PairingCheck
+	PairingBaseGas = 37700
+	// PairingPerPairGas is the gas cost of PairingCheck per input pair
+	PairingPerPairGas = 32600
+	// MapFpToG1Gas is the gas cost of MapFpToG1
+	MapFpToG1Gas = 5500
+	// MapFp2ToG2Gas is the gas cost of MapFp2ToG2
+	MapFp2ToG2Gas = 23800
+)
+
+const (
+	sizeFp      = 64
+	sizeFp2     = 2 * sizeFp
+	sizeScalar  = 32
+	sizeG1      = 2 * sizeFp
+	sizeG2      = 2 * sizeFp2
+	sizeG1Add   = 2 * sizeG1
+	sizeG2Add   = 2 * sizeG2
+	sizeG1MSM   = sizeG1 + sizeScalar
+	sizeG2MSM   = sizeG2 + sizeScalar
+	sizePairing = sizeG1 + sizeG2
+)
+
+// G1Add computes the sum of two G1 points, input being the encoding of the
+// points (256 bytes). The points are not checked to be in the r-torsion.
+func G1Add(input []byte) ([]byte, error) {
+	if len(input) != sizeG1Add {
+		return nil, ErrInvalidInputLength
+	}
+	var p, q bls12381.G1Affine
+	if err := unmarshalG1(&p, input[:sizeG1], false); err != nil {
+		return nil, err
+	}
+	if err := unmarshalG1(&q, input[sizeG1:], false); err != nil {
+		return nil, err
+	}
+	p.Add(&p, &q)
+	return MarshalG1(&p), nil
+}
+
+// G2Add computes the sum of two G2 points, input being the encoding of the
+// points (512 bytes). The points are not checked to be in the r-torsion.
+func G2Add(input []byte) ([]byte, error) {
+	if len(input) != sizeG2Add {
+		return nil, ErrInvalidInputLength
+	}
+	var p, q bls12381.G2Affine
+	if err := unmarshalG2(&p, input[:sizeG2], false); err != nil {
+		return nil, err
+	}
+	if err := unmarshalG2(&q, input[sizeG2:], false); err != nil {
+		return nil, err
+	}
+	p.Add(&p, &q)
+	return MarshalG2(&p), nil
+}
+
+// G1MSM computes the multi-scalar multiplication ∑ᵢ sᵢ⋅Pᵢ, input being the
+// concatenation of the encodings of the pairs Pᵢ||sᵢ (160 bytes each). There
+// must be at least one pair.
+func G1MSM(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%sizeG1MSM != 0 {
+		return nil, ErrInvalidInputLength
+	}
+	n := len(input) / sizeG1MSM
+	points := make([]bls12381.G1Affine, n)
+	scalars := make([]fr.Element, n)
+	for i := 0; i < n; i++ {
+		offset := i * sizeG1MSM
+		if err := UnmarshalG1(&points[i], input[offset:offset+sizeG1]); err != nil {
+			return nil, err
+		}
+		// reduce the scalar modulo r, the point is in the r-torsion
+		scalars[i].SetBytes(input[offset+sizeG1 : offset+sizeG1MSM])
+	}
+
+	var res bls12381.G1Affine
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return nil, err
+	}
+	return MarshalG1(&res), nil
+}
+
+// G2MSM computes the multi-scalar multiplication ∑ᵢ sᵢ⋅Qᵢ, input being the
+// concatenation of the encodings of the pairs Qᵢ||sᵢ (288 bytes each). There
+// must be at least one pair.
+func G2MSM(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%sizeG2MSM != 0 {
+		return nil, ErrInvalidInputLength
+	}
+	n := len(input) / sizeG2MSM
+	points := make([]bls12381.G2Affine, n)
+	scalars := make([]fr.Element, n)
+	for i := 0; i < n; i++ {
+		offset := i * sizeG2MSM
+		if err := UnmarshalG2(&points[i], input[offset:offset+sizeG2]); err != nil {
+			return nil, err
+		}
+		// reduce the scalar modulo r, the point is in the r-torsion
+		scalars[i].SetBytes(input[offset+sizeG2 : offset+sizeG2MSM])
+	}
+
+	var res bls12381.G2Affine
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return nil, err
+	}
+	return MarshalG2(&res), nil
+}
+
+// PairingCheck checks that the product of the pairings of the (G1, G2) pairs
+// encoded in input (384 bytes each) equals 1. There must be at least one pair.
+// It returns 1 (true) or 0 (false) as a 32-byte big-endian integer.
+func PairingCheck(input []byte) ([]byte, error) {
+	if len(input) == 0 || len(input)%sizePairing != 0 {
+		return nil, ErrInvalidInputLength
+	}
+	n := len(input) / sizePairing
+	P := make([]bls12381.G1Affine, n)
+	Q := make([]bls12381.G2Affine, n)
+	for i := 0; i < n; i++ {
+		offset := i * sizePairing
+		if err := UnmarshalG1(&P[i], input[offset:offset+sizeG1]); err != nil {
+			return nil, err
+		}
+		if err := UnmarshalG2(&Q[i], input[offset+sizeG1:offset+sizePairing]); err != nil {
+			return nil, err
+		}
+	}
+
+	ok, err := bls12381.PairingCheck(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]byte, 32)
+	if ok {
+		res[31] = 1
+	}
+	return res, nil
+}
+
+// MapFpToG1 maps the base field element encoded in input (64 bytes) to G1
+// with the SSWU map of RFC 9380 (BLS12381G1_XMD:SHA-256_SSWU_RO_ suite),
+// followed by the cofactor clearing.
+func MapFpToG1(input []byte) ([]byte, error) {
+	if len(input) != sizeFp {
+		return nil, ErrInvalidInputLength
+	}
+	var u fp.Element
+	if err := setFp(&u, input); err != nil {
+		return nil, err
+	}
+	res := bls12381.MapToG1(u)
+	return MarshalG1(&res), nil
+}
+
+// MapFp2ToG2 maps the Fp2 element encoded in input (128 bytes) to G2
+// with the SSWU map of RFC 9380 (BLS12381G2_XMD:SHA-256_SSWU_RO_ suite),
+// followed by the cofactor clearing.
+func MapFp2ToG2(input []byte) ([]byte, error) {
+	if len(input) != sizeFp2 {
+		return nil, ErrInvalidInputLength
+	}
+	var u bls12381.E2
+	if err := setFp2(&u, input); err != nil {
+		return nil, err
+	}
+	res := bls12381.MapToG2(u)
+	return MarshalG2(&res), nil
+}
+
+// G1MSMGas returns the gas cost of G1MSM(input).
+func G1MSMGas(input []byte) uint64 {
+	return msmGas(len(input)/sizeG1MSM, G1MulGas, g1MSMDiscount[:])
+}
+
+// G2MSMGas returns the gas cost of G2MSM(input).
+func G2MSMGas(input []byte) uint64 {
+	return msmGas(len(input)/sizeG2MSM, G2MulGas, g2MSMDiscount[:])
+}
+
+// PairingCheckGas returns the gas cost of PairingCheck(input).
+func PairingCheckGas(input []byte) uint64 {
+	return PairingBaseGas + PairingPerPairGas*uint64(len(input)/sizePairing)
+}
+
+// msmGas returns k⋅mulGas⋅discount(k)/1000, the discount being constant
+// after the end of the table.
+func msmGas(k int, mulGas uint64, discount []uint64) uint64 {
+	if k == 0 {
+		return 0
+	}
+	d := discount[len(discount)-1]
+	if k <= len(discount) {
+		d = discount[k-1]
+	}
+	return uint64(k) * mulGas * d / 1000
+}
+
+// discount tables of EIP-2537, for k = 1..128 pairs
+var (
+	g1MSMDiscount = [128]uint64{1000, 949, 848, 797, 764, 750, 738, 728, 719, 712, 705, 698, 692, 687, 682, 677, 673, 669, 665, 661, 658, 654, 651, 648, 645, 642, 640, 637, 635, 632, 630, 627, 625, 623, 621, 619, 617, 615, 613, 611, 609, 608, 606, 604, 603, 601, 599, 598, 596, 595, 593, 592, 591, 589, 588, 586, 585, 584, 582, 581, 580, 579, 577, 576, 575, 574, 573, 572, 570, 569, 568, 567, 566, 565, 564, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 551, 550, 549, 548, 547, 547, 546, 545, 544, 543, 542, 541, 540, 540, 539, 538, 537, 536, 536, 535, 534, 533, 532, 532, 531, 530, 529, 528, 528, 527, 526, 525, 525, 524, 523, 522, 522, 521, 520, 520, 519}
+	g2MSMDiscount = [128]uint64{1000, 1000, 923, 884, 855, 832, 812, 796, 782, 770, 759, 749, 740, 732, 724, 717, 711, 704, 699, 693, 688, 683, 679, 674, 670, 666, 663, 659, 655, 652, 649, 646, 643, 640, 637, 634, 632, 629, 627, 624, 622, 620, 618, 615, 613, 611, 609, 607, 606, 604, 602, 600, 598, 597, 595, 593, 592, 590, 589, 587, 586, 584, 583, 582, 580, 579, 578, 576, 575, 574, 573, 571, 570, 569, 568, 567, 566, 565, 563, 562, 561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 552, 551, 550, 549, 548, 547, 546, 545, 545, 544, 543, 542, 541, 541, 540, 539, 538, 537, 537, 536, 535, 535, 534, 533, 532, 532, 531, 530, 530, 529, 528, 528, 527, 526, 526, 525, 524, 524}
+)
@@ -0,0 +1,370 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evmprecompiles
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// 2⋅G₁, computed independently with affine formulas
+const g1x2 = "000000000000000000000000000000000572cbea904d67468808c8eb50a9450c9721db309128012543902d0ac358a62ae28f75bb8f1c7c42c39a8c5529bf0f4e" +
+	"00000000000000000000000000000000166a9d8cabc673a322fda673779d8e3822ba3ecb8670e461f73bb9021d5fd76a4c56d9d4cd16bd1bba86881979749d28"
+
+func TestG1Add(t *testing.T) {
+	_, _, g1Gen, _ := bls12381.Generators()
+	var inf, minusG bls12381.G1Affine
+	minusG.Neg(&g1Gen)
+	noSubGroup := g1NotInSubGroup(t)
+	var noSubGroup2 bls12381.G1Affine
+	noSubGroup2.Double(&noSubGroup)
+
+	valid := []struct {
+		name   string
+		input  []byte
+		output []byte
+	}{
+		{"G+G", concat(MarshalG1(&g1Gen), MarshalG1(&g1Gen)), decodeHex(t, g1x2)},
+		{"G+0", concat(MarshalG1(&g1Gen), MarshalG1(&inf)), MarshalG1(&g1Gen)},
+		{"0+0", concat(MarshalG1(&inf), MarshalG1(&inf)), MarshalG1(&inf)},
+		{"G-G", concat(MarshalG1(&g1Gen), MarshalG1(&minusG)), MarshalG1(&inf)},
+		{"no subgroup check", concat(MarshalG1(&noSubGroup), MarshalG1(&noSubGroup)), MarshalG1(&noSubGroup2)},
+	}
+	for _, c := range valid {
+		output, err := G1Add(c.input)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(output, c.output) {
+			t.Fatalf("%s: wrong output %x", c.name, output)
+		}
+	}
+
+	g := MarshalG1(&g1Gen)
+	topBytes := MarshalG1(&g1Gen)
+	topBytes[0] = 1
+	xOverflow := MarshalG1(&g1Gen)
+	fp.Modulus().FillBytes(xOverflow[:sizeFp])
+	notOnCurve := MarshalG1(&g1Gen)
+	notOnCurve[sizeG1-1] ^= 1
+
+	invalid := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{"empty input", nil, ErrInvalidInputLength},
+		{"short input", concat(g, g)[1:], ErrInvalidInputLength},
+		{"long input", concat(g, g, []byte{0}), ErrInvalidInputLength},
+		{"non zero top bytes", concat(topBytes, g), ErrInvalidFieldElement},
+		{"x = p", concat(g, xOverflow), ErrInvalidFieldElement},
+		{"not on curve", concat(notOnCurve, g), ErrPointNotOnCurve},
+	}
+	for _, c := range invalid {
+		if _, err := G1Add(c.input); err != c.err {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestG2Add(t *testing.T) {
+	_, _, _, g2Gen := bls12381.Generators()
+	var inf, minusG, g2x2 bls12381.G2Affine
+	minusG.Neg(&g2Gen)
+	g2x2.Double(&g2Gen)
+	noSubGroup := g2NotInSubGroup(t)
+	var noSubGroup2 bls12381.G2Affine
+	noSubGroup2.Double(&noSubGroup)
+
+	valid := []struct {
+		name   string
+		input  []byte
+		output []byte
+	}{
+		{"G+G", concat(MarshalG2(&g2Gen), MarshalG2(&g2Gen)), MarshalG2(&g2x2)},
+		{"G+0", concat(MarshalG2(&g2Gen), MarshalG2(&inf)), MarshalG2(&g2Gen)},
+		{"G-G", concat(MarshalG2(&g2Gen), MarshalG2(&minusG)), MarshalG2(&inf)},
+		{"no subgroup check", concat(MarshalG2(&noSubGroup), MarshalG2(&noSubGroup)), MarshalG2(&noSubGroup2)},
+	}
+	for _, c := range valid {
+		output, err := G2Add(c.input)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(output, c.output) {
+			t.Fatalf("%s: wrong output %x", c.name, output)
+		}
+	}
+
+	g := MarshalG2(&g2Gen)
+	notOnCurve := MarshalG2(&g2Gen)
+	notOnCurve[sizeG2-1] ^= 1
+	if _, err := G2Add(concat(g, notOnCurve)); err != ErrPointNotOnCurve {
+		t.Fatalf("expected %v, got %v", ErrPointNotOnCurve, err)
+	}
+	if _, err := G2Add(g); err != ErrInvalidInputLength {
+		t.Fatalf("expected %v, got %v", ErrInvalidInputLength, err)
+	}
+}
+
+func TestMSM(t *testing.T) {
+	_, _, g1Gen, g2Gen := bls12381.Generators()
+	order := fr.Modulus()
+	scalar := func(s *big.Int) []byte {
+		b := make([]byte, sizeScalar)
+		s.FillBytes(b)
+		return b
+	}
+
+	// ∑ᵢ sᵢ⋅[i+1]G
+	s := []*big.Int{big.NewInt(2), new(big.Int).Add(order, big.NewInt(5)), big.NewInt(0)}
+	var in1, in2 []byte
+	var exp1, tmp1 bls12381.G1Affine
+	var exp2, tmp2 bls12381.G2Affine
+	for i := range s {
+		tmp1.ScalarMultiplication(&g1Gen, big.NewInt(int64(i+1)))
+		tmp2.ScalarMultiplication(&g2Gen, big.NewInt(int64(i+1)))
+		in1 = concat(in1, MarshalG1(&tmp1), scalar(s[i]))
+		in2 = concat(in2, MarshalG2(&tmp2), scalar(s[i]))
+		tmp1.ScalarMultiplication(&tmp1, s[i])
+		tmp2.ScalarMultiplication(&tmp2, s[i])
+		exp1.Add(&exp1, &tmp1)
+		exp2.Add(&exp2, &tmp2)
+	}
+
+	output, err := G1MSM(in1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(output, MarshalG1(&exp1)) {
+		t.Fatal("wrong G1MSM output")
+	}
+	output, err = G1MSM(concat(MarshalG1(&g1Gen), scalar(big.NewInt(2))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(output, decodeHex(t, g1x2)) {
+		t.Fatal("wrong G1MSM output for 2⋅G₁")
+	}
+	output, err = G2MSM(in2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(output, MarshalG2(&exp2)) {
+		t.Fatal("wrong G2MSM output")
+	}
+
+	// subgroup checks and lengths
+	noSubGroup1 := g1NotInSubGroup(t)
+	noSubGroup2 := g2NotInSubGroup(t)
+	if _, err := G1MSM(concat(MarshalG1(&noSubGroup1), scalar(big.NewInt(1)))); err != ErrPointNotInSubGroup {
+		t.Fatalf("expected %v, got %v", ErrPointNotInSubGroup, err)
+	}
+	if _, err := G2MSM(concat(MarshalG2(&noSubGroup2), scalar(big.NewInt(1)))); err != ErrPointNotInSubGroup {
+		t.Fatalf("expected %v, got %v", ErrPointNotInSubGroup, err)
+	}
+	if _, err := G1MSM(nil); err != ErrInvalidInputLength {
+		t.Fatalf("expected %v, got %v", ErrInvalidInputLength, err)
+	}
+	if _, err := G2MSM(in2[1:]); err != ErrInvalidInputLength {
+		t.Fatalf("expected %v, got %v", ErrInvalidInputLength, err)
+	}
+
+	// gas
+	gas := []struct {
+		name     string
+		got, exp uint64
+	}{
+		{"G1MSM k=1", G1MSMGas(make([]byte, sizeG1MSM)), 12000},
+		{"G1MSM k=2", G1MSMGas(make([]byte, 2*sizeG1MSM)), 22776},
+		{"G1MSM k=200", G1MSMGas(make([]byte, 200*sizeG1MSM)), 1245600},
+		{"G2MSM k=1", G2MSMGas(make([]byte, sizeG2MSM)), 22500},
+		{"G2MSM k=3", G2MSMGas(make([]byte, 3*sizeG2MSM)), 62302},
+		{"G2MSM k=200", G2MSMGas(make([]byte, 200*sizeG2MSM)), 2358000},
+	}
+	for _, c := range gas {
+		if c.got != c.exp {
+			t.Fatalf("%s: expected %d, got %d", c.name, c.exp, c.got)
+		}
+	}
+}
+
+func TestPairingCheck(t *testing.T) {
+	_, _, g1Gen, g2Gen := bls12381.Generators()
+
+	// e(aG₁, bG₂)⋅e(-abG₁, G₂) = 1
+	a, b := big.NewInt(17), big.NewInt(42)
+	var P1, P2 bls12381.G1Affine
+	var Q1 bls12381.G2Affine
+	P1.ScalarMultiplication(&g1Gen, a)
+	Q1.ScalarMultiplication(&g2Gen, b)
+	P2.ScalarMultiplication(&g1Gen, new(big.Int).Mul(a, b))
+	P2.Neg(&P2)
+
+	pair := func(P *bls12381.G1Affine, Q *bls12381.G2Affine) []byte {
+		return concat(MarshalG1(P), MarshalG2(Q))
+	}
+	var inf1 bls12381.G1Affine
+	var inf2 bls12381.G2Affine
+	one := make([]byte, 32)
+	one[31] = 1
+	zero := make([]byte, 32)
+
+	valid := []struct {
+		name   string
+		input  []byte
+		output []byte
+	}{
+		{"e(aG₁, bG₂)⋅e(-abG₁, G₂)", concat(pair(&P1, &Q1), pair(&P2, &g2Gen)), one},
+		{"e(aG₁, bG₂)⋅e(abG₁, G₂)", concat(pair(&P1, &Q1), pair(new(bls12381.G1Affine).Neg(&P2), &g2Gen)), zero},
+		{"e(G₁, G₂)", pair(&g1Gen, &g2Gen), zero},
+		{"e(0, G₂)", pair(&inf1, &g2Gen), one},
+		{"e(G₁, 0)", pair(&g1Gen, &inf2), one},
+	}
+	for _, c := range valid {
+		output, err := PairingCheck(c.input)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(output, c.output) {
+			t.Fatalf("%s: wrong output %x", c.name, output)
+		}
+	}
+
+	noSubGroup1 := g1NotInSubGroup(t)
+	noSubGroup2 := g2NotInSubGroup(t)
+	invalid := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{"empty input", nil, ErrInvalidInputLength},
+		{"invalid length", pair(&g1Gen, &g2Gen)[1:], ErrInvalidInputLength},
+		{"G₁ point not in subgroup", pair(&noSubGroup1, &g2Gen), ErrPointNotInSubGroup},
+		{"G₂ point not in subgroup", pair(&g1Gen, &noSubGroup2), ErrPointNotInSubGroup},
+	}
+	for _, c := range invalid {
+		if _, err := PairingCheck(c.input); err != c.err {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.err, err)
+		}
+	}
+
+	if gas := PairingCheckGas(make([]byte, 2*sizePairing)); gas != 102900 {
+		t.Fatalf("wrong gas %d", gas)
+	}
+}
+
+// the inputs and outputs of the maps are the ones of the RFC 9380
+// BLS12381G1_XMD:SHA-256_SSWU_NU_ and BLS12381G2_XMD:SHA-256_SSWU_NU_
+// suites, for the empty message.
+func TestMapToCurve(t *testing.T) {
+	u := fpHex(t, "156c8a6a2c184569d69a76be144b5cdc5141d2d2ca4fe341f011e25e3969c55ad9e9b9ce2eb833c81a908e5fa4ac5f03")
+	P := fpHex(t, "184bb665c37ff561a89ec2122dd343f20e0f4cbcaec84e3c3052ea81d1834e192c426074b02ed3dca4e7676ce4ce48ba") +
+		fpHex(t, "04407b8d35af4dacc809927071fc0405218f1401a6d15af775810e4e460064bcc9468beeba82fdc751be70476c888bf3")
+	output, err := MapFpToG1(decodeHex(t, u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(output) != P {
+		t.Fatalf("wrong MapFpToG1 output %x", output)
+	}
+
+	u = fpHex(t, "07355d25caf6e7f2f0cb2812ca0e513bd026ed09dda65b177500fa31714e09ea0ded3a078b526bed3307f804d4b93b04") +
+		fpHex(t, "02829ce3c021339ccb5caf3e187f6370e1e2a311dec9b75363117063ab2015603ff52c3d3b98f19c2f65575e99e8b78c")
+	P = fpHex(t, "00e7f4568a82b4b7dc1f14c6aaa055edf51502319c723c4dc2688c7fe5944c213f510328082396515734b6612c4e7bb7") +
+		fpHex(t, "126b855e9e69b1f691f816e48ac6977664d24d99f8724868a184186469ddfd4617367e94527d4b74fc86413483afb35b") +
+		fpHex(t, "0caead0fd7b6176c01436833c79d305c78be307da5f6af6c133c47311def6ff1e0babf57a0fb5539fce7ee12407b0a42") +
+		fpHex(t, "1498aadcf7ae2b345243e281ae076df6de84455d766ab6fcdaad71fab60abb2e8b980a440043cd305db09d283c895e3d")
+	output, err = MapFp2ToG2(decodeHex(t, u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(output) != P {
+		t.Fatalf("wrong MapFp2ToG2 output %x", output)
+	}
+
+	overflow := make([]byte, sizeFp)
+	fp.Modulus().FillBytes(overflow)
+	if _, err := MapFpToG1(overflow); err != ErrInvalidFieldElement {
+		t.Fatalf("expected %v, got %v", ErrInvalidFieldElement, err)
+	}
+	if _, err := MapFp2ToG2(overflow); err != ErrInvalidInputLength {
+		t.Fatalf("expected %v, got %v", ErrInvalidInputLength, err)
+	}
+}
+
+// g1NotInSubGroup returns a point on the curve outside of the r-torsion.
+func g1NotInSubGroup(t *testing.T) bls12381.G1Affine {
+	t.Helper()
+	var p bls12381.G1Affine
+	var b fp.Element
+	b.SetUint64(4)
+	for x := uint64(1); ; x++ {
+		p.X.SetUint64(x)
+		p.Y.Square(&p.X).Mul(&p.Y, &p.X).Add(&p.Y, &b)
+		if p.Y.Sqrt(&p.Y) != nil && !p.IsInSubGroup() {
+			return p
+		}
+	}
+}
+
+// g2NotInSubGroup returns a point on the twist outside of the r-torsion.
+func g2NotInSubGroup(t *testing.T) bls12381.G2Affine {
+	t.Helper()
+	var p bls12381.G2Affine
+	var b bls12381.E2
+	b.A0.SetUint64(4)
+	b.A1.SetUint64(4)
+	for x := uint64(1); ; x++ {
+		p.X.A0.SetUint64(x)
+		p.Y.Square(&p.X).Mul(&p.Y, &p.X).Add(&p.Y, &b)
+		if p.Y.Legendre() == 1 {
+			p.Y.Sqrt(&p.Y)
+			if !p.IsInSubGroup() {
+				return p
+			}
+		}
+	}
+}
+
+// fpHex returns the 64-byte encoding of a 48-byte hex field element.
+func fpHex(t *testing.T, s string) string {
+	t.Helper()
+	return strings.Repeat("0", 2*(sizeFp-fp.Bytes)) + s
+}
+
+func concat(bufs ...[]byte) []byte {
+	var res []byte
+	for _, b := range bufs {
+		res = append(res, b...)
+	}
+	return res
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
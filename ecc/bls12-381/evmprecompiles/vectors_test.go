@@ -0,0 +1,112 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evmprecompiles
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// precompiledTest and precompiledFailureTest are the formats of the
+// go-ethereum precompile tests. The files of testdata/ are copied from
+// go-ethereum v1.17.7 (core/vm/testdata/precompiles), see
+// testdata/LICENSE.go-ethereum.
+type precompiledTest struct {
+	Input, Expected string
+	Gas             uint64
+	Name            string
+	NoBenchmark     bool
+}
+
+type precompiledFailureTest struct {
+	Input         string
+	ExpectedError string
+	Name          string
+}
+
+// failureCategories maps the go-ethereum error messages to the errors
+// returned by this package: only the kind of failure is compared.
+var failureCategories = map[string]error{
+	"invalid input length":                ErrInvalidInputLength,
+	"invalid field element top bytes":     ErrInvalidFieldElement,
+	"invalid fp.Element encoding":         ErrInvalidFieldElement,
+	"invalid point: not on curve":         ErrPointNotOnCurve,
+	"g1 point is not on correct subgroup": ErrPointNotInSubGroup,
+	"g2 point is not on correct subgroup": ErrPointNotInSubGroup,
+}
+
+func TestVectors(t *testing.T) {
+	// the blsG1Mul and blsG2Mul files are multi-scalar multiplications with a
+	// single pair, EIP-2537 has no separate multiplication precompile
+	precompiles := []struct {
+		name string
+		run  func([]byte) ([]byte, error)
+		gas  func([]byte) uint64
+	}{
+		{"blsG1Add", G1Add, func([]byte) uint64 { return G1AddGas }},
+		{"blsG2Add", G2Add, func([]byte) uint64 { return G2AddGas }},
+		{"blsG1Mul", G1MSM, G1MSMGas},
+		{"blsG2Mul", G2MSM, G2MSMGas},
+		{"blsG1MultiExp", G1MSM, G1MSMGas},
+		{"blsG2MultiExp", G2MSM, G2MSMGas},
+		{"blsPairing", PairingCheck, PairingCheckGas},
+		{"blsMapG1", MapFpToG1, func([]byte) uint64 { return MapFpToG1Gas }},
+		{"blsMapG2", MapFp2ToG2, func([]byte) uint64 { return MapFp2ToG2Gas }},
+	}
+	for _, p := range precompiles {
+		var valid []precompiledTest
+		readVectors(t, p.name, &valid)
+		for _, v := range valid {
+			input := decodeHex(t, v.Input)
+			output, err := p.run(input)
+			if err != nil {
+				t.Fatalf("%s/%s: %v", p.name, v.Name, err)
+			}
+			if hex.EncodeToString(output) != v.Expected {
+				t.Fatalf("%s/%s: wrong output %x", p.name, v.Name, output)
+			}
+			if gas := p.gas(input); gas != v.Gas {
+				t.Fatalf("%s/%s: expected gas %d, got %d", p.name, v.Name, v.Gas, gas)
+			}
+		}
+
+		var invalid []precompiledFailureTest
+		readVectors(t, "fail-"+p.name, &invalid)
+		for _, v := range invalid {
+			expected, ok := failureCategories[v.ExpectedError]
+			if !ok {
+				t.Fatalf("%s/%s: unknown error %q", p.name, v.Name, v.ExpectedError)
+			}
+			if _, err := p.run(decodeHex(t, v.Input)); !errors.Is(err, expected) {
+				t.Fatalf("%s/%s: expected %v, got %v", p.name, v.Name, expected, err)
+			}
+		}
+	}
+}
+
+func readVectors(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,499 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrDivisionByZero  = errors.New("division by the zero polynomial")
+	ErrDuplicatePoints = errors.New("the interpolation points must be distinct")
+	ErrSizeMismatch    = errors.New("the number of points and values must be the same")
+)
+
+const (
+	// below karatsubaThreshold, polynomials are multiplied with the schoolbook method.
+	karatsubaThreshold = 16
+
+	// when the size of the product is above fftThreshold, polynomials are
+	// multiplied using FFTs.
+	fftThreshold = 64
+
+	// below subproductThreshold points, multi-point evaluation uses Horner's method.
+	subproductThreshold = 32
+)
+
+// domains caches the fft domains used for the multiplication, indexed by their cardinality.
+var domains sync.Map
+
+func getDomain(size uint64) *fft.Domain {
+	if d, ok := domains.Load(size); ok {
+		return d.(*fft.Domain)
+	}
+	d, _ := domains.LoadOrStore(size, fft.NewDomain(size))
+	return d.(*fft.Domain)
+}
+
+// Mul sets p to p1*p2 and returns it.
+//
+// The product is computed using the schoolbook method for small polynomials,
+// Karatsuba's method for medium ones and FFTs above. This function allocates
+// a new slice.
+func (p *Polynomial) Mul(p1, p2 Polynomial) *Polynomial {
+	if len(p1) == 0 || len(p2) == 0 {
+		*p = Polynomial{}
+		return p
+	}
+	size := len(p1) + len(p2) - 1
+	if size >= fftThreshold && len(p1) >= karatsubaThreshold && len(p2) >= karatsubaThreshold {
+		*p = mulFFT(p1, p2)
+		return p
+	}
+	*p = mulKaratsuba(p1, p2)
+	return p
+}
+
+// mulSchoolbook returns a*b
+func mulSchoolbook(a, b Polynomial) Polynomial {
+	res := make(Polynomial, len(a)+len(b)-1)
+	var t fr.Element
+	for i := range a {
+		for j := range b {
+			t.Mul(&a[i], &b[j])
+			res[i+j].Add(&res[i+j], &t)
+		}
+	}
+	return res
+}
+
+// mulKaratsuba returns a*b, a and b being non empty.
+func mulKaratsuba(a, b Polynomial) Polynomial {
+	if len(a) < karatsubaThreshold || len(b) < karatsubaThreshold {
+		return mulSchoolbook(a, b)
+	}
+
+	// a = a₀ + Xᵐa₁, b = b₀ + Xᵐb₁
+	m := len(a)
+	if len(b) > m {
+		m = len(b)
+	}
+	m /= 2
+	res := make(Polynomial, len(a)+len(b)-1)
+
+	// unbalanced case: a·b = a·b₀ + Xᵐ·a·b₁
+	if len(a) <= m || len(b) <= m {
+		if len(a) > len(b) {
+			a, b = b, a
+		}
+		low := mulKaratsuba(a, b[:m])
+		high := mulKaratsuba(a, b[m:])
+		copy(res, low)
+		addAt(res, high, m)
+		return res
+	}
+
+	a0, a1 := a[:m], a[m:]
+	b0, b1 := b[:m], b[m:]
+	z0 := mulKaratsuba(a0, b0)
+	z2 := mulKaratsuba(a1, b1)
+
+	var s0, s1 Polynomial
+	s0.Add(a0, a1)
+	s1.Add(b0, b1)
+	z1 := mulKaratsuba(s0, s1)
+	for i := range z0 {
+		z1[i].Sub(&z1[i], &z0[i])
+	}
+	for i := range z2 {
+		z1[i].Sub(&z1[i], &z2[i])
+	}
+
+	copy(res, z0)
+	addAt(res, z1, m)
+	addAt(res, z2, 2*m)
+	return res
+}
+
+// addAt adds Xᵒᶠᶠˢᵉᵗ·b to res.
+func addAt(res, b Polynomial, offset int) {
+	for i := range b {
+		if i+offset >= len(res) {
+			// the leading coefficients are zero
+			break
+		}
+		res[i+offset].Add(&res[i+offset], &b[i])
+	}
+}
+
+// mulFFT returns a*b, computed with FFTs.
+func mulFFT(a, b Polynomial) Polynomial {
+	size := len(a) + len(b) - 1
+	d := getDomain(ecc.NextPowerOfTwo(uint64(size)))
+
+	_a := make([]fr.Element, d.Cardinality)
+	_b := make([]fr.Element, d.Cardinality)
+	copy(_a, a)
+	copy(_b, b)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		d.FFT(_b, fft.DIF)
+		wg.Done()
+	}()
+	d.FFT(_a, fft.DIF)
+	wg.Wait()
+
+	parallel.Execute(len(_a), func(start, end int) {
+		for i := start; i < end; i++ {
+			_a[i].Mul(&_a[i], &_b[i])
+		}
+	})
+	d.FFTInverse(_a, fft.DIT)
+
+	return _a[:size]
+}
+
+// trim returns p without its leading zero coefficients.
+func trim(p Polynomial) Polynomial {
+	n := len(p)
+	for n > 0 && p[n-1].IsZero() {
+		n--
+	}
+	return p[:n]
+}
+
+// DivRem returns q and r such that a = b·q + r, with deg r < deg b.
+//
+// The leading zero coefficients of b are ignored. q has len(a)-len(b)+1
+// coefficients and r has len(b)-1 coefficients.
+func DivRem(a, b Polynomial) (q, r Polynomial, err error) {
+	b = trim(b)
+	if len(b) == 0 {
+		return nil, nil, ErrDivisionByZero
+	}
+	if len(a) < len(b) {
+		r = make(Polynomial, len(b)-1)
+		copy(r, a)
+		return Polynomial{}, r, nil
+	}
+
+	lq := len(a) - len(b) + 1
+	if lq >= fftThreshold && len(b) >= fftThreshold {
+		q = divNewton(a, b)
+	} else {
+		q = divSchoolbook(a, b)
+	}
+
+	// r = a - b·q
+	var bq Polynomial
+	bq.Mul(b, q)
+	r = make(Polynomial, len(b)-1)
+	for i := range r {
+		r[i].Sub(&a[i], &bq[i])
+	}
+
+	return q, r, nil
+}
+
+// divSchoolbook returns the quotient of a by b using long division,
+// b having a non-zero leading coefficient.
+func divSchoolbook(a, b Polynomial) Polynomial {
+	rem := a.Clone()
+	q := make(Polynomial, len(a)-len(b)+1)
+
+	var lcInv, t fr.Element
+	lcInv.Inverse(&b[len(b)-1])
+	for i := len(q) - 1; i >= 0; i-- {
+		q[i].Mul(&rem[i+len(b)-1], &lcInv)
+		if q[i].IsZero() {
+			continue
+		}
+		for j := range b {
+			t.Mul(&q[i], &b[j])
+			rem[i+j].Sub(&rem[i+j], &t)
+		}
+	}
+	return q
+}
+
+// divNewton returns the quotient of a by b, computed as the reversed product
+// of the reversal of a by the inverse of the reversal of b modulo Xᵏ,
+// k = len(a)-len(b)+1.
+func divNewton(a, b Polynomial) Polynomial {
+	k := len(a) - len(b) + 1
+	inv := inverseSeries(reverse(b), k)
+	var q Polynomial
+	ra := reverse(a)
+	q.Mul(ra[:k], inv)
+	q = q[:k]
+	return reverse(q)
+}
+
+// inverseSeries returns f⁻¹ mod Xᵏ, f[0] being non zero, using Newton's
+// iteration g ← g·(2 - f·g).
+func inverseSeries(f Polynomial, k int) Polynomial {
+	g := make(Polynomial, 1, k)
+	g[0].Inverse(&f[0])
+
+	var two fr.Element
+	two.SetUint64(2)
+
+	var fg, t Polynomial
+	for l := 1; l < k; {
+		l *= 2
+		if l > k {
+			l = k
+		}
+		fl := f
+		if len(fl) > l {
+			fl = fl[:l]
+		}
+		fg.Mul(fl, g)
+		if len(fg) > l {
+			fg = fg[:l]
+		}
+		// 2 - f·g
+		for i := range fg {
+			fg[i].Neg(&fg[i])
+		}
+		fg[0].Add(&fg[0], &two)
+		t.Mul(g, fg)
+		if len(t) > l {
+			t = t[:l]
+		}
+		g = t.Clone()
+	}
+	return g
+}
+
+// reverse returns Xⁿ⁻¹·p(1/X) where n = len(p).
+func reverse(p Polynomial) Polynomial {
+	res := make(Polynomial, len(p))
+	for i := range p {
+		res[len(p)-1-i] = p[i]
+	}
+	return res
+}
+
+// DivideByVanishing returns q and r such that p = (Xⁿ-1)·q + r, with deg r < n,
+// that is the division of p by the vanishing polynomial of the subgroup of size n.
+// It runs in linear time.
+func DivideByVanishing(p Polynomial, n int) (q, r Polynomial) {
+	if len(p) <= n {
+		return Polynomial{}, p.Clone()
+	}
+	q = make(Polynomial, len(p)-n)
+	for i := len(q) - 1; i >= 0; i-- {
+		q[i] = p[i+n]
+		if i+n < len(q) {
+			q[i].Add(&q[i], &q[i+n])
+		}
+	}
+	r = make(Polynomial, n)
+	copy(r, p[:n])
+	for i := 0; i < n && i < len(q); i++ {
+		r[i].Add(&r[i], &q[i])
+	}
+	return q, r
+}
+
+// GCD returns the monic greatest common divisor of a and b. If a and b are
+// both zero, the result is the zero polynomial.
+func GCD(a, b Polynomial) Polynomial {
+	a, b = trim(a.Clone()), trim(b.Clone())
+	for len(b) != 0 {
+		_, r, _ := DivRem(a, b)
+		a, b = b, trim(r)
+	}
+	if len(a) == 0 {
+		return Polynomial{}
+	}
+	var lcInv fr.Element
+	lcInv.Inverse(&a[len(a)-1])
+	a.ScaleInPlace(&lcInv)
+	return a
+}
+
+// BatchEval evaluates each polynomial of ps at x.
+func BatchEval(ps []Polynomial, x *fr.Element) []fr.Element {
+	res := make([]fr.Element, len(ps))
+	parallel.Execute(len(ps), func(start, end int) {
+		for i := start; i < end; i++ {
+			if len(ps[i]) != 0 {
+				res[i] = ps[i].Eval(x)
+			}
+		}
+	})
+	return res
+}
+
+// subproductTree stores the products of the (X - xᵢ) in a binary tree.
+// tree[0] contains the (X - xᵢ), tree[k+1][j] = tree[k][2j]·tree[k][2j+1],
+// and the last level contains Π(X - xᵢ). When a level has an odd number
+// of nodes, the last one is carried to the next level as is.
+type subproductTree [][]Polynomial
+
+func newSubproductTree(points []fr.Element) subproductTree {
+	level := make([]Polynomial, len(points))
+	for i := range points {
+		level[i] = make(Polynomial, 2)
+		level[i][0].Neg(&points[i])
+		level[i][1].SetOne()
+	}
+	tree := subproductTree{level}
+	for len(level) > 1 {
+		next := make([]Polynomial, (len(level)+1)/2)
+		parallel.Execute(len(next), func(start, end int) {
+			for j := start; j < end; j++ {
+				if 2*j+1 < len(level) {
+					next[j].Mul(level[2*j], level[2*j+1])
+				} else {
+					next[j] = level[2*j]
+				}
+			}
+		}, len(next))
+		tree = append(tree, next)
+		level = next
+	}
+	return tree
+}
+
+// root returns Π(X - xᵢ)
+func (tree subproductTree) root() Polynomial {
+	return tree[len(tree)-1][0]
+}
+
+// eval evaluates p on the leaves of the tree, by reducing p modulo each node.
+func (tree subproductTree) eval(p Polynomial) []fr.Element {
+	remainders := []Polynomial{mod(p, tree.root())}
+	for k := len(tree) - 2; k >= 0; k-- {
+		level := tree[k]
+		next := make([]Polynomial, len(level))
+		for j := range remainders {
+			for c := 2 * j; c < 2*j+2 && c < len(level); c++ {
+				next[c] = mod(remainders[j], level[c])
+			}
+		}
+		remainders = next
+	}
+	res := make([]fr.Element, len(remainders))
+	for i := range remainders {
+		if len(remainders[i]) != 0 {
+			res[i] = remainders[i][0]
+		}
+	}
+	return res
+}
+
+// mod returns p mod m, m being monic.
+func mod(p, m Polynomial) Polynomial {
+	if len(p) < len(m) {
+		return p
+	}
+	_, r, _ := DivRem(p, m)
+	return r
+}
+
+// Vanishing returns the polynomial Π(X - xᵢ).
+func Vanishing(points []fr.Element) Polynomial {
+	if len(points) == 0 {
+		res := make(Polynomial, 1)
+		res[0].SetOne()
+		return res
+	}
+	return newSubproductTree(points).root()
+}
+
+// EvalMultiPoints evaluates p on each of the points. Above a few points,
+// it uses a subproduct tree.
+func (p *Polynomial) EvalMultiPoints(points []fr.Element) []fr.Element {
+	if len(points) < subproductThreshold || len(*p) < subproductThreshold {
+		res := make([]fr.Element, len(points))
+		if len(*p) == 0 {
+			return res
+		}
+		parallel.Execute(len(points), func(start, end int) {
+			for i := start; i < end; i++ {
+				res[i] = p.Eval(&points[i])
+			}
+		})
+		return res
+	}
+	return newSubproductTree(points).eval(*p)
+}
+
+// Interpolate returns the polynomial P of degree < len(xs) such that
+// P(xᵢ) = yᵢ. The xᵢ must be distinct.
+func Interpolate(xs, ys []fr.Element) (Polynomial, error) {
+	if len(xs) != len(ys) {
+		return nil, ErrSizeMismatch
+	}
+	if len(xs) == 0 {
+		return Polynomial{}, nil
+	}
+	tree := newSubproductTree(xs)
+
+	// M = Π(X - xᵢ), P(leafᵢ) = yᵢ/M'(xᵢ)
+	m := tree.root()
+	dm := make(Polynomial, len(m)-1)
+	for i := range dm {
+		dm[i].SetUint64(uint64(i + 1))
+		dm[i].Mul(&dm[i], &m[i+1])
+	}
+	w := tree.eval(dm)
+	for i := range w {
+		if w[i].IsZero() {
+			return nil, ErrDuplicatePoints
+		}
+	}
+	w = fr.BatchInvert(w)
+
+	// bottom-up: P(node) = P(left)·M(right) + P(right)·M(left)
+	level := make([]Polynomial, len(xs))
+	for i := range level {
+		level[i] = Polynomial{w[i]}
+		level[i][0].Mul(&level[i][0], &ys[i])
+	}
+	for k := 0; k < len(tree)-1; k++ {
+		next := make([]Polynomial, len(tree[k+1]))
+		parallel.Execute(len(next), func(start, end int) {
+			for j := start; j < end; j++ {
+				if 2*j+1 >= len(level) {
+					next[j] = level[2*j]
+					continue
+				}
+				var l, r Polynomial
+				l.Mul(level[2*j], tree[k][2*j+1])
+				r.Mul(level[2*j+1], tree[k][2*j])
+				next[j].Add(l, r)
+			}
+		}, len(next))
+		level = next
+	}
+
+	res := make(Polynomial, len(xs))
+	copy(res, level[0])
+	return res, nil
+}
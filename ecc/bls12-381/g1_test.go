@@ -25,6 +25,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/prop"
 )
@@ -507,9 +508,77 @@ func TestG1AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-381] ScalarMultiplicationCT and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var q G1Jac
+			var qAff G1Affine
+			q.ScalarMultiplication(&g1Gen, big.NewInt(5))
+			qAff.FromJacobian(&q)
+
+			var scalar, negScalar big.Int
+			s.BigInt(&scalar)
+			negScalar.Neg(&scalar)
+			for _, k := range []*big.Int{&scalar, &negScalar} {
+				var op1, op2, op3, op4 G1Jac
+				op1.ScalarMultiplication(&q, k)
+				op2.ScalarMultiplicationCT(&q, k)
+				op3.ScalarMultiplicationBase(k)
+				op4.ScalarMultiplicationBaseCT(k)
+
+				var op5, op6 G1Affine
+				op5.FromJacobian(&op1)
+				op6.ScalarMultiplicationCT(&qAff, k)
+
+				if !op1.Equal(&op2) || !op3.Equal(&op4) || !op5.Equal(&op6) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.Property("[BLS12-381] ScalarMultiplicationCT should handle the point at infinity and the zero scalar", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var scalar big.Int
+			s.BigInt(&scalar)
+
+			var op1, op2 G1Jac
+			op1.ScalarMultiplicationCT(&g1Infinity, &scalar)
+			op2.ScalarMultiplicationCT(&g1Gen, fr.Modulus())
+
+			var op3, inf G1Affine
+			op3.ScalarMultiplicationCT(&inf, &scalar)
+
+			return op1.Equal(&g1Infinity) && op1.Z.IsZero() && op1.X.IsOne() && op1.Y.IsOne() &&
+				op2.Equal(&g1Infinity) && op3.IsInfinity()
+		},
+		genScalar,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG1AffineScalarMultiplicationCTTiming(t *testing.T) {
+	// class 0: s = 1, class 1: random s
+	const n = 1 << 11
+	var scalars [2][n]big.Int
+	for i := 0; i < n; i++ {
+		var s fr.Element
+		if _, err := s.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		s.BigInt(&scalars[1][i])
+		scalars[0][i].SetUint64(1)
+	}
+	var p G1Jac
+	testutils.ConstantTime(t, n, func(c, i int) {
+		p.ScalarMultiplicationCT(&g1Gen, &scalars[c][i])
+	})
+}
+
 func TestG1AffineCofactorCleaning(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -735,6 +804,14 @@ func BenchmarkG1JacScalarMultiplication(b *testing.B) {
 		}
 	})
 
+	var ct G1Jac
+	b.Run("constant time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			ct.ScalarMultiplicationCT(&g1Gen, &scalar)
+		}
+	})
+
 }
 
 func BenchmarkG1AffineCofactorClearing(b *testing.B) {
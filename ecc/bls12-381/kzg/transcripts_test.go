@@ -0,0 +1,274 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// the public transcripts are too large to be part of the tests, the tests below
+// import synthetic transcripts of an SRS with a known secret, in the same formats.
+// TestImportEthereumCeremonyFixture imports an excerpt of the public transcript.
+
+// testPowersOfTau returns the SRS of size powers of alpha, and the first nbG2 [alphaⁱ]G₂
+func testPowersOfTau(t *testing.T, size, nbG2 int, alpha int64) (*SRS, []bls12381.G2Affine) {
+	srs, err := NewSRS(uint64(size), big.NewInt(alpha))
+	require.NoError(t, err)
+	g2 := make([]bls12381.G2Affine, nbG2)
+	g2[0] = srs.Vk.G2[0]
+	for i := 1; i < nbG2; i++ {
+		g2[i].ScalarMultiplication(&g2[i-1], big.NewInt(alpha))
+	}
+	return srs, g2
+}
+
+// writePtau returns a snarkjs powers-of-tau file of the given power
+func writePtau(t *testing.T, power int, alpha int64) (*SRS, []byte) {
+	srs, g2 := testPowersOfTau(t, (1<<(power+1))-1, 1<<power, alpha)
+
+	var buf bytes.Buffer
+	write := func(data interface{}) {
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, data))
+	}
+	buf.WriteString("ptau")
+	write(uint32(1))
+	write(uint32(4))
+
+	// header, the coordinates are in Montgomery form, as fp.Element
+	q := make([]byte, fp.Bytes)
+	fp.Modulus().FillBytes(q)
+	for i := 0; i < fp.Bytes/2; i++ {
+		q[i], q[fp.Bytes-1-i] = q[fp.Bytes-1-i], q[i]
+	}
+	write(uint32(1))
+	write(uint64(4 + fp.Bytes + 8))
+	write(uint32(fp.Bytes))
+	write(q)
+	write(uint32(power))
+	write(uint32(power))
+
+	// τG₁
+	write(uint32(2))
+	write(uint64(len(srs.Pk.G1) * 2 * fp.Bytes))
+	for i := range srs.Pk.G1 {
+		write(srs.Pk.G1[i].X)
+		write(srs.Pk.G1[i].Y)
+	}
+
+	// section ignored by the import
+	write(uint32(7))
+	write(uint64(3))
+	write([]byte{1, 2, 3})
+
+	// τG₂
+	write(uint32(3))
+	write(uint64(len(g2) * 4 * fp.Bytes))
+	for i := range g2 {
+		write(g2[i].X.A0)
+		write(g2[i].X.A1)
+		write(g2[i].Y.A0)
+		write(g2[i].Y.A1)
+	}
+
+	return srs, buf.Bytes()
+}
+
+func TestImportPtau(t *testing.T) {
+	const power = 4
+	const size = 20
+	ref, ptau := writePtau(t, power, 42)
+
+	srs, err := ImportPtau(bytes.NewReader(ptau), size)
+	require.NoError(t, err)
+	require.Equal(t, ref.Pk.G1[:size], srs.Pk.G1)
+	require.Equal(t, ref.Vk, srs.Vk)
+
+	srs, err = ImportPtau(bytes.NewReader(ptau), uint64(len(ref.Pk.G1)))
+	require.NoError(t, err)
+	require.Equal(t, ref.Pk.G1, srs.Pk.G1)
+
+	t.Run("not enough powers", func(t *testing.T) {
+		_, err := ImportPtau(bytes.NewReader(ptau), uint64(len(ref.Pk.G1))+1)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("not a ptau file", func(t *testing.T) {
+		tampered := append([]byte{}, ptau...)
+		tampered[0] = 'q'
+		_, err := ImportPtau(bytes.NewReader(tampered), size)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("wrong curve", func(t *testing.T) {
+		tampered := append([]byte{}, ptau...)
+		// first byte of q
+		tampered[12+12+4]++
+		_, err := ImportPtau(bytes.NewReader(tampered), size)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := ImportPtau(bytes.NewReader(ptau[:len(ptau)/2]), size)
+		require.Error(t, err)
+	})
+
+	t.Run("wrong power of τ", func(t *testing.T) {
+		// swaps [τ²]G₁ and [τ³]G₁
+		tampered := append([]byte{}, ptau...)
+		offset := 12 + 12 + 4 + fp.Bytes + 8 + 12 + 2*2*fp.Bytes
+		p2 := append([]byte{}, tampered[offset:offset+2*fp.Bytes]...)
+		copy(tampered[offset:], tampered[offset+2*fp.Bytes:offset+4*fp.Bytes])
+		copy(tampered[offset+2*fp.Bytes:], p2)
+		_, err := ImportPtau(bytes.NewReader(tampered), size)
+		require.ErrorIs(t, err, ErrInvalidSRS)
+	})
+}
+
+// writeEthereumCeremony returns an Ethereum KZG ceremony transcript with sub-ceremonies of the
+// given sizes and 65 [τⁱ]G₂ each
+func writeEthereumCeremony(t *testing.T, sizes []int, alpha int64) ([]*SRS, []byte) {
+	const nbG2 = 65
+	var ceremony ethereumCeremony
+	ceremony.Transcripts = make([]struct {
+		NumG1Powers uint64 `json:"numG1Powers"`
+		NumG2Powers uint64 `json:"numG2Powers"`
+		PowersOfTau struct {
+			G1Powers []string `json:"G1Powers"`
+			G2Powers []string `json:"G2Powers"`
+		} `json:"powersOfTau"`
+		Witness struct {
+			RunningProducts []string `json:"runningProducts"`
+		} `json:"witness"`
+	}, len(sizes))
+
+	srs := make([]*SRS, len(sizes))
+	for i, size := range sizes {
+		var g2 []bls12381.G2Affine
+		srs[i], g2 = testPowersOfTau(t, size, nbG2, alpha+int64(i))
+		tr := &ceremony.Transcripts[i]
+		tr.NumG1Powers = uint64(size)
+		tr.NumG2Powers = nbG2
+		for _, p := range srs[i].Pk.G1 {
+			b := p.Bytes()
+			tr.PowersOfTau.G1Powers = append(tr.PowersOfTau.G1Powers, "0x"+hex.EncodeToString(b[:]))
+		}
+		for _, p := range g2 {
+			b := p.Bytes()
+			tr.PowersOfTau.G2Powers = append(tr.PowersOfTau.G2Powers, "0x"+hex.EncodeToString(b[:]))
+		}
+		tr.Witness.RunningProducts = []string{tr.PowersOfTau.G1Powers[0], tr.PowersOfTau.G1Powers[1]}
+	}
+
+	b, err := json.Marshal(&ceremony)
+	require.NoError(t, err)
+	return srs, b
+}
+
+func TestImportEthereumCeremony(t *testing.T) {
+	sizes := []int{8, 16, 32}
+	ref, transcript := writeEthereumCeremony(t, sizes, 42)
+
+	for _, size := range []uint64{2, 8, 9, 32} {
+		srs, err := ImportEthereumCeremony(bytes.NewReader(transcript), size)
+		require.NoError(t, err)
+		i := 0
+		for uint64(sizes[i]) < size {
+			i++
+		}
+		require.Equal(t, ref[i].Pk.G1[:size], srs.Pk.G1)
+		require.Equal(t, ref[i].Vk, srs.Vk)
+	}
+
+	t.Run("not enough powers", func(t *testing.T) {
+		_, err := ImportEthereumCeremony(bytes.NewReader(transcript), 33)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("wrong running product", func(t *testing.T) {
+		var ceremony ethereumCeremony
+		require.NoError(t, json.Unmarshal(transcript, &ceremony))
+		w := &ceremony.Transcripts[0].Witness
+		w.RunningProducts = w.RunningProducts[:1]
+		b, err := json.Marshal(&ceremony)
+		require.NoError(t, err)
+		_, err = ImportEthereumCeremony(bytes.NewReader(b), 4)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("wrong power of τ", func(t *testing.T) {
+		var ceremony ethereumCeremony
+		require.NoError(t, json.Unmarshal(transcript, &ceremony))
+		g1 := ceremony.Transcripts[0].PowersOfTau.G1Powers
+		g1[2], g1[3] = g1[3], g1[2]
+		b, err := json.Marshal(&ceremony)
+		require.NoError(t, err)
+		_, err = ImportEthereumCeremony(bytes.NewReader(b), 4)
+		require.ErrorIs(t, err, ErrInvalidSRS)
+	})
+}
+
+// checkImportedSRS checks that the SRS starts with the generators, and commits to and opens
+// a polynomial of maximal degree with it
+func checkImportedSRS(t *testing.T, srs *SRS) {
+	_, _, g1Gen, g2Gen := bls12381.Generators()
+	require.True(t, srs.Pk.G1[0].Equal(&g1Gen))
+	require.True(t, srs.Vk.G2[0].Equal(&g2Gen))
+
+	p := make([]fr.Element, len(srs.Pk.G1))
+	for i := range p {
+		p[i].SetRandom()
+	}
+	var point fr.Element
+	point.SetRandom()
+	digest, err := Commit(p, srs.Pk)
+	require.NoError(t, err)
+	proof, err := Open(p, point, srs.Pk)
+	require.NoError(t, err)
+	require.NoError(t, Verify(&digest, &proof, point, srs.Vk))
+}
+
+// TestImportEthereumCeremonyFixture imports testdata/ethereum_ceremony_excerpt.json, an
+// excerpt of the output of the Ethereum KZG ceremony: the first 16 powers of τ in G₁ and 2 in
+// G₂ of its 4096 powers sub-ceremony. They are the g1_monomial and g2_monomial points of the
+// trusted_setup.json distributed with c-kzg-4844 and go-ethereum (crypto/kzg4844), which is
+// derived from the transcript; its last running product, [τ]G₁, is g1_monomial[1]:
+//
+//	jq '{transcripts: [{numG1Powers: 16, numG2Powers: 2,
+//	    powersOfTau: {G1Powers: .g1_monomial[:16], G2Powers: .g2_monomial[:2]},
+//	    witness: {runningProducts: [.g1_monomial[1]]}}]}' trusted_setup.json
+func TestImportEthereumCeremonyFixture(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "ethereum_ceremony_excerpt.json"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	srs, err := ImportEthereumCeremony(f, 16)
+	require.NoError(t, err)
+	checkImportedSRS(t, srs)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc"
 	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
 	"github.com/consensys/gnark-crypto/internal/parallel"
 )
 
@@ -32,10 +33,11 @@ import (
 // From the formula Lᵢ(τ) = 1/n∑_{j<n}(τ/ωⁱ)ʲ we
 // see that [L₁(τ),..,Lₙ(τ)] = FFT_inv(∑_{j<n}τʲXʲ), so it suffices to apply the inverse
 // fft on the vector consisting of the original SRS.
-// Size of coeffs must be a power of 2.
+// Size of coeffs must be a power of 2, or the cardinality of a mixed radix
+// domain (see fft.WithMixedRadix).
 func ToLagrangeG1(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
 	if bits.OnesCount64(uint64(len(coeffs))) != 1 {
-		return nil, fmt.Errorf("len(coeffs) must be a power of 2")
+		return toLagrangeG1MixedRadix(coeffs)
 	}
 	size := len(coeffs)
 
@@ -58,6 +60,56 @@ func ToLagrangeG1(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
 	// TODO @gbotrel generify the cobra bitreverse function, benchmark it and use it everywhere
 	bitReverse(jCoeffs)
 
+	return scaleAndConvertG1(jCoeffs), nil
+}
+
+// toLagrangeG1MixedRadix is ToLagrangeG1 when len(coeffs) is not a power of 2.
+func toLagrangeG1MixedRadix(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
+	errSize := fmt.Errorf("len(coeffs) must be a power of 2 or the cardinality of a mixed radix domain")
+	size := len(coeffs)
+
+	// size must divide r-1, with small prime factors
+	var rMinusOne, rem big.Int
+	rMinusOne.Sub(fr.Modulus(), big.NewInt(1))
+	if size == 0 || rem.Mod(&rMinusOne, big.NewInt(int64(size))).Sign() != 0 {
+		return nil, errSize
+	}
+	m := size
+	for p := 2; p < 256 && m > 1; p++ {
+		for m%p == 0 {
+			m /= p
+		}
+	}
+	if m != 1 {
+		return nil, errSize
+	}
+	domain := fft.NewDomain(uint64(size), fft.WithMixedRadix(), fft.WithoutPrecompute())
+	if domain.Cardinality != uint64(size) {
+		return nil, errSize
+	}
+
+	// twiddles[i] = ω⁻ⁱ
+	twiddles := make([]big.Int, size)
+	w := fr.One()
+	for i := range twiddles {
+		w.BigInt(&twiddles[i])
+		w.Mul(&w, &domain.GeneratorInv)
+	}
+
+	jCoeffs := make([]curve.G1Jac, size)
+	for i := 0; i < size; i++ {
+		jCoeffs[i].FromAffine(&coeffs[i])
+	}
+
+	mixedRadixFFTG1(jCoeffs, twiddles, 1)
+
+	return scaleAndConvertG1(jCoeffs), nil
+}
+
+// scaleAndConvertG1 multiplies the points by 1/len(jCoeffs) and converts them
+// to affine coordinates.
+func scaleAndConvertG1(jCoeffs []curve.G1Jac) []curve.G1Affine {
+	size := len(jCoeffs)
 	var invBigint big.Int
 	var frCardinality fr.Element
 	frCardinality.SetUint64(uint64(size))
@@ -71,7 +123,7 @@ func ToLagrangeG1(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
 	})
 
 	// batch convert to affine
-	return curve.BatchJacobianToAffineG1(jCoeffs), nil
+	return curve.BatchJacobianToAffineG1(jCoeffs)
 }
 
 func computeTwiddlesInv(cardinality int) ([]*big.Int, error) {
@@ -180,3 +232,64 @@ func difFFTG1(a []curve.G1Jac, twiddles []*big.Int, stage, maxSplits int, chDone
 		difFFTG1(a[m:n], twiddles, nextStage, maxSplits, nil)
 	}
 }
+
+// mixedRadixFFTG1 computes the DFT of a in natural order, with a recursive
+// decimation in time FFT where the radix of each stage is the smallest prime
+// factor of len(a). twiddles contains the powers of the N-th root of unity ω, and
+// the root of unity of order len(a) is ω^stride.
+func mixedRadixFFTG1(a []curve.G1Jac, twiddles []big.Int, stride int) {
+	n := len(a)
+	if n == 1 {
+		return
+	}
+	p := 2
+	for n%p != 0 {
+		p++
+	}
+	m := n / p
+
+	// Yⱼ₂ = DFTₘ(a[p·j₁+j₂])
+	y := make([]curve.G1Jac, n)
+	for j2 := 0; j2 < p; j2++ {
+		for j1 := 0; j1 < m; j1++ {
+			y[j2*m+j1] = a[p*j1+j2]
+		}
+	}
+	if m >= 64 {
+		parallel.Execute(p, func(start, end int) {
+			for j2 := start; j2 < end; j2++ {
+				mixedRadixFFTG1(y[j2*m:(j2+1)*m], twiddles, stride*p)
+			}
+		})
+	} else {
+		for j2 := 0; j2 < p; j2++ {
+			mixedRadixFFTG1(y[j2*m:(j2+1)*m], twiddles, stride*p)
+		}
+	}
+
+	// Xₖ₁₊ₘₖ₂ = Σⱼ₂ ωₚʲ²ᵏ² ωₙʲ²ᵏ¹ Yⱼ₂[k₁]
+	N := len(twiddles)
+	wp := stride * m // ωₚ = ωʷᵖ
+	parallel.Execute(m, func(start, end int) {
+		x := make([]curve.G1Jac, p)
+		var t curve.G1Jac
+		for k1 := start; k1 < end; k1++ {
+			x[0] = y[k1]
+			for j2 := 1; j2 < p; j2++ {
+				x[j2].ScalarMultiplication(&y[j2*m+k1], &twiddles[(stride*j2*k1)%N])
+			}
+			for k2 := 0; k2 < p; k2++ {
+				acc := x[0]
+				for j2 := 1; j2 < p; j2++ {
+					if k2 == 0 {
+						acc.AddAssign(&x[j2])
+						continue
+					}
+					t.ScalarMultiplication(&x[j2], &twiddles[(wp*j2*k2)%N])
+					acc.AddAssign(&t)
+				}
+				a[k1+m*k2] = acc
+			}
+		}
+	})
+}
@@ -20,9 +20,11 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"math"
 	"reflect"
 	"sync/atomic"
 
+	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/internal/fptower"
@@ -52,13 +54,15 @@ const SizeOfGT = fptower.SizeOfGT
 var (
 	ErrInvalidInfinityEncoding = errors.New("invalid infinity point encoding")
 	ErrInvalidEncoding         = errors.New("invalid point encoding")
+	ErrUnsupportedEncoding     = errors.New("unsupported encoding")
 )
 
 // Encoder writes bls12-381 object values to an output stream
 type Encoder struct {
-	w   io.Writer
-	n   int64 // written bytes
-	raw bool  // raw vs compressed encoding
+	w        io.Writer
+	n        int64 // written bytes
+	raw      bool  // raw vs compressed encoding
+	encoding ecc.Encoding
 }
 
 // Decoder reads bls12-381 object values from an inbound stream
@@ -66,6 +70,8 @@ type Decoder struct {
 	r             io.Reader
 	n             int64 // read bytes
 	subGroupCheck bool  // default to true
+	raw           bool  // raw vs compressed encoding, for encodings which don't flag it
+	encoding      ecc.Encoding
 }
 
 // NewDecoder returns a binary decoder supporting curve bls12-381 objects in both
@@ -88,6 +94,12 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		return errors.New("bls12-381 decoder: unsupported type, need pointer")
 	}
 
+	if !isGnarkEncoding(dec.encoding) {
+		if ok, err := dec.decodeWithEncoding(v); ok {
+			return err
+		}
+	}
+
 	// implementation note: code is a bit verbose (abusing code generation), but minimize allocations on the heap
 	// in particular, careful attention must be given to usage of Bytes() method on Elements and Points
 	// that return an array (not a slice) of bytes. Using this is beneficial to minimize memory allocations
@@ -428,6 +440,11 @@ func NewEncoder(w io.Writer, options ...func(*Encoder)) *Encoder {
 // Encode writes the binary encoding of v to the stream
 // type must be uint64, *fr.Element, *fp.Element, *G1Affine, *G2Affine, []G1Affine or []G2Affine
 func (enc *Encoder) Encode(v interface{}) (err error) {
+	if !isGnarkEncoding(enc.encoding) {
+		if ok, err := enc.encodeWithEncoding(v); ok {
+			return err
+		}
+	}
 	if enc.raw {
 		return enc.encodeRaw(v)
 	}
@@ -447,6 +464,33 @@ func RawEncoding() func(*Encoder) {
 	}
 }
 
+// WithEncoding returns an option to use in NewEncoder(...) which sets the encoding of the points
+// (and for ecc.ArkworksEncoding, of the field elements, integers and slice lengths).
+// see ecc.Encoding for the supported encodings
+func WithEncoding(e ecc.Encoding) func(*Encoder) {
+	return func(enc *Encoder) {
+		enc.encoding = e
+	}
+}
+
+// WithDecoding returns an option to use in NewDecoder(...) which sets the encoding of the points
+// (and for ecc.ArkworksEncoding, of the field elements, integers and slice lengths).
+// see ecc.Encoding for the supported encodings
+func WithDecoding(e ecc.Encoding) func(*Decoder) {
+	return func(dec *Decoder) {
+		dec.encoding = e
+	}
+}
+
+// RawDecoding returns an option to use in NewDecoder(...) which tells the decoder that the points
+// are not compressed. It is only needed by the encodings which don't flag the compression in the
+// point encoding, that is ecc.ArkworksEncoding.
+func RawDecoding() func(*Decoder) {
+	return func(dec *Decoder) {
+		dec.raw = true
+	}
+}
+
 // NoSubgroupChecks returns an option to use in NewDecoder(...) which disable subgroup checks on the points
 // the decoder will read. Use with caution, as crafted points from an untrusted source can lead to crypto-attacks.
 func NoSubgroupChecks() func(*Decoder) {
@@ -704,6 +748,303 @@ func (enc *Encoder) encodeRaw(v interface{}) (err error) {
 	}
 }
 
+// isGnarkEncoding returns true if e encodes the points with the default encoding
+func isGnarkEncoding(e ecc.Encoding) bool {
+	return e == ecc.GnarkEncoding || e == ecc.ZCashEncoding
+}
+
+// The arkworks and SEC 1 encodings store the base field elements on the minimal number of bytes.
+const (
+	sizeOfFpMinimal = (fp.Bits + 7) / 8
+	sizeOfFrMinimal = (fr.Bits + 7) / 8
+)
+
+// encodeWithEncoding writes v with the encoder's encoding; it returns false if v is not
+// affected by this encoding and must be written with the default one.
+func (enc *Encoder) encodeWithEncoding(v interface{}) (bool, error) {
+	var buf []byte
+	var err error
+	switch t := v.(type) {
+	case *G1Affine:
+		if buf, err = t.BytesWithEncoding(enc.encoding, !enc.raw); err != nil {
+			return true, err
+		}
+		return true, enc.write(buf)
+	case []G1Affine:
+		if err = enc.writeLen(len(t)); err != nil {
+			return true, err
+		}
+		for i := range t {
+			if buf, err = t[i].BytesWithEncoding(enc.encoding, !enc.raw); err != nil {
+				return true, err
+			}
+			if err = enc.write(buf); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	case *G2Affine:
+		if buf, err = t.BytesWithEncoding(enc.encoding, !enc.raw); err != nil {
+			return true, err
+		}
+		return true, enc.write(buf)
+	case []G2Affine:
+		if err = enc.writeLen(len(t)); err != nil {
+			return true, err
+		}
+		for i := range t {
+			if buf, err = t[i].BytesWithEncoding(enc.encoding, !enc.raw); err != nil {
+				return true, err
+			}
+			if err = enc.write(buf); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	}
+
+	if enc.encoding != ecc.ArkworksEncoding {
+		return false, nil
+	}
+
+	// arkworks encodes the integers and field elements in little-endian
+	switch t := v.(type) {
+	case uint64:
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], t)
+		return true, enc.write(b[:])
+	case *fr.Element:
+		var b [fr.Bytes]byte
+		fr.LittleEndian.PutElement(&b, *t)
+		return true, enc.write(b[:sizeOfFrMinimal])
+	case *fp.Element:
+		var b [fp.Bytes]byte
+		fp.LittleEndian.PutElement(&b, *t)
+		return true, enc.write(b[:sizeOfFpMinimal])
+	case []fr.Element:
+		if err = enc.writeLen(len(t)); err != nil {
+			return true, err
+		}
+		var b [fr.Bytes]byte
+		for i := range t {
+			fr.LittleEndian.PutElement(&b, t[i])
+			if err = enc.write(b[:sizeOfFrMinimal]); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	default:
+		return true, errors.New("bls12-381 encoder: unsupported type for arkworks encoding")
+	}
+}
+
+func (enc *Encoder) write(buf []byte) error {
+	written, err := enc.w.Write(buf)
+	enc.n += int64(written)
+	return err
+}
+
+// writeLen writes a slice length, on 8 little-endian bytes with the arkworks encoding,
+// on 4 big-endian bytes otherwise
+func (enc *Encoder) writeLen(l int) error {
+	if enc.encoding == ecc.ArkworksEncoding {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], uint64(l))
+		return enc.write(b[:])
+	}
+	return enc.writeUint32(uint32(l))
+}
+
+// decodeWithEncoding reads v with the decoder's encoding; it returns false if v is not
+// affected by this encoding and must be read with the default one.
+func (dec *Decoder) decodeWithEncoding(v interface{}) (bool, error) {
+	var err error
+	switch t := v.(type) {
+	case *G1Affine:
+		return true, dec.readPoints(1, SizeOfG1AffineCompressed/fp.Bytes, func(_ int, buf []byte) error {
+			_, err := t.setBytesWithEncoding(dec.encoding, !dec.raw, buf, dec.subGroupCheck)
+			return err
+		})
+	case *[]G1Affine:
+		var sliceLen int
+		if sliceLen, err = dec.readLen(); err != nil {
+			return true, err
+		}
+		if len(*t) != sliceLen || *t == nil {
+			*t = make([]G1Affine, sliceLen)
+		}
+		return true, dec.readPoints(sliceLen, SizeOfG1AffineCompressed/fp.Bytes, func(i int, buf []byte) error {
+			_, err := (*t)[i].setBytesWithEncoding(dec.encoding, !dec.raw, buf, dec.subGroupCheck)
+			return err
+		})
+	case *G2Affine:
+		return true, dec.readPoints(1, SizeOfG2AffineCompressed/fp.Bytes, func(_ int, buf []byte) error {
+			_, err := t.setBytesWithEncoding(dec.encoding, !dec.raw, buf, dec.subGroupCheck)
+			return err
+		})
+	case *[]G2Affine:
+		var sliceLen int
+		if sliceLen, err = dec.readLen(); err != nil {
+			return true, err
+		}
+		if len(*t) != sliceLen || *t == nil {
+			*t = make([]G2Affine, sliceLen)
+		}
+		return true, dec.readPoints(sliceLen, SizeOfG2AffineCompressed/fp.Bytes, func(i int, buf []byte) error {
+			_, err := (*t)[i].setBytesWithEncoding(dec.encoding, !dec.raw, buf, dec.subGroupCheck)
+			return err
+		})
+	}
+
+	if dec.encoding != ecc.ArkworksEncoding {
+		return false, nil
+	}
+
+	// arkworks encodes the integers and field elements in little-endian
+	switch t := v.(type) {
+	case *uint64:
+		var b [8]byte
+		if err = dec.readFull(b[:]); err != nil {
+			return true, err
+		}
+		*t = binary.LittleEndian.Uint64(b[:])
+		return true, nil
+	case *fr.Element:
+		var b [fr.Bytes]byte
+		if err = dec.readFull(b[:sizeOfFrMinimal]); err != nil {
+			return true, err
+		}
+		*t, err = fr.LittleEndian.Element(&b)
+		return true, err
+	case *fp.Element:
+		var b [fp.Bytes]byte
+		if err = dec.readFull(b[:sizeOfFpMinimal]); err != nil {
+			return true, err
+		}
+		*t, err = fp.LittleEndian.Element(&b)
+		return true, err
+	case *[]fr.Element:
+		var sliceLen int
+		if sliceLen, err = dec.readLen(); err != nil {
+			return true, err
+		}
+		if len(*t) != sliceLen || *t == nil {
+			*t = make([]fr.Element, sliceLen)
+		}
+		var b [fr.Bytes]byte
+		for i := range *t {
+			if err = dec.readFull(b[:sizeOfFrMinimal]); err != nil {
+				return true, err
+			}
+			if (*t)[i], err = fr.LittleEndian.Element(&b); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	default:
+		return true, errors.New("bls12-381 decoder: unsupported type for arkworks encoding")
+	}
+}
+
+func (dec *Decoder) readFull(buf []byte) error {
+	read, err := io.ReadFull(dec.r, buf)
+	dec.n += int64(read)
+	return err
+}
+
+// readLen reads a slice length, on 8 little-endian bytes with the arkworks encoding,
+// on 4 big-endian bytes otherwise
+func (dec *Decoder) readLen() (int, error) {
+	if dec.encoding == ecc.ArkworksEncoding {
+		var b [8]byte
+		if err := dec.readFull(b[:]); err != nil {
+			return 0, err
+		}
+		l := binary.LittleEndian.Uint64(b[:])
+		if l > math.MaxUint32 {
+			return 0, errors.New("bls12-381 decoder: slice length overflows")
+		}
+		return int(l), nil
+	}
+	l, err := dec.readUint32()
+	return int(l), err
+}
+
+// readPoints reads the encodings of n points whose coordinates are made of degree base field
+// elements, and decodes them in parallel with set.
+func (dec *Decoder) readPoints(n, degree int, set func(i int, buf []byte) error) error {
+	var buf [SizeOfG2AffineUncompressed + 1]byte
+	var encoded []byte
+	offsets := make([]int, n+1)
+	for i := 0; i < n; i++ {
+		size, err := dec.readPointBytes(buf[:], degree)
+		if err != nil {
+			return err
+		}
+		encoded = append(encoded, buf[:size]...)
+		offsets[i+1] = len(encoded)
+	}
+	if n == 1 {
+		return set(0, encoded)
+	}
+
+	var nbErrs uint64
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			if err := set(i, encoded[offsets[i]:offsets[i+1]]); err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return errors.New("point decoding failed")
+	}
+	return nil
+}
+
+// readPointBytes reads in buf the encoding of a point whose coordinates are made of degree
+// base field elements, and returns its size.
+func (dec *Decoder) readPointBytes(buf []byte, degree int) (n int, err error) {
+	switch dec.encoding {
+	case ecc.ArkworksEncoding:
+		// arkworks uses the ZCash encoding for BLS12-381 points
+		n = degree * fp.Bytes
+		if err = dec.readFull(buf[:n]); err != nil {
+			return 0, err
+		}
+		if isMaskInvalid(buf[0]) {
+			return 0, ErrInvalidEncoding
+		}
+		if !isCompressed(buf[0]) {
+			if err = dec.readFull(buf[n : 2*n]); err != nil {
+				return 0, err
+			}
+			n *= 2
+		}
+		return n, nil
+	case ecc.SEC1Encoding:
+		if degree != 1 {
+			return 0, ErrUnsupportedEncoding
+		}
+		if err = dec.readFull(buf[:1]); err != nil {
+			return 0, err
+		}
+		switch buf[0] {
+		case 0x00:
+			return 1, nil
+		case 0x02, 0x03:
+			n = 1 + sizeOfFpMinimal
+		case 0x04:
+			n = 1 + 2*sizeOfFpMinimal
+		default:
+			return 0, ErrInvalidEncoding
+		}
+		return n, dec.readFull(buf[1:n])
+	default:
+		return 0, ErrUnsupportedEncoding
+	}
+}
+
 func (enc *Encoder) writeUint64Slice(t []uint64) (err error) {
 	if err = enc.writeUint32(uint32(len(t))); err != nil {
 		return
@@ -1010,6 +1351,126 @@ func (p *G1Affine) unsafeSetCompressedBytes(buf []byte) (isInfinity bool, err er
 	return isInfinity, nil
 }
 
+// BytesWithEncoding returns the binary representation of p in the encoding e, compressed or not.
+//
+// see ecc.Encoding for the encodings; ecc.ArkworksEncoding and ecc.SEC1Encoding are supported on G1Affine, as well as ecc.ZCashEncoding.
+func (p *G1Affine) BytesWithEncoding(e ecc.Encoding, compressed bool) ([]byte, error) {
+	if isGnarkEncoding(e) || e == ecc.ArkworksEncoding {
+		if compressed {
+			res := p.Bytes()
+			return res[:], nil
+		}
+		res := p.RawBytes()
+		return res[:], nil
+	}
+	switch e {
+	case ecc.SEC1Encoding:
+		return p.sec1Bytes(compressed), nil
+	default:
+		return nil, ErrUnsupportedEncoding
+	}
+}
+
+// SetBytesWithEncoding sets p from its binary representation in the encoding e and returns the
+// number of consumed bytes. compressed tells if buf holds a compressed point; it is ignored by
+// the encodings which flag the compression.
+//
+// if buf is too short io.ErrShortBuffer is returned
+//
+// this check if the resulting point is on the curve and in the correct subgroup
+func (p *G1Affine) SetBytesWithEncoding(e ecc.Encoding, compressed bool, buf []byte) (int, error) {
+	return p.setBytesWithEncoding(e, compressed, buf, true)
+}
+
+func (p *G1Affine) setBytesWithEncoding(e ecc.Encoding, compressed bool, buf []byte, subGroupCheck bool) (int, error) {
+	if isGnarkEncoding(e) || e == ecc.ArkworksEncoding {
+		return p.setBytes(buf, subGroupCheck)
+	}
+	switch e {
+	case ecc.SEC1Encoding:
+		return p.setSEC1Bytes(buf, subGroupCheck)
+	default:
+		return 0, ErrUnsupportedEncoding
+	}
+}
+
+// sec1Bytes returns the SEC 1 encoding of p
+func (p *G1Affine) sec1Bytes(compressed bool) []byte {
+	if p.IsInfinity() {
+		return []byte{0x00}
+	}
+	raw := p.RawBytes()
+	x := raw[fp.Bytes-sizeOfFpMinimal : fp.Bytes]
+	y := raw[SizeOfG1AffineUncompressed-sizeOfFpMinimal:]
+
+	if compressed {
+		res := make([]byte, 1+sizeOfFpMinimal)
+		res[0] = 0x02 | (y[sizeOfFpMinimal-1] & 1)
+		copy(res[1:], x)
+		return res
+	}
+	res := make([]byte, 1+2*sizeOfFpMinimal)
+	res[0] = 0x04
+	copy(res[1:], x)
+	copy(res[1+sizeOfFpMinimal:], y)
+	return res
+}
+
+// setSEC1Bytes sets p from its SEC 1 encoding
+func (p *G1Affine) setSEC1Bytes(buf []byte, subGroupCheck bool) (int, error) {
+	if len(buf) < 1 {
+		return 0, io.ErrShortBuffer
+	}
+
+	var raw [SizeOfG1AffineUncompressed]byte
+	switch buf[0] {
+	case 0x00:
+		p.X.SetZero()
+		p.Y.SetZero()
+		return 1, nil
+	case 0x02, 0x03:
+		if len(buf) < 1+sizeOfFpMinimal {
+			return 0, io.ErrShortBuffer
+		}
+		copy(raw[fp.Bytes-sizeOfFpMinimal:fp.Bytes], buf[1:1+sizeOfFpMinimal])
+		if err := p.X.SetBytesCanonical(raw[:fp.Bytes]); err != nil {
+			return 0, err
+		}
+
+		var YSquared fp.Element
+		YSquared.Square(&p.X).Mul(&YSquared, &p.X)
+		YSquared.Add(&YSquared, &bCurveCoeff)
+		if p.Y.Sqrt(&YSquared) == nil {
+			return 0, errors.New("invalid compressed coordinate: square root doesn't exist")
+		}
+		if byte(p.Y.Bits()[0]&1) != buf[0]&1 {
+			p.Y.Neg(&p.Y)
+		}
+
+		// subgroup check
+		if subGroupCheck && !p.IsInSubGroup() {
+			return 0, errors.New("invalid point: subgroup check failed")
+		}
+		return 1 + sizeOfFpMinimal, nil
+	case 0x04:
+		if len(buf) < 1+2*sizeOfFpMinimal {
+			return 0, io.ErrShortBuffer
+		}
+		copy(raw[fp.Bytes-sizeOfFpMinimal:fp.Bytes], buf[1:1+sizeOfFpMinimal])
+		copy(raw[SizeOfG1AffineUncompressed-sizeOfFpMinimal:], buf[1+sizeOfFpMinimal:1+2*sizeOfFpMinimal])
+		// (0, 0) is not the point at infinity
+		if isZeroed(raw[0], raw[1:]) {
+			return 0, ErrInvalidEncoding
+		}
+		if _, err := p.setBytes(raw[:], subGroupCheck); err != nil {
+			return 0, err
+		}
+		return 1 + 2*sizeOfFpMinimal, nil
+	default:
+		return 0, ErrInvalidEncoding
+	}
+}
+
 // SizeOfG2AffineCompressed represents the size in bytes that a G2Affine need in binary form, compressed
 const SizeOfG2AffineCompressed = 48 * 2
 
@@ -1295,3 +1756,42 @@ func (p *G2Affine) unsafeSetCompressedBytes(buf []byte) (isInfinity bool, err er
 	// recomputing Y will be done asynchronously
 	return isInfinity, nil
 }
+
+// BytesWithEncoding returns the binary representation of p in the encoding e, compressed or not.
+//
+// see ecc.Encoding for the encodings; ecc.ArkworksEncoding is supported on G2Affine, as well as ecc.ZCashEncoding.
+func (p *G2Affine) BytesWithEncoding(e ecc.Encoding, compressed bool) ([]byte, error) {
+	if isGnarkEncoding(e) || e == ecc.ArkworksEncoding {
+		if compressed {
+			res := p.Bytes()
+			return res[:], nil
+		}
+		res := p.RawBytes()
+		return res[:], nil
+	}
+	switch e {
+	default:
+		return nil, ErrUnsupportedEncoding
+	}
+}
+
+// SetBytesWithEncoding sets p from its binary representation in the encoding e and returns the
+// number of consumed bytes. compressed tells if buf holds a compressed point; it is ignored by
+// the encodings which flag the compression.
+//
+// if buf is too short io.ErrShortBuffer is returned
+//
+// this check if the resulting point is on the curve and in the correct subgroup
+func (p *G2Affine) SetBytesWithEncoding(e ecc.Encoding, compressed bool, buf []byte) (int, error) {
+	return p.setBytesWithEncoding(e, compressed, buf, true)
+}
+
+func (p *G2Affine) setBytesWithEncoding(e ecc.Encoding, compressed bool, buf []byte, subGroupCheck bool) (int, error) {
+	if isGnarkEncoding(e) || e == ecc.ArkworksEncoding {
+		return p.setBytes(buf, subGroupCheck)
+	}
+	switch e {
+	default:
+		return 0, ErrUnsupportedEncoding
+	}
+}
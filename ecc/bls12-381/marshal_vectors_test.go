@@ -0,0 +1,179 @@
+package bls12381
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+// ZCash encodings of the generators and the point at infinity, from
+// draft-irtf-cfrg-pairing-friendly-curves Appendix C and the zkcrypto/bls12_381 crate.
+// ArkworksEncoding uses the ZCash encoding for the points, like ark-bls12-381.
+func TestEncodingVectors(t *testing.T) {
+	t.Parallel()
+
+	var g1Inf G1Affine
+	var g2Inf G2Affine
+
+	g1Vectors := []struct {
+		p          *G1Affine
+		compressed bool
+		encoding   string
+	}{
+		{&g1GenAff, true, "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb"},
+		{&g1GenAff, false, "17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb" +
+			"08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1"},
+		{&g1Inf, true, "c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},
+		{&g1Inf, false, "400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},
+	}
+	g2Vectors := []struct {
+		p          *G2Affine
+		compressed bool
+		encoding   string
+	}{
+		{&g2GenAff, true, "93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e" +
+			"024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8"},
+		{&g2GenAff, false, "13e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e" +
+			"024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8" +
+			"0606c4a02ea734cc32acd2b02bc28b99cb3e287e85a763af267492ab572e99ab3f370d275cec1da1aaa9075ff05f79be" +
+			"0ce5d527727d6e118cc9cdc6da2e351aadfd9baa8cbdd3a76d429a695160d12c923ac9cc3baca289e193548608b82801"},
+		{&g2Inf, true, "c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+			"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, e := range []ecc.Encoding{ecc.GnarkEncoding, ecc.ZCashEncoding, ecc.ArkworksEncoding} {
+		for _, v := range g1Vectors {
+			buf, err := v.p.BytesWithEncoding(e, v.compressed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(buf) != v.encoding {
+				t.Fatalf("%s (compressed=%v): expected %s, got %x", e, v.compressed, v.encoding, buf)
+			}
+			var q G1Affine
+			if _, err = q.SetBytesWithEncoding(e, v.compressed, buf); err != nil {
+				t.Fatal(err)
+			}
+			if !q.Equal(v.p) {
+				t.Fatalf("%s (compressed=%v): decoding %s failed", e, v.compressed, v.encoding)
+			}
+		}
+		for _, v := range g2Vectors {
+			buf, err := v.p.BytesWithEncoding(e, v.compressed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(buf) != v.encoding {
+				t.Fatalf("%s (compressed=%v): expected %s, got %x", e, v.compressed, v.encoding, buf)
+			}
+			var q G2Affine
+			if _, err = q.SetBytesWithEncoding(e, v.compressed, buf); err != nil {
+				t.Fatal(err)
+			}
+			if !q.Equal(v.p) {
+				t.Fatalf("%s (compressed=%v): decoding %s failed", e, v.compressed, v.encoding)
+			}
+		}
+	}
+}
+
+// TestZCashVectors checks the encodings of the points k⋅G, k = 0, …, 999, of G1
+// and G2 against the test vectors of zkcrypto/bls12_381, see
+// testdata/zcash/README.md. They start with the point at infinity and the
+// compressed encodings have both values of the sign flag. ark-bls12-381
+// serializes the points in the same format, so ArkworksEncoding is checked
+// against them too.
+func TestZCashVectors(t *testing.T) {
+	t.Parallel()
+
+	encodings := []ecc.Encoding{ecc.GnarkEncoding, ecc.ZCashEncoding, ecc.ArkworksEncoding}
+	for _, compressed := range []bool{true, false} {
+		size := SizeOfG1AffineUncompressed
+		if compressed {
+			size = SizeOfG1AffineCompressed
+		}
+		var p G1Affine
+		for i, v := range readZCashVectors(t, "g1", compressed, size) {
+			for _, e := range encodings {
+				checkEncodingVector(t, &p, e, compressed, v)
+			}
+			if i == 0 && !p.IsInfinity() {
+				t.Fatal("the first vector must be the point at infinity")
+			}
+			p.Add(&p, &g1GenAff)
+		}
+	}
+
+	for _, compressed := range []bool{true, false} {
+		size := SizeOfG2AffineUncompressed
+		if compressed {
+			size = SizeOfG2AffineCompressed
+		}
+		var p G2Affine
+		var signs [2]int
+		for _, v := range readZCashVectors(t, "g2", compressed, size) {
+			for _, e := range encodings {
+				checkEncodingVector(t, &p, e, compressed, v)
+			}
+			if !p.IsInfinity() {
+				signs[(v[0]>>1)&1]++ // 0x20 flag: y is lexicographically largest
+			}
+			p.Add(&p, &g2GenAff)
+		}
+		if compressed && (signs[0] == 0 || signs[1] == 0) {
+			t.Fatalf("the compressed G2 vectors must have both signs, got %v", signs)
+		}
+	}
+}
+
+// readZCashVectors returns the hex encodings stored in
+// testdata/zcash/<group>_<compressed|uncompressed>_valid_test_vectors.dat.
+func readZCashVectors(t *testing.T, group string, compressed bool, size int) []string {
+	t.Helper()
+	name := group + "_uncompressed_valid_test_vectors.dat"
+	if compressed {
+		name = group + "_compressed_valid_test_vectors.dat"
+	}
+	b, err := os.ReadFile(filepath.Join("testdata", "zcash", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const nbVectors = 1000
+	if len(b) != nbVectors*size {
+		t.Fatalf("%s: expected %d bytes, got %d", name, nbVectors*size, len(b))
+	}
+	res := make([]string, nbVectors)
+	for i := range res {
+		res[i] = hex.EncodeToString(b[i*size : (i+1)*size])
+	}
+	return res
+}
+
+type encodablePoint[T any] interface {
+	*T
+	BytesWithEncoding(ecc.Encoding, bool) ([]byte, error)
+	SetBytesWithEncoding(ecc.Encoding, bool, []byte) (int, error)
+	Equal(*T) bool
+}
+
+func checkEncodingVector[T any, PT encodablePoint[T]](t *testing.T, p PT, e ecc.Encoding, compressed bool, encoding string) {
+	t.Helper()
+	buf, err := p.BytesWithEncoding(e, compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(buf) != encoding {
+		t.Fatalf("%s (compressed=%v): expected %s, got %x", e, compressed, encoding, buf)
+	}
+	var q T
+	if _, err = PT(&q).SetBytesWithEncoding(e, compressed, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&q) {
+		t.Fatalf("%s (compressed=%v): decoding %s failed", e, compressed, encoding)
+	}
+}
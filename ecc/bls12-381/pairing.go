@@ -117,6 +117,15 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	if n == 0 || n != len(Q) {
 		return GT{}, errors.New("invalid inputs sizes")
 	}
+	return millerLoopMixed(P, Q, nil, nil)
+}
+
+// millerLoopMixed computes the multi-Miller loop of the pairs (Pᵢ, Qᵢ), as in
+// MillerLoop, together with the one of the pairs (fixedPⱼ, Q'ⱼ) where the lines
+// of Q'ⱼ are precomputed, as in MillerLoopFixedQ. The squarings are shared among
+// all the pairs and the precomputed lines are not modified.
+func millerLoopMixed(P []G1Affine, Q []G2Affine, fixedP []G1Affine, lines [][2][len(LoopCounter) - 1]LineEvaluationAff) (GT, error) {
+	n := len(P)
 
 	// filter infinity points
 	p := make([]G1Affine, 0, n)
@@ -138,6 +147,9 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		qProj[k].FromAffine(&q[k])
 	}
 
+	// precomputations for the fixed pairs
+	yInv, xNegOverY := fixedLinesPrecomputations(fixedP)
+
 	var result GT
 	result.SetOne()
 	var l1, l2 lineEvaluation
@@ -193,6 +205,8 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		result.MulBy01245(&prodLines)
 	}
 
+	mulByFixedLines(&result, lines, yInv, xNegOverY, 62)
+
 	// i <= 61
 	for i := len(LoopCounter) - 3; i >= 1; i-- {
 		// mutualize the square among n Miller loops
@@ -222,6 +236,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 				result.MulBy01245(&prodLines)
 			}
 		}
+		mulByFixedLines(&result, lines, yInv, xNegOverY, i)
 	}
 
 	// i = 0, separately to avoid a point doubling
@@ -236,6 +251,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		// ℓ × result
 		result.MulBy014(&l1.r0, &l1.r1, &l1.r2)
 	}
+	mulByFixedLines(&result, lines, yInv, xNegOverY, 0)
 
 	// negative x₀
 	result.Conjugate(&result)
@@ -588,3 +604,43 @@ func (p *G2Affine) doubleAndAddStep(evaluations1, evaluations2 *LineEvaluationAf
 	p.X.Set(&x4)
 	p.Y.Set(&y4)
 }
+
+// fixedLinesPrecomputations returns 1/y and -x/y for the points P, used to
+// evaluate the precomputed lines at P.
+func fixedLinesPrecomputations(P []G1Affine) (yInv, xNegOverY []fp.Element) {
+	n := len(P)
+	yInv = make([]fp.Element, n)
+	xNegOverY = make([]fp.Element, n)
+	for k := 0; k < n; k++ {
+		yInv[k].Set(&P[k].Y)
+	}
+	yInv = fp.BatchInvert(yInv)
+	for k := 0; k < n; k++ {
+		xNegOverY[k].Mul(&P[k].X, &yInv[k]).
+			Neg(&xNegOverY[k])
+	}
+	return
+}
+
+// mulByFixedLines multiplies result by the precomputed lines of index i, evaluated
+// at the points given by fixedLinesPrecomputations. The lines are not modified.
+func mulByFixedLines(result *GT, lines [][2][len(LoopCounter) - 1]LineEvaluationAff, yInv, xNegOverY []fp.Element, i int) {
+	var l0, l1 LineEvaluationAff
+	var prodLines [5]E2
+	for k := range lines {
+		// line evaluation at P[k]
+		l0.R0.MulByElement(&lines[k][0][i].R0, &xNegOverY[k])
+		l0.R1.MulByElement(&lines[k][0][i].R1, &yInv[k])
+		if LoopCounter[i] == 0 {
+			// ℓ × res
+			result.MulBy01(&l0.R1, &l0.R0)
+			continue
+		}
+		l1.R0.MulByElement(&lines[k][1][i].R0, &xNegOverY[k])
+		l1.R1.MulByElement(&lines[k][1][i].R1, &yInv[k])
+		// ℓ × ℓ
+		prodLines = fptower.Mul01By01(&l0.R1, &l0.R0, &l1.R1, &l1.R0)
+		// (ℓ × ℓ) × res
+		result.MulBy01245(&prodLines)
+	}
+}
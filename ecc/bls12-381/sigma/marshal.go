@@ -0,0 +1,99 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package sigma
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// WriteTo writes the binary encoding of the proof: the number of branches on
+// 4 bytes, then for each branch the number of responses on 4 bytes, the
+// challenge and the responses, all in big endian.
+func (proof *Proof) WriteTo(w io.Writer) (int64, error) {
+	buf := binary.BigEndian.AppendUint32(nil, uint32(len(proof.Challenges)))
+	for k := range proof.Challenges {
+		var responses []fr.Element
+		if k < len(proof.Responses) {
+			responses = proof.Responses[k]
+		}
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(responses)))
+		buf = appendScalar(buf, &proof.Challenges[k])
+		for i := range responses {
+			buf = appendScalar(buf, &responses[i])
+		}
+	}
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// ReadFrom decodes a proof written by WriteTo. Non-canonical encodings of the
+// scalars are rejected.
+func (proof *Proof) ReadFrom(r io.Reader) (int64, error) {
+	var read int64
+	var buf [fr.Bytes]byte
+	readUint32 := func() (int, error) {
+		n, err := io.ReadFull(r, buf[:4])
+		read += int64(n)
+		return int(binary.BigEndian.Uint32(buf[:4])), err
+	}
+	readScalar := func(e *fr.Element) error {
+		n, err := io.ReadFull(r, buf[:])
+		read += int64(n)
+		if err != nil {
+			return err
+		}
+		return e.SetBytesCanonical(buf[:])
+	}
+
+	nbBranches, err := readUint32()
+	if err != nil {
+		return read, err
+	}
+	// the slices grow as the data is read, so that a corrupted size can't
+	// trigger a large allocation
+	var res Proof
+	for k := 0; k < nbBranches; k++ {
+		nbResponses, err := readUint32()
+		if err != nil {
+			return read, err
+		}
+		var c fr.Element
+		if err = readScalar(&c); err != nil {
+			return read, err
+		}
+		var responses []fr.Element
+		for i := 0; i < nbResponses; i++ {
+			var z fr.Element
+			if err = readScalar(&z); err != nil {
+				return read, err
+			}
+			responses = append(responses, z)
+		}
+		res.Challenges = append(res.Challenges, c)
+		res.Responses = append(res.Responses, responses)
+	}
+	*proof = res
+	return read, nil
+}
+
+func appendScalar(buf []byte, e *fr.Element) []byte {
+	b := e.Bytes()
+	return append(buf, b[:]...)
+}
@@ -65,3 +65,8 @@ func initCurveParams() {
 func mulByA(x *fr.Element) {
 	x.Neg(x)
 }
+
+// mulByACT multiplies x by a in constant time
+func mulByACT(x *fr.Element) {
+	x.NegCT(x)
+}
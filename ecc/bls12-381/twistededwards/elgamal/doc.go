@@ -0,0 +1,42 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package elgamal provides exponential ElGamal encryption on bls12-381's twistededwards curve.
+//
+// A message m, an integer modulo the order of the prime subgroup, is encrypted
+// under the public key A = [x]B as
+//
+//	(C₁, C₂) = ([r]B, [m]B + [r]A)
+//
+// for a random r. The encryption is additively homomorphic: the sum of two
+// ciphertexts encrypts the sum of the messages, so that encrypted ballots can
+// be tallied without being decrypted one by one. Ciphertexts can be
+// re-randomized without changing the message.
+//
+// Decryption yields [m]B. When m is small (a tally, an amount), it is
+// recovered with a baby-step giant-step search on a precomputed DLogTable.
+//
+// The secret key can be shared among n trustees, any t of which can decrypt:
+// the shares are dealt, or generated without a trusted party, with the frost
+// package (GenerateWithDealer, DKGParticipant). Each trustee publishes a
+// decryption share [sᵢ]C₁ with a Chaum-Pedersen proof (sigma.DLEQ) that it
+// used the secret share matching its public share, and the shares are
+// combined by Lagrange interpolation.
+//
+// See Cramer, Gennaro and Schoenmakers, "A secure and optimally efficient
+// multi-authority election scheme" (EUROCRYPT 1997), for a description of the
+// scheme.
+package elgamal
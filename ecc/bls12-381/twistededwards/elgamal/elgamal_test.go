@@ -0,0 +1,243 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package elgamal
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/frost"
+)
+
+// table re-used across tests
+var testTable *DLogTable
+
+func init() {
+	var err error
+	testTable, err = NewDLogTable(1 << 16)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	assert := require.New(t)
+
+	privKey, err := GenerateKey(crand.Reader)
+	assert.NoError(err)
+	pk := privKey.Public()
+
+	for _, m := range []uint64{0, 1, 42, 1<<16 - 1} {
+		ct, err := pk.Encrypt(crand.Reader, new(big.Int).SetUint64(m))
+		assert.NoError(err)
+		mB := privKey.Decrypt(&ct)
+		dlog, err := testTable.DLog(&mB)
+		assert.NoError(err)
+		assert.Equal(m, dlog)
+
+		// re-randomization changes the ciphertext, not the message
+		ct2, err := pk.Rerandomize(crand.Reader, &ct)
+		assert.NoError(err)
+		assert.False(ct2.Equal(&ct))
+		mB2 := privKey.Decrypt(&ct2)
+		assert.True(mB2.Equal(&mB))
+	}
+
+	// message out of the range of the table
+	ct, err := pk.Encrypt(crand.Reader, big.NewInt(1<<16))
+	assert.NoError(err)
+	mB := privKey.Decrypt(&ct)
+	_, err = testTable.DLog(&mB)
+	assert.ErrorIs(err, ErrDLogNotFound)
+
+	// wrong key
+	other, err := GenerateKey(crand.Reader)
+	assert.NoError(err)
+	ct, err = pk.Encrypt(crand.Reader, big.NewInt(7))
+	assert.NoError(err)
+	mB = other.Decrypt(&ct)
+	_, err = testTable.DLog(&mB)
+	assert.ErrorIs(err, ErrDLogNotFound)
+}
+
+func TestHomomorphicTally(t *testing.T) {
+	assert := require.New(t)
+
+	privKey, err := GenerateKey(crand.Reader)
+	assert.NoError(err)
+	pk := privKey.Public()
+
+	// 0/1 ballots
+	const nbVoters = 50
+	ballots := make([]Ciphertext, nbVoters)
+	var expected uint64
+	for i := range ballots {
+		vote := uint64(i % 3 % 2)
+		expected += vote
+		ballots[i], err = pk.Encrypt(crand.Reader, new(big.Int).SetUint64(vote))
+		assert.NoError(err)
+	}
+	tally := Sum(ballots)
+	mB := privKey.Decrypt(&tally)
+	dlog, err := testTable.DLog(&mB)
+	assert.NoError(err)
+	assert.Equal(expected, dlog)
+
+	// 3·(a - b) + b
+	a, err := pk.Encrypt(crand.Reader, big.NewInt(20))
+	assert.NoError(err)
+	b, err := pk.Encrypt(crand.Reader, big.NewInt(8))
+	assert.NoError(err)
+	var res Ciphertext
+	res.Sub(&a, &b).ScalarMultiplication(&res, big.NewInt(3)).Add(&res, &b)
+	mB = privKey.Decrypt(&res)
+	dlog, err = testTable.DLog(&mB)
+	assert.NoError(err)
+	assert.Equal(uint64(44), dlog)
+}
+
+func TestThresholdDecryption(t *testing.T) {
+	assert := require.New(t)
+
+	const threshold, n = 3, 5
+	shares, err := frost.GenerateWithDealer(crand.Reader, threshold, n)
+	assert.NoError(err)
+	commitment := shares[0].Commitment
+	pk := PublicKeyFromCommitment(commitment)
+
+	ct, err := pk.Encrypt(crand.Reader, big.NewInt(1234))
+	assert.NoError(err)
+
+	hFunc := sha256.New
+	decryptionShares := make([]DecryptionShare, n)
+	for i := range shares {
+		decryptionShares[i], err = NewDecryptionShare(&shares[i], &ct, hFunc())
+		assert.NoError(err)
+		assert.NoError(VerifyDecryptionShare(commitment, &ct, &decryptionShares[i], hFunc()))
+	}
+
+	for _, trustees := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 2, 3, 4}, {0, 1, 2, 3, 4}} {
+		subset := make([]DecryptionShare, len(trustees))
+		for i, s := range trustees {
+			subset[i] = decryptionShares[s]
+		}
+		mB, err := CombineDecryptionShares(commitment, &ct, subset)
+		assert.NoError(err)
+		dlog, err := testTable.DLog(&mB)
+		assert.NoError(err)
+		assert.Equal(uint64(1234), dlog)
+	}
+
+	// not enough, or duplicate, shares
+	_, err = CombineDecryptionShares(commitment, &ct, decryptionShares[:threshold-1])
+	assert.ErrorIs(err, ErrInvalidShares)
+	_, err = CombineDecryptionShares(commitment, &ct, []DecryptionShare{decryptionShares[0], decryptionShares[1], decryptionShares[0]})
+	assert.ErrorIs(err, ErrInvalidShares)
+
+	// decryption share of another trustee, or of another ciphertext
+	wrong := decryptionShares[0]
+	wrong.ID = decryptionShares[1].ID
+	assert.ErrorIs(VerifyDecryptionShare(commitment, &ct, &wrong, hFunc()), ErrInvalidDecryptionShare)
+	other, err := pk.Encrypt(crand.Reader, big.NewInt(1234))
+	assert.NoError(err)
+	assert.ErrorIs(VerifyDecryptionShare(commitment, &other, &decryptionShares[0], hFunc()), ErrInvalidDecryptionShare)
+
+	// wrong partial decryption
+	wrong = decryptionShares[0]
+	wrong.D.Add(&wrong.D, &ct.C1)
+	assert.ErrorIs(VerifyDecryptionShare(commitment, &ct, &wrong, hFunc()), ErrInvalidDecryptionShare)
+
+	// ciphertext with a torsion component, built in memory with Add: T = (0, -1)
+	// has order 2
+	var torsion Ciphertext
+	torsion.C1.Y.SetOne().Neg(&torsion.C1.Y)
+	torsion.C2.Y.SetOne()
+	var shifted Ciphertext
+	shifted.Add(&ct, &torsion)
+	_, err = NewDecryptionShare(&shares[0], &shifted, hFunc())
+	assert.ErrorIs(err, ErrInvalidPoint)
+	assert.ErrorIs(VerifyDecryptionShare(commitment, &shifted, &decryptionShares[0], hFunc()), ErrInvalidPoint)
+	torsion.C1, torsion.C2 = torsion.C2, torsion.C1
+	shifted.Add(&ct, &torsion)
+	_, err = CombineDecryptionShares(commitment, &shifted, decryptionShares)
+	assert.ErrorIs(err, ErrInvalidPoint)
+}
+
+func TestDLogTable(t *testing.T) {
+	assert := require.New(t)
+
+	params := twistededwards.GetEdwardsCurve()
+	for _, bound := range []uint64{1, 2, 10, 17, 1000} {
+		table, err := NewDLogTable(bound)
+		assert.NoError(err)
+		assert.Equal(bound, table.Bound())
+		for _, m := range []uint64{0, bound / 2, bound - 1, bound, bound + 1} {
+			var p twistededwards.PointAffine
+			p.ScalarMultiplication(&params.Base, new(big.Int).SetUint64(m))
+			dlog, err := table.DLog(&p)
+			if m < bound {
+				assert.NoError(err)
+				assert.Equal(m, dlog)
+			} else {
+				assert.ErrorIs(err, ErrDLogNotFound)
+			}
+		}
+	}
+
+	_, err := NewDLogTable(0)
+	assert.ErrorIs(err, ErrInvalidBound)
+}
+
+func TestCiphertextSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	privKey, err := GenerateKey(crand.Reader)
+	assert.NoError(err)
+	ct, err := privKey.Public().Encrypt(crand.Reader, big.NewInt(5))
+	assert.NoError(err)
+
+	var decoded Ciphertext
+	n, err := decoded.SetBytes(ct.Bytes())
+	assert.NoError(err)
+	assert.Equal(sizeCiphertext, n)
+	assert.True(decoded.Equal(&ct))
+
+	_, err = decoded.SetBytes(ct.Bytes()[:sizeCiphertext-1])
+	assert.ErrorIs(err, ErrInvalidCiphertext)
+}
+
+func BenchmarkNewDLogTable(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = NewDLogTable(1 << 16)
+	}
+}
+
+func BenchmarkDLog(b *testing.B) {
+	params := twistededwards.GetEdwardsCurve()
+	var p twistededwards.PointAffine
+	p.ScalarMultiplication(&params.Base, big.NewInt(1<<16-1))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = testTable.DLog(&p)
+	}
+}
@@ -0,0 +1,786 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 39f6d3a994cebea4199cec0404d0ec0299a0824f3320420b425c397b5bdcb2e)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_110      = _10 + _100
+	//	_1000     = _10 + _110
+	//	_1010     = _10 + _1000
+	//	_1100     = _10 + _1010
+	//	_1101     = 1 + _1100
+	//	_10100    = _1000 + _1100
+	//	_11001    = _1100 + _1101
+	//	_110010   = 2*_11001
+	//	_110011   = 1 + _110010
+	//	_110101   = _10 + _110011
+	//	_111010   = _1000 + _110010
+	//	_111011   = 1 + _111010
+	//	_1000001  = _110 + _111011
+	//	_1001101  = _1100 + _1000001
+	//	_1001111  = _10 + _1001101
+	//	_1010011  = _100 + _1001111
+	//	_1100111  = _10100 + _1010011
+	//	_1101111  = _1000 + _1100111
+	//	_10000001 = _110010 + _1001111
+	//	_10000011 = _10 + _10000001
+	//	_10010111 = _10100 + _10000011
+	//	_10011001 = _10 + _10010111
+	//	_10011101 = _100 + _10011001
+	//	_11010111 = _111010 + _10011101
+	//	_11011011 = _100 + _11010111
+	//	_11100101 = _1010 + _11011011
+	//	_11100111 = _10 + _11100101
+	//	i57       = ((_11100111 << 8 + _11011011) << 9 + _10011101) << 9
+	//	i77       = ((_10011001 + i57) << 9 + _10011001) << 8 + _11010111
+	//	i104      = ((i77 << 6 + _110101) << 10 + _10000011) << 9
+	//	i123      = ((_1100111 + i104) << 8 + _111011) << 8 + 1
+	//	i164      = ((i123 << 14 + _1001101) << 10 + _111011) << 15
+	//	i185      = ((_1010011 + i164) << 6 + _1101) << 12 + _1000001
+	//	i214      = ((i185 << 9 + _1001111) << 8 + _110011) << 10
+	//	i233      = ((_10000001 + i214) << 11 + _1000001) << 5 + _1101
+	//	i267      = ((i233 << 12 + _10010111) << 12 + _11100101) << 8
+	//	i287      = ((_11100111 + i267 + _110) << 8 + _1101111) << 9
+	//	return      2*((_11100101 + i287) << 8 + _10010111)
+	//
+	// Operations: 245 squares 53 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19 Element
+	// Step 1: t3 = x^0x2
+	t3.Square(&x)
+
+	// Step 2: t18 = x^0x4
+	t18.Square(t3)
+
+	// Step 3: t2 = x^0x6
+	t2.Mul(t3, t18)
+
+	// Step 4: t1 = x^0x8
+	t1.Mul(t3, t2)
+
+	// Step 5: t0 = x^0xa
+	t0.Mul(t3, t1)
+
+	// Step 6: t8 = x^0xc
+	t8.Mul(t3, t0)
+
+	// Step 7: t4 = x^0xd
+	t4.Mul(&x, t8)
+
+	// Step 8: z = x^0x14
+	z.Mul(t1, t8)
+
+	// Step 9: t5 = x^0x19
+	t5.Mul(t8, t4)
+
+	// Step 10: t6 = x^0x32
+	t6.Square(t5)
+
+	// Step 11: t7 = x^0x33
+	t7.Mul(&x, t6)
+
+	// Step 12: t14 = x^0x35
+	t14.Mul(t3, t7)
+
+	// Step 13: t15 = x^0x3a
+	t15.Mul(t1, t6)
+
+	// Step 14: t10 = x^0x3b
+	t10.Mul(&x, t15)
+
+	// Step 15: t5 = x^0x41
+	t5.Mul(t2, t10)
+
+	// Step 16: t11 = x^0x4d
+	t11.Mul(t8, t5)
+
+	// Step 17: t8 = x^0x4f
+	t8.Mul(t3, t11)
+
+	// Step 18: t9 = x^0x53
+	t9.Mul(t18, t8)
+
+	// Step 19: t12 = x^0x67
+	t12.Mul(z, t9)
+
+	// Step 20: t1 = x^0x6f
+	t1.Mul(t1, t12)
+
+	// Step 21: t6 = x^0x81
+	t6.Mul(t6, t8)
+
+	// Step 22: t13 = x^0x83
+	t13.Mul(t3, t6)
+
+	// Step 23: z = x^0x97
+	z.Mul(z, t13)
+
+	// Step 24: t16 = x^0x99
+	t16.Mul(t3, z)
+
+	// Step 25: t17 = x^0x9d
+	t17.Mul(t18, t16)
+
+	// Step 26: t15 = x^0xd7
+	t15.Mul(t15, t17)
+
+	// Step 27: t18 = x^0xdb
+	t18.Mul(t18, t15)
+
+	// Step 28: t0 = x^0xe5
+	t0.Mul(t0, t18)
+
+	// Step 29: t3 = x^0xe7
+	t3.Mul(t3, t0)
+
+	// Step 37: t19 = x^0xe700
+	t19.Square(t3)
+	for s := 1; s < 8; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 38: t18 = x^0xe7db
+	t18.Mul(t18, t19)
+
+	// Step 47: t18 = x^0x1cfb600
+	for s := 0; s < 9; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 48: t17 = x^0x1cfb69d
+	t17.Mul(t17, t18)
+
+	// Step 57: t17 = x^0x39f6d3a00
+	for s := 0; s < 9; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 58: t17 = x^0x39f6d3a99
+	t17.Mul(t16, t17)
+
+	// Step 67: t17 = x^0x73eda753200
+	for s := 0; s < 9; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 68: t16 = x^0x73eda753299
+	t16.Mul(t16, t17)
+
+	// Step 76: t16 = x^0x73eda75329900
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 77: t15 = x^0x73eda753299d7
+	t15.Mul(t15, t16)
+
+	// Step 83: t15 = x^0x1cfb69d4ca675c0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 84: t14 = x^0x1cfb69d4ca675f5
+	t14.Mul(t14, t15)
+
+	// Step 94: t14 = x^0x73eda753299d7d400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 95: t13 = x^0x73eda753299d7d483
+	t13.Mul(t13, t14)
+
+	// Step 104: t13 = x^0xe7db4ea6533afa90600
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 105: t12 = x^0xe7db4ea6533afa90667
+	t12.Mul(t12, t13)
+
+	// Step 113: t12 = x^0xe7db4ea6533afa9066700
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 114: t12 = x^0xe7db4ea6533afa906673b
+	t12.Mul(t10, t12)
+
+	// Step 122: t12 = x^0xe7db4ea6533afa906673b00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 123: t12 = x^0xe7db4ea6533afa906673b01
+	t12.Mul(&x, t12)
+
+	// Step 137: t12 = x^0x39f6d3a994cebea4199cec04000
+	for s := 0; s < 14; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 138: t11 = x^0x39f6d3a994cebea4199cec0404d
+	t11.Mul(t11, t12)
+
+	// Step 148: t11 = x^0xe7db4ea6533afa906673b01013400
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 149: t10 = x^0xe7db4ea6533afa906673b0101343b
+	t10.Mul(t10, t11)
+
+	// Step 164: t10 = x^0x73eda753299d7d483339d80809a1d8000
+	for s := 0; s < 15; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 165: t9 = x^0x73eda753299d7d483339d80809a1d8053
+	t9.Mul(t9, t10)
+
+	// Step 171: t9 = x^0x1cfb69d4ca675f520cce7602026876014c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 172: t9 = x^0x1cfb69d4ca675f520cce7602026876014cd
+	t9.Mul(t4, t9)
+
+	// Step 184: t9 = x^0x1cfb69d4ca675f520cce7602026876014cd000
+	for s := 0; s < 12; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 185: t9 = x^0x1cfb69d4ca675f520cce7602026876014cd041
+	t9.Mul(t5, t9)
+
+	// Step 194: t9 = x^0x39f6d3a994cebea4199cec0404d0ec0299a08200
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 195: t8 = x^0x39f6d3a994cebea4199cec0404d0ec0299a0824f
+	t8.Mul(t8, t9)
+
+	// Step 203: t8 = x^0x39f6d3a994cebea4199cec0404d0ec0299a0824f00
+	for s := 0; s < 8; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 204: t7 = x^0x39f6d3a994cebea4199cec0404d0ec0299a0824f33
+	t7.Mul(t7, t8)
+
+	// Step 214: t7 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc00
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 215: t6 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81
+	t6.Mul(t6, t7)
+
+	// Step 226: t6 = x^0x73eda753299d7d483339d80809a1d8053341049e6640800
+	for s := 0; s < 11; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 227: t5 = x^0x73eda753299d7d483339d80809a1d8053341049e6640841
+	t5.Mul(t5, t6)
+
+	// Step 232: t5 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc810820
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 233: t4 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d
+	t4.Mul(t4, t5)
+
+	// Step 245: t4 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d000
+	for s := 0; s < 12; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 246: t4 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d097
+	t4.Mul(z, t4)
+
+	// Step 258: t4 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d097000
+	for s := 0; s < 12; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 259: t4 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5
+	t4.Mul(t0, t4)
+
+	// Step 267: t4 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e500
+	for s := 0; s < 8; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 268: t3 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5e7
+	t3.Mul(t3, t4)
+
+	// Step 269: t2 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed
+	t2.Mul(t2, t3)
+
+	// Step 277: t2 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed00
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 278: t1 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed6f
+	t1.Mul(t1, t2)
+
+	// Step 287: t1 = x^0x1cfb69d4ca675f520cce7602026876014cd0412799902105a12e1cbdade00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 288: t0 = x^0x1cfb69d4ca675f520cce7602026876014cd0412799902105a12e1cbdadee5
+	t0.Mul(t0, t1)
+
+	// Step 296: t0 = x^0x1cfb69d4ca675f520cce7602026876014cd0412799902105a12e1cbdadee500
+	for s := 0; s < 8; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 297: z = x^0x1cfb69d4ca675f520cce7602026876014cd0412799902105a12e1cbdadee597
+	z.Mul(z, t0)
+
+	// Step 298: z = x^0x39f6d3a994cebea4199cec0404d0ec0299a0824f3320420b425c397b5bdcb2e
+	z.Square(z)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 73eda753299d7d483339d80809a1d8053341049e6640841684b872f6b7b965b)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_110      = 1 + _101
+	//	_1010     = _100 + _110
+	//	_1101     = _11 + _1010
+	//	_10100    = 2*_1010
+	//	_11001    = _101 + _10100
+	//	_110010   = 2*_11001
+	//	_110011   = 1 + _110010
+	//	_110101   = _10 + _110011
+	//	_111010   = _101 + _110101
+	//	_111011   = 1 + _111010
+	//	_1000001  = _110 + _111011
+	//	_1001011  = _1010 + _1000001
+	//	_1001101  = _10 + _1001011
+	//	_1001111  = _10 + _1001101
+	//	_1010011  = _100 + _1001111
+	//	_1100111  = _10100 + _1010011
+	//	_1101111  = _110101 + _111010
+	//	_10000001 = _110010 + _1001111
+	//	_10000011 = _10 + _10000001
+	//	_10010111 = _10100 + _10000011
+	//	_10011001 = _10 + _10010111
+	//	_10011101 = _100 + _10011001
+	//	_11010111 = _111010 + _10011101
+	//	_11011011 = _100 + _11010111
+	//	_11100101 = _1010 + _11011011
+	//	_11100111 = _10 + _11100101
+	//	i58       = ((_11100111 << 8 + _11011011) << 9 + _10011101) << 9
+	//	i78       = ((_10011001 + i58) << 9 + _10011001) << 8 + _11010111
+	//	i105      = ((i78 << 6 + _110101) << 10 + _10000011) << 9
+	//	i124      = ((_1100111 + i105) << 8 + _111011) << 8 + 1
+	//	i165      = ((i124 << 14 + _1001101) << 10 + _111011) << 15
+	//	i186      = ((_1010011 + i165) << 6 + _1101) << 12 + _1000001
+	//	i215      = ((i186 << 9 + _1001111) << 8 + _110011) << 10
+	//	i234      = ((_10000001 + i215) << 11 + _1000001) << 5 + _1101
+	//	i268      = ((i234 << 12 + _10010111) << 12 + _11100101) << 8
+	//	i288      = ((_11100111 + i268 + _110) << 8 + _1101111) << 9
+	//	return      ((_11100101 + i288) << 7 + _1001011) << 3 + _11
+	//
+	// Operations: 246 squares 55 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t4)
+
+	// Step 3: t20 = x^0x4
+	t20.Mul(&x, z)
+
+	// Step 4: t0 = x^0x5
+	t0.Mul(&x, t20)
+
+	// Step 5: t3 = x^0x6
+	t3.Mul(&x, t0)
+
+	// Step 6: t1 = x^0xa
+	t1.Mul(t20, t3)
+
+	// Step 7: t6 = x^0xd
+	t6.Mul(z, t1)
+
+	// Step 8: t5 = x^0x14
+	t5.Square(t1)
+
+	// Step 9: t2 = x^0x19
+	t2.Mul(t0, t5)
+
+	// Step 10: t8 = x^0x32
+	t8.Square(t2)
+
+	// Step 11: t9 = x^0x33
+	t9.Mul(&x, t8)
+
+	// Step 12: t16 = x^0x35
+	t16.Mul(t4, t9)
+
+	// Step 13: t17 = x^0x3a
+	t17.Mul(t0, t16)
+
+	// Step 14: t12 = x^0x3b
+	t12.Mul(&x, t17)
+
+	// Step 15: t7 = x^0x41
+	t7.Mul(t3, t12)
+
+	// Step 16: t0 = x^0x4b
+	t0.Mul(t1, t7)
+
+	// Step 17: t13 = x^0x4d
+	t13.Mul(t4, t0)
+
+	// Step 18: t10 = x^0x4f
+	t10.Mul(t4, t13)
+
+	// Step 19: t11 = x^0x53
+	t11.Mul(t20, t10)
+
+	// Step 20: t14 = x^0x67
+	t14.Mul(t5, t11)
+
+	// Step 21: t2 = x^0x6f
+	t2.Mul(t16, t17)
+
+	// Step 22: t8 = x^0x81
+	t8.Mul(t8, t10)
+
+	// Step 23: t15 = x^0x83
+	t15.Mul(t4, t8)
+
+	// Step 24: t5 = x^0x97
+	t5.Mul(t5, t15)
+
+	// Step 25: t18 = x^0x99
+	t18.Mul(t4, t5)
+
+	// Step 26: t19 = x^0x9d
+	t19.Mul(t20, t18)
+
+	// Step 27: t17 = x^0xd7
+	t17.Mul(t17, t19)
+
+	// Step 28: t20 = x^0xdb
+	t20.Mul(t20, t17)
+
+	// Step 29: t1 = x^0xe5
+	t1.Mul(t1, t20)
+
+	// Step 30: t4 = x^0xe7
+	t4.Mul(t4, t1)
+
+	// Step 38: t21 = x^0xe700
+	t21.Square(t4)
+	for s := 1; s < 8; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 39: t20 = x^0xe7db
+	t20.Mul(t20, t21)
+
+	// Step 48: t20 = x^0x1cfb600
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 49: t19 = x^0x1cfb69d
+	t19.Mul(t19, t20)
+
+	// Step 58: t19 = x^0x39f6d3a00
+	for s := 0; s < 9; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 59: t19 = x^0x39f6d3a99
+	t19.Mul(t18, t19)
+
+	// Step 68: t19 = x^0x73eda753200
+	for s := 0; s < 9; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 69: t18 = x^0x73eda753299
+	t18.Mul(t18, t19)
+
+	// Step 77: t18 = x^0x73eda75329900
+	for s := 0; s < 8; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 78: t17 = x^0x73eda753299d7
+	t17.Mul(t17, t18)
+
+	// Step 84: t17 = x^0x1cfb69d4ca675c0
+	for s := 0; s < 6; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 85: t16 = x^0x1cfb69d4ca675f5
+	t16.Mul(t16, t17)
+
+	// Step 95: t16 = x^0x73eda753299d7d400
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 96: t15 = x^0x73eda753299d7d483
+	t15.Mul(t15, t16)
+
+	// Step 105: t15 = x^0xe7db4ea6533afa90600
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 106: t14 = x^0xe7db4ea6533afa90667
+	t14.Mul(t14, t15)
+
+	// Step 114: t14 = x^0xe7db4ea6533afa9066700
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 115: t14 = x^0xe7db4ea6533afa906673b
+	t14.Mul(t12, t14)
+
+	// Step 123: t14 = x^0xe7db4ea6533afa906673b00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 124: t14 = x^0xe7db4ea6533afa906673b01
+	t14.Mul(&x, t14)
+
+	// Step 138: t14 = x^0x39f6d3a994cebea4199cec04000
+	for s := 0; s < 14; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 139: t13 = x^0x39f6d3a994cebea4199cec0404d
+	t13.Mul(t13, t14)
+
+	// Step 149: t13 = x^0xe7db4ea6533afa906673b01013400
+	for s := 0; s < 10; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 150: t12 = x^0xe7db4ea6533afa906673b0101343b
+	t12.Mul(t12, t13)
+
+	// Step 165: t12 = x^0x73eda753299d7d483339d80809a1d8000
+	for s := 0; s < 15; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 166: t11 = x^0x73eda753299d7d483339d80809a1d8053
+	t11.Mul(t11, t12)
+
+	// Step 172: t11 = x^0x1cfb69d4ca675f520cce7602026876014c0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 173: t11 = x^0x1cfb69d4ca675f520cce7602026876014cd
+	t11.Mul(t6, t11)
+
+	// Step 185: t11 = x^0x1cfb69d4ca675f520cce7602026876014cd000
+	for s := 0; s < 12; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 186: t11 = x^0x1cfb69d4ca675f520cce7602026876014cd041
+	t11.Mul(t7, t11)
+
+	// Step 195: t11 = x^0x39f6d3a994cebea4199cec0404d0ec0299a08200
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 196: t10 = x^0x39f6d3a994cebea4199cec0404d0ec0299a0824f
+	t10.Mul(t10, t11)
+
+	// Step 204: t10 = x^0x39f6d3a994cebea4199cec0404d0ec0299a0824f00
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 205: t9 = x^0x39f6d3a994cebea4199cec0404d0ec0299a0824f33
+	t9.Mul(t9, t10)
+
+	// Step 215: t9 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc00
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 216: t8 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81
+	t8.Mul(t8, t9)
+
+	// Step 227: t8 = x^0x73eda753299d7d483339d80809a1d8053341049e6640800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 228: t7 = x^0x73eda753299d7d483339d80809a1d8053341049e6640841
+	t7.Mul(t7, t8)
+
+	// Step 233: t7 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc810820
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 234: t6 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d
+	t6.Mul(t6, t7)
+
+	// Step 246: t6 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d000
+	for s := 0; s < 12; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 247: t5 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d097
+	t5.Mul(t5, t6)
+
+	// Step 259: t5 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d097000
+	for s := 0; s < 12; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 260: t5 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5
+	t5.Mul(t1, t5)
+
+	// Step 268: t5 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e500
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 269: t4 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5e7
+	t4.Mul(t4, t5)
+
+	// Step 270: t3 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed
+	t3.Mul(t3, t4)
+
+	// Step 278: t3 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed00
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 279: t2 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed6f
+	t2.Mul(t2, t3)
+
+	// Step 288: t2 = x^0x1cfb69d4ca675f520cce7602026876014cd0412799902105a12e1cbdade00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 289: t1 = x^0x1cfb69d4ca675f520cce7602026876014cd0412799902105a12e1cbdadee5
+	t1.Mul(t1, t2)
+
+	// Step 296: t1 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed6f7280
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 297: t0 = x^0xe7db4ea6533afa906673b0101343b00a6682093ccc81082d0970e5ed6f72cb
+	t0.Mul(t0, t1)
+
+	// Step 300: t0 = x^0x73eda753299d7d483339d80809a1d8053341049e6640841684b872f6b7b9658
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 301: z = x^0x73eda753299d7d483339d80809a1d8053341049e6640841684b872f6b7b965b
+	z.Mul(z, t0)
+
+	return z
+}
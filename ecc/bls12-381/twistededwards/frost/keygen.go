@@ -0,0 +1,426 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/fr"
+	fieldhash "github.com/consensys/gnark-crypto/field/hash"
+)
+
+var (
+	ErrInvalidThreshold        = errors.New("threshold must be in [1, n]")
+	ErrInvalidIdentifier       = errors.New("participant identifiers must be in [1, n]")
+	ErrInvalidPoint            = errors.New("point is not in the prime subgroup")
+	ErrInvalidShare            = errors.New("share does not match the commitment")
+	ErrInvalidProofOfKnowledge = errors.New("invalid proof of knowledge of the secret")
+	ErrInvalidDKGMessages      = errors.New("missing or duplicate DKG messages")
+)
+
+// domainSeparator is the prefix of the domain separation tags of the hashes to scalars
+const domainSeparator = "FROST-BLS12-381-TWISTEDEDWARDS-v1"
+
+// Commitment is Feldman's commitment [a₀]B, [a₁]B, …, [aₜ₋₁]B to the polynomial
+// f = a₀ + a₁X + … + aₜ₋₁Xᵗ⁻¹ sharing the secret key a₀. Its length is the
+// threshold t; it is public and the same for all participants.
+type Commitment []twistededwards.PointAffine
+
+// KeyShare is the signing key of a participant: its share f(ID) of the secret
+// key, along with the commitment to f.
+type KeyShare struct {
+	ID         uint32
+	Secret     fr.Element
+	Commitment Commitment
+}
+
+// Threshold returns the number of signers needed to produce a signature.
+func (c Commitment) Threshold() int {
+	return len(c)
+}
+
+// GroupKey returns the public key of the group, [a₀]B.
+func (c Commitment) GroupKey() twistededwards.PointAffine {
+	return c[0]
+}
+
+// PublicShare returns the public key [f(id)]B of the share of participant id.
+func (c Commitment) PublicShare(id uint32) twistededwards.PointAffine {
+	// Horner's rule in the exponent
+	var res twistededwards.PointAffine
+	bID := new(big.Int).SetUint64(uint64(id))
+	res.Set(&c[len(c)-1])
+	for i := len(c) - 2; i >= 0; i-- {
+		res.ScalarMultiplication(&res, bID)
+		res.Add(&res, &c[i])
+	}
+	return res
+}
+
+// Verify checks that the commitment is well-formed, i.e. its points are in the
+// prime subgroup and the group key is not the identity.
+func (c Commitment) Verify() error {
+	if len(c) == 0 {
+		return ErrInvalidThreshold
+	}
+	for i := range c {
+		if !isInSubGroup(&c[i]) {
+			return ErrInvalidPoint
+		}
+	}
+	if c[0].IsZero() {
+		return ErrInvalidPoint
+	}
+	return nil
+}
+
+// GroupKey returns the public key of the group.
+func (k *KeyShare) GroupKey() twistededwards.PointAffine {
+	return k.Commitment.GroupKey()
+}
+
+// Verify checks the share against the commitment: [f(ID)]B = ∑ [IDⁱ][aᵢ]B.
+// Participants must verify the share they receive from the dealer.
+func (k *KeyShare) Verify() error {
+	if k.ID == 0 {
+		return ErrInvalidIdentifier
+	}
+	if err := k.Commitment.Verify(); err != nil {
+		return err
+	}
+	return verifyShare(k.Commitment, k.ID, &k.Secret)
+}
+
+// GenerateWithDealer generates a random secret key and splits it in n shares, any
+// threshold of which can sign. The dealer must send each KeyShare privately to
+// its participant, and erase the shares and the secret.
+func GenerateWithDealer(r io.Reader, threshold, n int) ([]KeyShare, error) {
+	if threshold < 1 || threshold > n {
+		return nil, ErrInvalidThreshold
+	}
+	coefficients, commitment, err := randomPolynomial(r, threshold)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]KeyShare, n)
+	for i := range shares {
+		shares[i].ID = uint32(i + 1)
+		shares[i].Commitment = commitment
+		evalPolynomial(&shares[i].Secret, coefficients, shares[i].ID)
+	}
+	for i := range coefficients {
+		coefficients[i].SetZero()
+	}
+	return shares, nil
+}
+
+// DKGParticipant runs the distributed key generation of the FROST paper
+// (Pedersen's DKG with proofs of knowledge), which involves no trusted party:
+//
+//  1. each participant samples a polynomial fᵢ and broadcasts its commitment with
+//     a proof of knowledge of fᵢ(0) (NewDKGParticipant);
+//  2. each participant checks the proofs of the others, and sends them privately
+//     their shares fᵢ(j) (Round2);
+//  3. each participant checks the shares it received, and derives its share of
+//     the secret key f = ∑ fᵢ (Finalize).
+type DKGParticipant struct {
+	id           uint32
+	n            int
+	coefficients []fr.Element
+	commitment   Commitment
+}
+
+// DKGRound1Message is broadcast by a participant in the first round of the DKG.
+type DKGRound1Message struct {
+	From       uint32
+	Commitment Commitment
+
+	// Schnorr proof of knowledge of the secret of Commitment[0]
+	ProofR twistededwards.PointAffine
+	ProofZ fr.Element
+}
+
+// DKGRound2Message carries the share fᵢ(j) of participant i = From for participant
+// j = To. It must be sent privately.
+type DKGRound2Message struct {
+	From, To uint32
+	Share    fr.Element
+}
+
+// NewDKGParticipant starts the DKG for participant id in [1, n] and returns the
+// message to broadcast to the other participants.
+func NewDKGParticipant(r io.Reader, id uint32, threshold, n int) (*DKGParticipant, DKGRound1Message, error) {
+	if threshold < 1 || threshold > n {
+		return nil, DKGRound1Message{}, ErrInvalidThreshold
+	}
+	if id == 0 || int(id) > n {
+		return nil, DKGRound1Message{}, ErrInvalidIdentifier
+	}
+	coefficients, commitment, err := randomPolynomial(r, threshold)
+	if err != nil {
+		return nil, DKGRound1Message{}, err
+	}
+	p := &DKGParticipant{
+		id:           id,
+		n:            n,
+		coefficients: coefficients,
+		commitment:   commitment,
+	}
+
+	// proof of knowledge of a₀: R = [k]B, z = k + a₀⋅c with c = H(id, [a₀]B, R)
+	msg := DKGRound1Message{From: id, Commitment: commitment}
+	params := twistededwards.GetEdwardsCurve()
+	var k fr.Element
+	if err := randomScalar(r, &k); err != nil {
+		return nil, DKGRound1Message{}, err
+	}
+	var bK big.Int
+	msg.ProofR.ScalarMultiplicationCT(&params.Base, k.BigInt(&bK))
+	c := proofOfKnowledgeChallenge(id, &commitment[0], &msg.ProofR)
+	msg.ProofZ.MulCT(&coefficients[0], &c).
+		AddCT(&msg.ProofZ, &k)
+	k.SetZero()
+	bK.SetUint64(0)
+
+	return p, msg, nil
+}
+
+// Round2 checks the messages of the first round, one per participant, and returns
+// the shares to send privately to each of the other participants.
+func (p *DKGParticipant) Round2(round1 []DKGRound1Message) ([]DKGRound2Message, error) {
+	if p.coefficients == nil {
+		return nil, ErrInvalidDKGMessages
+	}
+	if _, err := p.round1Commitments(round1); err != nil {
+		return nil, err
+	}
+	res := make([]DKGRound2Message, 0, p.n-1)
+	for j := 1; j <= p.n; j++ {
+		if uint32(j) == p.id {
+			continue
+		}
+		msg := DKGRound2Message{From: p.id, To: uint32(j)}
+		evalPolynomial(&msg.Share, p.coefficients, msg.To)
+		res = append(res, msg)
+	}
+	return res, nil
+}
+
+// Finalize checks the shares received in the second round, one from each of the
+// other participants, and returns the key share of the participant. The
+// commitment of the key share is the sum of the commitments of the first round.
+func (p *DKGParticipant) Finalize(round1 []DKGRound1Message, round2 []DKGRound2Message) (*KeyShare, error) {
+	if p.coefficients == nil {
+		return nil, ErrInvalidDKGMessages
+	}
+	commitments, err := p.round1Commitments(round1)
+	if err != nil {
+		return nil, err
+	}
+
+	res := KeyShare{ID: p.id}
+	evalPolynomial(&res.Secret, p.coefficients, p.id)
+	received := make([]bool, p.n+1)
+	received[p.id] = true
+	for i := range round2 {
+		m := &round2[i]
+		if m.To != p.id {
+			continue
+		}
+		if m.From == 0 || int(m.From) > p.n || received[m.From] {
+			return nil, ErrInvalidDKGMessages
+		}
+		received[m.From] = true
+		if err := verifyShare(commitments[m.From], p.id, &m.Share); err != nil {
+			return nil, err
+		}
+		res.Secret.AddCT(&res.Secret, &m.Share)
+	}
+	for _, ok := range received[1:] {
+		if !ok {
+			return nil, ErrInvalidDKGMessages
+		}
+	}
+	res.Commitment = make(Commitment, len(p.commitment))
+	for i := range res.Commitment {
+		res.Commitment[i] = commitments[1][i]
+		for j := 2; j <= p.n; j++ {
+			res.Commitment[i].Add(&res.Commitment[i], &commitments[j][i])
+		}
+	}
+
+	for i := range p.coefficients {
+		p.coefficients[i].SetZero()
+	}
+	p.coefficients = nil
+
+	return &res, nil
+}
+
+// round1Commitments checks the messages of the first round and returns the
+// commitments indexed by participant.
+func (p *DKGParticipant) round1Commitments(round1 []DKGRound1Message) ([]Commitment, error) {
+	params := twistededwards.GetEdwardsCurve()
+	res := make([]Commitment, p.n+1)
+	for i := range round1 {
+		m := &round1[i]
+		if m.From == 0 || int(m.From) > p.n || res[m.From] != nil {
+			return nil, ErrInvalidDKGMessages
+		}
+		if len(m.Commitment) != len(p.commitment) {
+			return nil, ErrInvalidThreshold
+		}
+		if err := m.Commitment.Verify(); err != nil {
+			return nil, err
+		}
+		if !isInSubGroup(&m.ProofR) {
+			return nil, ErrInvalidPoint
+		}
+		// [z]B = R + [c][a₀]B
+		c := proofOfKnowledgeChallenge(m.From, &m.Commitment[0], &m.ProofR)
+		var lhs, rhs twistededwards.PointAffine
+		var b big.Int
+		lhs.ScalarMultiplication(&params.Base, m.ProofZ.BigInt(&b))
+		rhs.ScalarMultiplication(&m.Commitment[0], c.BigInt(&b)).
+			Add(&rhs, &m.ProofR)
+		if !lhs.Equal(&rhs) {
+			return nil, ErrInvalidProofOfKnowledge
+		}
+		res[m.From] = m.Commitment
+	}
+	for j := 1; j <= p.n; j++ {
+		if res[j] == nil {
+			return nil, ErrInvalidDKGMessages
+		}
+	}
+	if !res[p.id][0].Equal(&p.commitment[0]) {
+		return nil, ErrInvalidDKGMessages
+	}
+	return res, nil
+}
+
+func proofOfKnowledgeChallenge(id uint32, a0, r *twistededwards.PointAffine) fr.Element {
+	var bID [4]byte
+	binary.BigEndian.PutUint32(bID[:], id)
+	bA0 := a0.Bytes()
+	bR := r.Bytes()
+	return hashToScalar("dkg", bID[:], bA0[:], bR[:])
+}
+
+// randomPolynomial returns the threshold coefficients of a random polynomial,
+// and their commitment.
+func randomPolynomial(r io.Reader, threshold int) ([]fr.Element, Commitment, error) {
+	params := twistededwards.GetEdwardsCurve()
+	coefficients := make([]fr.Element, threshold)
+	commitment := make(Commitment, threshold)
+	var b big.Int
+	for i := range coefficients {
+		if err := randomScalar(r, &coefficients[i]); err != nil {
+			return nil, nil, err
+		}
+		commitment[i].ScalarMultiplicationCT(&params.Base, coefficients[i].BigInt(&b))
+	}
+	b.SetUint64(0)
+	return coefficients, commitment, nil
+}
+
+// evalPolynomial sets res to f(id), with Horner's rule
+func evalPolynomial(res *fr.Element, coefficients []fr.Element, id uint32) {
+	var x fr.Element
+	x.SetUint64(uint64(id))
+	res.Set(&coefficients[len(coefficients)-1])
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		res.MulCT(res, &x).
+			AddCT(res, &coefficients[i])
+	}
+}
+
+// verifyShare checks that [share]B = [f(id)]B
+func verifyShare(c Commitment, id uint32, share *fr.Element) error {
+	params := twistededwards.GetEdwardsCurve()
+	var lhs twistededwards.PointAffine
+	var b big.Int
+	lhs.ScalarMultiplication(&params.Base, share.BigInt(&b))
+	rhs := c.PublicShare(id)
+	if !lhs.Equal(&rhs) {
+		return ErrInvalidShare
+	}
+	return nil
+}
+
+// isInSubGroup returns true if p is on the curve and in the prime subgroup
+func isInSubGroup(p *twistededwards.PointAffine) bool {
+	return p.IsInSubGroup()
+}
+
+// randomScalar sets res to a non-zero random scalar
+func randomScalar(r io.Reader, res *fr.Element) error {
+	// 128 extra bits make the bias of the reduction negligible
+	var buf [fr.Bytes + 16]byte
+	for {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return err
+		}
+		setBytesCT(res, buf[:])
+		if !res.IsZero() {
+			return nil
+		}
+	}
+}
+
+// hashToScalar hashes data to a scalar as fr.Hash does, with expand_message_xmd
+// (SHA-256) and the domain separation tag domainSeparator || label. The output
+// is reduced in constant time, since the data may be secret.
+func hashToScalar(label string, data ...[]byte) fr.Element {
+	var msg []byte
+	for _, d := range data {
+		msg = append(msg, d...)
+	}
+	b, err := fieldhash.ExpandMsgXmd(msg, []byte(domainSeparator+label), 16+(fr.Bits+7)/8)
+	if err != nil {
+		// the output length and the domain separation tag are fixed and valid
+		panic(err)
+	}
+	var res fr.Element
+	setBytesCT(&res, b)
+	return res
+}
+
+// setBytesCT sets res to the big-endian integer b modulo the order of the prime
+// subgroup, with Horner's rule on 64-bit words so as not to branch on b.
+func setBytesCT(res *fr.Element, b []byte) {
+	buf := make([]byte, (len(b)+7)/8*8)
+	copy(buf[len(buf)-len(b):], b)
+	var radix, w fr.Element
+	var bW big.Int
+	radix.SetBigInt(new(big.Int).Lsh(big.NewInt(1), 64))
+	res.SetZero()
+	for i := 0; i < len(buf); i += 8 {
+		bW.SetUint64(binary.BigEndian.Uint64(buf[i:]))
+		w.SetBigIntCT(&bW)
+		res.MulCT(res, &radix).
+			AddCT(res, &w)
+	}
+	for i := range buf {
+		buf[i] = 0
+	}
+	bW.SetUint64(0)
+}
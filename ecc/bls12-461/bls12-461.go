@@ -0,0 +1,171 @@
+// Package bls12461 efficient elliptic curve, pairing and hash to curve implementation for bls12-461.
+//
+// bls12-461: A Barreto--Lynn--Scott curve
+//
+//	embedding degree k=12
+//	seed x₀=-151115726325920150061056 (-2⁷⁷+2⁵⁰+2³³)
+//	𝔽r: r=521481194400158902870293791036394582812650143983424074083311820261824039635303638490268303361 (x₀⁴-x₀²+1)
+//	𝔽p: p=3969508375500863470560772059146634051800057393085754326046523646985852496169198543994841284697713271737768244168253401239242781720740276907 ((x₀-1)² ⋅ r(x₀)/3+x₀)
+//	(E/𝔽p): Y²=X³+4
+//	(Eₜ/𝔽p²): Y² = X³+4(u+1) (M-type twist)
+//	r ∣ #E(Fp) and r ∣ #Eₜ(𝔽p²)
+//
+// Extension fields tower:
+//
+//	𝔽p²[u] = 𝔽p/u²+1
+//	𝔽p⁶[v] = 𝔽p²/v³-1-u
+//	𝔽p¹²[w] = 𝔽p⁶/w²-v
+//
+// optimal Ate loop size:
+//
+//	x₀
+//
+// Security: estimated 128-bit level following [https://eprint.iacr.org/2019/885.pdf]
+// and [https://eprint.iacr.org/2019/1371.pdf]
+// (r is 308 bits and p¹² is 5532 bits)
+//
+// # Fixed-base scalar multiplication
+//
+// G1FixedBaseTable and G2FixedBaseTable store the multiples of a point which is used
+// as a base many times, with a window size trading memory for speed.
+// ScalarMultiplicationBase uses such a table for the generator, computed on first use.
+//
+// # Constant time
+//
+// ScalarMultiplicationCT, ScalarMultiplicationBaseCT and
+// ScalarMultiplicationFixedBaseCT don't branch on the scalar nor access memory
+// depending on it, and are used by the signature schemes for the secret scalars. The
+// other scalar multiplications are faster but not constant time.
+//
+// # Warning
+//
+// This code has been partially audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package bls12461
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/internal/fptower"
+)
+
+// ID bls461 ID
+const ID = ecc.BLS12_461
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+
+// twist
+var twist fptower.E2
+
+// bTwistCurveCoeff b coeff of the twist (defined over 𝔽p²) curve
+var bTwistCurveCoeff fptower.E2
+
+// generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+var g1Gen G1Jac
+var g2Gen G2Jac
+
+var g1GenAff G1Affine
+var g2GenAff G2Affine
+
+// point at infinity
+var g1Infinity G1Jac
+var g2Infinity G2Jac
+
+// optimal Ate loop counter
+var LoopCounter [78]int8
+
+// Parameters useful for the GLV scalar multiplication. The third roots define the
+// endomorphisms ϕ₁ and ϕ₂ for <G1Affine> and <G2Affine>. lambda is such that <r, ϕ-λ> lies above
+// <r> in the ring Z[ϕ]. More concretely it's the associated eigenvalue
+// of ϕ₁ (resp ϕ₂) restricted to <G1Affine> (resp <G2Affine>)
+// see https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var thirdRootOneG2 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independent vectors (a,b), (c,d)
+// in ker((u,v) → u+vλ[r]), and their determinant
+var glvBasis ecc.Lattice
+
+// ψ o π o ψ^{-1}, where ψ:E → E' is the degree 6 iso defined over 𝔽p¹²
+var endo struct {
+	u fptower.E2
+	v fptower.E2
+}
+
+// seed x₀ of the curve
+var xGen big.Int
+
+// 𝔽p²
+type E2 = fptower.E2
+
+// 𝔽p⁶
+type E6 = fptower.E6
+
+// 𝔽p¹²
+type E12 = fptower.E12
+
+func init() {
+	aCurveCoeff.SetUint64(0)
+	bCurveCoeff.SetUint64(4)
+	// M-twist
+	twist.A0.SetUint64(1)
+	twist.A1.SetUint64(1)
+	bTwistCurveCoeff.MulByElement(&twist, &bCurveCoeff)
+
+	g1Gen.X.SetString("1398527172648428069787168519105533253498566190341475351247629056777238709263509972090374302924050139827367373786653757974930999445344714417")
+	g1Gen.Y.SetString("1984309219437330368254821601850534503191827228715064844276923731265966736965969102514144381489166999808585598360882163233842701552684314222")
+	g1Gen.Z.SetOne()
+
+	g2Gen.X.SetString("3322594987029133172875246863511032904109914995874404471426908449246716405743981046369000807595967491731072516341011764433777708324000959996",
+		"3663686036400333745623170754613089559808187162694502004389740161567880538854003590139218831917131250264207526422921423625681419690730536740")
+	g2Gen.Y.SetString("3500294562026325806984416416777110861161682161734370257524785494794142935820684873446312375197157450098199344840217169474652624637584523196",
+		"412964459739816749102557587219731802732966611884112365247846058566754627376201505410739561744008711522952224240682808276091752551602094568")
+	g2Gen.Z.SetString("1",
+		"0")
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+	g2Infinity.X.SetOne()
+	g2Infinity.Y.SetOne()
+
+	thirdRootOneG1.SetString("3969508375500863470560693255137176963423801865085792368101350438590870255442235963096294938597297659006567800256110934583382094451363719852")
+	thirdRootOneG2.Square(&thirdRootOneG1)
+	lambdaGLV.SetString("22835962743010396295234787749133261360527835135", 10) //(x₀²-1)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+
+	endo.u.A0.SetString("0")
+	endo.u.A1.SetString("3969508375500863470560693255137176963423801865085792368101350438590870255442235963096294938597297659006567800256110934583382094451363719853")
+	endo.v.A0.SetString("2524210510698418061138192212520434726147573502997442178332977930495536998899461668978368355719774327896349475278357760768231432230068282728")
+	endo.v.A1.SetString("1445297864802445409422579846626199325652483890088312147713545716490315497269736875016472928977938943841418768889895640471011349490671994179")
+
+	// NAF decomposition of -x₀ little endian
+	LoopCounter = [78]int8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, -1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, -1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+
+	// -x₀
+	xGen.SetString("151115726325920150061056", 10)
+
+}
+
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Affine, g2Aff G2Affine) {
+	g1Aff = g1GenAff
+	g2Aff = g2GenAff
+	g1Jac = g1Gen
+	g2Jac = g2Gen
+	return
+}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
@@ -0,0 +1,1165 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+// expBySqrtExp is equivalent to z.Exp(x, 555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01000080001555552aaaaab00002aaaaaab)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_101      = 1 + _100
+	//	_110      = 1 + _101
+	//	_1000     = _10 + _110
+	//	_1101     = _101 + _1000
+	//	_1111     = _10 + _1101
+	//	_10111    = _1000 + _1111
+	//	_11000    = 1 + _10111
+	//	_11010    = _10 + _11000
+	//	_101001   = _1111 + _11010
+	//	_101010   = 1 + _101001
+	//	_101011   = 1 + _101010
+	//	_110011   = _1000 + _101011
+	//	_110100   = 1 + _110011
+	//	_1001101  = _11010 + _110011
+	//	_1010101  = _1000 + _1001101
+	//	_1011011  = _110 + _1010101
+	//	_1110011  = _11000 + _1011011
+	//	_1111001  = _110 + _1110011
+	//	_1111011  = _10 + _1111001
+	//	_10010001 = _11000 + _1111001
+	//	_10010101 = _100 + _10010001
+	//	_10101001 = _11000 + _10010001
+	//	_10101011 = _10 + _10101001
+	//	_10101101 = _10 + _10101011
+	//	_11011111 = _110100 + _10101011
+	//	_11100011 = _100 + _11011111
+	//	_11101011 = _1000 + _11100011
+	//	i46       = ((_10101001 + _10101011) << 6 + _1010101) << 8 + _1010101
+	//	i75       = ((i46 << 10 + _1010101) << 9 + _10101001) << 8
+	//	i96       = ((_10101011 + i75) << 9 + _10010101) << 9 + _10101101
+	//	i121      = ((i96 << 10 + _10101011) << 8 + _10101101) << 5
+	//	i144      = ((_101 + i121) << 11 + _101001) << 9 + _1001101
+	//	i164      = (i144 << 9 + _11010 + _11100011) << 8 + _11101011
+	//	i184      = (i164 << 9 + _110 + _11101011) << 8 + _11100011
+	//	i206      = (i184 << 9 + _1011011) << 10 + _1011011 + _10
+	//	i233      = ((i206 << 7 + _110011) << 8 + _101001) << 10
+	//	i257      = ((_1111011 + i233) << 9 + _101001) << 12 + _10010001
+	//	i286      = ((i257 << 5 + _1101) << 11 + _1010101) << 11
+	//	i303      = ((_11011111 + i286) << 7 + _1111011 + _10) << 7
+	//	i335      = ((_10111 + i303) << 12 + _1110011) << 17 + _1111001
+	//	i355      = (i335 << 10 + _101010 + _10101011) << 7 + _101001
+	//	i377      = (i355 << 10 + _1010101) << 9 + _10010101 + _101010
+	//	i407      = ((2*i377 + 1) << 10 + 1) << 17
+	//	i443      = ((1 + i407) << 25 + _1010101) << 8 + _1010101
+	//	i470      = ((i443 << 9 + _10101001) << 8 + _1010101) << 8
+	//	i507      = ((_1010101 + i470) << 9 + _10101011) << 25 + _1010101
+	//	i532      = ((i507 << 8 + _1010101) << 8 + _1010101) << 7
+	//	return      _101011 + i532
+	//
+	// Operations: 452 squares 81 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+		t23 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22,t23 Element
+	// Step 1: t9 = x^0x2
+	t9.Square(&x)
+
+	// Step 2: t16 = x^0x4
+	t16.Square(t9)
+
+	// Step 3: t21 = x^0x5
+	t21.Mul(&x, t16)
+
+	// Step 4: t18 = x^0x6
+	t18.Mul(&x, t21)
+
+	// Step 5: t17 = x^0x8
+	t17.Mul(t9, t18)
+
+	// Step 6: t12 = x^0xd
+	t12.Mul(t21, t17)
+
+	// Step 7: z = x^0xf
+	z.Mul(t9, t12)
+
+	// Step 8: t8 = x^0x17
+	t8.Mul(t17, z)
+
+	// Step 9: t1 = x^0x18
+	t1.Mul(&x, t8)
+
+	// Step 10: t19 = x^0x1a
+	t19.Mul(t9, t1)
+
+	// Step 11: t5 = x^0x29
+	t5.Mul(z, t19)
+
+	// Step 12: t3 = x^0x2a
+	t3.Mul(&x, t5)
+
+	// Step 13: z = x^0x2b
+	z.Mul(&x, t3)
+
+	// Step 14: t14 = x^0x33
+	t14.Mul(t17, z)
+
+	// Step 15: t11 = x^0x34
+	t11.Mul(&x, t14)
+
+	// Step 16: t20 = x^0x4d
+	t20.Mul(t19, t14)
+
+	// Step 17: t0 = x^0x55
+	t0.Mul(t17, t20)
+
+	// Step 18: t15 = x^0x5b
+	t15.Mul(t18, t0)
+
+	// Step 19: t7 = x^0x73
+	t7.Mul(t1, t15)
+
+	// Step 20: t6 = x^0x79
+	t6.Mul(t18, t7)
+
+	// Step 21: t10 = x^0x7b
+	t10.Mul(t9, t6)
+
+	// Step 22: t13 = x^0x91
+	t13.Mul(t1, t6)
+
+	// Step 23: t4 = x^0x95
+	t4.Mul(t16, t13)
+
+	// Step 24: t2 = x^0xa9
+	t2.Mul(t1, t13)
+
+	// Step 25: t1 = x^0xab
+	t1.Mul(t9, t2)
+
+	// Step 26: t22 = x^0xad
+	t22.Mul(t9, t1)
+
+	// Step 27: t11 = x^0xdf
+	t11.Mul(t11, t1)
+
+	// Step 28: t16 = x^0xe3
+	t16.Mul(t16, t11)
+
+	// Step 29: t17 = x^0xeb
+	t17.Mul(t17, t16)
+
+	// Step 30: t23 = x^0x154
+	t23.Mul(t2, t1)
+
+	// Step 36: t23 = x^0x5500
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 37: t23 = x^0x5555
+	t23.Mul(t0, t23)
+
+	// Step 45: t23 = x^0x555500
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 46: t23 = x^0x555555
+	t23.Mul(t0, t23)
+
+	// Step 56: t23 = x^0x155555400
+	for s := 0; s < 10; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 57: t23 = x^0x155555455
+	t23.Mul(t0, t23)
+
+	// Step 66: t23 = x^0x2aaaaa8aa00
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 67: t23 = x^0x2aaaaa8aaa9
+	t23.Mul(t2, t23)
+
+	// Step 75: t23 = x^0x2aaaaa8aaa900
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 76: t23 = x^0x2aaaaa8aaa9ab
+	t23.Mul(t1, t23)
+
+	// Step 85: t23 = x^0x555555155535600
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 86: t23 = x^0x555555155535695
+	t23.Mul(t4, t23)
+
+	// Step 95: t23 = x^0xaaaaaa2aaa6ad2a00
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 96: t23 = x^0xaaaaaa2aaa6ad2aad
+	t23.Mul(t22, t23)
+
+	// Step 106: t23 = x^0x2aaaaa8aaa9ab4aab400
+	for s := 0; s < 10; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 107: t23 = x^0x2aaaaa8aaa9ab4aab4ab
+	t23.Mul(t1, t23)
+
+	// Step 115: t23 = x^0x2aaaaa8aaa9ab4aab4ab00
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 116: t22 = x^0x2aaaaa8aaa9ab4aab4abad
+	t22.Mul(t22, t23)
+
+	// Step 121: t22 = x^0x555555155535695569575a0
+	for s := 0; s < 5; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 122: t21 = x^0x555555155535695569575a5
+	t21.Mul(t21, t22)
+
+	// Step 133: t21 = x^0x2aaaaa8aaa9ab4aab4abad2800
+	for s := 0; s < 11; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 134: t21 = x^0x2aaaaa8aaa9ab4aab4abad2829
+	t21.Mul(t5, t21)
+
+	// Step 143: t21 = x^0x555555155535695569575a505200
+	for s := 0; s < 9; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 144: t20 = x^0x555555155535695569575a50524d
+	t20.Mul(t20, t21)
+
+	// Step 153: t20 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49a00
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 154: t19 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49a1a
+	t19.Mul(t19, t20)
+
+	// Step 155: t19 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afd
+	t19.Mul(t16, t19)
+
+	// Step 163: t19 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afd00
+	for s := 0; s < 8; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 164: t19 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb
+	t19.Mul(t17, t19)
+
+	// Step 173: t19 = x^0x15555545554d5a555a55d69414935fbd600
+	for s := 0; s < 9; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 174: t18 = x^0x15555545554d5a555a55d69414935fbd606
+	t18.Mul(t18, t19)
+
+	// Step 175: t17 = x^0x15555545554d5a555a55d69414935fbd6f1
+	t17.Mul(t17, t18)
+
+	// Step 183: t17 = x^0x15555545554d5a555a55d69414935fbd6f100
+	for s := 0; s < 8; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 184: t16 = x^0x15555545554d5a555a55d69414935fbd6f1e3
+	t16.Mul(t16, t17)
+
+	// Step 193: t16 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c600
+	for s := 0; s < 9; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 194: t16 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b
+	t16.Mul(t15, t16)
+
+	// Step 204: t16 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c00
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 205: t15 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5b
+	t15.Mul(t15, t16)
+
+	// Step 206: t15 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d
+	t15.Mul(t9, t15)
+
+	// Step 213: t15 = x^0x555555155535695569575a50524d7ef5bc78cb62e80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 214: t14 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3
+	t14.Mul(t14, t15)
+
+	// Step 222: t14 = x^0x555555155535695569575a50524d7ef5bc78cb62eb300
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 223: t14 = x^0x555555155535695569575a50524d7ef5bc78cb62eb329
+	t14.Mul(t5, t14)
+
+	// Step 233: t14 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 234: t14 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b
+	t14.Mul(t10, t14)
+
+	// Step 243: t14 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f600
+	for s := 0; s < 9; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 244: t14 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f629
+	t14.Mul(t5, t14)
+
+	// Step 256: t14 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f629000
+	for s := 0; s < 12; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 257: t13 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f629091
+	t13.Mul(t13, t14)
+
+	// Step 262: t13 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec521220
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 263: t12 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d
+	t12.Mul(t12, t13)
+
+	// Step 274: t12 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f6290916800
+	for s := 0; s < 11; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 275: t12 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f6290916855
+	t12.Mul(t0, t12)
+
+	// Step 286: t12 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a800
+	for s := 0; s < 11; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 287: t11 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8df
+	t11.Mul(t11, t12)
+
+	// Step 294: t11 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546f80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 295: t10 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffb
+	t10.Mul(t10, t11)
+
+	// Step 296: t9 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd
+	t9.Mul(t9, t10)
+
+	// Step 303: t9 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe80
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 304: t8 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97
+	t8.Mul(t8, t9)
+
+	// Step 316: t8 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97000
+	for s := 0; s < 12; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 317: t7 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073
+	t7.Mul(t7, t8)
+
+	// Step 334: t7 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e60000
+	for s := 0; s < 17; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 335: t6 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e60079
+	t6.Mul(t6, t7)
+
+	// Step 345: t6 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e400
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 346: t6 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e42a
+	t6.Mul(t3, t6)
+
+	// Step 347: t6 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5
+	t6.Mul(t1, t6)
+
+	// Step 354: t6 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26a80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 355: t5 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa9
+	t5.Mul(t5, t6)
+
+	// Step 365: t5 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa400
+	for s := 0; s < 10; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 366: t5 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa455
+	t5.Mul(t0, t5)
+
+	// Step 375: t5 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aa00
+	for s := 0; s < 9; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 376: t4 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aa95
+	t4.Mul(t4, t5)
+
+	// Step 377: t3 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf
+	t3.Mul(t3, t4)
+
+	// Step 378: t3 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557e
+	t3.Square(t3)
+
+	// Step 379: t3 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f
+	t3.Mul(&x, t3)
+
+	// Step 389: t3 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc00
+	for s := 0; s < 10; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 390: t3 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01
+	t3.Mul(&x, t3)
+
+	// Step 407: t3 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf8020000
+	for s := 0; s < 17; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 408: t3 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf8020001
+	t3.Mul(&x, t3)
+
+	// Step 433: t3 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f0040002000000
+	for s := 0; s < 25; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 434: t3 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f0040002000055
+	t3.Mul(t0, t3)
+
+	// Step 442: t3 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f004000200005500
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 443: t3 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f004000200005555
+	t3.Mul(t0, t3)
+
+	// Step 452: t3 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaa00
+	for s := 0; s < 9; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 453: t2 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa9
+	t2.Mul(t2, t3)
+
+	// Step 461: t2 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa900
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 462: t2 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa955
+	t2.Mul(t0, t2)
+
+	// Step 470: t2 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa95500
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 471: t2 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa95555
+	t2.Mul(t0, t2)
+
+	// Step 480: t2 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01000080001555552aaaa00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 481: t1 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01000080001555552aaaaab
+	t1.Mul(t1, t2)
+
+	// Step 506: t1 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa5555556000000
+	for s := 0; s < 25; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 507: t1 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa5555556000055
+	t1.Mul(t0, t1)
+
+	// Step 515: t1 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa555555600005500
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 516: t1 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa555555600005555
+	t1.Mul(t0, t1)
+
+	// Step 524: t1 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa55555560000555500
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 525: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa55555560000555555
+	t0.Mul(t0, t1)
+
+	// Step 532: t0 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01000080001555552aaaaab00002aaaaa80
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 533: z = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01000080001555552aaaaab00002aaaaaab
+	z.Mul(z, t0)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, aaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa5555556000055555555)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_110      = 1 + _101
+	//	_111      = 1 + _110
+	//	_1101     = _110 + _111
+	//	_1111     = _10 + _1101
+	//	_10110    = _111 + _1111
+	//	_11001    = _11 + _10110
+	//	_11010    = 1 + _11001
+	//	_101001   = _1111 + _11010
+	//	_110011   = _11001 + _11010
+	//	_1001101  = _11010 + _110011
+	//	_1001110  = 1 + _1001101
+	//	_1010101  = _111 + _1001110
+	//	_1011011  = _110 + _1010101
+	//	_1011101  = _10 + _1011011
+	//	_1110011  = _10110 + _1011101
+	//	_1111001  = _110 + _1110011
+	//	_1111011  = _10 + _1111001
+	//	_10010001 = _10110 + _1111011
+	//	_10010101 = _11010 + _1111011
+	//	_10101001 = _1001110 + _1011011
+	//	_10101011 = _10 + _10101001
+	//	_10101101 = _10 + _10101011
+	//	_10111111 = _10110 + _10101001
+	//	_11010101 = _10110 + _10111111
+	//	_11100011 = _1001110 + _10010101
+	//	_11101011 = _10110 + _11010101
+	//	_11111101 = _11010 + _11100011
+	//	i47       = ((_10010101 + _10111111) << 6 + _1010101) << 8 + _1010101
+	//	i76       = ((i47 << 10 + _1010101) << 9 + _10101001) << 8
+	//	i97       = ((_10101011 + i76) << 9 + _10010101) << 9 + _10101101
+	//	i122      = ((i97 << 10 + _10101011) << 8 + _10101101) << 5
+	//	i145      = ((_101 + i122) << 11 + _101001) << 9 + _1001101
+	//	i173      = ((i145 << 9 + _11111101) << 8 + _11101011) << 9
+	//	i193      = ((_11101011 + i173 + _110) << 8 + _11100011) << 9
+	//	i213      = ((_1011011 + i193) << 10 + _1011101) << 7 + _110011
+	//	i242      = ((i213 << 8 + _101001) << 10 + _1111011) << 9
+	//	i262      = ((_101001 + i242) << 12 + _10010001) << 5 + _1101
+	//	i289      = ((i262 << 11 + _1010101) << 5 + _11) << 9
+	//	i303      = ((_11111101 + i289 + _10) << 2 + _11) << 9
+	//	i335      = ((_10010101 + i303 + _10) << 12 + _1110011) << 17
+	//	i355      = ((_1111001 + i335) << 10 + _11010101) << 7 + _101001
+	//	i377      = 2*((i355 << 10 + _1010101) << 9 + _10111111)
+	//	i407      = ((1 + i377) << 10 + 1) << 17 + 1
+	//	i451      = ((i407 << 25 + _1010101) << 8 + _1010101) << 9
+	//	i470      = ((_10101001 + i451) << 8 + _1010101) << 8 + _1010101
+	//	i514      = ((i470 << 9 + _10101011) << 25 + _1010101) << 8
+	//	return      ((_1010101 + i514) << 8 + _1010101) << 8 + _1010101
+	//
+	// Operations: 452 squares 81 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+		t23 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22,t23 Element
+	// Step 1: t7 = x^0x2
+	t7.Square(&x)
+
+	// Step 2: t9 = x^0x3
+	t9.Mul(&x, t7)
+
+	// Step 3: t21 = x^0x5
+	t21.Mul(t7, t9)
+
+	// Step 4: t18 = x^0x6
+	t18.Mul(&x, t21)
+
+	// Step 5: z = x^0x7
+	z.Mul(&x, t18)
+
+	// Step 6: t11 = x^0xd
+	t11.Mul(t18, z)
+
+	// Step 7: t1 = x^0xf
+	t1.Mul(t7, t11)
+
+	// Step 8: t19 = x^0x16
+	t19.Mul(z, t1)
+
+	// Step 9: t0 = x^0x19
+	t0.Mul(t9, t19)
+
+	// Step 10: t10 = x^0x1a
+	t10.Mul(&x, t0)
+
+	// Step 11: t3 = x^0x29
+	t3.Mul(t1, t10)
+
+	// Step 12: t14 = x^0x33
+	t14.Mul(t0, t10)
+
+	// Step 13: t20 = x^0x4d
+	t20.Mul(t10, t14)
+
+	// Step 14: t17 = x^0x4e
+	t17.Mul(&x, t20)
+
+	// Step 15: z = x^0x55
+	z.Mul(z, t17)
+
+	// Step 16: t16 = x^0x5b
+	t16.Mul(t18, z)
+
+	// Step 17: t15 = x^0x5d
+	t15.Mul(t7, t16)
+
+	// Step 18: t6 = x^0x73
+	t6.Mul(t19, t15)
+
+	// Step 19: t5 = x^0x79
+	t5.Mul(t18, t6)
+
+	// Step 20: t13 = x^0x7b
+	t13.Mul(t7, t5)
+
+	// Step 21: t12 = x^0x91
+	t12.Mul(t19, t13)
+
+	// Step 22: t8 = x^0x95
+	t8.Mul(t10, t13)
+
+	// Step 23: t1 = x^0xa9
+	t1.Mul(t17, t16)
+
+	// Step 24: t0 = x^0xab
+	t0.Mul(t7, t1)
+
+	// Step 25: t22 = x^0xad
+	t22.Mul(t7, t0)
+
+	// Step 26: t2 = x^0xbf
+	t2.Mul(t19, t1)
+
+	// Step 27: t4 = x^0xd5
+	t4.Mul(t19, t2)
+
+	// Step 28: t17 = x^0xe3
+	t17.Mul(t17, t8)
+
+	// Step 29: t19 = x^0xeb
+	t19.Mul(t19, t4)
+
+	// Step 30: t10 = x^0xfd
+	t10.Mul(t10, t17)
+
+	// Step 31: t23 = x^0x154
+	t23.Mul(t8, t2)
+
+	// Step 37: t23 = x^0x5500
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 38: t23 = x^0x5555
+	t23.Mul(z, t23)
+
+	// Step 46: t23 = x^0x555500
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 47: t23 = x^0x555555
+	t23.Mul(z, t23)
+
+	// Step 57: t23 = x^0x155555400
+	for s := 0; s < 10; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 58: t23 = x^0x155555455
+	t23.Mul(z, t23)
+
+	// Step 67: t23 = x^0x2aaaaa8aa00
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 68: t23 = x^0x2aaaaa8aaa9
+	t23.Mul(t1, t23)
+
+	// Step 76: t23 = x^0x2aaaaa8aaa900
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 77: t23 = x^0x2aaaaa8aaa9ab
+	t23.Mul(t0, t23)
+
+	// Step 86: t23 = x^0x555555155535600
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 87: t23 = x^0x555555155535695
+	t23.Mul(t8, t23)
+
+	// Step 96: t23 = x^0xaaaaaa2aaa6ad2a00
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 97: t23 = x^0xaaaaaa2aaa6ad2aad
+	t23.Mul(t22, t23)
+
+	// Step 107: t23 = x^0x2aaaaa8aaa9ab4aab400
+	for s := 0; s < 10; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 108: t23 = x^0x2aaaaa8aaa9ab4aab4ab
+	t23.Mul(t0, t23)
+
+	// Step 116: t23 = x^0x2aaaaa8aaa9ab4aab4ab00
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 117: t22 = x^0x2aaaaa8aaa9ab4aab4abad
+	t22.Mul(t22, t23)
+
+	// Step 122: t22 = x^0x555555155535695569575a0
+	for s := 0; s < 5; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 123: t21 = x^0x555555155535695569575a5
+	t21.Mul(t21, t22)
+
+	// Step 134: t21 = x^0x2aaaaa8aaa9ab4aab4abad2800
+	for s := 0; s < 11; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 135: t21 = x^0x2aaaaa8aaa9ab4aab4abad2829
+	t21.Mul(t3, t21)
+
+	// Step 144: t21 = x^0x555555155535695569575a505200
+	for s := 0; s < 9; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 145: t20 = x^0x555555155535695569575a50524d
+	t20.Mul(t20, t21)
+
+	// Step 154: t20 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49a00
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 155: t20 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afd
+	t20.Mul(t10, t20)
+
+	// Step 163: t20 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afd00
+	for s := 0; s < 8; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 164: t20 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb
+	t20.Mul(t19, t20)
+
+	// Step 173: t20 = x^0x15555545554d5a555a55d69414935fbd600
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 174: t19 = x^0x15555545554d5a555a55d69414935fbd6eb
+	t19.Mul(t19, t20)
+
+	// Step 175: t18 = x^0x15555545554d5a555a55d69414935fbd6f1
+	t18.Mul(t18, t19)
+
+	// Step 183: t18 = x^0x15555545554d5a555a55d69414935fbd6f100
+	for s := 0; s < 8; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 184: t17 = x^0x15555545554d5a555a55d69414935fbd6f1e3
+	t17.Mul(t17, t18)
+
+	// Step 193: t17 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c600
+	for s := 0; s < 9; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 194: t16 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b
+	t16.Mul(t16, t17)
+
+	// Step 204: t16 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c00
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 205: t15 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d
+	t15.Mul(t15, t16)
+
+	// Step 212: t15 = x^0x555555155535695569575a50524d7ef5bc78cb62e80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 213: t14 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3
+	t14.Mul(t14, t15)
+
+	// Step 221: t14 = x^0x555555155535695569575a50524d7ef5bc78cb62eb300
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 222: t14 = x^0x555555155535695569575a50524d7ef5bc78cb62eb329
+	t14.Mul(t3, t14)
+
+	// Step 232: t14 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 233: t13 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b
+	t13.Mul(t13, t14)
+
+	// Step 242: t13 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f600
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 243: t13 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f629
+	t13.Mul(t3, t13)
+
+	// Step 255: t13 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f629000
+	for s := 0; s < 12; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 256: t12 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f629091
+	t12.Mul(t12, t13)
+
+	// Step 261: t12 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec521220
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 262: t11 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d
+	t11.Mul(t11, t12)
+
+	// Step 273: t11 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f6290916800
+	for s := 0; s < 11; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 274: t11 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f6290916855
+	t11.Mul(z, t11)
+
+	// Step 279: t11 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 280: t11 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa3
+	t11.Mul(t9, t11)
+
+	// Step 289: t11 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a154600
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 290: t10 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546fd
+	t10.Mul(t10, t11)
+
+	// Step 291: t10 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ff
+	t10.Mul(t7, t10)
+
+	// Step 293: t10 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bfc
+	for s := 0; s < 2; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 294: t9 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff
+	t9.Mul(t9, t10)
+
+	// Step 303: t9 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe00
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 304: t8 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe95
+	t8.Mul(t8, t9)
+
+	// Step 305: t7 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97
+	t7.Mul(t7, t8)
+
+	// Step 317: t7 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97000
+	for s := 0; s < 12; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 318: t6 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073
+	t6.Mul(t6, t7)
+
+	// Step 335: t6 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e60000
+	for s := 0; s < 17; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 336: t5 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e60079
+	t5.Mul(t5, t6)
+
+	// Step 346: t5 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e400
+	for s := 0; s < 10; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 347: t4 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5
+	t4.Mul(t4, t5)
+
+	// Step 354: t4 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26a80
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 355: t3 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa9
+	t3.Mul(t3, t4)
+
+	// Step 365: t3 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa400
+	for s := 0; s < 10; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 366: t3 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa455
+	t3.Mul(z, t3)
+
+	// Step 375: t3 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aa00
+	for s := 0; s < 9; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 376: t2 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf
+	t2.Mul(t2, t3)
+
+	// Step 377: t2 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557e
+	t2.Square(t2)
+
+	// Step 378: t2 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f
+	t2.Mul(&x, t2)
+
+	// Step 388: t2 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc00
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 389: t2 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01
+	t2.Mul(&x, t2)
+
+	// Step 406: t2 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf8020000
+	for s := 0; s < 17; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 407: t2 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf8020001
+	t2.Mul(&x, t2)
+
+	// Step 432: t2 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f0040002000000
+	for s := 0; s < 25; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 433: t2 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f0040002000055
+	t2.Mul(z, t2)
+
+	// Step 441: t2 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f004000200005500
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 442: t2 = x^0x15555545554d5a555a55d69414935fbd6f1e32d8bacca47b14848b42a8dffa5c1cc00f26aa91557f004000200005555
+	t2.Mul(z, t2)
+
+	// Step 451: t2 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaa00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 452: t1 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa9
+	t1.Mul(t1, t2)
+
+	// Step 460: t1 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa900
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 461: t1 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa955
+	t1.Mul(z, t1)
+
+	// Step 469: t1 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa95500
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 470: t1 = x^0x2aaaaa8aaa9ab4aab4abad282926bf7ade3c65b1759948f62909168551bff4b839801e4d5522aafe00800040000aaaaa95555
+	t1.Mul(z, t1)
+
+	// Step 479: t1 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01000080001555552aaaa00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 480: t0 = x^0x555555155535695569575a50524d7ef5bc78cb62eb3291ec52122d0aa37fe97073003c9aaa4555fc01000080001555552aaaaab
+	t0.Mul(t0, t1)
+
+	// Step 505: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa5555556000000
+	for s := 0; s < 25; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 506: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa5555556000055
+	t0.Mul(z, t0)
+
+	// Step 514: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa555555600005500
+	for s := 0; s < 8; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 515: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa555555600005555
+	t0.Mul(z, t0)
+
+	// Step 523: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa55555560000555500
+	for s := 0; s < 8; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 524: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa55555560000555555
+	t0.Mul(z, t0)
+
+	// Step 532: t0 = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa5555556000055555500
+	for s := 0; s < 8; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 533: z = x^0xaaaaaa2aaa6ad2aad2aeb4a0a49afdeb78f196c5d66523d8a4245a1546ffd2e0e6007935548aabf802000100002aaaaa5555556000055555555
+	z.Mul(z, t0)
+
+	return z
+}
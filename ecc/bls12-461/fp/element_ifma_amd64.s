@@ -0,0 +1,2728 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q, in radix 2⁵²
+DATA q52<>+0(SB)/8, $0x000c0000aaaaaaab
+DATA q52<>+8(SB)/8, $0x0000555554aaaaaa
+DATA q52<>+16(SB)/8, $0x00057f0040002000
+DATA q52<>+24(SB)/8, $0x0001cc00f26aa915
+DATA q52<>+32(SB)/8, $0x00048b42a8dffa5c
+DATA q52<>+40(SB)/8, $0x000d8bacca47b148
+DATA q52<>+48(SB)/8, $0x0004935fbd6f1e32
+DATA q52<>+56(SB)/8, $0x0005a555a55d6941
+DATA q52<>+64(SB)/8, $0x000015555545554d
+GLOBL q52<>(SB), (RODATA+NOPTR), $72
+
+// c = 2¹⁰⁴ᴸ⁻⁶⁴ᴺ mod q, in radix 2⁵²
+DATA c52<>+0(SB)/8, $0x0000000000000000
+DATA c52<>+8(SB)/8, $0x0000000000000000
+DATA c52<>+16(SB)/8, $0x0000000000000000
+DATA c52<>+24(SB)/8, $0x0000000000000000
+DATA c52<>+32(SB)/8, $0x0000000000000000
+DATA c52<>+40(SB)/8, $0x0000000000000000
+DATA c52<>+48(SB)/8, $0x0000000000000000
+DATA c52<>+56(SB)/8, $0x0000000000000000
+DATA c52<>+64(SB)/8, $0x0000000000000100
+GLOBL c52<>(SB), (RODATA+NOPTR), $72
+
+// qInvNeg = -q⁻¹ mod 2⁵²
+DATA qInvNeg52<>+0(SB)/8, $0x000c0005fffffffd
+GLOBL qInvNeg52<>(SB), (RODATA+NOPTR), $8
+
+// offsets of 8 consecutive elements
+DATA offsets<>+0(SB)/8, $0x0000000000000000
+DATA offsets<>+8(SB)/8, $0x0000000000000040
+DATA offsets<>+16(SB)/8, $0x0000000000000080
+DATA offsets<>+24(SB)/8, $0x00000000000000c0
+DATA offsets<>+32(SB)/8, $0x0000000000000100
+DATA offsets<>+40(SB)/8, $0x0000000000000140
+DATA offsets<>+48(SB)/8, $0x0000000000000180
+DATA offsets<>+56(SB)/8, $0x00000000000001c0
+GLOBL offsets<>(SB), (RODATA+NOPTR), $64
+
+// mulVecIFMA(res, a, b *Element, n uint64) res[i] = a[i]·b[i], for 8·n elements
+TEXT ·mulVecIFMA(SB), $1152-32
+	MOVQ         $0xfffffffffffff, R8
+	VPBROADCASTQ R8, Z31
+	VMOVDQU64    offsets<>(SB), Z30
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+
+l1:
+	TESTQ      BX, BX
+	JEQ        l2
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 0(AX)(Z30*1), K1, Z20
+	VPSRLQ     $0, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 0(SP)
+	VPSRLQ     $52, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 8(AX)(Z30*1), K1, Z21
+	VPSLLQ     $12, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 64(SP)
+	VPSRLQ     $40, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 16(AX)(Z30*1), K1, Z20
+	VPSLLQ     $24, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 128(SP)
+	VPSRLQ     $28, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 24(AX)(Z30*1), K1, Z21
+	VPSLLQ     $36, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 192(SP)
+	VPSRLQ     $16, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 32(AX)(Z30*1), K1, Z20
+	VPSLLQ     $48, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 256(SP)
+	VPSRLQ     $4, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 320(SP)
+	VPSRLQ     $56, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 40(AX)(Z30*1), K1, Z21
+	VPSLLQ     $8, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 384(SP)
+	VPSRLQ     $44, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 48(AX)(Z30*1), K1, Z20
+	VPSLLQ     $20, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 448(SP)
+	VPSRLQ     $32, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 56(AX)(Z30*1), K1, Z21
+	VPSLLQ     $32, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 512(SP)
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 0(DX)(Z30*1), K1, Z20
+	VPSRLQ     $0, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 576(SP)
+	VPSRLQ     $52, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 8(DX)(Z30*1), K1, Z21
+	VPSLLQ     $12, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 640(SP)
+	VPSRLQ     $40, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 16(DX)(Z30*1), K1, Z20
+	VPSLLQ     $24, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 704(SP)
+	VPSRLQ     $28, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 24(DX)(Z30*1), K1, Z21
+	VPSLLQ     $36, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 768(SP)
+	VPSRLQ     $16, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 32(DX)(Z30*1), K1, Z20
+	VPSLLQ     $48, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 832(SP)
+	VPSRLQ     $4, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 896(SP)
+	VPSRLQ     $56, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 40(DX)(Z30*1), K1, Z21
+	VPSLLQ     $8, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 960(SP)
+	VPSRLQ     $44, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 48(DX)(Z30*1), K1, Z20
+	VPSLLQ     $20, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 1024(SP)
+	VPSRLQ     $32, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 56(DX)(Z30*1), K1, Z21
+	VPSLLQ     $32, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 1088(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+	VPXORQ     Z8, Z8, Z8
+	VPXORQ     Z9, Z9, Z9
+
+	// t += x·y[0], followed by a reduction round
+	VMOVDQU64        576(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z0
+	VPMADD52HUQ      0(SP), Z16, Z1
+	VPMADD52LUQ      64(SP), Z16, Z1
+	VPMADD52HUQ      64(SP), Z16, Z2
+	VPMADD52LUQ      128(SP), Z16, Z2
+	VPMADD52HUQ      128(SP), Z16, Z3
+	VPMADD52LUQ      192(SP), Z16, Z3
+	VPMADD52HUQ      192(SP), Z16, Z4
+	VPMADD52LUQ      256(SP), Z16, Z4
+	VPMADD52HUQ      256(SP), Z16, Z5
+	VPMADD52LUQ      320(SP), Z16, Z5
+	VPMADD52HUQ      320(SP), Z16, Z6
+	VPMADD52LUQ      384(SP), Z16, Z6
+	VPMADD52HUQ      384(SP), Z16, Z7
+	VPMADD52LUQ      448(SP), Z16, Z7
+	VPMADD52HUQ      448(SP), Z16, Z8
+	VPMADD52LUQ      512(SP), Z16, Z8
+	VPMADD52HUQ      512(SP), Z16, Z9
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z0, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z9
+	VPSRLQ           $52, Z0, Z18
+	VPADDQ           Z18, Z1, Z1
+	VPXORQ           Z0, Z0, Z0
+
+	// t += x·y[1], followed by a reduction round
+	VMOVDQU64        640(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z1
+	VPMADD52HUQ      0(SP), Z16, Z2
+	VPMADD52LUQ      64(SP), Z16, Z2
+	VPMADD52HUQ      64(SP), Z16, Z3
+	VPMADD52LUQ      128(SP), Z16, Z3
+	VPMADD52HUQ      128(SP), Z16, Z4
+	VPMADD52LUQ      192(SP), Z16, Z4
+	VPMADD52HUQ      192(SP), Z16, Z5
+	VPMADD52LUQ      256(SP), Z16, Z5
+	VPMADD52HUQ      256(SP), Z16, Z6
+	VPMADD52LUQ      320(SP), Z16, Z6
+	VPMADD52HUQ      320(SP), Z16, Z7
+	VPMADD52LUQ      384(SP), Z16, Z7
+	VPMADD52HUQ      384(SP), Z16, Z8
+	VPMADD52LUQ      448(SP), Z16, Z8
+	VPMADD52HUQ      448(SP), Z16, Z9
+	VPMADD52LUQ      512(SP), Z16, Z9
+	VPMADD52HUQ      512(SP), Z16, Z0
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z1, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z0
+	VPSRLQ           $52, Z1, Z18
+	VPADDQ           Z18, Z2, Z2
+	VPXORQ           Z1, Z1, Z1
+
+	// t += x·y[2], followed by a reduction round
+	VMOVDQU64        704(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z2
+	VPMADD52HUQ      0(SP), Z16, Z3
+	VPMADD52LUQ      64(SP), Z16, Z3
+	VPMADD52HUQ      64(SP), Z16, Z4
+	VPMADD52LUQ      128(SP), Z16, Z4
+	VPMADD52HUQ      128(SP), Z16, Z5
+	VPMADD52LUQ      192(SP), Z16, Z5
+	VPMADD52HUQ      192(SP), Z16, Z6
+	VPMADD52LUQ      256(SP), Z16, Z6
+	VPMADD52HUQ      256(SP), Z16, Z7
+	VPMADD52LUQ      320(SP), Z16, Z7
+	VPMADD52HUQ      320(SP), Z16, Z8
+	VPMADD52LUQ      384(SP), Z16, Z8
+	VPMADD52HUQ      384(SP), Z16, Z9
+	VPMADD52LUQ      448(SP), Z16, Z9
+	VPMADD52HUQ      448(SP), Z16, Z0
+	VPMADD52LUQ      512(SP), Z16, Z0
+	VPMADD52HUQ      512(SP), Z16, Z1
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z2, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z1
+	VPSRLQ           $52, Z2, Z18
+	VPADDQ           Z18, Z3, Z3
+	VPXORQ           Z2, Z2, Z2
+
+	// t += x·y[3], followed by a reduction round
+	VMOVDQU64        768(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z3
+	VPMADD52HUQ      0(SP), Z16, Z4
+	VPMADD52LUQ      64(SP), Z16, Z4
+	VPMADD52HUQ      64(SP), Z16, Z5
+	VPMADD52LUQ      128(SP), Z16, Z5
+	VPMADD52HUQ      128(SP), Z16, Z6
+	VPMADD52LUQ      192(SP), Z16, Z6
+	VPMADD52HUQ      192(SP), Z16, Z7
+	VPMADD52LUQ      256(SP), Z16, Z7
+	VPMADD52HUQ      256(SP), Z16, Z8
+	VPMADD52LUQ      320(SP), Z16, Z8
+	VPMADD52HUQ      320(SP), Z16, Z9
+	VPMADD52LUQ      384(SP), Z16, Z9
+	VPMADD52HUQ      384(SP), Z16, Z0
+	VPMADD52LUQ      448(SP), Z16, Z0
+	VPMADD52HUQ      448(SP), Z16, Z1
+	VPMADD52LUQ      512(SP), Z16, Z1
+	VPMADD52HUQ      512(SP), Z16, Z2
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z3, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z2
+	VPSRLQ           $52, Z3, Z18
+	VPADDQ           Z18, Z4, Z4
+	VPXORQ           Z3, Z3, Z3
+
+	// t += x·y[4], followed by a reduction round
+	VMOVDQU64        832(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z4
+	VPMADD52HUQ      0(SP), Z16, Z5
+	VPMADD52LUQ      64(SP), Z16, Z5
+	VPMADD52HUQ      64(SP), Z16, Z6
+	VPMADD52LUQ      128(SP), Z16, Z6
+	VPMADD52HUQ      128(SP), Z16, Z7
+	VPMADD52LUQ      192(SP), Z16, Z7
+	VPMADD52HUQ      192(SP), Z16, Z8
+	VPMADD52LUQ      256(SP), Z16, Z8
+	VPMADD52HUQ      256(SP), Z16, Z9
+	VPMADD52LUQ      320(SP), Z16, Z9
+	VPMADD52HUQ      320(SP), Z16, Z0
+	VPMADD52LUQ      384(SP), Z16, Z0
+	VPMADD52HUQ      384(SP), Z16, Z1
+	VPMADD52LUQ      448(SP), Z16, Z1
+	VPMADD52HUQ      448(SP), Z16, Z2
+	VPMADD52LUQ      512(SP), Z16, Z2
+	VPMADD52HUQ      512(SP), Z16, Z3
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z4, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z3
+	VPSRLQ           $52, Z4, Z18
+	VPADDQ           Z18, Z5, Z5
+	VPXORQ           Z4, Z4, Z4
+
+	// t += x·y[5], followed by a reduction round
+	VMOVDQU64        896(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z5
+	VPMADD52HUQ      0(SP), Z16, Z6
+	VPMADD52LUQ      64(SP), Z16, Z6
+	VPMADD52HUQ      64(SP), Z16, Z7
+	VPMADD52LUQ      128(SP), Z16, Z7
+	VPMADD52HUQ      128(SP), Z16, Z8
+	VPMADD52LUQ      192(SP), Z16, Z8
+	VPMADD52HUQ      192(SP), Z16, Z9
+	VPMADD52LUQ      256(SP), Z16, Z9
+	VPMADD52HUQ      256(SP), Z16, Z0
+	VPMADD52LUQ      320(SP), Z16, Z0
+	VPMADD52HUQ      320(SP), Z16, Z1
+	VPMADD52LUQ      384(SP), Z16, Z1
+	VPMADD52HUQ      384(SP), Z16, Z2
+	VPMADD52LUQ      448(SP), Z16, Z2
+	VPMADD52HUQ      448(SP), Z16, Z3
+	VPMADD52LUQ      512(SP), Z16, Z3
+	VPMADD52HUQ      512(SP), Z16, Z4
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z5, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z4
+	VPSRLQ           $52, Z5, Z18
+	VPADDQ           Z18, Z6, Z6
+	VPXORQ           Z5, Z5, Z5
+
+	// t += x·y[6], followed by a reduction round
+	VMOVDQU64        960(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z6
+	VPMADD52HUQ      0(SP), Z16, Z7
+	VPMADD52LUQ      64(SP), Z16, Z7
+	VPMADD52HUQ      64(SP), Z16, Z8
+	VPMADD52LUQ      128(SP), Z16, Z8
+	VPMADD52HUQ      128(SP), Z16, Z9
+	VPMADD52LUQ      192(SP), Z16, Z9
+	VPMADD52HUQ      192(SP), Z16, Z0
+	VPMADD52LUQ      256(SP), Z16, Z0
+	VPMADD52HUQ      256(SP), Z16, Z1
+	VPMADD52LUQ      320(SP), Z16, Z1
+	VPMADD52HUQ      320(SP), Z16, Z2
+	VPMADD52LUQ      384(SP), Z16, Z2
+	VPMADD52HUQ      384(SP), Z16, Z3
+	VPMADD52LUQ      448(SP), Z16, Z3
+	VPMADD52HUQ      448(SP), Z16, Z4
+	VPMADD52LUQ      512(SP), Z16, Z4
+	VPMADD52HUQ      512(SP), Z16, Z5
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z6, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z5
+	VPSRLQ           $52, Z6, Z18
+	VPADDQ           Z18, Z7, Z7
+	VPXORQ           Z6, Z6, Z6
+
+	// t += x·y[7], followed by a reduction round
+	VMOVDQU64        1024(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z7
+	VPMADD52HUQ      0(SP), Z16, Z8
+	VPMADD52LUQ      64(SP), Z16, Z8
+	VPMADD52HUQ      64(SP), Z16, Z9
+	VPMADD52LUQ      128(SP), Z16, Z9
+	VPMADD52HUQ      128(SP), Z16, Z0
+	VPMADD52LUQ      192(SP), Z16, Z0
+	VPMADD52HUQ      192(SP), Z16, Z1
+	VPMADD52LUQ      256(SP), Z16, Z1
+	VPMADD52HUQ      256(SP), Z16, Z2
+	VPMADD52LUQ      320(SP), Z16, Z2
+	VPMADD52HUQ      320(SP), Z16, Z3
+	VPMADD52LUQ      384(SP), Z16, Z3
+	VPMADD52HUQ      384(SP), Z16, Z4
+	VPMADD52LUQ      448(SP), Z16, Z4
+	VPMADD52HUQ      448(SP), Z16, Z5
+	VPMADD52LUQ      512(SP), Z16, Z5
+	VPMADD52HUQ      512(SP), Z16, Z6
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z7, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z6
+	VPSRLQ           $52, Z7, Z18
+	VPADDQ           Z18, Z8, Z8
+	VPXORQ           Z7, Z7, Z7
+
+	// t += x·y[8], followed by a reduction round
+	VMOVDQU64        1088(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z8
+	VPMADD52HUQ      0(SP), Z16, Z9
+	VPMADD52LUQ      64(SP), Z16, Z9
+	VPMADD52HUQ      64(SP), Z16, Z0
+	VPMADD52LUQ      128(SP), Z16, Z0
+	VPMADD52HUQ      128(SP), Z16, Z1
+	VPMADD52LUQ      192(SP), Z16, Z1
+	VPMADD52HUQ      192(SP), Z16, Z2
+	VPMADD52LUQ      256(SP), Z16, Z2
+	VPMADD52HUQ      256(SP), Z16, Z3
+	VPMADD52LUQ      320(SP), Z16, Z3
+	VPMADD52HUQ      320(SP), Z16, Z4
+	VPMADD52LUQ      384(SP), Z16, Z4
+	VPMADD52HUQ      384(SP), Z16, Z5
+	VPMADD52LUQ      448(SP), Z16, Z5
+	VPMADD52HUQ      448(SP), Z16, Z6
+	VPMADD52LUQ      512(SP), Z16, Z6
+	VPMADD52HUQ      512(SP), Z16, Z7
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z8, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z7
+	VPSRLQ           $52, Z8, Z18
+	VPADDQ           Z18, Z9, Z9
+	VPXORQ           Z8, Z8, Z8
+	VPSRLQ           $52, Z9, Z18
+	VPANDQ           Z31, Z9, Z9
+	VPADDQ           Z18, Z0, Z0
+	VPSRLQ           $52, Z0, Z18
+	VPANDQ           Z31, Z0, Z0
+	VPADDQ           Z18, Z1, Z1
+	VPSRLQ           $52, Z1, Z18
+	VPANDQ           Z31, Z1, Z1
+	VPADDQ           Z18, Z2, Z2
+	VPSRLQ           $52, Z2, Z18
+	VPANDQ           Z31, Z2, Z2
+	VPADDQ           Z18, Z3, Z3
+	VPSRLQ           $52, Z3, Z18
+	VPANDQ           Z31, Z3, Z3
+	VPADDQ           Z18, Z4, Z4
+	VPSRLQ           $52, Z4, Z18
+	VPANDQ           Z31, Z4, Z4
+	VPADDQ           Z18, Z5, Z5
+	VPSRLQ           $52, Z5, Z18
+	VPANDQ           Z31, Z5, Z5
+	VPADDQ           Z18, Z6, Z6
+	VPSRLQ           $52, Z6, Z18
+	VPANDQ           Z31, Z6, Z6
+	VPADDQ           Z18, Z7, Z7
+	VMOVDQU64        Z9, 0(SP)
+	VMOVDQU64        Z0, 64(SP)
+	VMOVDQU64        Z1, 128(SP)
+	VMOVDQU64        Z2, 192(SP)
+	VMOVDQU64        Z3, 256(SP)
+	VMOVDQU64        Z4, 320(SP)
+	VMOVDQU64        Z5, 384(SP)
+	VMOVDQU64        Z6, 448(SP)
+	VMOVDQU64        Z7, 512(SP)
+
+	// back to the Montgomery form of the elements
+	VPXORQ Z0, Z0, Z0
+	VPXORQ Z1, Z1, Z1
+	VPXORQ Z2, Z2, Z2
+	VPXORQ Z3, Z3, Z3
+	VPXORQ Z4, Z4, Z4
+	VPXORQ Z5, Z5, Z5
+	VPXORQ Z6, Z6, Z6
+	VPXORQ Z7, Z7, Z7
+	VPXORQ Z8, Z8, Z8
+	VPXORQ Z9, Z9, Z9
+
+	// t += x·y[0], followed by a reduction round
+	VPBROADCASTQ     c52<>+0(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z0
+	VPMADD52HUQ      0(SP), Z16, Z1
+	VPMADD52LUQ      64(SP), Z16, Z1
+	VPMADD52HUQ      64(SP), Z16, Z2
+	VPMADD52LUQ      128(SP), Z16, Z2
+	VPMADD52HUQ      128(SP), Z16, Z3
+	VPMADD52LUQ      192(SP), Z16, Z3
+	VPMADD52HUQ      192(SP), Z16, Z4
+	VPMADD52LUQ      256(SP), Z16, Z4
+	VPMADD52HUQ      256(SP), Z16, Z5
+	VPMADD52LUQ      320(SP), Z16, Z5
+	VPMADD52HUQ      320(SP), Z16, Z6
+	VPMADD52LUQ      384(SP), Z16, Z6
+	VPMADD52HUQ      384(SP), Z16, Z7
+	VPMADD52LUQ      448(SP), Z16, Z7
+	VPMADD52HUQ      448(SP), Z16, Z8
+	VPMADD52LUQ      512(SP), Z16, Z8
+	VPMADD52HUQ      512(SP), Z16, Z9
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z0, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z9
+	VPSRLQ           $52, Z0, Z18
+	VPADDQ           Z18, Z1, Z1
+	VPXORQ           Z0, Z0, Z0
+
+	// t += x·y[1], followed by a reduction round
+	VPBROADCASTQ     c52<>+8(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z1
+	VPMADD52HUQ      0(SP), Z16, Z2
+	VPMADD52LUQ      64(SP), Z16, Z2
+	VPMADD52HUQ      64(SP), Z16, Z3
+	VPMADD52LUQ      128(SP), Z16, Z3
+	VPMADD52HUQ      128(SP), Z16, Z4
+	VPMADD52LUQ      192(SP), Z16, Z4
+	VPMADD52HUQ      192(SP), Z16, Z5
+	VPMADD52LUQ      256(SP), Z16, Z5
+	VPMADD52HUQ      256(SP), Z16, Z6
+	VPMADD52LUQ      320(SP), Z16, Z6
+	VPMADD52HUQ      320(SP), Z16, Z7
+	VPMADD52LUQ      384(SP), Z16, Z7
+	VPMADD52HUQ      384(SP), Z16, Z8
+	VPMADD52LUQ      448(SP), Z16, Z8
+	VPMADD52HUQ      448(SP), Z16, Z9
+	VPMADD52LUQ      512(SP), Z16, Z9
+	VPMADD52HUQ      512(SP), Z16, Z0
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z1, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z0
+	VPSRLQ           $52, Z1, Z18
+	VPADDQ           Z18, Z2, Z2
+	VPXORQ           Z1, Z1, Z1
+
+	// t += x·y[2], followed by a reduction round
+	VPBROADCASTQ     c52<>+16(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z2
+	VPMADD52HUQ      0(SP), Z16, Z3
+	VPMADD52LUQ      64(SP), Z16, Z3
+	VPMADD52HUQ      64(SP), Z16, Z4
+	VPMADD52LUQ      128(SP), Z16, Z4
+	VPMADD52HUQ      128(SP), Z16, Z5
+	VPMADD52LUQ      192(SP), Z16, Z5
+	VPMADD52HUQ      192(SP), Z16, Z6
+	VPMADD52LUQ      256(SP), Z16, Z6
+	VPMADD52HUQ      256(SP), Z16, Z7
+	VPMADD52LUQ      320(SP), Z16, Z7
+	VPMADD52HUQ      320(SP), Z16, Z8
+	VPMADD52LUQ      384(SP), Z16, Z8
+	VPMADD52HUQ      384(SP), Z16, Z9
+	VPMADD52LUQ      448(SP), Z16, Z9
+	VPMADD52HUQ      448(SP), Z16, Z0
+	VPMADD52LUQ      512(SP), Z16, Z0
+	VPMADD52HUQ      512(SP), Z16, Z1
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z2, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z1
+	VPSRLQ           $52, Z2, Z18
+	VPADDQ           Z18, Z3, Z3
+	VPXORQ           Z2, Z2, Z2
+
+	// t += x·y[3], followed by a reduction round
+	VPBROADCASTQ     c52<>+24(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z3
+	VPMADD52HUQ      0(SP), Z16, Z4
+	VPMADD52LUQ      64(SP), Z16, Z4
+	VPMADD52HUQ      64(SP), Z16, Z5
+	VPMADD52LUQ      128(SP), Z16, Z5
+	VPMADD52HUQ      128(SP), Z16, Z6
+	VPMADD52LUQ      192(SP), Z16, Z6
+	VPMADD52HUQ      192(SP), Z16, Z7
+	VPMADD52LUQ      256(SP), Z16, Z7
+	VPMADD52HUQ      256(SP), Z16, Z8
+	VPMADD52LUQ      320(SP), Z16, Z8
+	VPMADD52HUQ      320(SP), Z16, Z9
+	VPMADD52LUQ      384(SP), Z16, Z9
+	VPMADD52HUQ      384(SP), Z16, Z0
+	VPMADD52LUQ      448(SP), Z16, Z0
+	VPMADD52HUQ      448(SP), Z16, Z1
+	VPMADD52LUQ      512(SP), Z16, Z1
+	VPMADD52HUQ      512(SP), Z16, Z2
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z3, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z2
+	VPSRLQ           $52, Z3, Z18
+	VPADDQ           Z18, Z4, Z4
+	VPXORQ           Z3, Z3, Z3
+
+	// t += x·y[4], followed by a reduction round
+	VPBROADCASTQ     c52<>+32(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z4
+	VPMADD52HUQ      0(SP), Z16, Z5
+	VPMADD52LUQ      64(SP), Z16, Z5
+	VPMADD52HUQ      64(SP), Z16, Z6
+	VPMADD52LUQ      128(SP), Z16, Z6
+	VPMADD52HUQ      128(SP), Z16, Z7
+	VPMADD52LUQ      192(SP), Z16, Z7
+	VPMADD52HUQ      192(SP), Z16, Z8
+	VPMADD52LUQ      256(SP), Z16, Z8
+	VPMADD52HUQ      256(SP), Z16, Z9
+	VPMADD52LUQ      320(SP), Z16, Z9
+	VPMADD52HUQ      320(SP), Z16, Z0
+	VPMADD52LUQ      384(SP), Z16, Z0
+	VPMADD52HUQ      384(SP), Z16, Z1
+	VPMADD52LUQ      448(SP), Z16, Z1
+	VPMADD52HUQ      448(SP), Z16, Z2
+	VPMADD52LUQ      512(SP), Z16, Z2
+	VPMADD52HUQ      512(SP), Z16, Z3
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z4, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z3
+	VPSRLQ           $52, Z4, Z18
+	VPADDQ           Z18, Z5, Z5
+	VPXORQ           Z4, Z4, Z4
+
+	// t += x·y[5], followed by a reduction round
+	VPBROADCASTQ     c52<>+40(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z5
+	VPMADD52HUQ      0(SP), Z16, Z6
+	VPMADD52LUQ      64(SP), Z16, Z6
+	VPMADD52HUQ      64(SP), Z16, Z7
+	VPMADD52LUQ      128(SP), Z16, Z7
+	VPMADD52HUQ      128(SP), Z16, Z8
+	VPMADD52LUQ      192(SP), Z16, Z8
+	VPMADD52HUQ      192(SP), Z16, Z9
+	VPMADD52LUQ      256(SP), Z16, Z9
+	VPMADD52HUQ      256(SP), Z16, Z0
+	VPMADD52LUQ      320(SP), Z16, Z0
+	VPMADD52HUQ      320(SP), Z16, Z1
+	VPMADD52LUQ      384(SP), Z16, Z1
+	VPMADD52HUQ      384(SP), Z16, Z2
+	VPMADD52LUQ      448(SP), Z16, Z2
+	VPMADD52HUQ      448(SP), Z16, Z3
+	VPMADD52LUQ      512(SP), Z16, Z3
+	VPMADD52HUQ      512(SP), Z16, Z4
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z5, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z4
+	VPSRLQ           $52, Z5, Z18
+	VPADDQ           Z18, Z6, Z6
+	VPXORQ           Z5, Z5, Z5
+
+	// t += x·y[6], followed by a reduction round
+	VPBROADCASTQ     c52<>+48(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z6
+	VPMADD52HUQ      0(SP), Z16, Z7
+	VPMADD52LUQ      64(SP), Z16, Z7
+	VPMADD52HUQ      64(SP), Z16, Z8
+	VPMADD52LUQ      128(SP), Z16, Z8
+	VPMADD52HUQ      128(SP), Z16, Z9
+	VPMADD52LUQ      192(SP), Z16, Z9
+	VPMADD52HUQ      192(SP), Z16, Z0
+	VPMADD52LUQ      256(SP), Z16, Z0
+	VPMADD52HUQ      256(SP), Z16, Z1
+	VPMADD52LUQ      320(SP), Z16, Z1
+	VPMADD52HUQ      320(SP), Z16, Z2
+	VPMADD52LUQ      384(SP), Z16, Z2
+	VPMADD52HUQ      384(SP), Z16, Z3
+	VPMADD52LUQ      448(SP), Z16, Z3
+	VPMADD52HUQ      448(SP), Z16, Z4
+	VPMADD52LUQ      512(SP), Z16, Z4
+	VPMADD52HUQ      512(SP), Z16, Z5
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z6, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z5
+	VPSRLQ           $52, Z6, Z18
+	VPADDQ           Z18, Z7, Z7
+	VPXORQ           Z6, Z6, Z6
+
+	// t += x·y[7], followed by a reduction round
+	VPBROADCASTQ     c52<>+56(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z7
+	VPMADD52HUQ      0(SP), Z16, Z8
+	VPMADD52LUQ      64(SP), Z16, Z8
+	VPMADD52HUQ      64(SP), Z16, Z9
+	VPMADD52LUQ      128(SP), Z16, Z9
+	VPMADD52HUQ      128(SP), Z16, Z0
+	VPMADD52LUQ      192(SP), Z16, Z0
+	VPMADD52HUQ      192(SP), Z16, Z1
+	VPMADD52LUQ      256(SP), Z16, Z1
+	VPMADD52HUQ      256(SP), Z16, Z2
+	VPMADD52LUQ      320(SP), Z16, Z2
+	VPMADD52HUQ      320(SP), Z16, Z3
+	VPMADD52LUQ      384(SP), Z16, Z3
+	VPMADD52HUQ      384(SP), Z16, Z4
+	VPMADD52LUQ      448(SP), Z16, Z4
+	VPMADD52HUQ      448(SP), Z16, Z5
+	VPMADD52LUQ      512(SP), Z16, Z5
+	VPMADD52HUQ      512(SP), Z16, Z6
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z7, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z6
+	VPSRLQ           $52, Z7, Z18
+	VPADDQ           Z18, Z8, Z8
+	VPXORQ           Z7, Z7, Z7
+
+	// t += x·y[8], followed by a reduction round
+	VPBROADCASTQ     c52<>+64(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z8
+	VPMADD52HUQ      0(SP), Z16, Z9
+	VPMADD52LUQ      64(SP), Z16, Z9
+	VPMADD52HUQ      64(SP), Z16, Z0
+	VPMADD52LUQ      128(SP), Z16, Z0
+	VPMADD52HUQ      128(SP), Z16, Z1
+	VPMADD52LUQ      192(SP), Z16, Z1
+	VPMADD52HUQ      192(SP), Z16, Z2
+	VPMADD52LUQ      256(SP), Z16, Z2
+	VPMADD52HUQ      256(SP), Z16, Z3
+	VPMADD52LUQ      320(SP), Z16, Z3
+	VPMADD52HUQ      320(SP), Z16, Z4
+	VPMADD52LUQ      384(SP), Z16, Z4
+	VPMADD52HUQ      384(SP), Z16, Z5
+	VPMADD52LUQ      448(SP), Z16, Z5
+	VPMADD52HUQ      448(SP), Z16, Z6
+	VPMADD52LUQ      512(SP), Z16, Z6
+	VPMADD52HUQ      512(SP), Z16, Z7
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z8, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z7
+	VPSRLQ           $52, Z8, Z18
+	VPADDQ           Z18, Z9, Z9
+	VPXORQ           Z8, Z8, Z8
+	VPSRLQ           $52, Z9, Z18
+	VPANDQ           Z31, Z9, Z9
+	VPADDQ           Z18, Z0, Z0
+	VPSRLQ           $52, Z0, Z18
+	VPANDQ           Z31, Z0, Z0
+	VPADDQ           Z18, Z1, Z1
+	VPSRLQ           $52, Z1, Z18
+	VPANDQ           Z31, Z1, Z1
+	VPADDQ           Z18, Z2, Z2
+	VPSRLQ           $52, Z2, Z18
+	VPANDQ           Z31, Z2, Z2
+	VPADDQ           Z18, Z3, Z3
+	VPSRLQ           $52, Z3, Z18
+	VPANDQ           Z31, Z3, Z3
+	VPADDQ           Z18, Z4, Z4
+	VPSRLQ           $52, Z4, Z18
+	VPANDQ           Z31, Z4, Z4
+	VPADDQ           Z18, Z5, Z5
+	VPSRLQ           $52, Z5, Z18
+	VPANDQ           Z31, Z5, Z5
+	VPADDQ           Z18, Z6, Z6
+	VPSRLQ           $52, Z6, Z18
+	VPANDQ           Z31, Z6, Z6
+	VPADDQ           Z18, Z7, Z7
+
+	// t = t - q if t >= q
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z9, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+8(SB), Z0, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+16(SB), Z1, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+24(SB), Z2, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+32(SB), Z3, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+40(SB), Z4, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+48(SB), Z5, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+56(SB), Z6, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+64(SB), Z7, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPTESTNMQ   Z19, Z19, K2
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z9, K2, Z9
+	VPSUBQ      Z19, Z9, K2, Z9
+	VPSRLQ      $63, Z9, Z19
+	VPANDQ      Z31, Z9, K2, Z9
+	VPSUBQ.BCST q52<>+8(SB), Z0, K2, Z0
+	VPSUBQ      Z19, Z0, K2, Z0
+	VPSRLQ      $63, Z0, Z19
+	VPANDQ      Z31, Z0, K2, Z0
+	VPSUBQ.BCST q52<>+16(SB), Z1, K2, Z1
+	VPSUBQ      Z19, Z1, K2, Z1
+	VPSRLQ      $63, Z1, Z19
+	VPANDQ      Z31, Z1, K2, Z1
+	VPSUBQ.BCST q52<>+24(SB), Z2, K2, Z2
+	VPSUBQ      Z19, Z2, K2, Z2
+	VPSRLQ      $63, Z2, Z19
+	VPANDQ      Z31, Z2, K2, Z2
+	VPSUBQ.BCST q52<>+32(SB), Z3, K2, Z3
+	VPSUBQ      Z19, Z3, K2, Z3
+	VPSRLQ      $63, Z3, Z19
+	VPANDQ      Z31, Z3, K2, Z3
+	VPSUBQ.BCST q52<>+40(SB), Z4, K2, Z4
+	VPSUBQ      Z19, Z4, K2, Z4
+	VPSRLQ      $63, Z4, Z19
+	VPANDQ      Z31, Z4, K2, Z4
+	VPSUBQ.BCST q52<>+48(SB), Z5, K2, Z5
+	VPSUBQ      Z19, Z5, K2, Z5
+	VPSRLQ      $63, Z5, Z19
+	VPANDQ      Z31, Z5, K2, Z5
+	VPSUBQ.BCST q52<>+56(SB), Z6, K2, Z6
+	VPSUBQ      Z19, Z6, K2, Z6
+	VPSRLQ      $63, Z6, Z19
+	VPANDQ      Z31, Z6, K2, Z6
+	VPSUBQ.BCST q52<>+64(SB), Z7, K2, Z7
+	VPSUBQ      Z19, Z7, K2, Z7
+	VPSRLQ      $63, Z7, Z19
+	VPANDQ      Z31, Z7, K2, Z7
+	VPSLLQ      $0, Z9, Z20
+	VPSLLQ      $52, Z0, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 0(CX)(Z30*1)
+	VPSRLQ      $12, Z0, Z20
+	VPSLLQ      $40, Z1, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 8(CX)(Z30*1)
+	VPSRLQ      $24, Z1, Z20
+	VPSLLQ      $28, Z2, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 16(CX)(Z30*1)
+	VPSRLQ      $36, Z2, Z20
+	VPSLLQ      $16, Z3, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 24(CX)(Z30*1)
+	VPSRLQ      $48, Z3, Z20
+	VPSLLQ      $4, Z4, Z21
+	VPORQ       Z21, Z20, Z20
+	VPSLLQ      $56, Z5, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 32(CX)(Z30*1)
+	VPSRLQ      $8, Z5, Z20
+	VPSLLQ      $44, Z6, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 40(CX)(Z30*1)
+	VPSRLQ      $20, Z6, Z20
+	VPSLLQ      $32, Z7, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 48(CX)(Z30*1)
+	VPSRLQ      $32, Z7, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 56(CX)(Z30*1)
+	ADDQ        $512, CX
+	ADDQ        $512, AX
+	ADDQ        $512, DX
+	DECQ        BX
+	JMP         l1
+
+l2:
+	VZEROUPPER
+	RET
+
+// scalarMulVecIFMA(res, a, b *Element, n uint64) res[i] = a[i]·b, for 8·n elements
+TEXT ·scalarMulVecIFMA(SB), $1152-32
+	MOVQ         $0xfffffffffffff, R8
+	VPBROADCASTQ R8, Z31
+	VMOVDQU64    offsets<>(SB), Z30
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+
+	// b·c·2⁻⁵²ᴸ = b·2⁵²ᴸ⁻⁶⁴ᴺ, such that a[i]·b·2⁻⁶⁴ᴺ is a single multiplication
+	VPBROADCASTQ 0(DX), Z20
+	VPSRLQ       $0, Z20, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 0(SP)
+	VPSRLQ       $52, Z20, Z22
+	VPBROADCASTQ 8(DX), Z21
+	VPSLLQ       $12, Z21, Z23
+	VPORQ        Z23, Z22, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 64(SP)
+	VPSRLQ       $40, Z21, Z22
+	VPBROADCASTQ 16(DX), Z20
+	VPSLLQ       $24, Z20, Z23
+	VPORQ        Z23, Z22, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 128(SP)
+	VPSRLQ       $28, Z20, Z22
+	VPBROADCASTQ 24(DX), Z21
+	VPSLLQ       $36, Z21, Z23
+	VPORQ        Z23, Z22, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 192(SP)
+	VPSRLQ       $16, Z21, Z22
+	VPBROADCASTQ 32(DX), Z20
+	VPSLLQ       $48, Z20, Z23
+	VPORQ        Z23, Z22, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 256(SP)
+	VPSRLQ       $4, Z20, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 320(SP)
+	VPSRLQ       $56, Z20, Z22
+	VPBROADCASTQ 40(DX), Z21
+	VPSLLQ       $8, Z21, Z23
+	VPORQ        Z23, Z22, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 384(SP)
+	VPSRLQ       $44, Z21, Z22
+	VPBROADCASTQ 48(DX), Z20
+	VPSLLQ       $20, Z20, Z23
+	VPORQ        Z23, Z22, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 448(SP)
+	VPSRLQ       $32, Z20, Z22
+	VPBROADCASTQ 56(DX), Z21
+	VPSLLQ       $32, Z21, Z23
+	VPORQ        Z23, Z22, Z22
+	VPANDQ       Z31, Z22, Z22
+	VMOVDQU64    Z22, 512(SP)
+	VPXORQ       Z0, Z0, Z0
+	VPXORQ       Z1, Z1, Z1
+	VPXORQ       Z2, Z2, Z2
+	VPXORQ       Z3, Z3, Z3
+	VPXORQ       Z4, Z4, Z4
+	VPXORQ       Z5, Z5, Z5
+	VPXORQ       Z6, Z6, Z6
+	VPXORQ       Z7, Z7, Z7
+	VPXORQ       Z8, Z8, Z8
+	VPXORQ       Z9, Z9, Z9
+
+	// t += x·y[0], followed by a reduction round
+	VPBROADCASTQ     c52<>+0(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z0
+	VPMADD52HUQ      0(SP), Z16, Z1
+	VPMADD52LUQ      64(SP), Z16, Z1
+	VPMADD52HUQ      64(SP), Z16, Z2
+	VPMADD52LUQ      128(SP), Z16, Z2
+	VPMADD52HUQ      128(SP), Z16, Z3
+	VPMADD52LUQ      192(SP), Z16, Z3
+	VPMADD52HUQ      192(SP), Z16, Z4
+	VPMADD52LUQ      256(SP), Z16, Z4
+	VPMADD52HUQ      256(SP), Z16, Z5
+	VPMADD52LUQ      320(SP), Z16, Z5
+	VPMADD52HUQ      320(SP), Z16, Z6
+	VPMADD52LUQ      384(SP), Z16, Z6
+	VPMADD52HUQ      384(SP), Z16, Z7
+	VPMADD52LUQ      448(SP), Z16, Z7
+	VPMADD52HUQ      448(SP), Z16, Z8
+	VPMADD52LUQ      512(SP), Z16, Z8
+	VPMADD52HUQ      512(SP), Z16, Z9
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z0, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z9
+	VPSRLQ           $52, Z0, Z18
+	VPADDQ           Z18, Z1, Z1
+	VPXORQ           Z0, Z0, Z0
+
+	// t += x·y[1], followed by a reduction round
+	VPBROADCASTQ     c52<>+8(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z1
+	VPMADD52HUQ      0(SP), Z16, Z2
+	VPMADD52LUQ      64(SP), Z16, Z2
+	VPMADD52HUQ      64(SP), Z16, Z3
+	VPMADD52LUQ      128(SP), Z16, Z3
+	VPMADD52HUQ      128(SP), Z16, Z4
+	VPMADD52LUQ      192(SP), Z16, Z4
+	VPMADD52HUQ      192(SP), Z16, Z5
+	VPMADD52LUQ      256(SP), Z16, Z5
+	VPMADD52HUQ      256(SP), Z16, Z6
+	VPMADD52LUQ      320(SP), Z16, Z6
+	VPMADD52HUQ      320(SP), Z16, Z7
+	VPMADD52LUQ      384(SP), Z16, Z7
+	VPMADD52HUQ      384(SP), Z16, Z8
+	VPMADD52LUQ      448(SP), Z16, Z8
+	VPMADD52HUQ      448(SP), Z16, Z9
+	VPMADD52LUQ      512(SP), Z16, Z9
+	VPMADD52HUQ      512(SP), Z16, Z0
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z1, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z0
+	VPSRLQ           $52, Z1, Z18
+	VPADDQ           Z18, Z2, Z2
+	VPXORQ           Z1, Z1, Z1
+
+	// t += x·y[2], followed by a reduction round
+	VPBROADCASTQ     c52<>+16(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z2
+	VPMADD52HUQ      0(SP), Z16, Z3
+	VPMADD52LUQ      64(SP), Z16, Z3
+	VPMADD52HUQ      64(SP), Z16, Z4
+	VPMADD52LUQ      128(SP), Z16, Z4
+	VPMADD52HUQ      128(SP), Z16, Z5
+	VPMADD52LUQ      192(SP), Z16, Z5
+	VPMADD52HUQ      192(SP), Z16, Z6
+	VPMADD52LUQ      256(SP), Z16, Z6
+	VPMADD52HUQ      256(SP), Z16, Z7
+	VPMADD52LUQ      320(SP), Z16, Z7
+	VPMADD52HUQ      320(SP), Z16, Z8
+	VPMADD52LUQ      384(SP), Z16, Z8
+	VPMADD52HUQ      384(SP), Z16, Z9
+	VPMADD52LUQ      448(SP), Z16, Z9
+	VPMADD52HUQ      448(SP), Z16, Z0
+	VPMADD52LUQ      512(SP), Z16, Z0
+	VPMADD52HUQ      512(SP), Z16, Z1
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z2, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z1
+	VPSRLQ           $52, Z2, Z18
+	VPADDQ           Z18, Z3, Z3
+	VPXORQ           Z2, Z2, Z2
+
+	// t += x·y[3], followed by a reduction round
+	VPBROADCASTQ     c52<>+24(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z3
+	VPMADD52HUQ      0(SP), Z16, Z4
+	VPMADD52LUQ      64(SP), Z16, Z4
+	VPMADD52HUQ      64(SP), Z16, Z5
+	VPMADD52LUQ      128(SP), Z16, Z5
+	VPMADD52HUQ      128(SP), Z16, Z6
+	VPMADD52LUQ      192(SP), Z16, Z6
+	VPMADD52HUQ      192(SP), Z16, Z7
+	VPMADD52LUQ      256(SP), Z16, Z7
+	VPMADD52HUQ      256(SP), Z16, Z8
+	VPMADD52LUQ      320(SP), Z16, Z8
+	VPMADD52HUQ      320(SP), Z16, Z9
+	VPMADD52LUQ      384(SP), Z16, Z9
+	VPMADD52HUQ      384(SP), Z16, Z0
+	VPMADD52LUQ      448(SP), Z16, Z0
+	VPMADD52HUQ      448(SP), Z16, Z1
+	VPMADD52LUQ      512(SP), Z16, Z1
+	VPMADD52HUQ      512(SP), Z16, Z2
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z3, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z2
+	VPSRLQ           $52, Z3, Z18
+	VPADDQ           Z18, Z4, Z4
+	VPXORQ           Z3, Z3, Z3
+
+	// t += x·y[4], followed by a reduction round
+	VPBROADCASTQ     c52<>+32(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z4
+	VPMADD52HUQ      0(SP), Z16, Z5
+	VPMADD52LUQ      64(SP), Z16, Z5
+	VPMADD52HUQ      64(SP), Z16, Z6
+	VPMADD52LUQ      128(SP), Z16, Z6
+	VPMADD52HUQ      128(SP), Z16, Z7
+	VPMADD52LUQ      192(SP), Z16, Z7
+	VPMADD52HUQ      192(SP), Z16, Z8
+	VPMADD52LUQ      256(SP), Z16, Z8
+	VPMADD52HUQ      256(SP), Z16, Z9
+	VPMADD52LUQ      320(SP), Z16, Z9
+	VPMADD52HUQ      320(SP), Z16, Z0
+	VPMADD52LUQ      384(SP), Z16, Z0
+	VPMADD52HUQ      384(SP), Z16, Z1
+	VPMADD52LUQ      448(SP), Z16, Z1
+	VPMADD52HUQ      448(SP), Z16, Z2
+	VPMADD52LUQ      512(SP), Z16, Z2
+	VPMADD52HUQ      512(SP), Z16, Z3
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z4, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z3
+	VPSRLQ           $52, Z4, Z18
+	VPADDQ           Z18, Z5, Z5
+	VPXORQ           Z4, Z4, Z4
+
+	// t += x·y[5], followed by a reduction round
+	VPBROADCASTQ     c52<>+40(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z5
+	VPMADD52HUQ      0(SP), Z16, Z6
+	VPMADD52LUQ      64(SP), Z16, Z6
+	VPMADD52HUQ      64(SP), Z16, Z7
+	VPMADD52LUQ      128(SP), Z16, Z7
+	VPMADD52HUQ      128(SP), Z16, Z8
+	VPMADD52LUQ      192(SP), Z16, Z8
+	VPMADD52HUQ      192(SP), Z16, Z9
+	VPMADD52LUQ      256(SP), Z16, Z9
+	VPMADD52HUQ      256(SP), Z16, Z0
+	VPMADD52LUQ      320(SP), Z16, Z0
+	VPMADD52HUQ      320(SP), Z16, Z1
+	VPMADD52LUQ      384(SP), Z16, Z1
+	VPMADD52HUQ      384(SP), Z16, Z2
+	VPMADD52LUQ      448(SP), Z16, Z2
+	VPMADD52HUQ      448(SP), Z16, Z3
+	VPMADD52LUQ      512(SP), Z16, Z3
+	VPMADD52HUQ      512(SP), Z16, Z4
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z5, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z4
+	VPSRLQ           $52, Z5, Z18
+	VPADDQ           Z18, Z6, Z6
+	VPXORQ           Z5, Z5, Z5
+
+	// t += x·y[6], followed by a reduction round
+	VPBROADCASTQ     c52<>+48(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z6
+	VPMADD52HUQ      0(SP), Z16, Z7
+	VPMADD52LUQ      64(SP), Z16, Z7
+	VPMADD52HUQ      64(SP), Z16, Z8
+	VPMADD52LUQ      128(SP), Z16, Z8
+	VPMADD52HUQ      128(SP), Z16, Z9
+	VPMADD52LUQ      192(SP), Z16, Z9
+	VPMADD52HUQ      192(SP), Z16, Z0
+	VPMADD52LUQ      256(SP), Z16, Z0
+	VPMADD52HUQ      256(SP), Z16, Z1
+	VPMADD52LUQ      320(SP), Z16, Z1
+	VPMADD52HUQ      320(SP), Z16, Z2
+	VPMADD52LUQ      384(SP), Z16, Z2
+	VPMADD52HUQ      384(SP), Z16, Z3
+	VPMADD52LUQ      448(SP), Z16, Z3
+	VPMADD52HUQ      448(SP), Z16, Z4
+	VPMADD52LUQ      512(SP), Z16, Z4
+	VPMADD52HUQ      512(SP), Z16, Z5
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z6, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z5
+	VPSRLQ           $52, Z6, Z18
+	VPADDQ           Z18, Z7, Z7
+	VPXORQ           Z6, Z6, Z6
+
+	// t += x·y[7], followed by a reduction round
+	VPBROADCASTQ     c52<>+56(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z7
+	VPMADD52HUQ      0(SP), Z16, Z8
+	VPMADD52LUQ      64(SP), Z16, Z8
+	VPMADD52HUQ      64(SP), Z16, Z9
+	VPMADD52LUQ      128(SP), Z16, Z9
+	VPMADD52HUQ      128(SP), Z16, Z0
+	VPMADD52LUQ      192(SP), Z16, Z0
+	VPMADD52HUQ      192(SP), Z16, Z1
+	VPMADD52LUQ      256(SP), Z16, Z1
+	VPMADD52HUQ      256(SP), Z16, Z2
+	VPMADD52LUQ      320(SP), Z16, Z2
+	VPMADD52HUQ      320(SP), Z16, Z3
+	VPMADD52LUQ      384(SP), Z16, Z3
+	VPMADD52HUQ      384(SP), Z16, Z4
+	VPMADD52LUQ      448(SP), Z16, Z4
+	VPMADD52HUQ      448(SP), Z16, Z5
+	VPMADD52LUQ      512(SP), Z16, Z5
+	VPMADD52HUQ      512(SP), Z16, Z6
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z7, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z6
+	VPSRLQ           $52, Z7, Z18
+	VPADDQ           Z18, Z8, Z8
+	VPXORQ           Z7, Z7, Z7
+
+	// t += x·y[8], followed by a reduction round
+	VPBROADCASTQ     c52<>+64(SB), Z16
+	VPMADD52LUQ      0(SP), Z16, Z8
+	VPMADD52HUQ      0(SP), Z16, Z9
+	VPMADD52LUQ      64(SP), Z16, Z9
+	VPMADD52HUQ      64(SP), Z16, Z0
+	VPMADD52LUQ      128(SP), Z16, Z0
+	VPMADD52HUQ      128(SP), Z16, Z1
+	VPMADD52LUQ      192(SP), Z16, Z1
+	VPMADD52HUQ      192(SP), Z16, Z2
+	VPMADD52LUQ      256(SP), Z16, Z2
+	VPMADD52HUQ      256(SP), Z16, Z3
+	VPMADD52LUQ      320(SP), Z16, Z3
+	VPMADD52HUQ      320(SP), Z16, Z4
+	VPMADD52LUQ      384(SP), Z16, Z4
+	VPMADD52HUQ      384(SP), Z16, Z5
+	VPMADD52LUQ      448(SP), Z16, Z5
+	VPMADD52HUQ      448(SP), Z16, Z6
+	VPMADD52LUQ      512(SP), Z16, Z6
+	VPMADD52HUQ      512(SP), Z16, Z7
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z8, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z7
+	VPSRLQ           $52, Z8, Z18
+	VPADDQ           Z18, Z9, Z9
+	VPXORQ           Z8, Z8, Z8
+	VPSRLQ           $52, Z9, Z18
+	VPANDQ           Z31, Z9, Z9
+	VPADDQ           Z18, Z0, Z0
+	VPSRLQ           $52, Z0, Z18
+	VPANDQ           Z31, Z0, Z0
+	VPADDQ           Z18, Z1, Z1
+	VPSRLQ           $52, Z1, Z18
+	VPANDQ           Z31, Z1, Z1
+	VPADDQ           Z18, Z2, Z2
+	VPSRLQ           $52, Z2, Z18
+	VPANDQ           Z31, Z2, Z2
+	VPADDQ           Z18, Z3, Z3
+	VPSRLQ           $52, Z3, Z18
+	VPANDQ           Z31, Z3, Z3
+	VPADDQ           Z18, Z4, Z4
+	VPSRLQ           $52, Z4, Z18
+	VPANDQ           Z31, Z4, Z4
+	VPADDQ           Z18, Z5, Z5
+	VPSRLQ           $52, Z5, Z18
+	VPANDQ           Z31, Z5, Z5
+	VPADDQ           Z18, Z6, Z6
+	VPSRLQ           $52, Z6, Z18
+	VPANDQ           Z31, Z6, Z6
+	VPADDQ           Z18, Z7, Z7
+	VMOVDQU64        Z9, 576(SP)
+	VMOVDQU64        Z0, 640(SP)
+	VMOVDQU64        Z1, 704(SP)
+	VMOVDQU64        Z2, 768(SP)
+	VMOVDQU64        Z3, 832(SP)
+	VMOVDQU64        Z4, 896(SP)
+	VMOVDQU64        Z5, 960(SP)
+	VMOVDQU64        Z6, 1024(SP)
+	VMOVDQU64        Z7, 1088(SP)
+
+l3:
+	TESTQ      BX, BX
+	JEQ        l4
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 0(AX)(Z30*1), K1, Z20
+	VPSRLQ     $0, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 0(SP)
+	VPSRLQ     $52, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 8(AX)(Z30*1), K1, Z21
+	VPSLLQ     $12, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 64(SP)
+	VPSRLQ     $40, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 16(AX)(Z30*1), K1, Z20
+	VPSLLQ     $24, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 128(SP)
+	VPSRLQ     $28, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 24(AX)(Z30*1), K1, Z21
+	VPSLLQ     $36, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 192(SP)
+	VPSRLQ     $16, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 32(AX)(Z30*1), K1, Z20
+	VPSLLQ     $48, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 256(SP)
+	VPSRLQ     $4, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 320(SP)
+	VPSRLQ     $56, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 40(AX)(Z30*1), K1, Z21
+	VPSLLQ     $8, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 384(SP)
+	VPSRLQ     $44, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 48(AX)(Z30*1), K1, Z20
+	VPSLLQ     $20, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 448(SP)
+	VPSRLQ     $32, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 56(AX)(Z30*1), K1, Z21
+	VPSLLQ     $32, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 512(SP)
+	VPXORQ     Z0, Z0, Z0
+	VPXORQ     Z1, Z1, Z1
+	VPXORQ     Z2, Z2, Z2
+	VPXORQ     Z3, Z3, Z3
+	VPXORQ     Z4, Z4, Z4
+	VPXORQ     Z5, Z5, Z5
+	VPXORQ     Z6, Z6, Z6
+	VPXORQ     Z7, Z7, Z7
+	VPXORQ     Z8, Z8, Z8
+	VPXORQ     Z9, Z9, Z9
+
+	// t += x·y[0], followed by a reduction round
+	VMOVDQU64        576(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z0
+	VPMADD52HUQ      0(SP), Z16, Z1
+	VPMADD52LUQ      64(SP), Z16, Z1
+	VPMADD52HUQ      64(SP), Z16, Z2
+	VPMADD52LUQ      128(SP), Z16, Z2
+	VPMADD52HUQ      128(SP), Z16, Z3
+	VPMADD52LUQ      192(SP), Z16, Z3
+	VPMADD52HUQ      192(SP), Z16, Z4
+	VPMADD52LUQ      256(SP), Z16, Z4
+	VPMADD52HUQ      256(SP), Z16, Z5
+	VPMADD52LUQ      320(SP), Z16, Z5
+	VPMADD52HUQ      320(SP), Z16, Z6
+	VPMADD52LUQ      384(SP), Z16, Z6
+	VPMADD52HUQ      384(SP), Z16, Z7
+	VPMADD52LUQ      448(SP), Z16, Z7
+	VPMADD52HUQ      448(SP), Z16, Z8
+	VPMADD52LUQ      512(SP), Z16, Z8
+	VPMADD52HUQ      512(SP), Z16, Z9
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z0, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z9
+	VPSRLQ           $52, Z0, Z18
+	VPADDQ           Z18, Z1, Z1
+	VPXORQ           Z0, Z0, Z0
+
+	// t += x·y[1], followed by a reduction round
+	VMOVDQU64        640(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z1
+	VPMADD52HUQ      0(SP), Z16, Z2
+	VPMADD52LUQ      64(SP), Z16, Z2
+	VPMADD52HUQ      64(SP), Z16, Z3
+	VPMADD52LUQ      128(SP), Z16, Z3
+	VPMADD52HUQ      128(SP), Z16, Z4
+	VPMADD52LUQ      192(SP), Z16, Z4
+	VPMADD52HUQ      192(SP), Z16, Z5
+	VPMADD52LUQ      256(SP), Z16, Z5
+	VPMADD52HUQ      256(SP), Z16, Z6
+	VPMADD52LUQ      320(SP), Z16, Z6
+	VPMADD52HUQ      320(SP), Z16, Z7
+	VPMADD52LUQ      384(SP), Z16, Z7
+	VPMADD52HUQ      384(SP), Z16, Z8
+	VPMADD52LUQ      448(SP), Z16, Z8
+	VPMADD52HUQ      448(SP), Z16, Z9
+	VPMADD52LUQ      512(SP), Z16, Z9
+	VPMADD52HUQ      512(SP), Z16, Z0
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z1, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z0
+	VPSRLQ           $52, Z1, Z18
+	VPADDQ           Z18, Z2, Z2
+	VPXORQ           Z1, Z1, Z1
+
+	// t += x·y[2], followed by a reduction round
+	VMOVDQU64        704(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z2
+	VPMADD52HUQ      0(SP), Z16, Z3
+	VPMADD52LUQ      64(SP), Z16, Z3
+	VPMADD52HUQ      64(SP), Z16, Z4
+	VPMADD52LUQ      128(SP), Z16, Z4
+	VPMADD52HUQ      128(SP), Z16, Z5
+	VPMADD52LUQ      192(SP), Z16, Z5
+	VPMADD52HUQ      192(SP), Z16, Z6
+	VPMADD52LUQ      256(SP), Z16, Z6
+	VPMADD52HUQ      256(SP), Z16, Z7
+	VPMADD52LUQ      320(SP), Z16, Z7
+	VPMADD52HUQ      320(SP), Z16, Z8
+	VPMADD52LUQ      384(SP), Z16, Z8
+	VPMADD52HUQ      384(SP), Z16, Z9
+	VPMADD52LUQ      448(SP), Z16, Z9
+	VPMADD52HUQ      448(SP), Z16, Z0
+	VPMADD52LUQ      512(SP), Z16, Z0
+	VPMADD52HUQ      512(SP), Z16, Z1
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z2, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z1
+	VPSRLQ           $52, Z2, Z18
+	VPADDQ           Z18, Z3, Z3
+	VPXORQ           Z2, Z2, Z2
+
+	// t += x·y[3], followed by a reduction round
+	VMOVDQU64        768(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z3
+	VPMADD52HUQ      0(SP), Z16, Z4
+	VPMADD52LUQ      64(SP), Z16, Z4
+	VPMADD52HUQ      64(SP), Z16, Z5
+	VPMADD52LUQ      128(SP), Z16, Z5
+	VPMADD52HUQ      128(SP), Z16, Z6
+	VPMADD52LUQ      192(SP), Z16, Z6
+	VPMADD52HUQ      192(SP), Z16, Z7
+	VPMADD52LUQ      256(SP), Z16, Z7
+	VPMADD52HUQ      256(SP), Z16, Z8
+	VPMADD52LUQ      320(SP), Z16, Z8
+	VPMADD52HUQ      320(SP), Z16, Z9
+	VPMADD52LUQ      384(SP), Z16, Z9
+	VPMADD52HUQ      384(SP), Z16, Z0
+	VPMADD52LUQ      448(SP), Z16, Z0
+	VPMADD52HUQ      448(SP), Z16, Z1
+	VPMADD52LUQ      512(SP), Z16, Z1
+	VPMADD52HUQ      512(SP), Z16, Z2
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z3, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z2
+	VPSRLQ           $52, Z3, Z18
+	VPADDQ           Z18, Z4, Z4
+	VPXORQ           Z3, Z3, Z3
+
+	// t += x·y[4], followed by a reduction round
+	VMOVDQU64        832(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z4
+	VPMADD52HUQ      0(SP), Z16, Z5
+	VPMADD52LUQ      64(SP), Z16, Z5
+	VPMADD52HUQ      64(SP), Z16, Z6
+	VPMADD52LUQ      128(SP), Z16, Z6
+	VPMADD52HUQ      128(SP), Z16, Z7
+	VPMADD52LUQ      192(SP), Z16, Z7
+	VPMADD52HUQ      192(SP), Z16, Z8
+	VPMADD52LUQ      256(SP), Z16, Z8
+	VPMADD52HUQ      256(SP), Z16, Z9
+	VPMADD52LUQ      320(SP), Z16, Z9
+	VPMADD52HUQ      320(SP), Z16, Z0
+	VPMADD52LUQ      384(SP), Z16, Z0
+	VPMADD52HUQ      384(SP), Z16, Z1
+	VPMADD52LUQ      448(SP), Z16, Z1
+	VPMADD52HUQ      448(SP), Z16, Z2
+	VPMADD52LUQ      512(SP), Z16, Z2
+	VPMADD52HUQ      512(SP), Z16, Z3
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z4, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z3
+	VPSRLQ           $52, Z4, Z18
+	VPADDQ           Z18, Z5, Z5
+	VPXORQ           Z4, Z4, Z4
+
+	// t += x·y[5], followed by a reduction round
+	VMOVDQU64        896(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z5
+	VPMADD52HUQ      0(SP), Z16, Z6
+	VPMADD52LUQ      64(SP), Z16, Z6
+	VPMADD52HUQ      64(SP), Z16, Z7
+	VPMADD52LUQ      128(SP), Z16, Z7
+	VPMADD52HUQ      128(SP), Z16, Z8
+	VPMADD52LUQ      192(SP), Z16, Z8
+	VPMADD52HUQ      192(SP), Z16, Z9
+	VPMADD52LUQ      256(SP), Z16, Z9
+	VPMADD52HUQ      256(SP), Z16, Z0
+	VPMADD52LUQ      320(SP), Z16, Z0
+	VPMADD52HUQ      320(SP), Z16, Z1
+	VPMADD52LUQ      384(SP), Z16, Z1
+	VPMADD52HUQ      384(SP), Z16, Z2
+	VPMADD52LUQ      448(SP), Z16, Z2
+	VPMADD52HUQ      448(SP), Z16, Z3
+	VPMADD52LUQ      512(SP), Z16, Z3
+	VPMADD52HUQ      512(SP), Z16, Z4
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z5, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z4
+	VPSRLQ           $52, Z5, Z18
+	VPADDQ           Z18, Z6, Z6
+	VPXORQ           Z5, Z5, Z5
+
+	// t += x·y[6], followed by a reduction round
+	VMOVDQU64        960(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z6
+	VPMADD52HUQ      0(SP), Z16, Z7
+	VPMADD52LUQ      64(SP), Z16, Z7
+	VPMADD52HUQ      64(SP), Z16, Z8
+	VPMADD52LUQ      128(SP), Z16, Z8
+	VPMADD52HUQ      128(SP), Z16, Z9
+	VPMADD52LUQ      192(SP), Z16, Z9
+	VPMADD52HUQ      192(SP), Z16, Z0
+	VPMADD52LUQ      256(SP), Z16, Z0
+	VPMADD52HUQ      256(SP), Z16, Z1
+	VPMADD52LUQ      320(SP), Z16, Z1
+	VPMADD52HUQ      320(SP), Z16, Z2
+	VPMADD52LUQ      384(SP), Z16, Z2
+	VPMADD52HUQ      384(SP), Z16, Z3
+	VPMADD52LUQ      448(SP), Z16, Z3
+	VPMADD52HUQ      448(SP), Z16, Z4
+	VPMADD52LUQ      512(SP), Z16, Z4
+	VPMADD52HUQ      512(SP), Z16, Z5
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z6, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z5
+	VPSRLQ           $52, Z6, Z18
+	VPADDQ           Z18, Z7, Z7
+	VPXORQ           Z6, Z6, Z6
+
+	// t += x·y[7], followed by a reduction round
+	VMOVDQU64        1024(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z7
+	VPMADD52HUQ      0(SP), Z16, Z8
+	VPMADD52LUQ      64(SP), Z16, Z8
+	VPMADD52HUQ      64(SP), Z16, Z9
+	VPMADD52LUQ      128(SP), Z16, Z9
+	VPMADD52HUQ      128(SP), Z16, Z0
+	VPMADD52LUQ      192(SP), Z16, Z0
+	VPMADD52HUQ      192(SP), Z16, Z1
+	VPMADD52LUQ      256(SP), Z16, Z1
+	VPMADD52HUQ      256(SP), Z16, Z2
+	VPMADD52LUQ      320(SP), Z16, Z2
+	VPMADD52HUQ      320(SP), Z16, Z3
+	VPMADD52LUQ      384(SP), Z16, Z3
+	VPMADD52HUQ      384(SP), Z16, Z4
+	VPMADD52LUQ      448(SP), Z16, Z4
+	VPMADD52HUQ      448(SP), Z16, Z5
+	VPMADD52LUQ      512(SP), Z16, Z5
+	VPMADD52HUQ      512(SP), Z16, Z6
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z7, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z7
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z6
+	VPSRLQ           $52, Z7, Z18
+	VPADDQ           Z18, Z8, Z8
+	VPXORQ           Z7, Z7, Z7
+
+	// t += x·y[8], followed by a reduction round
+	VMOVDQU64        1088(SP), Z16
+	VPMADD52LUQ      0(SP), Z16, Z8
+	VPMADD52HUQ      0(SP), Z16, Z9
+	VPMADD52LUQ      64(SP), Z16, Z9
+	VPMADD52HUQ      64(SP), Z16, Z0
+	VPMADD52LUQ      128(SP), Z16, Z0
+	VPMADD52HUQ      128(SP), Z16, Z1
+	VPMADD52LUQ      192(SP), Z16, Z1
+	VPMADD52HUQ      192(SP), Z16, Z2
+	VPMADD52LUQ      256(SP), Z16, Z2
+	VPMADD52HUQ      256(SP), Z16, Z3
+	VPMADD52LUQ      320(SP), Z16, Z3
+	VPMADD52HUQ      320(SP), Z16, Z4
+	VPMADD52LUQ      384(SP), Z16, Z4
+	VPMADD52HUQ      384(SP), Z16, Z5
+	VPMADD52LUQ      448(SP), Z16, Z5
+	VPMADD52HUQ      448(SP), Z16, Z6
+	VPMADD52LUQ      512(SP), Z16, Z6
+	VPMADD52HUQ      512(SP), Z16, Z7
+	VPXORQ           Z17, Z17, Z17
+	VPMADD52LUQ.BCST qInvNeg52<>(SB), Z8, Z17
+	VPMADD52LUQ.BCST q52<>+0(SB), Z17, Z8
+	VPMADD52HUQ.BCST q52<>+0(SB), Z17, Z9
+	VPMADD52LUQ.BCST q52<>+8(SB), Z17, Z9
+	VPMADD52HUQ.BCST q52<>+8(SB), Z17, Z0
+	VPMADD52LUQ.BCST q52<>+16(SB), Z17, Z0
+	VPMADD52HUQ.BCST q52<>+16(SB), Z17, Z1
+	VPMADD52LUQ.BCST q52<>+24(SB), Z17, Z1
+	VPMADD52HUQ.BCST q52<>+24(SB), Z17, Z2
+	VPMADD52LUQ.BCST q52<>+32(SB), Z17, Z2
+	VPMADD52HUQ.BCST q52<>+32(SB), Z17, Z3
+	VPMADD52LUQ.BCST q52<>+40(SB), Z17, Z3
+	VPMADD52HUQ.BCST q52<>+40(SB), Z17, Z4
+	VPMADD52LUQ.BCST q52<>+48(SB), Z17, Z4
+	VPMADD52HUQ.BCST q52<>+48(SB), Z17, Z5
+	VPMADD52LUQ.BCST q52<>+56(SB), Z17, Z5
+	VPMADD52HUQ.BCST q52<>+56(SB), Z17, Z6
+	VPMADD52LUQ.BCST q52<>+64(SB), Z17, Z6
+	VPMADD52HUQ.BCST q52<>+64(SB), Z17, Z7
+	VPSRLQ           $52, Z8, Z18
+	VPADDQ           Z18, Z9, Z9
+	VPXORQ           Z8, Z8, Z8
+	VPSRLQ           $52, Z9, Z18
+	VPANDQ           Z31, Z9, Z9
+	VPADDQ           Z18, Z0, Z0
+	VPSRLQ           $52, Z0, Z18
+	VPANDQ           Z31, Z0, Z0
+	VPADDQ           Z18, Z1, Z1
+	VPSRLQ           $52, Z1, Z18
+	VPANDQ           Z31, Z1, Z1
+	VPADDQ           Z18, Z2, Z2
+	VPSRLQ           $52, Z2, Z18
+	VPANDQ           Z31, Z2, Z2
+	VPADDQ           Z18, Z3, Z3
+	VPSRLQ           $52, Z3, Z18
+	VPANDQ           Z31, Z3, Z3
+	VPADDQ           Z18, Z4, Z4
+	VPSRLQ           $52, Z4, Z18
+	VPANDQ           Z31, Z4, Z4
+	VPADDQ           Z18, Z5, Z5
+	VPSRLQ           $52, Z5, Z18
+	VPANDQ           Z31, Z5, Z5
+	VPADDQ           Z18, Z6, Z6
+	VPSRLQ           $52, Z6, Z18
+	VPANDQ           Z31, Z6, Z6
+	VPADDQ           Z18, Z7, Z7
+
+	// t = t - q if t >= q
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z9, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+8(SB), Z0, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+16(SB), Z1, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+24(SB), Z2, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+32(SB), Z3, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+40(SB), Z4, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+48(SB), Z5, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+56(SB), Z6, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+64(SB), Z7, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPTESTNMQ   Z19, Z19, K2
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z9, K2, Z9
+	VPSUBQ      Z19, Z9, K2, Z9
+	VPSRLQ      $63, Z9, Z19
+	VPANDQ      Z31, Z9, K2, Z9
+	VPSUBQ.BCST q52<>+8(SB), Z0, K2, Z0
+	VPSUBQ      Z19, Z0, K2, Z0
+	VPSRLQ      $63, Z0, Z19
+	VPANDQ      Z31, Z0, K2, Z0
+	VPSUBQ.BCST q52<>+16(SB), Z1, K2, Z1
+	VPSUBQ      Z19, Z1, K2, Z1
+	VPSRLQ      $63, Z1, Z19
+	VPANDQ      Z31, Z1, K2, Z1
+	VPSUBQ.BCST q52<>+24(SB), Z2, K2, Z2
+	VPSUBQ      Z19, Z2, K2, Z2
+	VPSRLQ      $63, Z2, Z19
+	VPANDQ      Z31, Z2, K2, Z2
+	VPSUBQ.BCST q52<>+32(SB), Z3, K2, Z3
+	VPSUBQ      Z19, Z3, K2, Z3
+	VPSRLQ      $63, Z3, Z19
+	VPANDQ      Z31, Z3, K2, Z3
+	VPSUBQ.BCST q52<>+40(SB), Z4, K2, Z4
+	VPSUBQ      Z19, Z4, K2, Z4
+	VPSRLQ      $63, Z4, Z19
+	VPANDQ      Z31, Z4, K2, Z4
+	VPSUBQ.BCST q52<>+48(SB), Z5, K2, Z5
+	VPSUBQ      Z19, Z5, K2, Z5
+	VPSRLQ      $63, Z5, Z19
+	VPANDQ      Z31, Z5, K2, Z5
+	VPSUBQ.BCST q52<>+56(SB), Z6, K2, Z6
+	VPSUBQ      Z19, Z6, K2, Z6
+	VPSRLQ      $63, Z6, Z19
+	VPANDQ      Z31, Z6, K2, Z6
+	VPSUBQ.BCST q52<>+64(SB), Z7, K2, Z7
+	VPSUBQ      Z19, Z7, K2, Z7
+	VPSRLQ      $63, Z7, Z19
+	VPANDQ      Z31, Z7, K2, Z7
+	VPSLLQ      $0, Z9, Z20
+	VPSLLQ      $52, Z0, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 0(CX)(Z30*1)
+	VPSRLQ      $12, Z0, Z20
+	VPSLLQ      $40, Z1, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 8(CX)(Z30*1)
+	VPSRLQ      $24, Z1, Z20
+	VPSLLQ      $28, Z2, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 16(CX)(Z30*1)
+	VPSRLQ      $36, Z2, Z20
+	VPSLLQ      $16, Z3, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 24(CX)(Z30*1)
+	VPSRLQ      $48, Z3, Z20
+	VPSLLQ      $4, Z4, Z21
+	VPORQ       Z21, Z20, Z20
+	VPSLLQ      $56, Z5, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 32(CX)(Z30*1)
+	VPSRLQ      $8, Z5, Z20
+	VPSLLQ      $44, Z6, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 40(CX)(Z30*1)
+	VPSRLQ      $20, Z6, Z20
+	VPSLLQ      $32, Z7, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 48(CX)(Z30*1)
+	VPSRLQ      $32, Z7, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 56(CX)(Z30*1)
+	ADDQ        $512, CX
+	ADDQ        $512, AX
+	DECQ        BX
+	JMP         l3
+
+l4:
+	VZEROUPPER
+	RET
+
+// butterflyVecIFMA(a, b *Element, n uint64) a[i], b[i] = a[i]+b[i], a[i]-b[i], for 8·n elements
+TEXT ·butterflyVecIFMA(SB), $1152-24
+	MOVQ         $0xfffffffffffff, R8
+	VPBROADCASTQ R8, Z31
+	VMOVDQU64    offsets<>(SB), Z30
+	MOVQ         a+0(FP), AX
+	MOVQ         b+8(FP), DX
+	MOVQ         n+16(FP), BX
+
+l5:
+	TESTQ      BX, BX
+	JEQ        l6
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 0(AX)(Z30*1), K1, Z20
+	VPSRLQ     $0, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 0(SP)
+	VPSRLQ     $52, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 8(AX)(Z30*1), K1, Z21
+	VPSLLQ     $12, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 64(SP)
+	VPSRLQ     $40, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 16(AX)(Z30*1), K1, Z20
+	VPSLLQ     $24, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 128(SP)
+	VPSRLQ     $28, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 24(AX)(Z30*1), K1, Z21
+	VPSLLQ     $36, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 192(SP)
+	VPSRLQ     $16, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 32(AX)(Z30*1), K1, Z20
+	VPSLLQ     $48, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 256(SP)
+	VPSRLQ     $4, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 320(SP)
+	VPSRLQ     $56, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 40(AX)(Z30*1), K1, Z21
+	VPSLLQ     $8, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 384(SP)
+	VPSRLQ     $44, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 48(AX)(Z30*1), K1, Z20
+	VPSLLQ     $20, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 448(SP)
+	VPSRLQ     $32, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 56(AX)(Z30*1), K1, Z21
+	VPSLLQ     $32, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 512(SP)
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 0(DX)(Z30*1), K1, Z20
+	VPSRLQ     $0, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 576(SP)
+	VPSRLQ     $52, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 8(DX)(Z30*1), K1, Z21
+	VPSLLQ     $12, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 640(SP)
+	VPSRLQ     $40, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 16(DX)(Z30*1), K1, Z20
+	VPSLLQ     $24, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 704(SP)
+	VPSRLQ     $28, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 24(DX)(Z30*1), K1, Z21
+	VPSLLQ     $36, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 768(SP)
+	VPSRLQ     $16, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 32(DX)(Z30*1), K1, Z20
+	VPSLLQ     $48, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 832(SP)
+	VPSRLQ     $4, Z20, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 896(SP)
+	VPSRLQ     $56, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 40(DX)(Z30*1), K1, Z21
+	VPSLLQ     $8, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 960(SP)
+	VPSRLQ     $44, Z21, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 48(DX)(Z30*1), K1, Z20
+	VPSLLQ     $20, Z20, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 1024(SP)
+	VPSRLQ     $32, Z20, Z22
+	KXNORW     K1, K1, K1
+	VPGATHERQQ 56(DX)(Z30*1), K1, Z21
+	VPSLLQ     $32, Z21, Z23
+	VPORQ      Z23, Z22, Z22
+	VPANDQ     Z31, Z22, Z22
+	VMOVDQU64  Z22, 1088(SP)
+
+	// a + b
+	VMOVDQU64 0(SP), Z0
+	VPADDQ    576(SP), Z0, Z0
+	VMOVDQU64 64(SP), Z1
+	VPADDQ    640(SP), Z1, Z1
+	VMOVDQU64 128(SP), Z2
+	VPADDQ    704(SP), Z2, Z2
+	VMOVDQU64 192(SP), Z3
+	VPADDQ    768(SP), Z3, Z3
+	VMOVDQU64 256(SP), Z4
+	VPADDQ    832(SP), Z4, Z4
+	VMOVDQU64 320(SP), Z5
+	VPADDQ    896(SP), Z5, Z5
+	VMOVDQU64 384(SP), Z6
+	VPADDQ    960(SP), Z6, Z6
+	VMOVDQU64 448(SP), Z7
+	VPADDQ    1024(SP), Z7, Z7
+	VMOVDQU64 512(SP), Z8
+	VPADDQ    1088(SP), Z8, Z8
+	VPSRLQ    $52, Z0, Z18
+	VPANDQ    Z31, Z0, Z0
+	VPADDQ    Z18, Z1, Z1
+	VPSRLQ    $52, Z1, Z18
+	VPANDQ    Z31, Z1, Z1
+	VPADDQ    Z18, Z2, Z2
+	VPSRLQ    $52, Z2, Z18
+	VPANDQ    Z31, Z2, Z2
+	VPADDQ    Z18, Z3, Z3
+	VPSRLQ    $52, Z3, Z18
+	VPANDQ    Z31, Z3, Z3
+	VPADDQ    Z18, Z4, Z4
+	VPSRLQ    $52, Z4, Z18
+	VPANDQ    Z31, Z4, Z4
+	VPADDQ    Z18, Z5, Z5
+	VPSRLQ    $52, Z5, Z18
+	VPANDQ    Z31, Z5, Z5
+	VPADDQ    Z18, Z6, Z6
+	VPSRLQ    $52, Z6, Z18
+	VPANDQ    Z31, Z6, Z6
+	VPADDQ    Z18, Z7, Z7
+	VPSRLQ    $52, Z7, Z18
+	VPANDQ    Z31, Z7, Z7
+	VPADDQ    Z18, Z8, Z8
+
+	// t = t - q if t >= q
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z0, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+8(SB), Z1, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+16(SB), Z2, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+24(SB), Z3, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+32(SB), Z4, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+40(SB), Z5, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+48(SB), Z6, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+56(SB), Z7, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+64(SB), Z8, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPTESTNMQ   Z19, Z19, K2
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z0, K2, Z0
+	VPSUBQ      Z19, Z0, K2, Z0
+	VPSRLQ      $63, Z0, Z19
+	VPANDQ      Z31, Z0, K2, Z0
+	VPSUBQ.BCST q52<>+8(SB), Z1, K2, Z1
+	VPSUBQ      Z19, Z1, K2, Z1
+	VPSRLQ      $63, Z1, Z19
+	VPANDQ      Z31, Z1, K2, Z1
+	VPSUBQ.BCST q52<>+16(SB), Z2, K2, Z2
+	VPSUBQ      Z19, Z2, K2, Z2
+	VPSRLQ      $63, Z2, Z19
+	VPANDQ      Z31, Z2, K2, Z2
+	VPSUBQ.BCST q52<>+24(SB), Z3, K2, Z3
+	VPSUBQ      Z19, Z3, K2, Z3
+	VPSRLQ      $63, Z3, Z19
+	VPANDQ      Z31, Z3, K2, Z3
+	VPSUBQ.BCST q52<>+32(SB), Z4, K2, Z4
+	VPSUBQ      Z19, Z4, K2, Z4
+	VPSRLQ      $63, Z4, Z19
+	VPANDQ      Z31, Z4, K2, Z4
+	VPSUBQ.BCST q52<>+40(SB), Z5, K2, Z5
+	VPSUBQ      Z19, Z5, K2, Z5
+	VPSRLQ      $63, Z5, Z19
+	VPANDQ      Z31, Z5, K2, Z5
+	VPSUBQ.BCST q52<>+48(SB), Z6, K2, Z6
+	VPSUBQ      Z19, Z6, K2, Z6
+	VPSRLQ      $63, Z6, Z19
+	VPANDQ      Z31, Z6, K2, Z6
+	VPSUBQ.BCST q52<>+56(SB), Z7, K2, Z7
+	VPSUBQ      Z19, Z7, K2, Z7
+	VPSRLQ      $63, Z7, Z19
+	VPANDQ      Z31, Z7, K2, Z7
+	VPSUBQ.BCST q52<>+64(SB), Z8, K2, Z8
+	VPSUBQ      Z19, Z8, K2, Z8
+	VPSRLQ      $63, Z8, Z19
+	VPANDQ      Z31, Z8, K2, Z8
+	VPSLLQ      $0, Z0, Z20
+	VPSLLQ      $52, Z1, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 0(AX)(Z30*1)
+	VPSRLQ      $12, Z1, Z20
+	VPSLLQ      $40, Z2, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 8(AX)(Z30*1)
+	VPSRLQ      $24, Z2, Z20
+	VPSLLQ      $28, Z3, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 16(AX)(Z30*1)
+	VPSRLQ      $36, Z3, Z20
+	VPSLLQ      $16, Z4, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 24(AX)(Z30*1)
+	VPSRLQ      $48, Z4, Z20
+	VPSLLQ      $4, Z5, Z21
+	VPORQ       Z21, Z20, Z20
+	VPSLLQ      $56, Z6, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 32(AX)(Z30*1)
+	VPSRLQ      $8, Z6, Z20
+	VPSLLQ      $44, Z7, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 40(AX)(Z30*1)
+	VPSRLQ      $20, Z7, Z20
+	VPSLLQ      $32, Z8, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 48(AX)(Z30*1)
+	VPSRLQ      $32, Z8, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 56(AX)(Z30*1)
+
+	// a - b + q
+	VMOVDQU64   0(SP), Z0
+	VPSUBQ      576(SP), Z0, Z0
+	VPADDQ.BCST q52<>+0(SB), Z0, Z0
+	VMOVDQU64   64(SP), Z1
+	VPSUBQ      640(SP), Z1, Z1
+	VPADDQ.BCST q52<>+8(SB), Z1, Z1
+	VMOVDQU64   128(SP), Z2
+	VPSUBQ      704(SP), Z2, Z2
+	VPADDQ.BCST q52<>+16(SB), Z2, Z2
+	VMOVDQU64   192(SP), Z3
+	VPSUBQ      768(SP), Z3, Z3
+	VPADDQ.BCST q52<>+24(SB), Z3, Z3
+	VMOVDQU64   256(SP), Z4
+	VPSUBQ      832(SP), Z4, Z4
+	VPADDQ.BCST q52<>+32(SB), Z4, Z4
+	VMOVDQU64   320(SP), Z5
+	VPSUBQ      896(SP), Z5, Z5
+	VPADDQ.BCST q52<>+40(SB), Z5, Z5
+	VMOVDQU64   384(SP), Z6
+	VPSUBQ      960(SP), Z6, Z6
+	VPADDQ.BCST q52<>+48(SB), Z6, Z6
+	VMOVDQU64   448(SP), Z7
+	VPSUBQ      1024(SP), Z7, Z7
+	VPADDQ.BCST q52<>+56(SB), Z7, Z7
+	VMOVDQU64   512(SP), Z8
+	VPSUBQ      1088(SP), Z8, Z8
+	VPADDQ.BCST q52<>+64(SB), Z8, Z8
+	VPSRAQ      $52, Z0, Z18
+	VPANDQ      Z31, Z0, Z0
+	VPADDQ      Z18, Z1, Z1
+	VPSRAQ      $52, Z1, Z18
+	VPANDQ      Z31, Z1, Z1
+	VPADDQ      Z18, Z2, Z2
+	VPSRAQ      $52, Z2, Z18
+	VPANDQ      Z31, Z2, Z2
+	VPADDQ      Z18, Z3, Z3
+	VPSRAQ      $52, Z3, Z18
+	VPANDQ      Z31, Z3, Z3
+	VPADDQ      Z18, Z4, Z4
+	VPSRAQ      $52, Z4, Z18
+	VPANDQ      Z31, Z4, Z4
+	VPADDQ      Z18, Z5, Z5
+	VPSRAQ      $52, Z5, Z18
+	VPANDQ      Z31, Z5, Z5
+	VPADDQ      Z18, Z6, Z6
+	VPSRAQ      $52, Z6, Z18
+	VPANDQ      Z31, Z6, Z6
+	VPADDQ      Z18, Z7, Z7
+	VPSRAQ      $52, Z7, Z18
+	VPANDQ      Z31, Z7, Z7
+	VPADDQ      Z18, Z8, Z8
+
+	// t = t - q if t >= q
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z0, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+8(SB), Z1, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+16(SB), Z2, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+24(SB), Z3, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+32(SB), Z4, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+40(SB), Z5, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+48(SB), Z6, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+56(SB), Z7, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPSUBQ.BCST q52<>+64(SB), Z8, Z18
+	VPSUBQ      Z19, Z18, Z18
+	VPSRLQ      $63, Z18, Z19
+	VPTESTNMQ   Z19, Z19, K2
+	VPXORQ      Z19, Z19, Z19
+	VPSUBQ.BCST q52<>+0(SB), Z0, K2, Z0
+	VPSUBQ      Z19, Z0, K2, Z0
+	VPSRLQ      $63, Z0, Z19
+	VPANDQ      Z31, Z0, K2, Z0
+	VPSUBQ.BCST q52<>+8(SB), Z1, K2, Z1
+	VPSUBQ      Z19, Z1, K2, Z1
+	VPSRLQ      $63, Z1, Z19
+	VPANDQ      Z31, Z1, K2, Z1
+	VPSUBQ.BCST q52<>+16(SB), Z2, K2, Z2
+	VPSUBQ      Z19, Z2, K2, Z2
+	VPSRLQ      $63, Z2, Z19
+	VPANDQ      Z31, Z2, K2, Z2
+	VPSUBQ.BCST q52<>+24(SB), Z3, K2, Z3
+	VPSUBQ      Z19, Z3, K2, Z3
+	VPSRLQ      $63, Z3, Z19
+	VPANDQ      Z31, Z3, K2, Z3
+	VPSUBQ.BCST q52<>+32(SB), Z4, K2, Z4
+	VPSUBQ      Z19, Z4, K2, Z4
+	VPSRLQ      $63, Z4, Z19
+	VPANDQ      Z31, Z4, K2, Z4
+	VPSUBQ.BCST q52<>+40(SB), Z5, K2, Z5
+	VPSUBQ      Z19, Z5, K2, Z5
+	VPSRLQ      $63, Z5, Z19
+	VPANDQ      Z31, Z5, K2, Z5
+	VPSUBQ.BCST q52<>+48(SB), Z6, K2, Z6
+	VPSUBQ      Z19, Z6, K2, Z6
+	VPSRLQ      $63, Z6, Z19
+	VPANDQ      Z31, Z6, K2, Z6
+	VPSUBQ.BCST q52<>+56(SB), Z7, K2, Z7
+	VPSUBQ      Z19, Z7, K2, Z7
+	VPSRLQ      $63, Z7, Z19
+	VPANDQ      Z31, Z7, K2, Z7
+	VPSUBQ.BCST q52<>+64(SB), Z8, K2, Z8
+	VPSUBQ      Z19, Z8, K2, Z8
+	VPSRLQ      $63, Z8, Z19
+	VPANDQ      Z31, Z8, K2, Z8
+	VPSLLQ      $0, Z0, Z20
+	VPSLLQ      $52, Z1, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 0(DX)(Z30*1)
+	VPSRLQ      $12, Z1, Z20
+	VPSLLQ      $40, Z2, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 8(DX)(Z30*1)
+	VPSRLQ      $24, Z2, Z20
+	VPSLLQ      $28, Z3, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 16(DX)(Z30*1)
+	VPSRLQ      $36, Z3, Z20
+	VPSLLQ      $16, Z4, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 24(DX)(Z30*1)
+	VPSRLQ      $48, Z4, Z20
+	VPSLLQ      $4, Z5, Z21
+	VPORQ       Z21, Z20, Z20
+	VPSLLQ      $56, Z6, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 32(DX)(Z30*1)
+	VPSRLQ      $8, Z6, Z20
+	VPSLLQ      $44, Z7, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 40(DX)(Z30*1)
+	VPSRLQ      $20, Z7, Z20
+	VPSLLQ      $32, Z8, Z21
+	VPORQ       Z21, Z20, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 48(DX)(Z30*1)
+	VPSRLQ      $32, Z8, Z20
+	KXNORW      K1, K1, K1
+	VPSCATTERQQ Z20, K1, 56(DX)(Z30*1)
+	ADDQ        $512, AX
+	ADDQ        $512, DX
+	DECQ        BX
+	JMP         l5
+
+l6:
+	VZEROUPPER
+	RET
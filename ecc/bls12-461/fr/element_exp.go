@@ -0,0 +1,537 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 1fffffeffff8030002ffc0bfa001d003f802fff90000220000ffdfffdffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_111     = _10 + _101
+	//	_1100    = _101 + _111
+	//	_10001   = _101 + _1100
+	//	_11101   = _1100 + _10001
+	//	_11111   = _10 + _11101
+	//	_1111100 = _11111 << 2
+	//	_1111111 = _11 + _1111100
+	//	x10      = _1111111 << 3 + _111
+	//	x13      = x10 << 3 + _111
+	//	x15      = x13 << 2 + _11
+	//	x17      = x15 << 2 + _11
+	//	x24      = x17 << 7 + _1111111
+	//	i79      = ((x24 << 18 + x17) << 11 + _11) << 15
+	//	i100     = ((1 + i79) << 11 + x10) << 7 + 1
+	//	i129     = ((i100 << 8 + _1111111) << 2 + 1) << 17
+	//	i159     = ((_11101 + i129) << 17 + _1111111) << 10 + 1
+	//	i201     = ((i159 << 14 + x13) << 3 + 1) << 23
+	//	i247     = ((_10001 + i201) << 27 + x10) << 16 + x15
+	//	return     i247 << 18 + x17
+	//
+	// Operations: 237 squares 29 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: t6 = x^0x3
+	t6.Mul(&x, t0)
+
+	// Step 3: t2 = x^0x5
+	t2.Mul(t0, t6)
+
+	// Step 4: z = x^0x7
+	z.Mul(t0, t2)
+
+	// Step 5: t1 = x^0xc
+	t1.Mul(t2, z)
+
+	// Step 6: t2 = x^0x11
+	t2.Mul(t2, t1)
+
+	// Step 7: t5 = x^0x1d
+	t5.Mul(t1, t2)
+
+	// Step 8: t0 = x^0x1f
+	t0.Mul(t0, t5)
+
+	// Step 10: t0 = x^0x7c
+	for s := 0; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 11: t4 = x^0x7f
+	t4.Mul(t6, t0)
+
+	// Step 14: t0 = x^0x3f8
+	t0.Square(t4)
+	for s := 1; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 15: t1 = x^0x3ff
+	t1.Mul(z, t0)
+
+	// Step 18: t0 = x^0x1ff8
+	t0.Square(t1)
+	for s := 1; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 19: t3 = x^0x1fff
+	t3.Mul(z, t0)
+
+	// Step 21: z = x^0x7ffc
+	z.Square(t3)
+	for s := 1; s < 2; s++ {
+		z.Square(z)
+	}
+
+	// Step 22: t0 = x^0x7fff
+	t0.Mul(t6, z)
+
+	// Step 24: z = x^0x1fffc
+	z.Square(t0)
+	for s := 1; s < 2; s++ {
+		z.Square(z)
+	}
+
+	// Step 25: z = x^0x1ffff
+	z.Mul(t6, z)
+
+	// Step 32: t7 = x^0xffff80
+	t7.Square(z)
+	for s := 1; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 33: t7 = x^0xffffff
+	t7.Mul(t4, t7)
+
+	// Step 51: t7 = x^0x3fffffc0000
+	for s := 0; s < 18; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 52: t7 = x^0x3fffffdffff
+	t7.Mul(z, t7)
+
+	// Step 63: t7 = x^0x1fffffeffff800
+	for s := 0; s < 11; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 64: t6 = x^0x1fffffeffff803
+	t6.Mul(t6, t7)
+
+	// Step 79: t6 = x^0xffffff7fffc018000
+	for s := 0; s < 15; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 80: t6 = x^0xffffff7fffc018001
+	t6.Mul(&x, t6)
+
+	// Step 91: t6 = x^0x7fffffbfffe00c000800
+	for s := 0; s < 11; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 92: t6 = x^0x7fffffbfffe00c000bff
+	t6.Mul(t1, t6)
+
+	// Step 99: t6 = x^0x3fffffdffff0060005ff80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 100: t6 = x^0x3fffffdffff0060005ff81
+	t6.Mul(&x, t6)
+
+	// Step 108: t6 = x^0x3fffffdffff0060005ff8100
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 109: t6 = x^0x3fffffdffff0060005ff817f
+	t6.Mul(t4, t6)
+
+	// Step 111: t6 = x^0xffffff7fffc0180017fe05fc
+	for s := 0; s < 2; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 112: t6 = x^0xffffff7fffc0180017fe05fd
+	t6.Mul(&x, t6)
+
+	// Step 129: t6 = x^0x1fffffeffff8030002ffc0bfa0000
+	for s := 0; s < 17; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 130: t5 = x^0x1fffffeffff8030002ffc0bfa001d
+	t5.Mul(t5, t6)
+
+	// Step 147: t5 = x^0x3fffffdffff0060005ff817f4003a0000
+	for s := 0; s < 17; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 148: t4 = x^0x3fffffdffff0060005ff817f4003a007f
+	t4.Mul(t4, t5)
+
+	// Step 158: t4 = x^0xffffff7fffc0180017fe05fd000e801fc00
+	for s := 0; s < 10; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 159: t4 = x^0xffffff7fffc0180017fe05fd000e801fc01
+	t4.Mul(&x, t4)
+
+	// Step 173: t4 = x^0x3fffffdffff0060005ff817f4003a007f004000
+	for s := 0; s < 14; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 174: t3 = x^0x3fffffdffff0060005ff817f4003a007f005fff
+	t3.Mul(t3, t4)
+
+	// Step 177: t3 = x^0x1fffffeffff8030002ffc0bfa001d003f802fff8
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 178: t3 = x^0x1fffffeffff8030002ffc0bfa001d003f802fff9
+	t3.Mul(&x, t3)
+
+	// Step 201: t3 = x^0xffffff7fffc0180017fe05fd000e801fc017ffc800000
+	for s := 0; s < 23; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 202: t2 = x^0xffffff7fffc0180017fe05fd000e801fc017ffc800011
+	t2.Mul(t2, t3)
+
+	// Step 229: t2 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe4000088000000
+	for s := 0; s < 27; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 230: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff
+	t1.Mul(t1, t2)
+
+	// Step 246: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff0000
+	for s := 0; s < 16; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 247: t0 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff7fff
+	t0.Mul(t0, t1)
+
+	// Step 265: t0 = x^0x1fffffeffff8030002ffc0bfa001d003f802fff90000220000ffdfffc0000
+	for s := 0; s < 18; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 266: z = x^0x1fffffeffff8030002ffc0bfa001d003f802fff90000220000ffdfffdffff
+	z.Mul(z, t0)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff7fff7fffe0000000000000000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_111     = _10 + _101
+	//	_1100    = _101 + _111
+	//	_10001   = _101 + _1100
+	//	_11101   = _1100 + _10001
+	//	_111010  = 2*_11101
+	//	_111111  = _101 + _111010
+	//	_1111110 = 2*_111111
+	//	_1111111 = 1 + _1111110
+	//	x10      = _1111111 << 3 + _111
+	//	x13      = x10 << 3 + _111
+	//	x15      = x13 << 2 + _11
+	//	x17      = x15 << 2 + _11
+	//	x18      = 2*x17 + 1
+	//	x24      = x18 << 6 + _111111
+	//	i80      = ((x24 << 18 + x17) << 11 + _11) << 15
+	//	i101     = ((1 + i80) << 11 + x10) << 7 + 1
+	//	i130     = ((i101 << 8 + _1111111) << 2 + 1) << 17
+	//	i160     = ((_11101 + i130) << 17 + _1111111) << 10 + 1
+	//	i202     = ((i160 << 14 + x13) << 3 + 1) << 23
+	//	i248     = ((_10001 + i202) << 27 + x10) << 16 + x15
+	//	return     (i248 << 19 + x18) << 65
+	//
+	// Operations: 303 squares 30 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+		t8 = new(Element)
+		t9 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t6 = x^0x3
+	t6.Mul(&x, z)
+
+	// Step 3: t0 = x^0x5
+	t0.Mul(z, t6)
+
+	// Step 4: z = x^0x7
+	z.Mul(z, t0)
+
+	// Step 5: t1 = x^0xc
+	t1.Mul(t0, z)
+
+	// Step 6: t2 = x^0x11
+	t2.Mul(t0, t1)
+
+	// Step 7: t5 = x^0x1d
+	t5.Mul(t1, t2)
+
+	// Step 8: t1 = x^0x3a
+	t1.Square(t5)
+
+	// Step 9: t8 = x^0x3f
+	t8.Mul(t0, t1)
+
+	// Step 10: t0 = x^0x7e
+	t0.Square(t8)
+
+	// Step 11: t4 = x^0x7f
+	t4.Mul(&x, t0)
+
+	// Step 14: t0 = x^0x3f8
+	t0.Square(t4)
+	for s := 1; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 15: t1 = x^0x3ff
+	t1.Mul(z, t0)
+
+	// Step 18: t0 = x^0x1ff8
+	t0.Square(t1)
+	for s := 1; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 19: t3 = x^0x1fff
+	t3.Mul(z, t0)
+
+	// Step 21: z = x^0x7ffc
+	z.Square(t3)
+	for s := 1; s < 2; s++ {
+		z.Square(z)
+	}
+
+	// Step 22: t0 = x^0x7fff
+	t0.Mul(t6, z)
+
+	// Step 24: z = x^0x1fffc
+	z.Square(t0)
+	for s := 1; s < 2; s++ {
+		z.Square(z)
+	}
+
+	// Step 25: t7 = x^0x1ffff
+	t7.Mul(t6, z)
+
+	// Step 26: z = x^0x3fffe
+	z.Square(t7)
+
+	// Step 27: z = x^0x3ffff
+	z.Mul(&x, z)
+
+	// Step 33: t9 = x^0xffffc0
+	t9.Square(z)
+	for s := 1; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 34: t8 = x^0xffffff
+	t8.Mul(t8, t9)
+
+	// Step 52: t8 = x^0x3fffffc0000
+	for s := 0; s < 18; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 53: t7 = x^0x3fffffdffff
+	t7.Mul(t7, t8)
+
+	// Step 64: t7 = x^0x1fffffeffff800
+	for s := 0; s < 11; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 65: t6 = x^0x1fffffeffff803
+	t6.Mul(t6, t7)
+
+	// Step 80: t6 = x^0xffffff7fffc018000
+	for s := 0; s < 15; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 81: t6 = x^0xffffff7fffc018001
+	t6.Mul(&x, t6)
+
+	// Step 92: t6 = x^0x7fffffbfffe00c000800
+	for s := 0; s < 11; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 93: t6 = x^0x7fffffbfffe00c000bff
+	t6.Mul(t1, t6)
+
+	// Step 100: t6 = x^0x3fffffdffff0060005ff80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 101: t6 = x^0x3fffffdffff0060005ff81
+	t6.Mul(&x, t6)
+
+	// Step 109: t6 = x^0x3fffffdffff0060005ff8100
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 110: t6 = x^0x3fffffdffff0060005ff817f
+	t6.Mul(t4, t6)
+
+	// Step 112: t6 = x^0xffffff7fffc0180017fe05fc
+	for s := 0; s < 2; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 113: t6 = x^0xffffff7fffc0180017fe05fd
+	t6.Mul(&x, t6)
+
+	// Step 130: t6 = x^0x1fffffeffff8030002ffc0bfa0000
+	for s := 0; s < 17; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 131: t5 = x^0x1fffffeffff8030002ffc0bfa001d
+	t5.Mul(t5, t6)
+
+	// Step 148: t5 = x^0x3fffffdffff0060005ff817f4003a0000
+	for s := 0; s < 17; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 149: t4 = x^0x3fffffdffff0060005ff817f4003a007f
+	t4.Mul(t4, t5)
+
+	// Step 159: t4 = x^0xffffff7fffc0180017fe05fd000e801fc00
+	for s := 0; s < 10; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 160: t4 = x^0xffffff7fffc0180017fe05fd000e801fc01
+	t4.Mul(&x, t4)
+
+	// Step 174: t4 = x^0x3fffffdffff0060005ff817f4003a007f004000
+	for s := 0; s < 14; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 175: t3 = x^0x3fffffdffff0060005ff817f4003a007f005fff
+	t3.Mul(t3, t4)
+
+	// Step 178: t3 = x^0x1fffffeffff8030002ffc0bfa001d003f802fff8
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 179: t3 = x^0x1fffffeffff8030002ffc0bfa001d003f802fff9
+	t3.Mul(&x, t3)
+
+	// Step 202: t3 = x^0xffffff7fffc0180017fe05fd000e801fc017ffc800000
+	for s := 0; s < 23; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 203: t2 = x^0xffffff7fffc0180017fe05fd000e801fc017ffc800011
+	t2.Mul(t2, t3)
+
+	// Step 230: t2 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe4000088000000
+	for s := 0; s < 27; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 231: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff
+	t1.Mul(t1, t2)
+
+	// Step 247: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff0000
+	for s := 0; s < 16; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 248: t0 = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff7fff
+	t0.Mul(t0, t1)
+
+	// Step 267: t0 = x^0x3fffffdffff0060005ff817f4003a007f005fff20000440001ffbfff80000
+	for s := 0; s < 19; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 268: z = x^0x3fffffdffff0060005ff817f4003a007f005fff20000440001ffbfffbffff
+	z.Mul(z, t0)
+
+	// Step 333: z = x^0x7fffffbfffe00c000bff02fe8007400fe00bffe40000880003ff7fff7fffe0000000000000000
+	for s := 0; s < 65; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
@@ -0,0 +1,273 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr/fft"
+)
+
+// errors related to the QuotientBuilder.
+var (
+	ErrUnknownPolynomial = errors.New("the polynomial is not registered")
+	ErrNoConstraint      = errors.New("at least one constraint is needed")
+	ErrInvalidDegree     = errors.New("the degree of a constraint must be positive")
+)
+
+// Term refers to a registered polynomial P, interpreted as P(ωˢʰⁱᶠᵗX)
+// where ω is the generator of the domain of size n.
+type Term struct {
+	Name  string
+	Shift int
+}
+
+// constraint is an expression on a list of terms, of total degree degree.
+type constraint struct {
+	f      Expression
+	degree int
+	terms  []Term
+}
+
+// QuotientBuilder computes the quotient of a list of constraints by Xⁿ-1.
+//
+// The polynomials involved in the constraints are registered by name, in any
+// form and possibly blinded. A constraint is an Expression on a list of Terms,
+// a Term being a registered polynomial possibly shifted (e.g. Z(ωX)). The
+// constraints are combined using powers of a challenge α:
+//
+//	C(X) = C₀(X) + α·C₁(X) + α²·C₂(X) + …
+//
+// and the builder returns H = C/(Xⁿ-1), split in chunks of size n.
+//
+// The evaluations of the registered polynomials on the big coset are cached,
+// so that computing the quotient for several challenges doesn't re-do the FFTs.
+type QuotientBuilder struct {
+	domains     [2]*fft.Domain
+	polynomials map[string]*Polynomial
+	evaluations map[string]*Polynomial
+	constraints []constraint
+	buf         []fr.Element
+}
+
+// NewQuotientBuilder returns a QuotientBuilder for constraints holding on
+// the domain of size n. n must be a power of 2, or a divisor of r-1 made of
+// small primes, in which case the domains are mixed radix domains.
+func NewQuotientBuilder(n int) (*QuotientBuilder, error) {
+	domain, err := newDomain(n)
+	if err != nil {
+		return nil, err
+	}
+	return &QuotientBuilder{
+		domains:     [2]*fft.Domain{domain},
+		polynomials: make(map[string]*Polynomial),
+		evaluations: make(map[string]*Polynomial),
+	}, nil
+}
+
+// Register registers p under the given name. p is not modified.
+// If a polynomial is already registered under the same name, it is replaced.
+//
+// p can be given in Canonical form (possibly blinded) or in Lagrange form
+// (on the domain of size n). If p is given in LagrangeCoset form, it must
+// be evaluated on the big domain used by the builder.
+func (b *QuotientBuilder) Register(name string, p *Polynomial) {
+	b.polynomials[name] = p
+	delete(b.evaluations, name)
+}
+
+// AddConstraint adds the constraint f(t₁, …, tₖ) = 0, which must hold on
+// the domain of size n. degree is the total degree of f.
+//
+// When f is called, x holds the values of the terms on the i-th point of
+// the big coset.
+func (b *QuotientBuilder) AddConstraint(f Expression, degree int, terms ...Term) error {
+	if degree <= 0 {
+		return ErrInvalidDegree
+	}
+	for _, t := range terms {
+		if _, ok := b.polynomials[t.Name]; !ok {
+			return ErrUnknownPolynomial
+		}
+	}
+	b.constraints = append(b.constraints, constraint{f: f, degree: degree, terms: terms})
+	return nil
+}
+
+// Domains returns the small and big domains used to compute the quotient.
+// The big domain is nil until Quotient is called.
+func (b *QuotientBuilder) Domains() [2]*fft.Domain {
+	return b.domains
+}
+
+// Quotient returns H = (C₀ + α·C₁ + α²·C₂ + …)/(Xⁿ-1) where the Cᵢ are
+// the constraints, in the order in which they were added.
+//
+// H is returned split in chunks Hⱼ of size n, in Canonical Regular form,
+// such that H = H₀ + XⁿH₁ + X²ⁿH₂ + …
+//
+// If the constraints don't hold on the domain of size n, the result is not
+// a polynomial and the chunks are meaningless.
+func (b *QuotientBuilder) Quotient(alpha fr.Element) ([]*Polynomial, error) {
+	if len(b.constraints) == 0 {
+		return nil, ErrNoConstraint
+	}
+	n := int(b.domains[0].Cardinality)
+
+	// degree of the numerator
+	degree := 0
+	for _, c := range b.constraints {
+		d := 0
+		for _, t := range c.terms {
+			p, ok := b.polynomials[t.Name]
+			if !ok {
+				return nil, ErrUnknownPolynomial
+			}
+			if p.blindedSize-1 > d {
+				d = p.blindedSize - 1
+			}
+		}
+		if c.degree*d > degree {
+			degree = c.degree * d
+		}
+	}
+
+	// the big domain must hold the numerator, and be strictly bigger than
+	// the small one for Xⁿ-1 to be invertible on its coset.
+	bigSize := 2 * n
+	for bigSize < degree+1 {
+		bigSize *= 2
+	}
+	if b.domains[1] == nil || int(b.domains[1].Cardinality) != bigSize {
+		d, err := newDomain(bigSize)
+		if err != nil {
+			return nil, err
+		}
+		b.domains[1] = d
+		b.evaluations = make(map[string]*Polynomial)
+	}
+
+	// evaluate the terms on the big coset
+	values := make([][]*Polynomial, len(b.constraints))
+	for i, c := range b.constraints {
+		values[i] = make([]*Polynomial, len(c.terms))
+		for j, t := range c.terms {
+			e, err := b.evaluate(t.Name)
+			if err != nil {
+				return nil, err
+			}
+			shift := t.Shift % n
+			if shift < 0 {
+				shift += n
+			}
+			values[i][j] = e.ShallowClone().Shift(shift)
+		}
+	}
+
+	// powers of α
+	alphas := make([]fr.Element, len(b.constraints))
+	alphas[0].SetOne()
+	for i := 1; i < len(alphas); i++ {
+		alphas[i].Mul(&alphas[i-1], &alpha)
+	}
+
+	// evaluate the combined constraints and divide by Xⁿ-1
+	if cap(b.buf) < bigSize {
+		b.buf = make([]fr.Element, bigSize)
+	}
+	buf := b.buf[:bigSize]
+	xnMinusOneInverse := evaluateXnMinusOneDomainBigCoset(b.domains)
+	rho := bigSize / n
+	parallel.Execute(bigSize, func(start, end int) {
+		x := make([][]fr.Element, len(b.constraints))
+		for k := range x {
+			x[k] = make([]fr.Element, len(values[k]))
+		}
+		var acc, t fr.Element
+		for i := start; i < end; i++ {
+			acc.SetZero()
+			for k, c := range b.constraints {
+				for j := range values[k] {
+					x[k][j] = values[k][j].GetCoeff(i)
+				}
+				t = c.f(i, x[k]...)
+				t.Mul(&t, &alphas[k])
+				acc.Add(&acc, &t)
+			}
+			buf[i].Mul(&acc, &xnMinusOneInverse[i%rho])
+		}
+	})
+	h := NewPolynomial(&buf, Form{Basis: LagrangeCoset, Layout: Regular})
+	h.ToCanonical(b.domains[1]).ToRegular()
+
+	// split the quotient in chunks of size n
+	nbChunks := 1
+	if degree >= 2*n {
+		nbChunks = degree / n
+	}
+	res := make([]*Polynomial, nbChunks)
+	for i := range res {
+		coeffs := make([]fr.Element, n)
+		copy(coeffs, buf[i*n:])
+		res[i] = NewPolynomial(&coeffs, Form{Basis: Canonical, Layout: Regular})
+	}
+
+	return res, nil
+}
+
+// evaluate returns the polynomial registered under name, evaluated on the
+// big coset. The result is cached.
+func (b *QuotientBuilder) evaluate(name string) (*Polynomial, error) {
+	if e, ok := b.evaluations[name]; ok {
+		return e, nil
+	}
+	p, ok := b.polynomials[name]
+	if !ok {
+		return nil, ErrUnknownPolynomial
+	}
+	n := int(b.domains[0].Cardinality)
+	bigSize := int(b.domains[1].Cardinality)
+
+	var e *Polynomial
+	switch p.Basis {
+	case LagrangeCoset:
+		if p.coefficients.Len() != bigSize {
+			return nil, ErrInconsistentSizeDomain
+		}
+		e = p.ShallowClone()
+	case Lagrange:
+		if p.coefficients.Len() != n {
+			return nil, ErrInconsistentSizeDomain
+		}
+		e = p.Clone(bigSize)
+		e.ToCanonical(b.domains[0]).ToRegular().ToLagrangeCoset(b.domains[1])
+	default:
+		if p.coefficients.Len() > bigSize {
+			return nil, ErrInconsistentSizeDomain
+		}
+		e = p.Clone(bigSize)
+		e.ToRegular().ToLagrangeCoset(b.domains[1])
+	}
+	e.shift = 0
+	e.size = n
+	b.evaluations[name] = e
+
+	return e, nil
+}
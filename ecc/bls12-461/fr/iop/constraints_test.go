@@ -0,0 +1,273 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr/fft"
+)
+
+// plonkGate returns ql·a + qr·b + qm·a·b + qo·c + qk
+func plonkGate(_ int, x ...fr.Element) fr.Element {
+	var res, t fr.Element
+	res.Mul(&x[0], &x[5])
+	t.Mul(&x[1], &x[6])
+	res.Add(&res, &t)
+	t.Mul(&x[5], &x[6]).Mul(&t, &x[2])
+	res.Add(&res, &t)
+	t.Mul(&x[3], &x[7])
+	res.Add(&res, &t)
+	res.Add(&res, &x[4])
+	return res
+}
+
+// accumulatorGate returns Z(ωX) - Z(X)·r(X)
+func accumulatorGate(_ int, x ...fr.Element) fr.Element {
+	var res fr.Element
+	res.Mul(&x[1], &x[2])
+	res.Sub(&x[0], &res)
+	return res
+}
+
+// buildCircuit returns the selectors and the witness of a random plonk
+// circuit of size n, and an accumulator Z such that Z(ωX) = Z(X)·r(X).
+// Z is blinded and in canonical form, the other polynomials are in Lagrange form.
+func buildCircuit(n int) map[string]*Polynomial {
+	names := []string{"ql", "qr", "qm", "qo", "qk", "a", "b", "c", "r"}
+	res := make(map[string]*Polynomial)
+	for _, name := range names {
+		res[name] = buildPoly(n, Form{Basis: Lagrange, Layout: Regular})
+	}
+	var t fr.Element
+	for i := 0; i < n; i++ {
+		for _, name := range names[:7] {
+			res[name].Coefficients()[i].SetRandom()
+		}
+		// qo·c = -(ql·a + qr·b + qm·a·b + qk)
+		x := make([]fr.Element, 8)
+		for j, name := range names[:7] {
+			x[j] = res[name].Coefficients()[i]
+		}
+		t = plonkGate(i, x...)
+		c := &res["c"].Coefficients()[i]
+		c.Inverse(&x[3]).Mul(c, &t).Neg(c)
+	}
+
+	// r is random with Π r = 1
+	r := res["r"].Coefficients()
+	var prod fr.Element
+	prod.SetOne()
+	for i := 0; i < n-1; i++ {
+		r[i].SetRandom()
+		prod.Mul(&prod, &r[i])
+	}
+	r[n-1].Inverse(&prod)
+
+	z := make([]fr.Element, n)
+	z[0].SetOne()
+	for i := 1; i < n; i++ {
+		z[i].Mul(&z[i-1], &r[i-1])
+	}
+	d, err := newDomain(n)
+	if err != nil {
+		panic(err)
+	}
+	res["z"] = NewPolynomial(&z, Form{Basis: Lagrange, Layout: Regular})
+	res["z"].ToCanonical(d).ToRegular().Blind(2)
+
+	return res
+}
+
+func newTestBuilder(t testing.TB, polynomials map[string]*Polynomial) *QuotientBuilder {
+	b, err := NewQuotientBuilder(polynomials["a"].Size())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, p := range polynomials {
+		b.Register(name, p)
+	}
+	gate := make([]Term, 0, 8)
+	for _, name := range []string{"ql", "qr", "qm", "qo", "qk", "a", "b", "c"} {
+		gate = append(gate, Term{Name: name})
+	}
+	if err := b.AddConstraint(plonkGate, 3, gate...); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddConstraint(accumulatorGate, 2, Term{"z", 1}, Term{"z", 0}, Term{"r", 0}); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// evaluateAt evaluates p(x), p being in Lagrange or Canonical form.
+func evaluateAt(p *Polynomial, x fr.Element, d *fft.Domain) fr.Element {
+	c := p.Clone()
+	if c.Basis == Lagrange {
+		c.ToCanonical(d)
+	}
+	c.ToRegular()
+	return c.Evaluate(x)
+}
+
+// checkQuotient checks that C(ζ) = (ζⁿ-1)·H(ζ) at a random ζ.
+func checkQuotient(b *QuotientBuilder, polynomials map[string]*Polynomial, alpha fr.Element, h []*Polynomial) bool {
+	d := b.Domains()[0]
+	n := int(d.Cardinality)
+
+	var zeta, zetaShifted fr.Element
+	zeta.SetRandom()
+	zetaShifted.Mul(&zeta, &d.Generator)
+
+	e := make(map[string]fr.Element)
+	for name, p := range polynomials {
+		e[name] = evaluateAt(p, zeta, d)
+	}
+	zw := evaluateAt(polynomials["z"], zetaShifted, d)
+
+	gate := plonkGate(0, e["ql"], e["qr"], e["qm"], e["qo"], e["qk"], e["a"], e["b"], e["c"])
+	acc := accumulatorGate(0, zw, e["z"], e["r"])
+	var lhs fr.Element
+	lhs.Mul(&acc, &alpha).Add(&lhs, &gate)
+
+	// (ζⁿ-1)·(H₀(ζ) + ζⁿH₁(ζ) + …)
+	var zn, rhs, one fr.Element
+	one.SetOne()
+	zn.Exp(zeta, big.NewInt(int64(n)))
+	for i := len(h) - 1; i >= 0; i-- {
+		v := h[i].Evaluate(zeta)
+		rhs.Mul(&rhs, &zn).Add(&rhs, &v)
+	}
+	zn.Sub(&zn, &one)
+	rhs.Mul(&rhs, &zn)
+
+	return lhs.Equal(&rhs)
+}
+
+func TestQuotientBuilder(t *testing.T) {
+	for _, n := range []int{16, 24} {
+		n := n
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			testQuotientBuilder(t, n)
+		})
+	}
+}
+
+func testQuotientBuilder(t *testing.T, n int) {
+	polynomials := buildCircuit(n)
+	b := newTestBuilder(t, polynomials)
+
+	var alpha fr.Element
+	alpha.SetRandom()
+	h, err := b.Quotient(alpha)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// deg C = 3(n-1), the quotient is made of 2 chunks of size n
+	if len(h) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(h))
+	}
+	for _, c := range h {
+		if c.Size() != n || c.Form != canonicalRegular {
+			t.Fatal("chunks should be of size n, in Canonical Regular form")
+		}
+	}
+	if !checkQuotient(b, polynomials, alpha, h) {
+		t.Fatal("C(ζ) ≠ (ζⁿ-1)·H(ζ)")
+	}
+
+	// the inputs are not modified
+	if polynomials["a"].Basis != Lagrange || polynomials["z"].Basis != Canonical {
+		t.Fatal("the registered polynomials should not be modified")
+	}
+
+	// re-use the cached evaluations with another challenge
+	alpha.SetRandom()
+	h, err = b.Quotient(alpha)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkQuotient(b, polynomials, alpha, h) {
+		t.Fatal("C(ζ) ≠ (ζⁿ-1)·H(ζ)")
+	}
+
+	// replace the witness by a wrong one
+	wrong := polynomials["c"].Clone()
+	wrong.Coefficients()[3].SetRandom()
+	polynomials["c"] = wrong
+	b.Register("c", wrong)
+	h, err = b.Quotient(alpha)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkQuotient(b, polynomials, alpha, h) {
+		t.Fatal("the quotient should not be a polynomial")
+	}
+}
+
+func TestQuotientBuilderErrors(t *testing.T) {
+
+	// 3·2ᵏ divides r-1 for all the curves, 2·3·509 does not
+	if _, err := NewQuotientBuilder(12); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewQuotientBuilder(2 * 3 * 509); err != ErrSizeNotPowerOfTwo {
+		t.Fatal("expected ErrSizeNotPowerOfTwo")
+	}
+
+	b, err := NewQuotientBuilder(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Quotient(fr.One()); err != ErrNoConstraint {
+		t.Fatal("expected ErrNoConstraint")
+	}
+	if err := b.AddConstraint(accumulatorGate, 2, Term{"z", 1}); err != ErrUnknownPolynomial {
+		t.Fatal("expected ErrUnknownPolynomial")
+	}
+	b.Register("z", buildPoly(8, Form{Basis: Lagrange, Layout: Regular}))
+	if err := b.AddConstraint(accumulatorGate, 0, Term{"z", 1}); err != ErrInvalidDegree {
+		t.Fatal("expected ErrInvalidDegree")
+	}
+
+	// Lagrange polynomials must be of size n
+	b.Register("z", buildPoly(16, Form{Basis: Lagrange, Layout: Regular}))
+	if err := b.AddConstraint(accumulatorGate, 1, Term{"z", 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Quotient(fr.One()); err != ErrInconsistentSizeDomain {
+		t.Fatal("expected ErrInconsistentSizeDomain")
+	}
+}
+
+func BenchmarkQuotientBuilder(b *testing.B) {
+	const n = 1 << 12
+	polynomials := buildCircuit(n)
+	builder := newTestBuilder(b, polynomials)
+	var alpha fr.Element
+	alpha.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		builder.evaluations = make(map[string]*Polynomial)
+		_, _ = builder.Quotient(alpha)
+	}
+}
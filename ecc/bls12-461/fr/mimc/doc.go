@@ -0,0 +1,60 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package mimc provides MiMC hash function using Miyaguchi–Preneel construction.
+//
+// # Length extension attack
+//
+// The MiMC hash function is vulnerable to a length extension attack. For
+// example when we have a hash
+//
+//	h = MiMC(k || m)
+//
+// and we want to hash a new message
+//
+//	m' = m || m2,
+//
+// we can compute
+//
+//	h' = MiMC(k || m || m2)
+//
+// without knowing k by computing
+//
+//	h' = MiMC(h || m2).
+//
+// This is because the MiMC hash function is a simple iterated cipher, and the
+// hash value is the state of the cipher after encrypting the message.
+//
+// There are several ways to mitigate this attack:
+//   - use a random key for each hash
+//   - use a domain separation tag for different use cases:
+//     h = MiMC(k || tag || m)
+//   - use the secret input as last input:
+//     h = MiMC(m || k)
+//
+// In general, inside a circuit the length-extension attack is not a concern as
+// due to the circuit definition the attacker can not append messages to
+// existing hash. But the user has to consider the cases when using a secret key
+// and MiMC in different contexts.
+//
+// # Hash input format
+//
+// The MiMC hash function is defined over a field. The input to the hash
+// function is a byte slice. The byte slice is interpreted as a sequence of
+// field elements. Due to this interpretation, the input byte slice length must
+// be multiple of the field modulus size. And every secuence of byte slice for a
+// single field element must be strictly less than the field modulus.
+package mimc
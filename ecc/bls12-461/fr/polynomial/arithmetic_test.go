@@ -0,0 +1,223 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr"
+)
+
+func randomPolynomial(size int) Polynomial {
+	p := make(Polynomial, size)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+func randomPoints(size int) []fr.Element {
+	return randomPolynomial(size)
+}
+
+func TestPolynomialMul(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, sizes := range [][2]int{
+		{1, 1}, {3, 7}, {20, 17}, {40, 100}, {8, 500}, {200, 300}, {513, 1024},
+	} {
+		a, b := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+		expected := mulSchoolbook(a, b)
+		assert.Equal(expected, mulKaratsuba(a, b), "karatsuba %v", sizes)
+		assert.Equal(expected, mulFFT(a, b), "fft %v", sizes)
+
+		var c Polynomial
+		c.Mul(a, b)
+		assert.Equal(expected, c, "mul %v", sizes)
+
+		// (ab)(x) = a(x)b(x)
+		var x, ax, bx fr.Element
+		x.SetRandom()
+		ax, bx = a.Eval(&x), b.Eval(&x)
+		ax.Mul(&ax, &bx)
+		abx := c.Eval(&x)
+		assert.True(abx.Equal(&ax))
+	}
+
+	var c Polynomial
+	c.Mul(Polynomial{}, randomPolynomial(3))
+	assert.Equal(0, len(c))
+}
+
+func TestPolynomialDivRem(t *testing.T) {
+	assert := require.New(t)
+
+	for _, sizes := range [][2]int{
+		{10, 3}, {3, 10}, {100, 1}, {1000, 300}, {1200, 600},
+	} {
+		a, b := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+		q, r, err := DivRem(a, b)
+		assert.NoError(err)
+		assert.Equal(sizes[1]-1, len(r))
+
+		// a = b·q + r
+		var bq, res Polynomial
+		bq.Mul(b, q)
+		res.Add(bq, r)
+		assert.Equal(trim(a), trim(res), "%v", sizes)
+	}
+
+	// leading zeros are ignored
+	b := append(randomPolynomial(3), make(Polynomial, 2)...)
+	_, r, err := DivRem(randomPolynomial(10), b)
+	assert.NoError(err)
+	assert.Equal(2, len(r))
+
+	_, _, err = DivRem(randomPolynomial(10), make(Polynomial, 3))
+	assert.ErrorIs(err, ErrDivisionByZero)
+}
+
+func TestDivideByVanishing(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 16
+	for _, size := range []int{5, 16, 17, 40, 100} {
+		p := randomPolynomial(size)
+		q, r := DivideByVanishing(p, n)
+
+		vanishing := make(Polynomial, n+1)
+		vanishing[0].SetOne()
+		vanishing[0].Neg(&vanishing[0])
+		vanishing[n].SetOne()
+		expectedQ, expectedR, err := DivRem(p, vanishing)
+		assert.NoError(err)
+		assert.Equal(trim(expectedQ), trim(q))
+		assert.Equal(trim(expectedR), trim(r))
+	}
+}
+
+func TestGCD(t *testing.T) {
+	assert := require.New(t)
+
+	// gcd(a·c, b·c) = c, c monic
+	a, b, c := randomPolynomial(20), randomPolynomial(15), randomPolynomial(7)
+	c[len(c)-1].SetOne()
+	var ac, bc Polynomial
+	ac.Mul(a, c)
+	bc.Mul(b, c)
+	assert.Equal(c, GCD(ac, bc))
+
+	assert.Equal(0, len(GCD(Polynomial{}, make(Polynomial, 2))))
+}
+
+func TestVanishing(t *testing.T) {
+	assert := require.New(t)
+
+	points := randomPoints(37)
+	v := Vanishing(points)
+	assert.Equal(len(points)+1, len(v))
+	assert.True(v[len(v)-1].IsOne())
+	for i := range points {
+		e := v.Eval(&points[i])
+		assert.True(e.IsZero())
+	}
+}
+
+func TestEvalMultiPoints(t *testing.T) {
+	assert := require.New(t)
+
+	for _, sizes := range [][2]int{
+		{10, 5}, {100, 200}, {300, 45}, {20, 100},
+	} {
+		p := randomPolynomial(sizes[0])
+		points := randomPoints(sizes[1])
+		values := p.EvalMultiPoints(points)
+		assert.Equal(len(points), len(values))
+		for i := range points {
+			assert.Equal(p.Eval(&points[i]), values[i], "%v", sizes)
+		}
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{1, 2, 13, 64, 300} {
+		xs, ys := randomPoints(size), randomPoints(size)
+		p, err := Interpolate(xs, ys)
+		assert.NoError(err)
+		assert.Equal(size, len(p))
+		assert.Equal(ys, p.EvalMultiPoints(xs))
+	}
+
+	xs := randomPoints(10)
+	xs[7] = xs[2]
+	_, err := Interpolate(xs, randomPoints(10))
+	assert.ErrorIs(err, ErrDuplicatePoints)
+
+	_, err = Interpolate(xs, randomPoints(9))
+	assert.ErrorIs(err, ErrSizeMismatch)
+}
+
+func TestBatchEval(t *testing.T) {
+	assert := require.New(t)
+
+	ps := make([]Polynomial, 10)
+	for i := range ps {
+		ps[i] = randomPolynomial(i)
+	}
+	var x fr.Element
+	x.SetRandom()
+	values := BatchEval(ps, &x)
+	for i := range ps {
+		var expected fr.Element
+		if len(ps[i]) != 0 {
+			expected = ps[i].Eval(&x)
+		}
+		assert.Equal(expected, values[i])
+	}
+}
+
+func BenchmarkPolynomialMul(b *testing.B) {
+	for _, size := range []int{32, 128, 1 << 12} {
+		p1, p2 := randomPolynomial(size), randomPolynomial(size)
+		b.Run("karatsuba/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = mulKaratsuba(p1, p2)
+			}
+		})
+		b.Run("fft/"+strconv.Itoa(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = mulFFT(p1, p2)
+			}
+		})
+	}
+}
+
+func BenchmarkInterpolate(b *testing.B) {
+	const size = 1 << 10
+	xs, ys := randomPoints(size), randomPoints(size)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Interpolate(xs, ys)
+	}
+}
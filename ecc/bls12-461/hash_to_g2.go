@@ -0,0 +1,205 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12461
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/internal/fptower"
+)
+
+// MapToCurve2 implements the Shallue and van de Woestijne method, applicable to any elliptic curve in Weierstrass form
+// No cofactor clearing or isogeny
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#straightline-svdw
+func MapToCurve2(u *fptower.E2) G2Affine {
+	var tv1, tv2, tv3, tv4 fptower.E2
+	var x1, x2, x3, gx1, gx2, gx, x, y fptower.E2
+	var one fptower.E2
+	var gx1NotSquare, gx1SquareOrGx2Not int
+
+	//constants
+	//c1 = g(Z)
+	//c2 = -Z / 2
+	//c3 = sqrt(-g(Z) * (3 * Z² + 4 * A))     # sgn0(c3) MUST equal 0
+	//c4 = -4 * g(Z) / (3 * Z² + 4 * A)
+
+	Z := fptower.E2{
+		A0: fp.Element{17579797838265581183, 13808010344119407615, 9622778195577192255, 8751095125298506632, 8083499387353651646, 9088017784187803242, 6052016441416596766, 5120},
+		A1: fp.Element{0},
+	}
+	c1 := fptower.E2{
+		A0: fp.Element{1812682302687628751, 13663790610823461205, 6853412827005696830, 3616401227826931370, 7315218123212615334, 2038808819173553051, 5682437977749801025, 3756},
+		A1: fp.Element{14977833223436892667, 18311531562574835711, 16769126206003346494, 4900074702194825185, 2895602420820486508, 8412797078854503503, 5774832593666499960, 4097},
+	}
+	c2 := fptower.E2{
+		A0: fp.Element{6582575460374631958, 2323870475875687253, 14181228726353600640, 641836737183946907, 16236936222513487203, 12410366166695251033, 9269569344813125275, 170},
+		A1: fp.Element{0},
+	}
+	c3 := fptower.E2{
+		A0: fp.Element{11502814005935413503, 16345921199768174566, 4753052090487768246, 16400562455226086965, 6605523403944882101, 18197151791024570301, 8347284789295849164, 2834},
+		A1: fp.Element{12906108004087384102, 12294928829734894210, 12123176466142575657, 15056644249398600392, 14924024694867750974, 14990195023240326443, 15484852183518881686, 3513},
+	}
+	c4 := fptower.E2{
+		A0: fp.Element{7831781818920369104, 12538195604632945095, 4615608947619159041, 14706737853689142642, 1280468773568393853, 17897596299593600857, 6764878797347843440, 2273},
+		A1: fp.Element{2576076640394384960, 6341207668964445753, 3692487158095327233, 697343838725583144, 1024375018854715083, 3250030595449149716, 12790600667362095399, 1818},
+	}
+
+	one.SetOne()
+
+	tv1.Square(u)       //    1.  tv1 = u²
+	tv1.Mul(&tv1, &c1)  //    2.  tv1 = tv1 * c1
+	tv2.Add(&one, &tv1) //    3.  tv2 = 1 + tv1
+	tv1.Sub(&one, &tv1) //    4.  tv1 = 1 - tv1
+	tv3.Mul(&tv1, &tv2) //    5.  tv3 = tv1 * tv2
+
+	tv3.Inverse(&tv3)   //    6.  tv3 = inv0(tv3)
+	tv4.Mul(u, &tv1)    //    7.  tv4 = u * tv1
+	tv4.Mul(&tv4, &tv3) //    8.  tv4 = tv4 * tv3
+	tv4.Mul(&tv4, &c3)  //    9.  tv4 = tv4 * c3
+	x1.Sub(&c2, &tv4)   //    10.  x1 = c2 - tv4
+
+	gx1.Square(&x1) //    11. gx1 = x1²
+	//12. gx1 = gx1 + A     All curves in gnark-crypto have A=0 (j-invariant=0). It is crucial to include this step if the curve has nonzero A coefficient.
+	gx1.Mul(&gx1, &x1)                 //    13. gx1 = gx1 * x1
+	gx1.Add(&gx1, &bTwistCurveCoeff)   //    14. gx1 = gx1 + B
+	gx1NotSquare = gx1.Legendre() >> 1 //    15.  e1 = is_square(gx1)
+	// gx1NotSquare = 0 if gx1 is a square, -1 otherwise
+
+	x2.Add(&c2, &tv4) //    16.  x2 = c2 + tv4
+	gx2.Square(&x2)   //    17. gx2 = x2²
+	//    18. gx2 = gx2 + A     See line 12
+	gx2.Mul(&gx2, &x2)               //    19. gx2 = gx2 * x2
+	gx2.Add(&gx2, &bTwistCurveCoeff) //    20. gx2 = gx2 + B
+
+	{
+		gx2NotSquare := gx2.Legendre() >> 1              // gx2Square = 0 if gx2 is a square, -1 otherwise
+		gx1SquareOrGx2Not = gx2NotSquare | ^gx1NotSquare //    21.  e2 = is_square(gx2) AND NOT e1   # Avoid short-circuit logic ops
+	}
+
+	x3.Square(&tv2)   //    22.  x3 = tv2²
+	x3.Mul(&x3, &tv3) //    23.  x3 = x3 * tv3
+	x3.Square(&x3)    //    24.  x3 = x3²
+	x3.Mul(&x3, &c4)  //    25.  x3 = x3 * c4
+
+	x3.Add(&x3, &Z)                  //    26.  x3 = x3 + Z
+	x.Select(gx1NotSquare, &x1, &x3) //    27.   x = CMOV(x3, x1, e1)   # x = x1 if gx1 is square, else x = x3
+	// Select x1 iff gx1 is square iff gx1NotSquare = 0
+	x.Select(gx1SquareOrGx2Not, &x2, &x) //    28.   x = CMOV(x, x2, e2)    # x = x2 if gx2 is square and gx1 is not
+	// Select x2 iff gx2 is square and gx1 is not, iff gx1SquareOrGx2Not = 0
+	gx.Square(&x) //    29.  gx = x²
+	//    30.  gx = gx + A
+
+	gx.Mul(&gx, &x)                //    31.  gx = gx * x
+	gx.Add(&gx, &bTwistCurveCoeff) //    32.  gx = gx + B
+
+	y.Sqrt(&gx)                             //    33.   y = sqrt(gx)
+	signsNotEqual := g2Sgn0(u) ^ g2Sgn0(&y) //    34.  e3 = sgn0(u) == sgn0(y)
+
+	tv1.Neg(&y)
+	y.Select(int(signsNotEqual), &y, &tv1) //    35.   y = CMOV(-y, y, e3)       # Select correct sign of y
+	return G2Affine{x, y}
+}
+
+// g2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-the-sgn0-function
+// The sign of an element is not obviously related to that of its Montgomery form
+func g2Sgn0(z *fptower.E2) uint64 {
+
+	nonMont := z.Bits()
+
+	sign := uint64(0) // 1. sign = 0
+	zero := uint64(1) // 2. zero = 1
+	var signI uint64
+	var zeroI uint64
+
+	// 3. i = 1
+	signI = nonMont.A0[0] % 2 // 4.   sign_i = x_i mod 2
+	zeroI = g1NotZero(&nonMont.A0)
+	zeroI = 1 ^ (zeroI|-zeroI)>>63 // 5.   zero_i = x_i == 0
+	sign = sign | (zero & signI)   // 6.   sign = sign OR (zero AND sign_i) # Avoid short-circuit logic ops
+	zero = zero & zeroI            // 7.   zero = zero AND zero_i
+	// 3. i = 2
+	signI = nonMont.A1[0] % 2 // 4.   sign_i = x_i mod 2
+	// 5.   zero_i = x_i == 0
+	sign = sign | (zero & signI) // 6.   sign = sign OR (zero AND sign_i) # Avoid short-circuit logic ops
+	// 7.   zero = zero AND zero_i
+	return sign
+
+}
+
+// MapToG2 invokes the SVDW map, and guarantees that the result is in g2
+func MapToG2(u fptower.E2) G2Affine {
+	res := MapToCurve2(&u)
+	res.ClearCofactor(&res)
+	return res
+}
+
+// EncodeToG2 hashes a message to a point on the G2 curve using the SVDW map.
+// It is faster than HashToG2, but the result is not uniformly distributed. Unsuitable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
+func EncodeToG2(msg, dst []byte) (G2Affine, error) {
+
+	var res G2Affine
+	u, err := fp.Hash(msg, dst, 2)
+	if err != nil {
+		return res, err
+	}
+
+	res = MapToCurve2(&fptower.E2{
+		A0: u[0],
+		A1: u[1],
+	})
+
+	res.ClearCofactor(&res)
+	return res, nil
+}
+
+// HashToG2 hashes a message to a point on the G2 curve using the SVDW map.
+// Slower than EncodeToG2, but usable as a random oracle.
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#roadmap
+func HashToG2(msg, dst []byte) (G2Affine, error) {
+	u, err := fp.Hash(msg, dst, 2*2)
+	if err != nil {
+		return G2Affine{}, err
+	}
+
+	Q0 := MapToCurve2(&fptower.E2{
+		A0: u[0],
+		A1: u[1],
+	})
+	Q1 := MapToCurve2(&fptower.E2{
+		A0: u[2+0],
+		A1: u[2+1],
+	})
+
+	var _Q0, _Q1 G2Jac
+	_Q0.FromAffine(&Q0)
+	_Q1.FromAffine(&Q1).AddAssign(&_Q0)
+
+	_Q1.ClearCofactor(&_Q1)
+
+	Q1.FromJacobian(&_Q1)
+	return Q1, nil
+}
+
+func g2NotZero(x *fptower.E2) uint64 {
+	//Assuming G1 is over Fp and that if hashing is available for G2, it also is for G1
+	return g1NotZero(&x.A0) | g1NotZero(&x.A1)
+
+}
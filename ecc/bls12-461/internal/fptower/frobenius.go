@@ -0,0 +1,289 @@
+// Copyright 2020 ConsenSys AG
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import "github.com/consensys/gnark-crypto/ecc/bls12-461/fp"
+
+// Frobenius set z to Frobenius(x), return z
+func (z *E12) Frobenius(x *E12) *E12 {
+	// Algorithm 28 from https://eprint.iacr.org/2010/354.pdf (beware typos!)
+	var t [6]E2
+
+	// Frobenius acts on fp2 by conjugation
+	t[0].Conjugate(&x.C0.B0)
+	t[1].Conjugate(&x.C0.B1)
+	t[2].Conjugate(&x.C0.B2)
+	t[3].Conjugate(&x.C1.B0)
+	t[4].Conjugate(&x.C1.B1)
+	t[5].Conjugate(&x.C1.B2)
+
+	t[1].MulByNonResidue1Power2(&t[1])
+	t[2].MulByNonResidue1Power4(&t[2])
+	t[3].MulByNonResidue1Power1(&t[3])
+	t[4].MulByNonResidue1Power3(&t[4])
+	t[5].MulByNonResidue1Power5(&t[5])
+
+	z.C0.B0 = t[0]
+	z.C0.B1 = t[1]
+	z.C0.B2 = t[2]
+	z.C1.B0 = t[3]
+	z.C1.B1 = t[4]
+	z.C1.B2 = t[5]
+
+	return z
+}
+
+// FrobeniusSquare set z to Frobenius^2(x), and return z
+func (z *E12) FrobeniusSquare(x *E12) *E12 {
+	// Algorithm 29 from https://eprint.iacr.org/2010/354.pdf (beware typos!)
+
+	z.C0.B0 = x.C0.B0
+	z.C0.B1.MulByNonResidue2Power2(&x.C0.B1)
+	z.C0.B2.MulByNonResidue2Power4(&x.C0.B2)
+	z.C1.B0.MulByNonResidue2Power1(&x.C1.B0)
+	z.C1.B1.MulByNonResidue2Power3(&x.C1.B1)
+	z.C1.B2.MulByNonResidue2Power5(&x.C1.B2)
+
+	return z
+}
+
+// MulByNonResidue1Power1 set z=x*(1,1)^(1*(p^1-1)/6) and return z
+func (z *E2) MulByNonResidue1Power1(x *E2) *E2 {
+	// (3515006319094941663135912580993104246467068465739176125644225113463647163240411904164862104889442725159760097491343617188434909441455893050,454502056405921807424859478153529805332988927346578200402298533522205332928786639829979179808270546578008146676909784050807872279284383857)
+	var b = E2{
+		A0: fp.Element{
+			6916162424140887465,
+			3147787715005610474,
+			10144725031195727727,
+			4863824832564726762,
+			15677203996326237329,
+			12799918598942930236,
+			18412287897027120565,
+			4078,
+		},
+		A1: fp.Element{
+			5382042261164406018,
+			15307963580865171648,
+			9393766543379114192,
+			5170943767101673685,
+			6433423762344837107,
+			2662087444925823457,
+			6178867234015726752,
+			1382,
+		},
+	}
+	z.Mul(x, &b)
+	return z
+}
+
+// MulByNonResidue1Power2 set z=x*(1,1)^(2*(p^1-1)/6) and return z
+func (z *E2) MulByNonResidue1Power2(x *E2) *E2 {
+	// (0,3969508375500863470560693255137176963423801865085792368101350438590870255442235963096294938597297659006567800256110934583382094451363719852)
+	var b = E2{
+		A0: fp.Element{
+			0,
+			0,
+			0,
+			0,
+			0,
+			0,
+			0,
+			0,
+		},
+		A1: fp.Element{
+			17112633202382341968,
+			10741592638915626051,
+			16367648812138481991,
+			10874975030476781285,
+			5839645603710615064,
+			12162025159626820187,
+			4257206939572915356,
+			992,
+		},
+	}
+	z.Mul(x, &b)
+	return z
+}
+
+// MulByNonResidue1Power3 set z=x*(1,1)^(3*(p^1-1)/6) and return z
+func (z *E2) MulByNonResidue1Power3(x *E2) *E2 {
+	// (1445297864802445409422579846626199325652483890088312147713545716490315497269736875016472928977938943841418768889895640471011349490671994179,1445297864802445409422579846626199325652483890088312147713545716490315497269736875016472928977938943841418768889895640471011349490671994179)
+	var b = E2{
+		A0: fp.Element{
+			14386715096158969797,
+			15791058470870513881,
+			12380805710968451272,
+			15474031233715378822,
+			12152325333111777469,
+			17763700450849884631,
+			9477379332587982675,
+			30,
+		},
+		A1: fp.Element{
+			14386715096158969797,
+			15791058470870513881,
+			12380805710968451272,
+			15474031233715378822,
+			12152325333111777469,
+			17763700450849884631,
+			9477379332587982675,
+			30,
+		},
+	}
+	z.Mul(x, &b)
+	return z
+}
+
+// MulByNonResidue1Power4 set z=x*(1,1)^(4*(p^1-1)/6) and return z
+func (z *E2) MulByNonResidue1Power4(x *E2) *E2 {
+	// 3969508375500863470560693255137176963423801865085792368101350438590870255442235963096294938597297659006567800256110934583382094451363719853
+	var b = fp.Element{
+		3947482281633078052,
+		6093851687164251545,
+		6451935433140832327,
+		9591301556108887470,
+		10259261306102743890,
+		5788036899945869735,
+		4164812323656216421,
+		651,
+	}
+	z.A0.Mul(&x.A0, &b)
+	z.A1.Mul(&x.A1, &b)
+	return z
+}
+
+// MulByNonResidue1Power5 set z=x*(1,1)^(5*(p^1-1)/6) and return z
+func (z *E2) MulByNonResidue1Power5(x *E2) *E2 {
+	// (990795808396523601997720368472669520319494962741733947311247182968110164340950235186493749169668397263410622212985856420203477211387610322,2978712567104339868563051690673964531480562430344020378735276464017742331828248308808347535528044874474357621955267544819039304509352666585)
+	var b = E2{
+		A0: fp.Element{
+			2856133446590305646,
+			492102112166572740,
+			4078786668454627384,
+			1891111992570553969,
+			9382785255728463183,
+			12116874976083263252,
+			9442923155905551625,
+			4109,
+		},
+		A1: fp.Element{
+			9442071238714987837,
+			17963649183704209382,
+			15459704906120214535,
+			8143656607095846478,
+			12727842502942611253,
+			3345131067785490441,
+			15148231975137295692,
+			1351,
+		},
+	}
+	z.Mul(x, &b)
+	return z
+}
+
+// 78804009457088376255527999961957945173208394982240726962580898546346100415612731200443912142466655860687269376557055
+var nonResidue2Power1 = fp.Element{
+	13632315556632503131,
+	7714158656955156070,
+	3170842762436359928,
+	17606537642899170778,
+	16270982154960459371,
+	3299980884241933506,
+	1887204117760380345,
+	4469,
+}
+
+// MulByNonResidue2Power1 set z=x*(1,1)^(1*(p^2-1)/6) and return z
+func (z *E2) MulByNonResidue2Power1(x *E2) *E2 {
+	z.A0.Mul(&x.A0, &nonResidue2Power1)
+	z.A1.Mul(&x.A1, &nonResidue2Power1)
+	return z
+}
+
+// 78804009457088376255527999961957945173208394982240726962580898546346100415612731200443912142466655860687269376557054
+var nonResidue2Power2 = fp.Element{
+	8350722403672215431,
+	12361899608706530577,
+	13086556141434009592,
+	443467043557512977,
+	11851366452568330546,
+	9673969143922883958,
+	1979598733677079280,
+	4810,
+}
+
+// MulByNonResidue2Power2 set z=x*(1,1)^(2*(p^2-1)/6) and return z
+func (z *E2) MulByNonResidue2Power2(x *E2) *E2 {
+	z.A0.Mul(&x.A0, &nonResidue2Power2)
+	z.A1.Mul(&x.A1, &nonResidue2Power2)
+	return z
+}
+
+// 3969508375500863470560772059146634051800057393085754326046523646985852496169198543994841284697713271737768244168253401239242781720740276906
+var nonResidue2Power3 = fp.Element{
+	13165150920749263916,
+	4647740951751374506,
+	9915713378997649664,
+	1283673474367893815,
+	14027128371317422790,
+	6373988259680950451,
+	92394615916698935,
+	341,
+}
+
+// MulByNonResidue2Power3 set z=x*(1,1)^(3*(p^2-1)/6) and return z
+func (z *E2) MulByNonResidue2Power3(x *E2) *E2 {
+	z.A0.Mul(&x.A0, &nonResidue2Power3)
+	z.A1.Mul(&x.A1, &nonResidue2Power3)
+	return z
+}
+
+// 3969508375500863470560693255137176963423801865085792368101350438590870255442235963096294938597297659006567800256110934583382094451363719852
+var nonResidue2Power4 = fp.Element{
+	17112633202382341968,
+	10741592638915626051,
+	16367648812138481991,
+	10874975030476781285,
+	5839645603710615064,
+	12162025159626820187,
+	4257206939572915356,
+	992,
+}
+
+// MulByNonResidue2Power4 set z=x*(1,1)^(4*(p^2-1)/6) and return z
+func (z *E2) MulByNonResidue2Power4(x *E2) *E2 {
+	z.A0.Mul(&x.A0, &nonResidue2Power4)
+	z.A1.Mul(&x.A1, &nonResidue2Power4)
+	return z
+}
+
+// 3969508375500863470560693255137176963423801865085792368101350438590870255442235963096294938597297659006567800256110934583382094451363719853
+var nonResidue2Power5 = fp.Element{
+	3947482281633078052,
+	6093851687164251545,
+	6451935433140832327,
+	9591301556108887470,
+	10259261306102743890,
+	5788036899945869735,
+	4164812323656216421,
+	651,
+}
+
+// MulByNonResidue2Power5 set z=x*(1,1)^(5*(p^2-1)/6) and return z
+func (z *E2) MulByNonResidue2Power5(x *E2) *E2 {
+	z.A0.Mul(&x.A0, &nonResidue2Power5)
+	z.A1.Mul(&x.A1, &nonResidue2Power5)
+	return z
+}
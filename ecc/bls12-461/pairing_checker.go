@@ -0,0 +1,157 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12461
+
+import (
+	"errors"
+	"io"
+)
+
+// fixedQLines are the lines of the Miller loop of a point of G2, as computed by
+// PrecomputeLines.
+type fixedQLines = [2][len(LoopCounter) - 1]LineEvaluationAff
+
+// ErrNbFixedQ is returned when the number of G1 points paired with the fixed G2
+// points of a PairingChecker doesn't match the number of fixed points.
+var ErrNbFixedQ = errors.New("the number of G1 points doesn't match the number of fixed G2 points")
+
+// PairingChecker computes products of pairings ∏ᵢ e(Pᵢ, Qᵢ) where some of the Qᵢ
+// are fixed points of G2, registered once, whose lines are precomputed.
+//
+// The other (variable) pairs are given at each call, and all the pairs share a
+// single Miller loop and final exponentiation. It is typically used by verifiers
+// whose keys (e.g. [γ]G₂ and [δ]G₂ for Groth16, [α]G₂ for KZG) are known in
+// advance.
+//
+// A PairingChecker is safe for concurrent use once all the fixed points are
+// registered.
+type PairingChecker struct {
+	fixedQ []G2Affine
+	lines  []fixedQLines
+}
+
+// NewPairingChecker returns a PairingChecker with the fixed points Q.
+func NewPairingChecker(Q ...G2Affine) *PairingChecker {
+	c := &PairingChecker{}
+	for i := range Q {
+		c.AddFixedQ(Q[i])
+	}
+	return c
+}
+
+// AddFixedQ registers Q as a fixed point and precomputes its lines. It returns the
+// index of Q, which is the index of the G1 point paired with it in MillerLoop.
+func (c *PairingChecker) AddFixedQ(Q G2Affine) int {
+	c.fixedQ = append(c.fixedQ, Q)
+	c.lines = append(c.lines, PrecomputeLines(Q))
+	return len(c.fixedQ) - 1
+}
+
+// NbFixedQ returns the number of fixed points.
+func (c *PairingChecker) NbFixedQ() int {
+	return len(c.fixedQ)
+}
+
+// FixedQ returns the i-th fixed point.
+func (c *PairingChecker) FixedQ(i int) G2Affine {
+	return c.fixedQ[i]
+}
+
+// MillerLoop computes the multi-Miller loop of the pairs (fixedP[i], FixedQ(i))
+// and (P[j], Q[j]), where len(fixedP) = NbFixedQ() and len(P) = len(Q).
+//
+// The fixed pairs use the precomputed lines, as in MillerLoopFixedQ, and the
+// variable pairs the projective lines, as in MillerLoop. All the pairs share a
+// single Miller loop.
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func (c *PairingChecker) MillerLoop(fixedP, P []G1Affine, Q []G2Affine) (GT, error) {
+	if len(fixedP) != len(c.fixedQ) {
+		return GT{}, ErrNbFixedQ
+	}
+	if len(P) != len(Q) || len(fixedP)+len(P) == 0 {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+	return millerLoopMixed(P, Q, fixedP, c.lines)
+}
+
+// Pair computes the reduced pairing ∏ᵢ e(fixedP[i], FixedQ(i)) ⋅ ∏ⱼ e(P[j], Q[j]).
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func (c *PairingChecker) Pair(fixedP, P []G1Affine, Q []G2Affine) (GT, error) {
+	f, err := c.MillerLoop(fixedP, P, Q)
+	if err != nil {
+		return GT{}, err
+	}
+	return FinalExponentiation(&f), nil
+}
+
+// Check returns true if ∏ᵢ e(fixedP[i], FixedQ(i)) ⋅ ∏ⱼ e(P[j], Q[j]) = 1.
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func (c *PairingChecker) Check(fixedP, P []G1Affine, Q []G2Affine) (bool, error) {
+	f, err := c.Pair(fixedP, P, Q)
+	if err != nil {
+		return false, err
+	}
+	var one GT
+	one.SetOne()
+	return f.Equal(&one), nil
+}
+
+// WriteTo writes the fixed points and their precomputed lines.
+func (c *PairingChecker) WriteTo(w io.Writer) (int64, error) {
+	enc := NewEncoder(w)
+	if err := enc.Encode(uint64(len(c.fixedQ))); err != nil {
+		return enc.BytesWritten(), err
+	}
+	for i := range c.fixedQ {
+		if err := enc.Encode(&c.fixedQ[i]); err != nil {
+			return enc.BytesWritten(), err
+		}
+		if err := enc.Encode(&c.lines[i]); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom reads the fixed points and their lines written by WriteTo. The points
+// are checked to be in G2, but the lines are not recomputed: they must come from a
+// trusted source.
+func (c *PairingChecker) ReadFrom(r io.Reader) (int64, error) {
+	dec := NewDecoder(r)
+	var n uint64
+	if err := dec.Decode(&n); err != nil {
+		return dec.BytesRead(), err
+	}
+	c.fixedQ = make([]G2Affine, 0, min(n, 1<<10))
+	c.lines = make([]fixedQLines, 0, min(n, 1<<10))
+	for i := uint64(0); i < n; i++ {
+		var Q G2Affine
+		var lines fixedQLines
+		if err := dec.Decode(&Q); err != nil {
+			return dec.BytesRead(), err
+		}
+		if err := dec.Decode(&lines); err != nil {
+			return dec.BytesRead(), err
+		}
+		c.fixedQ = append(c.fixedQ, Q)
+		c.lines = append(c.lines, lines)
+	}
+	return dec.BytesRead(), nil
+}
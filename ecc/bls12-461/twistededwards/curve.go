@@ -0,0 +1,72 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fr.Element
+	Cofactor fr.Element
+	Order    big.Int
+	Base     PointAffine
+}
+
+// GetEdwardsCurve returns the twisted Edwards curve on bls12-461/Fr
+func GetEdwardsCurve() CurveParams {
+	initOnce.Do(initCurveParams)
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&curveParams.A)
+	res.D.Set(&curveParams.D)
+	res.Cofactor.Set(&curveParams.Cofactor)
+	res.Order.Set(&curveParams.Order)
+	res.Base.Set(&curveParams.Base)
+
+	return res
+}
+
+var (
+	initOnce    sync.Once
+	curveParams CurveParams
+)
+
+func initCurveParams() {
+	curveParams.A.SetString("-1")
+	curveParams.D.SetString("12684952174626220506040436099186893752706393072872908005390223981622457925506295015640210670")
+	curveParams.Cofactor.SetString("4")
+	curveParams.Order.SetString("130370298600039725717573447759098645703162536006144525041469660254739662404379850676697040523", 10)
+
+	curveParams.Base.X.SetString("207955063574944033059683916730863806131088104231638720011308060102786694697977676982655725858")
+	curveParams.Base.Y.SetString("359738252716639465315801876460282652284991200253242165886979365863817733918390647254037300754")
+}
+
+// mulByA multiplies fr.Element by curveParams.A
+func mulByA(x *fr.Element) {
+	x.Neg(x)
+}
+
+// mulByACT multiplies x by a in constant time
+func mulByACT(x *fr.Element) {
+	x.NegCT(x)
+}
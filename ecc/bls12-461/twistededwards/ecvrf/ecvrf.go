@@ -0,0 +1,377 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecvrf
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/fr/mimc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/twistededwards"
+)
+
+var (
+	ErrInvalidProof     = errors.New("invalid proof")
+	ErrInvalidPublicKey = errors.New("invalid public key")
+	ErrInvalidEncoding  = errors.New("invalid encoding")
+	ErrInvalidInput     = errors.New("input must be a sequence of field elements")
+)
+
+const (
+	sizeFr    = fr.Bytes
+	sizePoint = sizeFr
+
+	// SizePublicKey is the size of the encoding of a public key
+	SizePublicKey = sizePoint
+	// SizePrivateKey is the size of the encoding of a private key
+	SizePrivateKey = sizeFr
+	// SizeProof is the size of a proof π = Γ || c || s
+	SizeProof = sizePoint + 2*sizeFr
+	// SizeHash is the size of the VRF output β, a field element
+	SizeHash = sizeFr
+)
+
+// domain separators of the hashes
+const (
+	domainEncodeToCurve = 0x01
+	domainChallenge     = 0x02
+	domainProofToHash   = 0x03
+)
+
+// PublicKey is a VRF public key Y = [x]B
+type PublicKey struct {
+	A twistededwards.PointAffine
+}
+
+// PrivateKey is a VRF private key x
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	params := twistededwards.GetEdwardsCurve()
+	// 128 extra bits make the bias of the reduction negligible
+	b := make([]byte, sizeFr+16)
+	var x big.Int
+	for x.Sign() == 0 {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		x.SetBytes(b).Mod(&x, &params.Order)
+	}
+
+	privateKey := new(PrivateKey)
+	x.FillBytes(privateKey.scalar[:])
+	privateKey.PublicKey.A.ScalarMultiplicationCT(&params.Base, &x)
+	return privateKey, nil
+}
+
+// Bytes returns the compressed encoding of the public key.
+func (pub *PublicKey) Bytes() []byte {
+	b := pub.A.Bytes()
+	return b[:]
+}
+
+// SetBytes sets pub from its compressed encoding, and checks that it is a valid
+// public key.
+func (pub *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	if err := setPoint(&pub.A, buf[:SizePublicKey]); err != nil {
+		return 0, err
+	}
+	if !isValidPublicKey(&pub.A) {
+		return 0, ErrInvalidPublicKey
+	}
+	return SizePublicKey, nil
+}
+
+// Bytes returns the big-endian encoding of the private scalar.
+func (privKey *PrivateKey) Bytes() []byte {
+	res := make([]byte, SizePrivateKey)
+	subtle.ConstantTimeCopy(1, res, privKey.scalar[:])
+	return res
+}
+
+// SetBytes sets privKey from the big-endian encoding of the private scalar, and
+// computes the public key.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizePrivateKey {
+		return 0, io.ErrShortBuffer
+	}
+	params := twistededwards.GetEdwardsCurve()
+	var x big.Int
+	x.SetBytes(buf[:SizePrivateKey])
+	if x.Sign() == 0 || x.Cmp(&params.Order) >= 0 {
+		return 0, ErrInvalidEncoding
+	}
+	copy(privKey.scalar[:], buf[:SizePrivateKey])
+	privKey.PublicKey.A.ScalarMultiplicationCT(&params.Base, &x)
+	return SizePrivateKey, nil
+}
+
+// Prove returns the proof π of the VRF output of alpha.
+func (privKey *PrivateKey) Prove(alpha []byte) ([]byte, error) {
+	params := twistededwards.GetEdwardsCurve()
+	var x big.Int
+	x.SetBytes(privKey.scalar[:])
+
+	// H = encode_to_curve(Y, α), Γ = [x]H
+	h, err := encodeToCurve(&privKey.PublicKey.A, alpha)
+	if err != nil {
+		return nil, err
+	}
+	var gamma twistededwards.PointAffine
+	gamma.ScalarMultiplicationCT(&h, &x)
+
+	// k = nonce(x, H), c = challenge(Y, H, Γ, [k]B, [k]H), s = k + c⋅x
+	k := nonce(privKey.scalar[:], &h)
+	var kB, kH twistededwards.PointAffine
+	kB.ScalarMultiplicationCT(&params.Base, k)
+	kH.ScalarMultiplicationCT(&h, k)
+	c, err := challenge(&privKey.PublicKey.A, &h, &gamma, &kB, &kH)
+	if err != nil {
+		return nil, err
+	}
+
+	var bc, s big.Int
+	c.BigInt(&bc)
+	s.Mul(&bc, &x).
+		Add(&s, k).
+		Mod(&s, &params.Order)
+	k.SetUint64(0)
+	x.SetUint64(0)
+
+	pi := make([]byte, 0, SizeProof)
+	bGamma := gamma.Bytes()
+	bC := c.Bytes()
+	pi = append(pi, bGamma[:]...)
+	pi = append(pi, bC[:]...)
+	pi = append(pi, s.FillBytes(make([]byte, sizeFr))...)
+	return pi, nil
+}
+
+// Verify checks the proof pi of the VRF output of alpha, and returns the output β.
+// It returns ErrInvalidProof if the proof is invalid.
+func (pub *PublicKey) Verify(pi, alpha []byte) ([]byte, error) {
+	if !isValidPublicKey(&pub.A) {
+		return nil, ErrInvalidPublicKey
+	}
+	var gamma twistededwards.PointAffine
+	var c fr.Element
+	var s big.Int
+	if err := decodeProof(pi, &gamma, &c, &s); err != nil {
+		return nil, err
+	}
+
+	h, err := encodeToCurve(&pub.A, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	// U = [s]B - [c]Y, V = [s]H - [c]Γ
+	params := twistededwards.GetEdwardsCurve()
+	var bc big.Int
+	c.BigInt(&bc)
+	var u, v, t twistededwards.PointAffine
+	u.ScalarMultiplication(&params.Base, &s)
+	t.ScalarMultiplication(&pub.A, &bc).Neg(&t)
+	u.Add(&u, &t)
+	v.ScalarMultiplication(&h, &s)
+	t.ScalarMultiplication(&gamma, &bc).Neg(&t)
+	v.Add(&v, &t)
+
+	expected, err := challenge(&pub.A, &h, &gamma, &u, &v)
+	if err != nil {
+		return nil, err
+	}
+	if !expected.Equal(&c) {
+		return nil, ErrInvalidProof
+	}
+	return proofToHash(&gamma)
+}
+
+// ProofToHash returns the VRF output β of the proof pi. It doesn't verify the
+// proof: the output must not be used before Verify succeeds.
+func ProofToHash(pi []byte) ([]byte, error) {
+	var gamma twistededwards.PointAffine
+	var c fr.Element
+	var s big.Int
+	if err := decodeProof(pi, &gamma, &c, &s); err != nil {
+		return nil, err
+	}
+	return proofToHash(&gamma)
+}
+
+// proofToHash returns β = MiMC(0x03, [cofactor]Γ)
+func proofToHash(gamma *twistededwards.PointAffine) ([]byte, error) {
+	params := twistededwards.GetEdwardsCurve()
+	var cofactor big.Int
+	params.Cofactor.BigInt(&cofactor)
+	var p twistededwards.PointAffine
+	p.ScalarMultiplication(gamma, &cofactor)
+
+	h := mimc.NewMiMC()
+	if err := writePoints(h, domainProofToHash, &p); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// decodeProof decodes π = Γ || c || s
+func decodeProof(pi []byte, gamma *twistededwards.PointAffine, c *fr.Element, s *big.Int) error {
+	if len(pi) != SizeProof {
+		return ErrInvalidProof
+	}
+	if err := setPoint(gamma, pi[:sizePoint]); err != nil {
+		return ErrInvalidProof
+	}
+	if err := c.SetBytesCanonical(pi[sizePoint : sizePoint+sizeFr]); err != nil {
+		return ErrInvalidProof
+	}
+	params := twistededwards.GetEdwardsCurve()
+	s.SetBytes(pi[sizePoint+sizeFr:])
+	if s.Cmp(&params.Order) >= 0 {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// encodeToCurve maps MiMC(0x01, Y, α, ctr) to the curve, for the smallest
+// counter ctr for which it is the y coordinate of a point, and returns this point
+// multiplied by the cofactor. Of the two x coordinates, the one which is not
+// lexicographically largest is chosen.
+func encodeToCurve(y *twistededwards.PointAffine, alpha []byte) (twistededwards.PointAffine, error) {
+	if len(alpha)%sizeFr != 0 {
+		return twistededwards.PointAffine{}, ErrInvalidInput
+	}
+	params := twistededwards.GetEdwardsCurve()
+	var cofactor big.Int
+	params.Cofactor.BigInt(&cofactor)
+
+	h := mimc.NewMiMC()
+	var one, num, den fr.Element
+	one.SetOne()
+	for ctr := 0; ctr < 256; ctr++ {
+		h.Reset()
+		if err := writePoints(h, domainEncodeToCurve, y); err != nil {
+			return twistededwards.PointAffine{}, err
+		}
+		if _, err := h.Write(alpha); err != nil {
+			return twistededwards.PointAffine{}, err
+		}
+		if _, err := h.Write([]byte{byte(ctr)}); err != nil {
+			return twistededwards.PointAffine{}, err
+		}
+		var p twistededwards.PointAffine
+		p.Y.SetBytes(h.Sum(nil))
+
+		// x² = (1 - y²) / (a - d⋅y²)
+		num.Square(&p.Y)
+		den.Mul(&num, &params.D)
+		num.Sub(&one, &num)
+		den.Sub(&params.A, &den)
+		if den.IsZero() {
+			continue
+		}
+		num.Div(&num, &den)
+		if num.Legendre() != 1 {
+			continue
+		}
+		p.X.Sqrt(&num)
+		if p.X.LexicographicallyLargest() {
+			p.X.Neg(&p.X)
+		}
+		p.ScalarMultiplication(&p, &cofactor)
+		if !p.IsZero() {
+			return p, nil
+		}
+	}
+	return twistededwards.PointAffine{}, errors.New("failed to encode to the curve")
+}
+
+// challenge returns MiMC(0x02, P₁, …, P₅)
+func challenge(points ...*twistededwards.PointAffine) (fr.Element, error) {
+	h := mimc.NewMiMC()
+	if err := writePoints(h, domainChallenge, points...); err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(h.Sum(nil))
+	return res, nil
+}
+
+// nonce returns SHA-512(x || H) mod the order of the prime subgroup, as the
+// nonces of RFC 9381 (section 5.4.2.2) and eddsa. It is computed natively only.
+func nonce(x []byte, h *twistededwards.PointAffine) *big.Int {
+	params := twistededwards.GetEdwardsCurve()
+	bH := h.Bytes()
+	hFunc := sha512.New()
+	hFunc.Write(x)
+	hFunc.Write(bH[:])
+	res := new(big.Int).SetBytes(hFunc.Sum(nil))
+	res.Mod(res, &params.Order)
+	if res.Sign() == 0 {
+		res.SetUint64(1)
+	}
+	return res
+}
+
+// writePoints writes the domain separator and the coordinates of the points to h
+func writePoints(h hash.Hash, domain byte, points ...*twistededwards.PointAffine) error {
+	if _, err := h.Write([]byte{domain}); err != nil {
+		return err
+	}
+	for _, p := range points {
+		x := p.X.Bytes()
+		y := p.Y.Bytes()
+		if _, err := h.Write(x[:]); err != nil {
+			return err
+		}
+		if _, err := h.Write(y[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setPoint sets p from its compressed encoding, and checks that the encoding is
+// canonical and p is on the curve.
+func setPoint(p *twistededwards.PointAffine, buf []byte) error {
+	if _, err := p.SetBytes(buf); err != nil {
+		return ErrInvalidEncoding
+	}
+	b := p.Bytes()
+	if !p.IsOnCurve() || subtle.ConstantTimeCompare(b[:], buf) != 1 {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
+
+// isValidPublicKey returns true if y is on the curve, in the prime subgroup and
+// not the identity
+func isValidPublicKey(y *twistededwards.PointAffine) bool {
+	return y.IsInSubGroup() && !y.IsZero()
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package eddsa provides EdDSA signature scheme on bls12-461's twisted edwards curve.
+//
+// Key generation and signing compute the multiples of the base point by the
+// private scalar and the nonce with the constant-time scalar multiplication
+// (ScalarMultiplicationCT). The response s = r + H(R,A,M)⋅a is computed modulo
+// the order of the subgroup with math/big, which is not constant time: it may
+// leak information on a and r through timing.
+//
+// # See also
+//
+// https://en.wikipedia.org/wiki/EdDSA
+package eddsa
@@ -0,0 +1,869 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, ffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c516b201a3)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_111     = _10 + _101
+	//	_1001    = _10 + _111
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_1111000 = _1111 << 3
+	//	_1111111 = _111 + _1111000
+	//	x10      = _1111111 << 3 + _111
+	//	x17      = x10 << 7 + _1111111
+	//	x24      = x17 << 7 + _1111111
+	//	i78      = ((x24 << 18 + x17) << 11 + _11) << 15
+	//	i99      = ((1 + i78) << 11 + x10) << 7 + 1
+	//	i126     = ((i99 << 8 + _1111111) << 2 + 1) << 15
+	//	i148     = ((_111 + i126) << 2 + 1) << 17 + _1111111
+	//	i182     = ((i148 << 11 + _11) << 16 + _111) << 5
+	//	i195     = ((_1101 + i182) << 5 + _1011) << 5 + _1001
+	//	i213     = ((i195 << 6 + _111) << 5 + _1111) << 5
+	//	i226     = ((_1111 + i213) << 3 + 1) << 7 + _1101
+	//	i244     = ((2*i226 + 1) << 10 + _1111) << 5
+	//	i256     = ((_11 + i244) << 4 + _11) << 5 + _11
+	//	i272     = ((i256 << 3 + 1) << 8 + _1001) << 3
+	//	i286     = ((_11 + i272) << 4 + _11) << 7 + _1111
+	//	i306     = ((i286 << 5 + _1111) << 4 + _111) << 9
+	//	i322     = ((_111 + i306) << 6 + _101) << 7 + _1011
+	//	i344     = ((i322 << 5 + _1011) << 3 + 1) << 12
+	//	return     (_1101 + i344) << 5 + _11
+	//
+	// Operations: 301 squares 50 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+		t8 = new(Element)
+		t9 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t4)
+
+	// Step 3: t2 = x^0x5
+	t2.Mul(t4, z)
+
+	// Step 4: t3 = x^0x7
+	t3.Mul(t4, t2)
+
+	// Step 5: t5 = x^0x9
+	t5.Mul(t4, t3)
+
+	// Step 6: t1 = x^0xb
+	t1.Mul(t4, t5)
+
+	// Step 7: t0 = x^0xd
+	t0.Mul(t4, t1)
+
+	// Step 8: t4 = x^0xf
+	t4.Mul(t4, t0)
+
+	// Step 11: t6 = x^0x78
+	t6.Square(t4)
+	for s := 1; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 12: t6 = x^0x7f
+	t6.Mul(t3, t6)
+
+	// Step 15: t7 = x^0x3f8
+	t7.Square(t6)
+	for s := 1; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 16: t7 = x^0x3ff
+	t7.Mul(t3, t7)
+
+	// Step 23: t8 = x^0x1ff80
+	t8.Square(t7)
+	for s := 1; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 24: t8 = x^0x1ffff
+	t8.Mul(t6, t8)
+
+	// Step 31: t9 = x^0xffff80
+	t9.Square(t8)
+	for s := 1; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 32: t9 = x^0xffffff
+	t9.Mul(t6, t9)
+
+	// Step 50: t9 = x^0x3fffffc0000
+	for s := 0; s < 18; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 51: t8 = x^0x3fffffdffff
+	t8.Mul(t8, t9)
+
+	// Step 62: t8 = x^0x1fffffeffff800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 63: t8 = x^0x1fffffeffff803
+	t8.Mul(z, t8)
+
+	// Step 78: t8 = x^0xffffff7fffc018000
+	for s := 0; s < 15; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 79: t8 = x^0xffffff7fffc018001
+	t8.Mul(&x, t8)
+
+	// Step 90: t8 = x^0x7fffffbfffe00c000800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 91: t7 = x^0x7fffffbfffe00c000bff
+	t7.Mul(t7, t8)
+
+	// Step 98: t7 = x^0x3fffffdffff0060005ff80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 99: t7 = x^0x3fffffdffff0060005ff81
+	t7.Mul(&x, t7)
+
+	// Step 107: t7 = x^0x3fffffdffff0060005ff8100
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 108: t7 = x^0x3fffffdffff0060005ff817f
+	t7.Mul(t6, t7)
+
+	// Step 110: t7 = x^0xffffff7fffc0180017fe05fc
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 111: t7 = x^0xffffff7fffc0180017fe05fd
+	t7.Mul(&x, t7)
+
+	// Step 126: t7 = x^0x7fffffbfffe00c000bff02fe8000
+	for s := 0; s < 15; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 127: t7 = x^0x7fffffbfffe00c000bff02fe8007
+	t7.Mul(t3, t7)
+
+	// Step 129: t7 = x^0x1fffffeffff8030002ffc0bfa001c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 130: t7 = x^0x1fffffeffff8030002ffc0bfa001d
+	t7.Mul(&x, t7)
+
+	// Step 147: t7 = x^0x3fffffdffff0060005ff817f4003a0000
+	for s := 0; s < 17; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 148: t6 = x^0x3fffffdffff0060005ff817f4003a007f
+	t6.Mul(t6, t7)
+
+	// Step 159: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f800
+	for s := 0; s < 11; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 160: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f803
+	t6.Mul(z, t6)
+
+	// Step 176: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f8030000
+	for s := 0; s < 16; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 177: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f8030007
+	t6.Mul(t3, t6)
+
+	// Step 182: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 183: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed
+	t6.Mul(t0, t6)
+
+	// Step 188: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001da0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 189: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab
+	t6.Mul(t1, t6)
+
+	// Step 194: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b560
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 195: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b569
+	t6.Mul(t5, t6)
+
+	// Step 201: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a40
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 202: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a47
+	t6.Mul(t3, t6)
+
+	// Step 207: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 208: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef
+	t6.Mul(t4, t6)
+
+	// Step 213: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691de0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 214: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def
+	t6.Mul(t4, t6)
+
+	// Step 217: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef78
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 218: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef79
+	t6.Mul(&x, t6)
+
+	// Step 225: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 226: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d
+	t6.Mul(t0, t6)
+
+	// Step 227: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791a
+	t6.Square(t6)
+
+	// Step 228: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b
+	t6.Mul(&x, t6)
+
+	// Step 238: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c00
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 239: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f
+	t6.Mul(t4, t6)
+
+	// Step 244: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 245: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e3
+	t6.Mul(z, t6)
+
+	// Step 249: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e30
+	for s := 0; s < 4; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 250: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e33
+	t6.Mul(z, t6)
+
+	// Step 255: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c660
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 256: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663
+	t6.Mul(z, t6)
+
+	// Step 259: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e3318
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 260: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e3319
+	t6.Mul(&x, t6)
+
+	// Step 268: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331900
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 269: t5 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331909
+	t5.Mul(t5, t6)
+
+	// Step 272: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c848
+	for s := 0; s < 3; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 273: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b
+	t5.Mul(z, t5)
+
+	// Step 277: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b0
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 278: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b3
+	t5.Mul(z, t5)
+
+	// Step 285: t5 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc6425980
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 286: t5 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f
+	t5.Mul(t4, t5)
+
+	// Step 291: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31e0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 292: t4 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef
+	t4.Mul(t4, t5)
+
+	// Step 296: t4 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef0
+	for s := 0; s < 4; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 297: t4 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef7
+	t4.Mul(t3, t4)
+
+	// Step 306: t4 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331909663dee00
+	for s := 0; s < 9; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 307: t3 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331909663dee07
+	t3.Mul(t3, t4)
+
+	// Step 313: t3 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c0
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 314: t2 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c5
+	t2.Mul(t2, t3)
+
+	// Step 321: t2 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e280
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 322: t2 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b
+	t2.Mul(t1, t2)
+
+	// Step 327: t2 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c5160
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 328: t1 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c516b
+	t1.Mul(t1, t2)
+
+	// Step 331: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b58
+	for s := 0; s < 3; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 332: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b59
+	t1.Mul(&x, t1)
+
+	// Step 344: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b59000
+	for s := 0; s < 12; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 345: t0 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b5900d
+	t0.Mul(t0, t1)
+
+	// Step 350: t0 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c516b201a0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 351: z = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c516b201a3
+	z.Mul(z, t0)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef7038a2d640345)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_111     = _10 + _101
+	//	_1001    = _10 + _111
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_1111000 = _1111 << 3
+	//	_1111111 = _111 + _1111000
+	//	x10      = _1111111 << 3 + _111
+	//	x17      = x10 << 7 + _1111111
+	//	x24      = x17 << 7 + _1111111
+	//	i78      = ((x24 << 18 + x17) << 11 + _11) << 15
+	//	i99      = ((1 + i78) << 11 + x10) << 7 + 1
+	//	i126     = ((i99 << 8 + _1111111) << 2 + 1) << 15
+	//	i148     = ((_111 + i126) << 2 + 1) << 17 + _1111111
+	//	i182     = ((i148 << 11 + _11) << 16 + _111) << 5
+	//	i195     = ((_1101 + i182) << 5 + _1011) << 5 + _1001
+	//	i213     = ((i195 << 6 + _111) << 5 + _1111) << 5
+	//	i226     = ((_1111 + i213) << 3 + 1) << 7 + _1101
+	//	i244     = ((2*i226 + 1) << 10 + _1111) << 5
+	//	i256     = ((_11 + i244) << 4 + _11) << 5 + _11
+	//	i272     = ((i256 << 3 + 1) << 8 + _1001) << 3
+	//	i286     = ((_11 + i272) << 4 + _11) << 7 + _1111
+	//	i306     = ((i286 << 5 + _1111) << 4 + _111) << 9
+	//	i322     = ((_111 + i306) << 6 + _101) << 7 + _1011
+	//	i344     = ((i322 << 5 + _1011) << 3 + 1) << 12
+	//	return     (_1101 + i344) << 6 + _101
+	//
+	// Operations: 302 squares 50 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+		t8 = new(Element)
+		t9 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9 Element
+	// Step 1: t3 = x^0x2
+	t3.Square(&x)
+
+	// Step 2: t4 = x^0x3
+	t4.Mul(&x, t3)
+
+	// Step 3: z = x^0x5
+	z.Mul(t3, t4)
+
+	// Step 4: t2 = x^0x7
+	t2.Mul(t3, z)
+
+	// Step 5: t5 = x^0x9
+	t5.Mul(t3, t2)
+
+	// Step 6: t1 = x^0xb
+	t1.Mul(t3, t5)
+
+	// Step 7: t0 = x^0xd
+	t0.Mul(t3, t1)
+
+	// Step 8: t3 = x^0xf
+	t3.Mul(t3, t0)
+
+	// Step 11: t6 = x^0x78
+	t6.Square(t3)
+	for s := 1; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 12: t6 = x^0x7f
+	t6.Mul(t2, t6)
+
+	// Step 15: t7 = x^0x3f8
+	t7.Square(t6)
+	for s := 1; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 16: t7 = x^0x3ff
+	t7.Mul(t2, t7)
+
+	// Step 23: t8 = x^0x1ff80
+	t8.Square(t7)
+	for s := 1; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 24: t8 = x^0x1ffff
+	t8.Mul(t6, t8)
+
+	// Step 31: t9 = x^0xffff80
+	t9.Square(t8)
+	for s := 1; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 32: t9 = x^0xffffff
+	t9.Mul(t6, t9)
+
+	// Step 50: t9 = x^0x3fffffc0000
+	for s := 0; s < 18; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 51: t8 = x^0x3fffffdffff
+	t8.Mul(t8, t9)
+
+	// Step 62: t8 = x^0x1fffffeffff800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 63: t8 = x^0x1fffffeffff803
+	t8.Mul(t4, t8)
+
+	// Step 78: t8 = x^0xffffff7fffc018000
+	for s := 0; s < 15; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 79: t8 = x^0xffffff7fffc018001
+	t8.Mul(&x, t8)
+
+	// Step 90: t8 = x^0x7fffffbfffe00c000800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 91: t7 = x^0x7fffffbfffe00c000bff
+	t7.Mul(t7, t8)
+
+	// Step 98: t7 = x^0x3fffffdffff0060005ff80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 99: t7 = x^0x3fffffdffff0060005ff81
+	t7.Mul(&x, t7)
+
+	// Step 107: t7 = x^0x3fffffdffff0060005ff8100
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 108: t7 = x^0x3fffffdffff0060005ff817f
+	t7.Mul(t6, t7)
+
+	// Step 110: t7 = x^0xffffff7fffc0180017fe05fc
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 111: t7 = x^0xffffff7fffc0180017fe05fd
+	t7.Mul(&x, t7)
+
+	// Step 126: t7 = x^0x7fffffbfffe00c000bff02fe8000
+	for s := 0; s < 15; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 127: t7 = x^0x7fffffbfffe00c000bff02fe8007
+	t7.Mul(t2, t7)
+
+	// Step 129: t7 = x^0x1fffffeffff8030002ffc0bfa001c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 130: t7 = x^0x1fffffeffff8030002ffc0bfa001d
+	t7.Mul(&x, t7)
+
+	// Step 147: t7 = x^0x3fffffdffff0060005ff817f4003a0000
+	for s := 0; s < 17; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 148: t6 = x^0x3fffffdffff0060005ff817f4003a007f
+	t6.Mul(t6, t7)
+
+	// Step 159: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f800
+	for s := 0; s < 11; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 160: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f803
+	t6.Mul(t4, t6)
+
+	// Step 176: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f8030000
+	for s := 0; s < 16; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 177: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f8030007
+	t6.Mul(t2, t6)
+
+	// Step 182: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 183: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed
+	t6.Mul(t0, t6)
+
+	// Step 188: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001da0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 189: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab
+	t6.Mul(t1, t6)
+
+	// Step 194: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b560
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 195: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b569
+	t6.Mul(t5, t6)
+
+	// Step 201: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a40
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 202: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a47
+	t6.Mul(t2, t6)
+
+	// Step 207: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 208: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef
+	t6.Mul(t3, t6)
+
+	// Step 213: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691de0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 214: t6 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def
+	t6.Mul(t3, t6)
+
+	// Step 217: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef78
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 218: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef79
+	t6.Mul(&x, t6)
+
+	// Step 225: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 226: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d
+	t6.Mul(t0, t6)
+
+	// Step 227: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791a
+	t6.Square(t6)
+
+	// Step 228: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b
+	t6.Mul(&x, t6)
+
+	// Step 238: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c00
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 239: t6 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f
+	t6.Mul(t3, t6)
+
+	// Step 244: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 245: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e3
+	t6.Mul(t4, t6)
+
+	// Step 249: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e30
+	for s := 0; s < 4; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 250: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e33
+	t6.Mul(t4, t6)
+
+	// Step 255: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c660
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 256: t6 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663
+	t6.Mul(t4, t6)
+
+	// Step 259: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e3318
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 260: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e3319
+	t6.Mul(&x, t6)
+
+	// Step 268: t6 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331900
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 269: t5 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331909
+	t5.Mul(t5, t6)
+
+	// Step 272: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c848
+	for s := 0; s < 3; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 273: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b
+	t5.Mul(t4, t5)
+
+	// Step 277: t5 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b0
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 278: t4 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b3
+	t4.Mul(t4, t5)
+
+	// Step 285: t4 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc6425980
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 286: t4 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f
+	t4.Mul(t3, t4)
+
+	// Step 291: t4 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31e0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 292: t3 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef
+	t3.Mul(t3, t4)
+
+	// Step 296: t3 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef0
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 297: t3 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef7
+	t3.Mul(t2, t3)
+
+	// Step 306: t3 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331909663dee00
+	for s := 0; s < 9; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 307: t2 = x^0x3fffffdffff0060005ff817f4003a007f006000ed5a477bc8d81e331909663dee07
+	t2.Mul(t2, t3)
+
+	// Step 313: t2 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c0
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 314: t2 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c5
+	t2.Mul(z, t2)
+
+	// Step 321: t2 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e280
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 322: t2 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b
+	t2.Mul(t1, t2)
+
+	// Step 327: t2 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c5160
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 328: t1 = x^0xffffff7fffc0180017fe05fd000e801fc018003b5691def236078cc642598f7b81c516b
+	t1.Mul(t1, t2)
+
+	// Step 331: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b58
+	for s := 0; s < 3; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 332: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b59
+	t1.Mul(&x, t1)
+
+	// Step 344: t1 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b59000
+	for s := 0; s < 12; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 345: t0 = x^0x7fffffbfffe00c000bff02fe8007400fe00c001dab48ef791b03c663212cc7bdc0e28b5900d
+	t0.Mul(t0, t1)
+
+	// Step 351: t0 = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef7038a2d640340
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 352: z = x^0x1fffffeffff8030002ffc0bfa001d003f80300076ad23bde46c0f198c84b31ef7038a2d640345
+	z.Mul(z, t0)
+
+	return z
+}
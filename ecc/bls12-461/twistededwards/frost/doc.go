@@ -0,0 +1,45 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package frost provides FROST threshold Schnorr signatures on bls12-461's twistededwards curve.
+//
+// A group of n participants holds Shamir shares of a secret key, so that any
+// t of them can sign together while fewer learn nothing about the key. The
+// shares are dealt by a trusted dealer (GenerateWithDealer) or generated
+// without any trusted party with a distributed key generation (DKGParticipant).
+// Every participant checks its share against the public commitment of the
+// polynomial (Feldman's VSS).
+//
+// Signing takes two rounds: the signers first publish commitments to single-use
+// nonces (KeyShare.Commit), then each computes a signature share
+// (KeyShare.Sign) which a coordinator checks and aggregates (Aggregate).
+//
+// The signatures have the encoding and the verification equation of the eddsa
+// package, with the same challenge H(R, A, M) where H is chosen by the caller
+// (e.g. MiMC or SHA-256). They verify with eddsa.PublicKey.Verify, A being
+// the group public key, or equivalently with Verify.
+//
+// The secret scalars (key shares, nonces and signature shares) are elements of
+// the scalar field fr of the curve, and the signers compute with them in
+// constant time. The nonces are derived as in nonce_generate of RFC 9591.
+//
+// RFC 9591 only specifies ciphersuites on Ed25519, ristretto255, Ed448, P-256
+// and secp256k1, with their own challenge and hash functions, so its test
+// vectors don't apply to this curve.
+//
+// See https://www.rfc-editor.org/rfc/rfc9591 (FROST) and
+// https://eprint.iacr.org/2020/852.pdf for a description of the protocol.
+package frost
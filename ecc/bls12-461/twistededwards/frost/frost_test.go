@@ -0,0 +1,239 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package frost
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"crypto/sha256"
+	stdhash "hash"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	fp "github.com/consensys/gnark-crypto/ecc/bls12-461/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/twistededwards/eddsa"
+	"github.com/consensys/gnark-crypto/ecc/bls12-461/twistededwards/fr"
+	"github.com/consensys/gnark-crypto/hash"
+)
+
+// sign runs the two rounds of signing with the given signers
+func sign(t *testing.T, shares []KeyShare, signers []int, message []byte, hFunc stdhash.Hash) ([]SigningCommitment, []SignatureShare) {
+	nonces := make([]*SigningNonce, len(signers))
+	commitments := make([]SigningCommitment, len(signers))
+	for i, s := range signers {
+		var err error
+		nonces[i], err = shares[s].Commit(crand.Reader)
+		require.NoError(t, err)
+		commitments[i] = nonces[i].Commitment
+	}
+	sigShares := make([]SignatureShare, len(signers))
+	for i, s := range signers {
+		var err error
+		sigShares[i], err = shares[s].Sign(message, nonces[i], commitments, hFunc)
+		require.NoError(t, err)
+		require.NoError(t, VerifySignatureShare(shares[s].Commitment, message, commitments, &sigShares[i], hFunc))
+	}
+	return commitments, sigShares
+}
+
+func testMessage(t *testing.T) []byte {
+	// MiMC hashes elements of the base field
+	var msg fp.Element
+	_, err := msg.SetRandom()
+	require.NoError(t, err)
+	return msg.Marshal()
+}
+
+func TestSignWithDealer(t *testing.T) {
+	const threshold, n = 3, 5
+	shares, err := GenerateWithDealer(crand.Reader, threshold, n)
+	require.NoError(t, err)
+	for i := range shares {
+		require.NoError(t, shares[i].Verify())
+	}
+	groupKey := shares[0].GroupKey()
+
+	for name, hFunc := range map[string]stdhash.Hash{
+		"mimc":    hash.MIMC_BLS12_461.New(),
+		"sha-256": sha256.New(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			message := testMessage(t)
+			for _, signers := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 2, 3, 4}, {0, 1, 2, 3, 4}} {
+				commitments, sigShares := sign(t, shares, signers, message, hFunc)
+				sig, err := Aggregate(shares[0].Commitment, message, commitments, sigShares, hFunc)
+				require.NoError(t, err)
+
+				ok, err := Verify(&groupKey, sig, message, hFunc)
+				require.NoError(t, err)
+				require.True(t, ok)
+
+				// the signature is an eddsa signature for the group key
+				pub := eddsa.PublicKey{A: groupKey}
+				ok, err = pub.Verify(sig, message, hFunc)
+				require.NoError(t, err)
+				require.True(t, ok)
+
+				ok, err = Verify(&groupKey, sig, testMessage(t), hFunc)
+				require.NoError(t, err)
+				require.False(t, ok)
+			}
+		})
+	}
+}
+
+func TestDKG(t *testing.T) {
+	const threshold, n = 2, 4
+	participants := make([]*DKGParticipant, n)
+	round1 := make([]DKGRound1Message, n)
+	for i := range participants {
+		var err error
+		participants[i], round1[i], err = NewDKGParticipant(crand.Reader, uint32(i+1), threshold, n)
+		require.NoError(t, err)
+	}
+
+	t.Run("invalid proof of knowledge", func(t *testing.T) {
+		tampered := append([]DKGRound1Message{}, round1...)
+		tampered[2].ProofZ.Add(&round1[2].ProofZ, &round1[1].ProofZ)
+		_, err := participants[0].Round2(tampered)
+		require.ErrorIs(t, err, ErrInvalidProofOfKnowledge)
+	})
+
+	t.Run("missing message", func(t *testing.T) {
+		_, err := participants[0].Round2(round1[1:])
+		require.ErrorIs(t, err, ErrInvalidDKGMessages)
+	})
+
+	var round2 []DKGRound2Message
+	for i := range participants {
+		msgs, err := participants[i].Round2(round1)
+		require.NoError(t, err)
+		require.Len(t, msgs, n-1)
+		round2 = append(round2, msgs...)
+	}
+
+	t.Run("invalid share", func(t *testing.T) {
+		tampered := append([]DKGRound2Message{}, round2...)
+		for i := range tampered {
+			if tampered[i].To == 1 {
+				tampered[i].Share.Double(&round2[i].Share)
+				break
+			}
+		}
+		_, err := participants[0].Finalize(round1, tampered)
+		require.ErrorIs(t, err, ErrInvalidShare)
+	})
+
+	shares := make([]KeyShare, n)
+	for i := range participants {
+		share, err := participants[i].Finalize(round1, round2)
+		require.NoError(t, err)
+		require.NoError(t, share.Verify())
+		shares[i] = *share
+	}
+	groupKey := shares[0].GroupKey()
+	for i := range shares {
+		require.Equal(t, shares[0].Commitment, shares[i].Commitment)
+	}
+
+	hFunc := sha256.New()
+	message := []byte("frost dkg")
+	commitments, sigShares := sign(t, shares, []int{3, 1}, message, hFunc)
+	sig, err := Aggregate(shares[0].Commitment, message, commitments, sigShares, hFunc)
+	require.NoError(t, err)
+	ok, err := Verify(&groupKey, sig, message, hFunc)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestInvalidSigning(t *testing.T) {
+	const threshold, n = 3, 4
+	shares, err := GenerateWithDealer(crand.Reader, threshold, n)
+	require.NoError(t, err)
+	hFunc := sha256.New()
+	message := []byte("frost")
+
+	t.Run("invalid key share", func(t *testing.T) {
+		share := shares[1]
+		share.Secret.Add(&shares[1].Secret, &shares[0].Secret)
+		require.ErrorIs(t, share.Verify(), ErrInvalidShare)
+	})
+
+	t.Run("not enough signers", func(t *testing.T) {
+		nonce, err := shares[0].Commit(crand.Reader)
+		require.NoError(t, err)
+		other, err := shares[1].Commit(crand.Reader)
+		require.NoError(t, err)
+		_, err = shares[0].Sign(message, nonce, []SigningCommitment{nonce.Commitment, other.Commitment}, hFunc)
+		require.ErrorIs(t, err, ErrNotEnoughSigners)
+	})
+
+	t.Run("nonce reuse", func(t *testing.T) {
+		nonces := make([]*SigningNonce, threshold)
+		commitments := make([]SigningCommitment, threshold)
+		for i := range nonces {
+			nonces[i], err = shares[i].Commit(crand.Reader)
+			require.NoError(t, err)
+			commitments[i] = nonces[i].Commitment
+		}
+		_, err = shares[0].Sign(message, nonces[0], commitments, hFunc)
+		require.NoError(t, err)
+		_, err = shares[0].Sign(message, nonces[0], commitments, hFunc)
+		require.ErrorIs(t, err, ErrNonceUsed)
+
+		// the nonce of another signer
+		_, err = shares[1].Sign(message, nonces[2], commitments, hFunc)
+		require.ErrorIs(t, err, ErrInvalidSigningSet)
+	})
+
+	t.Run("invalid signature share", func(t *testing.T) {
+		commitments, sigShares := sign(t, shares, []int{0, 2, 3}, message, hFunc)
+		sigShares[1].Z.Add(&sigShares[1].Z, &sigShares[0].Z)
+		require.ErrorIs(t, VerifySignatureShare(shares[0].Commitment, message, commitments, &sigShares[1], hFunc), ErrInvalidSignatureShare)
+		_, err := Aggregate(shares[0].Commitment, message, commitments, sigShares, hFunc)
+		require.ErrorIs(t, err, ErrInvalidSignatureShare)
+	})
+
+	t.Run("wrong message", func(t *testing.T) {
+		commitments, sigShares := sign(t, shares, []int{0, 1, 3}, message, hFunc)
+		_, err := Aggregate(shares[0].Commitment, []byte("other"), commitments, sigShares, hFunc)
+		require.ErrorIs(t, err, ErrInvalidSignatureShare)
+	})
+}
+
+func TestHashToScalar(t *testing.T) {
+	// the constant time reduction matches fr.Hash
+	for _, msg := range []string{"", "abc", "frost"} {
+		expected, err := fr.Hash([]byte(msg), []byte(domainSeparator+"nonce"), 1)
+		require.NoError(t, err)
+		res := hashToScalar("nonce", []byte(msg))
+		require.True(t, res.Equal(&expected[0]), msg)
+	}
+
+	// the nonces are H3(random_bytes || SerializeScalar(secret))
+	shares, err := GenerateWithDealer(crand.Reader, 1, 1)
+	require.NoError(t, err)
+	random := make([]byte, 32)
+	_, err = crand.Read(random)
+	require.NoError(t, err)
+	var nonce fr.Element
+	require.NoError(t, shares[0].generateNonce(bytes.NewReader(random), &nonce))
+	secret := shares[0].Secret.Bytes()
+	expected := hashToScalar("nonce", random, secret[:])
+	require.True(t, nonce.Equal(&expected))
+}
@@ -24,6 +24,19 @@
 // Security: estimated 160-bit level following [https://eprint.iacr.org/2019/885.pdf]
 // (r is 253 bits and p²⁴ is 7543 bits)
 //
+// # Fixed-base scalar multiplication
+//
+// G1FixedBaseTable and G2FixedBaseTable store the multiples of a point which is used
+// as a base many times, with a window size trading memory for speed.
+// ScalarMultiplicationBase uses such a table for the generator, computed on first use.
+//
+// # Constant time
+//
+// ScalarMultiplicationCT, ScalarMultiplicationBaseCT and
+// ScalarMultiplicationFixedBaseCT don't branch on the scalar nor access memory
+// depending on it, and are used by the signature schemes for the secret scalars. The
+// other scalar multiplications are faster but not constant time.
+//
 // # Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
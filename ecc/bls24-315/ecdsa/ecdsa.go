@@ -17,8 +17,11 @@
 package ecdsa
 
 import (
+	"bytes"
+	"crypto"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha512"
 	"crypto/subtle"
@@ -85,11 +88,10 @@ func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 		return nil, err
 
 	}
-	_, _, g, _ := bls24315.Generators()
 
 	privateKey := new(PrivateKey)
 	k.FillBytes(privateKey.scalar[:sizeFr])
-	privateKey.PublicKey.A.ScalarMultiplication(&g, k)
+	privateKey.PublicKey.A.ScalarMultiplicationBaseCT(k)
 	return privateKey, nil
 }
 
@@ -101,7 +103,7 @@ func HashToInt(hash []byte) *big.Int {
 		hash = hash[:sizeFr]
 	}
 	ret := new(big.Int).SetBytes(hash)
-	excess := ret.BitLen() - sizeFrBits
+	excess := len(hash)*8 - sizeFrBits
 	if excess > 0 {
 		ret.Rsh(ret, uint(excess))
 	}
@@ -167,6 +169,12 @@ func nonce(privateKey *PrivateKey, hash []byte) (csprng *cipher.StreamReader, er
 
 // Equal compares 2 public keys
 func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	return pub.EqualPublicKey(x)
+}
+
+// EqualPublicKey reports whether x is the same public key, as the Equal
+// method of the public keys of the standard library.
+func (pub *PublicKey) EqualPublicKey(x crypto.PublicKey) bool {
 	xx, ok := x.(*PublicKey)
 	if !ok {
 		return false
@@ -183,66 +191,179 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 	return &pub
 }
 
-// Sign performs the ECDSA signature
+// signHash signs the integer m, with the nonces returned by nextK until the
+// signature is valid. It returns the signature {r, s} and the public key
+// recovery information v.
 //
-// k ← 𝔽r (random)
 // P = k ⋅ g1Gen
 // r = x_P (mod order)
 // s = k⁻¹ . (m + sk ⋅ r)
-// signature = {r, s}
-//
-// SEC 1, Version 2.0, Section 4.1.3
-func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
-	scalar, r, s, kInv := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
-	scalar.SetBytes(privKey.scalar[:sizeFr])
+// v = (div(x_P, order)<<1) || y_P[-1]
+func (privKey *PrivateKey) signHash(m *big.Int, nextK func() (*big.Int, error)) (v uint, r, s *big.Int, err error) {
+	r, s = new(big.Int), new(big.Int)
+
+	// the secret values are handled with the constant-time arithmetic of fr and G1
+	var scalar, kInv, sFr, rFr, mFr fr.Element
+	scalar.SetBigIntCT(new(big.Int).SetBytes(privKey.scalar[:sizeFr]))
+	mFr.SetBigInt(m)
 	for {
-		for {
-			csprng, err := nonce(privKey, message)
-			if err != nil {
-				return nil, err
-			}
-			k, err := randFieldElement(csprng)
-			if err != nil {
-				return nil, err
-			}
+		k, err := nextK()
+		if err != nil {
+			return 0, nil, nil, err
+		}
 
-			var P bls24315.G1Affine
-			P.ScalarMultiplicationBase(k)
-			kInv.ModInverse(k, order)
+		var P bls24315.G1Affine
+		P.ScalarMultiplicationBaseCT(k)
+		kInv.SetBigIntCT(k).InverseCT(&kInv)
+		k.SetUint64(0)
 
-			P.X.BigInt(r)
+		P.X.BigInt(r)
+		// set how many times we overflow the scalar field
+		v = (uint(new(big.Int).Div(r, order).Uint64())) << 1
+		// set if y is even or odd
+		v |= P.Y.BigInt(new(big.Int)).Bit(0)
 
-			r.Mod(r, order)
-			if r.Sign() != 0 {
-				break
-			}
-		}
-		s.Mul(r, scalar)
-
-		var m *big.Int
-		if hFunc != nil {
-			// compute the hash of the message as an integer
-			dataToHash := make([]byte, len(message))
-			copy(dataToHash[:], message[:])
-			hFunc.Reset()
-			_, err := hFunc.Write(dataToHash[:])
-			if err != nil {
-				return nil, err
-			}
-			hramBin := hFunc.Sum(nil)
-			m = HashToInt(hramBin)
-		} else {
-			m = HashToInt(message)
+		r.Mod(r, order)
+		if r.Sign() == 0 {
+			continue
 		}
 
-		s.Add(m, s).
-			Mul(kInv, s).
-			Mod(s, order) // order != 0
-		if s.Sign() != 0 {
+		rFr.SetBigInt(r)
+		sFr.MulCT(&rFr, &scalar).
+			AddCT(&sFr, &mFr).
+			MulCT(&sFr, &kInv)
+		if !sFr.IsZero() {
 			break
 		}
 	}
+	sFr.BigInt(s)
+
+	return v, r, s, nil
+}
+
+// hashMessage returns the hash of the message as an integer, or the message
+// itself as an integer if hFunc is nil.
+func hashMessage(message []byte, hFunc hash.Hash) (*big.Int, error) {
+	if hFunc == nil {
+		return HashToInt(message), nil
+	}
+	// compute the hash of the message as an integer
+	dataToHash := make([]byte, len(message))
+	copy(dataToHash[:], message[:])
+	hFunc.Reset()
+	_, err := hFunc.Write(dataToHash[:])
+	if err != nil {
+		return nil, err
+	}
+	hramBin := hFunc.Sum(nil)
+	return HashToInt(hramBin), nil
+}
+
+// randomNonces returns a generator of random nonces, see nonce.
+func (privKey *PrivateKey) randomNonces(message []byte) func() (*big.Int, error) {
+	return func() (*big.Int, error) {
+		csprng, err := nonce(privKey, message)
+		if err != nil {
+			return nil, err
+		}
+		return randFieldElement(csprng)
+	}
+}
+
+// nonceRFC6979 returns the generator of the nonces of RFC 6979, section 3.2,
+// with HMAC-hFunc, for the private key and the message hash h1. extraEntropy is
+// the optional additional data k' of section 3.6 (nil if none). The next nonce
+// is generated when the signature with the previous one is invalid (step h.3).
+func (privKey *PrivateKey) nonceRFC6979(h1, extraEntropy []byte, hFunc func() hash.Hash) func() (*big.Int, error) {
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(hFunc, key)
+		for _, d := range data {
+			m.Write(d)
+		}
+		return m.Sum(nil)
+	}
+
+	// bits2octets(h1) = int2octets(bits2int(h1) mod q)
+	z := HashToInt(h1)
+	z.Mod(z, order)
+	bx := make([]byte, 0, 2*sizeFr+len(extraEntropy))
+	bx = append(bx, privKey.scalar[:]...)
+	bx = append(bx, z.FillBytes(make([]byte, sizeFr))...)
+	bx = append(bx, extraEntropy...)
+
+	hLen := hFunc().Size()
+	v := bytes.Repeat([]byte{0x01}, hLen)
+	k := make([]byte, hLen)
+	k = mac(k, v, []byte{0x00}, bx)
+	v = mac(k, v)
+	k = mac(k, v, []byte{0x01}, bx)
+	v = mac(k, v)
+
+	first := true
+	return func() (*big.Int, error) {
+		for {
+			if !first {
+				k = mac(k, v, []byte{0x00})
+				v = mac(k, v)
+			}
+			first = false
 
+			t := make([]byte, 0, sizeFr+hLen)
+			for len(t) < sizeFr {
+				v = mac(k, v)
+				t = append(t, v...)
+			}
+			res := HashToInt(t)
+			if res.Sign() > 0 && res.Cmp(order) < 0 {
+				return res, nil
+			}
+		}
+	}
+}
+
+// Sign performs the ECDSA signature
+//
+// k ← 𝔽r (random)
+// P = k ⋅ g1Gen
+// r = x_P (mod order)
+// s = k⁻¹ . (m + sk ⋅ r)
+// signature = {r, s}
+//
+// SEC 1, Version 2.0, Section 4.1.3
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	m, err := hashMessage(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	_, r, s, err := privKey.signHash(m, privKey.randomNonces(message))
+	if err != nil {
+		return nil, err
+	}
+	var sig Signature
+	r.FillBytes(sig.R[:sizeFr])
+	s.FillBytes(sig.S[:sizeFr])
+
+	return sig.Bytes(), nil
+}
+
+// SignRFC6979 performs the deterministic ECDSA signature of RFC 6979: the
+// message is hashed with hFunc, and the nonce k is derived from the private key
+// and the hash with HMAC-hFunc. The signature r||s is the same as the one of
+// other RFC 6979 implementations.
+func (privKey *PrivateKey) SignRFC6979(message []byte, hFunc func() hash.Hash) ([]byte, error) {
+	h := hFunc()
+	h.Write(message)
+	return privKey.SignDigestRFC6979(h.Sum(nil), hFunc)
+}
+
+// SignDigestRFC6979 performs the deterministic ECDSA signature of RFC 6979 of
+// a message hash: the nonce k is derived from the private key and digest with
+// HMAC-hFunc.
+func (privKey *PrivateKey) SignDigestRFC6979(digest []byte, hFunc func() hash.Hash) ([]byte, error) {
+	_, r, s, err := privKey.signHash(HashToInt(digest), privKey.nonceRFC6979(digest, nil, hFunc))
+	if err != nil {
+		return nil, err
+	}
 	var sig Signature
 	r.FillBytes(sig.R[:sizeFr])
 	s.FillBytes(sig.S[:sizeFr])
@@ -303,3 +424,49 @@ func (publicKey *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (boo
 	return z.Cmp(r) == 0, nil
 
 }
+
+// VerifyDER validates the ECDSA signature in ASN.1 DER encoding, see
+// Signature.BytesDER. The encoding must span the whole sigDER buffer.
+func (publicKey *PublicKey) VerifyDER(sigDER, message []byte, hFunc hash.Hash) (bool, error) {
+	var sig Signature
+	n, err := sig.SetBytesDER(sigDER)
+	if err != nil {
+		return false, err
+	}
+	if n != len(sigDER) {
+		return false, errInvalidDER
+	}
+	return publicKey.Verify(sig.Bytes(), message, hFunc)
+}
+
+// VerifyLowS validates the ECDSA signature as Verify, and rejects the signatures
+// whose s is not low (see Signature.IsLowS), as Bitcoin and Ethereum do on
+// secp256k1: of the two valid signatures {r, s} and {r, r_mod-s} of a message,
+// only one is accepted, so that the signatures can't be malleated.
+func (publicKey *PublicKey) VerifyLowS(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	if !sig.IsLowS() {
+		return false, errHighS
+	}
+	return publicKey.Verify(sigBin, message, hFunc)
+}
+
+// VerifyDERLowS validates the ECDSA signature in ASN.1 DER encoding as
+// VerifyDER, and rejects the signatures whose s is not low as VerifyLowS.
+func (publicKey *PublicKey) VerifyDERLowS(sigDER, message []byte, hFunc hash.Hash) (bool, error) {
+	var sig Signature
+	n, err := sig.SetBytesDER(sigDER)
+	if err != nil {
+		return false, err
+	}
+	if n != len(sigDER) {
+		return false, errInvalidDER
+	}
+	if !sig.IsLowS() {
+		return false, errHighS
+	}
+	return publicKey.Verify(sig.Bytes(), message, hFunc)
+}
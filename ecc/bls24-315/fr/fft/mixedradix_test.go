@@ -0,0 +1,221 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// evaluate returns p(x)
+func evaluate(p []fr.Element, x fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &x).Add(&res, &p[i])
+	}
+	return res
+}
+
+func mixedRadixDomains(t *testing.T) []*Domain {
+	var res []*Domain
+	for _, m := range []uint64{3, 6, 12, 96, 97, 3 << 10} {
+		for _, opts := range [][]DomainOption{{WithMixedRadix()}, {WithMixedRadix(), WithoutPrecompute()}} {
+			d := NewDomain(m, opts...)
+			if d.Cardinality < m {
+				t.Fatal("cardinality too small")
+			}
+			var order big.Int
+			order.Sub(fr.Modulus(), big.NewInt(1)).Mod(&order, new(big.Int).SetUint64(d.Cardinality))
+			if order.Sign() != 0 {
+				t.Fatal("the cardinality must divide r-1")
+			}
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+func TestMixedRadixDomain(t *testing.T) {
+	// 3·2ᵏ divides r-1 for all the curves
+	d := NewDomain(96, WithMixedRadix())
+	if d.Cardinality != 96 || d.radices == nil {
+		t.Fatal("expected a mixed radix domain of size 96")
+	}
+
+	// the generator is of order exactly n
+	var one fr.Element
+	one.SetOne()
+	if x := fr.NewElement(1); !x.Exp(d.Generator, big.NewInt(96)).Equal(&one) {
+		t.Fatal("ωⁿ ≠ 1")
+	}
+	for _, p := range []int64{2, 3} {
+		if x := fr.NewElement(1); x.Exp(d.Generator, big.NewInt(96/p)).Equal(&one) {
+			t.Fatal("ω is not a primitive root of unity")
+		}
+	}
+
+	// powers of 2 are unchanged
+	if d := NewDomain(64, WithMixedRadix()); d.radices != nil || !reflect.DeepEqual(d, NewDomain(64)) {
+		t.Fatal("power of 2 domains should not be mixed radix")
+	}
+}
+
+func TestMixedRadixFFT(t *testing.T) {
+	for _, d := range mixedRadixDomains(t) {
+		d := d
+		n := int(d.Cardinality)
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			p := make([]fr.Element, n)
+			for i := range p {
+				p[i].SetRandom()
+			}
+
+			// check a few evaluations
+			checkEvaluations := func(evals []fr.Element, shift fr.Element, reversed bool) {
+				t.Helper()
+				var x fr.Element
+				for _, i := range []int{0, 1, n / 3, n - 1} {
+					x.Exp(d.Generator, big.NewInt(int64(i))).Mul(&x, &shift)
+					j := i
+					if reversed {
+						j = int(BitReverseIndex(uint64(i), uint64(n)))
+					}
+					expected := evaluate(p, x)
+					if !expected.Equal(&evals[j]) {
+						t.Fatalf("wrong evaluation at ω^%d", i)
+					}
+				}
+			}
+
+			var one fr.Element
+			one.SetOne()
+
+			// DIF: regular -> reversed
+			evals := make([]fr.Element, n)
+			copy(evals, p)
+			d.FFT(evals, DIF)
+			checkEvaluations(evals, one, true)
+
+			d.FFTInverse(evals, DIT)
+			if !reflect.DeepEqual(evals, p) {
+				t.Fatal("FFTInverse(DIT) ∘ FFT(DIF) ≠ id")
+			}
+
+			// DIT: reversed -> regular
+			copy(evals, p)
+			BitReverse(evals)
+			d.FFT(evals, DIT)
+			checkEvaluations(evals, one, false)
+
+			d.FFTInverse(evals, DIF)
+			BitReverse(evals)
+			if !reflect.DeepEqual(evals, p) {
+				t.Fatal("FFTInverse(DIF) ∘ FFT(DIT) ≠ id")
+			}
+
+			// coset
+			copy(evals, p)
+			d.FFT(evals, DIF, OnCoset())
+			checkEvaluations(evals, d.FrMultiplicativeGen, true)
+			d.FFTInverse(evals, DIT, OnCoset())
+			if !reflect.DeepEqual(evals, p) {
+				t.Fatal("coset FFTInverse(DIT) ∘ FFT(DIF) ≠ id")
+			}
+
+			copy(evals, p)
+			BitReverse(evals)
+			d.FFT(evals, DIT, OnCoset(), WithNbTasks(1))
+			checkEvaluations(evals, d.FrMultiplicativeGen, false)
+			d.FFTInverse(evals, DIF, OnCoset(), WithNbTasks(1))
+			BitReverse(evals)
+			if !reflect.DeepEqual(evals, p) {
+				t.Fatal("coset FFTInverse(DIF) ∘ FFT(DIT) ≠ id")
+			}
+		})
+	}
+}
+
+func TestMixedRadixBitReverse(t *testing.T) {
+	const n = 96
+	v := make([]fr.Element, n)
+	for i := range v {
+		v[i].SetUint64(uint64(i))
+	}
+	BitReverse(v)
+	for i := range v {
+		j := BitReverseIndex(uint64(i), n)
+		if !v[j].Equal(new(fr.Element).SetUint64(uint64(i))) {
+			t.Fatal("BitReverse and BitReverseIndex mismatch")
+		}
+	}
+
+	// the permutation is an involution
+	BitReverse(v)
+	for i := range v {
+		if !v[i].Equal(new(fr.Element).SetUint64(uint64(i))) {
+			t.Fatal("BitReverse is not an involution")
+		}
+	}
+}
+
+func TestMixedRadixDomainSerialization(t *testing.T) {
+	domain := NewDomain(3<<4, WithMixedRadix())
+	var reconstructed Domain
+
+	var buf bytes.Buffer
+	written, err := domain.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var read int64
+	read, err = reconstructed.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if written != read {
+		t.Fatal("didn't read as many bytes as we wrote")
+	}
+	if !reflect.DeepEqual(domain, &reconstructed) {
+		t.Fatal("Domain.SetBytes(Bytes()) failed")
+	}
+}
+
+func BenchmarkFFTMixedRadix(b *testing.B) {
+	const maxSize = 3 << 18
+	pol := make([]fr.Element, maxSize)
+	pol[0].SetRandom()
+	for i := 1; i < maxSize; i++ {
+		pol[i] = pol[i-1]
+	}
+
+	for i := 8; i < 18; i++ {
+		sizeDomain := uint64(3 << i)
+		domain := NewDomain(sizeDomain, WithMixedRadix())
+		b.Run("fft 3·2**"+strconv.Itoa(i)+"bits", func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				domain.FFT(pol[:domain.Cardinality], DIT)
+			}
+		})
+	}
+}
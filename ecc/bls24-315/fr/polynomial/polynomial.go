@@ -104,14 +104,14 @@ func (p *Polynomial) Add(p1, p2 Polynomial) *Polynomial {
 		bigger, smaller = smaller, bigger
 	}
 
-	if len(*p) == len(bigger) && (&(*p)[0] == &bigger[0]) {
+	if len(*p) == len(bigger) && len(bigger) != 0 && (&(*p)[0] == &bigger[0]) {
 		for i := 0; i < len(smaller); i++ {
 			(*p)[i].Add(&(*p)[i], &smaller[i])
 		}
 		return p
 	}
 
-	if len(*p) == len(smaller) && (&(*p)[0] == &smaller[0]) {
+	if len(*p) == len(smaller) && len(smaller) != 0 && (&(*p)[0] == &smaller[0]) {
 		for i := 0; i < len(smaller); i++ {
 			(*p)[i].Add(&(*p)[i], &bigger[i])
 		}
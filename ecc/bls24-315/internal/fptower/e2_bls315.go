@@ -86,6 +86,60 @@ func (z *E2) Inverse(x *E2) *E2 {
 	return z
 }
 
+// MulCT sets z to the E2-product of x,y in constant time, returns z
+func (z *E2) MulCT(x, y *E2) *E2 {
+	var a, b, c fp.Element
+	a.AddCT(&x.A0, &x.A1)
+	b.AddCT(&y.A0, &y.A1)
+	a.MulCT(&a, &b)
+	b.MulCT(&x.A0, &y.A0)
+	c.MulCT(&x.A1, &y.A1)
+	z.A1.SubCT(&a, &b).SubCT(&z.A1, &c)
+	// z.A0 = b + 13c
+	var t4, t8 fp.Element
+	t4.DoubleCT(&c).DoubleCT(&t4)
+	t8.DoubleCT(&t4)
+	t8.AddCT(&t8, &t4).AddCT(&t8, &c)
+	z.A0.AddCT(&b, &t8)
+	return z
+}
+
+// SquareCT sets z to the E2-product of x,x in constant time, returns z
+func (z *E2) SquareCT(x *E2) *E2 {
+	return z.MulCT(x, x)
+}
+
+// mulByNonResidueCT multiplies a E2 by (0,1) in constant time
+func (z *E2) mulByNonResidueCT(x *E2) *E2 {
+	// z.A0 = 13·x.A1
+	var t4, t8 fp.Element
+	t4.DoubleCT(&x.A1).DoubleCT(&t4)
+	t8.DoubleCT(&t4)
+	t8.AddCT(&t8, &t4).AddCT(&t8, &x.A1)
+	z.A1 = x.A0
+	z.A0 = t8
+	return z
+}
+
+// InverseCT sets z to the E2-inverse of x in constant time, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) InverseCT(x *E2) *E2 {
+	// Algorithm 8 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t4, t8 fp.Element
+	t0.SquareCT(&x.A0)
+	t1.SquareCT(&x.A1)
+	t4.DoubleCT(&t1).DoubleCT(&t4)
+	t8.DoubleCT(&t4)
+	t8.AddCT(&t8, &t4).AddCT(&t8, &t1)
+	t0.SubCT(&t0, &t8)
+	t1.InverseCT(&t0)
+	z.A0.MulCT(&x.A0, &t1)
+	z.A1.MulCT(&x.A1, &t1).NegCT(&z.A1)
+
+	return z
+}
+
 // norm sets x to the norm of z
 func (z *E2) norm(x *fp.Element) {
 	var tmp0, tmp1 fp.Element
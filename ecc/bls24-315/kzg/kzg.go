@@ -19,6 +19,7 @@ package kzg
 import (
 	"errors"
 	"hash"
+	"math"
 	"math/big"
 	"sync"
 
@@ -137,12 +138,31 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	for i := 1; i < len(alphas); i++ {
 		alphas[i].Mul(&alphas[i-1], &alpha)
 	}
-	g1s := bls24315.BatchScalarMultiplicationG1(&gen1Aff, alphas)
+	table, err := bls24315.NewG1FixedBaseTable(&gen1Aff, srsTableWindow(len(alphas)))
+	if err != nil {
+		return nil, err
+	}
+	g1s := table.BatchScalarMultiplication(alphas)
 	copy(srs.Pk.G1[1:], g1s)
 
 	return &srs, nil
 }
 
+// srsTableWindow returns the window size of the fixed-base table used to compute
+// n points of the SRS. The table costs ≈ (log₂(r)/w)⋅2ʷ⁻¹ additions and each
+// point ≈ log₂(r)/w additions; the window is capped to keep the table smaller
+// than the SRS.
+func srsTableWindow(n int) int {
+	best, bestCost := 2, math.MaxFloat64
+	for w := 2; w <= 12; w++ {
+		cost := float64(1<<(w-1)+n) / float64(w)
+		if cost < bestCost {
+			best, bestCost = w, cost
+		}
+	}
+	return best
+}
+
 // OpeningProof KZG proof for opening at a single point.
 //
 // implements io.ReaderFrom and io.WriterTo
@@ -43,17 +43,30 @@ func init() {
 }
 
 func TestToLagrangeG1(t *testing.T) {
-	assert := require.New(t)
+	t.Run("32", func(t *testing.T) {
+		w, err := fr.Generator(32)
+		require.NoError(t, err)
+		testToLagrangeG1(t, 32, w)
+	})
+	t.Run("24", func(t *testing.T) {
+		d := fft.NewDomain(24, fft.WithMixedRadix())
+		testToLagrangeG1(t, 24, d.Generator)
+	})
 
-	const size = 32
+	// 5³ doesn't divide r-1
+	_, err := ToLagrangeG1(testSrs.Pk.G1[:2*125])
+	require.Error(t, err)
+}
+
+// testToLagrangeG1 checks ToLagrangeG1 on a domain of given size and generator w.
+func testToLagrangeG1(t *testing.T, size int, w fr.Element) {
+	assert := require.New(t)
 
 	// convert the test SRS to Lagrange form
 	lagrange, err := ToLagrangeG1(testSrs.Pk.G1[:size])
 	assert.NoError(err)
 
 	// generate the Lagrange SRS manually and compare
-	w, err := fr.Generator(uint64(size))
-	assert.NoError(err)
 
 	var li, n, d, one, acc, alpha fr.Element
 	alpha.SetBigInt(bAlpha)
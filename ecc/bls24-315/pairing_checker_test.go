@@ -0,0 +1,194 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestPairingChecker(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genR1 := GenFr()
+	genR2 := GenFr()
+
+	properties.Property("[BLS24-315] PairingChecker.Pair should output the same result as Pair", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var abigint, bbigint big.Int
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+
+			var ag1, bg1 G1Affine
+			var ag2, bg2 G2Affine
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg1.ScalarMultiplication(&g1GenAff, &bbigint)
+			ag2.ScalarMultiplication(&g2GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			checker := NewPairingChecker(g2GenAff, ag2)
+			fixedP := []G1Affine{bg1, ag1}
+			P := []G1Affine{g1GenAff, ag1}
+			Q := []G2Affine{bg2, g2GenAff}
+
+			expected, err := Pair(append(fixedP, P...), []G2Affine{g2GenAff, ag2, bg2, g2GenAff})
+			if err != nil {
+				return false
+			}
+			// twice, to check that the precomputed lines are not modified
+			for i := 0; i < 2; i++ {
+				res, err := checker.Pair(fixedP, P, Q)
+				if err != nil || !res.Equal(&expected) {
+					return false
+				}
+			}
+			// fixed points only
+			res, err := checker.Pair(fixedP, nil, nil)
+			if err != nil {
+				return false
+			}
+			expected, err = Pair(fixedP, []G2Affine{g2GenAff, ag2})
+			if err != nil || !res.Equal(&expected) {
+				return false
+			}
+			// a fixed point paired with the point at infinity
+			var inf G1Affine
+			res, err = checker.Pair([]G1Affine{inf, ag1}, P, Q)
+			if err != nil {
+				return false
+			}
+			expected, err = Pair(append([]G1Affine{ag1}, P...), []G2Affine{ag2, bg2, g2GenAff})
+			return err == nil && res.Equal(&expected)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[BLS24-315] PairingChecker.Check should accept e(aG₁, bG₂) ⋅ e(-abG₁, G₂)", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ab fr.Element
+			ab.Mul(&a, &b).Neg(&ab)
+			var abigint, bbigint, abbigint big.Int
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+			ab.BigInt(&abbigint)
+
+			var ag1, abg1 G1Affine
+			var bg2 G2Affine
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			abg1.ScalarMultiplication(&g1GenAff, &abbigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			checker := NewPairingChecker(g2GenAff)
+			ok, err := checker.Check([]G1Affine{abg1}, []G1Affine{ag1}, []G2Affine{bg2})
+			if err != nil || !ok {
+				return false
+			}
+			ok, err = checker.Check([]G1Affine{ag1}, []G1Affine{ag1}, []G2Affine{bg2})
+			return err == nil && !ok
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	checker := NewPairingChecker(g2GenAff)
+	if _, err := checker.Check(nil, nil, nil); err != ErrNbFixedQ {
+		t.Fatalf("expected ErrNbFixedQ, got %v", err)
+	}
+	if _, err := checker.Check([]G1Affine{g1GenAff}, []G1Affine{g1GenAff}, nil); err == nil {
+		t.Fatal("expected an error for mismatched variable pairs")
+	}
+}
+
+func TestPairingCheckerSerialization(t *testing.T) {
+	t.Parallel()
+
+	var s fr.Element
+	if _, err := s.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	var sbigint big.Int
+	s.BigInt(&sbigint)
+	var sg2 G2Affine
+	sg2.ScalarMultiplication(&g2GenAff, &sbigint)
+
+	checker := NewPairingChecker(g2GenAff, sg2)
+	var buf bytes.Buffer
+	written, err := checker.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reconstructed PairingChecker
+	read, err := reconstructed.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != read {
+		t.Fatalf("%d bytes written, %d bytes read", written, read)
+	}
+	if reconstructed.NbFixedQ() != 2 {
+		t.Fatalf("expected 2 fixed points, got %d", reconstructed.NbFixedQ())
+	}
+	for i := 0; i < 2; i++ {
+		q1, q2 := checker.FixedQ(i), reconstructed.FixedQ(i)
+		if !q1.Equal(&q2) || checker.lines[i] != reconstructed.lines[i] {
+			t.Fatalf("fixed point %d differs", i)
+		}
+	}
+}
+
+func BenchmarkPairingChecker(b *testing.B) {
+	// Groth16-like check: 3 fixed points and 1 variable point
+	checker := NewPairingChecker(g2GenAff, g2GenAff, g2GenAff)
+	fixedP := []G1Affine{g1GenAff, g1GenAff, g1GenAff}
+	P := []G1Affine{g1GenAff}
+	Q := []G2Affine{g2GenAff}
+
+	b.Run("PairingChecker", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			checker.Check(fixedP, P, Q)
+		}
+	})
+
+	allP := append(append([]G1Affine{}, fixedP...), P...)
+	allQ := []G2Affine{g2GenAff, g2GenAff, g2GenAff, g2GenAff}
+	b.Run("PairingCheck", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			PairingCheck(allP, allQ)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package rangeproof
+
+import (
+	"crypto/sha256"
+	"math"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// parameters re-used across tests
+var testParams Params
+
+func init() {
+	var err error
+	testParams, err = NewParams(64, 4, []byte("range proof test"))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomBlindings(size int) []fr.Element {
+	res := make([]fr.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestRangeProof(t *testing.T) {
+	assert := require.New(t)
+
+	for _, values := range [][]uint64{
+		{0},
+		{math.MaxUint64},
+		{42, 1 << 63},
+		{1, 2, 3, math.MaxUint64 - 1},
+	} {
+		gammas := randomBlindings(len(values))
+		proof, commitments, err := Prove(&testParams, values, gammas, sha256.New())
+		assert.NoError(err)
+		for j := range values {
+			expected := testParams.Commit(values[j], gammas[j])
+			assert.True(expected.Equal(&commitments[j]))
+		}
+		assert.NoError(Verify(&testParams, commitments, &proof, sha256.New()))
+
+		// commitment to another value
+		other := testParams.Commit(values[0]+1, gammas[0])
+		wrong := append([]curve.G1Affine{other}, commitments[1:]...)
+		assert.ErrorIs(Verify(&testParams, wrong, &proof, sha256.New()), ErrVerifyRangeProof)
+
+		// tampered proof
+		tampered := proof
+		tampered.THat.Double(&tampered.THat)
+		assert.ErrorIs(Verify(&testParams, commitments, &tampered, sha256.New()), ErrVerifyRangeProof)
+
+		t.Run("serialization", testutils.SerializationRoundTrip(&proof))
+	}
+}
+
+func TestRangeProofSmallRange(t *testing.T) {
+	assert := require.New(t)
+
+	params, err := NewParams(8, 2, []byte("small range"))
+	assert.NoError(err)
+	gammas := randomBlindings(2)
+
+	_, _, err = Prove(&params, []uint64{255, 256}, gammas, sha256.New())
+	assert.ErrorIs(err, ErrValueOutOfRange)
+
+	proof, commitments, err := Prove(&params, []uint64{255, 0}, gammas, sha256.New())
+	assert.NoError(err)
+	assert.NoError(Verify(&params, commitments, &proof, sha256.New()))
+
+	// proof for 8 bits does not verify with 16 bits parameters
+	params16, err := NewParams(16, 2, []byte("small range"))
+	assert.NoError(err)
+	assert.Error(Verify(&params16, commitments, &proof, sha256.New()))
+}
+
+func TestRangeProofOutOfRangeValue(t *testing.T) {
+	assert := require.New(t)
+
+	// a dishonest prover commits to 2⁸ with an 8-bit proof of 0 and swaps the
+	// commitment: verification must fail.
+	params, err := NewParams(8, 1, []byte("out of range"))
+	assert.NoError(err)
+	gammas := randomBlindings(1)
+	proof, _, err := Prove(&params, []uint64{0}, gammas, sha256.New())
+	assert.NoError(err)
+	commitment := params.Commit(256, gammas[0])
+	assert.Error(Verify(&params, []curve.G1Affine{commitment}, &proof, sha256.New()))
+}
+
+func TestBatchVerify(t *testing.T) {
+	assert := require.New(t)
+
+	const nbProofs = 5
+	proofs := make([]Proof, nbProofs)
+	commitments := make([][]curve.G1Affine, nbProofs)
+	for i := range proofs {
+		values := make([]uint64, 1<<(i%3))
+		for j := range values {
+			values[j] = uint64(i*1000 + j)
+		}
+		var err error
+		proofs[i], commitments[i], err = Prove(&testParams, values, randomBlindings(len(values)), sha256.New())
+		assert.NoError(err)
+	}
+	assert.NoError(BatchVerify(&testParams, commitments, proofs, sha256.New()))
+
+	proofs[3].Mu.Double(&proofs[3].Mu)
+	assert.ErrorIs(BatchVerify(&testParams, commitments, proofs, sha256.New()), ErrVerifyRangeProof)
+
+	assert.ErrorIs(BatchVerify(&testParams, commitments[1:], proofs, sha256.New()), ErrInvalidNbCommitments)
+}
+
+func BenchmarkProve(b *testing.B) {
+	values := []uint64{1, 2}
+	gammas := randomBlindings(len(values))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = Prove(&testParams, values, gammas, sha256.New())
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	values := []uint64{1, 2}
+	proof, commitments, _ := Prove(&testParams, values, randomBlindings(len(values)), sha256.New())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(&testParams, commitments, &proof, sha256.New())
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	const nbProofs = 16
+	proofs := make([]Proof, nbProofs)
+	commitments := make([][]curve.G1Affine, nbProofs)
+	for i := range proofs {
+		proofs[i], commitments[i], _ = Prove(&testParams, []uint64{uint64(i)}, randomBlindings(1), sha256.New())
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = BatchVerify(&testParams, commitments, proofs, sha256.New())
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecvrf
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// testInput returns a random input, encoded as field elements to be hashed with MiMC
+func testInput(t *testing.T, n int) []byte {
+	res := make([]byte, 0, n*fr.Bytes)
+	for i := 0; i < n; i++ {
+		var e fr.Element
+		_, err := e.SetRandom()
+		require.NoError(t, err)
+		b := e.Bytes()
+		res = append(res, b[:]...)
+	}
+	return res
+}
+
+func TestProveVerify(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pub := privKey.PublicKey
+	alpha := testInput(t, 2)
+
+	pi, err := privKey.Prove(alpha)
+	require.NoError(t, err)
+	require.Len(t, pi, SizeProof)
+
+	beta, err := pub.Verify(pi, alpha)
+	require.NoError(t, err)
+	require.Len(t, beta, SizeHash)
+
+	// the output doesn't depend on the proof randomness, there is none
+	pi2, err := privKey.Prove(alpha)
+	require.NoError(t, err)
+	require.Equal(t, pi, pi2)
+
+	beta2, err := ProofToHash(pi)
+	require.NoError(t, err)
+	require.Equal(t, beta, beta2)
+
+	t.Run("empty input", func(t *testing.T) {
+		pi, err := privKey.Prove(nil)
+		require.NoError(t, err)
+		_, err = pub.Verify(pi, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("other input", func(t *testing.T) {
+		other := testInput(t, 2)
+		_, err := pub.Verify(pi, other)
+		require.ErrorIs(t, err, ErrInvalidProof)
+
+		pi2, err := privKey.Prove(other)
+		require.NoError(t, err)
+		beta2, err := pub.Verify(pi2, other)
+		require.NoError(t, err)
+		require.NotEqual(t, beta, beta2)
+	})
+
+	t.Run("input not made of field elements", func(t *testing.T) {
+		_, err := privKey.Prove(make([]byte, fr.Bytes+1))
+		require.ErrorIs(t, err, ErrInvalidInput)
+		_, err = pub.Verify(pi, make([]byte, fr.Bytes-1))
+		require.ErrorIs(t, err, ErrInvalidInput)
+
+		// not canonical
+		input := make([]byte, fr.Bytes)
+		for i := range input {
+			input[i] = 0xff
+		}
+		_, err = privKey.Prove(input)
+		require.Error(t, err)
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		other, err := GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		_, err = other.PublicKey.Verify(pi, alpha)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+
+	t.Run("tampered proof", func(t *testing.T) {
+		for _, i := range []int{0, sizePoint + sizeFr - 1, SizeProof - 1} {
+			tampered := append([]byte{}, pi...)
+			tampered[i] ^= 1
+			_, err := pub.Verify(tampered, alpha)
+			require.ErrorIs(t, err, ErrInvalidProof, "byte %d", i)
+		}
+		_, err := pub.Verify(pi[:SizeProof-1], alpha)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+}
+
+func TestSerialization(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var pub PublicKey
+	n, err := pub.SetBytes(privKey.PublicKey.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, SizePublicKey, n)
+	require.True(t, pub.A.Equal(&privKey.PublicKey.A))
+
+	var priv PrivateKey
+	n, err = priv.SetBytes(privKey.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, SizePrivateKey, n)
+	require.Equal(t, privKey.Bytes(), priv.Bytes())
+	require.True(t, priv.PublicKey.A.Equal(&privKey.PublicKey.A))
+
+	_, err = priv.SetBytes(make([]byte, SizePrivateKey))
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+}
@@ -16,6 +16,12 @@
 
 // Package eddsa provides EdDSA signature scheme on bls24-315's twisted edwards curve.
 //
+// Key generation and signing compute the multiples of the base point by the
+// private scalar and the nonce with the constant-time scalar multiplication
+// (ScalarMultiplicationCT). The response s = r + H(R,A,M)⋅a is computed modulo
+// the order of the subgroup with math/big, which is not constant time: it may
+// leak information on a and r through timing.
+//
 // # See also
 //
 // https://en.wikipedia.org/wiki/EdDSA
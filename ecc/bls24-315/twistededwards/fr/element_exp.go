@@ -0,0 +1,831 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 32dbd584953b42564bf8fd939f24f53138f389f67beda7e5558abe965b8f2)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_110      = 2*_11
+	//	_1000     = _10 + _110
+	//	_1010     = _10 + _1000
+	//	_1101     = _11 + _1010
+	//	_1110     = 1 + _1101
+	//	_10011    = _110 + _1101
+	//	_10100    = 1 + _10011
+	//	_11000    = _1010 + _1110
+	//	_101011   = _10011 + _11000
+	//	_110001   = _110 + _101011
+	//	_110101   = _1010 + _101011
+	//	_1001001  = _10100 + _110101
+	//	_1001011  = _10 + _1001001
+	//	_1010101  = _1010 + _1001011
+	//	_1011111  = _1010 + _1010101
+	//	_1100111  = _1000 + _1011111
+	//	_1101111  = _1000 + _1100111
+	//	_1111001  = _1010 + _1101111
+	//	_1111111  = _110 + _1111001
+	//	_10010011 = _10100 + _1111111
+	//	_10010101 = _10 + _10010011
+	//	_10011111 = _1010 + _10010101
+	//	_10100111 = _1000 + _10011111
+	//	_10110111 = _11000 + _10011111
+	//	_11000101 = _1110 + _10110111
+	//	_11001011 = _110 + _11000101
+	//	_11001111 = _1010 + _11000101
+	//	_11010011 = _1000 + _11001011
+	//	_11100011 = _10100 + _11001111
+	//	_11111011 = _11000 + _11100011
+	//	i60       = ((_11001011 << 8 + _1101111) << 7 + _101011) << 11
+	//	i77       = ((_1001001 + i60) << 9 + _10100111) << 5 + _1101
+	//	i106      = ((i77 << 12 + _10010101) << 7 + _1001001) << 8
+	//	i128      = ((_1111111 + i106) << 11 + _11111011 + _10) << 8
+	//	i149      = ((_10010011 + i128) << 8 + _10011111) << 10 + _10010011
+	//	i175      = ((i149 << 6 + _110101) << 8 + _110001) << 10
+	//	i196      = ((_11100011 + i175) << 7 + _1100111) << 11 + _10011111
+	//	i225      = ((i196 << 9 + _11001111) << 9 + _11111011) << 9
+	//	i243      = ((_11010011 + i225) << 7 + _1111001) << 8 + _1010101
+	//	i270      = ((i243 << 9 + _11000101) << 8 + _1011111) << 8
+	//	i293      = ((_1001011 + i270) << 10 + _10110111) << 10 + _1111001
+	//	return      2*i293
+	//
+	// Operations: 236 squares 58 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22 Element
+	// Step 1: t14 = x^0x2
+	t14.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t14)
+
+	// Step 3: t22 = x^0x6
+	t22.Square(z)
+
+	// Step 4: t5 = x^0x8
+	t5.Mul(t14, t22)
+
+	// Step 5: t7 = x^0xa
+	t7.Mul(t14, t5)
+
+	// Step 6: t18 = x^0xd
+	t18.Mul(z, t7)
+
+	// Step 7: t3 = x^0xe
+	t3.Mul(&x, t18)
+
+	// Step 8: z = x^0x13
+	z.Mul(t22, t18)
+
+	// Step 9: t10 = x^0x14
+	t10.Mul(&x, z)
+
+	// Step 10: t6 = x^0x18
+	t6.Mul(t7, t3)
+
+	// Step 11: t20 = x^0x2b
+	t20.Mul(z, t6)
+
+	// Step 12: t11 = x^0x31
+	t11.Mul(t22, t20)
+
+	// Step 13: t12 = x^0x35
+	t12.Mul(t7, t20)
+
+	// Step 14: t16 = x^0x49
+	t16.Mul(t10, t12)
+
+	// Step 15: t1 = x^0x4b
+	t1.Mul(t14, t16)
+
+	// Step 16: t4 = x^0x55
+	t4.Mul(t7, t1)
+
+	// Step 17: t2 = x^0x5f
+	t2.Mul(t7, t4)
+
+	// Step 18: t9 = x^0x67
+	t9.Mul(t5, t2)
+
+	// Step 19: t21 = x^0x6f
+	t21.Mul(t5, t9)
+
+	// Step 20: z = x^0x79
+	z.Mul(t7, t21)
+
+	// Step 21: t15 = x^0x7f
+	t15.Mul(t22, z)
+
+	// Step 22: t13 = x^0x93
+	t13.Mul(t10, t15)
+
+	// Step 23: t17 = x^0x95
+	t17.Mul(t14, t13)
+
+	// Step 24: t8 = x^0x9f
+	t8.Mul(t7, t17)
+
+	// Step 25: t19 = x^0xa7
+	t19.Mul(t5, t8)
+
+	// Step 26: t0 = x^0xb7
+	t0.Mul(t6, t8)
+
+	// Step 27: t3 = x^0xc5
+	t3.Mul(t3, t0)
+
+	// Step 28: t22 = x^0xcb
+	t22.Mul(t22, t3)
+
+	// Step 29: t7 = x^0xcf
+	t7.Mul(t7, t3)
+
+	// Step 30: t5 = x^0xd3
+	t5.Mul(t5, t22)
+
+	// Step 31: t10 = x^0xe3
+	t10.Mul(t10, t7)
+
+	// Step 32: t6 = x^0xfb
+	t6.Mul(t6, t10)
+
+	// Step 40: t22 = x^0xcb00
+	for s := 0; s < 8; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 41: t21 = x^0xcb6f
+	t21.Mul(t21, t22)
+
+	// Step 48: t21 = x^0x65b780
+	for s := 0; s < 7; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 49: t20 = x^0x65b7ab
+	t20.Mul(t20, t21)
+
+	// Step 60: t20 = x^0x32dbd5800
+	for s := 0; s < 11; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 61: t20 = x^0x32dbd5849
+	t20.Mul(t16, t20)
+
+	// Step 70: t20 = x^0x65b7ab09200
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 71: t19 = x^0x65b7ab092a7
+	t19.Mul(t19, t20)
+
+	// Step 76: t19 = x^0xcb6f561254e0
+	for s := 0; s < 5; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 77: t18 = x^0xcb6f561254ed
+	t18.Mul(t18, t19)
+
+	// Step 89: t18 = x^0xcb6f561254ed000
+	for s := 0; s < 12; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 90: t17 = x^0xcb6f561254ed095
+	t17.Mul(t17, t18)
+
+	// Step 97: t17 = x^0x65b7ab092a7684a80
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 98: t16 = x^0x65b7ab092a7684ac9
+	t16.Mul(t16, t17)
+
+	// Step 106: t16 = x^0x65b7ab092a7684ac900
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 107: t15 = x^0x65b7ab092a7684ac97f
+	t15.Mul(t15, t16)
+
+	// Step 118: t15 = x^0x32dbd584953b42564bf800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 119: t15 = x^0x32dbd584953b42564bf8fb
+	t15.Mul(t6, t15)
+
+	// Step 120: t14 = x^0x32dbd584953b42564bf8fd
+	t14.Mul(t14, t15)
+
+	// Step 128: t14 = x^0x32dbd584953b42564bf8fd00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 129: t14 = x^0x32dbd584953b42564bf8fd93
+	t14.Mul(t13, t14)
+
+	// Step 137: t14 = x^0x32dbd584953b42564bf8fd9300
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 138: t14 = x^0x32dbd584953b42564bf8fd939f
+	t14.Mul(t8, t14)
+
+	// Step 148: t14 = x^0xcb6f561254ed09592fe3f64e7c00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 149: t13 = x^0xcb6f561254ed09592fe3f64e7c93
+	t13.Mul(t13, t14)
+
+	// Step 155: t13 = x^0x32dbd584953b42564bf8fd939f24c0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 156: t12 = x^0x32dbd584953b42564bf8fd939f24f5
+	t12.Mul(t12, t13)
+
+	// Step 164: t12 = x^0x32dbd584953b42564bf8fd939f24f500
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 165: t11 = x^0x32dbd584953b42564bf8fd939f24f531
+	t11.Mul(t11, t12)
+
+	// Step 175: t11 = x^0xcb6f561254ed09592fe3f64e7c93d4c400
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 176: t10 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3
+	t10.Mul(t10, t11)
+
+	// Step 183: t10 = x^0x65b7ab092a7684ac97f1fb273e49ea627180
+	for s := 0; s < 7; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 184: t9 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e7
+	t9.Mul(t9, t10)
+
+	// Step 195: t9 = x^0x32dbd584953b42564bf8fd939f24f53138f3800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 196: t8 = x^0x32dbd584953b42564bf8fd939f24f53138f389f
+	t8.Mul(t8, t9)
+
+	// Step 205: t8 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713e00
+	for s := 0; s < 9; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 206: t7 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf
+	t7.Mul(t7, t8)
+
+	// Step 215: t7 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9e00
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 216: t6 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb
+	t6.Mul(t6, t7)
+
+	// Step 225: t6 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df600
+	for s := 0; s < 9; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 226: t5 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3
+	t5.Mul(t5, t6)
+
+	// Step 233: t5 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb6980
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 234: t5 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb69f9
+	t5.Mul(z, t5)
+
+	// Step 242: t5 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb69f900
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 243: t4 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb69f955
+	t4.Mul(t4, t5)
+
+	// Step 252: t4 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aa00
+	for s := 0; s < 9; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 253: t3 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac5
+	t3.Mul(t3, t4)
+
+	// Step 261: t3 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac500
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 262: t2 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f
+	t2.Mul(t2, t3)
+
+	// Step 270: t2 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f00
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 271: t1 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b
+	t1.Mul(t1, t2)
+
+	// Step 281: t1 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf7db4fcaab157d2c00
+	for s := 0; s < 10; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 282: t0 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf7db4fcaab157d2cb7
+	t0.Mul(t0, t1)
+
+	// Step 292: t0 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b2dc00
+	for s := 0; s < 10; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 293: z = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b2dc79
+	z.Mul(z, t0)
+
+	// Step 294: z = x^0x32dbd584953b42564bf8fd939f24f53138f389f67beda7e5558abe965b8f2
+	z.Square(z)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b2dc7940)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_110      = 2*_11
+	//	_1000     = _10 + _110
+	//	_1010     = _10 + _1000
+	//	_1101     = _11 + _1010
+	//	_1110     = 1 + _1101
+	//	_10011    = _110 + _1101
+	//	_10100    = 1 + _10011
+	//	_11000    = _1010 + _1110
+	//	_101011   = _10011 + _11000
+	//	_110001   = _110 + _101011
+	//	_110101   = _1010 + _101011
+	//	_1001001  = _10100 + _110101
+	//	_1001011  = _10 + _1001001
+	//	_1010101  = _1010 + _1001011
+	//	_1011111  = _1010 + _1010101
+	//	_1100111  = _1000 + _1011111
+	//	_1101111  = _1000 + _1100111
+	//	_1111001  = _1010 + _1101111
+	//	_1111111  = _110 + _1111001
+	//	_10010011 = _10100 + _1111111
+	//	_10010101 = _10 + _10010011
+	//	_10011111 = _1010 + _10010101
+	//	_10100111 = _1000 + _10011111
+	//	_10110111 = _11000 + _10011111
+	//	_11000101 = _1110 + _10110111
+	//	_11001011 = _110 + _11000101
+	//	_11001111 = _1010 + _11000101
+	//	_11010011 = _1000 + _11001011
+	//	_11100011 = _10100 + _11001111
+	//	_11111011 = _11000 + _11100011
+	//	i60       = ((_11001011 << 8 + _1101111) << 7 + _101011) << 11
+	//	i77       = ((_1001001 + i60) << 9 + _10100111) << 5 + _1101
+	//	i106      = ((i77 << 12 + _10010101) << 7 + _1001001) << 8
+	//	i128      = ((_1111111 + i106) << 11 + _10 + _11111011) << 8
+	//	i149      = ((_10010011 + i128) << 8 + _10011111) << 10 + _10010011
+	//	i175      = ((i149 << 6 + _110101) << 8 + _110001) << 10
+	//	i196      = ((_11100011 + i175) << 7 + _1100111) << 11 + _10011111
+	//	i225      = ((i196 << 9 + _11001111) << 9 + _11111011) << 9
+	//	i243      = ((_11010011 + i225) << 7 + _1111001) << 8 + _1010101
+	//	i270      = ((i243 << 9 + _11000101) << 8 + _1011111) << 8
+	//	i293      = ((_1001011 + i270) << 10 + _10110111) << 10 + _1111001
+	//	return      (i293 << 2 + 1) << 6
+	//
+	// Operations: 243 squares 59 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22 Element
+	// Step 1: t14 = x^0x2
+	t14.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t14)
+
+	// Step 3: t22 = x^0x6
+	t22.Square(z)
+
+	// Step 4: t5 = x^0x8
+	t5.Mul(t14, t22)
+
+	// Step 5: t7 = x^0xa
+	t7.Mul(t14, t5)
+
+	// Step 6: t18 = x^0xd
+	t18.Mul(z, t7)
+
+	// Step 7: t3 = x^0xe
+	t3.Mul(&x, t18)
+
+	// Step 8: z = x^0x13
+	z.Mul(t22, t18)
+
+	// Step 9: t10 = x^0x14
+	t10.Mul(&x, z)
+
+	// Step 10: t6 = x^0x18
+	t6.Mul(t7, t3)
+
+	// Step 11: t20 = x^0x2b
+	t20.Mul(z, t6)
+
+	// Step 12: t11 = x^0x31
+	t11.Mul(t22, t20)
+
+	// Step 13: t12 = x^0x35
+	t12.Mul(t7, t20)
+
+	// Step 14: t16 = x^0x49
+	t16.Mul(t10, t12)
+
+	// Step 15: t1 = x^0x4b
+	t1.Mul(t14, t16)
+
+	// Step 16: t4 = x^0x55
+	t4.Mul(t7, t1)
+
+	// Step 17: t2 = x^0x5f
+	t2.Mul(t7, t4)
+
+	// Step 18: t9 = x^0x67
+	t9.Mul(t5, t2)
+
+	// Step 19: t21 = x^0x6f
+	t21.Mul(t5, t9)
+
+	// Step 20: z = x^0x79
+	z.Mul(t7, t21)
+
+	// Step 21: t15 = x^0x7f
+	t15.Mul(t22, z)
+
+	// Step 22: t13 = x^0x93
+	t13.Mul(t10, t15)
+
+	// Step 23: t17 = x^0x95
+	t17.Mul(t14, t13)
+
+	// Step 24: t8 = x^0x9f
+	t8.Mul(t7, t17)
+
+	// Step 25: t19 = x^0xa7
+	t19.Mul(t5, t8)
+
+	// Step 26: t0 = x^0xb7
+	t0.Mul(t6, t8)
+
+	// Step 27: t3 = x^0xc5
+	t3.Mul(t3, t0)
+
+	// Step 28: t22 = x^0xcb
+	t22.Mul(t22, t3)
+
+	// Step 29: t7 = x^0xcf
+	t7.Mul(t7, t3)
+
+	// Step 30: t5 = x^0xd3
+	t5.Mul(t5, t22)
+
+	// Step 31: t10 = x^0xe3
+	t10.Mul(t10, t7)
+
+	// Step 32: t6 = x^0xfb
+	t6.Mul(t6, t10)
+
+	// Step 40: t22 = x^0xcb00
+	for s := 0; s < 8; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 41: t21 = x^0xcb6f
+	t21.Mul(t21, t22)
+
+	// Step 48: t21 = x^0x65b780
+	for s := 0; s < 7; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 49: t20 = x^0x65b7ab
+	t20.Mul(t20, t21)
+
+	// Step 60: t20 = x^0x32dbd5800
+	for s := 0; s < 11; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 61: t20 = x^0x32dbd5849
+	t20.Mul(t16, t20)
+
+	// Step 70: t20 = x^0x65b7ab09200
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 71: t19 = x^0x65b7ab092a7
+	t19.Mul(t19, t20)
+
+	// Step 76: t19 = x^0xcb6f561254e0
+	for s := 0; s < 5; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 77: t18 = x^0xcb6f561254ed
+	t18.Mul(t18, t19)
+
+	// Step 89: t18 = x^0xcb6f561254ed000
+	for s := 0; s < 12; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 90: t17 = x^0xcb6f561254ed095
+	t17.Mul(t17, t18)
+
+	// Step 97: t17 = x^0x65b7ab092a7684a80
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 98: t16 = x^0x65b7ab092a7684ac9
+	t16.Mul(t16, t17)
+
+	// Step 106: t16 = x^0x65b7ab092a7684ac900
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 107: t15 = x^0x65b7ab092a7684ac97f
+	t15.Mul(t15, t16)
+
+	// Step 118: t15 = x^0x32dbd584953b42564bf800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 119: t14 = x^0x32dbd584953b42564bf802
+	t14.Mul(t14, t15)
+
+	// Step 120: t14 = x^0x32dbd584953b42564bf8fd
+	t14.Mul(t6, t14)
+
+	// Step 128: t14 = x^0x32dbd584953b42564bf8fd00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 129: t14 = x^0x32dbd584953b42564bf8fd93
+	t14.Mul(t13, t14)
+
+	// Step 137: t14 = x^0x32dbd584953b42564bf8fd9300
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 138: t14 = x^0x32dbd584953b42564bf8fd939f
+	t14.Mul(t8, t14)
+
+	// Step 148: t14 = x^0xcb6f561254ed09592fe3f64e7c00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 149: t13 = x^0xcb6f561254ed09592fe3f64e7c93
+	t13.Mul(t13, t14)
+
+	// Step 155: t13 = x^0x32dbd584953b42564bf8fd939f24c0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 156: t12 = x^0x32dbd584953b42564bf8fd939f24f5
+	t12.Mul(t12, t13)
+
+	// Step 164: t12 = x^0x32dbd584953b42564bf8fd939f24f500
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 165: t11 = x^0x32dbd584953b42564bf8fd939f24f531
+	t11.Mul(t11, t12)
+
+	// Step 175: t11 = x^0xcb6f561254ed09592fe3f64e7c93d4c400
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 176: t10 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3
+	t10.Mul(t10, t11)
+
+	// Step 183: t10 = x^0x65b7ab092a7684ac97f1fb273e49ea627180
+	for s := 0; s < 7; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 184: t9 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e7
+	t9.Mul(t9, t10)
+
+	// Step 195: t9 = x^0x32dbd584953b42564bf8fd939f24f53138f3800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 196: t8 = x^0x32dbd584953b42564bf8fd939f24f53138f389f
+	t8.Mul(t8, t9)
+
+	// Step 205: t8 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713e00
+	for s := 0; s < 9; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 206: t7 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf
+	t7.Mul(t7, t8)
+
+	// Step 215: t7 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9e00
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 216: t6 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb
+	t6.Mul(t6, t7)
+
+	// Step 225: t6 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df600
+	for s := 0; s < 9; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 226: t5 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3
+	t5.Mul(t5, t6)
+
+	// Step 233: t5 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb6980
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 234: t5 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb69f9
+	t5.Mul(z, t5)
+
+	// Step 242: t5 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb69f900
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 243: t4 = x^0xcb6f561254ed09592fe3f64e7c93d4c4e3ce27d9efb69f955
+	t4.Mul(t4, t5)
+
+	// Step 252: t4 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aa00
+	for s := 0; s < 9; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 253: t3 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac5
+	t3.Mul(t3, t4)
+
+	// Step 261: t3 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac500
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 262: t2 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f
+	t2.Mul(t2, t3)
+
+	// Step 270: t2 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f00
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 271: t1 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b
+	t1.Mul(t1, t2)
+
+	// Step 281: t1 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf7db4fcaab157d2c00
+	for s := 0; s < 10; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 282: t0 = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf7db4fcaab157d2cb7
+	t0.Mul(t0, t1)
+
+	// Step 292: t0 = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b2dc00
+	for s := 0; s < 10; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 293: z = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b2dc79
+	z.Mul(z, t0)
+
+	// Step 295: z = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf7db4fcaab157d2cb71e4
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+
+	// Step 296: z = x^0x65b7ab092a7684ac97f1fb273e49ea6271e713ecf7db4fcaab157d2cb71e5
+	z.Mul(&x, z)
+
+	// Step 302: z = x^0x196deac24a9da12b25fc7ec9cf927a989c79c4fb3df6d3f2aac55f4b2dc7940
+	for s := 0; s < 6; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+const (
+	// sizeScalar is the size of the encoding of a scalar, the size of an
+	// element of the field of definition of the curve, larger than the order
+	// of the prime subgroup.
+	sizeScalar = fr.Bytes
+
+	// sizePoint is the size of a compressed point: the y coordinate and the
+	// sign of x.
+	sizePoint = fr.Bytes
+)
+
+var errNonCanonicalScalar = errors.New("scalar is not reduced modulo the order of the prime subgroup")
+
+// WriteTo writes the binary encoding of the proof: the number of rounds on 4
+// bytes, the compressed points L then R, and the scalars A and B in big
+// endian.
+func (proof *InnerProductProof) WriteTo(w io.Writer) (int64, error) {
+	buf := appendRounds(nil, proof.L, proof.R)
+	buf = appendScalar(buf, &proof.A)
+	buf = appendScalar(buf, &proof.B)
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// ReadFrom decodes a proof written by WriteTo. Points out of the prime
+// subgroup and non-canonical encodings of the scalars are rejected.
+func (proof *InnerProductProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := decoder{r: r}
+	var res InnerProductProof
+	res.L, res.R = dec.readRounds()
+	dec.readScalar(&res.A)
+	dec.readScalar(&res.B)
+	if dec.err != nil {
+		return dec.read, dec.err
+	}
+	*proof = res
+	return dec.read, nil
+}
+
+// WriteTo writes the binary encoding of the proof: the number of rounds on 4
+// bytes, the compressed points L then R, the compressed point T and the
+// scalars Z1 and Z2 in big endian.
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+	buf := appendRounds(nil, proof.L, proof.R)
+	buf = append(buf, proof.T.Marshal()...)
+	buf = appendScalar(buf, &proof.Z1)
+	buf = appendScalar(buf, &proof.Z2)
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// ReadFrom decodes a proof written by WriteTo. Points out of the prime
+// subgroup and non-canonical encodings of the scalars are rejected.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := decoder{r: r}
+	var res OpeningProof
+	res.L, res.R = dec.readRounds()
+	dec.readPoint(&res.T)
+	dec.readScalar(&res.Z1)
+	dec.readScalar(&res.Z2)
+	if dec.err != nil {
+		return dec.read, dec.err
+	}
+	*proof = res
+	return dec.read, nil
+}
+
+func appendRounds(buf []byte, L, R []Digest) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(L)))
+	for i := range L {
+		buf = append(buf, L[i].Marshal()...)
+	}
+	for i := range R {
+		buf = append(buf, R[i].Marshal()...)
+	}
+	return buf
+}
+
+func appendScalar(buf []byte, e *big.Int) []byte {
+	var b [sizeScalar]byte
+	e.FillBytes(b[:])
+	return append(buf, b[:]...)
+}
+
+// decoder reads the elements of a proof and keeps the first error, so that
+// the reads can be chained.
+type decoder struct {
+	r    io.Reader
+	read int64
+	err  error
+}
+
+func (dec *decoder) readFull(buf []byte) {
+	if dec.err != nil {
+		return
+	}
+	n, err := io.ReadFull(dec.r, buf)
+	dec.read += int64(n)
+	dec.err = err
+}
+
+func (dec *decoder) readPoint(p *Digest) {
+	var buf [sizePoint]byte
+	dec.readFull(buf[:])
+	if dec.err != nil {
+		return
+	}
+	if dec.err = p.Unmarshal(buf[:]); dec.err == nil && !p.IsInSubGroup() {
+		dec.err = ErrInvalidPoint
+	}
+}
+
+func (dec *decoder) readScalar(e *big.Int) {
+	var buf [sizeScalar]byte
+	dec.readFull(buf[:])
+	if dec.err == nil && e.SetBytes(buf[:]).Cmp(order()) >= 0 {
+		dec.err = errNonCanonicalScalar
+	}
+}
+
+// readRounds reads the number of rounds and the points L and R. The slices
+// grow as the data is read, so that a corrupted size can't trigger a large
+// allocation.
+func (dec *decoder) readRounds() (L, R []Digest) {
+	var buf [4]byte
+	dec.readFull(buf[:])
+	nbRounds := int(binary.BigEndian.Uint32(buf[:]))
+	for _, s := range []*[]Digest{&L, &R} {
+		for i := 0; i < nbRounds && dec.err == nil; i++ {
+			var p Digest
+			dec.readPoint(&p)
+			*s = append(*s, p)
+		}
+	}
+	return L, R
+}
@@ -0,0 +1,1210 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// mul(res, x, y *Element) sets res = x·y·R⁻¹ (mod q)
+
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $0x55b5e0028b047ffd, R8
+	MOVD $0x8d512e565dab2aab, R16
+	MOVD $0xd6f339e43424bf7e, R17
+	MOVD $0x169a61e684c73446, R19
+	MOVD $0xf28fc5a0b7f9d039, R20
+	MOVD $0x1058ca226f60892c, R21
+	LDP  0(R0), (R11, R12)
+	LDP  16(R0), (R13, R14)
+	MOVD 32(R0), R15
+
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R9
+	MUL   R11, R9, R2
+	MUL   R12, R9, R3
+	MUL   R13, R9, R4
+	MUL   R14, R9, R5
+	MUL   R15, R9, R6
+	UMULH R15, R9, R7
+	UMULH R11, R9, R10
+	ADDS  R10, R3, R3
+	UMULH R12, R9, R10
+	ADCS  R10, R4, R4
+	UMULH R13, R9, R10
+	ADCS  R10, R5, R5
+	UMULH R14, R9, R10
+	ADCS  R10, R6, R6
+	ADC   ZR, R7, R7
+	MUL   R2, R8, R9
+	MUL   R16, R9, R10
+	ADDS  R10, R2, R2
+	MUL   R17, R9, R10
+	ADCS  R10, R3, R3
+	MUL   R19, R9, R10
+	ADCS  R10, R4, R4
+	MUL   R20, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R21, R9, R10
+	ADCS  R10, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R16, R9, R10
+	ADDS  R10, R3, R3
+	UMULH R17, R9, R10
+	ADCS  R10, R4, R4
+	UMULH R19, R9, R10
+	ADCS  R10, R5, R5
+	UMULH R20, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R21, R9, R10
+	ADC   R10, R7, R7
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R9
+	MUL   R11, R9, R10
+	ADDS  R10, R3, R3
+	MUL   R12, R9, R10
+	ADCS  R10, R4, R4
+	MUL   R13, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R14, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R15, R9, R10
+	ADCS  R10, R7, R7
+	ADC   ZR, R2, R2
+	UMULH R11, R9, R10
+	ADDS  R10, R4, R4
+	UMULH R12, R9, R10
+	ADCS  R10, R5, R5
+	UMULH R13, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R14, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R15, R9, R10
+	ADC   R10, R2, R2
+	MUL   R3, R8, R9
+	MUL   R16, R9, R10
+	ADDS  R10, R3, R3
+	MUL   R17, R9, R10
+	ADCS  R10, R4, R4
+	MUL   R19, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R20, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R21, R9, R10
+	ADCS  R10, R7, R7
+	ADC   ZR, R2, R2
+	UMULH R16, R9, R10
+	ADDS  R10, R4, R4
+	UMULH R17, R9, R10
+	ADCS  R10, R5, R5
+	UMULH R19, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R20, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R21, R9, R10
+	ADC   R10, R2, R2
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R9
+	MUL   R11, R9, R10
+	ADDS  R10, R4, R4
+	MUL   R12, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R13, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R14, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R15, R9, R10
+	ADCS  R10, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R11, R9, R10
+	ADDS  R10, R5, R5
+	UMULH R12, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R13, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R14, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R15, R9, R10
+	ADC   R10, R3, R3
+	MUL   R4, R8, R9
+	MUL   R16, R9, R10
+	ADDS  R10, R4, R4
+	MUL   R17, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R19, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R20, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R21, R9, R10
+	ADCS  R10, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R16, R9, R10
+	ADDS  R10, R5, R5
+	UMULH R17, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R19, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R20, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R21, R9, R10
+	ADC   R10, R3, R3
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R9
+	MUL   R11, R9, R10
+	ADDS  R10, R5, R5
+	MUL   R12, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R13, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R14, R9, R10
+	ADCS  R10, R2, R2
+	MUL   R15, R9, R10
+	ADCS  R10, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R11, R9, R10
+	ADDS  R10, R6, R6
+	UMULH R12, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R13, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R14, R9, R10
+	ADCS  R10, R3, R3
+	UMULH R15, R9, R10
+	ADC   R10, R4, R4
+	MUL   R5, R8, R9
+	MUL   R16, R9, R10
+	ADDS  R10, R5, R5
+	MUL   R17, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R19, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R20, R9, R10
+	ADCS  R10, R2, R2
+	MUL   R21, R9, R10
+	ADCS  R10, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R16, R9, R10
+	ADDS  R10, R6, R6
+	UMULH R17, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R19, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R20, R9, R10
+	ADCS  R10, R3, R3
+	UMULH R21, R9, R10
+	ADC   R10, R4, R4
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R1), R9
+	MUL   R11, R9, R10
+	ADDS  R10, R6, R6
+	MUL   R12, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R13, R9, R10
+	ADCS  R10, R2, R2
+	MUL   R14, R9, R10
+	ADCS  R10, R3, R3
+	MUL   R15, R9, R10
+	ADCS  R10, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R11, R9, R10
+	ADDS  R10, R7, R7
+	UMULH R12, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R13, R9, R10
+	ADCS  R10, R3, R3
+	UMULH R14, R9, R10
+	ADCS  R10, R4, R4
+	UMULH R15, R9, R10
+	ADC   R10, R5, R5
+	MUL   R6, R8, R9
+	MUL   R16, R9, R10
+	ADDS  R10, R6, R6
+	MUL   R17, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R19, R9, R10
+	ADCS  R10, R2, R2
+	MUL   R20, R9, R10
+	ADCS  R10, R3, R3
+	MUL   R21, R9, R10
+	ADCS  R10, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R16, R9, R10
+	ADDS  R10, R7, R7
+	UMULH R17, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R19, R9, R10
+	ADCS  R10, R3, R3
+	UMULH R20, R9, R10
+	ADCS  R10, R4, R4
+	UMULH R21, R9, R10
+	ADC   R10, R5, R5
+
+	// t = t - q if t >= q
+	SUBS  R16, R7, R10
+	SBCS  R17, R2, R10
+	SBCS  R19, R3, R10
+	SBCS  R20, R4, R10
+	SBCS  R21, R5, R10
+	CSETM CS, R9
+	AND   R9, R16, R10
+	SUBS  R10, R7, R7
+	AND   R9, R17, R10
+	SBCS  R10, R2, R2
+	AND   R9, R19, R10
+	SBCS  R10, R3, R3
+	AND   R9, R20, R10
+	SBCS  R10, R4, R4
+	AND   R9, R21, R10
+	SBCS  R10, R5, R5
+	MOVD  res+0(FP), R0
+	STP   (R7, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	MOVD  R5, 32(R0)
+	RET
+
+// fromMont(res *Element) sets res = res·R⁻¹ (mod q)
+
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	MOVD  $0x55b5e0028b047ffd, R7
+	MOVD  $0x8d512e565dab2aab, R10
+	MOVD  $0xd6f339e43424bf7e, R11
+	MOVD  $0x169a61e684c73446, R12
+	MOVD  $0xf28fc5a0b7f9d039, R13
+	MOVD  $0x1058ca226f60892c, R14
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	MOVD  32(R0), R5
+	MOVD  ZR, R6
+	MUL   R1, R7, R8
+	MUL   R10, R8, R9
+	ADDS  R9, R1, R1
+	MUL   R11, R8, R9
+	ADCS  R9, R2, R2
+	MUL   R12, R8, R9
+	ADCS  R9, R3, R3
+	MUL   R13, R8, R9
+	ADCS  R9, R4, R4
+	MUL   R14, R8, R9
+	ADCS  R9, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R10, R8, R9
+	ADDS  R9, R2, R2
+	UMULH R11, R8, R9
+	ADCS  R9, R3, R3
+	UMULH R12, R8, R9
+	ADCS  R9, R4, R4
+	UMULH R13, R8, R9
+	ADCS  R9, R5, R5
+	UMULH R14, R8, R9
+	ADC   R9, R6, R6
+	MUL   R2, R7, R8
+	MUL   R10, R8, R9
+	ADDS  R9, R2, R2
+	MUL   R11, R8, R9
+	ADCS  R9, R3, R3
+	MUL   R12, R8, R9
+	ADCS  R9, R4, R4
+	MUL   R13, R8, R9
+	ADCS  R9, R5, R5
+	MUL   R14, R8, R9
+	ADCS  R9, R6, R6
+	ADC   ZR, R1, R1
+	UMULH R10, R8, R9
+	ADDS  R9, R3, R3
+	UMULH R11, R8, R9
+	ADCS  R9, R4, R4
+	UMULH R12, R8, R9
+	ADCS  R9, R5, R5
+	UMULH R13, R8, R9
+	ADCS  R9, R6, R6
+	UMULH R14, R8, R9
+	ADC   R9, R1, R1
+	MUL   R3, R7, R8
+	MUL   R10, R8, R9
+	ADDS  R9, R3, R3
+	MUL   R11, R8, R9
+	ADCS  R9, R4, R4
+	MUL   R12, R8, R9
+	ADCS  R9, R5, R5
+	MUL   R13, R8, R9
+	ADCS  R9, R6, R6
+	MUL   R14, R8, R9
+	ADCS  R9, R1, R1
+	ADC   ZR, R2, R2
+	UMULH R10, R8, R9
+	ADDS  R9, R4, R4
+	UMULH R11, R8, R9
+	ADCS  R9, R5, R5
+	UMULH R12, R8, R9
+	ADCS  R9, R6, R6
+	UMULH R13, R8, R9
+	ADCS  R9, R1, R1
+	UMULH R14, R8, R9
+	ADC   R9, R2, R2
+	MUL   R4, R7, R8
+	MUL   R10, R8, R9
+	ADDS  R9, R4, R4
+	MUL   R11, R8, R9
+	ADCS  R9, R5, R5
+	MUL   R12, R8, R9
+	ADCS  R9, R6, R6
+	MUL   R13, R8, R9
+	ADCS  R9, R1, R1
+	MUL   R14, R8, R9
+	ADCS  R9, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R10, R8, R9
+	ADDS  R9, R5, R5
+	UMULH R11, R8, R9
+	ADCS  R9, R6, R6
+	UMULH R12, R8, R9
+	ADCS  R9, R1, R1
+	UMULH R13, R8, R9
+	ADCS  R9, R2, R2
+	UMULH R14, R8, R9
+	ADC   R9, R3, R3
+	MUL   R5, R7, R8
+	MUL   R10, R8, R9
+	ADDS  R9, R5, R5
+	MUL   R11, R8, R9
+	ADCS  R9, R6, R6
+	MUL   R12, R8, R9
+	ADCS  R9, R1, R1
+	MUL   R13, R8, R9
+	ADCS  R9, R2, R2
+	MUL   R14, R8, R9
+	ADCS  R9, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R10, R8, R9
+	ADDS  R9, R6, R6
+	UMULH R11, R8, R9
+	ADCS  R9, R1, R1
+	UMULH R12, R8, R9
+	ADCS  R9, R2, R2
+	UMULH R13, R8, R9
+	ADCS  R9, R3, R3
+	UMULH R14, R8, R9
+	ADC   R9, R4, R4
+
+	// t = t - q if t >= q
+	SUBS  R10, R6, R9
+	SBCS  R11, R1, R9
+	SBCS  R12, R2, R9
+	SBCS  R13, R3, R9
+	SBCS  R14, R4, R9
+	CSETM CS, R8
+	AND   R8, R10, R9
+	SUBS  R9, R6, R6
+	AND   R8, R11, R9
+	SBCS  R9, R1, R1
+	AND   R8, R12, R9
+	SBCS  R9, R2, R2
+	AND   R8, R13, R9
+	SBCS  R9, R3, R3
+	AND   R8, R14, R9
+	SBCS  R9, R4, R4
+	STP   (R6, R1), 0(R0)
+	STP   (R2, R3), 16(R0)
+	MOVD  R4, 32(R0)
+	RET
+
+// reduce(res *Element) sets res = res - q if res >= q
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $0x8d512e565dab2aab, R8
+	MOVD $0xd6f339e43424bf7e, R9
+	MOVD $0x169a61e684c73446, R10
+	MOVD $0xf28fc5a0b7f9d039, R11
+	MOVD $0x1058ca226f60892c, R12
+	LDP  0(R0), (R1, R2)
+	LDP  16(R0), (R3, R4)
+	MOVD 32(R0), R5
+
+	// t = t - q if t >= q
+	SUBS  R8, R1, R7
+	SBCS  R9, R2, R7
+	SBCS  R10, R3, R7
+	SBCS  R11, R4, R7
+	SBCS  R12, R5, R7
+	CSETM CS, R6
+	AND   R6, R8, R7
+	SUBS  R7, R1, R1
+	AND   R6, R9, R7
+	SBCS  R7, R2, R2
+	AND   R6, R10, R7
+	SBCS  R7, R3, R3
+	AND   R6, R11, R7
+	SBCS  R7, R4, R4
+	AND   R6, R12, R7
+	SBCS  R7, R5, R5
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	MOVD  R5, 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD $0x8d512e565dab2aab, R10
+	MOVD $0xd6f339e43424bf7e, R11
+	MOVD $0x169a61e684c73446, R12
+	MOVD $0xf28fc5a0b7f9d039, R13
+	MOVD $0x1058ca226f60892c, R14
+
+	// t = a + b, not reduced
+	LDP  0(R0), (R2, R3)
+	LDP  16(R0), (R4, R5)
+	MOVD 32(R0), R6
+	MOVD 0(R1), R7
+	ADDS R7, R2, R2
+	MOVD 8(R1), R7
+	ADCS R7, R3, R3
+	MOVD 16(R1), R7
+	ADCS R7, R4, R4
+	MOVD 24(R1), R7
+	ADCS R7, R5, R5
+	MOVD 32(R1), R7
+	ADCS R7, R6, R6
+
+	// b = a - b
+	MOVD 0(R0), R7
+	MOVD 0(R1), R9
+	SUBS R9, R7, R7
+	MOVD R7, 0(R1)
+	MOVD 8(R0), R7
+	MOVD 8(R1), R9
+	SBCS R9, R7, R7
+	MOVD R7, 8(R1)
+	MOVD 16(R0), R7
+	MOVD 16(R1), R9
+	SBCS R9, R7, R7
+	MOVD R7, 16(R1)
+	MOVD 24(R0), R7
+	MOVD 24(R1), R9
+	SBCS R9, R7, R7
+	MOVD R7, 24(R1)
+	MOVD 32(R0), R7
+	MOVD 32(R1), R9
+	SBCS R9, R7, R7
+	MOVD R7, 32(R1)
+
+	// b = b + q if there was a borrow
+	CSETM CC, R8
+	MOVD  0(R1), R7
+	AND   R8, R10, R9
+	ADDS  R9, R7, R7
+	MOVD  R7, 0(R1)
+	MOVD  8(R1), R7
+	AND   R8, R11, R9
+	ADCS  R9, R7, R7
+	MOVD  R7, 8(R1)
+	MOVD  16(R1), R7
+	AND   R8, R12, R9
+	ADCS  R9, R7, R7
+	MOVD  R7, 16(R1)
+	MOVD  24(R1), R7
+	AND   R8, R13, R9
+	ADCS  R9, R7, R7
+	MOVD  R7, 24(R1)
+	MOVD  32(R1), R7
+	AND   R8, R14, R9
+	ADCS  R9, R7, R7
+	MOVD  R7, 32(R1)
+
+	// t = t - q if t >= q
+	SUBS  R10, R2, R9
+	SBCS  R11, R3, R9
+	SBCS  R12, R4, R9
+	SBCS  R13, R5, R9
+	SBCS  R14, R6, R9
+	CSETM CS, R8
+	AND   R8, R10, R9
+	SUBS  R9, R2, R2
+	AND   R8, R11, R9
+	SBCS  R9, R3, R3
+	AND   R8, R12, R9
+	SBCS  R9, R4, R4
+	AND   R8, R13, R9
+	SBCS  R9, R5, R5
+	AND   R8, R14, R9
+	SBCS  R9, R6, R6
+	STP   (R2, R3), 0(R0)
+	STP   (R4, R5), 16(R0)
+	MOVD  R6, 32(R0)
+	RET
+
+// addVecARM64(res, a, b *Element, n uint64) res[i] = a[i] + b[i]
+
+TEXT ·addVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x8d512e565dab2aab, R12
+	MOVD $0xd6f339e43424bf7e, R13
+	MOVD $0x169a61e684c73446, R14
+	MOVD $0xf28fc5a0b7f9d039, R15
+	MOVD $0x1058ca226f60892c, R16
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	MOVD 32(R1), R8
+	MOVD 0(R2), R9
+	ADDS R9, R4, R4
+	MOVD 8(R2), R9
+	ADCS R9, R5, R5
+	MOVD 16(R2), R9
+	ADCS R9, R6, R6
+	MOVD 24(R2), R9
+	ADCS R9, R7, R7
+	MOVD 32(R2), R9
+	ADCS R9, R8, R8
+
+	// t = t - q if t >= q
+	SUBS  R12, R4, R11
+	SBCS  R13, R5, R11
+	SBCS  R14, R6, R11
+	SBCS  R15, R7, R11
+	SBCS  R16, R8, R11
+	CSETM CS, R10
+	AND   R10, R12, R11
+	SUBS  R11, R4, R4
+	AND   R10, R13, R11
+	SBCS  R11, R5, R5
+	AND   R10, R14, R11
+	SBCS  R11, R6, R6
+	AND   R10, R15, R11
+	SBCS  R11, R7, R7
+	AND   R10, R16, R11
+	SBCS  R11, R8, R8
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	MOVD  R8, 32(R0)
+	ADD   $40, R0, R0
+	ADD   $40, R1, R1
+	ADD   $40, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// subVecARM64(res, a, b *Element, n uint64) res[i] = a[i] - b[i]
+
+TEXT ·subVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x8d512e565dab2aab, R12
+	MOVD $0xd6f339e43424bf7e, R13
+	MOVD $0x169a61e684c73446, R14
+	MOVD $0xf28fc5a0b7f9d039, R15
+	MOVD $0x1058ca226f60892c, R16
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	MOVD 32(R1), R8
+	MOVD 0(R2), R9
+	SUBS R9, R4, R4
+	MOVD 8(R2), R9
+	SBCS R9, R5, R5
+	MOVD 16(R2), R9
+	SBCS R9, R6, R6
+	MOVD 24(R2), R9
+	SBCS R9, R7, R7
+	MOVD 32(R2), R9
+	SBCS R9, R8, R8
+
+	// t = t + q if there was a borrow
+	CSETM CC, R10
+	AND   R10, R12, R11
+	ADDS  R11, R4, R4
+	AND   R10, R13, R11
+	ADCS  R11, R5, R5
+	AND   R10, R14, R11
+	ADCS  R11, R6, R6
+	AND   R10, R15, R11
+	ADCS  R11, R7, R7
+	AND   R10, R16, R11
+	ADCS  R11, R8, R8
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	MOVD  R8, 32(R0)
+	ADD   $40, R0, R0
+	ADD   $40, R1, R1
+	ADD   $40, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// scalarMulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b
+
+TEXT ·scalarMulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x55b5e0028b047ffd, R10
+	MOVD $0x8d512e565dab2aab, R19
+	MOVD $0xd6f339e43424bf7e, R20
+	MOVD $0x169a61e684c73446, R21
+	MOVD $0xf28fc5a0b7f9d039, R22
+	MOVD $0x1058ca226f60892c, R23
+	LDP  0(R2), (R13, R14)
+	LDP  16(R2), (R15, R16)
+	MOVD 32(R2), R17
+	CBZ  R3, done
+
+loop:
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R11
+	MUL   R13, R11, R4
+	MUL   R14, R11, R5
+	MUL   R15, R11, R6
+	MUL   R16, R11, R7
+	MUL   R17, R11, R8
+	UMULH R17, R11, R9
+	UMULH R13, R11, R12
+	ADDS  R12, R5, R5
+	UMULH R14, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R15, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R16, R11, R12
+	ADCS  R12, R8, R8
+	ADC   ZR, R9, R9
+	MUL   R4, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R4, R4
+	MUL   R20, R11, R12
+	ADCS  R12, R5, R5
+	MUL   R21, R11, R12
+	ADCS  R12, R6, R6
+	MUL   R22, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R23, R11, R12
+	ADCS  R12, R8, R8
+	ADC   ZR, R9, R9
+	UMULH R19, R11, R12
+	ADDS  R12, R5, R5
+	UMULH R20, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R21, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R22, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R23, R11, R12
+	ADC   R12, R9, R9
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R5, R5
+	MUL   R14, R11, R12
+	ADCS  R12, R6, R6
+	MUL   R15, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R16, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R17, R11, R12
+	ADCS  R12, R9, R9
+	ADC   ZR, R4, R4
+	UMULH R13, R11, R12
+	ADDS  R12, R6, R6
+	UMULH R14, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R15, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R16, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R17, R11, R12
+	ADC   R12, R4, R4
+	MUL   R5, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R5, R5
+	MUL   R20, R11, R12
+	ADCS  R12, R6, R6
+	MUL   R21, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R22, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R23, R11, R12
+	ADCS  R12, R9, R9
+	ADC   ZR, R4, R4
+	UMULH R19, R11, R12
+	ADDS  R12, R6, R6
+	UMULH R20, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R21, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R22, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R23, R11, R12
+	ADC   R12, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R6, R6
+	MUL   R14, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R15, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R16, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R17, R11, R12
+	ADCS  R12, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R13, R11, R12
+	ADDS  R12, R7, R7
+	UMULH R14, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R15, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R16, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R17, R11, R12
+	ADC   R12, R5, R5
+	MUL   R6, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R6, R6
+	MUL   R20, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R21, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R22, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R23, R11, R12
+	ADCS  R12, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R19, R11, R12
+	ADDS  R12, R7, R7
+	UMULH R20, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R21, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R22, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R23, R11, R12
+	ADC   R12, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R7, R7
+	MUL   R14, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R15, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R16, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R17, R11, R12
+	ADCS  R12, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R13, R11, R12
+	ADDS  R12, R8, R8
+	UMULH R14, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R15, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R16, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R17, R11, R12
+	ADC   R12, R6, R6
+	MUL   R7, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R7, R7
+	MUL   R20, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R21, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R22, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R23, R11, R12
+	ADCS  R12, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R19, R11, R12
+	ADDS  R12, R8, R8
+	UMULH R20, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R21, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R22, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R23, R11, R12
+	ADC   R12, R6, R6
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R1), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R8, R8
+	MUL   R14, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R15, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R16, R11, R12
+	ADCS  R12, R5, R5
+	MUL   R17, R11, R12
+	ADCS  R12, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R13, R11, R12
+	ADDS  R12, R9, R9
+	UMULH R14, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R15, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R16, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R17, R11, R12
+	ADC   R12, R7, R7
+	MUL   R8, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R8, R8
+	MUL   R20, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R21, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R22, R11, R12
+	ADCS  R12, R5, R5
+	MUL   R23, R11, R12
+	ADCS  R12, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R19, R11, R12
+	ADDS  R12, R9, R9
+	UMULH R20, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R21, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R22, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R23, R11, R12
+	ADC   R12, R7, R7
+
+	// t = t - q if t >= q
+	SUBS  R19, R9, R12
+	SBCS  R20, R4, R12
+	SBCS  R21, R5, R12
+	SBCS  R22, R6, R12
+	SBCS  R23, R7, R12
+	CSETM CS, R11
+	AND   R11, R19, R12
+	SUBS  R12, R9, R9
+	AND   R11, R20, R12
+	SBCS  R12, R4, R4
+	AND   R11, R21, R12
+	SBCS  R12, R5, R5
+	AND   R11, R22, R12
+	SBCS  R12, R6, R6
+	AND   R11, R23, R12
+	SBCS  R12, R7, R7
+	STP   (R9, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	MOVD  R7, 32(R0)
+	ADD   $40, R0, R0
+	ADD   $40, R1, R1
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// mulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b[i]
+
+TEXT ·mulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x55b5e0028b047ffd, R10
+	MOVD $0x8d512e565dab2aab, R19
+	MOVD $0xd6f339e43424bf7e, R20
+	MOVD $0x169a61e684c73446, R21
+	MOVD $0xf28fc5a0b7f9d039, R22
+	MOVD $0x1058ca226f60892c, R23
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R13, R14)
+	LDP  16(R1), (R15, R16)
+	MOVD 32(R1), R17
+
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R2), R11
+	MUL   R13, R11, R4
+	MUL   R14, R11, R5
+	MUL   R15, R11, R6
+	MUL   R16, R11, R7
+	MUL   R17, R11, R8
+	UMULH R17, R11, R9
+	UMULH R13, R11, R12
+	ADDS  R12, R5, R5
+	UMULH R14, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R15, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R16, R11, R12
+	ADCS  R12, R8, R8
+	ADC   ZR, R9, R9
+	MUL   R4, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R4, R4
+	MUL   R20, R11, R12
+	ADCS  R12, R5, R5
+	MUL   R21, R11, R12
+	ADCS  R12, R6, R6
+	MUL   R22, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R23, R11, R12
+	ADCS  R12, R8, R8
+	ADC   ZR, R9, R9
+	UMULH R19, R11, R12
+	ADDS  R12, R5, R5
+	UMULH R20, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R21, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R22, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R23, R11, R12
+	ADC   R12, R9, R9
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R2), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R5, R5
+	MUL   R14, R11, R12
+	ADCS  R12, R6, R6
+	MUL   R15, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R16, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R17, R11, R12
+	ADCS  R12, R9, R9
+	ADC   ZR, R4, R4
+	UMULH R13, R11, R12
+	ADDS  R12, R6, R6
+	UMULH R14, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R15, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R16, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R17, R11, R12
+	ADC   R12, R4, R4
+	MUL   R5, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R5, R5
+	MUL   R20, R11, R12
+	ADCS  R12, R6, R6
+	MUL   R21, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R22, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R23, R11, R12
+	ADCS  R12, R9, R9
+	ADC   ZR, R4, R4
+	UMULH R19, R11, R12
+	ADDS  R12, R6, R6
+	UMULH R20, R11, R12
+	ADCS  R12, R7, R7
+	UMULH R21, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R22, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R23, R11, R12
+	ADC   R12, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R2), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R6, R6
+	MUL   R14, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R15, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R16, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R17, R11, R12
+	ADCS  R12, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R13, R11, R12
+	ADDS  R12, R7, R7
+	UMULH R14, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R15, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R16, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R17, R11, R12
+	ADC   R12, R5, R5
+	MUL   R6, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R6, R6
+	MUL   R20, R11, R12
+	ADCS  R12, R7, R7
+	MUL   R21, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R22, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R23, R11, R12
+	ADCS  R12, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R19, R11, R12
+	ADDS  R12, R7, R7
+	UMULH R20, R11, R12
+	ADCS  R12, R8, R8
+	UMULH R21, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R22, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R23, R11, R12
+	ADC   R12, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R2), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R7, R7
+	MUL   R14, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R15, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R16, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R17, R11, R12
+	ADCS  R12, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R13, R11, R12
+	ADDS  R12, R8, R8
+	UMULH R14, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R15, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R16, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R17, R11, R12
+	ADC   R12, R6, R6
+	MUL   R7, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R7, R7
+	MUL   R20, R11, R12
+	ADCS  R12, R8, R8
+	MUL   R21, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R22, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R23, R11, R12
+	ADCS  R12, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R19, R11, R12
+	ADDS  R12, R8, R8
+	UMULH R20, R11, R12
+	ADCS  R12, R9, R9
+	UMULH R21, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R22, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R23, R11, R12
+	ADC   R12, R6, R6
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R2), R11
+	MUL   R13, R11, R12
+	ADDS  R12, R8, R8
+	MUL   R14, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R15, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R16, R11, R12
+	ADCS  R12, R5, R5
+	MUL   R17, R11, R12
+	ADCS  R12, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R13, R11, R12
+	ADDS  R12, R9, R9
+	UMULH R14, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R15, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R16, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R17, R11, R12
+	ADC   R12, R7, R7
+	MUL   R8, R10, R11
+	MUL   R19, R11, R12
+	ADDS  R12, R8, R8
+	MUL   R20, R11, R12
+	ADCS  R12, R9, R9
+	MUL   R21, R11, R12
+	ADCS  R12, R4, R4
+	MUL   R22, R11, R12
+	ADCS  R12, R5, R5
+	MUL   R23, R11, R12
+	ADCS  R12, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R19, R11, R12
+	ADDS  R12, R9, R9
+	UMULH R20, R11, R12
+	ADCS  R12, R4, R4
+	UMULH R21, R11, R12
+	ADCS  R12, R5, R5
+	UMULH R22, R11, R12
+	ADCS  R12, R6, R6
+	UMULH R23, R11, R12
+	ADC   R12, R7, R7
+
+	// t = t - q if t >= q
+	SUBS  R19, R9, R12
+	SBCS  R20, R4, R12
+	SBCS  R21, R5, R12
+	SBCS  R22, R6, R12
+	SBCS  R23, R7, R12
+	CSETM CS, R11
+	AND   R11, R19, R12
+	SUBS  R12, R9, R9
+	AND   R11, R20, R12
+	SBCS  R12, R4, R4
+	AND   R11, R21, R12
+	SBCS  R12, R5, R5
+	AND   R11, R22, R12
+	SBCS  R12, R6, R6
+	AND   R11, R23, R12
+	SBCS  R12, R7, R7
+	STP   (R9, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	MOVD  R7, 32(R0)
+	ADD   $40, R0, R0
+	ADD   $40, R1, R1
+	ADD   $40, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
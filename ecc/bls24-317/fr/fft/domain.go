@@ -31,7 +31,8 @@ import (
 	"github.com/consensys/gnark-crypto/ecc"
 )
 
-// Domain with a power of 2 cardinality
+// Domain with a power of 2 cardinality, or with a cardinality which is a product
+// of small primes (see WithMixedRadix)
 // compute a field element of order 2x and store it in FinerGenerator
 // all other values can be derived from x, GeneratorSqrt
 type Domain struct {
@@ -62,6 +63,10 @@ type Domain struct {
 
 	// cosetTable[i][j] = domain.Generator(i-th)SqrtInv ^ j
 	cosetTableInv []fr.Element
+
+	// radices of the FFT when the cardinality is not a power of 2; nil otherwise.
+	// It is not serialized and is derived from the cardinality.
+	radices []uint64
 }
 
 // GeneratorFullMultiplicativeGroup returns a generator of 𝔽ᵣˣ
@@ -76,10 +81,18 @@ func GeneratorFullMultiplicativeGroup() fr.Element {
 // NewDomain returns a subgroup with a power of 2 cardinality
 // cardinality >= m
 // shift: when specified, it's the element by which the set of root of unity is shifted.
+// With the WithMixedRadix option, the cardinality is the smallest divisor of r-1
+// made of small primes which is >= m.
 func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	opt := domainOptions(opts...)
 	domain := &Domain{}
 	x := ecc.NextPowerOfTwo(m)
+	if opt.mixedRadix {
+		var err error
+		if x, err = smoothCardinality(m); err != nil {
+			panic(err)
+		}
+	}
 	domain.Cardinality = uint64(x)
 	domain.FrMultiplicativeGen = GeneratorFullMultiplicativeGroup()
 
@@ -89,7 +102,12 @@ func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
 
 	var err error
-	domain.Generator, err = Generator(m)
+	if x&(x-1) == 0 {
+		domain.Generator, err = Generator(x)
+	} else {
+		domain.radices = radices(x)
+		domain.Generator, err = mixedRadixGenerator(x)
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -165,14 +183,22 @@ func (d *Domain) preComputeTwiddles() {
 	}
 
 	wg.Add(4)
-	go func() {
-		buildTwiddles(d.twiddles, d.Generator, nbStages)
-		wg.Done()
-	}()
-	go func() {
-		buildTwiddles(d.twiddlesInv, d.GeneratorInv, nbStages)
-		wg.Done()
-	}()
+	if d.radices != nil {
+		// mixed radix FFTs use all the powers of the generator
+		d.twiddles = [][]fr.Element{make([]fr.Element, d.Cardinality)}
+		d.twiddlesInv = [][]fr.Element{make([]fr.Element, d.Cardinality)}
+		go expTable(d.Generator, d.twiddles[0])
+		go expTable(d.GeneratorInv, d.twiddlesInv[0])
+	} else {
+		go func() {
+			buildTwiddles(d.twiddles, d.Generator, nbStages)
+			wg.Done()
+		}()
+		go func() {
+			buildTwiddles(d.twiddlesInv, d.GeneratorInv, nbStages)
+			wg.Done()
+		}()
+	}
 	go expTable(d.FrMultiplicativeGen, d.cosetTable)
 	go expTable(d.FrMultiplicativeGenInv, d.cosetTableInv)
 
@@ -284,6 +310,10 @@ func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
 		}
 	}
 
+	if d.Cardinality&(d.Cardinality-1) != 0 {
+		d.radices = radices(d.Cardinality)
+	}
+
 	if d.withPrecompute {
 		d.preComputeTwiddles()
 	}
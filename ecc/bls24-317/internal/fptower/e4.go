@@ -122,6 +122,34 @@ func (z *E4) Neg(x *E4) *E4 {
 	return z
 }
 
+// AddCT sets z=x+y in E4 in constant time and returns z
+func (z *E4) AddCT(x, y *E4) *E4 {
+	z.B0.AddCT(&x.B0, &y.B0)
+	z.B1.AddCT(&x.B1, &y.B1)
+	return z
+}
+
+// SubCT sets z to x sub y in constant time and returns z
+func (z *E4) SubCT(x, y *E4) *E4 {
+	z.B0.SubCT(&x.B0, &y.B0)
+	z.B1.SubCT(&x.B1, &y.B1)
+	return z
+}
+
+// DoubleCT sets z=2*x in constant time and returns z
+func (z *E4) DoubleCT(x *E4) *E4 {
+	z.B0.DoubleCT(&x.B0)
+	z.B1.DoubleCT(&x.B1)
+	return z
+}
+
+// NegCT negates an E4 element in constant time
+func (z *E4) NegCT(x *E4) *E4 {
+	z.B0.NegCT(&x.B0)
+	z.B1.NegCT(&x.B1)
+	return z
+}
+
 // SetRandom used only in tests
 func (z *E4) SetRandom() (*E4, error) {
 	if _, err := z.B0.SetRandom(); err != nil {
@@ -208,6 +236,42 @@ func (z *E4) Inverse(x *E4) *E4 {
 	return z
 }
 
+// MulCT sets z=x*y in E4 in constant time and returns z
+func (z *E4) MulCT(x, y *E4) *E4 {
+	var a, b, c E2
+	a.AddCT(&x.B0, &x.B1)
+	b.AddCT(&y.B0, &y.B1)
+	a.MulCT(&a, &b)
+	b.MulCT(&x.B0, &y.B0)
+	c.MulCT(&x.B1, &y.B1)
+	z.B1.SubCT(&a, &b).SubCT(&z.B1, &c)
+	z.B0.mulByNonResidueCT(&c).AddCT(&z.B0, &b)
+	return z
+}
+
+// SquareCT sets z=x*x in E4 in constant time and returns z
+func (z *E4) SquareCT(x *E4) *E4 {
+	return z.MulCT(x, x)
+}
+
+// InverseCT sets z to the E4-inverse of x in constant time, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E4) InverseCT(x *E4) *E4 {
+	// Algorithm 23 from https://eprint.iacr.org/2010/354.pdf
+
+	var t0, t1, tmp E2
+	t0.SquareCT(&x.B0)
+	t1.SquareCT(&x.B1)
+	tmp.mulByNonResidueCT(&t1)
+	t0.SubCT(&t0, &tmp)
+	t1.InverseCT(&t0)
+	z.B0.MulCT(&x.B0, &t1)
+	z.B1.MulCT(&x.B1, &t1).NegCT(&z.B1)
+
+	return z
+}
+
 // Exp sets z=xᵏ (mod q⁴) and returns it
 func (z *E4) Exp(x E4, k *big.Int) *E4 {
 	if k.IsUint64() && k.Uint64() == 0 {
@@ -188,6 +188,38 @@ func TestE4Ops(t *testing.T) {
 		genB,
 	))
 
+	properties.Property("[BLS24-317] InverseCT should output the same result as Inverse", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Inverse(a)
+			c.InverseCT(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[BLS24-317] AddCT, SubCT, DoubleCT and NegCT should output the same results as Add, Sub, Double and Neg", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			ok := c.Add(a, b).Equal(d.AddCT(a, b))
+			ok = ok && c.Sub(a, b).Equal(d.SubCT(a, b))
+			ok = ok && c.Double(a).Equal(d.DoubleCT(a))
+			ok = ok && c.Neg(a).Equal(d.NegCT(a))
+			return ok
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[BLS24-317] MulCT and SquareCT should output the same results as Mul and Square", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			return c.Mul(a, b).Equal(d.MulCT(a, b)) && c.Square(a).Equal(d.SquareCT(a))
+		},
+		genA,
+		genB,
+	))
+
 	properties.Property("[BLS24-317] BatchInvertE4 should output the same result as Inverse", prop.ForAll(
 		func(a, b, c *E4) bool {
 
@@ -0,0 +1,26 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package rangeproof provides Bulletproofs range proofs.
+//
+// A range proof shows that a Pedersen commitment V = v·B + γ·Q hides a value
+// v in [0, 2ⁿ), without revealing v and without a trusted setup: all the bases
+// are derived by hashing to the curve. Proofs for m values can be aggregated,
+// in which case the proof size grows as 2·log₂(n·m) group elements. Many
+// proofs can be verified at once with a single multi-scalar multiplication.
+//
+// See https://eprint.iacr.org/2017/1066.pdf, section 4.
+package rangeproof
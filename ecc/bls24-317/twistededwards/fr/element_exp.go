@@ -0,0 +1,890 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b534e5ab)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10101    = _100 + _10001
+	//	_10111    = _10 + _10101
+	//	_11011    = _100 + _10111
+	//	_11101    = _10 + _11011
+	//	_111010   = 2*_11101
+	//	_111111   = _101 + _111010
+	//	_1111110  = 2*_111111
+	//	_1111111  = 1 + _1111110
+	//	_10001000 = _1001 + _1111111
+	//	i43       = ((_10001000 << 8 + _1111111) << 7 + _10001) << 7
+	//	i56       = ((_111111 + i43) << 4 + _101) << 6 + _1101
+	//	i75       = ((i56 << 8 + _11011) << 2 + 1) << 7
+	//	i88       = ((_111111 + i75) << 8 + _1011) << 2 + 1
+	//	i114      = ((i88 << 8 + _1011) << 8 + _1001) << 8
+	//	i130      = ((_1111111 + i114) << 5 + _101) << 8 + _11011
+	//	i152      = ((i130 << 9 + _1111) << 6 + _1101) << 5
+	//	i168      = ((_1001 + i152) << 10 + _1111111) << 3 + _111
+	//	i195      = ((i168 << 2 + 1) << 10 + _11101) << 13
+	//	i209      = ((_10101 + i195) << 6 + _10001) << 5 + _10111
+	//	i228      = ((i209 << 7 + _10111) << 4 + _1011) << 6
+	//	i241      = ((_10001 + i228) << 3 + _11) << 7 + _1011
+	//	i262      = ((i241 << 8 + _10111) << 5 + _10101) << 6
+	//	i275      = ((_11011 + i262) << 4 + _101) << 6 + _1101
+	//	i294      = ((i275 << 5 + _111) << 6 + _1011) << 6
+	//	return      2*(_10101 + i294) + 1
+	//
+	// Operations: 242 squares 55 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t8 = x^0x2
+	t8.Square(&x)
+
+	// Step 2: t6 = x^0x3
+	t6.Mul(&x, t8)
+
+	// Step 3: t4 = x^0x4
+	t4.Mul(&x, t6)
+
+	// Step 4: t3 = x^0x5
+	t3.Mul(&x, t4)
+
+	// Step 5: t1 = x^0x7
+	t1.Mul(t8, t3)
+
+	// Step 6: t10 = x^0x9
+	t10.Mul(t8, t1)
+
+	// Step 7: t0 = x^0xb
+	t0.Mul(t8, t10)
+
+	// Step 8: t2 = x^0xd
+	t2.Mul(t8, t0)
+
+	// Step 9: t11 = x^0xf
+	t11.Mul(t8, t2)
+
+	// Step 10: t7 = x^0x11
+	t7.Mul(t8, t11)
+
+	// Step 11: z = x^0x15
+	z.Mul(t4, t7)
+
+	// Step 12: t5 = x^0x17
+	t5.Mul(t8, z)
+
+	// Step 13: t4 = x^0x1b
+	t4.Mul(t4, t5)
+
+	// Step 14: t8 = x^0x1d
+	t8.Mul(t8, t4)
+
+	// Step 15: t9 = x^0x3a
+	t9.Square(t8)
+
+	// Step 16: t12 = x^0x3f
+	t12.Mul(t3, t9)
+
+	// Step 17: t9 = x^0x7e
+	t9.Square(t12)
+
+	// Step 18: t9 = x^0x7f
+	t9.Mul(&x, t9)
+
+	// Step 19: t13 = x^0x88
+	t13.Mul(t10, t9)
+
+	// Step 27: t13 = x^0x8800
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 28: t13 = x^0x887f
+	t13.Mul(t9, t13)
+
+	// Step 35: t13 = x^0x443f80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 36: t13 = x^0x443f91
+	t13.Mul(t7, t13)
+
+	// Step 43: t13 = x^0x221fc880
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 44: t13 = x^0x221fc8bf
+	t13.Mul(t12, t13)
+
+	// Step 48: t13 = x^0x221fc8bf0
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 49: t13 = x^0x221fc8bf5
+	t13.Mul(t3, t13)
+
+	// Step 55: t13 = x^0x887f22fd40
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 56: t13 = x^0x887f22fd4d
+	t13.Mul(t2, t13)
+
+	// Step 64: t13 = x^0x887f22fd4d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 65: t13 = x^0x887f22fd4d1b
+	t13.Mul(t4, t13)
+
+	// Step 67: t13 = x^0x221fc8bf5346c
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 68: t13 = x^0x221fc8bf5346d
+	t13.Mul(&x, t13)
+
+	// Step 75: t13 = x^0x110fe45fa9a3680
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 76: t12 = x^0x110fe45fa9a36bf
+	t12.Mul(t12, t13)
+
+	// Step 84: t12 = x^0x110fe45fa9a36bf00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 85: t12 = x^0x110fe45fa9a36bf0b
+	t12.Mul(t0, t12)
+
+	// Step 87: t12 = x^0x443f917ea68dafc2c
+	for s := 0; s < 2; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 88: t12 = x^0x443f917ea68dafc2d
+	t12.Mul(&x, t12)
+
+	// Step 96: t12 = x^0x443f917ea68dafc2d00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 97: t12 = x^0x443f917ea68dafc2d0b
+	t12.Mul(t0, t12)
+
+	// Step 105: t12 = x^0x443f917ea68dafc2d0b00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 106: t12 = x^0x443f917ea68dafc2d0b09
+	t12.Mul(t10, t12)
+
+	// Step 114: t12 = x^0x443f917ea68dafc2d0b0900
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 115: t12 = x^0x443f917ea68dafc2d0b097f
+	t12.Mul(t9, t12)
+
+	// Step 120: t12 = x^0x887f22fd4d1b5f85a1612fe0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 121: t12 = x^0x887f22fd4d1b5f85a1612fe5
+	t12.Mul(t3, t12)
+
+	// Step 129: t12 = x^0x887f22fd4d1b5f85a1612fe500
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 130: t12 = x^0x887f22fd4d1b5f85a1612fe51b
+	t12.Mul(t4, t12)
+
+	// Step 139: t12 = x^0x110fe45fa9a36bf0b42c25fca3600
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 140: t11 = x^0x110fe45fa9a36bf0b42c25fca360f
+	t11.Mul(t11, t12)
+
+	// Step 146: t11 = x^0x443f917ea68dafc2d0b097f28d83c0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 147: t11 = x^0x443f917ea68dafc2d0b097f28d83cd
+	t11.Mul(t2, t11)
+
+	// Step 152: t11 = x^0x887f22fd4d1b5f85a1612fe51b079a0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 153: t10 = x^0x887f22fd4d1b5f85a1612fe51b079a9
+	t10.Mul(t10, t11)
+
+	// Step 163: t10 = x^0x221fc8bf5346d7e168584bf946c1e6a400
+	for s := 0; s < 10; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 164: t9 = x^0x221fc8bf5346d7e168584bf946c1e6a47f
+	t9.Mul(t9, t10)
+
+	// Step 167: t9 = x^0x110fe45fa9a36bf0b42c25fca360f3523f8
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 168: t9 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff
+	t9.Mul(t1, t9)
+
+	// Step 170: t9 = x^0x443f917ea68dafc2d0b097f28d83cd48ffc
+	for s := 0; s < 2; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 171: t9 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd
+	t9.Mul(&x, t9)
+
+	// Step 181: t9 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff400
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 182: t8 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d
+	t8.Mul(t8, t9)
+
+	// Step 195: t8 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a000
+	for s := 0; s < 13; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 196: t8 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a015
+	t8.Mul(z, t8)
+
+	// Step 202: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80540
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 203: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551
+	t8.Mul(t7, t8)
+
+	// Step 208: t8 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa20
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 209: t8 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa37
+	t8.Mul(t5, t8)
+
+	// Step 216: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b80
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 217: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97
+	t8.Mul(t5, t8)
+
+	// Step 221: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b970
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 222: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b
+	t8.Mul(t0, t8)
+
+	// Step 228: t8 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ec0
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 229: t7 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed1
+	t7.Mul(t7, t8)
+
+	// Step 232: t7 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f688
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 233: t6 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b
+	t6.Mul(t6, t7)
+
+	// Step 240: t6 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b4580
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 241: t6 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b
+	t6.Mul(t0, t6)
+
+	// Step 249: t6 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 250: t5 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17
+	t5.Mul(t5, t6)
+
+	// Step 255: t5 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162e0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 256: t5 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162f5
+	t5.Mul(z, t5)
+
+	// Step 262: t5 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd40
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 263: t4 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b
+	t4.Mul(t4, t5)
+
+	// Step 267: t4 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b0
+	for s := 0; s < 4; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 268: t3 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b5
+	t3.Mul(t3, t4)
+
+	// Step 274: t3 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162f56d40
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 275: t2 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162f56d4d
+	t2.Mul(t2, t3)
+
+	// Step 280: t2 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 281: t1 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a7
+	t1.Mul(t1, t2)
+
+	// Step 287: t1 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17ab6a69c0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 288: t0 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17ab6a69cb
+	t0.Mul(t0, t1)
+
+	// Step 294: t0 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a72c0
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 295: z = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a72d5
+	z.Mul(z, t0)
+
+	// Step 296: z = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b534e5aa
+	z.Square(z)
+
+	// Step 297: z = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b534e5ab
+	z.Mul(&x, z)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b534e5ab8)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10101    = _100 + _10001
+	//	_10111    = _10 + _10101
+	//	_11011    = _100 + _10111
+	//	_11101    = _10 + _11011
+	//	_111010   = 2*_11101
+	//	_111111   = _101 + _111010
+	//	_1111110  = 2*_111111
+	//	_1111111  = 1 + _1111110
+	//	_10001000 = _1001 + _1111111
+	//	i43       = ((_10001000 << 8 + _1111111) << 7 + _10001) << 7
+	//	i56       = ((_111111 + i43) << 4 + _101) << 6 + _1101
+	//	i75       = ((i56 << 8 + _11011) << 2 + 1) << 7
+	//	i88       = ((_111111 + i75) << 8 + _1011) << 2 + 1
+	//	i114      = ((i88 << 8 + _1011) << 8 + _1001) << 8
+	//	i130      = ((_1111111 + i114) << 5 + _101) << 8 + _11011
+	//	i152      = ((i130 << 9 + _1111) << 6 + _1101) << 5
+	//	i168      = ((_1001 + i152) << 10 + _1111111) << 3 + _111
+	//	i195      = ((i168 << 2 + 1) << 10 + _11101) << 13
+	//	i209      = ((_10101 + i195) << 6 + _10001) << 5 + _10111
+	//	i228      = ((i209 << 7 + _10111) << 4 + _1011) << 6
+	//	i241      = ((_10001 + i228) << 3 + _11) << 7 + _1011
+	//	i262      = ((i241 << 8 + _10111) << 5 + _10101) << 6
+	//	i275      = ((_11011 + i262) << 4 + _101) << 6 + _1101
+	//	i294      = ((i275 << 5 + _111) << 6 + _1011) << 6
+	//	return      ((_10101 + i294) << 2 + _11) << 3
+	//
+	// Operations: 246 squares 55 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t8 = x^0x2
+	t8.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t8)
+
+	// Step 3: t5 = x^0x4
+	t5.Mul(&x, z)
+
+	// Step 4: t4 = x^0x5
+	t4.Mul(&x, t5)
+
+	// Step 5: t2 = x^0x7
+	t2.Mul(t8, t4)
+
+	// Step 6: t10 = x^0x9
+	t10.Mul(t8, t2)
+
+	// Step 7: t1 = x^0xb
+	t1.Mul(t8, t10)
+
+	// Step 8: t3 = x^0xd
+	t3.Mul(t8, t1)
+
+	// Step 9: t11 = x^0xf
+	t11.Mul(t8, t3)
+
+	// Step 10: t7 = x^0x11
+	t7.Mul(t8, t11)
+
+	// Step 11: t0 = x^0x15
+	t0.Mul(t5, t7)
+
+	// Step 12: t6 = x^0x17
+	t6.Mul(t8, t0)
+
+	// Step 13: t5 = x^0x1b
+	t5.Mul(t5, t6)
+
+	// Step 14: t8 = x^0x1d
+	t8.Mul(t8, t5)
+
+	// Step 15: t9 = x^0x3a
+	t9.Square(t8)
+
+	// Step 16: t12 = x^0x3f
+	t12.Mul(t4, t9)
+
+	// Step 17: t9 = x^0x7e
+	t9.Square(t12)
+
+	// Step 18: t9 = x^0x7f
+	t9.Mul(&x, t9)
+
+	// Step 19: t13 = x^0x88
+	t13.Mul(t10, t9)
+
+	// Step 27: t13 = x^0x8800
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 28: t13 = x^0x887f
+	t13.Mul(t9, t13)
+
+	// Step 35: t13 = x^0x443f80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 36: t13 = x^0x443f91
+	t13.Mul(t7, t13)
+
+	// Step 43: t13 = x^0x221fc880
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 44: t13 = x^0x221fc8bf
+	t13.Mul(t12, t13)
+
+	// Step 48: t13 = x^0x221fc8bf0
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 49: t13 = x^0x221fc8bf5
+	t13.Mul(t4, t13)
+
+	// Step 55: t13 = x^0x887f22fd40
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 56: t13 = x^0x887f22fd4d
+	t13.Mul(t3, t13)
+
+	// Step 64: t13 = x^0x887f22fd4d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 65: t13 = x^0x887f22fd4d1b
+	t13.Mul(t5, t13)
+
+	// Step 67: t13 = x^0x221fc8bf5346c
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 68: t13 = x^0x221fc8bf5346d
+	t13.Mul(&x, t13)
+
+	// Step 75: t13 = x^0x110fe45fa9a3680
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 76: t12 = x^0x110fe45fa9a36bf
+	t12.Mul(t12, t13)
+
+	// Step 84: t12 = x^0x110fe45fa9a36bf00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 85: t12 = x^0x110fe45fa9a36bf0b
+	t12.Mul(t1, t12)
+
+	// Step 87: t12 = x^0x443f917ea68dafc2c
+	for s := 0; s < 2; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 88: t12 = x^0x443f917ea68dafc2d
+	t12.Mul(&x, t12)
+
+	// Step 96: t12 = x^0x443f917ea68dafc2d00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 97: t12 = x^0x443f917ea68dafc2d0b
+	t12.Mul(t1, t12)
+
+	// Step 105: t12 = x^0x443f917ea68dafc2d0b00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 106: t12 = x^0x443f917ea68dafc2d0b09
+	t12.Mul(t10, t12)
+
+	// Step 114: t12 = x^0x443f917ea68dafc2d0b0900
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 115: t12 = x^0x443f917ea68dafc2d0b097f
+	t12.Mul(t9, t12)
+
+	// Step 120: t12 = x^0x887f22fd4d1b5f85a1612fe0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 121: t12 = x^0x887f22fd4d1b5f85a1612fe5
+	t12.Mul(t4, t12)
+
+	// Step 129: t12 = x^0x887f22fd4d1b5f85a1612fe500
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 130: t12 = x^0x887f22fd4d1b5f85a1612fe51b
+	t12.Mul(t5, t12)
+
+	// Step 139: t12 = x^0x110fe45fa9a36bf0b42c25fca3600
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 140: t11 = x^0x110fe45fa9a36bf0b42c25fca360f
+	t11.Mul(t11, t12)
+
+	// Step 146: t11 = x^0x443f917ea68dafc2d0b097f28d83c0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 147: t11 = x^0x443f917ea68dafc2d0b097f28d83cd
+	t11.Mul(t3, t11)
+
+	// Step 152: t11 = x^0x887f22fd4d1b5f85a1612fe51b079a0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 153: t10 = x^0x887f22fd4d1b5f85a1612fe51b079a9
+	t10.Mul(t10, t11)
+
+	// Step 163: t10 = x^0x221fc8bf5346d7e168584bf946c1e6a400
+	for s := 0; s < 10; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 164: t9 = x^0x221fc8bf5346d7e168584bf946c1e6a47f
+	t9.Mul(t9, t10)
+
+	// Step 167: t9 = x^0x110fe45fa9a36bf0b42c25fca360f3523f8
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 168: t9 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff
+	t9.Mul(t2, t9)
+
+	// Step 170: t9 = x^0x443f917ea68dafc2d0b097f28d83cd48ffc
+	for s := 0; s < 2; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 171: t9 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd
+	t9.Mul(&x, t9)
+
+	// Step 181: t9 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff400
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 182: t8 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d
+	t8.Mul(t8, t9)
+
+	// Step 195: t8 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a000
+	for s := 0; s < 13; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 196: t8 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a015
+	t8.Mul(t0, t8)
+
+	// Step 202: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80540
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 203: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551
+	t8.Mul(t7, t8)
+
+	// Step 208: t8 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa20
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 209: t8 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa37
+	t8.Mul(t6, t8)
+
+	// Step 216: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b80
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 217: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97
+	t8.Mul(t6, t8)
+
+	// Step 221: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b970
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 222: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b
+	t8.Mul(t1, t8)
+
+	// Step 228: t8 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ec0
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 229: t7 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed1
+	t7.Mul(t7, t8)
+
+	// Step 232: t7 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f688
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 233: t7 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b
+	t7.Mul(z, t7)
+
+	// Step 240: t7 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b4580
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 241: t7 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b
+	t7.Mul(t1, t7)
+
+	// Step 249: t7 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 250: t6 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17
+	t6.Mul(t6, t7)
+
+	// Step 255: t6 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 256: t6 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162f5
+	t6.Mul(t0, t6)
+
+	// Step 262: t6 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd40
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 263: t5 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b
+	t5.Mul(t5, t6)
+
+	// Step 267: t5 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b0
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 268: t4 = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b5
+	t4.Mul(t4, t5)
+
+	// Step 274: t4 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162f56d40
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 275: t3 = x^0x110fe45fa9a36bf0b42c25fca360f3523ff41d00aa372f68b162f56d4d
+	t3.Mul(t3, t4)
+
+	// Step 280: t3 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a0
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 281: t2 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a7
+	t2.Mul(t2, t3)
+
+	// Step 287: t2 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17ab6a69c0
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 288: t1 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17ab6a69cb
+	t1.Mul(t1, t2)
+
+	// Step 294: t1 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a72c0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 295: t0 = x^0x221fc8bf5346d7e168584bf946c1e6a47fe83a01546e5ed162c5eada9a72d5
+	t0.Mul(t0, t1)
+
+	// Step 297: t0 = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17ab6a69cb54
+	for s := 0; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 298: z = x^0x887f22fd4d1b5f85a1612fe51b079a91ffa0e80551b97b458b17ab6a69cb57
+	z.Mul(z, t0)
+
+	// Step 301: z = x^0x443f917ea68dafc2d0b097f28d83cd48ffd07402a8dcbda2c58bd5b534e5ab8
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCryptoSigner(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer := privKey.CryptoSigner()
+	require.True(t, privKey.PublicKey.EqualPublicKey(signer.Public()))
+
+	digest := sha256.Sum256([]byte("testing ECDSA"))
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	ok, err := privKey.PublicKey.VerifyDER(sig, digest[:], nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the signature is deterministic
+	sig2, err := signer.Sign(nil, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	require.Equal(t, sig, sig2)
+
+	_, err = signer.Sign(rand.Reader, digest[1:], crypto.SHA256)
+	require.ErrorIs(t, err, errInvalidDigestSize)
+}
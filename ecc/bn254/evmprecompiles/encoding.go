@@ -0,0 +1,107 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evmprecompiles
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+var (
+	// ErrInvalidInputLength is returned when the length of the input is not
+	// valid for the precompile.
+	ErrInvalidInputLength = errors.New("invalid input length")
+	// ErrInvalidFieldElement is returned when a coordinate is not smaller than
+	// the base field modulus.
+	ErrInvalidFieldElement = errors.New("invalid field element encoding")
+	// ErrPointNotOnCurve is returned when a point is not on the curve.
+	ErrPointNotOnCurve = errors.New("point not on curve")
+	// ErrPointNotInSubGroup is returned when a G2 point is not in the r-torsion.
+	ErrPointNotInSubGroup = errors.New("point not in the correct subgroup")
+)
+
+// UnmarshalG1 sets p from its 64-byte EVM encoding x||y, checking that it is on
+// the curve. (0,0) encodes the point at infinity.
+func UnmarshalG1(p *bn254.G1Affine, buf []byte) error {
+	if len(buf) != sizeG1 {
+		return ErrInvalidInputLength
+	}
+	if err := setFp(&p.X, buf[:sizeFp]); err != nil {
+		return err
+	}
+	if err := setFp(&p.Y, buf[sizeFp:]); err != nil {
+		return err
+	}
+	// (0,0) is never on the curve, it is the infinity point by convention
+	if !p.IsInfinity() && !p.IsOnCurve() {
+		return ErrPointNotOnCurve
+	}
+	return nil
+}
+
+// MarshalG1 returns the 64-byte EVM encoding x||y of p.
+func MarshalG1(p *bn254.G1Affine) []byte {
+	res := make([]byte, sizeG1)
+	x := p.X.Bytes()
+	y := p.Y.Bytes()
+	copy(res[:sizeFp], x[:])
+	copy(res[sizeFp:], y[:])
+	return res
+}
+
+// UnmarshalG2 sets p from its 128-byte EVM encoding x.A1||x.A0||y.A1||y.A0,
+// checking that it is on the twist and in the r-torsion. (0,0) encodes the
+// point at infinity.
+func UnmarshalG2(p *bn254.G2Affine, buf []byte) error {
+	if len(buf) != sizeG2 {
+		return ErrInvalidInputLength
+	}
+	for i, e := range []*fp.Element{&p.X.A1, &p.X.A0, &p.Y.A1, &p.Y.A0} {
+		if err := setFp(e, buf[i*sizeFp:(i+1)*sizeFp]); err != nil {
+			return err
+		}
+	}
+	if p.IsInfinity() {
+		return nil
+	}
+	if !p.IsOnCurve() {
+		return ErrPointNotOnCurve
+	}
+	if !p.IsInSubGroup() {
+		return ErrPointNotInSubGroup
+	}
+	return nil
+}
+
+// MarshalG2 returns the 128-byte EVM encoding x.A1||x.A0||y.A1||y.A0 of p.
+func MarshalG2(p *bn254.G2Affine) []byte {
+	res := make([]byte, sizeG2)
+	for i, e := range []*fp.Element{&p.X.A1, &p.X.A0, &p.Y.A1, &p.Y.A0} {
+		b := e.Bytes()
+		copy(res[i*sizeFp:(i+1)*sizeFp], b[:])
+	}
+	return res
+}
+
+// setFp sets z from a 32-byte big-endian integer, that must be smaller than
+// the field modulus.
+func setFp(z *fp.Element, buf []byte) error {
+	if err := z.SetBytesCanonical(buf); err != nil {
+		return ErrInvalidFieldElement
+	}
+	return nil
+}
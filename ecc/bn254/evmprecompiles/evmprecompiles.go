@@ -0,0 +1,152 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package evmprecompiles implements the bn254 (alt_bn128) precompiled
+// contracts of the Ethereum Virtual Machine:
+//
+//   - ECAdd, address 0x06 (EIP-196)
+//   - ECMul, address 0x07 (EIP-196)
+//   - ECPairing, address 0x08 (EIP-197)
+//
+// with the byte encodings, input checks and gas costs (EIP-1108) used by the
+// execution clients. Field elements are 32-byte big-endian integers, that must
+// be smaller than the base field modulus. G1 points are encoded as x||y and G2
+// points as x.A1||x.A0||y.A1||y.A0 (imaginary part first). The point at
+// infinity is encoded with zeros.
+//
+// Every function returns an error exactly when the precompile call fails (and
+// consumes all the gas supplied to it).
+//
+// # See also
+//
+// https://eips.ethereum.org/EIPS/eip-196
+// https://eips.ethereum.org/EIPS/eip-197
+package evmprecompiles
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+const (
+	// ECAddGas is the gas cost of ECAdd
+	ECAddGas = 150
+	// ECMulGas is the gas cost of ECMul
+	ECMulGas = 6000
+	// ECPairingBaseGas is the constant part of the gas cost of ECPairing
+	ECPairingBaseGas = 45000
+	// ECPairingPerPairGas is the gas cost of ECPairing per input pair
+	ECPairingPerPairGas = 34000
+)
+
+const (
+	sizeFp     = 32
+	sizeScalar = 32
+	sizeG1     = 2 * sizeFp
+	sizeG2     = 4 * sizeFp
+	sizePair   = sizeG1 + sizeG2
+	sizeECAdd  = 2 * sizeG1
+	sizeECMul  = sizeG1 + sizeScalar
+)
+
+// ECAdd computes the sum of the two G1 points encoded in input (x1||y1||x2||y2).
+// input is right-padded with zeros to 128 bytes, extra bytes are ignored.
+// It returns the encoding of the sum (x||y) on 64 bytes.
+func ECAdd(input []byte) ([]byte, error) {
+	input = rightPad(input, sizeECAdd)
+
+	var p, q bn254.G1Affine
+	if err := UnmarshalG1(&p, input[:sizeG1]); err != nil {
+		return nil, err
+	}
+	if err := UnmarshalG1(&q, input[sizeG1:sizeECAdd]); err != nil {
+		return nil, err
+	}
+
+	p.Add(&p, &q)
+	return MarshalG1(&p), nil
+}
+
+// ECMul computes the scalar multiplication of the G1 point encoded in input by
+// a 256-bit big-endian scalar (x||y||s). input is right-padded with zeros to
+// 96 bytes, extra bytes are ignored. The scalar may be larger than the group
+// order. It returns the encoding of the result (x||y) on 64 bytes.
+func ECMul(input []byte) ([]byte, error) {
+	input = rightPad(input, sizeECMul)
+
+	var p bn254.G1Affine
+	if err := UnmarshalG1(&p, input[:sizeG1]); err != nil {
+		return nil, err
+	}
+
+	// reduce the scalar modulo r, G1 is of prime order
+	var s fr.Element
+	s.SetBytes(input[sizeG1:sizeECMul])
+	p.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+	return MarshalG1(&p), nil
+}
+
+// ECPairing checks that the product of the pairings of the (G1, G2) pairs
+// encoded in input equals 1. The length of input must be a multiple of 192
+// bytes, an empty input is valid. The G2 points must be in the r-torsion.
+// It returns 1 (true) or 0 (false) as a 32-byte big-endian integer.
+func ECPairing(input []byte) ([]byte, error) {
+	if len(input)%sizePair != 0 {
+		return nil, ErrInvalidInputLength
+	}
+	n := len(input) / sizePair
+
+	P := make([]bn254.G1Affine, n)
+	Q := make([]bn254.G2Affine, n)
+	for i := 0; i < n; i++ {
+		offset := i * sizePair
+		if err := UnmarshalG1(&P[i], input[offset:offset+sizeG1]); err != nil {
+			return nil, err
+		}
+		if err := UnmarshalG2(&Q[i], input[offset+sizeG1:offset+sizePair]); err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]byte, 32)
+	if n == 0 {
+		res[31] = 1
+		return res, nil
+	}
+	ok, err := bn254.PairingCheck(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		res[31] = 1
+	}
+	return res, nil
+}
+
+// ECPairingGas returns the gas cost of ECPairing(input).
+func ECPairingGas(input []byte) uint64 {
+	return ECPairingBaseGas + ECPairingPerPairGas*uint64(len(input)/sizePair)
+}
+
+// rightPad returns input padded with zeros, or truncated, to size bytes.
+func rightPad(input []byte, size int) []byte {
+	if len(input) >= size {
+		return input[:size]
+	}
+	res := make([]byte, size)
+	copy(res, input)
+	return res
+}
@@ -0,0 +1,225 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evmprecompiles
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+const (
+	g1Hex  = "0000000000000000000000000000000000000000000000000000000000000001" + "0000000000000000000000000000000000000000000000000000000000000002"
+	g1x2   = "030644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd3" + "15ed738c0e0a7c92e7845f96b2ae9c0a68a6a449e3538fc7ff3ebf7a5a18a2c4"
+	g1x3   = "0769bf9ac56bea3ff40232bcb1b6bd159315d84715b8e679f2d355961915abf0" + "2ab799bee0489429554fdb7c8d086475319e63b40b9c5b57cdf1ff3dd9fe2261"
+	infHex = "0000000000000000000000000000000000000000000000000000000000000000" + "0000000000000000000000000000000000000000000000000000000000000000"
+)
+
+func TestECAdd(t *testing.T) {
+	var minusG bn254.G1Affine
+	_, _, g1Gen, _ := bn254.Generators()
+	minusG.Neg(&g1Gen)
+	minusGHex := hex.EncodeToString(MarshalG1(&minusG))
+
+	valid := []struct {
+		name, input, output string
+	}{
+		{"G+G", g1Hex + g1Hex, g1x2},
+		{"2G+G", g1x2 + g1Hex, g1x3},
+		{"G+0", g1Hex + infHex, g1Hex},
+		{"0+0", infHex + infHex, infHex},
+		{"G-G", g1Hex + minusGHex, infHex},
+		{"empty input", "", infHex},
+		{"short input", g1Hex, g1Hex},
+		{"extra bytes", g1Hex + g1Hex + "ff", g1x2},
+	}
+	for _, c := range valid {
+		output, err := ECAdd(decodeHex(t, c.input))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(output, decodeHex(t, c.output)) {
+			t.Fatalf("%s: wrong output %x", c.name, output)
+		}
+	}
+
+	invalid := []struct {
+		name, input string
+		err         error
+	}{
+		{"x = p", hex.EncodeToString(fpBytes(fp.Modulus())) + g1Hex[64:] + g1Hex, ErrInvalidFieldElement},
+		{"not on curve", g1Hex[:64] + g1Hex[:64] + g1Hex, ErrPointNotOnCurve},
+		{"(0,1)", g1Hex + infHex[:64] + g1Hex[:64], ErrPointNotOnCurve},
+	}
+	for _, c := range invalid {
+		if _, err := ECAdd(decodeHex(t, c.input)); err != c.err {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.err, err)
+		}
+	}
+}
+
+func TestECMul(t *testing.T) {
+	order := fr.Modulus()
+	scalar := func(s *big.Int) string {
+		var b [sizeScalar]byte
+		s.FillBytes(b[:])
+		return hex.EncodeToString(b[:])
+	}
+	max := new(big.Int).Lsh(big.NewInt(1), 256)
+	max.Sub(max, big.NewInt(1))
+	_, _, g1Gen, _ := bn254.Generators()
+	var expected, expectedShort bn254.G1Affine
+	expected.ScalarMultiplication(&g1Gen, new(big.Int).Mod(max, order))
+	// the input is right-padded: the scalar is 2²⁴⁹
+	expectedShort.ScalarMultiplication(&g1Gen, new(big.Int).Lsh(big.NewInt(1), 249))
+
+	valid := []struct {
+		name, input, output string
+	}{
+		{"2G", g1Hex + scalar(big.NewInt(2)), g1x2},
+		{"3G", g1Hex + scalar(big.NewInt(3)), g1x3},
+		{"0G", g1Hex + scalar(big.NewInt(0)), infHex},
+		{"rG", g1Hex + scalar(order), infHex},
+		{"(r+2)G", g1Hex + scalar(new(big.Int).Add(order, big.NewInt(2))), g1x2},
+		{"(2²⁵⁶-1)G", g1Hex + scalar(max), hex.EncodeToString(MarshalG1(&expected))},
+		{"2·0", infHex + scalar(big.NewInt(2)), infHex},
+		{"short scalar", g1Hex + "02", hex.EncodeToString(MarshalG1(&expectedShort))},
+		{"extra bytes", g1Hex + scalar(big.NewInt(2)) + "ff", g1x2},
+	}
+	for _, c := range valid {
+		output, err := ECMul(decodeHex(t, c.input))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(output, decodeHex(t, c.output)) {
+			t.Fatalf("%s: wrong output %x", c.name, output)
+		}
+	}
+
+	if _, err := ECMul(decodeHex(t, g1Hex[:64]+g1Hex[:64]+scalar(big.NewInt(2)))); err != ErrPointNotOnCurve {
+		t.Fatalf("expected %v, got %v", ErrPointNotOnCurve, err)
+	}
+}
+
+func TestECPairing(t *testing.T) {
+	_, _, g1Gen, g2Gen := bn254.Generators()
+
+	// e(aG₁, bG₂)⋅e(-abG₁, G₂) = 1
+	a, b := big.NewInt(17), big.NewInt(42)
+	var P1, P2 bn254.G1Affine
+	var Q1 bn254.G2Affine
+	P1.ScalarMultiplication(&g1Gen, a)
+	Q1.ScalarMultiplication(&g2Gen, b)
+	P2.ScalarMultiplication(&g1Gen, new(big.Int).Mul(a, b))
+	P2.Neg(&P2)
+
+	pair := func(P *bn254.G1Affine, Q *bn254.G2Affine) []byte {
+		return append(MarshalG1(P), MarshalG2(Q)...)
+	}
+	var inf1 bn254.G1Affine
+	var inf2 bn254.G2Affine
+	one := make([]byte, 32)
+	one[31] = 1
+	zero := make([]byte, 32)
+
+	valid := []struct {
+		name   string
+		input  []byte
+		output []byte
+	}{
+		{"empty input", nil, one},
+		{"e(aG₁, bG₂)⋅e(-abG₁, G₂)", append(pair(&P1, &Q1), pair(&P2, &g2Gen)...), one},
+		{"e(aG₁, bG₂)⋅e(abG₁, G₂)", append(pair(&P1, &Q1), pair(new(bn254.G1Affine).Neg(&P2), &g2Gen)...), zero},
+		{"e(G₁, G₂)", pair(&g1Gen, &g2Gen), zero},
+		{"e(0, G₂)", pair(&inf1, &g2Gen), one},
+		{"e(G₁, 0)", pair(&g1Gen, &inf2), one},
+	}
+	for _, c := range valid {
+		output, err := ECPairing(c.input)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !bytes.Equal(output, c.output) {
+			t.Fatalf("%s: wrong output %x", c.name, output)
+		}
+	}
+
+	// a point on the twist which is not in G₂
+	var u bn254.G2Affine
+	u.X.A0.SetUint64(3)
+	Q := bn254.MapToCurve2(&u.X)
+	if !Q.IsOnCurve() || Q.IsInSubGroup() {
+		t.Fatal("expected a point on the twist outside of G₂")
+	}
+	notOnCurve := pair(&g1Gen, &g2Gen)
+	notOnCurve[len(notOnCurve)-1] ^= 1
+
+	invalid := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{"invalid length", pair(&g1Gen, &g2Gen)[1:], ErrInvalidInputLength},
+		{"G₂ point not in subgroup", pair(&g1Gen, &Q), ErrPointNotInSubGroup},
+		{"G₂ point not on curve", notOnCurve, ErrPointNotOnCurve},
+	}
+	for _, c := range invalid {
+		if _, err := ECPairing(c.input); err != c.err {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.err, err)
+		}
+	}
+
+	if gas := ECPairingGas(make([]byte, 2*sizePair)); gas != 113000 {
+		t.Fatalf("wrong gas %d", gas)
+	}
+}
+
+func TestMarshalG2(t *testing.T) {
+	_, _, _, g2Gen := bn254.Generators()
+	buf := MarshalG2(&g2Gen)
+	// EIP-197: the imaginary part comes first
+	expected := "198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2" +
+		"1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed"
+	if hex.EncodeToString(buf[:2*sizeFp]) != expected {
+		t.Fatalf("wrong encoding of G₂.X %x", buf[:2*sizeFp])
+	}
+	var Q bn254.G2Affine
+	if err := UnmarshalG2(&Q, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !Q.Equal(&g2Gen) {
+		t.Fatal("UnmarshalG2(MarshalG2(G₂)) != G₂")
+	}
+}
+
+func fpBytes(v *big.Int) []byte {
+	b := make([]byte, sizeFp)
+	v.FillBytes(b)
+	return b
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
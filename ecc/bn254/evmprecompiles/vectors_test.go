@@ -0,0 +1,76 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evmprecompiles
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// precompiledTest and precompiledFailureTest are the formats of the
+// go-ethereum precompile tests. The files of testdata/ are copied from
+// go-ethereum v1.17.7 (core/vm/testdata/precompiles), see
+// testdata/LICENSE.go-ethereum.
+type precompiledTest struct {
+	Input, Expected string
+	Gas             uint64
+	Name            string
+	NoBenchmark     bool
+}
+
+// go-ethereum has no failure vectors for these precompiles, the errors are
+// covered by the tests of evmprecompiles_test.go.
+func TestVectors(t *testing.T) {
+	precompiles := []struct {
+		name string
+		run  func([]byte) ([]byte, error)
+		gas  func([]byte) uint64
+	}{
+		{"bn256Add", ECAdd, func([]byte) uint64 { return ECAddGas }},
+		{"bn256ScalarMul", ECMul, func([]byte) uint64 { return ECMulGas }},
+		{"bn256Pairing", ECPairing, ECPairingGas},
+	}
+	for _, p := range precompiles {
+		var valid []precompiledTest
+		readVectors(t, p.name, &valid)
+		for _, v := range valid {
+			input := decodeHex(t, v.Input)
+			output, err := p.run(input)
+			if err != nil {
+				t.Fatalf("%s/%s: %v", p.name, v.Name, err)
+			}
+			if hex.EncodeToString(output) != v.Expected {
+				t.Fatalf("%s/%s: wrong output %x", p.name, v.Name, output)
+			}
+			if gas := p.gas(input); gas != v.Gas {
+				t.Fatalf("%s/%s: expected gas %d, got %d", p.name, v.Name, v.Gas, gas)
+			}
+		}
+	}
+}
+
+func readVectors(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+// ErrInvalidTranscript is returned when a ceremony transcript can't be parsed
+var ErrInvalidTranscript = errors.New("invalid ceremony transcript")
+
+// newSRSFromPowers returns the SRS of the given [τⁱ]G₁ and [τ]G₂, after checking it with CheckSRS
+func newSRSFromPowers(g1 []bn254.G1Affine, tauG2 bn254.G2Affine) (*SRS, error) {
+	var srs SRS
+	_, _, g1Gen, g2Gen := bn254.Generators()
+	srs.Pk.G1 = g1
+	srs.Vk.G1 = g1Gen
+	srs.Vk.G2[0] = g2Gen
+	srs.Vk.G2[1] = tauG2
+	if err := CheckSRS(&srs); err != nil {
+		return nil, err
+	}
+	srs.Vk.Lines[0] = bn254.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bn254.PrecomputeLines(srs.Vk.G2[1])
+	return &srs, nil
+}
+
+// ImportPtau reads the first size powers of τ of a snarkjs powers-of-tau file (.ptau), such as
+// the Perpetual Powers of Tau transcripts, and returns the corresponding SRS, checked with CheckSRS.
+//
+// The file is read sequentially: its sections are skipped except the header (1), τG₁ (2) and τG₂ (3).
+func ImportPtau(r io.Reader, size uint64) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	br := bufio.NewReader(r)
+	read := func(data interface{}) error {
+		return binary.Read(br, binary.LittleEndian, data)
+	}
+
+	var magic [4]byte
+	var version, nbSections uint32
+	if err := read(&magic); err != nil {
+		return nil, err
+	}
+	if string(magic[:]) != "ptau" {
+		return nil, fmt.Errorf("%w: not a ptau file", ErrInvalidTranscript)
+	}
+	if err := read(&version); err != nil {
+		return nil, err
+	}
+	if err := read(&nbSections); err != nil {
+		return nil, err
+	}
+
+	var power uint32
+	var g1 []bn254.G1Affine
+	var tauG2 bn254.G2Affine
+	var hasHeader, hasG2 bool
+	for i := uint32(0); i < nbSections; i++ {
+		var sectionType uint32
+		var sectionSize uint64
+		if err := read(&sectionType); err != nil {
+			return nil, err
+		}
+		if err := read(&sectionSize); err != nil {
+			return nil, err
+		}
+
+		var consumed uint64
+		switch sectionType {
+		case 1:
+			// header: n8, q, power, ceremony power
+			var n8 uint32
+			if err := read(&n8); err != nil {
+				return nil, err
+			}
+			if n8 != fp.Bytes {
+				return nil, fmt.Errorf("%w: wrong base field size %d", ErrInvalidTranscript, n8)
+			}
+			var q [fp.Bytes]byte
+			if _, err := io.ReadFull(br, q[:]); err != nil {
+				return nil, err
+			}
+			for j := 0; j < fp.Bytes/2; j++ {
+				q[j], q[fp.Bytes-1-j] = q[fp.Bytes-1-j], q[j]
+			}
+			if new(big.Int).SetBytes(q[:]).Cmp(fp.Modulus()) != 0 {
+				return nil, fmt.Errorf("%w: wrong curve", ErrInvalidTranscript)
+			}
+			if err := read(&power); err != nil {
+				return nil, err
+			}
+			consumed = 4 + fp.Bytes + 4
+			hasHeader = true
+		case 2:
+			// τG₁: 2ᵖᵒʷᵉʳ⁺¹-1 points
+			if !hasHeader {
+				return nil, fmt.Errorf("%w: missing header", ErrInvalidTranscript)
+			}
+			if size > (uint64(1)<<(power+1))-1 {
+				return nil, fmt.Errorf("%w: only 2^%d powers of τ", ErrInvalidTranscript, power)
+			}
+			g1 = make([]bn254.G1Affine, size)
+			var buf [2 * fp.Bytes]byte
+			for j := range g1 {
+				if _, err := io.ReadFull(br, buf[:]); err != nil {
+					return nil, err
+				}
+				if err := setPtauCoordinates(buf[:], &g1[j].X, &g1[j].Y); err != nil {
+					return nil, err
+				}
+			}
+			consumed = size * 2 * fp.Bytes
+		case 3:
+			// τG₂: 2ᵖᵒʷᵉʳ points, we need [τ]G₂
+			if !hasHeader {
+				return nil, fmt.Errorf("%w: missing header", ErrInvalidTranscript)
+			}
+			var buf [2 * 4 * fp.Bytes]byte
+			if _, err := io.ReadFull(br, buf[:]); err != nil {
+				return nil, err
+			}
+			if err := setPtauCoordinates(buf[4*fp.Bytes:], &tauG2.X.A0, &tauG2.X.A1, &tauG2.Y.A0, &tauG2.Y.A1); err != nil {
+				return nil, err
+			}
+			consumed = 2 * 4 * fp.Bytes
+			hasG2 = true
+		}
+
+		if consumed > sectionSize {
+			return nil, fmt.Errorf("%w: section %d is too short", ErrInvalidTranscript, sectionType)
+		}
+		if _, err := br.Discard(int(sectionSize - consumed)); err != nil {
+			return nil, err
+		}
+		if g1 != nil && hasG2 {
+			break
+		}
+	}
+	if g1 == nil || !hasG2 {
+		return nil, fmt.Errorf("%w: missing powers of τ", ErrInvalidTranscript)
+	}
+
+	return newSRSFromPowers(g1, tauG2)
+}
+
+// setPtauCoordinates sets the coordinates from their snarkjs encoding: little-endian Montgomery
+// form with R = 2⁸ⁿ⁸, which is the internal representation of fp.Element
+func setPtauCoordinates(buf []byte, coordinates ...*fp.Element) error {
+	for i, c := range coordinates {
+		var b [fp.Bytes]byte
+		copy(b[:], buf[i*fp.Bytes:(i+1)*fp.Bytes])
+		// checks that the Montgomery form is reduced
+		if _, err := fp.LittleEndian.Element(&b); err != nil {
+			return err
+		}
+		for j := range c {
+			c[j] = binary.LittleEndian.Uint64(b[8*j:])
+		}
+	}
+	return nil
+}
+
+// ignitionManifest is the header of an Aztec Ignition transcript, encoded in big-endian
+type ignitionManifest struct {
+	TranscriptNumber uint32
+	TotalTranscripts uint32
+	TotalG1Points    uint32
+	TotalG2Points    uint32
+	NumG1Points      uint32
+	NumG2Points      uint32
+	StartFrom        uint32
+}
+
+// ImportIgnition reads the first size powers of τ of the Aztec Ignition ceremony, whose transcripts
+// (transcript00.dat, transcript01.dat, ...) are read in order from transcripts, and returns the
+// corresponding SRS, checked with CheckSRS.
+//
+// The transcripts hold the points [τ]G₁, [τ²]G₁, ... followed in the first transcript by [τ]G₂,
+// with the coordinates in regular form, as 64-bit big-endian words, the least significant first.
+func ImportIgnition(size uint64, transcripts ...io.Reader) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	g1 := make([]bn254.G1Affine, 1, size)
+	_, _, g1[0], _ = bn254.Generators()
+	var tauG2 bn254.G2Affine
+
+	for i, r := range transcripts {
+		if uint64(len(g1)) == size {
+			break
+		}
+		br := bufio.NewReader(r)
+		var manifest ignitionManifest
+		if err := binary.Read(br, binary.BigEndian, &manifest); err != nil {
+			return nil, err
+		}
+		if manifest.TranscriptNumber != uint32(i) || uint64(manifest.StartFrom) != uint64(len(g1)-1) {
+			return nil, fmt.Errorf("%w: transcripts out of order", ErrInvalidTranscript)
+		}
+
+		var buf [4 * fp.Bytes]byte
+		n := min(uint64(manifest.NumG1Points), size-uint64(len(g1)))
+		for j := uint64(0); j < n; j++ {
+			var p bn254.G1Affine
+			if _, err := io.ReadFull(br, buf[:2*fp.Bytes]); err != nil {
+				return nil, err
+			}
+			if err := setIgnitionCoordinates(buf[:2*fp.Bytes], &p.X, &p.Y); err != nil {
+				return nil, err
+			}
+			g1 = append(g1, p)
+		}
+
+		if i == 0 {
+			if manifest.NumG2Points == 0 {
+				return nil, fmt.Errorf("%w: missing [τ]G₂", ErrInvalidTranscript)
+			}
+			if _, err := br.Discard(int(uint64(manifest.NumG1Points)-n) * 2 * fp.Bytes); err != nil {
+				return nil, err
+			}
+			if _, err := io.ReadFull(br, buf[:]); err != nil {
+				return nil, err
+			}
+			if err := setIgnitionCoordinates(buf[:], &tauG2.X.A0, &tauG2.X.A1, &tauG2.Y.A0, &tauG2.Y.A1); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if uint64(len(g1)) != size {
+		return nil, fmt.Errorf("%w: not enough powers of τ", ErrInvalidTranscript)
+	}
+
+	return newSRSFromPowers(g1, tauG2)
+}
+
+// setIgnitionCoordinates sets the coordinates from their Ignition encoding
+func setIgnitionCoordinates(buf []byte, coordinates ...*fp.Element) error {
+	const nbWords = fp.Bytes / 8
+	for i, c := range coordinates {
+		var b [fp.Bytes]byte
+		for j := 0; j < nbWords; j++ {
+			copy(b[(nbWords-1-j)*8:(nbWords-j)*8], buf[i*fp.Bytes+j*8:i*fp.Bytes+(j+1)*8])
+		}
+		if err := c.SetBytesCanonical(b[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// the public transcripts are too large to be part of the tests, the tests below
+// import synthetic transcripts of an SRS with a known secret, in the same formats.
+// The *Fixture tests import the public transcripts from testdata/ when the files
+// are present.
+
+// testPowersOfTau returns the SRS of size powers of alpha, and the first nbG2 [alphaⁱ]G₂
+func testPowersOfTau(t *testing.T, size, nbG2 int, alpha int64) (*SRS, []bn254.G2Affine) {
+	srs, err := NewSRS(uint64(size), big.NewInt(alpha))
+	require.NoError(t, err)
+	g2 := make([]bn254.G2Affine, nbG2)
+	g2[0] = srs.Vk.G2[0]
+	for i := 1; i < nbG2; i++ {
+		g2[i].ScalarMultiplication(&g2[i-1], big.NewInt(alpha))
+	}
+	return srs, g2
+}
+
+// writePtau returns a snarkjs powers-of-tau file of the given power
+func writePtau(t *testing.T, power int, alpha int64) (*SRS, []byte) {
+	srs, g2 := testPowersOfTau(t, (1<<(power+1))-1, 1<<power, alpha)
+
+	var buf bytes.Buffer
+	write := func(data interface{}) {
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, data))
+	}
+	buf.WriteString("ptau")
+	write(uint32(1))
+	write(uint32(4))
+
+	// header, the coordinates are in Montgomery form, as fp.Element
+	q := make([]byte, fp.Bytes)
+	fp.Modulus().FillBytes(q)
+	for i := 0; i < fp.Bytes/2; i++ {
+		q[i], q[fp.Bytes-1-i] = q[fp.Bytes-1-i], q[i]
+	}
+	write(uint32(1))
+	write(uint64(4 + fp.Bytes + 8))
+	write(uint32(fp.Bytes))
+	write(q)
+	write(uint32(power))
+	write(uint32(power))
+
+	// τG₁
+	write(uint32(2))
+	write(uint64(len(srs.Pk.G1) * 2 * fp.Bytes))
+	for i := range srs.Pk.G1 {
+		write(srs.Pk.G1[i].X)
+		write(srs.Pk.G1[i].Y)
+	}
+
+	// section ignored by the import
+	write(uint32(7))
+	write(uint64(3))
+	write([]byte{1, 2, 3})
+
+	// τG₂
+	write(uint32(3))
+	write(uint64(len(g2) * 4 * fp.Bytes))
+	for i := range g2 {
+		write(g2[i].X.A0)
+		write(g2[i].X.A1)
+		write(g2[i].Y.A0)
+		write(g2[i].Y.A1)
+	}
+
+	return srs, buf.Bytes()
+}
+
+func TestImportPtau(t *testing.T) {
+	const power = 4
+	const size = 20
+	ref, ptau := writePtau(t, power, 42)
+
+	srs, err := ImportPtau(bytes.NewReader(ptau), size)
+	require.NoError(t, err)
+	require.Equal(t, ref.Pk.G1[:size], srs.Pk.G1)
+	require.Equal(t, ref.Vk, srs.Vk)
+
+	srs, err = ImportPtau(bytes.NewReader(ptau), uint64(len(ref.Pk.G1)))
+	require.NoError(t, err)
+	require.Equal(t, ref.Pk.G1, srs.Pk.G1)
+
+	t.Run("not enough powers", func(t *testing.T) {
+		_, err := ImportPtau(bytes.NewReader(ptau), uint64(len(ref.Pk.G1))+1)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("not a ptau file", func(t *testing.T) {
+		tampered := append([]byte{}, ptau...)
+		tampered[0] = 'q'
+		_, err := ImportPtau(bytes.NewReader(tampered), size)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("wrong curve", func(t *testing.T) {
+		tampered := append([]byte{}, ptau...)
+		// first byte of q
+		tampered[12+12+4]++
+		_, err := ImportPtau(bytes.NewReader(tampered), size)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := ImportPtau(bytes.NewReader(ptau[:len(ptau)/2]), size)
+		require.Error(t, err)
+	})
+
+	t.Run("wrong power of τ", func(t *testing.T) {
+		// swaps [τ²]G₁ and [τ³]G₁
+		tampered := append([]byte{}, ptau...)
+		offset := 12 + 12 + 4 + fp.Bytes + 8 + 12 + 2*2*fp.Bytes
+		p2 := append([]byte{}, tampered[offset:offset+2*fp.Bytes]...)
+		copy(tampered[offset:], tampered[offset+2*fp.Bytes:offset+4*fp.Bytes])
+		copy(tampered[offset+2*fp.Bytes:], p2)
+		_, err := ImportPtau(bytes.NewReader(tampered), size)
+		require.ErrorIs(t, err, ErrInvalidSRS)
+	})
+}
+
+// writeIgnition returns Aztec Ignition transcripts of nbTranscripts × nbPoints powers of τ
+func writeIgnition(t *testing.T, nbTranscripts, nbPoints int, alpha int64) (*SRS, [][]byte) {
+	srs, g2 := testPowersOfTau(t, nbTranscripts*nbPoints+1, 3, alpha)
+
+	putCoordinates := func(buf *bytes.Buffer, coordinates ...*fp.Element) {
+		for _, c := range coordinates {
+			b := c.Bytes()
+			for j := fp.Bytes/8 - 1; j >= 0; j-- {
+				buf.Write(b[j*8 : (j+1)*8])
+			}
+		}
+	}
+
+	transcripts := make([][]byte, nbTranscripts)
+	for i := range transcripts {
+		var buf bytes.Buffer
+		manifest := ignitionManifest{
+			TranscriptNumber: uint32(i),
+			TotalTranscripts: uint32(nbTranscripts),
+			TotalG1Points:    uint32(nbTranscripts * nbPoints),
+			TotalG2Points:    2,
+			NumG1Points:      uint32(nbPoints),
+			StartFrom:        uint32(i * nbPoints),
+		}
+		if i == 0 {
+			manifest.NumG2Points = 2
+		}
+		require.NoError(t, binary.Write(&buf, binary.BigEndian, manifest))
+		for _, p := range srs.Pk.G1[1+i*nbPoints : 1+(i+1)*nbPoints] {
+			putCoordinates(&buf, &p.X, &p.Y)
+		}
+		if i == 0 {
+			for _, p := range g2[1:] {
+				putCoordinates(&buf, &p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1)
+			}
+		}
+		// checksum
+		buf.Write(make([]byte, 64))
+		transcripts[i] = buf.Bytes()
+	}
+	return srs, transcripts
+}
+
+func TestImportIgnition(t *testing.T) {
+	const nbTranscripts = 3
+	const nbPoints = 8
+	ref, transcripts := writeIgnition(t, nbTranscripts, nbPoints, 42)
+	readers := func(transcripts [][]byte) []io.Reader {
+		res := make([]io.Reader, len(transcripts))
+		for i := range transcripts {
+			res[i] = bytes.NewReader(transcripts[i])
+		}
+		return res
+	}
+
+	for _, size := range []uint64{2, nbPoints, nbPoints + 1, 2*nbPoints + 3, nbTranscripts*nbPoints + 1} {
+		srs, err := ImportIgnition(size, readers(transcripts)...)
+		require.NoError(t, err)
+		require.Equal(t, ref.Pk.G1[:size], srs.Pk.G1)
+		require.Equal(t, ref.Vk, srs.Vk)
+	}
+
+	t.Run("not enough powers", func(t *testing.T) {
+		_, err := ImportIgnition(nbTranscripts*nbPoints+2, readers(transcripts)...)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+		_, err = ImportIgnition(2*nbPoints, readers(transcripts[:1])...)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		_, err := ImportIgnition(2*nbPoints, readers([][]byte{transcripts[1], transcripts[0]})...)
+		require.ErrorIs(t, err, ErrInvalidTranscript)
+	})
+
+	t.Run("wrong power of τ", func(t *testing.T) {
+		tampered := append([]byte{}, transcripts[1]...)
+		// swaps the first two points of the second transcript
+		p := append([]byte{}, tampered[28:28+2*fp.Bytes]...)
+		copy(tampered[28:], tampered[28+2*fp.Bytes:28+4*fp.Bytes])
+		copy(tampered[28+2*fp.Bytes:], p)
+		_, err := ImportIgnition(2*nbPoints, readers([][]byte{transcripts[0], tampered})...)
+		require.ErrorIs(t, err, ErrInvalidSRS)
+	})
+}
+
+// checkImportedSRS checks that the SRS starts with the generators, and commits to and opens
+// a polynomial of maximal degree with it
+func checkImportedSRS(t *testing.T, srs *SRS) {
+	_, _, g1Gen, g2Gen := bn254.Generators()
+	require.True(t, srs.Pk.G1[0].Equal(&g1Gen))
+	require.True(t, srs.Vk.G2[0].Equal(&g2Gen))
+
+	p := make([]fr.Element, len(srs.Pk.G1))
+	for i := range p {
+		p[i].SetRandom()
+	}
+	var point fr.Element
+	point.SetRandom()
+	digest, err := Commit(p, srs.Pk)
+	require.NoError(t, err)
+	proof, err := Open(p, point, srs.Pk)
+	require.NoError(t, err)
+	require.NoError(t, Verify(&digest, &proof, point, srs.Vk))
+}
+
+// openFixture opens testdata/name, or skips the test if the file is missing
+func openFixture(t *testing.T, name string) *os.File {
+	f, err := os.Open(filepath.Join("testdata", name))
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("testdata/%s is missing", name)
+	}
+	require.NoError(t, err)
+	return f
+}
+
+// TestImportPtauFixture imports testdata/powersOfTau28_hez_final_08.ptau, the power 8
+// transcript of the Perpetual Powers of Tau ceremony prepared by Hermez, as listed in the
+// snarkjs README.
+func TestImportPtauFixture(t *testing.T) {
+	f := openFixture(t, "powersOfTau28_hez_final_08.ptau")
+	defer f.Close()
+
+	srs, err := ImportPtau(f, 1<<8)
+	require.NoError(t, err)
+	checkImportedSRS(t, srs)
+}
@@ -0,0 +1,132 @@
+package bn254
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// arkworks and SEC 1 encodings of the generators, their opposite and the point
+// at infinity. The arkworks encodings are derived from the ark-serialize 0.4
+// rules.
+func TestEncodingVectors(t *testing.T) {
+	t.Parallel()
+
+	var g1Neg G1Affine
+	g1Neg.Neg(&g1GenAff)
+	var g1Inf G1Affine
+	var g2Inf G2Affine
+
+	g1Vectors := []struct {
+		p          *G1Affine
+		e          ecc.Encoding
+		compressed bool
+		encoding   string
+	}{
+		{&g1GenAff, ecc.ArkworksEncoding, true, "0100000000000000000000000000000000000000000000000000000000000000"},
+		{&g1GenAff, ecc.ArkworksEncoding, false, "0100000000000000000000000000000000000000000000000000000000000000" +
+			"0200000000000000000000000000000000000000000000000000000000000000"},
+		{&g1Neg, ecc.ArkworksEncoding, true, "0100000000000000000000000000000000000000000000000000000000000080"},
+		{&g1Neg, ecc.ArkworksEncoding, false, "0100000000000000000000000000000000000000000000000000000000000000" +
+			"45fd7cd8168c203c8dca7168916a81975d588181b64550b829a031e1724e64b0"},
+		{&g1Inf, ecc.ArkworksEncoding, true, "0000000000000000000000000000000000000000000000000000000000000040"},
+		{&g1Inf, ecc.ArkworksEncoding, false, "0000000000000000000000000000000000000000000000000000000000000000" +
+			"0000000000000000000000000000000000000000000000000000000000000040"},
+		{&g1GenAff, ecc.SEC1Encoding, true, "020000000000000000000000000000000000000000000000000000000000000001"},
+		{&g1Neg, ecc.SEC1Encoding, true, "030000000000000000000000000000000000000000000000000000000000000001"},
+		{&g1GenAff, ecc.SEC1Encoding, false, "040000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000002"},
+		{&g1Inf, ecc.SEC1Encoding, true, "00"},
+	}
+	for _, v := range g1Vectors {
+		checkEncodingVector(t, v.p, v.e, v.compressed, v.encoding)
+	}
+
+	g2Vectors := []struct {
+		p          *G2Affine
+		compressed bool
+		encoding   string
+	}{
+		{&g2GenAff, true, "edf692d95cbdde46ddda5ef7d422436779445c5e66006a42761e1f12efde0018" +
+			"c212f3aeb785e49712e7a9353349aaf1255dfb31b7bf60723a480d9293938e19"},
+		{&g2GenAff, false, "edf692d95cbdde46ddda5ef7d422436779445c5e66006a42761e1f12efde0018" +
+			"c212f3aeb785e49712e7a9353349aaf1255dfb31b7bf60723a480d9293938e19" +
+			"aa7dfa6601cce64c7bd3430c69e7d1e38f40cb8d8071ab4aeb6d8cdba55ec812" +
+			"5b9722d1dcdaac55f38eb37033314bbc95330c69ad999eec75f05f58d0890609"},
+		{&g2Inf, true, "0000000000000000000000000000000000000000000000000000000000000000" +
+			"0000000000000000000000000000000000000000000000000000000000000040"},
+	}
+	for _, v := range g2Vectors {
+		checkEncodingVector(t, v.p, ecc.ArkworksEncoding, v.compressed, v.encoding)
+	}
+
+	// invalid encodings
+	invalid := []struct {
+		e          ecc.Encoding
+		compressed bool
+		encoding   string
+	}{
+		// both flags set
+		{ecc.ArkworksEncoding, true, "01000000000000000000000000000000000000000000000000000000000000c0"},
+		// infinity with a non zero coordinate
+		{ecc.ArkworksEncoding, true, "0100000000000000000000000000000000000000000000000000000000000040"},
+		// x = p
+		{ecc.ArkworksEncoding, true, "47fd7cd8168c203c8dca7168916a81975d588181b64550b829a031e1724e6430"},
+		// (0, 0) without the infinity flag
+		{ecc.ArkworksEncoding, false, "0000000000000000000000000000000000000000000000000000000000000000" +
+			"0000000000000000000000000000000000000000000000000000000000000000"},
+		// not on the curve
+		{ecc.SEC1Encoding, false, "040000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000003"},
+		// invalid tag
+		{ecc.SEC1Encoding, true, "050000000000000000000000000000000000000000000000000000000000000001"},
+	}
+	for _, v := range invalid {
+		var p G1Affine
+		buf, _ := hex.DecodeString(v.encoding)
+		if _, err := p.SetBytesWithEncoding(v.e, v.compressed, buf); err == nil {
+			t.Fatalf("%s: invalid encoding %s accepted", v.e, v.encoding)
+		}
+	}
+
+	// arkworks writes the field elements and the slice lengths in little-endian
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, WithEncoding(ecc.ArkworksEncoding))
+	if err := enc.Encode([]fr.Element{fr.NewElement(1), fr.NewElement(258)}); err != nil {
+		t.Fatal(err)
+	}
+	expected := "0200000000000000" +
+		"0100000000000000000000000000000000000000000000000000000000000000" +
+		"0201000000000000000000000000000000000000000000000000000000000000"
+	if hex.EncodeToString(buf.Bytes()) != expected {
+		t.Fatalf("unexpected arkworks encoding of []fr.Element %x", buf.Bytes())
+	}
+}
+
+type encodablePoint[T any] interface {
+	*T
+	BytesWithEncoding(ecc.Encoding, bool) ([]byte, error)
+	SetBytesWithEncoding(ecc.Encoding, bool, []byte) (int, error)
+	Equal(*T) bool
+}
+
+func checkEncodingVector[T any, PT encodablePoint[T]](t *testing.T, p PT, e ecc.Encoding, compressed bool, encoding string) {
+	t.Helper()
+	buf, err := p.BytesWithEncoding(e, compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(buf) != encoding {
+		t.Fatalf("%s (compressed=%v): expected %s, got %x", e, compressed, encoding, buf)
+	}
+	var q T
+	if _, err = PT(&q).SetBytesWithEncoding(e, compressed, buf); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(&q) {
+		t.Fatalf("%s (compressed=%v): decoding %s failed", e, compressed, encoding)
+	}
+}
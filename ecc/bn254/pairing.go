@@ -124,6 +124,15 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 	if n == 0 || n != len(Q) {
 		return GT{}, errors.New("invalid inputs sizes")
 	}
+	return millerLoopMixed(P, Q, nil, nil)
+}
+
+// millerLoopMixed computes the multi-Miller loop of the pairs (Pᵢ, Qᵢ), as in
+// MillerLoop, together with the one of the pairs (fixedPⱼ, Q'ⱼ) where the lines
+// of Q'ⱼ are precomputed, as in MillerLoopFixedQ. The squarings are shared among
+// all the pairs and the precomputed lines are not modified.
+func millerLoopMixed(P []G1Affine, Q []G2Affine, fixedP []G1Affine, lines [][2][len(LoopCounter)]LineEvaluationAff) (GT, error) {
+	n := len(P)
 
 	// filter infinity points
 	p := make([]G1Affine, 0, n)
@@ -146,6 +155,9 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		qNeg[k].Neg(&q[k])
 	}
 
+	// precomputations for the fixed pairs
+	yInv, xNegOverY := fixedLinesPrecomputations(fixedP)
+
 	var result GT
 	result.SetOne()
 	var l2, l1 lineEvaluation
@@ -195,6 +207,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		// ℓ × res
 		result.MulBy034(&l1.r0, &l1.r1, &l1.r2)
 	}
+	mulByFixedLines(&result, lines, yInv, xNegOverY, 64)
 
 	// i = 63, separately to avoid a doubleStep (LoopCounter[63]=-1)
 	// (at this point qProj = 2Q, so 2qProj-Q=3Q is equivalent to qProj+Q=3Q
@@ -220,6 +233,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		// (ℓ × ℓ) × res
 		result.MulBy01234(&prodLines)
 	}
+	mulByFixedLines(&result, lines, yInv, xNegOverY, 63)
 
 	// i <= 62
 	for i := len(LoopCounter) - 4; i >= 0; i-- {
@@ -262,6 +276,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 				result.MulBy034(&l1.r0, &l1.r1, &l1.r2)
 			}
 		}
+		mulByFixedLines(&result, lines, yInv, xNegOverY, i)
 	}
 
 	// Compute  ∏ᵢ { ℓᵢ_{[6x₀+2]Q,π(Q)}(P) · ℓᵢ_{[6x₀+2]Q+π(Q),-π²(Q)}(P) }
@@ -294,6 +309,7 @@ func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
 		// (ℓ × ℓ) × res
 		result.MulBy01234(&prodLines)
 	}
+	mulByFixedLines(&result, lines, yInv, xNegOverY, 65)
 
 	return result, nil
 }
@@ -721,3 +737,44 @@ func (p *G2Affine) doubleAndAddStep(evaluations1, evaluations2 *LineEvaluationAf
 	p.X.Set(&x4)
 	p.Y.Set(&y4)
 }
+
+// fixedLinesPrecomputations returns 1/y and -x/y for the points P, used to
+// evaluate the precomputed lines at P.
+func fixedLinesPrecomputations(P []G1Affine) (yInv, xNegOverY []fp.Element) {
+	n := len(P)
+	yInv = make([]fp.Element, n)
+	xNegOverY = make([]fp.Element, n)
+	for k := 0; k < n; k++ {
+		yInv[k].Set(&P[k].Y)
+	}
+	yInv = fp.BatchInvert(yInv)
+	for k := 0; k < n; k++ {
+		xNegOverY[k].Mul(&P[k].X, &yInv[k]).
+			Neg(&xNegOverY[k])
+	}
+	return
+}
+
+// mulByFixedLines multiplies result by the precomputed lines of index i, evaluated
+// at the points given by fixedLinesPrecomputations. The lines are not modified.
+func mulByFixedLines(result *GT, lines [][2][len(LoopCounter)]LineEvaluationAff, yInv, xNegOverY []fp.Element, i int) {
+	var l0, l1 LineEvaluationAff
+	var prodLines [5]E2
+	for k := range lines {
+		// line evaluation at P[k]
+		l0.R0.MulByElement(&lines[k][0][i].R0, &xNegOverY[k])
+		l0.R1.MulByElement(&lines[k][0][i].R1, &yInv[k])
+		// the last index holds the two lines of the Frobenius steps
+		if i < len(LoopCounter)-1 && LoopCounter[i] == 0 {
+			// ℓ × res
+			result.MulBy34(&l0.R0, &l0.R1)
+			continue
+		}
+		l1.R0.MulByElement(&lines[k][1][i].R0, &xNegOverY[k])
+		l1.R1.MulByElement(&lines[k][1][i].R1, &yInv[k])
+		// ℓ × ℓ
+		prodLines = fptower.Mul34By34(&l0.R0, &l0.R1, &l1.R0, &l1.R1)
+		// (ℓ × ℓ) × res
+		result.MulBy01234(&prodLines)
+	}
+}
@@ -0,0 +1,867 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c90937)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_110     = 1 + _101
+	//	_111     = 1 + _110
+	//	_1100    = _101 + _111
+	//	_1101    = 1 + _1100
+	//	_10001   = _101 + _1100
+	//	_10011   = _10 + _10001
+	//	_10111   = _110 + _10001
+	//	_11001   = _10 + _10111
+	//	_11011   = _10 + _11001
+	//	_11111   = _110 + _11001
+	//	_100001  = _10 + _11111
+	//	_100101  = _110 + _11111
+	//	_100111  = _10 + _100101
+	//	_101001  = _10 + _100111
+	//	_101011  = _10 + _101001
+	//	_110111  = _1100 + _101011
+	//	_111001  = _10 + _110111
+	//	_111011  = _10 + _111001
+	//	_1100000 = _100101 + _111011
+	//	i46      = ((_1100000 << 5 + _11001) << 9 + _100111) << 8
+	//	i62      = ((_111001 + i46) << 4 + _111) << 9 + _10011
+	//	i89      = ((i62 << 7 + _1101) << 13 + _101001) << 5
+	//	i109     = ((_10111 + i89) << 7 + _101) << 10 + _10001
+	//	i130     = ((i109 << 6 + _11011) << 5 + _1101) << 8
+	//	i154     = ((_11 + i130) << 12 + _101011) << 9 + _10111
+	//	i177     = ((i154 << 7 + _101011) << 7 + _11111) << 7
+	//	i198     = ((_111011 + i177) << 7 + _110111) << 11 + _111001
+	//	i215     = 2*((i198 << 3 + 1) << 11 + _111011)
+	//	i235     = ((1 + i215) << 11 + _101001) << 6 + _100111
+	//	i257     = ((i235 << 7 + _111001) << 7 + _100101) << 6
+	//	i272     = ((_111011 + i257 + _10) << 2 + _11) << 10
+	//	i290     = ((_111001 + i272) << 8 + _100001) << 7 + _10011
+	//	return     i290 << 4 + _111
+	//
+	// Operations: 240 squares 55 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: t3 = x^0x3
+	t3.Mul(&x, t4)
+
+	// Step 3: t16 = x^0x5
+	t16.Mul(t4, t3)
+
+	// Step 4: t5 = x^0x6
+	t5.Mul(&x, t16)
+
+	// Step 5: z = x^0x7
+	z.Mul(&x, t5)
+
+	// Step 6: t2 = x^0xc
+	t2.Mul(t16, z)
+
+	// Step 7: t13 = x^0xd
+	t13.Mul(&x, t2)
+
+	// Step 8: t15 = x^0x11
+	t15.Mul(t16, t2)
+
+	// Step 9: t0 = x^0x13
+	t0.Mul(t4, t15)
+
+	// Step 10: t12 = x^0x17
+	t12.Mul(t5, t15)
+
+	// Step 11: t17 = x^0x19
+	t17.Mul(t4, t12)
+
+	// Step 12: t14 = x^0x1b
+	t14.Mul(t4, t17)
+
+	// Step 13: t10 = x^0x1f
+	t10.Mul(t5, t17)
+
+	// Step 14: t1 = x^0x21
+	t1.Mul(t4, t10)
+
+	// Step 15: t6 = x^0x25
+	t6.Mul(t5, t10)
+
+	// Step 16: t7 = x^0x27
+	t7.Mul(t4, t6)
+
+	// Step 17: t8 = x^0x29
+	t8.Mul(t4, t7)
+
+	// Step 18: t11 = x^0x2b
+	t11.Mul(t4, t8)
+
+	// Step 19: t9 = x^0x37
+	t9.Mul(t2, t11)
+
+	// Step 20: t2 = x^0x39
+	t2.Mul(t4, t9)
+
+	// Step 21: t5 = x^0x3b
+	t5.Mul(t4, t2)
+
+	// Step 22: t18 = x^0x60
+	t18.Mul(t6, t5)
+
+	// Step 27: t18 = x^0xc00
+	for s := 0; s < 5; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 28: t17 = x^0xc19
+	t17.Mul(t17, t18)
+
+	// Step 37: t17 = x^0x183200
+	for s := 0; s < 9; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 38: t17 = x^0x183227
+	t17.Mul(t7, t17)
+
+	// Step 46: t17 = x^0x18322700
+	for s := 0; s < 8; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 47: t17 = x^0x18322739
+	t17.Mul(t2, t17)
+
+	// Step 51: t17 = x^0x183227390
+	for s := 0; s < 4; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 52: t17 = x^0x183227397
+	t17.Mul(z, t17)
+
+	// Step 61: t17 = x^0x30644e72e00
+	for s := 0; s < 9; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 62: t17 = x^0x30644e72e13
+	t17.Mul(t0, t17)
+
+	// Step 69: t17 = x^0x1832273970980
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 70: t17 = x^0x183227397098d
+	t17.Mul(t13, t17)
+
+	// Step 83: t17 = x^0x30644e72e131a000
+	for s := 0; s < 13; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 84: t17 = x^0x30644e72e131a029
+	t17.Mul(t8, t17)
+
+	// Step 89: t17 = x^0x60c89ce5c26340520
+	for s := 0; s < 5; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 90: t17 = x^0x60c89ce5c26340537
+	t17.Mul(t12, t17)
+
+	// Step 97: t17 = x^0x30644e72e131a029b80
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 98: t16 = x^0x30644e72e131a029b85
+	t16.Mul(t16, t17)
+
+	// Step 108: t16 = x^0xc19139cb84c680a6e1400
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 109: t15 = x^0xc19139cb84c680a6e1411
+	t15.Mul(t15, t16)
+
+	// Step 115: t15 = x^0x30644e72e131a029b850440
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 116: t14 = x^0x30644e72e131a029b85045b
+	t14.Mul(t14, t15)
+
+	// Step 121: t14 = x^0x60c89ce5c263405370a08b60
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 122: t13 = x^0x60c89ce5c263405370a08b6d
+	t13.Mul(t13, t14)
+
+	// Step 130: t13 = x^0x60c89ce5c263405370a08b6d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 131: t13 = x^0x60c89ce5c263405370a08b6d03
+	t13.Mul(t3, t13)
+
+	// Step 143: t13 = x^0x60c89ce5c263405370a08b6d03000
+	for s := 0; s < 12; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 144: t13 = x^0x60c89ce5c263405370a08b6d0302b
+	t13.Mul(t11, t13)
+
+	// Step 153: t13 = x^0xc19139cb84c680a6e14116da0605600
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 154: t12 = x^0xc19139cb84c680a6e14116da0605617
+	t12.Mul(t12, t13)
+
+	// Step 161: t12 = x^0x60c89ce5c263405370a08b6d0302b0b80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 162: t11 = x^0x60c89ce5c263405370a08b6d0302b0bab
+	t11.Mul(t11, t12)
+
+	// Step 169: t11 = x^0x30644e72e131a029b85045b68181585d580
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 170: t10 = x^0x30644e72e131a029b85045b68181585d59f
+	t10.Mul(t10, t11)
+
+	// Step 177: t10 = x^0x183227397098d014dc2822db40c0ac2eacf80
+	for s := 0; s < 7; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 178: t10 = x^0x183227397098d014dc2822db40c0ac2eacfbb
+	t10.Mul(t5, t10)
+
+	// Step 185: t10 = x^0xc19139cb84c680a6e14116da0605617567dd80
+	for s := 0; s < 7; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 186: t9 = x^0xc19139cb84c680a6e14116da0605617567ddb7
+	t9.Mul(t9, t10)
+
+	// Step 197: t9 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 198: t9 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb839
+	t9.Mul(t2, t9)
+
+	// Step 201: t9 = x^0x30644e72e131a029b85045b68181585d59f76dc1c8
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 202: t9 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9
+	t9.Mul(&x, t9)
+
+	// Step 213: t9 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e4800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 214: t9 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b
+	t9.Mul(t5, t9)
+
+	// Step 215: t9 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9076
+	t9.Square(t9)
+
+	// Step 216: t9 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9077
+	t9.Mul(&x, t9)
+
+	// Step 227: t9 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 228: t8 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b829
+	t8.Mul(t8, t9)
+
+	// Step 234: t8 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a40
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 235: t7 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a67
+	t7.Mul(t7, t8)
+
+	// Step 242: t7 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9077053380
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 243: t7 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b9
+	t7.Mul(t2, t7)
+
+	// Step 250: t7 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dc80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 251: t6 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dca5
+	t6.Mul(t6, t7)
+
+	// Step 257: t6 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a6772940
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 258: t5 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297b
+	t5.Mul(t5, t6)
+
+	// Step 259: t4 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297d
+	t4.Mul(t4, t5)
+
+	// Step 261: t4 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dca5f4
+	for s := 0; s < 2; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 262: t3 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dca5f7
+	t3.Mul(t3, t4)
+
+	// Step 272: t3 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297dc00
+	for s := 0; s < 10; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 273: t2 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297dc39
+	t2.Mul(t2, t3)
+
+	// Step 281: t2 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297dc3900
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 282: t1 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297dc3921
+	t1.Mul(t1, t2)
+
+	// Step 289: t1 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c9080
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 290: t0 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c9093
+	t0.Mul(t0, t1)
+
+	// Step 294: t0 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c90930
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 295: z = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c90937
+	z.Mul(z, t0)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c909378)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10    = 2*1
+	//	_11    = 1 + _10
+	//	_101   = _10 + _11
+	//	_111   = _10 + _101
+	//	_1001  = _10 + _111
+	//	_1011  = _10 + _1001
+	//	_1101  = _10 + _1011
+	//	_11000 = _1011 + _1101
+	//	i24    = ((_11000 << 4 + _11) << 3 + 1) << 7
+	//	i34    = ((_1001 + i24) << 2 + _11) << 5 + _111
+	//	i51    = (2*(i34 << 6 + _1011) + 1) << 8
+	//	i62    = (2*(_1001 + i51) + 1) << 7 + _1101
+	//	i82    = ((i62 << 10 + _101) << 6 + _1101) << 2
+	//	i98    = ((_11 + i82) << 7 + _101) << 6 + 1
+	//	i115   = ((i98 << 7 + _1011) << 5 + _1101) << 3
+	//	i135   = ((_101 + i115) << 8 + _11) << 9 + _101
+	//	i151   = ((i135 << 3 + _11) << 8 + _1011) << 3
+	//	i165   = ((_101 + i151) << 5 + _1011) << 6 + _10
+	//	i176   = ((_1101 + i165) << 4 + _1011) << 4 + _1011
+	//	i193   = ((i176 << 5 + _1101) << 2 + _11) << 8
+	//	i210   = ((_111 + i193) << 6 + _1001) << 8 + _111
+	//	i228   = ((i210 << 4 + _111) << 8 + _101) << 4
+	//	i240   = ((_11 + i228) << 5 + _111) << 4 + _111
+	//	i256   = ((i240 << 5 + _101) << 6 + _1011) << 3
+	//	i270   = ((_111 + i256) << 4 + _111) << 7 + _111
+	//	i292   = ((i270 << 6 + _1001) << 8 + _1001) << 6
+	//	return   ((_1101 + i292) << 3 + _111) << 3
+	//
+	// Operations: 246 squares 54 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6 Element
+	// Step 1: t5 = x^0x2
+	t5.Square(&x)
+
+	// Step 2: t4 = x^0x3
+	t4.Mul(&x, t5)
+
+	// Step 3: t3 = x^0x5
+	t3.Mul(t5, t4)
+
+	// Step 4: z = x^0x7
+	z.Mul(t5, t3)
+
+	// Step 5: t1 = x^0x9
+	t1.Mul(t5, z)
+
+	// Step 6: t2 = x^0xb
+	t2.Mul(t5, t1)
+
+	// Step 7: t0 = x^0xd
+	t0.Mul(t5, t2)
+
+	// Step 8: t6 = x^0x18
+	t6.Mul(t2, t0)
+
+	// Step 12: t6 = x^0x180
+	for s := 0; s < 4; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 13: t6 = x^0x183
+	t6.Mul(t4, t6)
+
+	// Step 16: t6 = x^0xc18
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 17: t6 = x^0xc19
+	t6.Mul(&x, t6)
+
+	// Step 24: t6 = x^0x60c80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 25: t6 = x^0x60c89
+	t6.Mul(t1, t6)
+
+	// Step 27: t6 = x^0x183224
+	for s := 0; s < 2; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 28: t6 = x^0x183227
+	t6.Mul(t4, t6)
+
+	// Step 33: t6 = x^0x30644e0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 34: t6 = x^0x30644e7
+	t6.Mul(z, t6)
+
+	// Step 40: t6 = x^0xc19139c0
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 41: t6 = x^0xc19139cb
+	t6.Mul(t2, t6)
+
+	// Step 42: t6 = x^0x183227396
+	t6.Square(t6)
+
+	// Step 43: t6 = x^0x183227397
+	t6.Mul(&x, t6)
+
+	// Step 51: t6 = x^0x18322739700
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 52: t6 = x^0x18322739709
+	t6.Mul(t1, t6)
+
+	// Step 53: t6 = x^0x30644e72e12
+	t6.Square(t6)
+
+	// Step 54: t6 = x^0x30644e72e13
+	t6.Mul(&x, t6)
+
+	// Step 61: t6 = x^0x1832273970980
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 62: t6 = x^0x183227397098d
+	t6.Mul(t0, t6)
+
+	// Step 72: t6 = x^0x60c89ce5c263400
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 73: t6 = x^0x60c89ce5c263405
+	t6.Mul(t3, t6)
+
+	// Step 79: t6 = x^0x183227397098d0140
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 80: t6 = x^0x183227397098d014d
+	t6.Mul(t0, t6)
+
+	// Step 82: t6 = x^0x60c89ce5c26340534
+	for s := 0; s < 2; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 83: t6 = x^0x60c89ce5c26340537
+	t6.Mul(t4, t6)
+
+	// Step 90: t6 = x^0x30644e72e131a029b80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 91: t6 = x^0x30644e72e131a029b85
+	t6.Mul(t3, t6)
+
+	// Step 97: t6 = x^0xc19139cb84c680a6e140
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 98: t6 = x^0xc19139cb84c680a6e141
+	t6.Mul(&x, t6)
+
+	// Step 105: t6 = x^0x60c89ce5c263405370a080
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 106: t6 = x^0x60c89ce5c263405370a08b
+	t6.Mul(t2, t6)
+
+	// Step 111: t6 = x^0xc19139cb84c680a6e141160
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 112: t6 = x^0xc19139cb84c680a6e14116d
+	t6.Mul(t0, t6)
+
+	// Step 115: t6 = x^0x60c89ce5c263405370a08b68
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 116: t6 = x^0x60c89ce5c263405370a08b6d
+	t6.Mul(t3, t6)
+
+	// Step 124: t6 = x^0x60c89ce5c263405370a08b6d00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 125: t6 = x^0x60c89ce5c263405370a08b6d03
+	t6.Mul(t4, t6)
+
+	// Step 134: t6 = x^0xc19139cb84c680a6e14116da0600
+	for s := 0; s < 9; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 135: t6 = x^0xc19139cb84c680a6e14116da0605
+	t6.Mul(t3, t6)
+
+	// Step 138: t6 = x^0x60c89ce5c263405370a08b6d03028
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 139: t6 = x^0x60c89ce5c263405370a08b6d0302b
+	t6.Mul(t4, t6)
+
+	// Step 147: t6 = x^0x60c89ce5c263405370a08b6d0302b00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 148: t6 = x^0x60c89ce5c263405370a08b6d0302b0b
+	t6.Mul(t2, t6)
+
+	// Step 151: t6 = x^0x30644e72e131a029b85045b681815858
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 152: t6 = x^0x30644e72e131a029b85045b68181585d
+	t6.Mul(t3, t6)
+
+	// Step 157: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 158: t6 = x^0x60c89ce5c263405370a08b6d0302b0bab
+	t6.Mul(t2, t6)
+
+	// Step 164: t6 = x^0x183227397098d014dc2822db40c0ac2eac0
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 165: t5 = x^0x183227397098d014dc2822db40c0ac2eac2
+	t5.Mul(t5, t6)
+
+	// Step 166: t5 = x^0x183227397098d014dc2822db40c0ac2eacf
+	t5.Mul(t0, t5)
+
+	// Step 170: t5 = x^0x183227397098d014dc2822db40c0ac2eacf0
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 171: t5 = x^0x183227397098d014dc2822db40c0ac2eacfb
+	t5.Mul(t2, t5)
+
+	// Step 175: t5 = x^0x183227397098d014dc2822db40c0ac2eacfb0
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 176: t5 = x^0x183227397098d014dc2822db40c0ac2eacfbb
+	t5.Mul(t2, t5)
+
+	// Step 181: t5 = x^0x30644e72e131a029b85045b68181585d59f760
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 182: t5 = x^0x30644e72e131a029b85045b68181585d59f76d
+	t5.Mul(t0, t5)
+
+	// Step 184: t5 = x^0xc19139cb84c680a6e14116da0605617567ddb4
+	for s := 0; s < 2; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 185: t5 = x^0xc19139cb84c680a6e14116da0605617567ddb7
+	t5.Mul(t4, t5)
+
+	// Step 193: t5 = x^0xc19139cb84c680a6e14116da0605617567ddb700
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 194: t5 = x^0xc19139cb84c680a6e14116da0605617567ddb707
+	t5.Mul(z, t5)
+
+	// Step 200: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 201: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9
+	t5.Mul(t1, t5)
+
+	// Step 209: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c900
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 210: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c907
+	t5.Mul(z, t5)
+
+	// Step 214: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9070
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 215: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9077
+	t5.Mul(z, t5)
+
+	// Step 223: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c907700
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 224: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c907705
+	t5.Mul(t3, t5)
+
+	// Step 228: t5 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9077050
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 229: t4 = x^0x30644e72e131a029b85045b68181585d59f76dc1c9077053
+	t4.Mul(t4, t5)
+
+	// Step 234: t4 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a60
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 235: t4 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a67
+	t4.Mul(z, t4)
+
+	// Step 239: t4 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a670
+	for s := 0; s < 4; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 240: t4 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677
+	t4.Mul(z, t4)
+
+	// Step 245: t4 = x^0xc19139cb84c680a6e14116da0605617567ddb707241dc14cee0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 246: t3 = x^0xc19139cb84c680a6e14116da0605617567ddb707241dc14cee5
+	t3.Mul(t3, t4)
+
+	// Step 252: t3 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b940
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 253: t2 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94b
+	t2.Mul(t2, t3)
+
+	// Step 256: t2 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dca58
+	for s := 0; s < 3; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 257: t2 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dca5f
+	t2.Mul(z, t2)
+
+	// Step 261: t2 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dca5f0
+	for s := 0; s < 4; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 262: t2 = x^0x183227397098d014dc2822db40c0ac2eacfbb6e0e483b8299dca5f7
+	t2.Mul(z, t2)
+
+	// Step 269: t2 = x^0xc19139cb84c680a6e14116da0605617567ddb707241dc14cee52fb80
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 270: t2 = x^0xc19139cb84c680a6e14116da0605617567ddb707241dc14cee52fb87
+	t2.Mul(z, t2)
+
+	// Step 276: t2 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c0
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 277: t2 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c9
+	t2.Mul(t1, t2)
+
+	// Step 285: t2 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c900
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 286: t1 = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c909
+	t1.Mul(t1, t2)
+
+	// Step 292: t1 = x^0xc19139cb84c680a6e14116da0605617567ddb707241dc14cee52fb8724240
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 293: t0 = x^0xc19139cb84c680a6e14116da0605617567ddb707241dc14cee52fb872424d
+	t0.Mul(t0, t1)
+
+	// Step 296: t0 = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297dc3921268
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 297: z = x^0x60c89ce5c263405370a08b6d0302b0bab3eedb83920ee0a677297dc392126f
+	z.Mul(z, t0)
+
+	// Step 300: z = x^0x30644e72e131a029b85045b68181585d59f76dc1c90770533b94bee1c909378
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
@@ -105,3 +105,50 @@ func (z *Element) Square(x *Element) *Element {
 	mul(z, x, x)
 	return z
 }
+
+//go:noescape
+func mulVecIFMA(res, a, b *Element, n uint64)
+
+//go:noescape
+func scalarMulVecIFMA(res, a, b *Element, n uint64)
+
+//go:noescape
+func butterflyVecIFMA(a, b *Element, n uint64)
+
+func addVec(res, a, b Vector) {
+	addVecGeneric(res, a, b)
+}
+
+func subVec(res, a, b Vector) {
+	subVecGeneric(res, a, b)
+}
+
+func scalarMulVec(res, a Vector, b *Element) {
+	n := len(a) / 8
+	if !supportAvx512IFMA || n == 0 {
+		scalarMulVecGeneric(res, a, b)
+		return
+	}
+	scalarMulVecIFMA(&res[0], &a[0], b, uint64(n))
+	scalarMulVecGeneric(res[8*n:], a[8*n:], b)
+}
+
+func mulVec(res, a, b Vector) {
+	n := len(a) / 8
+	if !supportAvx512IFMA || n == 0 {
+		mulVecGeneric(res, a, b)
+		return
+	}
+	mulVecIFMA(&res[0], &a[0], &b[0], uint64(n))
+	mulVecGeneric(res[8*n:], a[8*n:], b[8*n:])
+}
+
+func butterflyVec(a, b Vector) {
+	n := len(a) / 8
+	if !supportAvx512IFMA || n == 0 {
+		butterflyVecGeneric(a, b)
+		return
+	}
+	butterflyVecIFMA(&a[0], &b[0], uint64(n))
+	butterflyVecGeneric(a[8*n:], b[8*n:])
+}
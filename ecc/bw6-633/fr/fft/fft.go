@@ -43,6 +43,11 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 
 	opt := fftOptions(opts...)
 
+	if domain.radices != nil {
+		domain.fftMixedRadix(a, decimation, false, opt)
+		return
+	}
+
 	// find the stage where we should stop spawning go routines in our recursive calls
 	// (ie when we have as many go routines running as we have available CPUs)
 	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
@@ -117,10 +122,15 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 // if decimation == DIT (decimation in time), the input must be in bit-reversed order
 // if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
 // coset sets the shift of the fft (0 = no shift, standard fft)
-// len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+// len(a) must be the cardinality of the domain.
 func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...Option) {
 	opt := fftOptions(opts...)
 
+	if domain.radices != nil {
+		domain.fftMixedRadix(a, decimation, true, opt)
+		return
+	}
+
 	// find the stage where we should stop spawning go routines in our recursive calls
 	// (ie when we have as many go routines running as we have available CPUs)
 	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(opt.nbTasks)))
@@ -267,10 +277,10 @@ func innerDIFWithTwiddles(a []fr.Element, twiddles []fr.Element, start, end, m i
 		fr.Butterfly(&a[0], &a[m])
 		start++
 	}
-	for i := start; i < end; i++ {
-		fr.Butterfly(&a[i], &a[i+m])
-		a[i+m].Mul(&a[i+m], &twiddles[i])
-	}
+	// vector operations use the vectorized kernels when available (see fr.Vector)
+	u, v := fr.Vector(a[start:end]), fr.Vector(a[start+m:end+m])
+	u.Butterfly(v)
+	v.Mul(v, twiddles[start:end])
 }
 
 func innerDIFWithoutTwiddles(a []fr.Element, at, w fr.Element, start, end, m int) {
@@ -348,10 +358,10 @@ func innerDITWithTwiddles(a []fr.Element, twiddles []fr.Element, start, end, m i
 		fr.Butterfly(&a[0], &a[m])
 		start++
 	}
-	for i := start; i < end; i++ {
-		a[i+m].Mul(&a[i+m], &twiddles[i])
-		fr.Butterfly(&a[i], &a[i+m])
-	}
+	// vector operations use the vectorized kernels when available (see fr.Vector)
+	u, v := fr.Vector(a[start:end]), fr.Vector(a[start+m:end+m])
+	v.Mul(v, twiddles[start:end])
+	u.Butterfly(v)
 }
 
 func innerDITWithoutTwiddles(a []fr.Element, at, w fr.Element, start, end, m int) {
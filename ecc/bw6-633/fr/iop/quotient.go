@@ -18,7 +18,6 @@ package iop
 
 import (
 	"math/big"
-	"math/bits"
 
 	"github.com/consensys/gnark-crypto/internal/parallel"
 
@@ -48,10 +47,9 @@ func DivideByXMinusOne(a *Polynomial, domains [2]*fft.Domain) (*Polynomial, erro
 	res.size = a.size
 	res.blindedSize = a.blindedSize
 
-	nn := uint64(64 - bits.TrailingZeros(uint(nbElmts)))
 	parallel.Execute(a.coefficients.Len(), func(start, end int) {
 		for i := start; i < end; i++ {
-			iRev := bits.Reverse64(uint64(i)) >> nn
+			iRev := fft.BitReverseIndex(uint64(i), uint64(nbElmts))
 			c := a.GetCoeff(i)
 			(*res.coefficients)[iRev].
 				Mul(&c, &xnMinusOneInverseLagrangeCoset[i%rho])
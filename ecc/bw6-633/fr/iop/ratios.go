@@ -19,7 +19,6 @@ package iop
 import (
 	"errors"
 	"math/big"
-	"math/bits"
 	"runtime"
 	"sync"
 
@@ -37,7 +36,7 @@ var (
 	ErrInconsistentFormat         = errors.New("the format of the polynomials must be the same")
 	ErrInconsistentSize           = errors.New("the sizes of the polynomial must be the same as the size of the domain")
 	ErrNumberPolynomials          = errors.New("the number of polynomials in the denominator and the numerator must be the same")
-	ErrSizeNotPowerOfTwo          = errors.New("the size of the polynomials must be a power of two")
+	ErrSizeNotPowerOfTwo          = errors.New("the size of the polynomials must be a power of two or a smooth divisor of r-1")
 	ErrInconsistentSizeDomain     = errors.New("the size of the domain must be consistent with the size of the polynomials")
 	ErrIncorrectNumberOfVariables = errors.New("the number of variables is incorrect")
 )
@@ -92,13 +91,12 @@ func BuildRatioShuffledVectors(numerator, denominator []*Polynomial, beta fr.Ele
 	t[0].SetOne()
 	var a, b, c, d fr.Element
 
-	nn := uint64(64 - bits.TrailingZeros(uint(n)))
 	for i := 0; i < n-1; i++ {
 
 		b.SetOne()
 		d.SetOne()
 
-		iRev := bits.Reverse64(uint64(i)) >> nn
+		iRev := fft.BitReverseIndex(uint64(i), uint64(n))
 
 		for j := 0; j < nbPolynomials; j++ {
 
@@ -184,12 +182,11 @@ func BuildRatioCopyConstraint(
 
 	parallel.Execute(n-1, func(start, end int) {
 		var a, b, c, d fr.Element
-		nn := uint64(64 - bits.TrailingZeros(uint(n)))
 		for i := start; i < end; i++ {
 			b.SetOne()
 			d.SetOne()
 
-			iRev := int(bits.Reverse64(uint64(i)) >> nn)
+			iRev := int(fft.BitReverseIndex(uint64(i), uint64(n)))
 
 			for j, p := range entries {
 				idx := i
@@ -302,17 +299,16 @@ func checkSize(pols ...[]*Polynomial) error {
 }
 
 // buildDomain builds the fft domain necessary to do FFTs.
-// n is the cardinality of the domain, it must be a power of 2.
+// n is the cardinality of the domain, it must be a power of 2 or a divisor of r-1
+// made of small primes (see fft.WithMixedRadix).
 func buildDomain(n int, domain *fft.Domain) (*fft.Domain, error) {
 
-	// check if the sizes are a power of 2
-	if n&(n-1) != 0 {
-		return nil, ErrSizeNotPowerOfTwo
-	}
-
 	// if the domain doesn't exist we create it.
 	if domain == nil {
-		domain = fft.NewDomain(uint64(n))
+		var err error
+		if domain, err = newDomain(n); err != nil {
+			return nil, err
+		}
 	}
 
 	// in case domain was not nil, it must match the size of the polynomials.
@@ -370,3 +366,36 @@ func getSupportIdentityPermutation(nbCopies int, domain *fft.Domain) []fr.Elemen
 
 	return res
 }
+
+// newDomain returns a domain of cardinality exactly n. If n is not a power of 2,
+// it must divide r-1 and its prime factors must be small, in which case the domain
+// is a mixed radix domain.
+func newDomain(n int) (*fft.Domain, error) {
+	if n <= 0 {
+		return nil, ErrSizeNotPowerOfTwo
+	}
+	if n&(n-1) == 0 {
+		return fft.NewDomain(uint64(n)), nil
+	}
+
+	var rMinusOne, rem big.Int
+	rMinusOne.Sub(fr.Modulus(), big.NewInt(1))
+	if rem.Mod(&rMinusOne, big.NewInt(int64(n))).Sign() != 0 {
+		return nil, ErrSizeNotPowerOfTwo
+	}
+	m := n
+	for p := 2; p < 256 && m > 1; p++ {
+		for m%p == 0 {
+			m /= p
+		}
+	}
+	if m != 1 {
+		return nil, ErrSizeNotPowerOfTwo
+	}
+
+	domain := fft.NewDomain(uint64(n), fft.WithMixedRadix())
+	if domain.Cardinality != uint64(n) {
+		return nil, ErrSizeNotPowerOfTwo
+	}
+	return domain, nil
+}
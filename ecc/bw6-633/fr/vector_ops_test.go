@@ -0,0 +1,117 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import (
+	"fmt"
+	"testing"
+)
+
+func randomVector(t testing.TB, n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		if _, err := v[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// edge values
+	if n > 2 {
+		v[0].SetZero()
+		v[1].SetOne().Neg(&v[1])
+	}
+	return v
+}
+
+func TestVectorOps(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 8, 9, 16, 33, 64} {
+		a, b := randomVector(t, n), randomVector(t, n)
+		var scalar Element
+		scalar.SetRandom()
+
+		add, sub, mul, scalarMul := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+		add.Add(a, b)
+		sub.Sub(a, b)
+		mul.Mul(a, b)
+		scalarMul.ScalarMul(a, &scalar)
+		u, v := make(Vector, n), make(Vector, n)
+		copy(u, a)
+		copy(v, b)
+		u.Butterfly(v)
+
+		var sum, innerProduct Element
+		for i := 0; i < n; i++ {
+			var e, tmp Element
+			if !add[i].Equal(e.Add(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Add mismatch at %d", n, i)
+			}
+			if !sub[i].Equal(e.Sub(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Sub mismatch at %d", n, i)
+			}
+			if !mul[i].Equal(e.Mul(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Mul mismatch at %d", n, i)
+			}
+			if !scalarMul[i].Equal(e.Mul(&a[i], &scalar)) {
+				t.Fatalf("n=%d: ScalarMul mismatch at %d", n, i)
+			}
+			if !u[i].Equal(&add[i]) || !v[i].Equal(&sub[i]) {
+				t.Fatalf("n=%d: Butterfly mismatch at %d", n, i)
+			}
+			sum.Add(&sum, &a[i])
+			innerProduct.Add(&innerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+		if s := a.Sum(); !s.Equal(&sum) {
+			t.Fatalf("n=%d: Sum mismatch", n)
+		}
+		if ip := a.InnerProduct(b); !ip.Equal(&innerProduct) {
+			t.Fatalf("n=%d: InnerProduct mismatch", n)
+		}
+
+		// in place
+		a.Mul(a, b)
+		for i := range a {
+			if !a[i].Equal(&mul[i]) {
+				t.Fatalf("n=%d: in place Mul mismatch at %d", n, i)
+			}
+		}
+	}
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 10
+	a, c := randomVector(b, n), randomVector(b, n)
+	res := make(Vector, n)
+	b.Run(fmt.Sprintf("add/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run(fmt.Sprintf("mul/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run(fmt.Sprintf("scalarMul/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMul(a, &c[0])
+		}
+	})
+	b.Run(fmt.Sprintf("butterfly/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.Butterfly(c)
+		}
+	})
+}
@@ -0,0 +1,1058 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33bb8c6a0fae5561d0a29e4fdec)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_100     = 1 + _11
+	//	_101     = 1 + _100
+	//	_111     = _10 + _101
+	//	_1011    = _100 + _111
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_10001   = _10 + _1111
+	//	_10011   = _10 + _10001
+	//	_10101   = _10 + _10011
+	//	_10111   = _10 + _10101
+	//	_11001   = _10 + _10111
+	//	_11011   = _10 + _11001
+	//	_11101   = _10 + _11011
+	//	_11111   = _10 + _11101
+	//	_111110  = 2*_11111
+	//	_111111  = 1 + _111110
+	//	_1001100 = _1101 + _111111
+	//	i44      = ((_1001100 << 7 + _10001) << 4 + _1101) << 12
+	//	i53      = 2*((_10101 + i44) << 5 + _10101) + 1
+	//	i74      = ((i53 << 9 + _11011) << 5 + _1011) << 5
+	//	i93      = ((_101 + i74) << 8 + _1101) << 8 + _111111
+	//	i116     = ((i93 << 6 + _11101) << 7 + _10011) << 8
+	//	i130     = ((_10111 + i116) << 4 + _1101) << 7 + _10111
+	//	i147     = ((i130 << 2 + _11) << 8 + _10111) << 5
+	//	i162     = ((_10101 + i147) << 7 + _111111) << 5 + _1111
+	//	i188     = ((i162 << 3 + _11) << 10 + _11101) << 11
+	//	i199     = ((_1101 + i188) << 6 + _10101) << 2 + 1
+	//	i223     = ((i199 << 10 + _11) << 10 + _11011) << 2
+	//	i241     = ((_11 + i223) << 8 + _1111) << 7 + _11001
+	//	i259     = ((i241 << 6 + _10001) << 5 + _10011) << 5
+	//	i271     = ((_10111 + i259) << 4 + _111) << 5 + _11
+	//	i292     = ((i271 << 7 + _1101) << 2 + 1) << 10
+	//	i308     = ((_11111 + i292) << 6 + _10111) << 7 + _10101
+	//	i331     = ((i308 << 5 + _1011) << 9 + _11101) << 7
+	//	i346     = ((_101 + i331) << 6 + _101) << 6 + _1111
+	//	i364     = ((i346 << 3 + 1) << 8 + _111111) << 5
+	//	return     ((_1111 + i364) << 3 + _11) << 2
+	//
+	// Operations: 305 squares 66 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14 Element
+	// Step 1: t1 = x^0x2
+	t1.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, t1)
+
+	// Step 3: t0 = x^0x4
+	t0.Mul(&x, z)
+
+	// Step 4: t2 = x^0x5
+	t2.Mul(&x, t0)
+
+	// Step 5: t9 = x^0x7
+	t9.Mul(t1, t2)
+
+	// Step 6: t4 = x^0xb
+	t4.Mul(t0, t9)
+
+	// Step 7: t8 = x^0xd
+	t8.Mul(t1, t4)
+
+	// Step 8: t0 = x^0xf
+	t0.Mul(t1, t8)
+
+	// Step 9: t11 = x^0x11
+	t11.Mul(t1, t0)
+
+	// Step 10: t10 = x^0x13
+	t10.Mul(t1, t11)
+
+	// Step 11: t5 = x^0x15
+	t5.Mul(t1, t10)
+
+	// Step 12: t6 = x^0x17
+	t6.Mul(t1, t5)
+
+	// Step 13: t12 = x^0x19
+	t12.Mul(t1, t6)
+
+	// Step 14: t13 = x^0x1b
+	t13.Mul(t1, t12)
+
+	// Step 15: t3 = x^0x1d
+	t3.Mul(t1, t13)
+
+	// Step 16: t7 = x^0x1f
+	t7.Mul(t1, t3)
+
+	// Step 17: t1 = x^0x3e
+	t1.Square(t7)
+
+	// Step 18: t1 = x^0x3f
+	t1.Mul(&x, t1)
+
+	// Step 19: t14 = x^0x4c
+	t14.Mul(t8, t1)
+
+	// Step 26: t14 = x^0x2600
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 27: t14 = x^0x2611
+	t14.Mul(t11, t14)
+
+	// Step 31: t14 = x^0x26110
+	for s := 0; s < 4; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 32: t14 = x^0x2611d
+	t14.Mul(t8, t14)
+
+	// Step 44: t14 = x^0x2611d000
+	for s := 0; s < 12; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 45: t14 = x^0x2611d015
+	t14.Mul(t5, t14)
+
+	// Step 50: t14 = x^0x4c23a02a0
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 51: t14 = x^0x4c23a02b5
+	t14.Mul(t5, t14)
+
+	// Step 52: t14 = x^0x98474056a
+	t14.Square(t14)
+
+	// Step 53: t14 = x^0x98474056b
+	t14.Mul(&x, t14)
+
+	// Step 62: t14 = x^0x1308e80ad600
+	for s := 0; s < 9; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 63: t14 = x^0x1308e80ad61b
+	t14.Mul(t13, t14)
+
+	// Step 68: t14 = x^0x2611d015ac360
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 69: t14 = x^0x2611d015ac36b
+	t14.Mul(t4, t14)
+
+	// Step 74: t14 = x^0x4c23a02b586d60
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 75: t14 = x^0x4c23a02b586d65
+	t14.Mul(t2, t14)
+
+	// Step 83: t14 = x^0x4c23a02b586d6500
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 84: t14 = x^0x4c23a02b586d650d
+	t14.Mul(t8, t14)
+
+	// Step 92: t14 = x^0x4c23a02b586d650d00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 93: t14 = x^0x4c23a02b586d650d3f
+	t14.Mul(t1, t14)
+
+	// Step 99: t14 = x^0x1308e80ad61b59434fc0
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 100: t14 = x^0x1308e80ad61b59434fdd
+	t14.Mul(t3, t14)
+
+	// Step 107: t14 = x^0x98474056b0daca1a7ee80
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 108: t14 = x^0x98474056b0daca1a7ee93
+	t14.Mul(t10, t14)
+
+	// Step 116: t14 = x^0x98474056b0daca1a7ee9300
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 117: t14 = x^0x98474056b0daca1a7ee9317
+	t14.Mul(t6, t14)
+
+	// Step 121: t14 = x^0x98474056b0daca1a7ee93170
+	for s := 0; s < 4; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 122: t14 = x^0x98474056b0daca1a7ee9317d
+	t14.Mul(t8, t14)
+
+	// Step 129: t14 = x^0x4c23a02b586d650d3f7498be80
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 130: t14 = x^0x4c23a02b586d650d3f7498be97
+	t14.Mul(t6, t14)
+
+	// Step 132: t14 = x^0x1308e80ad61b59434fdd262fa5c
+	for s := 0; s < 2; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 133: t14 = x^0x1308e80ad61b59434fdd262fa5f
+	t14.Mul(z, t14)
+
+	// Step 141: t14 = x^0x1308e80ad61b59434fdd262fa5f00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 142: t14 = x^0x1308e80ad61b59434fdd262fa5f17
+	t14.Mul(t6, t14)
+
+	// Step 147: t14 = x^0x2611d015ac36b2869fba4c5f4be2e0
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 148: t14 = x^0x2611d015ac36b2869fba4c5f4be2f5
+	t14.Mul(t5, t14)
+
+	// Step 155: t14 = x^0x1308e80ad61b59434fdd262fa5f17a80
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 156: t14 = x^0x1308e80ad61b59434fdd262fa5f17abf
+	t14.Mul(t1, t14)
+
+	// Step 161: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57e0
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 162: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef
+	t14.Mul(t0, t14)
+
+	// Step 165: t14 = x^0x1308e80ad61b59434fdd262fa5f17abf78
+	for s := 0; s < 3; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 166: t14 = x^0x1308e80ad61b59434fdd262fa5f17abf7b
+	t14.Mul(z, t14)
+
+	// Step 176: t14 = x^0x4c23a02b586d650d3f7498be97c5eafdec00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 177: t14 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d
+	t14.Mul(t3, t14)
+
+	// Step 188: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e800
+	for s := 0; s < 11; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 189: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d
+	t14.Mul(t8, t14)
+
+	// Step 195: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0340
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 196: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0355
+	t14.Mul(t5, t14)
+
+	// Step 198: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d54
+	for s := 0; s < 2; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 199: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d55
+	t14.Mul(&x, t14)
+
+	// Step 209: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0355400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 210: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0355403
+	t14.Mul(z, t14)
+
+	// Step 220: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 221: t13 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1b
+	t13.Mul(t13, t14)
+
+	// Step 223: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306c
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 224: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f
+	t13.Mul(z, t13)
+
+	// Step 232: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 233: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f
+	t13.Mul(t0, t13)
+
+	// Step 240: t13 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa018378780
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 241: t12 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa018378799
+	t12.Mul(t12, t13)
+
+	// Step 247: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e640
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 248: t11 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e651
+	t11.Mul(t11, t12)
+
+	// Step 253: t11 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca20
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 254: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33
+	t10.Mul(t10, t11)
+
+	// Step 259: t10 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994660
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 260: t10 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677
+	t10.Mul(t6, t10)
+
+	// Step 264: t10 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa01837879946770
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 265: t9 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa01837879946777
+	t9.Mul(t9, t10)
+
+	// Step 270: t9 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 271: t9 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee3
+	t9.Mul(z, t9)
+
+	// Step 278: t9 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa01837879946777180
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 279: t8 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d
+	t8.Mul(t8, t9)
+
+	// Step 281: t8 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc634
+	for s := 0; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 282: t8 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc635
+	t8.Mul(&x, t8)
+
+	// Step 292: t8 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d400
+	for s := 0; s < 10; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 293: t7 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f
+	t7.Mul(t7, t8)
+
+	// Step 299: t7 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 300: t6 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d7
+	t6.Mul(t6, t7)
+
+	// Step 307: t6 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 308: t5 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95
+	t5.Mul(t5, t6)
+
+	// Step 313: t5 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72a0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 314: t4 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab
+	t4.Mul(t4, t5)
+
+	// Step 323: t4 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33bb8c6a0fae55600
+	for s := 0; s < 9; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 324: t3 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33bb8c6a0fae5561d
+	t3.Mul(t3, t4)
+
+	// Step 331: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e80
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 332: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e85
+	t3.Mul(t2, t3)
+
+	// Step 338: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f5caac3a140
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 339: t2 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f5caac3a145
+	t2.Mul(t2, t3)
+
+	// Step 345: t2 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e85140
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 346: t2 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e8514f
+	t2.Mul(t0, t2)
+
+	// Step 349: t2 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a78
+	for s := 0; s < 3; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 350: t2 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a79
+	t2.Mul(&x, t2)
+
+	// Step 358: t2 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a7900
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 359: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a793f
+	t1.Mul(t1, t2)
+
+	// Step 364: t1 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e8514f27e0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 365: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e8514f27ef
+	t0.Mul(t0, t1)
+
+	// Step 368: t0 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a793f78
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 369: z = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a793f7b
+	z.Mul(z, t0)
+
+	// Step 371: z = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33bb8c6a0fae5561d0a29e4fdec
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f5caac3a1453c9fbd7)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_100     = 1 + _11
+	//	_101     = 1 + _100
+	//	_111     = _10 + _101
+	//	_1011    = _100 + _111
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_10001   = _10 + _1111
+	//	_10011   = _10 + _10001
+	//	_10101   = _10 + _10011
+	//	_10111   = _10 + _10101
+	//	_11001   = _10 + _10111
+	//	_11011   = _10 + _11001
+	//	_11101   = _10 + _11011
+	//	_11111   = _10 + _11101
+	//	_111110  = 2*_11111
+	//	_111111  = 1 + _111110
+	//	_1001100 = _1101 + _111111
+	//	i44      = ((_1001100 << 7 + _10001) << 4 + _1101) << 12
+	//	i53      = 2*((_10101 + i44) << 5 + _10101) + 1
+	//	i74      = ((i53 << 9 + _11011) << 5 + _1011) << 5
+	//	i93      = ((_101 + i74) << 8 + _1101) << 8 + _111111
+	//	i116     = ((i93 << 6 + _11101) << 7 + _10011) << 8
+	//	i130     = ((_10111 + i116) << 4 + _1101) << 7 + _10111
+	//	i147     = ((i130 << 2 + _11) << 8 + _10111) << 5
+	//	i162     = ((_10101 + i147) << 7 + _111111) << 5 + _1111
+	//	i188     = ((i162 << 3 + _11) << 10 + _11101) << 11
+	//	i199     = ((_1101 + i188) << 6 + _10101) << 2 + 1
+	//	i223     = ((i199 << 10 + _11) << 10 + _11011) << 2
+	//	i241     = ((_11 + i223) << 8 + _1111) << 7 + _11001
+	//	i259     = ((i241 << 6 + _10001) << 5 + _10011) << 5
+	//	i271     = ((_10111 + i259) << 4 + _111) << 5 + _11
+	//	i292     = ((i271 << 7 + _1101) << 2 + 1) << 10
+	//	i308     = ((_11111 + i292) << 6 + _10111) << 7 + _10101
+	//	i331     = ((i308 << 5 + _1011) << 9 + _11101) << 7
+	//	i346     = ((_101 + i331) << 6 + _101) << 6 + _1111
+	//	i364     = ((i346 << 3 + 1) << 8 + _111111) << 5
+	//	return     (_1111 + i364) << 6 + _10111
+	//
+	// Operations: 306 squares 66 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14 Element
+	// Step 1: t1 = x^0x2
+	t1.Square(&x)
+
+	// Step 2: t8 = x^0x3
+	t8.Mul(&x, t1)
+
+	// Step 3: z = x^0x4
+	z.Mul(&x, t8)
+
+	// Step 4: t2 = x^0x5
+	t2.Mul(&x, z)
+
+	// Step 5: t9 = x^0x7
+	t9.Mul(t1, t2)
+
+	// Step 6: t4 = x^0xb
+	t4.Mul(z, t9)
+
+	// Step 7: t7 = x^0xd
+	t7.Mul(t1, t4)
+
+	// Step 8: t0 = x^0xf
+	t0.Mul(t1, t7)
+
+	// Step 9: t11 = x^0x11
+	t11.Mul(t1, t0)
+
+	// Step 10: t10 = x^0x13
+	t10.Mul(t1, t11)
+
+	// Step 11: t5 = x^0x15
+	t5.Mul(t1, t10)
+
+	// Step 12: z = x^0x17
+	z.Mul(t1, t5)
+
+	// Step 13: t12 = x^0x19
+	t12.Mul(t1, z)
+
+	// Step 14: t13 = x^0x1b
+	t13.Mul(t1, t12)
+
+	// Step 15: t3 = x^0x1d
+	t3.Mul(t1, t13)
+
+	// Step 16: t6 = x^0x1f
+	t6.Mul(t1, t3)
+
+	// Step 17: t1 = x^0x3e
+	t1.Square(t6)
+
+	// Step 18: t1 = x^0x3f
+	t1.Mul(&x, t1)
+
+	// Step 19: t14 = x^0x4c
+	t14.Mul(t7, t1)
+
+	// Step 26: t14 = x^0x2600
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 27: t14 = x^0x2611
+	t14.Mul(t11, t14)
+
+	// Step 31: t14 = x^0x26110
+	for s := 0; s < 4; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 32: t14 = x^0x2611d
+	t14.Mul(t7, t14)
+
+	// Step 44: t14 = x^0x2611d000
+	for s := 0; s < 12; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 45: t14 = x^0x2611d015
+	t14.Mul(t5, t14)
+
+	// Step 50: t14 = x^0x4c23a02a0
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 51: t14 = x^0x4c23a02b5
+	t14.Mul(t5, t14)
+
+	// Step 52: t14 = x^0x98474056a
+	t14.Square(t14)
+
+	// Step 53: t14 = x^0x98474056b
+	t14.Mul(&x, t14)
+
+	// Step 62: t14 = x^0x1308e80ad600
+	for s := 0; s < 9; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 63: t14 = x^0x1308e80ad61b
+	t14.Mul(t13, t14)
+
+	// Step 68: t14 = x^0x2611d015ac360
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 69: t14 = x^0x2611d015ac36b
+	t14.Mul(t4, t14)
+
+	// Step 74: t14 = x^0x4c23a02b586d60
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 75: t14 = x^0x4c23a02b586d65
+	t14.Mul(t2, t14)
+
+	// Step 83: t14 = x^0x4c23a02b586d6500
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 84: t14 = x^0x4c23a02b586d650d
+	t14.Mul(t7, t14)
+
+	// Step 92: t14 = x^0x4c23a02b586d650d00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 93: t14 = x^0x4c23a02b586d650d3f
+	t14.Mul(t1, t14)
+
+	// Step 99: t14 = x^0x1308e80ad61b59434fc0
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 100: t14 = x^0x1308e80ad61b59434fdd
+	t14.Mul(t3, t14)
+
+	// Step 107: t14 = x^0x98474056b0daca1a7ee80
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 108: t14 = x^0x98474056b0daca1a7ee93
+	t14.Mul(t10, t14)
+
+	// Step 116: t14 = x^0x98474056b0daca1a7ee9300
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 117: t14 = x^0x98474056b0daca1a7ee9317
+	t14.Mul(z, t14)
+
+	// Step 121: t14 = x^0x98474056b0daca1a7ee93170
+	for s := 0; s < 4; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 122: t14 = x^0x98474056b0daca1a7ee9317d
+	t14.Mul(t7, t14)
+
+	// Step 129: t14 = x^0x4c23a02b586d650d3f7498be80
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 130: t14 = x^0x4c23a02b586d650d3f7498be97
+	t14.Mul(z, t14)
+
+	// Step 132: t14 = x^0x1308e80ad61b59434fdd262fa5c
+	for s := 0; s < 2; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 133: t14 = x^0x1308e80ad61b59434fdd262fa5f
+	t14.Mul(t8, t14)
+
+	// Step 141: t14 = x^0x1308e80ad61b59434fdd262fa5f00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 142: t14 = x^0x1308e80ad61b59434fdd262fa5f17
+	t14.Mul(z, t14)
+
+	// Step 147: t14 = x^0x2611d015ac36b2869fba4c5f4be2e0
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 148: t14 = x^0x2611d015ac36b2869fba4c5f4be2f5
+	t14.Mul(t5, t14)
+
+	// Step 155: t14 = x^0x1308e80ad61b59434fdd262fa5f17a80
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 156: t14 = x^0x1308e80ad61b59434fdd262fa5f17abf
+	t14.Mul(t1, t14)
+
+	// Step 161: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57e0
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 162: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef
+	t14.Mul(t0, t14)
+
+	// Step 165: t14 = x^0x1308e80ad61b59434fdd262fa5f17abf78
+	for s := 0; s < 3; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 166: t14 = x^0x1308e80ad61b59434fdd262fa5f17abf7b
+	t14.Mul(t8, t14)
+
+	// Step 176: t14 = x^0x4c23a02b586d650d3f7498be97c5eafdec00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 177: t14 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d
+	t14.Mul(t3, t14)
+
+	// Step 188: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e800
+	for s := 0; s < 11; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 189: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d
+	t14.Mul(t7, t14)
+
+	// Step 195: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0340
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 196: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0355
+	t14.Mul(t5, t14)
+
+	// Step 198: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d54
+	for s := 0; s < 2; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 199: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d55
+	t14.Mul(&x, t14)
+
+	// Step 209: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0355400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 210: t14 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a0355403
+	t14.Mul(t8, t14)
+
+	// Step 220: t14 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 221: t13 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1b
+	t13.Mul(t13, t14)
+
+	// Step 223: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306c
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 224: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f
+	t13.Mul(t8, t13)
+
+	// Step 232: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 233: t13 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f
+	t13.Mul(t0, t13)
+
+	// Step 240: t13 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa018378780
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 241: t12 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa018378799
+	t12.Mul(t12, t13)
+
+	// Step 247: t12 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e640
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 248: t11 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e651
+	t11.Mul(t11, t12)
+
+	// Step 253: t11 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca20
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 254: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33
+	t10.Mul(t10, t11)
+
+	// Step 259: t10 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994660
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 260: t10 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677
+	t10.Mul(z, t10)
+
+	// Step 264: t10 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa01837879946770
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 265: t9 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa01837879946777
+	t9.Mul(t9, t10)
+
+	// Step 270: t9 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 271: t8 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee3
+	t8.Mul(t8, t9)
+
+	// Step 278: t8 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa01837879946777180
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 279: t7 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d
+	t7.Mul(t7, t8)
+
+	// Step 281: t7 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc634
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 282: t7 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc635
+	t7.Mul(&x, t7)
+
+	// Step 292: t7 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d400
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 293: t6 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f
+	t6.Mul(t6, t7)
+
+	// Step 299: t6 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507c0
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 300: t6 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d7
+	t6.Mul(z, t6)
+
+	// Step 307: t6 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 308: t5 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95
+	t5.Mul(t5, t6)
+
+	// Step 313: t5 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72a0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 314: t4 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab
+	t4.Mul(t4, t5)
+
+	// Step 323: t4 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33bb8c6a0fae55600
+	for s := 0; s < 9; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 324: t3 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5500c1bc3cca33bb8c6a0fae5561d
+	t3.Mul(t3, t4)
+
+	// Step 331: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e80
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 332: t3 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e85
+	t3.Mul(t2, t3)
+
+	// Step 338: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f5caac3a140
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 339: t2 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f5caac3a145
+	t2.Mul(t2, t3)
+
+	// Step 345: t2 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e85140
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 346: t2 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e8514f
+	t2.Mul(t0, t2)
+
+	// Step 349: t2 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a78
+	for s := 0; s < 3; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 350: t2 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a79
+	t2.Mul(&x, t2)
+
+	// Step 358: t2 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a7900
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 359: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a035540306f0f328ceee31a83eb95587428a793f
+	t1.Mul(t1, t2)
+
+	// Step 364: t1 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e8514f27e0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 365: t0 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406aa8060de1e6519ddc63507d72ab0e8514f27ef
+	t0.Mul(t0, t1)
+
+	// Step 371: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f5caac3a1453c9fbc0
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 372: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aaa0183787994677718d41f5caac3a1453c9fbd7
+	z.Mul(z, t0)
+
+	return z
+}
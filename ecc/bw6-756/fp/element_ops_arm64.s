@@ -0,0 +1,7117 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// mul(res, x, y *Element) sets res = x·y·R⁻¹ (mod q)
+
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $0xffffffffffffffff, R15
+
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R2
+	MOVD  8(R0), R19
+	MUL   R19, R16, R3
+	MOVD  16(R0), R19
+	MUL   R19, R16, R4
+	MOVD  24(R0), R19
+	MUL   R19, R16, R5
+	MOVD  32(R0), R19
+	MUL   R19, R16, R6
+	MOVD  40(R0), R19
+	MUL   R19, R16, R7
+	MOVD  48(R0), R19
+	MUL   R19, R16, R8
+	MOVD  56(R0), R19
+	MUL   R19, R16, R9
+	MOVD  64(R0), R19
+	MUL   R19, R16, R10
+	MOVD  72(R0), R19
+	MUL   R19, R16, R11
+	MOVD  80(R0), R19
+	MUL   R19, R16, R12
+	MOVD  88(R0), R19
+	MUL   R19, R16, R13
+	MOVD  88(R0), R19
+	UMULH R19, R16, R14
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R3, R3
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	ADC   ZR, R14, R14
+	MUL   R2, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R2, R2
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	ADC   ZR, R14, R14
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R3, R3
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R14, R14
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R3, R3
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	ADC   ZR, R2, R2
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R4, R4
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R2, R2
+	MUL   R3, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R3, R3
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	ADC   ZR, R2, R2
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R4, R4
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R2, R2
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R4, R4
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	ADC   ZR, R3, R3
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R5, R5
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R3, R3
+	MUL   R4, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R4, R4
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	ADC   ZR, R3, R3
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R5, R5
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R3, R3
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R5, R5
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	ADC   ZR, R4, R4
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R6, R6
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R4, R4
+	MUL   R5, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R5, R5
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	ADC   ZR, R4, R4
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R6, R6
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R4, R4
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R6, R6
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	ADC   ZR, R5, R5
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R7, R7
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R5, R5
+	MUL   R6, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R6, R6
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	ADC   ZR, R5, R5
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R7, R7
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R5, R5
+
+	// t += x·y[5], followed by a reduction round
+	MOVD  40(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R7, R7
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	ADC   ZR, R6, R6
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R8, R8
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R6, R6
+	MUL   R7, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R7, R7
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	ADC   ZR, R6, R6
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R8, R8
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R6, R6
+
+	// t += x·y[6], followed by a reduction round
+	MOVD  48(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R8, R8
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	ADC   ZR, R7, R7
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R9, R9
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R7, R7
+	MUL   R8, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R8, R8
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	ADC   ZR, R7, R7
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R9, R9
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R7, R7
+
+	// t += x·y[7], followed by a reduction round
+	MOVD  56(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R9, R9
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	ADC   ZR, R8, R8
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R10, R10
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R8, R8
+	MUL   R9, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R9, R9
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	ADC   ZR, R8, R8
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R10, R10
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R8, R8
+
+	// t += x·y[8], followed by a reduction round
+	MOVD  64(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R10, R10
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	ADC   ZR, R9, R9
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R11, R11
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R9, R9
+	MUL   R10, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R10, R10
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	ADC   ZR, R9, R9
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R11, R11
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R9, R9
+
+	// t += x·y[9], followed by a reduction round
+	MOVD  72(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R11, R11
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	ADC   ZR, R10, R10
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R12, R12
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R10, R10
+	MUL   R11, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R11, R11
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R12, R12
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	ADC   ZR, R10, R10
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R12, R12
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R10, R10
+
+	// t += x·y[10], followed by a reduction round
+	MOVD  80(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R12, R12
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	ADC   ZR, R11, R11
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R13, R13
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R11, R11
+	MUL   R12, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R12, R12
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R13, R13
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	ADC   ZR, R11, R11
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R13, R13
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R11, R11
+
+	// t += x·y[11], followed by a reduction round
+	MOVD  88(R1), R16
+	MOVD  0(R0), R19
+	MUL   R19, R16, R17
+	ADDS  R17, R13, R13
+	MOVD  8(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  16(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  24(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  32(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  40(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  48(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  56(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  64(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  72(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  80(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  88(R0), R19
+	MUL   R19, R16, R17
+	ADCS  R17, R11, R11
+	ADC   ZR, R12, R12
+	MOVD  0(R0), R19
+	UMULH R19, R16, R17
+	ADDS  R17, R14, R14
+	MOVD  8(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  16(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  24(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  32(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  40(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  48(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  56(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  64(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  72(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  80(R0), R19
+	UMULH R19, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  88(R0), R19
+	UMULH R19, R16, R17
+	ADC   R17, R12, R12
+	MUL   R13, R15, R16
+	MOVD  $0x0000000000000001, R20
+	MUL   R20, R16, R17
+	ADDS  R17, R13, R13
+	MOVD  $0x33c7e63f86840000, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R14, R14
+	MOVD  $0xd0b685e868524ec0, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0x4302aa3c258de7de, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0xe292cd15edb646a5, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xfbf23221455163a6, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R20
+	MUL   R20, R16, R17
+	ADCS  R17, R11, R11
+	ADC   ZR, R12, R12
+	MOVD  $0x0000000000000001, R20
+	UMULH R20, R16, R17
+	ADDS  R17, R14, R14
+	MOVD  $0x33c7e63f86840000, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R2, R2
+	MOVD  $0xd0b685e868524ec0, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R3, R3
+	MOVD  $0x4302aa3c258de7de, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R4, R4
+	MOVD  $0xe292cd15edb646a5, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R8, R8
+	MOVD  $0xfbf23221455163a6, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R20
+	UMULH R20, R16, R17
+	ADCS  R17, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R20
+	UMULH R20, R16, R17
+	ADC   R17, R12, R12
+
+	// t = t - q if t >= q
+	MOVD  $0x0000000000000001, R20
+	SUBS  R20, R14, R17
+	MOVD  $0x33c7e63f86840000, R20
+	SBCS  R20, R2, R17
+	MOVD  $0xd0b685e868524ec0, R20
+	SBCS  R20, R3, R17
+	MOVD  $0x4302aa3c258de7de, R20
+	SBCS  R20, R4, R17
+	MOVD  $0xe292cd15edb646a5, R20
+	SBCS  R20, R5, R17
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	SBCS  R20, R6, R17
+	MOVD  $0xeb02c812ea04faaa, R20
+	SBCS  R20, R7, R17
+	MOVD  $0xccc6ae73c42a46d9, R20
+	SBCS  R20, R8, R17
+	MOVD  $0xfbf23221455163a6, R20
+	SBCS  R20, R9, R17
+	MOVD  $0x5c978cd2fac2ce89, R20
+	SBCS  R20, R10, R17
+	MOVD  $0xe2ac127e1e3568cf, R20
+	SBCS  R20, R11, R17
+	MOVD  $0x000f76adbb5bb98a, R20
+	SBCS  R20, R12, R17
+	CSETM CS, R16
+	MOVD  $0x0000000000000001, R20
+	AND   R16, R20, R17
+	SUBS  R17, R14, R14
+	MOVD  $0x33c7e63f86840000, R20
+	AND   R16, R20, R17
+	SBCS  R17, R2, R2
+	MOVD  $0xd0b685e868524ec0, R20
+	AND   R16, R20, R17
+	SBCS  R17, R3, R3
+	MOVD  $0x4302aa3c258de7de, R20
+	AND   R16, R20, R17
+	SBCS  R17, R4, R4
+	MOVD  $0xe292cd15edb646a5, R20
+	AND   R16, R20, R17
+	SBCS  R17, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	AND   R16, R20, R17
+	SBCS  R17, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R20
+	AND   R16, R20, R17
+	SBCS  R17, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R20
+	AND   R16, R20, R17
+	SBCS  R17, R8, R8
+	MOVD  $0xfbf23221455163a6, R20
+	AND   R16, R20, R17
+	SBCS  R17, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R20
+	AND   R16, R20, R17
+	SBCS  R17, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R20
+	AND   R16, R20, R17
+	SBCS  R17, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R20
+	AND   R16, R20, R17
+	SBCS  R17, R12, R12
+	MOVD  res+0(FP), R0
+	STP   (R14, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	STP   (R7, R8), 48(R0)
+	STP   (R9, R10), 64(R0)
+	STP   (R11, R12), 80(R0)
+	RET
+
+// fromMont(res *Element) sets res = res·R⁻¹ (mod q)
+
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	MOVD  $0xffffffffffffffff, R14
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	LDP   48(R0), (R7, R8)
+	LDP   64(R0), (R9, R10)
+	LDP   80(R0), (R11, R12)
+	MOVD  ZR, R13
+	MUL   R1, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R1, R1
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	ADC   ZR, R13, R13
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R2, R2
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R13, R13
+	MUL   R2, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R2, R2
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	ADC   ZR, R1, R1
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R3, R3
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R1, R1
+	MUL   R3, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R3, R3
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	ADC   ZR, R2, R2
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R4, R4
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R2, R2
+	MUL   R4, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R4, R4
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	ADC   ZR, R3, R3
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R5, R5
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R3, R3
+	MUL   R5, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R5, R5
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	ADC   ZR, R4, R4
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R6, R6
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R4, R4
+	MUL   R6, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R6, R6
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	ADC   ZR, R5, R5
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R7, R7
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R5, R5
+	MUL   R7, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R7, R7
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	ADC   ZR, R6, R6
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R8, R8
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R6, R6
+	MUL   R8, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R8, R8
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	ADC   ZR, R7, R7
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R9, R9
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R7, R7
+	MUL   R9, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R9, R9
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	ADC   ZR, R8, R8
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R10, R10
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R8, R8
+	MUL   R10, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R10, R10
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R11, R11
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	ADC   ZR, R9, R9
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R11, R11
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R9, R9
+	MUL   R11, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R11, R11
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R12, R12
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	ADC   ZR, R10, R10
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R12, R12
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R10, R10
+	MUL   R12, R14, R15
+	MOVD  $0x0000000000000001, R17
+	MUL   R17, R15, R16
+	ADDS  R16, R12, R12
+	MOVD  $0x33c7e63f86840000, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R13, R13
+	MOVD  $0xd0b685e868524ec0, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0x4302aa3c258de7de, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0xe292cd15edb646a5, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xfbf23221455163a6, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R17
+	MUL   R17, R15, R16
+	ADCS  R16, R10, R10
+	ADC   ZR, R11, R11
+	MOVD  $0x0000000000000001, R17
+	UMULH R17, R15, R16
+	ADDS  R16, R13, R13
+	MOVD  $0x33c7e63f86840000, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R1, R1
+	MOVD  $0xd0b685e868524ec0, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R2, R2
+	MOVD  $0x4302aa3c258de7de, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R3, R3
+	MOVD  $0xe292cd15edb646a5, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R7, R7
+	MOVD  $0xfbf23221455163a6, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R17
+	UMULH R17, R15, R16
+	ADCS  R16, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R17
+	UMULH R17, R15, R16
+	ADC   R16, R11, R11
+
+	// t = t - q if t >= q
+	MOVD  $0x0000000000000001, R17
+	SUBS  R17, R13, R16
+	MOVD  $0x33c7e63f86840000, R17
+	SBCS  R17, R1, R16
+	MOVD  $0xd0b685e868524ec0, R17
+	SBCS  R17, R2, R16
+	MOVD  $0x4302aa3c258de7de, R17
+	SBCS  R17, R3, R16
+	MOVD  $0xe292cd15edb646a5, R17
+	SBCS  R17, R4, R16
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	SBCS  R17, R5, R16
+	MOVD  $0xeb02c812ea04faaa, R17
+	SBCS  R17, R6, R16
+	MOVD  $0xccc6ae73c42a46d9, R17
+	SBCS  R17, R7, R16
+	MOVD  $0xfbf23221455163a6, R17
+	SBCS  R17, R8, R16
+	MOVD  $0x5c978cd2fac2ce89, R17
+	SBCS  R17, R9, R16
+	MOVD  $0xe2ac127e1e3568cf, R17
+	SBCS  R17, R10, R16
+	MOVD  $0x000f76adbb5bb98a, R17
+	SBCS  R17, R11, R16
+	CSETM CS, R15
+	MOVD  $0x0000000000000001, R17
+	AND   R15, R17, R16
+	SUBS  R16, R13, R13
+	MOVD  $0x33c7e63f86840000, R17
+	AND   R15, R17, R16
+	SBCS  R16, R1, R1
+	MOVD  $0xd0b685e868524ec0, R17
+	AND   R15, R17, R16
+	SBCS  R16, R2, R2
+	MOVD  $0x4302aa3c258de7de, R17
+	AND   R15, R17, R16
+	SBCS  R16, R3, R3
+	MOVD  $0xe292cd15edb646a5, R17
+	AND   R15, R17, R16
+	SBCS  R16, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	AND   R15, R17, R16
+	SBCS  R16, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R17
+	AND   R15, R17, R16
+	SBCS  R16, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R17
+	AND   R15, R17, R16
+	SBCS  R16, R7, R7
+	MOVD  $0xfbf23221455163a6, R17
+	AND   R15, R17, R16
+	SBCS  R16, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R17
+	AND   R15, R17, R16
+	SBCS  R16, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R17
+	AND   R15, R17, R16
+	SBCS  R16, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R17
+	AND   R15, R17, R16
+	SBCS  R16, R11, R11
+	STP   (R13, R1), 0(R0)
+	STP   (R2, R3), 16(R0)
+	STP   (R4, R5), 32(R0)
+	STP   (R6, R7), 48(R0)
+	STP   (R8, R9), 64(R0)
+	STP   (R10, R11), 80(R0)
+	RET
+
+// reduce(res *Element) sets res = res - q if res >= q
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	LDP  0(R0), (R1, R2)
+	LDP  16(R0), (R3, R4)
+	LDP  32(R0), (R5, R6)
+	LDP  48(R0), (R7, R8)
+	LDP  64(R0), (R9, R10)
+	LDP  80(R0), (R11, R12)
+
+	// t = t - q if t >= q
+	MOVD  $0x0000000000000001, R15
+	SUBS  R15, R1, R14
+	MOVD  $0x33c7e63f86840000, R15
+	SBCS  R15, R2, R14
+	MOVD  $0xd0b685e868524ec0, R15
+	SBCS  R15, R3, R14
+	MOVD  $0x4302aa3c258de7de, R15
+	SBCS  R15, R4, R14
+	MOVD  $0xe292cd15edb646a5, R15
+	SBCS  R15, R5, R14
+	MOVD  $0x0a7eb1cb3d06e646, R15
+	SBCS  R15, R6, R14
+	MOVD  $0xeb02c812ea04faaa, R15
+	SBCS  R15, R7, R14
+	MOVD  $0xccc6ae73c42a46d9, R15
+	SBCS  R15, R8, R14
+	MOVD  $0xfbf23221455163a6, R15
+	SBCS  R15, R9, R14
+	MOVD  $0x5c978cd2fac2ce89, R15
+	SBCS  R15, R10, R14
+	MOVD  $0xe2ac127e1e3568cf, R15
+	SBCS  R15, R11, R14
+	MOVD  $0x000f76adbb5bb98a, R15
+	SBCS  R15, R12, R14
+	CSETM CS, R13
+	MOVD  $0x0000000000000001, R15
+	AND   R13, R15, R14
+	SUBS  R14, R1, R1
+	MOVD  $0x33c7e63f86840000, R15
+	AND   R13, R15, R14
+	SBCS  R14, R2, R2
+	MOVD  $0xd0b685e868524ec0, R15
+	AND   R13, R15, R14
+	SBCS  R14, R3, R3
+	MOVD  $0x4302aa3c258de7de, R15
+	AND   R13, R15, R14
+	SBCS  R14, R4, R4
+	MOVD  $0xe292cd15edb646a5, R15
+	AND   R13, R15, R14
+	SBCS  R14, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R15
+	AND   R13, R15, R14
+	SBCS  R14, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R15
+	AND   R13, R15, R14
+	SBCS  R14, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R15
+	AND   R13, R15, R14
+	SBCS  R14, R8, R8
+	MOVD  $0xfbf23221455163a6, R15
+	AND   R13, R15, R14
+	SBCS  R14, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R15
+	AND   R13, R15, R14
+	SBCS  R14, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R15
+	AND   R13, R15, R14
+	SBCS  R14, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R15
+	AND   R13, R15, R14
+	SBCS  R14, R12, R12
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	STP   (R7, R8), 48(R0)
+	STP   (R9, R10), 64(R0)
+	STP   (R11, R12), 80(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+
+	// t = a + b, not reduced
+	LDP  0(R0), (R2, R3)
+	LDP  16(R0), (R4, R5)
+	LDP  32(R0), (R6, R7)
+	LDP  48(R0), (R8, R9)
+	LDP  64(R0), (R10, R11)
+	LDP  80(R0), (R12, R13)
+	MOVD 0(R1), R14
+	ADDS R14, R2, R2
+	MOVD 8(R1), R14
+	ADCS R14, R3, R3
+	MOVD 16(R1), R14
+	ADCS R14, R4, R4
+	MOVD 24(R1), R14
+	ADCS R14, R5, R5
+	MOVD 32(R1), R14
+	ADCS R14, R6, R6
+	MOVD 40(R1), R14
+	ADCS R14, R7, R7
+	MOVD 48(R1), R14
+	ADCS R14, R8, R8
+	MOVD 56(R1), R14
+	ADCS R14, R9, R9
+	MOVD 64(R1), R14
+	ADCS R14, R10, R10
+	MOVD 72(R1), R14
+	ADCS R14, R11, R11
+	MOVD 80(R1), R14
+	ADCS R14, R12, R12
+	MOVD 88(R1), R14
+	ADCS R14, R13, R13
+
+	// b = a - b
+	MOVD 0(R0), R14
+	MOVD 0(R1), R16
+	SUBS R16, R14, R14
+	MOVD R14, 0(R1)
+	MOVD 8(R0), R14
+	MOVD 8(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 8(R1)
+	MOVD 16(R0), R14
+	MOVD 16(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 16(R1)
+	MOVD 24(R0), R14
+	MOVD 24(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 24(R1)
+	MOVD 32(R0), R14
+	MOVD 32(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 32(R1)
+	MOVD 40(R0), R14
+	MOVD 40(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 40(R1)
+	MOVD 48(R0), R14
+	MOVD 48(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 48(R1)
+	MOVD 56(R0), R14
+	MOVD 56(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 56(R1)
+	MOVD 64(R0), R14
+	MOVD 64(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 64(R1)
+	MOVD 72(R0), R14
+	MOVD 72(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 72(R1)
+	MOVD 80(R0), R14
+	MOVD 80(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 80(R1)
+	MOVD 88(R0), R14
+	MOVD 88(R1), R16
+	SBCS R16, R14, R14
+	MOVD R14, 88(R1)
+
+	// b = b + q if there was a borrow
+	CSETM CC, R15
+	MOVD  0(R1), R14
+	MOVD  $0x0000000000000001, R17
+	AND   R15, R17, R16
+	ADDS  R16, R14, R14
+	MOVD  R14, 0(R1)
+	MOVD  8(R1), R14
+	MOVD  $0x33c7e63f86840000, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 8(R1)
+	MOVD  16(R1), R14
+	MOVD  $0xd0b685e868524ec0, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 16(R1)
+	MOVD  24(R1), R14
+	MOVD  $0x4302aa3c258de7de, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 24(R1)
+	MOVD  32(R1), R14
+	MOVD  $0xe292cd15edb646a5, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 32(R1)
+	MOVD  40(R1), R14
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 40(R1)
+	MOVD  48(R1), R14
+	MOVD  $0xeb02c812ea04faaa, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 48(R1)
+	MOVD  56(R1), R14
+	MOVD  $0xccc6ae73c42a46d9, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 56(R1)
+	MOVD  64(R1), R14
+	MOVD  $0xfbf23221455163a6, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 64(R1)
+	MOVD  72(R1), R14
+	MOVD  $0x5c978cd2fac2ce89, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 72(R1)
+	MOVD  80(R1), R14
+	MOVD  $0xe2ac127e1e3568cf, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 80(R1)
+	MOVD  88(R1), R14
+	MOVD  $0x000f76adbb5bb98a, R17
+	AND   R15, R17, R16
+	ADCS  R16, R14, R14
+	MOVD  R14, 88(R1)
+
+	// t = t - q if t >= q
+	MOVD  $0x0000000000000001, R17
+	SUBS  R17, R2, R16
+	MOVD  $0x33c7e63f86840000, R17
+	SBCS  R17, R3, R16
+	MOVD  $0xd0b685e868524ec0, R17
+	SBCS  R17, R4, R16
+	MOVD  $0x4302aa3c258de7de, R17
+	SBCS  R17, R5, R16
+	MOVD  $0xe292cd15edb646a5, R17
+	SBCS  R17, R6, R16
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	SBCS  R17, R7, R16
+	MOVD  $0xeb02c812ea04faaa, R17
+	SBCS  R17, R8, R16
+	MOVD  $0xccc6ae73c42a46d9, R17
+	SBCS  R17, R9, R16
+	MOVD  $0xfbf23221455163a6, R17
+	SBCS  R17, R10, R16
+	MOVD  $0x5c978cd2fac2ce89, R17
+	SBCS  R17, R11, R16
+	MOVD  $0xe2ac127e1e3568cf, R17
+	SBCS  R17, R12, R16
+	MOVD  $0x000f76adbb5bb98a, R17
+	SBCS  R17, R13, R16
+	CSETM CS, R15
+	MOVD  $0x0000000000000001, R17
+	AND   R15, R17, R16
+	SUBS  R16, R2, R2
+	MOVD  $0x33c7e63f86840000, R17
+	AND   R15, R17, R16
+	SBCS  R16, R3, R3
+	MOVD  $0xd0b685e868524ec0, R17
+	AND   R15, R17, R16
+	SBCS  R16, R4, R4
+	MOVD  $0x4302aa3c258de7de, R17
+	AND   R15, R17, R16
+	SBCS  R16, R5, R5
+	MOVD  $0xe292cd15edb646a5, R17
+	AND   R15, R17, R16
+	SBCS  R16, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R17
+	AND   R15, R17, R16
+	SBCS  R16, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R17
+	AND   R15, R17, R16
+	SBCS  R16, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R17
+	AND   R15, R17, R16
+	SBCS  R16, R9, R9
+	MOVD  $0xfbf23221455163a6, R17
+	AND   R15, R17, R16
+	SBCS  R16, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R17
+	AND   R15, R17, R16
+	SBCS  R16, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R17
+	AND   R15, R17, R16
+	SBCS  R16, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R17
+	AND   R15, R17, R16
+	SBCS  R16, R13, R13
+	STP   (R2, R3), 0(R0)
+	STP   (R4, R5), 16(R0)
+	STP   (R6, R7), 32(R0)
+	STP   (R8, R9), 48(R0)
+	STP   (R10, R11), 64(R0)
+	STP   (R12, R13), 80(R0)
+	RET
+
+// addVecARM64(res, a, b *Element, n uint64) res[i] = a[i] + b[i]
+
+TEXT ·addVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	LDP  32(R1), (R8, R9)
+	LDP  48(R1), (R10, R11)
+	LDP  64(R1), (R12, R13)
+	LDP  80(R1), (R14, R15)
+	MOVD 0(R2), R16
+	ADDS R16, R4, R4
+	MOVD 8(R2), R16
+	ADCS R16, R5, R5
+	MOVD 16(R2), R16
+	ADCS R16, R6, R6
+	MOVD 24(R2), R16
+	ADCS R16, R7, R7
+	MOVD 32(R2), R16
+	ADCS R16, R8, R8
+	MOVD 40(R2), R16
+	ADCS R16, R9, R9
+	MOVD 48(R2), R16
+	ADCS R16, R10, R10
+	MOVD 56(R2), R16
+	ADCS R16, R11, R11
+	MOVD 64(R2), R16
+	ADCS R16, R12, R12
+	MOVD 72(R2), R16
+	ADCS R16, R13, R13
+	MOVD 80(R2), R16
+	ADCS R16, R14, R14
+	MOVD 88(R2), R16
+	ADCS R16, R15, R15
+
+	// t = t - q if t >= q
+	MOVD  $0x0000000000000001, R20
+	SUBS  R20, R4, R19
+	MOVD  $0x33c7e63f86840000, R20
+	SBCS  R20, R5, R19
+	MOVD  $0xd0b685e868524ec0, R20
+	SBCS  R20, R6, R19
+	MOVD  $0x4302aa3c258de7de, R20
+	SBCS  R20, R7, R19
+	MOVD  $0xe292cd15edb646a5, R20
+	SBCS  R20, R8, R19
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	SBCS  R20, R9, R19
+	MOVD  $0xeb02c812ea04faaa, R20
+	SBCS  R20, R10, R19
+	MOVD  $0xccc6ae73c42a46d9, R20
+	SBCS  R20, R11, R19
+	MOVD  $0xfbf23221455163a6, R20
+	SBCS  R20, R12, R19
+	MOVD  $0x5c978cd2fac2ce89, R20
+	SBCS  R20, R13, R19
+	MOVD  $0xe2ac127e1e3568cf, R20
+	SBCS  R20, R14, R19
+	MOVD  $0x000f76adbb5bb98a, R20
+	SBCS  R20, R15, R19
+	CSETM CS, R17
+	MOVD  $0x0000000000000001, R20
+	AND   R17, R20, R19
+	SUBS  R19, R4, R4
+	MOVD  $0x33c7e63f86840000, R20
+	AND   R17, R20, R19
+	SBCS  R19, R5, R5
+	MOVD  $0xd0b685e868524ec0, R20
+	AND   R17, R20, R19
+	SBCS  R19, R6, R6
+	MOVD  $0x4302aa3c258de7de, R20
+	AND   R17, R20, R19
+	SBCS  R19, R7, R7
+	MOVD  $0xe292cd15edb646a5, R20
+	AND   R17, R20, R19
+	SBCS  R19, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	AND   R17, R20, R19
+	SBCS  R19, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R20
+	AND   R17, R20, R19
+	SBCS  R19, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R20
+	AND   R17, R20, R19
+	SBCS  R19, R11, R11
+	MOVD  $0xfbf23221455163a6, R20
+	AND   R17, R20, R19
+	SBCS  R19, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R20
+	AND   R17, R20, R19
+	SBCS  R19, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R20
+	AND   R17, R20, R19
+	SBCS  R19, R14, R14
+	MOVD  $0x000f76adbb5bb98a, R20
+	AND   R17, R20, R19
+	SBCS  R19, R15, R15
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	STP   (R8, R9), 32(R0)
+	STP   (R10, R11), 48(R0)
+	STP   (R12, R13), 64(R0)
+	STP   (R14, R15), 80(R0)
+	ADD   $96, R0, R0
+	ADD   $96, R1, R1
+	ADD   $96, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// subVecARM64(res, a, b *Element, n uint64) res[i] = a[i] - b[i]
+
+TEXT ·subVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	LDP  32(R1), (R8, R9)
+	LDP  48(R1), (R10, R11)
+	LDP  64(R1), (R12, R13)
+	LDP  80(R1), (R14, R15)
+	MOVD 0(R2), R16
+	SUBS R16, R4, R4
+	MOVD 8(R2), R16
+	SBCS R16, R5, R5
+	MOVD 16(R2), R16
+	SBCS R16, R6, R6
+	MOVD 24(R2), R16
+	SBCS R16, R7, R7
+	MOVD 32(R2), R16
+	SBCS R16, R8, R8
+	MOVD 40(R2), R16
+	SBCS R16, R9, R9
+	MOVD 48(R2), R16
+	SBCS R16, R10, R10
+	MOVD 56(R2), R16
+	SBCS R16, R11, R11
+	MOVD 64(R2), R16
+	SBCS R16, R12, R12
+	MOVD 72(R2), R16
+	SBCS R16, R13, R13
+	MOVD 80(R2), R16
+	SBCS R16, R14, R14
+	MOVD 88(R2), R16
+	SBCS R16, R15, R15
+
+	// t = t + q if there was a borrow
+	CSETM CC, R17
+	MOVD  $0x0000000000000001, R20
+	AND   R17, R20, R19
+	ADDS  R19, R4, R4
+	MOVD  $0x33c7e63f86840000, R20
+	AND   R17, R20, R19
+	ADCS  R19, R5, R5
+	MOVD  $0xd0b685e868524ec0, R20
+	AND   R17, R20, R19
+	ADCS  R19, R6, R6
+	MOVD  $0x4302aa3c258de7de, R20
+	AND   R17, R20, R19
+	ADCS  R19, R7, R7
+	MOVD  $0xe292cd15edb646a5, R20
+	AND   R17, R20, R19
+	ADCS  R19, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R20
+	AND   R17, R20, R19
+	ADCS  R19, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R20
+	AND   R17, R20, R19
+	ADCS  R19, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R20
+	AND   R17, R20, R19
+	ADCS  R19, R11, R11
+	MOVD  $0xfbf23221455163a6, R20
+	AND   R17, R20, R19
+	ADCS  R19, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R20
+	AND   R17, R20, R19
+	ADCS  R19, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R20
+	AND   R17, R20, R19
+	ADCS  R19, R14, R14
+	MOVD  $0x000f76adbb5bb98a, R20
+	AND   R17, R20, R19
+	ADCS  R19, R15, R15
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	STP   (R8, R9), 32(R0)
+	STP   (R10, R11), 48(R0)
+	STP   (R12, R13), 64(R0)
+	STP   (R14, R15), 80(R0)
+	ADD   $96, R0, R0
+	ADD   $96, R1, R1
+	ADD   $96, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// scalarMulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b
+
+TEXT ·scalarMulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0xffffffffffffffff, R17
+	CBZ  R3, done
+
+loop:
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R4
+	MOVD  8(R2), R21
+	MUL   R21, R19, R5
+	MOVD  16(R2), R21
+	MUL   R21, R19, R6
+	MOVD  24(R2), R21
+	MUL   R21, R19, R7
+	MOVD  32(R2), R21
+	MUL   R21, R19, R8
+	MOVD  40(R2), R21
+	MUL   R21, R19, R9
+	MOVD  48(R2), R21
+	MUL   R21, R19, R10
+	MOVD  56(R2), R21
+	MUL   R21, R19, R11
+	MOVD  64(R2), R21
+	MUL   R21, R19, R12
+	MOVD  72(R2), R21
+	MUL   R21, R19, R13
+	MOVD  80(R2), R21
+	MUL   R21, R19, R14
+	MOVD  88(R2), R21
+	MUL   R21, R19, R15
+	MOVD  88(R2), R21
+	UMULH R21, R19, R16
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	ADC   ZR, R16, R16
+	MUL   R4, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R4, R4
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	ADC   ZR, R16, R16
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R16, R16
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	ADC   ZR, R4, R4
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R4, R4
+	MUL   R5, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	ADC   ZR, R4, R4
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	ADC   ZR, R5, R5
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R5, R5
+	MUL   R6, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	ADC   ZR, R5, R5
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	ADC   ZR, R6, R6
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R6, R6
+	MUL   R7, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	ADC   ZR, R6, R6
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R6, R6
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	ADC   ZR, R7, R7
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R7, R7
+	MUL   R8, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	ADC   ZR, R7, R7
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R7, R7
+
+	// t += x·y[5], followed by a reduction round
+	MOVD  40(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	ADC   ZR, R8, R8
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R8, R8
+	MUL   R9, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	ADC   ZR, R8, R8
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R8, R8
+
+	// t += x·y[6], followed by a reduction round
+	MOVD  48(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	ADC   ZR, R9, R9
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R9, R9
+	MUL   R10, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	ADC   ZR, R9, R9
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R9, R9
+
+	// t += x·y[7], followed by a reduction round
+	MOVD  56(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	ADC   ZR, R10, R10
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R10, R10
+	MUL   R11, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	ADC   ZR, R10, R10
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R10, R10
+
+	// t += x·y[8], followed by a reduction round
+	MOVD  64(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	ADC   ZR, R11, R11
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R11, R11
+	MUL   R12, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	ADC   ZR, R11, R11
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R11, R11
+
+	// t += x·y[9], followed by a reduction round
+	MOVD  72(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	ADC   ZR, R12, R12
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R12, R12
+	MUL   R13, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	ADC   ZR, R12, R12
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R12, R12
+
+	// t += x·y[10], followed by a reduction round
+	MOVD  80(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	ADC   ZR, R13, R13
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R13, R13
+	MUL   R14, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	ADC   ZR, R13, R13
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R13, R13
+
+	// t += x·y[11], followed by a reduction round
+	MOVD  88(R1), R19
+	MOVD  0(R2), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  8(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  16(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  24(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  32(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  40(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  48(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  56(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  64(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  72(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  80(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  88(R2), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	ADC   ZR, R14, R14
+	MOVD  0(R2), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R16, R16
+	MOVD  8(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  16(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  24(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  32(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  40(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  48(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  56(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  64(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  72(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  80(R2), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  88(R2), R21
+	UMULH R21, R19, R20
+	ADC   R20, R14, R14
+	MUL   R15, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	ADC   ZR, R14, R14
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R16, R16
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R14, R14
+
+	// t = t - q if t >= q
+	MOVD  $0x0000000000000001, R22
+	SUBS  R22, R16, R20
+	MOVD  $0x33c7e63f86840000, R22
+	SBCS  R22, R4, R20
+	MOVD  $0xd0b685e868524ec0, R22
+	SBCS  R22, R5, R20
+	MOVD  $0x4302aa3c258de7de, R22
+	SBCS  R22, R6, R20
+	MOVD  $0xe292cd15edb646a5, R22
+	SBCS  R22, R7, R20
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	SBCS  R22, R8, R20
+	MOVD  $0xeb02c812ea04faaa, R22
+	SBCS  R22, R9, R20
+	MOVD  $0xccc6ae73c42a46d9, R22
+	SBCS  R22, R10, R20
+	MOVD  $0xfbf23221455163a6, R22
+	SBCS  R22, R11, R20
+	MOVD  $0x5c978cd2fac2ce89, R22
+	SBCS  R22, R12, R20
+	MOVD  $0xe2ac127e1e3568cf, R22
+	SBCS  R22, R13, R20
+	MOVD  $0x000f76adbb5bb98a, R22
+	SBCS  R22, R14, R20
+	CSETM CS, R19
+	MOVD  $0x0000000000000001, R22
+	AND   R19, R22, R20
+	SUBS  R20, R16, R16
+	MOVD  $0x33c7e63f86840000, R22
+	AND   R19, R22, R20
+	SBCS  R20, R4, R4
+	MOVD  $0xd0b685e868524ec0, R22
+	AND   R19, R22, R20
+	SBCS  R20, R5, R5
+	MOVD  $0x4302aa3c258de7de, R22
+	AND   R19, R22, R20
+	SBCS  R20, R6, R6
+	MOVD  $0xe292cd15edb646a5, R22
+	AND   R19, R22, R20
+	SBCS  R20, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	AND   R19, R22, R20
+	SBCS  R20, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R22
+	AND   R19, R22, R20
+	SBCS  R20, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R22
+	AND   R19, R22, R20
+	SBCS  R20, R10, R10
+	MOVD  $0xfbf23221455163a6, R22
+	AND   R19, R22, R20
+	SBCS  R20, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R22
+	AND   R19, R22, R20
+	SBCS  R20, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R22
+	AND   R19, R22, R20
+	SBCS  R20, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R22
+	AND   R19, R22, R20
+	SBCS  R20, R14, R14
+	STP   (R16, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	STP   (R7, R8), 32(R0)
+	STP   (R9, R10), 48(R0)
+	STP   (R11, R12), 64(R0)
+	STP   (R13, R14), 80(R0)
+	ADD   $96, R0, R0
+	ADD   $96, R1, R1
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// mulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b[i]
+
+TEXT ·mulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0xffffffffffffffff, R17
+	CBZ  R3, done
+
+loop:
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R4
+	MOVD  8(R1), R21
+	MUL   R21, R19, R5
+	MOVD  16(R1), R21
+	MUL   R21, R19, R6
+	MOVD  24(R1), R21
+	MUL   R21, R19, R7
+	MOVD  32(R1), R21
+	MUL   R21, R19, R8
+	MOVD  40(R1), R21
+	MUL   R21, R19, R9
+	MOVD  48(R1), R21
+	MUL   R21, R19, R10
+	MOVD  56(R1), R21
+	MUL   R21, R19, R11
+	MOVD  64(R1), R21
+	MUL   R21, R19, R12
+	MOVD  72(R1), R21
+	MUL   R21, R19, R13
+	MOVD  80(R1), R21
+	MUL   R21, R19, R14
+	MOVD  88(R1), R21
+	MUL   R21, R19, R15
+	MOVD  88(R1), R21
+	UMULH R21, R19, R16
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	ADC   ZR, R16, R16
+	MUL   R4, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R4, R4
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	ADC   ZR, R16, R16
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R16, R16
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	ADC   ZR, R4, R4
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R4, R4
+	MUL   R5, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R5, R5
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	ADC   ZR, R4, R4
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	ADC   ZR, R5, R5
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R5, R5
+	MUL   R6, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R6, R6
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	ADC   ZR, R5, R5
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	ADC   ZR, R6, R6
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R6, R6
+	MUL   R7, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R7, R7
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	ADC   ZR, R6, R6
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R6, R6
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	ADC   ZR, R7, R7
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R7, R7
+	MUL   R8, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R8, R8
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	ADC   ZR, R7, R7
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R7, R7
+
+	// t += x·y[5], followed by a reduction round
+	MOVD  40(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	ADC   ZR, R8, R8
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R8, R8
+	MUL   R9, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R9, R9
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	ADC   ZR, R8, R8
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R8, R8
+
+	// t += x·y[6], followed by a reduction round
+	MOVD  48(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	ADC   ZR, R9, R9
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R9, R9
+	MUL   R10, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R10, R10
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	ADC   ZR, R9, R9
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R9, R9
+
+	// t += x·y[7], followed by a reduction round
+	MOVD  56(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	ADC   ZR, R10, R10
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R10, R10
+	MUL   R11, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R11, R11
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	ADC   ZR, R10, R10
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R10, R10
+
+	// t += x·y[8], followed by a reduction round
+	MOVD  64(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	ADC   ZR, R11, R11
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R11, R11
+	MUL   R12, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R12, R12
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	ADC   ZR, R11, R11
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R11, R11
+
+	// t += x·y[9], followed by a reduction round
+	MOVD  72(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	ADC   ZR, R12, R12
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R12, R12
+	MUL   R13, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R13, R13
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R14, R14
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	ADC   ZR, R12, R12
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R12, R12
+
+	// t += x·y[10], followed by a reduction round
+	MOVD  80(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	ADC   ZR, R13, R13
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R13, R13
+	MUL   R14, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R14, R14
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R15, R15
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	ADC   ZR, R13, R13
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R13, R13
+
+	// t += x·y[11], followed by a reduction round
+	MOVD  88(R2), R19
+	MOVD  0(R1), R21
+	MUL   R21, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  8(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  16(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  24(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  32(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  40(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  48(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  56(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  64(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  72(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  80(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  88(R1), R21
+	MUL   R21, R19, R20
+	ADCS  R20, R13, R13
+	ADC   ZR, R14, R14
+	MOVD  0(R1), R21
+	UMULH R21, R19, R20
+	ADDS  R20, R16, R16
+	MOVD  8(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  16(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  24(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  32(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  40(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  48(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  56(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  64(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  72(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  80(R1), R21
+	UMULH R21, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  88(R1), R21
+	UMULH R21, R19, R20
+	ADC   R20, R14, R14
+	MUL   R15, R17, R19
+	MOVD  $0x0000000000000001, R22
+	MUL   R22, R19, R20
+	ADDS  R20, R15, R15
+	MOVD  $0x33c7e63f86840000, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R16, R16
+	MOVD  $0xd0b685e868524ec0, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0x4302aa3c258de7de, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0xe292cd15edb646a5, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0xeb02c812ea04faaa, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xccc6ae73c42a46d9, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xfbf23221455163a6, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0x5c978cd2fac2ce89, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0xe2ac127e1e3568cf, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0x000f76adbb5bb98a, R22
+	MUL   R22, R19, R20
+	ADCS  R20, R13, R13
+	ADC   ZR, R14, R14
+	MOVD  $0x0000000000000001, R22
+	UMULH R22, R19, R20
+	ADDS  R20, R16, R16
+	MOVD  $0x33c7e63f86840000, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R4, R4
+	MOVD  $0xd0b685e868524ec0, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R5, R5
+	MOVD  $0x4302aa3c258de7de, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R6, R6
+	MOVD  $0xe292cd15edb646a5, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R10, R10
+	MOVD  $0xfbf23221455163a6, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R22
+	UMULH R22, R19, R20
+	ADCS  R20, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R22
+	UMULH R22, R19, R20
+	ADC   R20, R14, R14
+
+	// t = t - q if t >= q
+	MOVD  $0x0000000000000001, R22
+	SUBS  R22, R16, R20
+	MOVD  $0x33c7e63f86840000, R22
+	SBCS  R22, R4, R20
+	MOVD  $0xd0b685e868524ec0, R22
+	SBCS  R22, R5, R20
+	MOVD  $0x4302aa3c258de7de, R22
+	SBCS  R22, R6, R20
+	MOVD  $0xe292cd15edb646a5, R22
+	SBCS  R22, R7, R20
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	SBCS  R22, R8, R20
+	MOVD  $0xeb02c812ea04faaa, R22
+	SBCS  R22, R9, R20
+	MOVD  $0xccc6ae73c42a46d9, R22
+	SBCS  R22, R10, R20
+	MOVD  $0xfbf23221455163a6, R22
+	SBCS  R22, R11, R20
+	MOVD  $0x5c978cd2fac2ce89, R22
+	SBCS  R22, R12, R20
+	MOVD  $0xe2ac127e1e3568cf, R22
+	SBCS  R22, R13, R20
+	MOVD  $0x000f76adbb5bb98a, R22
+	SBCS  R22, R14, R20
+	CSETM CS, R19
+	MOVD  $0x0000000000000001, R22
+	AND   R19, R22, R20
+	SUBS  R20, R16, R16
+	MOVD  $0x33c7e63f86840000, R22
+	AND   R19, R22, R20
+	SBCS  R20, R4, R4
+	MOVD  $0xd0b685e868524ec0, R22
+	AND   R19, R22, R20
+	SBCS  R20, R5, R5
+	MOVD  $0x4302aa3c258de7de, R22
+	AND   R19, R22, R20
+	SBCS  R20, R6, R6
+	MOVD  $0xe292cd15edb646a5, R22
+	AND   R19, R22, R20
+	SBCS  R20, R7, R7
+	MOVD  $0x0a7eb1cb3d06e646, R22
+	AND   R19, R22, R20
+	SBCS  R20, R8, R8
+	MOVD  $0xeb02c812ea04faaa, R22
+	AND   R19, R22, R20
+	SBCS  R20, R9, R9
+	MOVD  $0xccc6ae73c42a46d9, R22
+	AND   R19, R22, R20
+	SBCS  R20, R10, R10
+	MOVD  $0xfbf23221455163a6, R22
+	AND   R19, R22, R20
+	SBCS  R20, R11, R11
+	MOVD  $0x5c978cd2fac2ce89, R22
+	AND   R19, R22, R20
+	SBCS  R20, R12, R12
+	MOVD  $0xe2ac127e1e3568cf, R22
+	AND   R19, R22, R20
+	SBCS  R20, R13, R13
+	MOVD  $0x000f76adbb5bb98a, R22
+	AND   R19, R22, R20
+	SBCS  R20, R14, R14
+	STP   (R16, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	STP   (R7, R8), 32(R0)
+	STP   (R9, R10), 48(R0)
+	STP   (R11, R12), 64(R0)
+	STP   (R13, R14), 80(R0)
+	ADD   $96, R0, R0
+	ADD   $96, R1, R1
+	ADD   $96, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
@@ -24,11 +24,13 @@ import (
 )
 
 // BitReverse applies the bit-reversal permutation to v.
-// len(v) must be a power of 2
+// If len(v) is not a power of 2, it applies the digit reversal permutation
+// matching the mixed radix domain of size len(v) (see BitReverseIndex).
 func BitReverse(v []fr.Element) {
 	n := uint64(len(v))
 	if bits.OnesCount64(n) != 1 {
-		panic("len(a) must be a power of 2")
+		digitReverseVector(v)
+		return
 	}
 
 	if runtime.GOARCH == "arm64" {
@@ -72,6 +72,7 @@ type DomainOption func(*domainConfig)
 type domainConfig struct {
 	shift          *fr.Element
 	withPrecompute bool
+	mixedRadix     bool
 }
 
 // WithShift sets the FrMultiplicativeGen of the domain.
@@ -90,6 +91,16 @@ func WithoutPrecompute() DomainOption {
 	}
 }
 
+// WithMixedRadix allows the cardinality of the domain not to be a power of 2.
+// The cardinality is then the smallest divisor of r-1 greater or equal to m whose
+// prime factors are small (e.g. 3·2ᵏ), which avoids padding circuits of such
+// sizes to the next power of 2. The FFT on such domains is a mixed radix FFT.
+func WithMixedRadix() DomainOption {
+	return func(opt *domainConfig) {
+		opt.mixedRadix = true
+	}
+}
+
 // default options
 func domainOptions(opts ...DomainOption) domainConfig {
 	// apply options
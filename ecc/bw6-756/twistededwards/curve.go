@@ -65,3 +65,8 @@ func initCurveParams() {
 func mulByA(x *fr.Element) {
 	x.Mul(x, &curveParams.A)
 }
+
+// mulByACT multiplies x by a in constant time
+func mulByACT(x *fr.Element) {
+	x.MulCT(x, &curveParams.A)
+}
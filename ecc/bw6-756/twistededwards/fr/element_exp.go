@@ -0,0 +1,1201 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, fbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e25193265e9fe96e26da)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_100     = 1 + _11
+	//	_101     = 1 + _100
+	//	_1000    = _11 + _101
+	//	_1001    = 1 + _1000
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_10001   = _100 + _1101
+	//	_10011   = _10 + _10001
+	//	_10111   = _100 + _10011
+	//	_11001   = _10 + _10111
+	//	_11011   = _10 + _11001
+	//	_11101   = _10 + _11011
+	//	_100001  = _100 + _11101
+	//	_100011  = _10 + _100001
+	//	_100101  = _10 + _100011
+	//	_101001  = _100 + _100101
+	//	_101011  = _10 + _101001
+	//	_110011  = _1000 + _101011
+	//	_110101  = _10 + _110011
+	//	_111001  = _100 + _110101
+	//	_111011  = _10 + _111001
+	//	_111101  = _10 + _111011
+	//	_111111  = _10 + _111101
+	//	_1111100 = _111101 + _111111
+	//	i41      = ((_1111100 << 4 + _11101) << 3 + _11) << 6
+	//	i59      = ((1 + i41) << 9 + _1011) << 6 + _1101
+	//	i80      = ((i59 << 9 + _10011) << 7 + _100011) << 3
+	//	i100     = ((1 + i80) << 11 + _101001) << 6 + _111001
+	//	i119     = ((i100 << 7 + _110101) << 4 + _1101) << 6
+	//	i140     = ((_1001 + i119) << 12 + _111011) << 6 + _10001
+	//	i164     = ((i140 << 11 + _111101) << 6 + _101) << 5
+	//	i186     = ((1 + i164) << 11 + _1011) << 8 + _111101
+	//	i207     = ((i186 << 6 + _11011) << 8 + _100011) << 5
+	//	i229     = ((_10001 + i207) << 12 + _100011) << 7 + _10011
+	//	i246     = ((i229 << 7 + _100001) << 6 + _100001) << 2
+	//	i266     = ((1 + i246) << 9 + _1011) << 8 + _101011
+	//	i286     = ((i266 << 5 + _10001) << 7 + _100011) << 6
+	//	i301     = ((_101011 + i286) << 2 + _11) << 10 + _111011
+	//	i327     = ((i301 << 7 + _110011) << 6 + _101) << 11
+	//	i345     = ((_100101 + i327) << 3 + _11) << 12 + _101001
+	//	i373     = ((i345 << 9 + _111111) << 9 + _100101) << 8
+	//	i390     = ((_11001 + i373) << 7 + _11001) << 7 + _11001
+	//	i409     = ((i390 << 7 + _111101) << 8 + _111111) << 2
+	//	i424     = ((_11 + i409) << 7 + _100101) << 5 + _10111
+	//	i442     = ((i424 << 8 + _10011) << 6 + _11011) << 2
+	//	return     2*(1 + i442)
+	//
+	// Operations: 366 squares 78 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: t3 = x^0x3
+	t3.Mul(&x, t4)
+
+	// Step 3: t5 = x^0x4
+	t5.Mul(&x, t3)
+
+	// Step 4: t8 = x^0x5
+	t8.Mul(&x, t5)
+
+	// Step 5: t9 = x^0x8
+	t9.Mul(t3, t8)
+
+	// Step 6: t16 = x^0x9
+	t16.Mul(&x, t9)
+
+	// Step 7: t14 = x^0xb
+	t14.Mul(t4, t16)
+
+	// Step 8: t17 = x^0xd
+	t17.Mul(t4, t14)
+
+	// Step 9: t13 = x^0x11
+	t13.Mul(t5, t17)
+
+	// Step 10: t0 = x^0x13
+	t0.Mul(t4, t13)
+
+	// Step 11: t1 = x^0x17
+	t1.Mul(t5, t0)
+
+	// Step 12: t6 = x^0x19
+	t6.Mul(t4, t1)
+
+	// Step 13: z = x^0x1b
+	z.Mul(t4, t6)
+
+	// Step 14: t20 = x^0x1d
+	t20.Mul(t4, z)
+
+	// Step 15: t15 = x^0x21
+	t15.Mul(t5, t20)
+
+	// Step 16: t12 = x^0x23
+	t12.Mul(t4, t15)
+
+	// Step 17: t2 = x^0x25
+	t2.Mul(t4, t12)
+
+	// Step 18: t7 = x^0x29
+	t7.Mul(t5, t2)
+
+	// Step 19: t11 = x^0x2b
+	t11.Mul(t4, t7)
+
+	// Step 20: t9 = x^0x33
+	t9.Mul(t9, t11)
+
+	// Step 21: t18 = x^0x35
+	t18.Mul(t4, t9)
+
+	// Step 22: t19 = x^0x39
+	t19.Mul(t5, t18)
+
+	// Step 23: t10 = x^0x3b
+	t10.Mul(t4, t19)
+
+	// Step 24: t5 = x^0x3d
+	t5.Mul(t4, t10)
+
+	// Step 25: t4 = x^0x3f
+	t4.Mul(t4, t5)
+
+	// Step 26: t21 = x^0x7c
+	t21.Mul(t5, t4)
+
+	// Step 30: t21 = x^0x7c0
+	for s := 0; s < 4; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 31: t20 = x^0x7dd
+	t20.Mul(t20, t21)
+
+	// Step 34: t20 = x^0x3ee8
+	for s := 0; s < 3; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 35: t20 = x^0x3eeb
+	t20.Mul(t3, t20)
+
+	// Step 41: t20 = x^0xfbac0
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 42: t20 = x^0xfbac1
+	t20.Mul(&x, t20)
+
+	// Step 51: t20 = x^0x1f758200
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 52: t20 = x^0x1f75820b
+	t20.Mul(t14, t20)
+
+	// Step 58: t20 = x^0x7dd6082c0
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 59: t20 = x^0x7dd6082cd
+	t20.Mul(t17, t20)
+
+	// Step 68: t20 = x^0xfbac1059a00
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 69: t20 = x^0xfbac1059a13
+	t20.Mul(t0, t20)
+
+	// Step 76: t20 = x^0x7dd6082cd0980
+	for s := 0; s < 7; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 77: t20 = x^0x7dd6082cd09a3
+	t20.Mul(t12, t20)
+
+	// Step 80: t20 = x^0x3eeb0416684d18
+	for s := 0; s < 3; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 81: t20 = x^0x3eeb0416684d19
+	t20.Mul(&x, t20)
+
+	// Step 92: t20 = x^0x1f75820b34268c800
+	for s := 0; s < 11; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 93: t20 = x^0x1f75820b34268c829
+	t20.Mul(t7, t20)
+
+	// Step 99: t20 = x^0x7dd6082cd09a320a40
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 100: t19 = x^0x7dd6082cd09a320a79
+	t19.Mul(t19, t20)
+
+	// Step 107: t19 = x^0x3eeb0416684d19053c80
+	for s := 0; s < 7; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 108: t18 = x^0x3eeb0416684d19053cb5
+	t18.Mul(t18, t19)
+
+	// Step 112: t18 = x^0x3eeb0416684d19053cb50
+	for s := 0; s < 4; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 113: t17 = x^0x3eeb0416684d19053cb5d
+	t17.Mul(t17, t18)
+
+	// Step 119: t17 = x^0xfbac1059a1346414f2d740
+	for s := 0; s < 6; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 120: t16 = x^0xfbac1059a1346414f2d749
+	t16.Mul(t16, t17)
+
+	// Step 132: t16 = x^0xfbac1059a1346414f2d749000
+	for s := 0; s < 12; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 133: t16 = x^0xfbac1059a1346414f2d74903b
+	t16.Mul(t10, t16)
+
+	// Step 139: t16 = x^0x3eeb0416684d19053cb5d240ec0
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 140: t16 = x^0x3eeb0416684d19053cb5d240ed1
+	t16.Mul(t13, t16)
+
+	// Step 151: t16 = x^0x1f75820b34268c829e5ae920768800
+	for s := 0; s < 11; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 152: t16 = x^0x1f75820b34268c829e5ae92076883d
+	t16.Mul(t5, t16)
+
+	// Step 158: t16 = x^0x7dd6082cd09a320a796ba481da20f40
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 159: t16 = x^0x7dd6082cd09a320a796ba481da20f45
+	t16.Mul(t8, t16)
+
+	// Step 164: t16 = x^0xfbac1059a1346414f2d74903b441e8a0
+	for s := 0; s < 5; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 165: t16 = x^0xfbac1059a1346414f2d74903b441e8a1
+	t16.Mul(&x, t16)
+
+	// Step 176: t16 = x^0x7dd6082cd09a320a796ba481da20f450800
+	for s := 0; s < 11; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 177: t16 = x^0x7dd6082cd09a320a796ba481da20f45080b
+	t16.Mul(t14, t16)
+
+	// Step 185: t16 = x^0x7dd6082cd09a320a796ba481da20f45080b00
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 186: t16 = x^0x7dd6082cd09a320a796ba481da20f45080b3d
+	t16.Mul(t5, t16)
+
+	// Step 192: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf40
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 193: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b
+	t16.Mul(z, t16)
+
+	// Step 201: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b00
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 202: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23
+	t16.Mul(t12, t16)
+
+	// Step 207: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6460
+	for s := 0; s < 5; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 208: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471
+	t16.Mul(t13, t16)
+
+	// Step 220: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471000
+	for s := 0; s < 12; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 221: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023
+	t16.Mul(t12, t16)
+
+	// Step 228: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881180
+	for s := 0; s < 7; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 229: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193
+	t16.Mul(t0, t16)
+
+	// Step 236: t16 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c980
+	for s := 0; s < 7; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 237: t16 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1
+	t16.Mul(t15, t16)
+
+	// Step 243: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326840
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 244: t15 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861
+	t15.Mul(t15, t16)
+
+	// Step 246: t15 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a184
+	for s := 0; s < 2; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 247: t15 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a185
+	t15.Mul(&x, t15)
+
+	// Step 256: t15 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a00
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 257: t14 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b
+	t14.Mul(t14, t15)
+
+	// Step 265: t14 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 266: t14 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b
+	t14.Mul(t11, t14)
+
+	// Step 271: t14 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416560
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 272: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571
+	t13.Mul(t13, t14)
+
+	// Step 279: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b880
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 280: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3
+	t12.Mul(t12, t13)
+
+	// Step 286: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28c0
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 287: t11 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28eb
+	t11.Mul(t11, t12)
+
+	// Step 289: t11 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3ac
+	for s := 0; s < 2; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 290: t11 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af
+	t11.Mul(t3, t11)
+
+	// Step 300: t11 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc00
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 301: t10 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b
+	t10.Mul(t10, t11)
+
+	// Step 308: t10 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1d80
+	for s := 0; s < 7; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 309: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db3
+	t9.Mul(t9, t10)
+
+	// Step 315: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 316: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc5
+	t8.Mul(t8, t9)
+
+	// Step 327: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b662800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 328: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b662825
+	t8.Mul(t2, t8)
+
+	// Step 331: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db314128
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 332: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b
+	t8.Mul(t3, t8)
+
+	// Step 344: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b000
+	for s := 0; s < 12; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 345: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b029
+	t7.Mul(t7, t8)
+
+	// Step 354: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b662825605200
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 355: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f
+	t7.Mul(t4, t7)
+
+	// Step 364: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e00
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 365: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e25
+	t7.Mul(t2, t7)
+
+	// Step 373: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e2500
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 374: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e2519
+	t7.Mul(t6, t7)
+
+	// Step 381: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 382: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c99
+	t7.Mul(t6, t7)
+
+	// Step 389: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 390: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c99
+	t6.Mul(t6, t7)
+
+	// Step 397: t6 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264c80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 398: t5 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd
+	t5.Mul(t5, t6)
+
+	// Step 406: t5 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd00
+	for s := 0; s < 8; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 407: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3f
+	t4.Mul(t4, t5)
+
+	// Step 409: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4fc
+	for s := 0; s < 2; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 410: t3 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff
+	t3.Mul(t3, t4)
+
+	// Step 417: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c997a7f80
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 418: t2 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c997a7fa5
+	t2.Mul(t2, t3)
+
+	// Step 423: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4a0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 424: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b7
+	t1.Mul(t1, t2)
+
+	// Step 432: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b700
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 433: t0 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b713
+	t0.Mul(t0, t1)
+
+	// Step 439: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3fd2dc4c0
+	for s := 0; s < 6; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 440: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3fd2dc4db
+	z.Mul(z, t0)
+
+	// Step 442: z = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b7136c
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+
+	// Step 443: z = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b7136d
+	z.Mul(&x, z)
+
+	// Step 444: z = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e25193265e9fe96e26da
+	z.Square(z)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c997a7fa5b89b6a)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_100     = 1 + _11
+	//	_101     = 1 + _100
+	//	_1000    = _11 + _101
+	//	_1001    = 1 + _1000
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_10001   = _100 + _1101
+	//	_10011   = _10 + _10001
+	//	_10111   = _100 + _10011
+	//	_11001   = _10 + _10111
+	//	_11011   = _10 + _11001
+	//	_11101   = _10 + _11011
+	//	_100001  = _100 + _11101
+	//	_100011  = _10 + _100001
+	//	_100101  = _10 + _100011
+	//	_101001  = _100 + _100101
+	//	_101011  = _10 + _101001
+	//	_110011  = _1000 + _101011
+	//	_110101  = _10 + _110011
+	//	_111001  = _100 + _110101
+	//	_111011  = _10 + _111001
+	//	_111101  = _10 + _111011
+	//	_111111  = _10 + _111101
+	//	_1111100 = _111101 + _111111
+	//	i41      = ((_1111100 << 4 + _11101) << 3 + _11) << 6
+	//	i59      = ((1 + i41) << 9 + _1011) << 6 + _1101
+	//	i80      = ((i59 << 9 + _10011) << 7 + _100011) << 3
+	//	i100     = ((1 + i80) << 11 + _101001) << 6 + _111001
+	//	i119     = ((i100 << 7 + _110101) << 4 + _1101) << 6
+	//	i140     = ((_1001 + i119) << 12 + _111011) << 6 + _10001
+	//	i164     = ((i140 << 11 + _111101) << 6 + _101) << 5
+	//	i186     = ((1 + i164) << 11 + _1011) << 8 + _111101
+	//	i207     = ((i186 << 6 + _11011) << 8 + _100011) << 5
+	//	i229     = ((_10001 + i207) << 12 + _100011) << 7 + _10011
+	//	i246     = ((i229 << 7 + _100001) << 6 + _100001) << 2
+	//	i266     = ((1 + i246) << 9 + _1011) << 8 + _101011
+	//	i286     = ((i266 << 5 + _10001) << 7 + _100011) << 6
+	//	i301     = ((_101011 + i286) << 2 + _11) << 10 + _111011
+	//	i327     = ((i301 << 7 + _110011) << 6 + _101) << 11
+	//	i345     = ((_100101 + i327) << 3 + _11) << 12 + _101001
+	//	i373     = ((i345 << 9 + _111111) << 9 + _100101) << 8
+	//	i390     = ((_11001 + i373) << 7 + _11001) << 7 + _11001
+	//	i409     = ((i390 << 7 + _111101) << 8 + _111111) << 2
+	//	i424     = ((_11 + i409) << 7 + _100101) << 5 + _10111
+	//	i444     = ((i424 << 8 + _10011) << 6 + _11011) << 4
+	//	return     2*(_101 + i444)
+	//
+	// Operations: 368 squares 78 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21 Element
+	// Step 1: t5 = x^0x2
+	t5.Square(&x)
+
+	// Step 2: t4 = x^0x3
+	t4.Mul(&x, t5)
+
+	// Step 3: t6 = x^0x4
+	t6.Mul(&x, t4)
+
+	// Step 4: z = x^0x5
+	z.Mul(&x, t6)
+
+	// Step 5: t9 = x^0x8
+	t9.Mul(t4, z)
+
+	// Step 6: t16 = x^0x9
+	t16.Mul(&x, t9)
+
+	// Step 7: t14 = x^0xb
+	t14.Mul(t5, t16)
+
+	// Step 8: t17 = x^0xd
+	t17.Mul(t5, t14)
+
+	// Step 9: t13 = x^0x11
+	t13.Mul(t6, t17)
+
+	// Step 10: t1 = x^0x13
+	t1.Mul(t5, t13)
+
+	// Step 11: t2 = x^0x17
+	t2.Mul(t6, t1)
+
+	// Step 12: t7 = x^0x19
+	t7.Mul(t5, t2)
+
+	// Step 13: t0 = x^0x1b
+	t0.Mul(t5, t7)
+
+	// Step 14: t20 = x^0x1d
+	t20.Mul(t5, t0)
+
+	// Step 15: t15 = x^0x21
+	t15.Mul(t6, t20)
+
+	// Step 16: t12 = x^0x23
+	t12.Mul(t5, t15)
+
+	// Step 17: t3 = x^0x25
+	t3.Mul(t5, t12)
+
+	// Step 18: t8 = x^0x29
+	t8.Mul(t6, t3)
+
+	// Step 19: t11 = x^0x2b
+	t11.Mul(t5, t8)
+
+	// Step 20: t9 = x^0x33
+	t9.Mul(t9, t11)
+
+	// Step 21: t18 = x^0x35
+	t18.Mul(t5, t9)
+
+	// Step 22: t19 = x^0x39
+	t19.Mul(t6, t18)
+
+	// Step 23: t10 = x^0x3b
+	t10.Mul(t5, t19)
+
+	// Step 24: t6 = x^0x3d
+	t6.Mul(t5, t10)
+
+	// Step 25: t5 = x^0x3f
+	t5.Mul(t5, t6)
+
+	// Step 26: t21 = x^0x7c
+	t21.Mul(t6, t5)
+
+	// Step 30: t21 = x^0x7c0
+	for s := 0; s < 4; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 31: t20 = x^0x7dd
+	t20.Mul(t20, t21)
+
+	// Step 34: t20 = x^0x3ee8
+	for s := 0; s < 3; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 35: t20 = x^0x3eeb
+	t20.Mul(t4, t20)
+
+	// Step 41: t20 = x^0xfbac0
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 42: t20 = x^0xfbac1
+	t20.Mul(&x, t20)
+
+	// Step 51: t20 = x^0x1f758200
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 52: t20 = x^0x1f75820b
+	t20.Mul(t14, t20)
+
+	// Step 58: t20 = x^0x7dd6082c0
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 59: t20 = x^0x7dd6082cd
+	t20.Mul(t17, t20)
+
+	// Step 68: t20 = x^0xfbac1059a00
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 69: t20 = x^0xfbac1059a13
+	t20.Mul(t1, t20)
+
+	// Step 76: t20 = x^0x7dd6082cd0980
+	for s := 0; s < 7; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 77: t20 = x^0x7dd6082cd09a3
+	t20.Mul(t12, t20)
+
+	// Step 80: t20 = x^0x3eeb0416684d18
+	for s := 0; s < 3; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 81: t20 = x^0x3eeb0416684d19
+	t20.Mul(&x, t20)
+
+	// Step 92: t20 = x^0x1f75820b34268c800
+	for s := 0; s < 11; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 93: t20 = x^0x1f75820b34268c829
+	t20.Mul(t8, t20)
+
+	// Step 99: t20 = x^0x7dd6082cd09a320a40
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 100: t19 = x^0x7dd6082cd09a320a79
+	t19.Mul(t19, t20)
+
+	// Step 107: t19 = x^0x3eeb0416684d19053c80
+	for s := 0; s < 7; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 108: t18 = x^0x3eeb0416684d19053cb5
+	t18.Mul(t18, t19)
+
+	// Step 112: t18 = x^0x3eeb0416684d19053cb50
+	for s := 0; s < 4; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 113: t17 = x^0x3eeb0416684d19053cb5d
+	t17.Mul(t17, t18)
+
+	// Step 119: t17 = x^0xfbac1059a1346414f2d740
+	for s := 0; s < 6; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 120: t16 = x^0xfbac1059a1346414f2d749
+	t16.Mul(t16, t17)
+
+	// Step 132: t16 = x^0xfbac1059a1346414f2d749000
+	for s := 0; s < 12; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 133: t16 = x^0xfbac1059a1346414f2d74903b
+	t16.Mul(t10, t16)
+
+	// Step 139: t16 = x^0x3eeb0416684d19053cb5d240ec0
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 140: t16 = x^0x3eeb0416684d19053cb5d240ed1
+	t16.Mul(t13, t16)
+
+	// Step 151: t16 = x^0x1f75820b34268c829e5ae920768800
+	for s := 0; s < 11; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 152: t16 = x^0x1f75820b34268c829e5ae92076883d
+	t16.Mul(t6, t16)
+
+	// Step 158: t16 = x^0x7dd6082cd09a320a796ba481da20f40
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 159: t16 = x^0x7dd6082cd09a320a796ba481da20f45
+	t16.Mul(z, t16)
+
+	// Step 164: t16 = x^0xfbac1059a1346414f2d74903b441e8a0
+	for s := 0; s < 5; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 165: t16 = x^0xfbac1059a1346414f2d74903b441e8a1
+	t16.Mul(&x, t16)
+
+	// Step 176: t16 = x^0x7dd6082cd09a320a796ba481da20f450800
+	for s := 0; s < 11; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 177: t16 = x^0x7dd6082cd09a320a796ba481da20f45080b
+	t16.Mul(t14, t16)
+
+	// Step 185: t16 = x^0x7dd6082cd09a320a796ba481da20f45080b00
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 186: t16 = x^0x7dd6082cd09a320a796ba481da20f45080b3d
+	t16.Mul(t6, t16)
+
+	// Step 192: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf40
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 193: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b
+	t16.Mul(t0, t16)
+
+	// Step 201: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b00
+	for s := 0; s < 8; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 202: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23
+	t16.Mul(t12, t16)
+
+	// Step 207: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6460
+	for s := 0; s < 5; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 208: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471
+	t16.Mul(t13, t16)
+
+	// Step 220: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471000
+	for s := 0; s < 12; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 221: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023
+	t16.Mul(t12, t16)
+
+	// Step 228: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881180
+	for s := 0; s < 7; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 229: t16 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193
+	t16.Mul(t1, t16)
+
+	// Step 236: t16 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c980
+	for s := 0; s < 7; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 237: t16 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1
+	t16.Mul(t15, t16)
+
+	// Step 243: t16 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326840
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 244: t15 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861
+	t15.Mul(t15, t16)
+
+	// Step 246: t15 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a184
+	for s := 0; s < 2; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 247: t15 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a185
+	t15.Mul(&x, t15)
+
+	// Step 256: t15 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a00
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 257: t14 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b
+	t14.Mul(t14, t15)
+
+	// Step 265: t14 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b00
+	for s := 0; s < 8; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 266: t14 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b
+	t14.Mul(t11, t14)
+
+	// Step 271: t14 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416560
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 272: t13 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571
+	t13.Mul(t13, t14)
+
+	// Step 279: t13 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b880
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 280: t12 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3
+	t12.Mul(t12, t13)
+
+	// Step 286: t12 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28c0
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 287: t11 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28eb
+	t11.Mul(t11, t12)
+
+	// Step 289: t11 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3ac
+	for s := 0; s < 2; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 290: t11 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af
+	t11.Mul(t4, t11)
+
+	// Step 300: t11 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc00
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 301: t10 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b
+	t10.Mul(t10, t11)
+
+	// Step 308: t10 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1d80
+	for s := 0; s < 7; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 309: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db3
+	t9.Mul(t9, t10)
+
+	// Step 315: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 316: t9 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc5
+	t9.Mul(z, t9)
+
+	// Step 327: t9 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b662800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 328: t9 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b662825
+	t9.Mul(t3, t9)
+
+	// Step 331: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db314128
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 332: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b
+	t9.Mul(t4, t9)
+
+	// Step 344: t9 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b000
+	for s := 0; s < 12; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 345: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b029
+	t8.Mul(t8, t9)
+
+	// Step 354: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b662825605200
+	for s := 0; s < 9; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 355: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f
+	t8.Mul(t5, t8)
+
+	// Step 364: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e00
+	for s := 0; s < 9; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 365: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e25
+	t8.Mul(t3, t8)
+
+	// Step 373: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e2500
+	for s := 0; s < 8; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 374: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a1850595c51d7876cc504ac0a47e2519
+	t8.Mul(t7, t8)
+
+	// Step 381: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c80
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 382: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c99
+	t8.Mul(t7, t8)
+
+	// Step 389: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c80
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 390: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c99
+	t7.Mul(t7, t8)
+
+	// Step 397: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264c80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 398: t6 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd
+	t6.Mul(t6, t7)
+
+	// Step 406: t6 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd00
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 407: t5 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3f
+	t5.Mul(t5, t6)
+
+	// Step 409: t5 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4fc
+	for s := 0; s < 2; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 410: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff
+	t4.Mul(t4, t5)
+
+	// Step 417: t4 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c997a7f80
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 418: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c997a7fa5
+	t3.Mul(t3, t4)
+
+	// Step 423: t3 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4a0
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 424: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b7
+	t2.Mul(t2, t3)
+
+	// Step 432: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b700
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 433: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0c282cae28ebc3b66282560523f128c9932f4ff4b713
+	t1.Mul(t1, t2)
+
+	// Step 439: t1 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3fd2dc4c0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 440: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3fd2dc4db
+	t0.Mul(t0, t1)
+
+	// Step 444: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3fd2dc4db0
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 445: z = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881193430a0b2b8a3af0ed98a0958148fc4a3264cbd3fd2dc4db5
+	z.Mul(z, t0)
+
+	// Step 446: z = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326861416571475e1db31412b0291f89464c997a7fa5b89b6a
+	z.Square(z)
+
+	return z
+}
@@ -0,0 +1,1580 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// mul(res, x, y *Element) sets res = x·y·R⁻¹ (mod q)
+
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	MOVD y+16(FP), R1
+	MOVD $0xeed0939dce77ad83, R9
+	MOVD $0x9932f4ff4b7136d5, R19
+	MOVD $0x66282560523f128c, R20
+	MOVD $0xd0c282cae28ebc3b, R21
+	MOVD $0x5080b3d6c8e20464, R22
+	MOVD $0x0a796ba481da20f4, R23
+	MOVD $0x007dd6082cd09a32, R24
+	LDP  0(R0), (R12, R13)
+	LDP  16(R0), (R14, R15)
+	LDP  32(R0), (R16, R17)
+
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R10
+	MUL   R12, R10, R2
+	MUL   R13, R10, R3
+	MUL   R14, R10, R4
+	MUL   R15, R10, R5
+	MUL   R16, R10, R6
+	MUL   R17, R10, R7
+	UMULH R17, R10, R8
+	UMULH R12, R10, R11
+	ADDS  R11, R3, R3
+	UMULH R13, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R14, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R15, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R16, R10, R11
+	ADCS  R11, R7, R7
+	ADC   ZR, R8, R8
+	MUL   R2, R9, R10
+	MUL   R19, R10, R11
+	ADDS  R11, R2, R2
+	MUL   R20, R10, R11
+	ADCS  R11, R3, R3
+	MUL   R21, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R22, R10, R11
+	ADCS  R11, R5, R5
+	MUL   R23, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R24, R10, R11
+	ADCS  R11, R7, R7
+	ADC   ZR, R8, R8
+	UMULH R19, R10, R11
+	ADDS  R11, R3, R3
+	UMULH R20, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R21, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R22, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R23, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R24, R10, R11
+	ADC   R11, R8, R8
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R3, R3
+	MUL   R13, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R14, R10, R11
+	ADCS  R11, R5, R5
+	MUL   R15, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R16, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R17, R10, R11
+	ADCS  R11, R8, R8
+	ADC   ZR, R2, R2
+	UMULH R12, R10, R11
+	ADDS  R11, R4, R4
+	UMULH R13, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R14, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R15, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R16, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R17, R10, R11
+	ADC   R11, R2, R2
+	MUL   R3, R9, R10
+	MUL   R19, R10, R11
+	ADDS  R11, R3, R3
+	MUL   R20, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R21, R10, R11
+	ADCS  R11, R5, R5
+	MUL   R22, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R23, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R24, R10, R11
+	ADCS  R11, R8, R8
+	ADC   ZR, R2, R2
+	UMULH R19, R10, R11
+	ADDS  R11, R4, R4
+	UMULH R20, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R21, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R22, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R23, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R24, R10, R11
+	ADC   R11, R2, R2
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R4, R4
+	MUL   R13, R10, R11
+	ADCS  R11, R5, R5
+	MUL   R14, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R15, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R16, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R17, R10, R11
+	ADCS  R11, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R12, R10, R11
+	ADDS  R11, R5, R5
+	UMULH R13, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R14, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R15, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R16, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R17, R10, R11
+	ADC   R11, R3, R3
+	MUL   R4, R9, R10
+	MUL   R19, R10, R11
+	ADDS  R11, R4, R4
+	MUL   R20, R10, R11
+	ADCS  R11, R5, R5
+	MUL   R21, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R22, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R23, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R24, R10, R11
+	ADCS  R11, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R19, R10, R11
+	ADDS  R11, R5, R5
+	UMULH R20, R10, R11
+	ADCS  R11, R6, R6
+	UMULH R21, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R22, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R23, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R24, R10, R11
+	ADC   R11, R3, R3
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R5, R5
+	MUL   R13, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R14, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R15, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R16, R10, R11
+	ADCS  R11, R2, R2
+	MUL   R17, R10, R11
+	ADCS  R11, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R12, R10, R11
+	ADDS  R11, R6, R6
+	UMULH R13, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R14, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R15, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R16, R10, R11
+	ADCS  R11, R3, R3
+	UMULH R17, R10, R11
+	ADC   R11, R4, R4
+	MUL   R5, R9, R10
+	MUL   R19, R10, R11
+	ADDS  R11, R5, R5
+	MUL   R20, R10, R11
+	ADCS  R11, R6, R6
+	MUL   R21, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R22, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R23, R10, R11
+	ADCS  R11, R2, R2
+	MUL   R24, R10, R11
+	ADCS  R11, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R19, R10, R11
+	ADDS  R11, R6, R6
+	UMULH R20, R10, R11
+	ADCS  R11, R7, R7
+	UMULH R21, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R22, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R23, R10, R11
+	ADCS  R11, R3, R3
+	UMULH R24, R10, R11
+	ADC   R11, R4, R4
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R6, R6
+	MUL   R13, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R14, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R15, R10, R11
+	ADCS  R11, R2, R2
+	MUL   R16, R10, R11
+	ADCS  R11, R3, R3
+	MUL   R17, R10, R11
+	ADCS  R11, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R12, R10, R11
+	ADDS  R11, R7, R7
+	UMULH R13, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R14, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R15, R10, R11
+	ADCS  R11, R3, R3
+	UMULH R16, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R17, R10, R11
+	ADC   R11, R5, R5
+	MUL   R6, R9, R10
+	MUL   R19, R10, R11
+	ADDS  R11, R6, R6
+	MUL   R20, R10, R11
+	ADCS  R11, R7, R7
+	MUL   R21, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R22, R10, R11
+	ADCS  R11, R2, R2
+	MUL   R23, R10, R11
+	ADCS  R11, R3, R3
+	MUL   R24, R10, R11
+	ADCS  R11, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R19, R10, R11
+	ADDS  R11, R7, R7
+	UMULH R20, R10, R11
+	ADCS  R11, R8, R8
+	UMULH R21, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R22, R10, R11
+	ADCS  R11, R3, R3
+	UMULH R23, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R24, R10, R11
+	ADC   R11, R5, R5
+
+	// t += x·y[5], followed by a reduction round
+	MOVD  40(R1), R10
+	MUL   R12, R10, R11
+	ADDS  R11, R7, R7
+	MUL   R13, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R14, R10, R11
+	ADCS  R11, R2, R2
+	MUL   R15, R10, R11
+	ADCS  R11, R3, R3
+	MUL   R16, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R17, R10, R11
+	ADCS  R11, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R12, R10, R11
+	ADDS  R11, R8, R8
+	UMULH R13, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R14, R10, R11
+	ADCS  R11, R3, R3
+	UMULH R15, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R16, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R17, R10, R11
+	ADC   R11, R6, R6
+	MUL   R7, R9, R10
+	MUL   R19, R10, R11
+	ADDS  R11, R7, R7
+	MUL   R20, R10, R11
+	ADCS  R11, R8, R8
+	MUL   R21, R10, R11
+	ADCS  R11, R2, R2
+	MUL   R22, R10, R11
+	ADCS  R11, R3, R3
+	MUL   R23, R10, R11
+	ADCS  R11, R4, R4
+	MUL   R24, R10, R11
+	ADCS  R11, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R19, R10, R11
+	ADDS  R11, R8, R8
+	UMULH R20, R10, R11
+	ADCS  R11, R2, R2
+	UMULH R21, R10, R11
+	ADCS  R11, R3, R3
+	UMULH R22, R10, R11
+	ADCS  R11, R4, R4
+	UMULH R23, R10, R11
+	ADCS  R11, R5, R5
+	UMULH R24, R10, R11
+	ADC   R11, R6, R6
+
+	// t = t - q if t >= q
+	SUBS  R19, R8, R11
+	SBCS  R20, R2, R11
+	SBCS  R21, R3, R11
+	SBCS  R22, R4, R11
+	SBCS  R23, R5, R11
+	SBCS  R24, R6, R11
+	CSETM CS, R10
+	AND   R10, R19, R11
+	SUBS  R11, R8, R8
+	AND   R10, R20, R11
+	SBCS  R11, R2, R2
+	AND   R10, R21, R11
+	SBCS  R11, R3, R3
+	AND   R10, R22, R11
+	SBCS  R11, R4, R4
+	AND   R10, R23, R11
+	SBCS  R11, R5, R5
+	AND   R10, R24, R11
+	SBCS  R11, R6, R6
+	MOVD  res+0(FP), R0
+	STP   (R8, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	RET
+
+// fromMont(res *Element) sets res = res·R⁻¹ (mod q)
+
+TEXT ·fromMont(SB), NOSPLIT, $0-8
+	MOVD  res+0(FP), R0
+	MOVD  $0xeed0939dce77ad83, R8
+	MOVD  $0x9932f4ff4b7136d5, R11
+	MOVD  $0x66282560523f128c, R12
+	MOVD  $0xd0c282cae28ebc3b, R13
+	MOVD  $0x5080b3d6c8e20464, R14
+	MOVD  $0x0a796ba481da20f4, R15
+	MOVD  $0x007dd6082cd09a32, R16
+	LDP   0(R0), (R1, R2)
+	LDP   16(R0), (R3, R4)
+	LDP   32(R0), (R5, R6)
+	MOVD  ZR, R7
+	MUL   R1, R8, R9
+	MUL   R11, R9, R10
+	ADDS  R10, R1, R1
+	MUL   R12, R9, R10
+	ADCS  R10, R2, R2
+	MUL   R13, R9, R10
+	ADCS  R10, R3, R3
+	MUL   R14, R9, R10
+	ADCS  R10, R4, R4
+	MUL   R15, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R16, R9, R10
+	ADCS  R10, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R11, R9, R10
+	ADDS  R10, R2, R2
+	UMULH R12, R9, R10
+	ADCS  R10, R3, R3
+	UMULH R13, R9, R10
+	ADCS  R10, R4, R4
+	UMULH R14, R9, R10
+	ADCS  R10, R5, R5
+	UMULH R15, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R16, R9, R10
+	ADC   R10, R7, R7
+	MUL   R2, R8, R9
+	MUL   R11, R9, R10
+	ADDS  R10, R2, R2
+	MUL   R12, R9, R10
+	ADCS  R10, R3, R3
+	MUL   R13, R9, R10
+	ADCS  R10, R4, R4
+	MUL   R14, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R15, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R16, R9, R10
+	ADCS  R10, R7, R7
+	ADC   ZR, R1, R1
+	UMULH R11, R9, R10
+	ADDS  R10, R3, R3
+	UMULH R12, R9, R10
+	ADCS  R10, R4, R4
+	UMULH R13, R9, R10
+	ADCS  R10, R5, R5
+	UMULH R14, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R15, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R16, R9, R10
+	ADC   R10, R1, R1
+	MUL   R3, R8, R9
+	MUL   R11, R9, R10
+	ADDS  R10, R3, R3
+	MUL   R12, R9, R10
+	ADCS  R10, R4, R4
+	MUL   R13, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R14, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R15, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R16, R9, R10
+	ADCS  R10, R1, R1
+	ADC   ZR, R2, R2
+	UMULH R11, R9, R10
+	ADDS  R10, R4, R4
+	UMULH R12, R9, R10
+	ADCS  R10, R5, R5
+	UMULH R13, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R14, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R15, R9, R10
+	ADCS  R10, R1, R1
+	UMULH R16, R9, R10
+	ADC   R10, R2, R2
+	MUL   R4, R8, R9
+	MUL   R11, R9, R10
+	ADDS  R10, R4, R4
+	MUL   R12, R9, R10
+	ADCS  R10, R5, R5
+	MUL   R13, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R14, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R15, R9, R10
+	ADCS  R10, R1, R1
+	MUL   R16, R9, R10
+	ADCS  R10, R2, R2
+	ADC   ZR, R3, R3
+	UMULH R11, R9, R10
+	ADDS  R10, R5, R5
+	UMULH R12, R9, R10
+	ADCS  R10, R6, R6
+	UMULH R13, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R14, R9, R10
+	ADCS  R10, R1, R1
+	UMULH R15, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R16, R9, R10
+	ADC   R10, R3, R3
+	MUL   R5, R8, R9
+	MUL   R11, R9, R10
+	ADDS  R10, R5, R5
+	MUL   R12, R9, R10
+	ADCS  R10, R6, R6
+	MUL   R13, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R14, R9, R10
+	ADCS  R10, R1, R1
+	MUL   R15, R9, R10
+	ADCS  R10, R2, R2
+	MUL   R16, R9, R10
+	ADCS  R10, R3, R3
+	ADC   ZR, R4, R4
+	UMULH R11, R9, R10
+	ADDS  R10, R6, R6
+	UMULH R12, R9, R10
+	ADCS  R10, R7, R7
+	UMULH R13, R9, R10
+	ADCS  R10, R1, R1
+	UMULH R14, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R15, R9, R10
+	ADCS  R10, R3, R3
+	UMULH R16, R9, R10
+	ADC   R10, R4, R4
+	MUL   R6, R8, R9
+	MUL   R11, R9, R10
+	ADDS  R10, R6, R6
+	MUL   R12, R9, R10
+	ADCS  R10, R7, R7
+	MUL   R13, R9, R10
+	ADCS  R10, R1, R1
+	MUL   R14, R9, R10
+	ADCS  R10, R2, R2
+	MUL   R15, R9, R10
+	ADCS  R10, R3, R3
+	MUL   R16, R9, R10
+	ADCS  R10, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R11, R9, R10
+	ADDS  R10, R7, R7
+	UMULH R12, R9, R10
+	ADCS  R10, R1, R1
+	UMULH R13, R9, R10
+	ADCS  R10, R2, R2
+	UMULH R14, R9, R10
+	ADCS  R10, R3, R3
+	UMULH R15, R9, R10
+	ADCS  R10, R4, R4
+	UMULH R16, R9, R10
+	ADC   R10, R5, R5
+
+	// t = t - q if t >= q
+	SUBS  R11, R7, R10
+	SBCS  R12, R1, R10
+	SBCS  R13, R2, R10
+	SBCS  R14, R3, R10
+	SBCS  R15, R4, R10
+	SBCS  R16, R5, R10
+	CSETM CS, R9
+	AND   R9, R11, R10
+	SUBS  R10, R7, R7
+	AND   R9, R12, R10
+	SBCS  R10, R1, R1
+	AND   R9, R13, R10
+	SBCS  R10, R2, R2
+	AND   R9, R14, R10
+	SBCS  R10, R3, R3
+	AND   R9, R15, R10
+	SBCS  R10, R4, R4
+	AND   R9, R16, R10
+	SBCS  R10, R5, R5
+	STP   (R7, R1), 0(R0)
+	STP   (R2, R3), 16(R0)
+	STP   (R4, R5), 32(R0)
+	RET
+
+// reduce(res *Element) sets res = res - q if res >= q
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVD res+0(FP), R0
+	MOVD $0x9932f4ff4b7136d5, R9
+	MOVD $0x66282560523f128c, R10
+	MOVD $0xd0c282cae28ebc3b, R11
+	MOVD $0x5080b3d6c8e20464, R12
+	MOVD $0x0a796ba481da20f4, R13
+	MOVD $0x007dd6082cd09a32, R14
+	LDP  0(R0), (R1, R2)
+	LDP  16(R0), (R3, R4)
+	LDP  32(R0), (R5, R6)
+
+	// t = t - q if t >= q
+	SUBS  R9, R1, R8
+	SBCS  R10, R2, R8
+	SBCS  R11, R3, R8
+	SBCS  R12, R4, R8
+	SBCS  R13, R5, R8
+	SBCS  R14, R6, R8
+	CSETM CS, R7
+	AND   R7, R9, R8
+	SUBS  R8, R1, R1
+	AND   R7, R10, R8
+	SBCS  R8, R2, R2
+	AND   R7, R11, R8
+	SBCS  R8, R3, R3
+	AND   R7, R12, R8
+	SBCS  R8, R4, R4
+	AND   R7, R13, R8
+	SBCS  R8, R5, R5
+	AND   R7, R14, R8
+	SBCS  R8, R6, R6
+	STP   (R1, R2), 0(R0)
+	STP   (R3, R4), 16(R0)
+	STP   (R5, R6), 32(R0)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD $0x9932f4ff4b7136d5, R11
+	MOVD $0x66282560523f128c, R12
+	MOVD $0xd0c282cae28ebc3b, R13
+	MOVD $0x5080b3d6c8e20464, R14
+	MOVD $0x0a796ba481da20f4, R15
+	MOVD $0x007dd6082cd09a32, R16
+
+	// t = a + b, not reduced
+	LDP  0(R0), (R2, R3)
+	LDP  16(R0), (R4, R5)
+	LDP  32(R0), (R6, R7)
+	MOVD 0(R1), R8
+	ADDS R8, R2, R2
+	MOVD 8(R1), R8
+	ADCS R8, R3, R3
+	MOVD 16(R1), R8
+	ADCS R8, R4, R4
+	MOVD 24(R1), R8
+	ADCS R8, R5, R5
+	MOVD 32(R1), R8
+	ADCS R8, R6, R6
+	MOVD 40(R1), R8
+	ADCS R8, R7, R7
+
+	// b = a - b
+	MOVD 0(R0), R8
+	MOVD 0(R1), R10
+	SUBS R10, R8, R8
+	MOVD R8, 0(R1)
+	MOVD 8(R0), R8
+	MOVD 8(R1), R10
+	SBCS R10, R8, R8
+	MOVD R8, 8(R1)
+	MOVD 16(R0), R8
+	MOVD 16(R1), R10
+	SBCS R10, R8, R8
+	MOVD R8, 16(R1)
+	MOVD 24(R0), R8
+	MOVD 24(R1), R10
+	SBCS R10, R8, R8
+	MOVD R8, 24(R1)
+	MOVD 32(R0), R8
+	MOVD 32(R1), R10
+	SBCS R10, R8, R8
+	MOVD R8, 32(R1)
+	MOVD 40(R0), R8
+	MOVD 40(R1), R10
+	SBCS R10, R8, R8
+	MOVD R8, 40(R1)
+
+	// b = b + q if there was a borrow
+	CSETM CC, R9
+	MOVD  0(R1), R8
+	AND   R9, R11, R10
+	ADDS  R10, R8, R8
+	MOVD  R8, 0(R1)
+	MOVD  8(R1), R8
+	AND   R9, R12, R10
+	ADCS  R10, R8, R8
+	MOVD  R8, 8(R1)
+	MOVD  16(R1), R8
+	AND   R9, R13, R10
+	ADCS  R10, R8, R8
+	MOVD  R8, 16(R1)
+	MOVD  24(R1), R8
+	AND   R9, R14, R10
+	ADCS  R10, R8, R8
+	MOVD  R8, 24(R1)
+	MOVD  32(R1), R8
+	AND   R9, R15, R10
+	ADCS  R10, R8, R8
+	MOVD  R8, 32(R1)
+	MOVD  40(R1), R8
+	AND   R9, R16, R10
+	ADCS  R10, R8, R8
+	MOVD  R8, 40(R1)
+
+	// t = t - q if t >= q
+	SUBS  R11, R2, R10
+	SBCS  R12, R3, R10
+	SBCS  R13, R4, R10
+	SBCS  R14, R5, R10
+	SBCS  R15, R6, R10
+	SBCS  R16, R7, R10
+	CSETM CS, R9
+	AND   R9, R11, R10
+	SUBS  R10, R2, R2
+	AND   R9, R12, R10
+	SBCS  R10, R3, R3
+	AND   R9, R13, R10
+	SBCS  R10, R4, R4
+	AND   R9, R14, R10
+	SBCS  R10, R5, R5
+	AND   R9, R15, R10
+	SBCS  R10, R6, R6
+	AND   R9, R16, R10
+	SBCS  R10, R7, R7
+	STP   (R2, R3), 0(R0)
+	STP   (R4, R5), 16(R0)
+	STP   (R6, R7), 32(R0)
+	RET
+
+// addVecARM64(res, a, b *Element, n uint64) res[i] = a[i] + b[i]
+
+TEXT ·addVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x9932f4ff4b7136d5, R13
+	MOVD $0x66282560523f128c, R14
+	MOVD $0xd0c282cae28ebc3b, R15
+	MOVD $0x5080b3d6c8e20464, R16
+	MOVD $0x0a796ba481da20f4, R17
+	MOVD $0x007dd6082cd09a32, R19
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	LDP  32(R1), (R8, R9)
+	MOVD 0(R2), R10
+	ADDS R10, R4, R4
+	MOVD 8(R2), R10
+	ADCS R10, R5, R5
+	MOVD 16(R2), R10
+	ADCS R10, R6, R6
+	MOVD 24(R2), R10
+	ADCS R10, R7, R7
+	MOVD 32(R2), R10
+	ADCS R10, R8, R8
+	MOVD 40(R2), R10
+	ADCS R10, R9, R9
+
+	// t = t - q if t >= q
+	SUBS  R13, R4, R12
+	SBCS  R14, R5, R12
+	SBCS  R15, R6, R12
+	SBCS  R16, R7, R12
+	SBCS  R17, R8, R12
+	SBCS  R19, R9, R12
+	CSETM CS, R11
+	AND   R11, R13, R12
+	SUBS  R12, R4, R4
+	AND   R11, R14, R12
+	SBCS  R12, R5, R5
+	AND   R11, R15, R12
+	SBCS  R12, R6, R6
+	AND   R11, R16, R12
+	SBCS  R12, R7, R7
+	AND   R11, R17, R12
+	SBCS  R12, R8, R8
+	AND   R11, R19, R12
+	SBCS  R12, R9, R9
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	STP   (R8, R9), 32(R0)
+	ADD   $48, R0, R0
+	ADD   $48, R1, R1
+	ADD   $48, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// subVecARM64(res, a, b *Element, n uint64) res[i] = a[i] - b[i]
+
+TEXT ·subVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0x9932f4ff4b7136d5, R13
+	MOVD $0x66282560523f128c, R14
+	MOVD $0xd0c282cae28ebc3b, R15
+	MOVD $0x5080b3d6c8e20464, R16
+	MOVD $0x0a796ba481da20f4, R17
+	MOVD $0x007dd6082cd09a32, R19
+	CBZ  R3, done
+
+loop:
+	LDP  0(R1), (R4, R5)
+	LDP  16(R1), (R6, R7)
+	LDP  32(R1), (R8, R9)
+	MOVD 0(R2), R10
+	SUBS R10, R4, R4
+	MOVD 8(R2), R10
+	SBCS R10, R5, R5
+	MOVD 16(R2), R10
+	SBCS R10, R6, R6
+	MOVD 24(R2), R10
+	SBCS R10, R7, R7
+	MOVD 32(R2), R10
+	SBCS R10, R8, R8
+	MOVD 40(R2), R10
+	SBCS R10, R9, R9
+
+	// t = t + q if there was a borrow
+	CSETM CC, R11
+	AND   R11, R13, R12
+	ADDS  R12, R4, R4
+	AND   R11, R14, R12
+	ADCS  R12, R5, R5
+	AND   R11, R15, R12
+	ADCS  R12, R6, R6
+	AND   R11, R16, R12
+	ADCS  R12, R7, R7
+	AND   R11, R17, R12
+	ADCS  R12, R8, R8
+	AND   R11, R19, R12
+	ADCS  R12, R9, R9
+	STP   (R4, R5), 0(R0)
+	STP   (R6, R7), 16(R0)
+	STP   (R8, R9), 32(R0)
+	ADD   $48, R0, R0
+	ADD   $48, R1, R1
+	ADD   $48, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// scalarMulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b
+
+TEXT ·scalarMulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0xeed0939dce77ad83, R11
+	MOVD $0x9932f4ff4b7136d5, R21
+	MOVD $0x66282560523f128c, R22
+	MOVD $0xd0c282cae28ebc3b, R23
+	MOVD $0x5080b3d6c8e20464, R24
+	MOVD $0x0a796ba481da20f4, R25
+	MOVD $0x007dd6082cd09a32, R26
+	LDP  0(R2), (R14, R15)
+	LDP  16(R2), (R16, R17)
+	LDP  32(R2), (R19, R20)
+	CBZ  R3, done
+
+loop:
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R1), R12
+	MUL   R14, R12, R4
+	MUL   R15, R12, R5
+	MUL   R16, R12, R6
+	MUL   R17, R12, R7
+	MUL   R19, R12, R8
+	MUL   R20, R12, R9
+	UMULH R20, R12, R10
+	UMULH R14, R12, R13
+	ADDS  R13, R5, R5
+	UMULH R15, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R16, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R17, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R19, R12, R13
+	ADCS  R13, R9, R9
+	ADC   ZR, R10, R10
+	MUL   R4, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R4, R4
+	MUL   R22, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R23, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R24, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R25, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R26, R12, R13
+	ADCS  R13, R9, R9
+	ADC   ZR, R10, R10
+	UMULH R21, R12, R13
+	ADDS  R13, R5, R5
+	UMULH R22, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R23, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R24, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R25, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R26, R12, R13
+	ADC   R13, R10, R10
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R1), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R5, R5
+	MUL   R15, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R16, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R17, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R19, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R20, R12, R13
+	ADCS  R13, R10, R10
+	ADC   ZR, R4, R4
+	UMULH R14, R12, R13
+	ADDS  R13, R6, R6
+	UMULH R15, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R16, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R17, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R19, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R20, R12, R13
+	ADC   R13, R4, R4
+	MUL   R5, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R5, R5
+	MUL   R22, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R23, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R24, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R25, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R26, R12, R13
+	ADCS  R13, R10, R10
+	ADC   ZR, R4, R4
+	UMULH R21, R12, R13
+	ADDS  R13, R6, R6
+	UMULH R22, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R23, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R24, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R25, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R26, R12, R13
+	ADC   R13, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R1), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R6, R6
+	MUL   R15, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R16, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R17, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R19, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R20, R12, R13
+	ADCS  R13, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R14, R12, R13
+	ADDS  R13, R7, R7
+	UMULH R15, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R16, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R17, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R19, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R20, R12, R13
+	ADC   R13, R5, R5
+	MUL   R6, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R6, R6
+	MUL   R22, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R23, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R24, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R25, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R26, R12, R13
+	ADCS  R13, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R21, R12, R13
+	ADDS  R13, R7, R7
+	UMULH R22, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R23, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R24, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R25, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R26, R12, R13
+	ADC   R13, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R1), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R7, R7
+	MUL   R15, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R16, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R17, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R19, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R20, R12, R13
+	ADCS  R13, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R14, R12, R13
+	ADDS  R13, R8, R8
+	UMULH R15, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R16, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R17, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R19, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R20, R12, R13
+	ADC   R13, R6, R6
+	MUL   R7, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R7, R7
+	MUL   R22, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R23, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R24, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R25, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R26, R12, R13
+	ADCS  R13, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R21, R12, R13
+	ADDS  R13, R8, R8
+	UMULH R22, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R23, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R24, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R25, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R26, R12, R13
+	ADC   R13, R6, R6
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R1), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R8, R8
+	MUL   R15, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R16, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R17, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R19, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R20, R12, R13
+	ADCS  R13, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R14, R12, R13
+	ADDS  R13, R9, R9
+	UMULH R15, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R16, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R17, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R19, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R20, R12, R13
+	ADC   R13, R7, R7
+	MUL   R8, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R8, R8
+	MUL   R22, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R23, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R24, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R25, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R26, R12, R13
+	ADCS  R13, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R21, R12, R13
+	ADDS  R13, R9, R9
+	UMULH R22, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R23, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R24, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R25, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R26, R12, R13
+	ADC   R13, R7, R7
+
+	// t += x·y[5], followed by a reduction round
+	MOVD  40(R1), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R9, R9
+	MUL   R15, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R16, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R17, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R19, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R20, R12, R13
+	ADCS  R13, R7, R7
+	ADC   ZR, R8, R8
+	UMULH R14, R12, R13
+	ADDS  R13, R10, R10
+	UMULH R15, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R16, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R17, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R19, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R20, R12, R13
+	ADC   R13, R8, R8
+	MUL   R9, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R9, R9
+	MUL   R22, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R23, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R24, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R25, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R26, R12, R13
+	ADCS  R13, R7, R7
+	ADC   ZR, R8, R8
+	UMULH R21, R12, R13
+	ADDS  R13, R10, R10
+	UMULH R22, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R23, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R24, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R25, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R26, R12, R13
+	ADC   R13, R8, R8
+
+	// t = t - q if t >= q
+	SUBS  R21, R10, R13
+	SBCS  R22, R4, R13
+	SBCS  R23, R5, R13
+	SBCS  R24, R6, R13
+	SBCS  R25, R7, R13
+	SBCS  R26, R8, R13
+	CSETM CS, R12
+	AND   R12, R21, R13
+	SUBS  R13, R10, R10
+	AND   R12, R22, R13
+	SBCS  R13, R4, R4
+	AND   R12, R23, R13
+	SBCS  R13, R5, R5
+	AND   R12, R24, R13
+	SBCS  R13, R6, R6
+	AND   R12, R25, R13
+	SBCS  R13, R7, R7
+	AND   R12, R26, R13
+	SBCS  R13, R8, R8
+	STP   (R10, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	STP   (R7, R8), 32(R0)
+	ADD   $48, R0, R0
+	ADD   $48, R1, R1
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
+
+// mulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b[i]
+
+TEXT ·mulVecARM64(SB), NOSPLIT, $0-32
+	MOVD res+0(FP), R0
+	MOVD a+8(FP), R1
+	MOVD b+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0xeed0939dce77ad83, R11
+	MOVD $0x9932f4ff4b7136d5, R21
+	MOVD $0x66282560523f128c, R22
+	MOVD $0xd0c282cae28ebc3b, R23
+	MOVD $0x5080b3d6c8e20464, R24
+	MOVD $0x0a796ba481da20f4, R25
+	MOVD $0x007dd6082cd09a32, R26
+	CBZ  R3, done
+
+loop:
+	LDP 0(R1), (R14, R15)
+	LDP 16(R1), (R16, R17)
+	LDP 32(R1), (R19, R20)
+
+	// t += x·y[0], followed by a reduction round
+	MOVD  0(R2), R12
+	MUL   R14, R12, R4
+	MUL   R15, R12, R5
+	MUL   R16, R12, R6
+	MUL   R17, R12, R7
+	MUL   R19, R12, R8
+	MUL   R20, R12, R9
+	UMULH R20, R12, R10
+	UMULH R14, R12, R13
+	ADDS  R13, R5, R5
+	UMULH R15, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R16, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R17, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R19, R12, R13
+	ADCS  R13, R9, R9
+	ADC   ZR, R10, R10
+	MUL   R4, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R4, R4
+	MUL   R22, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R23, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R24, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R25, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R26, R12, R13
+	ADCS  R13, R9, R9
+	ADC   ZR, R10, R10
+	UMULH R21, R12, R13
+	ADDS  R13, R5, R5
+	UMULH R22, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R23, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R24, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R25, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R26, R12, R13
+	ADC   R13, R10, R10
+
+	// t += x·y[1], followed by a reduction round
+	MOVD  8(R2), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R5, R5
+	MUL   R15, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R16, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R17, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R19, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R20, R12, R13
+	ADCS  R13, R10, R10
+	ADC   ZR, R4, R4
+	UMULH R14, R12, R13
+	ADDS  R13, R6, R6
+	UMULH R15, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R16, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R17, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R19, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R20, R12, R13
+	ADC   R13, R4, R4
+	MUL   R5, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R5, R5
+	MUL   R22, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R23, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R24, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R25, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R26, R12, R13
+	ADCS  R13, R10, R10
+	ADC   ZR, R4, R4
+	UMULH R21, R12, R13
+	ADDS  R13, R6, R6
+	UMULH R22, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R23, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R24, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R25, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R26, R12, R13
+	ADC   R13, R4, R4
+
+	// t += x·y[2], followed by a reduction round
+	MOVD  16(R2), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R6, R6
+	MUL   R15, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R16, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R17, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R19, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R20, R12, R13
+	ADCS  R13, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R14, R12, R13
+	ADDS  R13, R7, R7
+	UMULH R15, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R16, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R17, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R19, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R20, R12, R13
+	ADC   R13, R5, R5
+	MUL   R6, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R6, R6
+	MUL   R22, R12, R13
+	ADCS  R13, R7, R7
+	MUL   R23, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R24, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R25, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R26, R12, R13
+	ADCS  R13, R4, R4
+	ADC   ZR, R5, R5
+	UMULH R21, R12, R13
+	ADDS  R13, R7, R7
+	UMULH R22, R12, R13
+	ADCS  R13, R8, R8
+	UMULH R23, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R24, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R25, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R26, R12, R13
+	ADC   R13, R5, R5
+
+	// t += x·y[3], followed by a reduction round
+	MOVD  24(R2), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R7, R7
+	MUL   R15, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R16, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R17, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R19, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R20, R12, R13
+	ADCS  R13, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R14, R12, R13
+	ADDS  R13, R8, R8
+	UMULH R15, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R16, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R17, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R19, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R20, R12, R13
+	ADC   R13, R6, R6
+	MUL   R7, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R7, R7
+	MUL   R22, R12, R13
+	ADCS  R13, R8, R8
+	MUL   R23, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R24, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R25, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R26, R12, R13
+	ADCS  R13, R5, R5
+	ADC   ZR, R6, R6
+	UMULH R21, R12, R13
+	ADDS  R13, R8, R8
+	UMULH R22, R12, R13
+	ADCS  R13, R9, R9
+	UMULH R23, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R24, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R25, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R26, R12, R13
+	ADC   R13, R6, R6
+
+	// t += x·y[4], followed by a reduction round
+	MOVD  32(R2), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R8, R8
+	MUL   R15, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R16, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R17, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R19, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R20, R12, R13
+	ADCS  R13, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R14, R12, R13
+	ADDS  R13, R9, R9
+	UMULH R15, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R16, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R17, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R19, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R20, R12, R13
+	ADC   R13, R7, R7
+	MUL   R8, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R8, R8
+	MUL   R22, R12, R13
+	ADCS  R13, R9, R9
+	MUL   R23, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R24, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R25, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R26, R12, R13
+	ADCS  R13, R6, R6
+	ADC   ZR, R7, R7
+	UMULH R21, R12, R13
+	ADDS  R13, R9, R9
+	UMULH R22, R12, R13
+	ADCS  R13, R10, R10
+	UMULH R23, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R24, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R25, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R26, R12, R13
+	ADC   R13, R7, R7
+
+	// t += x·y[5], followed by a reduction round
+	MOVD  40(R2), R12
+	MUL   R14, R12, R13
+	ADDS  R13, R9, R9
+	MUL   R15, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R16, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R17, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R19, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R20, R12, R13
+	ADCS  R13, R7, R7
+	ADC   ZR, R8, R8
+	UMULH R14, R12, R13
+	ADDS  R13, R10, R10
+	UMULH R15, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R16, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R17, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R19, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R20, R12, R13
+	ADC   R13, R8, R8
+	MUL   R9, R11, R12
+	MUL   R21, R12, R13
+	ADDS  R13, R9, R9
+	MUL   R22, R12, R13
+	ADCS  R13, R10, R10
+	MUL   R23, R12, R13
+	ADCS  R13, R4, R4
+	MUL   R24, R12, R13
+	ADCS  R13, R5, R5
+	MUL   R25, R12, R13
+	ADCS  R13, R6, R6
+	MUL   R26, R12, R13
+	ADCS  R13, R7, R7
+	ADC   ZR, R8, R8
+	UMULH R21, R12, R13
+	ADDS  R13, R10, R10
+	UMULH R22, R12, R13
+	ADCS  R13, R4, R4
+	UMULH R23, R12, R13
+	ADCS  R13, R5, R5
+	UMULH R24, R12, R13
+	ADCS  R13, R6, R6
+	UMULH R25, R12, R13
+	ADCS  R13, R7, R7
+	UMULH R26, R12, R13
+	ADC   R13, R8, R8
+
+	// t = t - q if t >= q
+	SUBS  R21, R10, R13
+	SBCS  R22, R4, R13
+	SBCS  R23, R5, R13
+	SBCS  R24, R6, R13
+	SBCS  R25, R7, R13
+	SBCS  R26, R8, R13
+	CSETM CS, R12
+	AND   R12, R21, R13
+	SUBS  R13, R10, R10
+	AND   R12, R22, R13
+	SBCS  R13, R4, R4
+	AND   R12, R23, R13
+	SBCS  R13, R5, R5
+	AND   R12, R24, R13
+	SBCS  R13, R6, R6
+	AND   R12, R25, R13
+	SBCS  R13, R7, R7
+	AND   R12, R26, R13
+	SBCS  R13, R8, R8
+	STP   (R10, R4), 0(R0)
+	STP   (R5, R6), 16(R0)
+	STP   (R7, R8), 32(R0)
+	ADD   $48, R0, R0
+	ADD   $48, R1, R1
+	ADD   $48, R2, R2
+	SUB   $1, R3, R3
+	CBNZ  R3, loop
+
+done:
+	RET
@@ -0,0 +1,36 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ipa provides hiding Pedersen vector commitments and a
+// Bulletproofs-style inner-product argument.
+//
+// A commitment to a vector v with blinding r is
+//
+//	C = ⟨v, G⟩ + r·Q
+//
+// where the bases G and Q are derived by hashing to the curve, so that the
+// setup is transparent. Commitments are additively homomorphic: the sum of two
+// commitments commits to the sum of the vectors under the sum of the
+// blindings, and scaling a commitment scales both.
+//
+// The inner-product argument proves, with log₂(n) rounds, a statement about
+// the inner product of committed vectors of size n. Opening proofs are made
+// non-interactive with the Fiat-Shamir heuristic.
+//
+// See https://eprint.iacr.org/2017/1066.pdf (Bulletproofs) and
+// https://eprint.iacr.org/2019/1021.pdf (Halo) for a description of the
+// protocols.
+package ipa
@@ -0,0 +1,234 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ipa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// commitment key re-used across tests
+var testCk CommitmentKey
+
+func init() {
+	var err error
+	testCk, err = NewCommitmentKey(8, []byte("ipa test"))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomVector(t *testing.T, size int) []big.Int {
+	res := make([]big.Int, size)
+	for i := range res {
+		s, err := RandomScalar()
+		require.NoError(t, err)
+		res[i].Set(s)
+	}
+	return res
+}
+
+func TestCommitmentKey(t *testing.T) {
+	assert := require.New(t)
+
+	for i := range testCk.G {
+		assert.True(testCk.G[i].IsOnCurve())
+		assert.True(testCk.H[i].IsOnCurve())
+	}
+	ck, err := NewCommitmentKey(2, []byte("ipa test"))
+	assert.NoError(err)
+	assert.True(ck.G[1].Equal(&testCk.G[1]))
+	assert.True(ck.Q.Equal(&testCk.Q))
+
+	// points are in the prime subgroup
+	var p Digest
+	p.ScalarMultiplication(&testCk.Q, order())
+	assert.True(p.IsZero())
+}
+
+func TestCommitHomomorphism(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 5
+	v1, v2 := randomVector(t, size), randomVector(t, size)
+	r := randomVector(t, 3)
+
+	c1, err := testCk.Commit(v1, &r[0])
+	assert.NoError(err)
+	c2, err := testCk.Commit(v2, &r[1])
+	assert.NoError(err)
+	assert.NoError(testCk.VerifyCommitment(&c1, v1, &r[0]))
+	assert.Error(testCk.VerifyCommitment(&c1, v1, &r[1]))
+
+	sum := make([]big.Int, size)
+	scaled := make([]big.Int, size)
+	for i := range sum {
+		sum[i].Add(&v1[i], &v2[i])
+		scaled[i].Mul(&v1[i], &r[2])
+	}
+	var rSum, rScaled big.Int
+	rSum.Add(&r[0], &r[1])
+	rScaled.Mul(&r[0], &r[2])
+
+	cSum := Add(&c1, &c2)
+	assert.NoError(testCk.VerifyCommitment(&cSum, sum, &rSum))
+	cScaled := Scale(&c1, &r[2])
+	assert.NoError(testCk.VerifyCommitment(&cScaled, scaled, &rScaled))
+}
+
+func TestInnerProductProof(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 8
+	a, b := randomVector(t, size), randomVector(t, size)
+
+	// P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩·U
+	P := msm(testCk.G, a)
+	t1 := msm(testCk.H, b)
+	P.Add(&P, &t1)
+	t1.ScalarMultiplication(&testCk.U, innerProduct(a, b, order()))
+	P.Add(&P, &t1)
+
+	statement := P.Marshal()
+	proof, err := ProveInnerProduct(testCk.G, testCk.H, &testCk.U, a, b, sha256.New(), statement)
+	assert.NoError(err)
+	assert.NoError(VerifyInnerProduct(testCk.G, testCk.H, &testCk.U, &P, &proof, sha256.New(), statement))
+
+	proof.B.Add(&proof.B, big.NewInt(1))
+	assert.ErrorIs(VerifyInnerProduct(testCk.G, testCk.H, &testCk.U, &P, &proof, sha256.New(), statement), ErrVerifyInnerProduct)
+}
+
+func TestOpeningProof(t *testing.T) {
+	for _, size := range []int{1, 8} {
+		assert := require.New(t)
+
+		a, b := randomVector(t, size), randomVector(t, size)
+		r, err := RandomScalar()
+		assert.NoError(err)
+		digest, err := testCk.Commit(a, r)
+		assert.NoError(err)
+
+		proof, v, err := Open(&testCk, &digest, a, r, b, sha256.New())
+		assert.NoError(err)
+		assert.Equal(0, v.Cmp(innerProduct(a, b, order())))
+		assert.NoError(Verify(&testCk, &digest, b, v, &proof, sha256.New()))
+
+		wrong := new(big.Int).Add(v, big.NewInt(1))
+		assert.Error(Verify(&testCk, &digest, b, wrong, &proof, sha256.New()))
+
+		proof.Z2.Add(&proof.Z2, big.NewInt(1))
+		assert.ErrorIs(Verify(&testCk, &digest, b, v, &proof, sha256.New()), ErrVerifyOpeningProof)
+	}
+}
+
+func TestInvalidPoints(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 4
+	a, b := randomVector(t, size), randomVector(t, size)
+	r, err := RandomScalar()
+	assert.NoError(err)
+	digest, err := testCk.Commit(a, r)
+	assert.NoError(err)
+	proof, v, err := Open(&testCk, &digest, a, r, b, sha256.New())
+	assert.NoError(err)
+
+	// T = (0, -1) has order 2: the verification equation multiplies the
+	// commitment by a challenge, which cancels the torsion component half of
+	// the time
+	var T Digest
+	T.Y.SetOne().Neg(&T.Y)
+	assert.True(T.IsOnCurve())
+	shifted := Add(&digest, &T)
+	assert.ErrorIs(Verify(&testCk, &shifted, b, v, &proof, sha256.New()), ErrInvalidPoint)
+
+	shiftedProof := proof
+	shiftedProof.L = append([]Digest{}, proof.L...)
+	shiftedProof.L[0] = Add(&proof.L[0], &T)
+	assert.ErrorIs(Verify(&testCk, &digest, b, v, &shiftedProof, sha256.New()), ErrInvalidPoint)
+
+	shiftedProof = proof
+	shiftedProof.T = Add(&proof.T, &T)
+	assert.ErrorIs(Verify(&testCk, &digest, b, v, &shiftedProof, sha256.New()), ErrInvalidPoint)
+
+	// inner-product argument
+	P := msm(testCk.G[:size], a)
+	t1 := msm(testCk.H[:size], b)
+	P.Add(&P, &t1)
+	t1.ScalarMultiplication(&testCk.U, innerProduct(a, b, order()))
+	P.Add(&P, &t1)
+	ipProof, err := ProveInnerProduct(testCk.G[:size], testCk.H[:size], &testCk.U, a, b, sha256.New())
+	assert.NoError(err)
+	assert.NoError(VerifyInnerProduct(testCk.G[:size], testCk.H[:size], &testCk.U, &P, &ipProof, sha256.New()))
+
+	shifted = Add(&P, &T)
+	assert.ErrorIs(VerifyInnerProduct(testCk.G[:size], testCk.H[:size], &testCk.U, &shifted, &ipProof, sha256.New()), ErrInvalidPoint)
+	ipProof.R[1] = Add(&ipProof.R[1], &T)
+	assert.ErrorIs(VerifyInnerProduct(testCk.G[:size], testCk.H[:size], &testCk.U, &P, &ipProof, sha256.New()), ErrInvalidPoint)
+}
+
+func TestProofSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 8
+	a, b := randomVector(t, size), randomVector(t, size)
+	r, err := RandomScalar()
+	assert.NoError(err)
+	digest, err := testCk.Commit(a, r)
+	assert.NoError(err)
+	proof, v, err := Open(&testCk, &digest, a, r, b, sha256.New())
+	assert.NoError(err)
+	ipProof, err := ProveInnerProduct(testCk.G, testCk.H, &testCk.U, a, b, sha256.New())
+	assert.NoError(err)
+
+	t.Run("opening proof", testutils.SerializationRoundTrip(&proof))
+	t.Run("inner-product proof", testutils.SerializationRoundTrip(&ipProof))
+
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var decoded OpeningProof
+	_, err = decoded.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.NoError(Verify(&testCk, &digest, b, v, &decoded, sha256.New()))
+
+	// non-canonical scalar
+	enc := bytes.Clone(buf.Bytes())
+	for i := len(enc) - sizeScalar; i < len(enc); i++ {
+		enc[i] = 0xff
+	}
+	_, err = decoded.ReadFrom(bytes.NewReader(enc))
+	assert.ErrorIs(err, errNonCanonicalScalar)
+
+	// point with a torsion component
+	var T Digest
+	T.Y.SetOne().Neg(&T.Y)
+	shifted := Add(&proof.L[0], &T)
+	enc = bytes.Clone(buf.Bytes())
+	copy(enc[4:], shifted.Marshal())
+	_, err = decoded.ReadFrom(bytes.NewReader(enc))
+	assert.ErrorIs(err, ErrInvalidPoint)
+
+	// truncated proof
+	_, err = decoded.ReadFrom(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	assert.Error(err)
+}
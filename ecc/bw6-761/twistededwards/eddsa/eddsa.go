@@ -17,6 +17,7 @@
 package eddsa
 
 import (
+	"crypto"
 	"crypto/subtle"
 	"errors"
 	"hash"
@@ -67,7 +68,7 @@ func GenerateKey(r io.Reader) (*PrivateKey, error) {
 	var priv PrivateKey
 	// The source of randomness and the secret scalar must come
 	// from 2 distinct sources. Since the scalar is the size of the
-	// field of definition (48 bytes), the scalar must come from a
+	// field of definition (more than 32 bytes), the scalar must come from a
 	// different digest so there is no overlap between the source of
 	// randomness and the scalar.
 
@@ -99,7 +100,7 @@ func GenerateKey(r io.Reader) (*PrivateKey, error) {
 
 	var bScalar big.Int
 	bScalar.SetBytes(priv.scalar[:])
-	pub.A.ScalarMultiplication(&c.Base, &bScalar)
+	pub.A.ScalarMultiplicationCT(&c.Base, &bScalar)
 
 	priv.PublicKey = pub
 
@@ -108,6 +109,12 @@ func GenerateKey(r io.Reader) (*PrivateKey, error) {
 
 // Equal compares 2 public keys
 func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	return pub.EqualPublicKey(x)
+}
+
+// EqualPublicKey reports whether x is the same public key, as the Equal
+// method of the public keys of the standard library.
+func (pub *PublicKey) EqualPublicKey(x crypto.PublicKey) bool {
 	xx, ok := x.(*PublicKey)
 	if !ok {
 		return false
@@ -154,7 +161,7 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 	blindingFactorBigInt.SetBytes(blindingFactorBytes[:sizeFr])
 
 	// compute R = randScalar*Base
-	res.R.ScalarMultiplication(&curveParams.Base, &blindingFactorBigInt)
+	res.R.ScalarMultiplicationCT(&curveParams.Base, &blindingFactorBigInt)
 	if !res.R.IsOnCurve() {
 		return nil, errNotOnCurve
 	}
@@ -0,0 +1,1243 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb12702820690c92fb2a52)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10011    = _10 + _10001
+	//	_10111    = _100 + _10011
+	//	_11001    = _10 + _10111
+	//	_11011    = _10 + _11001
+	//	_11101    = _10 + _11011
+	//	_11111    = _10 + _11101
+	//	_110100   = _10111 + _11101
+	//	_11010000 = _110100 << 2
+	//	_11010111 = _111 + _11010000
+	//	i38       = 2*((_11010111 << 8 + _11101) << 7 + _10001)
+	//	i52       = ((1 + i38) << 9 + _10111) << 2 + _11
+	//	i73       = ((i52 << 6 + _101) << 4 + 1) << 9
+	//	i86       = ((_11101 + i73) << 5 + _1011) << 5 + _11
+	//	i107      = (2*(i86 << 8 + _11101) + 1) << 10
+	//	i127      = ((_10111 + i107) << 12 + _11011) << 5 + _101
+	//	i149      = ((i127 << 7 + _101) << 6 + _1001) << 7
+	//	i160      = ((_11101 + i149) << 5 + _10001) << 3 + _101
+	//	i183      = ((i160 << 8 + _10001) << 6 + _11011) << 7
+	//	i202      = ((_11111 + i183) << 4 + _11) << 12 + _1111
+	//	i221      = ((i202 << 4 + _101) << 8 + _10011) << 5
+	//	i236      = ((_10001 + i221) << 3 + _111) << 9 + _11101
+	//	i253      = ((i236 << 6 + _11101) << 6 + _11111) << 3
+	//	i268      = ((_111 + i253) << 7 + _11111) << 5 + _1011
+	//	i284      = ((i268 << 6 + _11101) << 4 + _1101) << 4
+	//	i301      = ((_111 + i284) << 5 + 1) << 9 + _10001
+	//	i322      = ((i301 << 3 + _101) << 12 + _11001) << 4
+	//	i339      = ((_1011 + i322) << 6 + _10001) << 8 + _1111
+	//	i357      = ((i339 << 6 + _10111) << 3 + _11) << 7
+	//	i374      = ((_1001 + i357) << 5 + _111) << 9 + _101
+	//	i395      = ((i374 << 6 + 1) << 10 + _1101) << 3
+	//	i413      = ((1 + i395) << 9 + _11001) << 6 + _1001
+	//	i431      = ((i413 << 6 + _11111) << 6 + _11001) << 4
+	//	i442      = ((_101 + i431) << 5 + _101) << 3 + 1
+	//	return      2*i442
+	//
+	// Operations: 366 squares 77 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t1 = x^0x2
+	t1.Square(&x)
+
+	// Step 2: t5 = x^0x3
+	t5.Mul(&x, t1)
+
+	// Step 3: t0 = x^0x4
+	t0.Mul(&x, t5)
+
+	// Step 4: z = x^0x5
+	z.Mul(&x, t0)
+
+	// Step 5: t4 = x^0x7
+	t4.Mul(t1, z)
+
+	// Step 6: t2 = x^0x9
+	t2.Mul(t1, t4)
+
+	// Step 7: t9 = x^0xb
+	t9.Mul(t1, t2)
+
+	// Step 8: t3 = x^0xd
+	t3.Mul(t1, t9)
+
+	// Step 9: t7 = x^0xf
+	t7.Mul(t1, t3)
+
+	// Step 10: t8 = x^0x11
+	t8.Mul(t1, t7)
+
+	// Step 11: t11 = x^0x13
+	t11.Mul(t1, t8)
+
+	// Step 12: t6 = x^0x17
+	t6.Mul(t0, t11)
+
+	// Step 13: t0 = x^0x19
+	t0.Mul(t1, t6)
+
+	// Step 14: t12 = x^0x1b
+	t12.Mul(t1, t0)
+
+	// Step 15: t10 = x^0x1d
+	t10.Mul(t1, t12)
+
+	// Step 16: t1 = x^0x1f
+	t1.Mul(t1, t10)
+
+	// Step 17: t13 = x^0x34
+	t13.Mul(t6, t10)
+
+	// Step 19: t13 = x^0xd0
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 20: t13 = x^0xd7
+	t13.Mul(t4, t13)
+
+	// Step 28: t13 = x^0xd700
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 29: t13 = x^0xd71d
+	t13.Mul(t10, t13)
+
+	// Step 36: t13 = x^0x6b8e80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 37: t13 = x^0x6b8e91
+	t13.Mul(t8, t13)
+
+	// Step 38: t13 = x^0xd71d22
+	t13.Square(t13)
+
+	// Step 39: t13 = x^0xd71d23
+	t13.Mul(&x, t13)
+
+	// Step 48: t13 = x^0x1ae3a4600
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 49: t13 = x^0x1ae3a4617
+	t13.Mul(t6, t13)
+
+	// Step 51: t13 = x^0x6b8e9185c
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 52: t13 = x^0x6b8e9185f
+	t13.Mul(t5, t13)
+
+	// Step 58: t13 = x^0x1ae3a4617c0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 59: t13 = x^0x1ae3a4617c5
+	t13.Mul(z, t13)
+
+	// Step 63: t13 = x^0x1ae3a4617c50
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 64: t13 = x^0x1ae3a4617c51
+	t13.Mul(&x, t13)
+
+	// Step 73: t13 = x^0x35c748c2f8a200
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 74: t13 = x^0x35c748c2f8a21d
+	t13.Mul(t10, t13)
+
+	// Step 79: t13 = x^0x6b8e9185f1443a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 80: t13 = x^0x6b8e9185f1443ab
+	t13.Mul(t9, t13)
+
+	// Step 85: t13 = x^0xd71d230be2887560
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 86: t13 = x^0xd71d230be2887563
+	t13.Mul(t5, t13)
+
+	// Step 94: t13 = x^0xd71d230be288756300
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 95: t13 = x^0xd71d230be28875631d
+	t13.Mul(t10, t13)
+
+	// Step 96: t13 = x^0x1ae3a4617c510eac63a
+	t13.Square(t13)
+
+	// Step 97: t13 = x^0x1ae3a4617c510eac63b
+	t13.Mul(&x, t13)
+
+	// Step 107: t13 = x^0x6b8e9185f1443ab18ec00
+	for s := 0; s < 10; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 108: t13 = x^0x6b8e9185f1443ab18ec17
+	t13.Mul(t6, t13)
+
+	// Step 120: t13 = x^0x6b8e9185f1443ab18ec17000
+	for s := 0; s < 12; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 121: t13 = x^0x6b8e9185f1443ab18ec1701b
+	t13.Mul(t12, t13)
+
+	// Step 126: t13 = x^0xd71d230be28875631d82e0360
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 127: t13 = x^0xd71d230be28875631d82e0365
+	t13.Mul(z, t13)
+
+	// Step 134: t13 = x^0x6b8e9185f1443ab18ec1701b280
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 135: t13 = x^0x6b8e9185f1443ab18ec1701b285
+	t13.Mul(z, t13)
+
+	// Step 141: t13 = x^0x1ae3a4617c510eac63b05c06ca140
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 142: t13 = x^0x1ae3a4617c510eac63b05c06ca149
+	t13.Mul(t2, t13)
+
+	// Step 149: t13 = x^0xd71d230be28875631d82e03650a480
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 150: t13 = x^0xd71d230be28875631d82e03650a49d
+	t13.Mul(t10, t13)
+
+	// Step 155: t13 = x^0x1ae3a4617c510eac63b05c06ca1493a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 156: t13 = x^0x1ae3a4617c510eac63b05c06ca1493b1
+	t13.Mul(t8, t13)
+
+	// Step 159: t13 = x^0xd71d230be28875631d82e03650a49d88
+	for s := 0; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 160: t13 = x^0xd71d230be28875631d82e03650a49d8d
+	t13.Mul(z, t13)
+
+	// Step 168: t13 = x^0xd71d230be28875631d82e03650a49d8d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 169: t13 = x^0xd71d230be28875631d82e03650a49d8d11
+	t13.Mul(t8, t13)
+
+	// Step 175: t13 = x^0x35c748c2f8a21d58c760b80d942927634440
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 176: t12 = x^0x35c748c2f8a21d58c760b80d94292763445b
+	t12.Mul(t12, t13)
+
+	// Step 183: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 184: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f
+	t12.Mul(t1, t12)
+
+	// Step 188: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f0
+	for s := 0; s < 4; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 189: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f3
+	t12.Mul(t5, t12)
+
+	// Step 201: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f3000
+	for s := 0; s < 12; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 202: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f
+	t12.Mul(t7, t12)
+
+	// Step 206: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f0
+	for s := 0; s < 4; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 207: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5
+	t12.Mul(z, t12)
+
+	// Step 215: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f500
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 216: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f513
+	t11.Mul(t11, t12)
+
+	// Step 221: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea260
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 222: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271
+	t11.Mul(t8, t11)
+
+	// Step 225: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f51388
+	for s := 0; s < 3; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 226: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f
+	t11.Mul(t4, t11)
+
+	// Step 235: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e00
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 236: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d
+	t11.Mul(t10, t11)
+
+	// Step 242: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c78740
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 243: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d
+	t11.Mul(t10, t11)
+
+	// Step 249: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d740
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 250: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75f
+	t11.Mul(t1, t11)
+
+	// Step 253: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaf8
+	for s := 0; s < 3; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 254: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff
+	t11.Mul(t4, t11)
+
+	// Step 261: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 262: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f
+	t11.Mul(t1, t11)
+
+	// Step 267: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3e0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 268: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb
+	t11.Mul(t9, t11)
+
+	// Step 274: t11 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfac0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 275: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfadd
+	t10.Mul(t10, t11)
+
+	// Step 279: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfadd0
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 280: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd
+	t10.Mul(t3, t10)
+
+	// Step 284: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd0
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 285: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7
+	t10.Mul(t4, t10)
+
+	// Step 290: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae0
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 291: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae1
+	t10.Mul(&x, t10)
+
+	// Step 300: t10 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb775c200
+	for s := 0; s < 9; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 301: t10 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb775c211
+	t10.Mul(t8, t10)
+
+	// Step 304: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae1088
+	for s := 0; s < 3; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 305: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d
+	t10.Mul(z, t10)
+
+	// Step 317: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d000
+	for s := 0; s < 12; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 318: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019
+	t10.Mul(t0, t10)
+
+	// Step 322: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d0190
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 323: t9 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b
+	t9.Mul(t9, t10)
+
+	// Step 329: t9 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 330: t8 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d1
+	t8.Mul(t8, t9)
+
+	// Step 338: t8 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d100
+	for s := 0; s < 8; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 339: t7 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f
+	t7.Mul(t7, t8)
+
+	// Step 345: t7 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 346: t6 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7
+	t6.Mul(t6, t7)
+
+	// Step 349: t6 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21eb8
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 350: t5 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb
+	t5.Mul(t5, t6)
+
+	// Step 357: t5 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d80
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 358: t5 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d89
+	t5.Mul(t2, t5)
+
+	// Step 363: t5 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb120
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 364: t4 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb127
+	t4.Mul(t4, t5)
+
+	// Step 373: t4 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e00
+	for s := 0; s < 9; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 374: t4 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05
+	t4.Mul(z, t4)
+
+	// Step 380: t4 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d8938140
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 381: t4 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d8938141
+	t4.Mul(&x, t4)
+
+	// Step 391: t4 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e050400
+	for s := 0; s < 10; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 392: t3 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d
+	t3.Mul(t3, t4)
+
+	// Step 395: t3 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb1270282068
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 396: t3 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb1270282069
+	t3.Mul(&x, t3)
+
+	// Step 405: t3 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d200
+	for s := 0; s < 9; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 406: t3 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d219
+	t3.Mul(t0, t3)
+
+	// Step 412: t3 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d89381410348640
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 413: t2 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d89381410348649
+	t2.Mul(t2, t3)
+
+	// Step 419: t2 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d219240
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 420: t1 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d21925f
+	t1.Mul(t1, t2)
+
+	// Step 426: t1 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497c0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 427: t0 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d9
+	t0.Mul(t0, t1)
+
+	// Step 431: t0 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d90
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 432: t0 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d95
+	t0.Mul(z, t0)
+
+	// Step 437: t0 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb12702820690c92fb2a0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 438: z = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb12702820690c92fb2a5
+	z.Mul(z, t0)
+
+	// Step 441: z = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d9528
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+
+	// Step 442: z = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d9529
+	z.Mul(&x, z)
+
+	// Step 443: z = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb12702820690c92fb2a52
+	z.Square(z)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb775c211a0336887aec49c0a081a4324beca94a)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10011    = _10 + _10001
+	//	_10111    = _100 + _10011
+	//	_11001    = _10 + _10111
+	//	_11011    = _10 + _11001
+	//	_11101    = _10 + _11011
+	//	_11111    = _10 + _11101
+	//	_110100   = _10111 + _11101
+	//	_11010000 = _110100 << 2
+	//	_11010111 = _111 + _11010000
+	//	i38       = 2*((_11010111 << 8 + _11101) << 7 + _10001)
+	//	i52       = ((1 + i38) << 9 + _10111) << 2 + _11
+	//	i73       = ((i52 << 6 + _101) << 4 + 1) << 9
+	//	i86       = ((_11101 + i73) << 5 + _1011) << 5 + _11
+	//	i107      = (2*(i86 << 8 + _11101) + 1) << 10
+	//	i127      = ((_10111 + i107) << 12 + _11011) << 5 + _101
+	//	i149      = ((i127 << 7 + _101) << 6 + _1001) << 7
+	//	i160      = ((_11101 + i149) << 5 + _10001) << 3 + _101
+	//	i183      = ((i160 << 8 + _10001) << 6 + _11011) << 7
+	//	i202      = ((_11111 + i183) << 4 + _11) << 12 + _1111
+	//	i221      = ((i202 << 4 + _101) << 8 + _10011) << 5
+	//	i236      = ((_10001 + i221) << 3 + _111) << 9 + _11101
+	//	i253      = ((i236 << 6 + _11101) << 6 + _11111) << 3
+	//	i268      = ((_111 + i253) << 7 + _11111) << 5 + _1011
+	//	i284      = ((i268 << 6 + _11101) << 4 + _1101) << 4
+	//	i301      = ((_111 + i284) << 5 + 1) << 9 + _10001
+	//	i322      = ((i301 << 3 + _101) << 12 + _11001) << 4
+	//	i339      = ((_1011 + i322) << 6 + _10001) << 8 + _1111
+	//	i357      = ((i339 << 6 + _10111) << 3 + _11) << 7
+	//	i374      = ((_1001 + i357) << 5 + _111) << 9 + _101
+	//	i395      = ((i374 << 6 + 1) << 10 + _1101) << 3
+	//	i413      = ((1 + i395) << 9 + _11001) << 6 + _1001
+	//	i431      = ((i413 << 6 + _11111) << 6 + _11001) << 4
+	//	i444      = ((_101 + i431) << 5 + _101) << 5 + _101
+	//	return      2*i444
+	//
+	// Operations: 368 squares 77 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t1 = x^0x2
+	t1.Square(&x)
+
+	// Step 2: t5 = x^0x3
+	t5.Mul(&x, t1)
+
+	// Step 3: t0 = x^0x4
+	t0.Mul(&x, t5)
+
+	// Step 4: z = x^0x5
+	z.Mul(&x, t0)
+
+	// Step 5: t4 = x^0x7
+	t4.Mul(t1, z)
+
+	// Step 6: t2 = x^0x9
+	t2.Mul(t1, t4)
+
+	// Step 7: t9 = x^0xb
+	t9.Mul(t1, t2)
+
+	// Step 8: t3 = x^0xd
+	t3.Mul(t1, t9)
+
+	// Step 9: t7 = x^0xf
+	t7.Mul(t1, t3)
+
+	// Step 10: t8 = x^0x11
+	t8.Mul(t1, t7)
+
+	// Step 11: t11 = x^0x13
+	t11.Mul(t1, t8)
+
+	// Step 12: t6 = x^0x17
+	t6.Mul(t0, t11)
+
+	// Step 13: t0 = x^0x19
+	t0.Mul(t1, t6)
+
+	// Step 14: t12 = x^0x1b
+	t12.Mul(t1, t0)
+
+	// Step 15: t10 = x^0x1d
+	t10.Mul(t1, t12)
+
+	// Step 16: t1 = x^0x1f
+	t1.Mul(t1, t10)
+
+	// Step 17: t13 = x^0x34
+	t13.Mul(t6, t10)
+
+	// Step 19: t13 = x^0xd0
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 20: t13 = x^0xd7
+	t13.Mul(t4, t13)
+
+	// Step 28: t13 = x^0xd700
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 29: t13 = x^0xd71d
+	t13.Mul(t10, t13)
+
+	// Step 36: t13 = x^0x6b8e80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 37: t13 = x^0x6b8e91
+	t13.Mul(t8, t13)
+
+	// Step 38: t13 = x^0xd71d22
+	t13.Square(t13)
+
+	// Step 39: t13 = x^0xd71d23
+	t13.Mul(&x, t13)
+
+	// Step 48: t13 = x^0x1ae3a4600
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 49: t13 = x^0x1ae3a4617
+	t13.Mul(t6, t13)
+
+	// Step 51: t13 = x^0x6b8e9185c
+	for s := 0; s < 2; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 52: t13 = x^0x6b8e9185f
+	t13.Mul(t5, t13)
+
+	// Step 58: t13 = x^0x1ae3a4617c0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 59: t13 = x^0x1ae3a4617c5
+	t13.Mul(z, t13)
+
+	// Step 63: t13 = x^0x1ae3a4617c50
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 64: t13 = x^0x1ae3a4617c51
+	t13.Mul(&x, t13)
+
+	// Step 73: t13 = x^0x35c748c2f8a200
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 74: t13 = x^0x35c748c2f8a21d
+	t13.Mul(t10, t13)
+
+	// Step 79: t13 = x^0x6b8e9185f1443a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 80: t13 = x^0x6b8e9185f1443ab
+	t13.Mul(t9, t13)
+
+	// Step 85: t13 = x^0xd71d230be2887560
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 86: t13 = x^0xd71d230be2887563
+	t13.Mul(t5, t13)
+
+	// Step 94: t13 = x^0xd71d230be288756300
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 95: t13 = x^0xd71d230be28875631d
+	t13.Mul(t10, t13)
+
+	// Step 96: t13 = x^0x1ae3a4617c510eac63a
+	t13.Square(t13)
+
+	// Step 97: t13 = x^0x1ae3a4617c510eac63b
+	t13.Mul(&x, t13)
+
+	// Step 107: t13 = x^0x6b8e9185f1443ab18ec00
+	for s := 0; s < 10; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 108: t13 = x^0x6b8e9185f1443ab18ec17
+	t13.Mul(t6, t13)
+
+	// Step 120: t13 = x^0x6b8e9185f1443ab18ec17000
+	for s := 0; s < 12; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 121: t13 = x^0x6b8e9185f1443ab18ec1701b
+	t13.Mul(t12, t13)
+
+	// Step 126: t13 = x^0xd71d230be28875631d82e0360
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 127: t13 = x^0xd71d230be28875631d82e0365
+	t13.Mul(z, t13)
+
+	// Step 134: t13 = x^0x6b8e9185f1443ab18ec1701b280
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 135: t13 = x^0x6b8e9185f1443ab18ec1701b285
+	t13.Mul(z, t13)
+
+	// Step 141: t13 = x^0x1ae3a4617c510eac63b05c06ca140
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 142: t13 = x^0x1ae3a4617c510eac63b05c06ca149
+	t13.Mul(t2, t13)
+
+	// Step 149: t13 = x^0xd71d230be28875631d82e03650a480
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 150: t13 = x^0xd71d230be28875631d82e03650a49d
+	t13.Mul(t10, t13)
+
+	// Step 155: t13 = x^0x1ae3a4617c510eac63b05c06ca1493a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 156: t13 = x^0x1ae3a4617c510eac63b05c06ca1493b1
+	t13.Mul(t8, t13)
+
+	// Step 159: t13 = x^0xd71d230be28875631d82e03650a49d88
+	for s := 0; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 160: t13 = x^0xd71d230be28875631d82e03650a49d8d
+	t13.Mul(z, t13)
+
+	// Step 168: t13 = x^0xd71d230be28875631d82e03650a49d8d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 169: t13 = x^0xd71d230be28875631d82e03650a49d8d11
+	t13.Mul(t8, t13)
+
+	// Step 175: t13 = x^0x35c748c2f8a21d58c760b80d942927634440
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 176: t12 = x^0x35c748c2f8a21d58c760b80d94292763445b
+	t12.Mul(t12, t13)
+
+	// Step 183: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 184: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f
+	t12.Mul(t1, t12)
+
+	// Step 188: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f0
+	for s := 0; s < 4; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 189: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f3
+	t12.Mul(t5, t12)
+
+	// Step 201: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f3000
+	for s := 0; s < 12; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 202: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f
+	t12.Mul(t7, t12)
+
+	// Step 206: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f0
+	for s := 0; s < 4; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 207: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5
+	t12.Mul(z, t12)
+
+	// Step 215: t12 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f500
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 216: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f513
+	t11.Mul(t11, t12)
+
+	// Step 221: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea260
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 222: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271
+	t11.Mul(t8, t11)
+
+	// Step 225: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f51388
+	for s := 0; s < 3; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 226: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f
+	t11.Mul(t4, t11)
+
+	// Step 235: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e00
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 236: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d
+	t11.Mul(t10, t11)
+
+	// Step 242: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c78740
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 243: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d
+	t11.Mul(t10, t11)
+
+	// Step 249: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d740
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 250: t11 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75f
+	t11.Mul(t1, t11)
+
+	// Step 253: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaf8
+	for s := 0; s < 3; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 254: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff
+	t11.Mul(t4, t11)
+
+	// Step 261: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 262: t11 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f
+	t11.Mul(t1, t11)
+
+	// Step 267: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3e0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 268: t11 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb
+	t11.Mul(t9, t11)
+
+	// Step 274: t11 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfac0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 275: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfadd
+	t10.Mul(t10, t11)
+
+	// Step 279: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfadd0
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 280: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd
+	t10.Mul(t3, t10)
+
+	// Step 284: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd0
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 285: t10 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7
+	t10.Mul(t4, t10)
+
+	// Step 290: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae0
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 291: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae1
+	t10.Mul(&x, t10)
+
+	// Step 300: t10 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb775c200
+	for s := 0; s < 9; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 301: t10 = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb775c211
+	t10.Mul(t8, t10)
+
+	// Step 304: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae1088
+	for s := 0; s < 3; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 305: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d
+	t10.Mul(z, t10)
+
+	// Step 317: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d000
+	for s := 0; s < 12; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 318: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019
+	t10.Mul(t0, t10)
+
+	// Step 322: t10 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d0190
+	for s := 0; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 323: t9 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b
+	t9.Mul(t9, t10)
+
+	// Step 329: t9 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 330: t8 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d1
+	t8.Mul(t8, t9)
+
+	// Step 338: t8 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d100
+	for s := 0; s < 8; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 339: t7 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f
+	t7.Mul(t7, t8)
+
+	// Step 345: t7 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 346: t6 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7
+	t6.Mul(t6, t7)
+
+	// Step 349: t6 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21eb8
+	for s := 0; s < 3; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 350: t5 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb
+	t5.Mul(t5, t6)
+
+	// Step 357: t5 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d80
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 358: t5 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d89
+	t5.Mul(t2, t5)
+
+	// Step 363: t5 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb120
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 364: t4 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb127
+	t4.Mul(t4, t5)
+
+	// Step 373: t4 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e00
+	for s := 0; s < 9; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 374: t4 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05
+	t4.Mul(z, t4)
+
+	// Step 380: t4 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d8938140
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 381: t4 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d8938141
+	t4.Mul(&x, t4)
+
+	// Step 391: t4 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e050400
+	for s := 0; s < 10; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 392: t3 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d
+	t3.Mul(t3, t4)
+
+	// Step 395: t3 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb1270282068
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 396: t3 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb1270282069
+	t3.Mul(&x, t3)
+
+	// Step 405: t3 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d200
+	for s := 0; s < 9; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 406: t3 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d219
+	t3.Mul(t0, t3)
+
+	// Step 412: t3 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d89381410348640
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 413: t2 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d89381410348649
+	t2.Mul(t2, t3)
+
+	// Step 419: t2 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d219240
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 420: t1 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d21925f
+	t1.Mul(t1, t2)
+
+	// Step 426: t1 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497c0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 427: t0 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d9
+	t0.Mul(t0, t1)
+
+	// Step 431: t0 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d90
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 432: t0 = x^0x35c748c2f8a21d58c760b80d94292763445b3e601ea271e1d75fe7d6eeb84234066d10f5d893814103486497d95
+	t0.Mul(z, t0)
+
+	// Step 437: t0 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb12702820690c92fb2a0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 438: t0 = x^0x6b8e9185f1443ab18ec1701b28524ec688b67cc03d44e3c3aebfcfaddd7084680cda21ebb12702820690c92fb2a5
+	t0.Mul(z, t0)
+
+	// Step 443: t0 = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d21925f654a0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 444: z = x^0xd71d230be28875631d82e03650a49d8d116cf9807a89c7875d7f9f5bbae108d019b443d7624e05040d21925f654a5
+	z.Mul(z, t0)
+
+	// Step 445: z = x^0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f0ebaff3eb775c211a0336887aec49c0a081a4324beca94a
+	z.Square(z)
+
+	return z
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package curve25519 efficient elliptic curve implementation for Curve25519 (RFC 7748), in its short Weierstrass model Wei25519 (https://datatracker.ietf.org/doc/draft-ietf-lwig-curve-representations/). The twisted Edwards model edwards25519, on which Ed25519 (RFC 8032) is defined, is in the twistededwards subpackage.
+//
+// curve25519: A curve of cofactor 8 with
+//
+//	𝔽r: r=7237005577332262213973186563042994240857116359379907606001950938285454250989 (2^252 + 27742317777372353535851937790883648493)
+//	𝔽p: p=57896044618658097711785492504343953926634992332820282019728792003956564819949 (2^255 - 19)
+//	(E/𝔽p): Y²=X³+aX+b, with a=(3-A²)/3 and b=(2A³-9A)/27 where A=486662
+//
+// The map (u,v) → (u+A/3,v) sends the Montgomery curve v²=u³+Au²+u to E, and the base
+// point u=9 of X25519 to the generator of G1.
+//
+// Security: estimated 126-bit level using Pollard's \rho attack
+// (r is 253 bits)
+//
+// # Fixed-base scalar multiplication
+//
+// G1FixedBaseTable stores the multiples of a point which is used as a base many times,
+// with a window size trading memory for speed. ScalarMultiplicationBase uses such a
+// table for the generator, computed on first use.
+//
+// # Constant time
+//
+// ScalarMultiplicationCT, ScalarMultiplicationBaseCT and
+// ScalarMultiplicationFixedBaseCT don't branch on the scalar nor access memory
+// depending on it, and are used by the signature schemes for the secret scalars. The
+// other scalar multiplications are faster but not constant time.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package curve25519
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+)
+
+// ID curve25519 ID
+const ID = ecc.CURVE25519
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+
+// generator of the r-torsion group
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+
+func init() {
+	aCurveCoeff.SetString("19298681539552699237261830834781317975544997444273427339909597334573241639236")
+	bCurveCoeff.SetString("55751746669818908907645289078257140818241103727901012315294400837956729358436")
+
+	g1Gen.X.SetString("19298681539552699237261830834781317975544997444273427339909597334652188435546")
+	g1Gen.Y.SetString("14781619447589544791020593568409986887264606134616475288964881837755586237401")
+	g1Gen.Z.SetOne()
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+}
+
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
@@ -0,0 +1,30 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eddsa provides the Ed25519 signature scheme on curve25519's twisted
+// edwards curve (edwards25519), following RFC 8032.
+//
+// Keys are expanded from a 32-byte seed with SHA-512, the private scalar is
+// clamped and the nonce is derived deterministically from the message. Key
+// generation and signing compute the multiples of the base point with the
+// constant-time scalar multiplication (ScalarMultiplicationCT).
+//
+// Signatures produced with hFunc = sha512.New() are Ed25519 signatures and
+// interoperate with crypto/ed25519. Verification is strict: R and A must be
+// canonical encodings and S must be reduced modulo the group order.
+//
+// # See also
+//
+// https://tools.ietf.org/html/rfc8032
+package eddsa
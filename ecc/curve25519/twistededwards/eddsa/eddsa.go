@@ -0,0 +1,253 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/twistededwards"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+var errNotOnCurve = errors.New("point not on curve")
+var errHashNeeded = errors.New("hFunc cannot be nil. We need a hash for Fiat-Shamir")
+var errWrongSeedSize = errors.New("seed must be 32 bytes long")
+
+const (
+	sizeFp         = fp.Bytes
+	sizeScalar     = 32
+	sizeSeed       = 32
+	sizePublicKey  = sizeFp
+	sizeSignature  = sizeFp + sizeScalar
+	sizePrivateKey = sizeFp + 2*sizeScalar
+)
+
+// PublicKey eddsa signature object
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type PublicKey struct {
+	A twistededwards.PointAffine
+}
+
+// PrivateKey private key of an eddsa instance
+type PrivateKey struct {
+	PublicKey PublicKey        // copy of the associated public key
+	scalar    [sizeScalar]byte // secret (clamped) scalar, in big Endian
+	randSrc   [32]byte         // prefix, second half of SHA-512(seed)
+}
+
+// Signature represents an eddsa signature
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type Signature struct {
+	R twistededwards.PointAffine
+	S [sizeScalar]byte // in big Endian, encoded in little Endian by Bytes()
+}
+
+// GenerateKey generates a public and private key pair from a 32-byte seed
+// read from r.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	seed := make([]byte, sizeSeed)
+	if _, err := io.ReadFull(r, seed); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(seed)
+}
+
+// NewKeyFromSeed derives the key pair from a 32-byte seed, as in
+// https://tools.ietf.org/html/rfc8032#section-5.1.5. The resulting keys
+// are the ones of crypto/ed25519.NewKeyFromSeed.
+func NewKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) != sizeSeed {
+		return nil, errWrongSeedSize
+	}
+	c := twistededwards.GetEdwardsCurve()
+
+	var priv PrivateKey
+	// hash(h) = private_key || random_source, on 32 bytes each
+	h := sha512.Sum512(seed)
+	copy(priv.randSrc[:], h[32:])
+
+	// prune the key
+	// https://tools.ietf.org/html/rfc8032#section-5.1.5, key generation
+	h[0] &= 0xF8
+	h[31] &= 0x7F
+	h[31] |= 0x40
+
+	// reverse first bytes because setBytes interpret stream as big endian
+	// but in eddsa specs s is the first 32 bytes in little endian
+	for i, j := 0, sizeScalar-1; i < sizeScalar; i, j = i+1, j-1 {
+		priv.scalar[i] = h[j]
+	}
+
+	var bScalar big.Int
+	bScalar.SetBytes(priv.scalar[:])
+	priv.PublicKey.A.ScalarMultiplicationCT(&c.Base, &bScalar)
+
+	return &priv, nil
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	return pub.EqualPublicKey(x)
+}
+
+// EqualPublicKey reports whether x is the same public key, as the Equal
+// method of the public keys of the standard library. x can also be a
+// crypto/ed25519 public key.
+func (pub *PublicKey) EqualPublicKey(x crypto.PublicKey) bool {
+	var bxx []byte
+	switch xx := x.(type) {
+	case *PublicKey:
+		bxx = xx.Bytes()
+	case ed25519.PublicKey:
+		bxx = xx
+	default:
+		return false
+	}
+	bpk := pub.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign signs message following https://tools.ietf.org/html/rfc8032#section-5.1.6.
+// The nonce is SHA-512(prefix||message) and the challenge is hFunc(R||A||message),
+// both interpreted as little endian integers. With hFunc = sha512.New() the
+// result is a pure Ed25519 signature.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+
+	// hFunc cannot be nil.
+	// We need a hash function for the Fiat-Shamir.
+	if hFunc == nil {
+		return nil, errHashNeeded
+	}
+
+	curveParams := twistededwards.GetEdwardsCurve()
+
+	var res Signature
+
+	// r = SHA-512(prefix || message) mod l
+	nonceHash := sha512.New()
+	nonceHash.Write(privKey.randSrc[:])
+	nonceHash.Write(message)
+	var r big.Int
+	setBytesLE(&r, nonceHash.Sum(nil))
+	r.Mod(&r, &curveParams.Order)
+
+	// compute R = r*Base
+	res.R.ScalarMultiplicationCT(&curveParams.Base, &r)
+	if !res.R.IsOnCurve() {
+		return nil, errNotOnCurve
+	}
+
+	// compute H(R, A, M)
+	var k big.Int
+	if err := challenge(&k, hFunc, &res.R, &privKey.PublicKey.A, message); err != nil {
+		return nil, err
+	}
+
+	// Compute s = r + H(R,A,M)*s mod l
+	// going with big int to do ops mod curve order
+	var bscalar, bs big.Int
+	bscalar.SetBytes(privKey.scalar[:])
+	bs.Mul(&k, &bscalar).
+		Add(&bs, &r).
+		Mod(&bs, &curveParams.Order)
+	bs.FillBytes(res.S[:])
+
+	return res.Bytes(), nil
+}
+
+// Verify verifies an eddsa signature, checking [S]B = R + [k]A as in
+// crypto/ed25519, where k = hFunc(R||A||message) mod l.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	// hFunc cannot be nil.
+	// We need a hash function for the Fiat-Shamir.
+	if hFunc == nil {
+		return false, errHashNeeded
+	}
+
+	curveParams := twistededwards.GetEdwardsCurve()
+
+	// verify that pubKey is on the curve
+	if !pub.A.IsOnCurve() {
+		return false, errNotOnCurve
+	}
+
+	// Deserialize the signature
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+
+	// compute H(R, A, M)
+	var k big.Int
+	if err := challenge(&k, hFunc, &sig.R, &pub.A, message); err != nil {
+		return false, err
+	}
+
+	// lhs = S*Base
+	var lhs twistededwards.PointAffine
+	var bs big.Int
+	bs.SetBytes(sig.S[:])
+	lhs.ScalarMultiplication(&curveParams.Base, &bs)
+
+	// rhs = R + H(R,A,M)*A
+	var rhs twistededwards.PointAffine
+	rhs.ScalarMultiplication(&pub.A, &k).
+		Add(&rhs, &sig.R)
+
+	return lhs.Equal(&rhs), nil
+}
+
+// challenge sets k to hFunc(R||A||message) mod l, the digest being read in
+// little endian.
+func challenge(k *big.Int, hFunc hash.Hash, R, A *twistededwards.PointAffine, message []byte) error {
+	hFunc.Reset()
+	bR := R.Bytes()
+	bA := A.Bytes()
+	toWrite := [][]byte{bR[:], bA[:], message}
+	for _, bytes := range toWrite {
+		if _, err := hFunc.Write(bytes); err != nil {
+			return err
+		}
+	}
+	curveParams := twistededwards.GetEdwardsCurve()
+	setBytesLE(k, hFunc.Sum(nil))
+	k.Mod(k, &curveParams.Order)
+	return nil
+}
+
+// setBytesLE sets z to the little endian integer buf.
+func setBytesLE(z *big.Int, buf []byte) *big.Int {
+	be := make([]byte, len(buf))
+	for i := range buf {
+		be[len(buf)-1-i] = buf[i]
+	}
+	return z.SetBytes(be)
+}
@@ -0,0 +1,377 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	crand "crypto/rand"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/twistededwards"
+)
+
+func Example() {
+	// instantiate hash function
+	hFunc := sha512.New()
+
+	// create a eddsa key pair
+	privateKey, _ := GenerateKey(crand.Reader)
+	publicKey := privateKey.PublicKey
+
+	// generate a message
+	msg := []byte("testing Ed25519")
+
+	// sign the message
+	signature, _ := privateKey.Sign(msg, hFunc)
+
+	// verifies signature
+	isValid, _ := publicKey.Verify(signature, msg, hFunc)
+	if !isValid {
+		fmt.Println("1. invalid signature")
+	} else {
+		fmt.Println("1. valid signature")
+	}
+
+	// Output: 1. valid signature
+}
+
+// https://tools.ietf.org/html/rfc8032#section-7.1, tests 1 to 3
+var rfc8032Vectors = []struct {
+	seed, pub, msg, sig string
+}{
+	{
+		"9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		"",
+		"e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	},
+	{
+		"4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		"3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		"72",
+		"92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+	},
+	{
+		"c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		"fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		"af82",
+		"6291d657deec24024827e69c3abe01a30ce548a284743a445e3680d7db5ac3ac18ff9b538d16f290ae67f760984dc6594a7c15e9716ed28dc027beceea1ec40a",
+	},
+}
+
+func TestRFC8032(t *testing.T) {
+	for i, v := range rfc8032Vectors {
+		seed, _ := hex.DecodeString(v.seed)
+		pub, _ := hex.DecodeString(v.pub)
+		msg, _ := hex.DecodeString(v.msg)
+		sig, _ := hex.DecodeString(v.sig)
+
+		privKey, err := NewKeyFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privKey.PublicKey.Bytes(), pub) {
+			t.Fatalf("vector %d: wrong public key", i)
+		}
+		s, err := privKey.Sign(msg, sha512.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(s, sig) {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+
+		var pk PublicKey
+		if _, err := pk.SetBytes(pub); err != nil {
+			t.Fatal(err)
+		}
+		ok, err := pk.Verify(sig, msg, sha512.New())
+		if err != nil || !ok {
+			t.Fatalf("vector %d: signature should verify", i)
+		}
+	}
+}
+
+func TestCrossCheckStdlib(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	for i := 0; i < 20; i++ {
+		crand.Read(seed)
+		msg := make([]byte, i*7)
+		crand.Read(msg)
+
+		privKey, err := NewKeyFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdPriv := ed25519.NewKeyFromSeed(seed)
+		stdPub := stdPriv.Public().(ed25519.PublicKey)
+		if !bytes.Equal(privKey.PublicKey.Bytes(), stdPub) {
+			t.Fatal("public keys differ from crypto/ed25519")
+		}
+
+		sig, err := privKey.Sign(msg, sha512.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, ed25519.Sign(stdPriv, msg)) {
+			t.Fatal("signatures differ from crypto/ed25519")
+		}
+		if !ed25519.Verify(stdPub, msg, sig) {
+			t.Fatal("crypto/ed25519 rejects the signature")
+		}
+	}
+}
+
+func TestCryptoSigner(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	crand.Read(seed)
+	msg := []byte("message")
+
+	privKey, err := NewKeyFromSeed(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdPriv := ed25519.NewKeyFromSeed(seed)
+
+	signer := privKey.CryptoSigner()
+	if !stdPriv.Public().(ed25519.PublicKey).Equal(signer.Public()) {
+		t.Fatal("public keys differ from crypto/ed25519")
+	}
+	if !privKey.PublicKey.EqualPublicKey(signer.Public()) {
+		t.Fatal("EqualPublicKey should accept crypto/ed25519 public keys")
+	}
+
+	sig, err := signer.Sign(nil, msg, crypto.Hash(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sig, ed25519.Sign(stdPriv, msg)) {
+		t.Fatal("signatures differ from crypto/ed25519")
+	}
+	if _, err := signer.Sign(nil, msg, crypto.SHA512); err == nil {
+		t.Fatal("signing a pre-hashed message should fail")
+	}
+}
+
+func TestNonMalleability(t *testing.T) {
+
+	privKey, _ := GenerateKey(crand.Reader)
+	msg := []byte("non malleability")
+	sig, _ := privKey.Sign(msg, sha512.New())
+
+	// buffer too big
+	t.Run("buffer_overflow", func(t *testing.T) {
+		bsig := make([]byte, sizeSignature+1)
+		var sig Signature
+		_, err := sig.SetBytes(bsig)
+		if err != errWrongSize {
+			t.Fatal("should raise wrong size error")
+		}
+	})
+
+	// S + l encodes the same scalar, but is not reduced
+	t.Run("S_overflow", func(t *testing.T) {
+		bsig := make([]byte, sizeSignature)
+		copy(bsig, sig)
+		var s big.Int
+		setBytesLE(&s, bsig[sizeFp:])
+		cp := twistededwards.GetEdwardsCurve()
+		s.Add(&s, &cp.Order)
+		buf := make([]byte, sizeScalar)
+		s.FillBytes(buf)
+		for i := 0; i < sizeScalar; i++ {
+			bsig[sizeFp+i] = buf[sizeScalar-1-i]
+		}
+
+		var sig Signature
+		_, err := sig.SetBytes(bsig)
+		if err != errSBiggerThanRMod {
+			t.Fatal("should raise error s >= r_mod")
+		}
+	})
+
+	// R.Y + p encodes the same point, but is not canonical
+	t.Run("R_overflow", func(t *testing.T) {
+		// y = 1 (the neutral element) is the only value for which y + p < 2^255
+		bsig := make([]byte, sizeSignature)
+		copy(bsig, sig)
+		var y big.Int
+		y.Add(fp.Modulus(), big.NewInt(1))
+		buf := make([]byte, sizeFp)
+		y.FillBytes(buf)
+		for i := 0; i < sizeFp; i++ {
+			bsig[i] = buf[sizeFp-1-i]
+		}
+
+		var sig Signature
+		if _, err := sig.SetBytes(bsig); err == nil {
+			t.Fatal("should reject non canonical R")
+		}
+	})
+
+	// x = 0 encoded with the sign bit set
+	t.Run("negative_zero", func(t *testing.T) {
+		bsig := make([]byte, sizeSignature)
+		copy(bsig, sig)
+		for i := 0; i < sizeFp; i++ {
+			bsig[i] = 0
+		}
+		bsig[0] = 1
+		bsig[sizeFp-1] = 0x80
+
+		var sig Signature
+		if _, err := sig.SetBytes(bsig); err == nil {
+			t.Fatal("should reject -0 as x coordinate")
+		}
+	})
+
+	// y such that (y²-1)/(dy²+1) is not a square
+	t.Run("not_on_curve", func(t *testing.T) {
+		bsig := make([]byte, sizeSignature)
+		copy(bsig, sig)
+		for i := 0; i < sizeFp; i++ {
+			bsig[i] = 0
+		}
+		bsig[0] = 2
+
+		var sig Signature
+		if _, err := sig.SetBytes(bsig); err == nil {
+			t.Fatal("should reject a point not on the curve")
+		}
+	})
+}
+
+func TestSerialization(t *testing.T) {
+
+	src := rand.NewSource(0) //#nosec G404 -- test case generation does not require a cryptographically secure RNG
+	r := rand.New(src)       //#nosec G404 weak rng is fine here
+
+	privKey1, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey1 := privKey1.PublicKey
+
+	privKey2, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey2 := privKey2.PublicKey
+
+	pubKeyBin1 := pubKey1.Bytes()
+	if _, err := pubKey2.SetBytes(pubKeyBin1); err != nil {
+		t.Fatal(err)
+	}
+	pubKeyBin2 := pubKey2.Bytes()
+	if len(pubKeyBin1) != len(pubKeyBin2) {
+		t.Fatal("Inconsistent size")
+	}
+	for i := 0; i < len(pubKeyBin1); i++ {
+		if pubKeyBin1[i] != pubKeyBin2[i] {
+			t.Fatal("Error serialize(deserialize(.))")
+		}
+	}
+
+	privKeyBin1 := privKey1.Bytes()
+	if _, err := privKey2.SetBytes(privKeyBin1); err != nil {
+		t.Fatal(err)
+	}
+	privKeyBin2 := privKey2.Bytes()
+	if len(privKeyBin1) != len(privKeyBin2) {
+		t.Fatal("Inconsistent size")
+	}
+	for i := 0; i < len(privKeyBin1); i++ {
+		if privKeyBin1[i] != privKeyBin2[i] {
+			t.Fatal("Error serialize(deserialize(.))")
+		}
+	}
+}
+
+func TestEddsaSHA256(t *testing.T) {
+
+	src := rand.NewSource(0) //#nosec G404 -- test case generation does not require a cryptographically secure RNG
+	r := rand.New(src)       //#nosec G404 weak rng is fine here
+
+	hFunc := sha256.New()
+
+	// create eddsa obj and sign a message
+
+	privKey, err := GenerateKey(r)
+	pubKey := privKey.PublicKey
+	if err != nil {
+		t.Fatal(nil)
+	}
+
+	signature, err := privKey.Sign([]byte("message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// verifies correct msg
+	res, err := pubKey.Verify(signature, []byte("message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("Verify correct signature should return true")
+	}
+
+	// verifies wrong msg
+	res, err = pubKey.Verify(signature, []byte("wrong_message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res {
+		t.Fatal("Verify wrong signature should be false")
+	}
+
+}
+
+// benchmarks
+
+func BenchmarkVerify(b *testing.B) {
+
+	src := rand.NewSource(0) //#nosec G404 -- test case generation does not require a cryptographically secure RNG
+	r := rand.New(src)       //#nosec G404 weak rng is fine here
+
+	hFunc := sha512.New()
+
+	// create eddsa obj and sign a message
+	privKey, err := GenerateKey(r)
+	pubKey := privKey.PublicKey
+	if err != nil {
+		b.Fatal(err)
+	}
+	msg := []byte("message")
+	signature, err := privKey.Sign(msg, hFunc)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pubKey.Verify(signature, msg, hFunc)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/twistededwards"
+)
+
+var errWrongSize = errors.New("wrong size buffer")
+var errSBiggerThanRMod = errors.New("s >= r_mod")
+
+// Bytes returns the binary representation of the public key
+// follows https://tools.ietf.org/html/rfc8032#section-5.1.2
+// and returns a compressed representation of the point (x,y):
+// y in little endian, with the parity of x in the most significant bit.
+func (pk *PublicKey) Bytes() []byte {
+	var res [sizePublicKey]byte
+	pkBin := pk.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:], pkBin[:])
+	return res[:]
+}
+
+// SetBytes sets pk from its compressed representation in buf, rejecting
+// non-canonical encodings (see https://tools.ietf.org/html/rfc8032#section-5.1.3).
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	if _, err := pk.A.SetBytes(buf[:sizePublicKey]); err != nil {
+		return 0, err
+	}
+	return sizePublicKey, nil
+}
+
+// Bytes returns the binary representation of pk,
+// as byte array publicKey||scalar||randSrc
+// where publicKey is as publicKey.Bytes(), and
+// scalar is the clamped secret scalar in big endian.
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [sizePrivateKey]byte
+	pubkBin := privKey.PublicKey.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizeFp], pubkBin[:])
+	subtle.ConstantTimeCopy(1, res[sizeFp:sizeFp+sizeScalar], privKey.scalar[:])
+	subtle.ConstantTimeCopy(1, res[sizeFp+sizeScalar:], privKey.randSrc[:])
+	return res[:]
+}
+
+// SetBytes sets pk from buf, where buf is interpreted
+// as  publicKey||scalar||randSrc
+// where publicKey is as publicKey.Bytes(), and
+// scalar is the clamped secret scalar in big endian.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	n := 0
+	if len(buf) < sizePrivateKey {
+		return n, io.ErrShortBuffer
+	}
+	if _, err := privKey.PublicKey.A.SetBytes(buf[:sizeFp]); err != nil {
+		return 0, err
+	}
+	n += sizeFp
+	subtle.ConstantTimeCopy(1, privKey.scalar[:], buf[sizeFp:sizeFp+sizeScalar])
+	n += sizeScalar
+	subtle.ConstantTimeCopy(1, privKey.randSrc[:], buf[sizeFp+sizeScalar:sizePrivateKey])
+	n += sizeScalar
+	return n, nil
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array R||S of size 64, following
+// https://tools.ietf.org/html/rfc8032#section-5.1.6, where
+//   - R is the compressed point, as PublicKey.Bytes()
+//   - S=r+h(R,A,M)*s mod l, in little endian
+func (sig *Signature) Bytes() []byte {
+	var res [sizeSignature]byte
+	sigRBin := sig.R.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizeFp], sigRBin[:])
+	for i := 0; i < sizeScalar; i++ {
+		res[sizeFp+i] = sig.S[sizeScalar-1-i]
+	}
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary, see Bytes().
+// To avoid malleability, R must be a canonical point encoding
+// and S must be smaller than the order l of the base point.
+//
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != sizeSignature {
+		return 0, errWrongSize
+	}
+
+	// S < R_mod (to avoid malleability)
+	var s [sizeScalar]byte
+	for i := 0; i < sizeScalar; i++ {
+		s[i] = buf[sizeSignature-1-i]
+	}
+	var bufBigInt big.Int
+	bufBigInt.SetBytes(s[:])
+	cp := twistededwards.GetEdwardsCurve()
+	if bufBigInt.Cmp(&cp.Order) != -1 {
+		return 0, errSBiggerThanRMod
+	}
+
+	// deserialisation
+	if _, err := sig.R.SetBytes(buf[:sizeFp]); err != nil {
+		return 0, err
+	}
+	sig.S = s
+	return sizeSignature, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+	"io"
+)
+
+var errPreHashed = errors.New("eddsa can't sign a pre-hashed message")
+
+// CryptoSigner returns an adapter of privKey to the crypto.Signer interface of
+// the standard library, producing pure Ed25519 signatures as the
+// crypto/ed25519 signer: the message is signed as is (opts.HashFunc() must be
+// 0), and the public key is an ed25519.PublicKey.
+func (privKey *PrivateKey) CryptoSigner() crypto.Signer {
+	return (*cryptoSigner)(privKey)
+}
+
+type cryptoSigner PrivateKey
+
+// Public returns the public key of the signer, as an ed25519.PublicKey.
+func (s *cryptoSigner) Public() crypto.PublicKey {
+	return ed25519.PublicKey(s.PublicKey.Bytes())
+}
+
+// Sign signs message, see PrivateKey.Sign. rand is not used.
+func (s *cryptoSigner) Sign(rand io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts != nil && opts.HashFunc() != 0 {
+		return nil, errPreHashed
+	}
+	return (*PrivateKey)(s).Sign(message, sha512.New())
+}
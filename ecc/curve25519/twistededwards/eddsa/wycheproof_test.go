@@ -0,0 +1,88 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// TestWycheproof checks Verify against the Wycheproof Ed25519 vectors
+// (test_vectors/LICENSE.wycheproof). The invalid signatures are mostly
+// malformed encodings, modified signatures and non-reduced S.
+func TestWycheproof(t *testing.T) {
+	f, err := os.Open("test_vectors/ed25519_test.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var vectors struct {
+		NumberOfTests int `json:"numberOfTests"`
+		TestGroups    []struct {
+			PublicKey struct {
+				Curve string `json:"curve"`
+				Pk    string `json:"pk"`
+			} `json:"publicKey"`
+			Tests []struct {
+				TcID    int    `json:"tcId"`
+				Comment string `json:"comment"`
+				Msg     string `json:"msg"`
+				Sig     string `json:"sig"`
+				Result  string `json:"result"`
+			} `json:"tests"`
+		} `json:"testGroups"`
+	}
+	if err := json.NewDecoder(f).Decode(&vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	nbTests := 0
+	for _, group := range vectors.TestGroups {
+		if group.PublicKey.Curve != "edwards25519" {
+			t.Fatalf("unexpected curve %s", group.PublicKey.Curve)
+		}
+		var publicKey PublicKey
+		if _, err := publicKey.SetBytes(decodeHex(t, group.PublicKey.Pk)); err != nil {
+			t.Fatal(err)
+		}
+		for _, test := range group.Tests {
+			nbTests++
+			msg := decodeHex(t, test.Msg)
+			sig := decodeHex(t, test.Sig)
+			expected := test.Result == "valid"
+
+			valid, err := publicKey.Verify(sig, msg, sha512.New())
+			if valid != expected {
+				t.Fatalf("tcId %d (%s): expected %s, got valid=%v, %v", test.TcID, test.Comment, test.Result, valid, err)
+			}
+		}
+	}
+	if nbTests != vectors.NumberOfTests {
+		t.Fatalf("expected %d test vectors, got %d", vectors.NumberOfTests, nbTests)
+	}
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
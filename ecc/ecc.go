@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package ecc provides bls12-381, bls12-377, bls12-378, bn254, bw6-761, bls24-315, bls24-317, bw6-633, bls12-378, bw6-756, secp256k1 and stark-curve elliptic curves implementation (+pairing).
+// Package ecc provides bls12-381, bls12-377, bls12-378, bls12-461, bn254, bw6-761, bls24-315, bls24-317, bw6-633, bw6-756, secp256k1, secp256r1, secp384r1, curve25519 and stark-curve elliptic curves implementation (+pairing).
 //
 // Also
 //
@@ -23,7 +23,7 @@ limitations under the License.
 //   - Polynomial commitment schemes
 //   - MiMC
 //   - twisted edwards "companion curves"
-//   - EdDSA (on the "companion" twisted edwards curves)
+//   - EdDSA (on the "companion" twisted edwards curves, and Ed25519 on curve25519)
 package ecc
 
 import (
@@ -51,11 +51,15 @@ const (
 	BW6_756
 	STARK_CURVE
 	SECP256K1
+	BLS12_461
+	SECP256R1
+	SECP384R1
+	CURVE25519
 )
 
 // Implemented return the list of curves fully implemented in gnark-crypto
 func Implemented() []ID {
-	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BLS24_315, BW6_633, BLS12_378, BW6_756, BLS24_317, STARK_CURVE, SECP256K1}
+	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BLS24_315, BW6_633, BLS12_378, BW6_756, BLS24_317, STARK_CURVE, SECP256K1, BLS12_461, SECP256R1, SECP384R1, CURVE25519}
 }
 
 func IDFromString(s string) (ID, error) {
@@ -94,6 +98,8 @@ func (id ID) config() *config.Curve {
 		return &config.BLS12_378
 	case BLS12_381:
 		return &config.BLS12_381
+	case BLS12_461:
+		return &config.BLS12_461
 	case BN254:
 		return &config.BN254
 	case BW6_761:
@@ -110,6 +116,12 @@ func (id ID) config() *config.Curve {
 		return &config.STARK_CURVE
 	case SECP256K1:
 		return &config.SECP256K1
+	case SECP256R1:
+		return &config.SECP256R1
+	case SECP384R1:
+		return &config.SECP384R1
+	case CURVE25519:
+		return &config.CURVE25519
 	default:
 		panic("unimplemented ecc ID")
 	}
@@ -0,0 +1,31 @@
+package ecc
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	bls12461fp "github.com/consensys/gnark-crypto/ecc/bls12-461/fp"
+)
+
+func TestBaseFieldBits(t *testing.T) {
+	if bls12461fp.Bits != 461 {
+		t.Fatalf("bls12-461: p has %d bits", bls12461fp.Bits)
+	}
+
+	// the pairing-friendly curves are named after the size of p
+	for _, id := range Implemented() {
+		name := id.String()
+		i := strings.LastIndexAny(name, "_n")
+		if !strings.HasPrefix(name, "bn") && !strings.HasPrefix(name, "bls") && !strings.HasPrefix(name, "bw") {
+			continue
+		}
+		bits, err := strconv.Atoi(name[i+1:])
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if id.BaseField().BitLen() != bits {
+			t.Fatalf("%s: p has %d bits", name, id.BaseField().BitLen())
+		}
+	}
+}
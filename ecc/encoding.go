@@ -0,0 +1,61 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecc
+
+// Encoding selects the binary encoding of elliptic curve points used by the curve
+// packages Encoder and Decoder (see WithEncoding and WithDecoding options) and by
+// the G1Affine.BytesWithEncoding / G1Affine.SetBytesWithEncoding methods.
+//
+// Encodings other than GnarkEncoding are meant to exchange data with other implementations;
+// they are not supported on every curve or point type.
+type Encoding uint8
+
+const (
+	// GnarkEncoding is the default gnark-crypto encoding: big-endian coordinates with the
+	// compression and infinity metadata in the most significant bits of the first byte.
+	// On BLS12-381 it is the ZCash encoding.
+	GnarkEncoding Encoding = iota
+
+	// ZCashEncoding is the ZCash / IETF serialization of BLS12-381 points
+	// (draft-irtf-cfrg-pairing-friendly-curves, Appendix C).
+	ZCashEncoding
+
+	// ArkworksEncoding is the CanonicalSerialize encoding of arkworks (ark-ec 0.4):
+	// little-endian coordinates, the lowest degree first, with the "y is negative" (0x80) and
+	// "infinity" (0x40) flags in the most significant bits of the last byte, and 8-byte
+	// little-endian slice lengths. ark-bls12-381 overrides the points encoding with the ZCash one.
+	ArkworksEncoding
+
+	// SEC1Encoding is the SEC 1 v2 §2.3.3 encoding of G1 points: 0x00 for the point at infinity,
+	// 0x02 or 0x03 (parity of y) followed by x, or 0x04 followed by x and y, big-endian.
+	SEC1Encoding
+)
+
+func (e Encoding) String() string {
+	switch e {
+	case GnarkEncoding:
+		return "gnark"
+	case ZCashEncoding:
+		return "zcash"
+	case ArkworksEncoding:
+		return "arkworks"
+	case SEC1Encoding:
+		return "sec1"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,211 @@
+package generator
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// check parses the parameters of c and checks them.
+func (c *Curve) check() (*curveData, error) {
+	pkg, err := c.packageName()
+	if err != nil {
+		return nil, err
+	}
+	if c.Cofactor == "" {
+		c.Cofactor = "1"
+	}
+	var p, r, a, b, gx, gy, cofactor big.Int
+	for _, v := range []struct {
+		name  string
+		value string
+		x     *big.Int
+	}{
+		{"p", c.P, &p}, {"r", c.R, &r}, {"a", c.A, &a}, {"b", c.B, &b},
+		{"Gx", c.Gx, &gx}, {"Gy", c.Gy, &gy}, {"cofactor", c.Cofactor, &cofactor},
+	} {
+		if err := parseInt(v.x, v.name, v.value); err != nil {
+			return nil, err
+		}
+	}
+
+	three := big.NewInt(3)
+	if p.Cmp(three) <= 0 || r.Cmp(three) <= 0 || !p.ProbablyPrime(20) || !r.ProbablyPrime(20) {
+		return nil, errNotPrime
+	}
+	E := weierstrass{p: &p}
+	E.a = E.reduce(&a)
+	E.b = E.reduce(&b)
+	// the point at infinity is encoded as (0,0), which must not be on the curve
+	if E.b.Sign() == 0 {
+		return nil, errSingular
+	}
+	// 4a³ + 27b² ≠ 0
+	var disc, b2 big.Int
+	disc.Mul(E.a, E.a).Mul(&disc, E.a).Lsh(&disc, 2)
+	b2.Mul(E.b, E.b).Mul(&b2, big.NewInt(27))
+	if E.reduce(disc.Add(&disc, &b2)).Sign() == 0 {
+		return nil, errSingular
+	}
+	// (x,y) → (ωx,y) is an endomorphism of E only if a = 0
+	if c.GLV != nil && E.a.Sign() != 0 {
+		return nil, errGLVA
+	}
+
+	// G ∈ E(𝔽p) of order r
+	g := point{x: E.reduce(&gx), y: E.reduce(&gy)}
+	if !E.isOnCurve(&g) {
+		return nil, errGeneratorG1
+	}
+	if !E.mul(&g, &r).inf {
+		return nil, errOrderG1
+	}
+
+	// |#E(𝔽p) - (p+1)| ≤ 2√p, and E(𝔽p)[r] = G1
+	var n, t, rem big.Int
+	n.Mul(&cofactor, &r)
+	t.Add(&p, big.NewInt(1)).Sub(&t, &n)
+	t.Mul(&t, &t)
+	var fourP big.Int
+	fourP.Lsh(&p, 2)
+	if cofactor.Sign() <= 0 || t.Cmp(&fourP) > 0 || rem.Mod(&cofactor, &r).Sign() == 0 {
+		return nil, errCofactor
+	}
+
+	data := &curveData{
+		Package:          pkg,
+		Name:             c.Name,
+		P:                p.String(),
+		R:                r.String(),
+		A:                E.a.String(),
+		B:                E.b.String(),
+		Gx:               g.x.String(),
+		Gy:               g.y.String(),
+		Cofactor:         cofactor.String(),
+		RBits:            r.BitLen(),
+		Security:         r.BitLen() / 2,
+		CofactorCleaning: cofactor.Cmp(big.NewInt(1)) != 0,
+	}
+
+	if c.GLV != nil {
+		var omega, lambda big.Int
+		if err := parseInt(&omega, "ω", c.GLV.ThirdRootOne); err != nil {
+			return nil, err
+		}
+		if err := parseInt(&lambda, "λ", c.GLV.Lambda); err != nil {
+			return nil, err
+		}
+		if !isPrimitiveCubeRoot(E.reduce(&omega), &p) {
+			return nil, errThirdRootOne
+		}
+		if !isPrimitiveCubeRoot(lambda.Mod(&lambda, &r), &r) {
+			return nil, errLambda
+		}
+		// ϕ(G) = (ωx, y) = [λ]G
+		phi := point{x: E.reduce(new(big.Int).Mul(g.x, &omega)), y: g.y}
+		if !E.equal(&phi, E.mul(&g, &lambda)) {
+			return nil, errEigenvalueGLV
+		}
+		data.GLV = true
+		data.ThirdRootOne = omega.String()
+		data.Lambda = lambda.String()
+	}
+
+	if c.Pairing != nil {
+		if data.Pairing, err = c.Pairing.check(data); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// parseInt sets x to the integer s (base 10, or base 16 with the 0x prefix).
+func parseInt(x *big.Int, name, s string) error {
+	if _, ok := x.SetString(s, 0); !ok {
+		return fmt.Errorf("invalid %s: %q", name, s)
+	}
+	return nil
+}
+
+// isPrimitiveCubeRoot returns true if x ≠ 1 and x³ = 1 mod q.
+func isPrimitiveCubeRoot(x, q *big.Int) bool {
+	var t big.Int
+	one := big.NewInt(1)
+	return x.Cmp(one) != 0 && t.Exp(x, big.NewInt(3), q).Cmp(one) == 0
+}
+
+// point is a point of a curve in affine coordinates; inf is set for the point at infinity.
+type point struct {
+	x, y *big.Int
+	inf  bool
+}
+
+// weierstrass implements the (slow) arithmetic of the curve Y² = X³ + aX + b over
+// 𝔽p, to check the parameters before generating the code.
+type weierstrass struct {
+	p, a, b *big.Int
+}
+
+func (E *weierstrass) reduce(x *big.Int) *big.Int {
+	return x.Mod(x, E.p)
+}
+
+func (E *weierstrass) isOnCurve(P *point) bool {
+	var left, right big.Int
+	left.Mul(P.y, P.y)
+	right.Mul(P.x, P.x).Add(&right, E.a).Mul(&right, P.x).Add(&right, E.b)
+	return E.reduce(&left).Cmp(E.reduce(&right)) == 0
+}
+
+func (E *weierstrass) equal(P, Q *point) bool {
+	if P.inf || Q.inf {
+		return P.inf == Q.inf
+	}
+	return P.x.Cmp(Q.x) == 0 && P.y.Cmp(Q.y) == 0
+}
+
+// add returns P+Q.
+func (E *weierstrass) add(P, Q *point) *point {
+	if P.inf {
+		return Q
+	}
+	if Q.inf {
+		return P
+	}
+	var num, den big.Int
+	if P.x.Cmp(Q.x) == 0 {
+		var sum big.Int
+		if E.reduce(sum.Add(P.y, Q.y)).Sign() == 0 {
+			return &point{inf: true}
+		}
+		// λ = (3x²+a)/2y
+		num.Mul(P.x, P.x).Mul(&num, big.NewInt(3)).Add(&num, E.a)
+		den.Lsh(P.y, 1)
+	} else {
+		// λ = (y₂-y₁)/(x₂-x₁)
+		num.Sub(Q.y, P.y)
+		den.Sub(Q.x, P.x)
+	}
+	var l big.Int
+	l.ModInverse(E.reduce(&den), E.p).Mul(&l, &num)
+	E.reduce(&l)
+
+	x, y := new(big.Int), new(big.Int)
+	x.Mul(&l, &l).Sub(x, P.x).Sub(x, Q.x)
+	E.reduce(x)
+	y.Sub(P.x, x).Mul(y, &l).Sub(y, P.y)
+	E.reduce(y)
+	return &point{x: x, y: y}
+}
+
+// mul returns [k]P, for k ≥ 0.
+func (E *weierstrass) mul(P *point, k *big.Int) *point {
+	res := &point{inf: true}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = E.add(res, res)
+		if k.Bit(i) == 1 {
+			res = E.add(res, P)
+		}
+	}
+	return res
+}
@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func secp256k1() Curve {
+	return Curve{
+		Name: "secp256k1-custom",
+		P:    "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f",
+		R:    "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+		A:    "0",
+		B:    "7",
+		Gx:   "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		Gy:   "0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+		GLV: &GLV{
+			ThirdRootOne: "0x7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee",
+			Lambda:       "0x5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72",
+		},
+	}
+}
+
+// p256 returns the description of secp256r1, which has a = -3.
+func p256() Curve {
+	return Curve{
+		Name: "p256-custom",
+		P:    "0xffffffff00000001000000000000000000000000ffffffffffffffffffffffff",
+		R:    "0xffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551",
+		A:    "-3",
+		B:    "0x5ac635d8aa3a93e7b3ebbd55769886bc651d06b0cc53b0f63bce3c3e27d2604b",
+		Gx:   "0x6b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296",
+		Gy:   "0x4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5",
+	}
+}
+
+func TestCheck(t *testing.T) {
+	c := secp256k1()
+	data, err := c.check()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.Package != "secp256k1custom" || !data.GLV || data.CofactorCleaning {
+		t.Fatal("unexpected curve data")
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*Curve)
+		err    error
+	}{
+		{"gnark-crypto curve", func(c *Curve) { c.Name = "secp256k1" }, errName},
+		{"invalid name", func(c *Curve) { c.Name = "k 256" }, errName},
+		{"p not prime", func(c *Curve) { c.P = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d" }, errNotPrime},
+		{"GLV with a ≠ 0", func(c *Curve) { c.A = "1" }, errGLVA},
+		{"b = 0", func(c *Curve) { c.B = "0" }, errSingular},
+		{"singular", func(c *Curve) { c.A, c.B, c.GLV = "-3", "2", nil }, errSingular},
+		{"generator not on the curve", func(c *Curve) { c.B = "5" }, errGeneratorG1},
+		{"wrong cofactor", func(c *Curve) { c.Cofactor = "2" }, errCofactor},
+		{"wrong ω", func(c *Curve) { c.GLV.ThirdRootOne = "2" }, errThirdRootOne},
+		{"wrong λ", func(c *Curve) { c.GLV.Lambda = "1" }, errLambda},
+		{"wrong eigenvalue", func(c *Curve) {
+			// λ² is the eigenvalue of ϕ² = (ω²x, y)
+			c.GLV.Lambda = "0xac9c52b33fa3cf1f5ad9e3fd77ed9ba4a880b9fc8ec739c2e0cfc810b51283ce"
+		}, errEigenvalueGLV},
+	} {
+		c := secp256k1()
+		tc.modify(&c)
+		if _, err := c.check(); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func TestCheckANonZero(t *testing.T) {
+	c := p256()
+	data, err := c.check()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a = p - 3
+	var a, p big.Int
+	a.SetString(data.A, 10)
+	p.SetString(c.P, 0)
+	if a.Add(&a, big.NewInt(3)).Cmp(&p) != 0 || data.GLV {
+		t.Fatal("unexpected curve data")
+	}
+
+	c.Gy = "0x4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f6"
+	if _, err := c.check(); !errors.Is(err, errGeneratorG1) {
+		t.Fatalf("expected %v, got %v", errGeneratorG1, err)
+	}
+}
+
+func TestHashSuite(t *testing.T) {
+	// Z of the SSWU suites of RFC 9380, section 8
+	for _, tc := range []struct {
+		name string
+		c    Curve
+		z    int
+	}{
+		{"P256_XMD:SHA-256_SSWU_RO_", p256(), -10},
+		{"P384_XMD:SHA-384_SSWU_RO_", Curve{
+			Name: "p384-custom",
+			P:    "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffff0000000000000000ffffffff",
+			R:    "0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a77aecec196accc52973",
+			A:    "-3",
+			B:    "0xb3312fa7e23ee7e4988e056be3f82d19181d9c6efe8141120314088f5013875ac656398d8a2ed19d2a85c8edd3ec2aef",
+			Gx:   "0xaa87ca22be8b05378eb1c71ef320ad746e1d3b628ba79b9859f741e082542a385502f25dbf55296c3a545e3872760ab7",
+			Gy:   "0x3617de4a96262c6f5d9e98bf9292dc29f8f41dbd289a147ce9da3113b5f0b8c00a60b1ce1d7e819d7a431d7c90ea0e5f",
+		}, -12},
+	} {
+		data, err := tc.c.check()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conf, err := newConfig(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		suite, ok := conf.HashE1.(*config.HashSuiteSswu)
+		if !ok || len(suite.Z) != 1 || suite.Z[0] != tc.z {
+			t.Errorf("%s: expected Z = %d, got %v", tc.name, tc.z, conf.HashE1)
+		}
+	}
+
+	// a = 0: Shallue and van de Woestijne map
+	k1 := secp256k1()
+	data, err := k1.check()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf, err := newConfig(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := conf.HashE1.(*config.HashSuiteSvdw); !ok {
+		t.Fatal("expected the SVDW map for a = 0")
+	}
+}
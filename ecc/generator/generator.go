@@ -0,0 +1,285 @@
+// Package generator generates the package of an elliptic curve which is not part of
+// gnark-crypto, from a description of the curve.
+//
+// The generated package has the same API as the ones of secp256k1 and secp256r1:
+// the fields fp and fr, the arithmetic of the points of the prime order subgroup G1
+// (with the GLV scalar multiplication if an endomorphism is provided), multi-scalar
+// multiplication, serialization and hash-to-curve, with their tests. Serialization is
+// generated only if p leaves at least 2 spare bits in its top word, to store the
+// compression flags.
+//
+// Curves Y² = X³ + aX + b with b ≠ 0 are supported. If a = 0, the points use the
+// formulas of the j=0 curves of gnark-crypto and are hashed with the Shallue and van
+// de Woestijne map; otherwise they use the formulas of secp256r1 (complete formulas
+// for a ≠ 0 in constant time) and the simplified SWU map, with Z chosen as in RFC
+// 9380, appendix H.2.
+//
+// # Pairing-friendly curves
+//
+// If the curve is a BN or BLS12 curve described by Curve.Pairing, the package also
+// has the tower 𝔽p¹² in internal/fptower, the group G2 on the sextic twist, the
+// optimal ate pairing (Miller loop with precomputed lines and final exponentiation)
+// and, if 𝔽r has the roots of unity of the FFT and the points can be serialized, the
+// fr/fft and kzg packages. The curves of gnark-crypto have faster hand-written
+// versions of some of them: the subgroup checks, the cofactor clearing and the hard
+// part of the final exponentiation use the seed of the family there, and are generic
+// here.
+//
+// # Not supported yet
+//
+// The hash to G2, and so the setup ceremony of KZG which needs it, and the SSWU map
+// through an isogeny (needed when a·b = 0 and the SVDW map is not wanted).
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/ecc/generator/internal/templates"
+	field "github.com/consensys/gnark-crypto/field/generator"
+	fieldconfig "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/ecc"
+	"github.com/consensys/gnark-crypto/internal/generator/kzg"
+	"github.com/consensys/gnark-crypto/internal/generator/tower"
+)
+
+// Curve describes a curve E: Y² = X³ + aX + b over 𝔽p and a subgroup G1 of E(𝔽p)
+// of prime order r. Integers are given in base 10, or base 16 with the 0x prefix.
+type Curve struct {
+	Name     string   // name of the curve; the package name is the lower case name without dashes
+	P        string   // modulus of the base field 𝔽p
+	R        string   // order of G1, modulus of the scalar field 𝔽r
+	A, B     string   // coefficients of the curve equation; b must be non-zero
+	Gx, Gy   string   // coordinates of the generator of G1
+	Cofactor string   // index of G1 in E(𝔽p); 1 if empty
+	GLV      *GLV     // endomorphism speeding up the scalar multiplication, if any
+	Pairing  *Pairing // pairing-friendly structure of the curve, if any
+}
+
+// GLV describes the endomorphism ϕ: (x,y) → (ωx,y) of E, which acts on G1 as the
+// multiplication by λ. It exists only if a = 0.
+type GLV struct {
+	ThirdRootOne string // ω, a primitive cube root of unity in 𝔽p
+	Lambda       string // λ, a primitive cube root of unity in 𝔽r
+}
+
+var (
+	errName          = errors.New("the name of the curve must give a valid package name")
+	errNotInModule   = errors.New("the output directory must be in a module (no go.mod found)")
+	errNotPrime      = errors.New("p and r must be primes > 3")
+	errSingular      = errors.New("the curve must be non-singular, with b ≠ 0")
+	errGeneratorG1   = errors.New("the generator is not on the curve")
+	errOrderG1       = errors.New("the generator is not of order r")
+	errCofactor      = errors.New("cofactor·r is not the order of a curve over 𝔽p (Hasse bound), or r divides the cofactor")
+	errThirdRootOne  = errors.New("ω must be a primitive cube root of unity in 𝔽p")
+	errLambda        = errors.New("λ must be a primitive cube root of unity in 𝔽r")
+	errEigenvalueGLV = errors.New("ϕ(G) ≠ [λ]G")
+	errGLVA          = errors.New("the endomorphism (x,y) → (ωx,y) needs a = 0")
+	errPairingA      = errors.New("the pairing-friendly curves of the BN and BLS12 families have a = 0")
+	errFamily        = errors.New("the family of the pairing must be \"bn\" or \"bls12\"")
+	errFamilyModuli  = errors.New("p and r are not the polynomials of the family evaluated at the seed")
+	errBeta          = errors.New("β must be a quadratic non-residue of 𝔽p, and -1 if p ≡ 3 mod 4")
+	errXi            = errors.New("ξ must be neither a square nor a cube in 𝔽p²")
+	errTwist         = errors.New("the twist must be \"D\" or \"M\"")
+	errGeneratorG2   = errors.New("the generator of G2 is not on the twist")
+	errOrderG2       = errors.New("the generator of G2 is not of order r")
+)
+
+// curveData is the data used to execute the templates of the curve package which
+// are not shared with the curves of gnark-crypto.
+type curveData struct {
+	Package, Name, ImportPath string
+	P, R, A, B, Gx, Gy        string
+	Cofactor                  string
+	ThirdRootOne, Lambda      string
+	RBits, Security           int
+	GLV, CofactorCleaning     bool
+	HasMarshal                bool
+	Pairing                   *pairingData
+}
+
+// Generate checks the description of the curve c and generates its package in
+// outputDir. The import path of the package is derived from the closest go.mod.
+func Generate(c Curve, outputDir string) error {
+	importPath, err := field.ImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+	if importPath == "" {
+		return errNotInModule
+	}
+	data, err := c.check()
+	if err != nil {
+		return err
+	}
+	data.ImportPath = importPath
+
+	conf, err := newConfig(data)
+	if err != nil {
+		return err
+	}
+	data.HasMarshal = conf.HasMarshal()
+
+	if err := field.GenerateFF(conf.Fp, filepath.Join(outputDir, "fp")); err != nil {
+		return err
+	}
+	if err := field.GenerateFF(conf.Fr, filepath.Join(outputDir, "fr")); err != nil {
+		return err
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+	if err := ecc.GenerateCustom(conf, outputDir, bavardOpts...); err != nil {
+		return err
+	}
+
+	entries := []templateEntry{
+		{"doc.go", data.Package, templates.Doc},
+		{data.Package + ".go", data.Package, templates.Curve},
+		{filepath.Join("internal", "parallel", "execute.go"), "parallel", templates.Parallel},
+	}
+	if data.Pairing != nil {
+		if err := generatePairing(conf, outputDir, bavardOpts); err != nil {
+			return err
+		}
+		towerDir := filepath.Join("internal", "fptower")
+		entries = append(entries,
+			templateEntry{filepath.Join(towerDir, "e2_"+data.Package+".go"), "fptower", templates.E2},
+			templateEntry{filepath.Join(towerDir, "e12_pairing.go"), "fptower", templates.E12Pairing},
+			templateEntry{filepath.Join(towerDir, "frobenius.go"), "fptower", templates.Frobenius},
+			templateEntry{filepath.Join(towerDir, "parameters.go"), "fptower", templates.Parameters},
+			templateEntry{filepath.Join(towerDir, "generators_test.go"), "fptower", templates.GeneratorsTest},
+			templateEntry{"pairing.go", data.Package, templates.Pairing},
+			templateEntry{"pairing_test.go", data.Package, templates.PairingTest},
+		)
+	}
+	for _, e := range entries {
+		opts := make([]func(*bavard.Bavard) error, len(bavardOpts), len(bavardOpts)+1)
+		copy(opts, bavardOpts)
+		opts = append(opts, bavard.Package(e.pkg))
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, e.file), []string{e.template}, data, opts...); err != nil {
+			return err
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// templateEntry is a file of the curve package generated from a template of
+// ecc/generator/internal/templates.
+type templateEntry struct {
+	file, pkg, template string
+}
+
+// generatePairing generates the parts of the package of a pairing-friendly curve
+// which come from the templates of gnark-crypto: the tower, the fft package of 𝔽r
+// and the kzg package. KZG needs the FFT and the serialization of the points.
+func generatePairing(conf config.Curve, outputDir string, bavardOpts []func(*bavard.Bavard) error) error {
+	if err := tower.GenerateCustom(conf, filepath.Join(outputDir, "internal", "fptower"), bavardOpts...); err != nil {
+		return err
+	}
+	if fieldconfig.NewFFTConfig(conf.Fr) == nil || !conf.HasMarshal() {
+		return nil
+	}
+	if err := field.GenerateFFT(conf.Fr, filepath.Join(outputDir, "fr")); err != nil {
+		return err
+	}
+	return kzg.GenerateCustom(conf, filepath.Join(outputDir, "kzg"), bavardOpts...)
+}
+
+// newConfig returns the configuration of the curve templates of gnark-crypto.
+func newConfig(data *curveData) (config.Curve, error) {
+	conf := config.Curve{
+		Name:         data.Package,
+		CurvePackage: data.Package,
+		FpModulus:    data.P,
+		FrModulus:    data.R,
+		ImportPath:   data.ImportPath,
+		ParallelPath: data.ImportPath + "/internal/parallel",
+		Custom:       true,
+		G1: config.Point{
+			CoordType:        "fp.Element",
+			CoordExtDegree:   1,
+			PointName:        "g1",
+			GLV:              data.GLV,
+			CofactorCleaning: data.CofactorCleaning,
+			CRange:           []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		},
+	}
+
+	if data.Pairing != nil {
+		conf.Family = data.Pairing.Family
+		// the hash to G2 is not generated, so HashE2 is not set
+		conf.G2 = config.Point{
+			CoordType:        "fptower.E2",
+			CoordExtDegree:   2,
+			PointName:        "g2",
+			CofactorCleaning: true,
+			CRange:           slices.Clone(conf.G1.CRange),
+		}
+	}
+
+	var err error
+	if conf.Fp, err = fieldconfig.NewFieldConfig("fp", "Element", data.P, false); err != nil {
+		return conf, err
+	}
+	if conf.Fr, err = fieldconfig.NewFieldConfig("fr", "Element", data.R, false); err != nil {
+		return conf, err
+	}
+	conf.FpUnusedBits = 64 - (conf.Fp.NbBits % 64)
+
+	// the digits of the multiexp are stored on 16 bits: with windows of 16 bits,
+	// the last one can't accommodate the carry if r has a multiple of 16 bits
+	if conf.Fr.NbBits%16 == 0 {
+		conf.G1.CRange = conf.G1.CRange[:len(conf.G1.CRange)-1]
+		if conf.HasG2() {
+			conf.G2.CRange = conf.G2.CRange[:len(conf.G2.CRange)-1]
+		}
+	}
+
+	// hash-to-curve: the simplified SWU map if a·b ≠ 0, the Shallue and van de
+	// Woestijne map otherwise (RFC 9380, section 6.6)
+	var p, a, b big.Int
+	p.SetString(data.P, 10)
+	a.SetString(data.A, 10)
+	b.SetString(data.B, 10)
+	if a.Sign() != 0 {
+		// the point templates use the formulas for a ≠ 0 when A is set
+		conf.G1.A = []string{data.A}
+		conf.G1.B = []string{data.B}
+		conf.HashE1, err = config.NewHashSuiteSswu(&p, &a, &b)
+	} else {
+		conf.HashE1, err = config.NewHashSuiteSvdw(&p, &a, &b)
+	}
+	return conf, err
+}
+
+// packageName returns the name of the package of the curve.
+func (c *Curve) packageName() (string, error) {
+	pkg := strings.ToLower(strings.ReplaceAll(c.Name, "-", ""))
+	if !token.IsIdentifier(pkg) || pkg == "fp" || pkg == "fr" {
+		return "", errName
+	}
+	// the templates specialize some functions for the curves of gnark-crypto
+	for _, curve := range config.Curves {
+		if pkg == strings.ReplaceAll(curve.Name, "-", "") {
+			return "", fmt.Errorf("%w: %s is a curve of gnark-crypto", errName, curve.Name)
+		}
+	}
+	return pkg, nil
+}
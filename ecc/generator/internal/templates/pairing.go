@@ -0,0 +1,1046 @@
+package templates
+
+// Pairing is the template of the optimal ate pairing of a BN or BLS12 curve, with
+// the lines of the Miller loop computed in affine coordinates.
+const Pairing = `
+{{- $nbLines := "len(LoopCounter)"}}
+{{- if eq .Pairing.Family "bls12"}}{{$nbLines = "len(LoopCounter) - 1"}}{{end}}
+import (
+	"errors"
+
+	"{{.ImportPath}}/fp"
+	"{{.ImportPath}}/internal/fptower"
+)
+
+// GT target group of the pairing
+type GT = fptower.E12
+
+// Pair calculates the reduced pairing for a set of points
+// ∏ᵢ e(Pᵢ, Qᵢ).
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func Pair(P []G1Affine, Q []G2Affine) (GT, error) {
+	f, err := MillerLoop(P, Q)
+	if err != nil {
+		return GT{}, err
+	}
+	return FinalExponentiation(&f), nil
+}
+
+// PairingCheck calculates the reduced pairing for a set of points and returns True if the result is One
+// ∏ᵢ e(Pᵢ, Qᵢ) =? 1
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
+	f, err := Pair(P, Q)
+	if err != nil {
+		return false, err
+	}
+	var one GT
+	one.SetOne()
+	return f.Equal(&one), nil
+}
+
+// FinalExponentiation computes the exponentiation (∏ᵢ zᵢ)ᵈ
+// where d = (p¹²-1)/r = (p¹²-1)/Φ₁₂(p) ⋅ Φ₁₂(p)/r = (p⁶-1)(p²+1)(p⁴ - p² +1)/r.
+// The hard part (p⁴ - p² +1)/r is computed as a multi-exponentiation of the
+// Frobenius images of the easy part, by the base p digits of the exponent.
+func FinalExponentiation(z *GT, _z ...*GT) GT {
+
+	var result GT
+	result.Set(z)
+
+	for _, e := range _z {
+		result.Mul(&result, e)
+	}
+
+	var t GT
+
+	// Easy part
+	// (p⁶-1)(p²+1)
+	t.Conjugate(&result)
+	result.Inverse(&result)
+	t.Mul(&t, &result)
+	result.FrobeniusSquare(&t).Mul(&result, &t)
+
+	// Hard part
+	// (p⁴-p²+1)/r = h₀ + h₁p + h₂p² + h₃p³
+	// table[i-1] = ∏ⱼ πʲ(result) for the bits j set in i, where π is the Frobenius
+	var table [15]GT
+	table[0].Set(&result)
+	table[1].Frobenius(&result)
+	table[3].FrobeniusSquare(&result)
+	table[7].FrobeniusCube(&result)
+	for i := 3; i < 16; i++ {
+		if low := i & -i; low != i {
+			table[i-1].Mul(&table[low-1], &table[i-low-1])
+		}
+	}
+
+	nbBits := 0
+	for j := range finalExpHardPart {
+		if l := finalExpHardPart[j].BitLen(); l > nbBits {
+			nbBits = l
+		}
+	}
+	result.SetOne()
+	for b := nbBits - 1; b >= 0; b-- {
+		result.CyclotomicSquare(&result)
+		var i uint
+		for j := range finalExpHardPart {
+			i |= finalExpHardPart[j].Bit(b) << j
+		}
+		if i != 0 {
+			result.Mul(&result, &table[i-1])
+		}
+	}
+
+	return result
+}
+
+// MillerLoop computes the multi-Miller loop
+{{- if eq .Pairing.Family "bn"}}
+// ∏ᵢ MillerLoop(Pᵢ, Qᵢ) =
+// ∏ᵢ { fᵢ_{6x₀+2,Qᵢ}(Pᵢ) · ℓᵢ_{[6x₀+2]Qᵢ,π(Qᵢ)}(Pᵢ) · ℓᵢ_{[6x₀+2]Qᵢ+π(Qᵢ),-π²(Qᵢ)}(Pᵢ) }
+{{- else}}
+// ∏ᵢ MillerLoop(Pᵢ, Qᵢ) = ∏ᵢ { fᵢ_{x₀,Qᵢ}(Pᵢ) }
+{{- end}}
+//
+// The lines of the Qᵢ are computed with PrecomputeLines, and the pairs with a
+// point at infinity are skipped.
+func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// filter infinity points
+	p := make([]G1Affine, 0, n)
+	lines := make([][2][{{$nbLines}}]LineEvaluationAff, 0, n)
+	for k := 0; k < n; k++ {
+		if P[k].IsInfinity() || Q[k].IsInfinity() {
+			continue
+		}
+		p = append(p, P[k])
+		lines = append(lines, PrecomputeLines(Q[k]))
+	}
+	if len(p) == 0 {
+		var one GT
+		one.SetOne()
+		return one, nil
+	}
+
+	return MillerLoopFixedQ(p, lines)
+}
+
+// ----------------------
+// Fixed-argument pairing
+// ----------------------
+
+// LineEvaluationAff is a line through points of the twist, ℓ: y = λx + (y' - λx'),
+// stored as R0 = λ and R1 = λx' - y'.
+type LineEvaluationAff struct {
+	R0 fptower.E2
+	R1 fptower.E2
+}
+
+// PairFixedQ calculates the reduced pairing for a set of points
+// ∏ᵢ e(Pᵢ, Qᵢ) where Q are fixed points in G2.
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func PairFixedQ(P []G1Affine, lines [][2][{{$nbLines}}]LineEvaluationAff) (GT, error) {
+	f, err := MillerLoopFixedQ(P, lines)
+	if err != nil {
+		return GT{}, err
+	}
+	return FinalExponentiation(&f), nil
+}
+
+// PairingCheckFixedQ calculates the reduced pairing for a set of points and returns True if the result is One
+// ∏ᵢ e(Pᵢ, Qᵢ) =? 1 where Q are fixed points in G2.
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func PairingCheckFixedQ(P []G1Affine, lines [][2][{{$nbLines}}]LineEvaluationAff) (bool, error) {
+	f, err := PairFixedQ(P, lines)
+	if err != nil {
+		return false, err
+	}
+	var one GT
+	one.SetOne()
+	return f.Equal(&one), nil
+}
+
+// PrecomputeLines precomputes the lines for the fixed-argument Miller loop
+func PrecomputeLines(Q G2Affine) (PrecomputedLines [2][{{$nbLines}}]LineEvaluationAff) {
+	var accQ G2Affine
+	accQ.Set(&Q)
+	{{- if eq .Pairing.Family "bn"}}
+	var negQ G2Affine
+	negQ.Neg(&Q)
+	{{- end}}
+
+	n := len(LoopCounter)
+	for i := n - 2; i >= 0; i-- {
+		a := &Q
+		{{- if eq .Pairing.Family "bn"}}
+		if LoopCounter[i] == -1 {
+			a = &negQ
+		}
+		{{- end}}
+		switch {
+		case LoopCounter[i] == 0:
+			accQ.doubleStep(&PrecomputedLines[0][i])
+		case i == n-2:
+			// accQ = Q: doubleAndAddStep needs the chord through accQ and a
+			accQ.doubleStep(&PrecomputedLines[0][i])
+			accQ.addStep(&PrecomputedLines[1][i], a)
+		default:
+			accQ.doubleAndAddStep(&PrecomputedLines[0][i], &PrecomputedLines[1][i], a)
+		}
+	}
+	{{- if eq .Pairing.Family "bn"}}
+	{{- if .Pairing.LoopCounterNeg}}
+
+	// 6x₀+2 < 0: [6x₀+2]Q = -[|6x₀+2|]Q
+	accQ.Neg(&accQ)
+	{{- end}}
+
+	// π(Q) and -π²(Q)
+	var piQ, pi2Q G2Affine
+	piQ.X.Conjugate(&Q.X).Mul(&piQ.X, &endo.u)
+	piQ.Y.Conjugate(&Q.Y).Mul(&piQ.Y, &endo.v)
+	pi2Q.X.Conjugate(&piQ.X).Mul(&pi2Q.X, &endo.u)
+	pi2Q.Y.Conjugate(&piQ.Y).Mul(&pi2Q.Y, &endo.v).Neg(&pi2Q.Y)
+
+	accQ.addStep(&PrecomputedLines[1][n-1], &piQ)
+	accQ.addStep(&PrecomputedLines[0][n-1], &pi2Q)
+	{{- end}}
+
+	return PrecomputedLines
+}
+
+// MillerLoopFixedQ computes the multi-Miller loop as in MillerLoop
+// but Qᵢ are fixed points in G2 known in advance.
+func MillerLoopFixedQ(P []G1Affine, lines [][2][{{$nbLines}}]LineEvaluationAff) (GT, error) {
+	n := len(P)
+	if n == 0 || n != len(lines) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// no need to filter infinity points:
+	// 		1. if Pᵢ=(0,0) then -x/y=1/y=0 by gnark-crypto convention and so
+	// 		the lines evaluated at Pᵢ are in a proper subfield of 𝔽p¹², which
+	// 		is sent to 1 by FinalExponentiation.
+	//
+	// 		2. if Qᵢ=(0,0) then PrecomputeLines(Qᵢ) returns lines R0 and R1
+	// 		that are 0 because of gnark-convention (*/0==0) in doubleStep and
+	// 		addStep, with the same outcome.
+
+	yInv, xNegOverY := fixedLinesPrecomputations(P)
+
+	var result GT
+	result.SetOne()
+
+	{{- if eq .Pairing.Family "bn"}}
+
+	// Compute ∏ᵢ { fᵢ_{6x₀+2,Q}(P) }
+	{{- else}}
+
+	// Compute ∏ᵢ { fᵢ_{x₀,Q}(P) }
+	{{- end}}
+	for i := len(LoopCounter) - 2; i >= 0; i-- {
+		// mutualize the square among n Miller loops
+		// (∏ᵢfᵢ)²
+		result.Square(&result)
+
+		mulByFixedLines(&result, lines, yInv, xNegOverY, i)
+	}
+	{{- if .Pairing.LoopCounterNeg}}
+
+	// the seed is negative: f_{-k,Q} = 1/f_{k,Q} up to vertical lines, and
+	// 1/f is the conjugate of f after the final exponentiation
+	result.Conjugate(&result)
+	{{- end}}
+	{{- if eq .Pairing.Family "bn"}}
+
+	// Compute  ∏ᵢ { ℓᵢ_{[6x₀+2]Q,π(Q)}(P) · ℓᵢ_{[6x₀+2]Q+π(Q),-π²(Q)}(P) }
+	mulByFixedLines(&result, lines, yInv, xNegOverY, len(LoopCounter)-1)
+	{{- end}}
+
+	return result, nil
+}
+
+func (p *G2Affine) doubleStep(evaluations *LineEvaluationAff) {
+
+	var n, d, λ, xr, yr fptower.E2
+	// λ = 3x²/2y
+	n.Square(&p.X)
+	λ.Double(&n).
+		Add(&λ, &n)
+	d.Double(&p.Y)
+	λ.Div(&λ, &d)
+
+	// xr = λ²-2x
+	xr.Square(&λ).
+		Sub(&xr, &p.X).
+		Sub(&xr, &p.X)
+
+	// yr = λ(x-xr)-y
+	yr.Sub(&p.X, &xr).
+		Mul(&yr, &λ).
+		Sub(&yr, &p.Y)
+
+	evaluations.R0.Set(&λ)
+	evaluations.R1.Mul(&λ, &p.X).
+		Sub(&evaluations.R1, &p.Y)
+
+	p.X.Set(&xr)
+	p.Y.Set(&yr)
+}
+
+func (p *G2Affine) addStep(evaluations *LineEvaluationAff, a *G2Affine) {
+	var n, d, λ, λλ, xr, yr fptower.E2
+
+	// compute λ = (y2-y1)/(x2-x1)
+	n.Sub(&a.Y, &p.Y)
+	d.Sub(&a.X, &p.X)
+	λ.Div(&n, &d)
+
+	// xr = λ²-x1-x2
+	λλ.Square(&λ)
+	n.Add(&p.X, &a.X)
+	xr.Sub(&λλ, &n)
+
+	// yr = λ(x1-xr) - y1
+	yr.Sub(&p.X, &xr).
+		Mul(&yr, &λ).
+		Sub(&yr, &p.Y)
+
+	evaluations.R0.Set(&λ)
+	evaluations.R1.Mul(&λ, &p.X).
+		Sub(&evaluations.R1, &p.Y)
+
+	p.X.Set(&xr)
+	p.Y.Set(&yr)
+}
+
+func (p *G2Affine) doubleAndAddStep(evaluations1, evaluations2 *LineEvaluationAff, a *G2Affine) {
+	var n, d, l1, x3, l2, x4, y4 fptower.E2
+
+	// compute λ1 = (y2-y1)/(x2-x1)
+	n.Sub(&p.Y, &a.Y)
+	d.Sub(&p.X, &a.X)
+	l1.Div(&n, &d)
+
+	// compute x3 =λ1²-x1-x2
+	x3.Square(&l1)
+	x3.Sub(&x3, &p.X)
+	x3.Sub(&x3, &a.X)
+
+	// omit y3 computation
+
+	// compute line1
+	evaluations1.R0.Set(&l1)
+	evaluations1.R1.Mul(&l1, &p.X)
+	evaluations1.R1.Sub(&evaluations1.R1, &p.Y)
+
+	// compute λ2 = -λ1-2y1/(x3-x1)
+	n.Double(&p.Y)
+	d.Sub(&x3, &p.X)
+	l2.Div(&n, &d)
+	l2.Add(&l2, &l1)
+	l2.Neg(&l2)
+
+	// compute x4 = λ2²-x1-x3
+	x4.Square(&l2)
+	x4.Sub(&x4, &p.X)
+	x4.Sub(&x4, &x3)
+
+	// compute y4 = λ2(x1 - x4)-y1
+	y4.Sub(&p.X, &x4)
+	y4.Mul(&l2, &y4)
+	y4.Sub(&y4, &p.Y)
+
+	// compute line2
+	evaluations2.R0.Set(&l2)
+	evaluations2.R1.Mul(&l2, &p.X)
+	evaluations2.R1.Sub(&evaluations2.R1, &p.Y)
+
+	p.X.Set(&x4)
+	p.Y.Set(&y4)
+}
+
+// fixedLinesPrecomputations returns 1/y and -x/y for the points P, used to
+// evaluate the precomputed lines at P.
+func fixedLinesPrecomputations(P []G1Affine) (yInv, xNegOverY []fp.Element) {
+	n := len(P)
+	yInv = make([]fp.Element, n)
+	xNegOverY = make([]fp.Element, n)
+	for k := 0; k < n; k++ {
+		yInv[k].Set(&P[k].Y)
+	}
+	yInv = fp.BatchInvert(yInv)
+	for k := 0; k < n; k++ {
+		xNegOverY[k].Mul(&P[k].X, &yInv[k]).
+			Neg(&xNegOverY[k])
+	}
+	return
+}
+
+// mulByFixedLines multiplies result by the precomputed lines of index i, evaluated
+// at the points given by fixedLinesPrecomputations. The lines are not modified.
+func mulByFixedLines(result *GT, lines [][2][{{$nbLines}}]LineEvaluationAff, yInv, xNegOverY []fp.Element, i int) {
+	for k := range lines {
+		mulByLine(result, &lines[k][0][i], &yInv[k], &xNegOverY[k])
+		{{- if eq .Pairing.Family "bn"}}
+		// the last index holds the two lines of the Frobenius steps
+		{{- end}}
+		if LoopCounter[i] != 0 {
+			mulByLine(result, &lines[k][1][i], &yInv[k], &xNegOverY[k])
+		}
+	}
+}
+
+// mulByLine multiplies result by the line l evaluated at P = (x, y), given 1/y and -x/y.
+func mulByLine(result *GT, l *LineEvaluationAff, yInv, xNegOverY *fp.Element) {
+	var r0, r1 E2
+	r0.MulByElement(&l.R0, xNegOverY)
+	r1.MulByElement(&l.R1, yInv)
+	{{- if eq .Pairing.Twist "D"}}
+	// the untwisted line divided by y is 1 - (λx/y)·w + ((λx'-y')/y)·w³
+	result.MulBy34(&r0, &r1)
+	{{- else}}
+	// the untwisted line multiplied by w³/y is (λx'-y')/y - (λx/y)·w² + w³
+	result.MulBy01(&r1, &r0)
+	{{- end}}
+}
+`
+
+// PairingTest is the template of the tests of the pairing.
+const PairingTest = `
+{{- $nbLines := "len(LoopCounter)"}}
+{{- if eq .Pairing.Family "bls12"}}{{$nbLines = "len(LoopCounter) - 1"}}{{end}}
+import (
+	"math/big"
+	"testing"
+
+	"{{.ImportPath}}/fp"
+	"{{.ImportPath}}/fr"
+	{{- if not .HasMarshal}}
+	"{{.ImportPath}}/internal/fptower"
+	{{- end}}
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestPairing(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE12()
+	genR1 := GenFr()
+	genR2 := GenFr()
+
+	properties.Property("[{{toUpper .Name}}] Having the receiver as operand (final expo) should output the same result", prop.ForAll(
+		func(a GT) bool {
+			b := FinalExponentiation(&a)
+			a = FinalExponentiation(&a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{toUpper .Name}}] Exponentiating FinalExpo(a) to r should output 1", prop.ForAll(
+		func(a GT) bool {
+			b := FinalExponentiation(&a)
+			return !a.IsInSubGroup() && b.IsInSubGroup()
+		},
+		genA,
+	))
+
+	properties.Property("[{{toUpper .Name}}] Exp, CyclotomicExp and ExpGLV results must be the same in GT (small and big exponents)", prop.ForAll(
+		func(a GT, e fr.Element) bool {
+			a = FinalExponentiation(&a)
+
+			var _e big.Int
+			e.BigInt(&_e)
+			res := true
+
+			// exponent < r, then exponent > r
+			for i := 0; i < 2; i++ {
+				var b, c, d GT
+				b.Exp(a, &_e)
+				c.ExpGLV(a, &_e)
+				d.CyclotomicExp(a, &_e)
+				res = res && b.Equal(&c) && c.Equal(&d)
+				_e.Mul(&_e, &_e)
+			}
+
+			return res
+		},
+		genA,
+		genR1,
+	))
+
+	properties.Property("[{{toUpper .Name}}] Expt(Expt) and Exp(t^2) should output the same result in the cyclotomic subgroup", prop.ForAll(
+		func(a GT) bool {
+			var b, c, d GT
+			b.Conjugate(&a)
+			a.Inverse(&a)
+			b.Mul(&b, &a)
+
+			a.FrobeniusSquare(&b).
+				Mul(&a, &b)
+
+			c.Expt(&a).Expt(&c)
+			d.Exp(a, &xGen).Exp(d, &xGen)
+			return c.Equal(&d)
+		},
+		genA,
+	))
+
+	properties.Property("[{{toUpper .Name}}] bilinearity", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var res, resa, resb, resab, zero GT
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint, ab big.Int
+
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+			ab.Mul(&abigint, &bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			res, _ = Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+			resa, _ = Pair([]G1Affine{ag1}, []G2Affine{g2GenAff})
+			resb, _ = Pair([]G1Affine{g1GenAff}, []G2Affine{bg2})
+
+			resab.Exp(res, &ab)
+			resa.Exp(resa, &bbigint)
+			resb.Exp(resb, &abigint)
+
+			var one GT
+			one.SetOne()
+
+			return resab.Equal(&resa) && resab.Equal(&resb) && !res.Equal(&zero) && !res.Equal(&one)
+
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[{{toUpper .Name}}] PairingCheck", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var g1GenAffNeg G1Affine
+			g1GenAffNeg.Neg(&g1GenAff)
+			tabP := []G1Affine{g1GenAff, g1GenAffNeg}
+			tabQ := []G2Affine{g2GenAff, g2GenAff}
+
+			res, _ := PairingCheck(tabP, tabQ)
+
+			return res
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[{{toUpper .Name}}] Pair should output the same result with MillerLoop or MillerLoopFixedQ", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			P := []G1Affine{g1GenAff, ag1}
+			Q := []G2Affine{g2GenAff, bg2}
+
+			ml1, _ := MillerLoop(P, Q)
+			ml2, _ := MillerLoopFixedQ(
+				P,
+				[][2][{{$nbLines}}]LineEvaluationAff{
+					PrecomputeLines(Q[0]),
+					PrecomputeLines(Q[1]),
+				})
+
+			res1 := FinalExponentiation(&ml1)
+			res2 := FinalExponentiation(&ml2)
+
+			return res1.Equal(&res2)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[{{toUpper .Name}}] MillerLoop should skip pairs with a point at infinity", prop.ForAll(
+		func(a fr.Element) bool {
+
+			var ag1, g1Inf G1Affine
+			var g2Inf G2Affine
+
+			var abigint big.Int
+			a.BigInt(&abigint)
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+
+			res1, _ := Pair([]G1Affine{ag1}, []G2Affine{g2GenAff})
+			res2, _ := Pair([]G1Affine{ag1, g1Inf, ag1}, []G2Affine{g2GenAff, g2GenAff, g2Inf})
+
+			return res1.Equal(&res2)
+		},
+		genR1,
+	))
+
+	properties.Property("[{{toUpper .Name}}] ψ should be the multiplication by p on G2", prop.ForAll(
+		func(a fr.Element) bool {
+
+			var abigint, pmodr big.Int
+			a.BigInt(&abigint)
+			pmodr.Mod(fp.Modulus(), fr.Modulus())
+
+			var q, res1, res2 G2Jac
+			q.ScalarMultiplication(&g2Gen, &abigint)
+			res1.psi(&q)
+			res2.ScalarMultiplication(&q, &pmodr)
+
+			return res1.Equal(&res2)
+		},
+		genR1,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+{{- if not .HasMarshal}}
+
+// GenE2 generates an fptower.E2 elmt
+func GenE2() gopter.Gen {
+	return gopter.CombineGens(
+		GenFp(),
+		GenFp(),
+	).Map(func(values []interface{}) fptower.E2 {
+		return fptower.E2{A0: values[0].(fp.Element), A1: values[1].(fp.Element)}
+	})
+}
+
+// GenE6 generates an fptower.E6 elmt
+func GenE6() gopter.Gen {
+	return gopter.CombineGens(
+		GenE2(),
+		GenE2(),
+		GenE2(),
+	).Map(func(values []interface{}) fptower.E6 {
+		return fptower.E6{B0: values[0].(fptower.E2), B1: values[1].(fptower.E2), B2: values[2].(fptower.E2)}
+	})
+}
+
+// GenE12 generates an fptower.E12 elmt
+func GenE12() gopter.Gen {
+	return gopter.CombineGens(
+		GenE6(),
+		GenE6(),
+	).Map(func(values []interface{}) fptower.E12 {
+		return fptower.E12{C0: values[0].(fptower.E6), C1: values[1].(fptower.E6)}
+	})
+}
+{{- end}}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkPairing(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+	}
+}
+
+func BenchmarkFinalExponentiation(b *testing.B) {
+
+	var a GT
+	a.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FinalExponentiation(&a)
+	}
+
+}
+`
+
+// E2 is the template of the arithmetic of 𝔽p² = 𝔽p[u]/(u²-β) which depends on β
+// and on the non-residue ξ of 𝔽p⁶ = 𝔽p²[v]/(v³-ξ).
+const E2 = `
+import (
+	"{{.ImportPath}}/fp"
+)
+
+// declaring nonResidue and nonResidueInv as globals makes MulByNonResidue and
+// MulByNonResidueInv inlinable
+
+// nonResidue is ξ = ({{index .Pairing.Xi 0}},{{index .Pairing.Xi 1}}), such that 𝔽p⁶ = 𝔽p²[v]/(v³-ξ)
+var nonResidue E2
+
+// nonResidueInv is ξ⁻¹
+var nonResidueInv E2
+
+{{- if not .Pairing.BetaIsMinusOne}}
+
+// beta is β, such that 𝔽p² = 𝔽p[u]/(u²-β)
+var beta fp.Element
+{{- end}}
+
+func init() {
+	nonResidue.SetString("{{index .Pairing.Xi 0}}", "{{index .Pairing.Xi 1}}")
+	nonResidueInv.SetString("{{index .Pairing.XiInv 0}}", "{{index .Pairing.XiInv 1}}")
+	{{- if not .Pairing.BetaIsMinusOne}}
+	beta.SetString("{{.Pairing.Beta}}")
+	{{- end}}
+}
+
+// mulGenericE2 sets z to the E2-product of x,y, returns z
+func mulGenericE2(z, x, y *E2) {
+	var a, b, c fp.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	{{- if .Pairing.BetaIsMinusOne}}
+	z.A0.Sub(&b, &c) // z.A0.MulByNonResidue(&c).Add(&z.A0, &b)
+	{{- else}}
+	z.A0.Mul(&c, &beta).Add(&z.A0, &b)
+	{{- end}}
+}
+
+// squareGenericE2 sets z to the E2-product of x,x returns z
+func squareGenericE2(z, x *E2) {
+	{{- if .Pairing.BetaIsMinusOne}}
+	// adapted from algo 22 https://eprint.iacr.org/2010/354.pdf
+	var a, b fp.Element
+	a.Add(&x.A0, &x.A1)
+	b.Sub(&x.A0, &x.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &x.A1).Double(&b)
+	z.A0.Set(&a)
+	z.A1.Set(&b)
+	{{- else}}
+	var a, b fp.Element
+	a.Square(&x.A0)
+	b.Square(&x.A1).Mul(&b, &beta)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&a, &b)
+	{{- end}}
+}
+
+// Mul sets z to the E2-product of x,y, returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	mulGenericE2(z, x, y)
+	return z
+}
+
+// Square sets z to the E2-product of x,x returns z
+func (z *E2) Square(x *E2) *E2 {
+	squareGenericE2(z, x)
+	return z
+}
+
+// MulByNonResidue multiplies a E2 by ξ
+func (z *E2) MulByNonResidue(x *E2) *E2 {
+	z.Mul(x, &nonResidue)
+	return z
+}
+
+// MulByNonResidueInv multiplies a E2 by ξ⁻¹
+func (z *E2) MulByNonResidueInv(x *E2) *E2 {
+	z.Mul(x, &nonResidueInv)
+	return z
+}
+
+// Inverse sets z to the E2-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) Inverse(x *E2) *E2 {
+	// Algorithm 8 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1 fp.Element
+	x.norm(&t0)
+	t1.Inverse(&t0)
+	z.A0.Mul(&x.A0, &t1)
+	z.A1.Mul(&x.A1, &t1).Neg(&z.A1)
+
+	return z
+}
+
+// MulCT sets z to the E2-product of x,y in constant time, returns z
+func (z *E2) MulCT(x, y *E2) *E2 {
+	var a, b, c fp.Element
+	a.AddCT(&x.A0, &x.A1)
+	b.AddCT(&y.A0, &y.A1)
+	a.MulCT(&a, &b)
+	b.MulCT(&x.A0, &y.A0)
+	c.MulCT(&x.A1, &y.A1)
+	z.A1.SubCT(&a, &b).SubCT(&z.A1, &c)
+	{{- if .Pairing.BetaIsMinusOne}}
+	z.A0.SubCT(&b, &c)
+	{{- else}}
+	z.A0.MulCT(&c, &beta).AddCT(&z.A0, &b)
+	{{- end}}
+	return z
+}
+
+// SquareCT sets z to the E2-product of x,x in constant time, returns z
+func (z *E2) SquareCT(x *E2) *E2 {
+	return z.MulCT(x, x)
+}
+
+// InverseCT sets z to the E2-inverse of x in constant time, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) InverseCT(x *E2) *E2 {
+	// Algorithm 8 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1 fp.Element
+	t0.SquareCT(&x.A0)
+	t1.SquareCT(&x.A1)
+	{{- if .Pairing.BetaIsMinusOne}}
+	t0.AddCT(&t0, &t1)
+	{{- else}}
+	t1.MulCT(&t1, &beta)
+	t0.SubCT(&t0, &t1)
+	{{- end}}
+	t1.InverseCT(&t0)
+	z.A0.MulCT(&x.A0, &t1)
+	z.A1.MulCT(&x.A1, &t1).NegCT(&z.A1)
+
+	return z
+}
+
+// norm sets x to the norm of z, A0² - β·A1²
+func (z *E2) norm(x *fp.Element) {
+	var tmp fp.Element
+	x.Square(&z.A0)
+	tmp.Square(&z.A1)
+	{{- if .Pairing.BetaIsMinusOne}}
+	x.Add(x, &tmp)
+	{{- else}}
+	tmp.Mul(&tmp, &beta)
+	x.Sub(x, &tmp)
+	{{- end}}
+}
+`
+
+// Frobenius is the template of the Frobenius maps of 𝔽p¹².
+const Frobenius = `
+// frobeniusCoeffs[i-1][k-1] = ξ^(k(pⁱ-1)/6), for i = 1, 2, 3 and k = 1, …, 5
+var frobeniusCoeffs [3][5]E2
+
+func init() {
+	{{- range $i, $c := .Pairing.Frobenius}}
+	{{- range $k, $v := $c}}
+	frobeniusCoeffs[{{$i}}][{{$k}}].SetString("{{index $v 0}}", "{{index $v 1}}")
+	{{- end}}
+	{{- end}}
+}
+
+// Frobenius set z to Frobenius(x), return z
+func (z *E12) Frobenius(x *E12) *E12 {
+	return z.frobenius(x, 1)
+}
+
+// FrobeniusSquare set z to Frobenius^2(x), and return z
+func (z *E12) FrobeniusSquare(x *E12) *E12 {
+	return z.frobenius(x, 2)
+}
+
+// FrobeniusCube set z to Frobenius^3(x), return z
+func (z *E12) FrobeniusCube(x *E12) *E12 {
+	return z.frobenius(x, 3)
+}
+
+// frobenius sets z to x^(pⁱ) and returns z, for i = 1, 2, 3. For x = Σₖ aₖwᵏ,
+// x^(pⁱ) = Σₖ aₖ^(pⁱ)·ξ^(k(pⁱ-1)/6)·wᵏ since w⁶ = ξ, and the Frobenius acts on 𝔽p² by
+// conjugation.
+func (z *E12) frobenius(x *E12, i int) *E12 {
+	// coefficients of w⁰, …, w⁵
+	a := [6]*E2{&x.C0.B0, &x.C1.B0, &x.C0.B1, &x.C1.B1, &x.C0.B2, &x.C1.B2}
+	c := [6]*E2{&z.C0.B0, &z.C1.B0, &z.C0.B1, &z.C1.B1, &z.C0.B2, &z.C1.B2}
+	for k := 0; k < 6; k++ {
+		if i%2 == 1 {
+			c[k].Conjugate(a[k])
+		} else {
+			c[k].Set(a[k])
+		}
+		if k > 0 {
+			c[k].Mul(c[k], &frobeniusCoeffs[i-1][k-1])
+		}
+	}
+	return z
+}
+`
+
+// E12Pairing is the template of the operations of 𝔽p¹² used by the pairing.
+const E12Pairing = `
+func (z *E12) nSquareCompressed(n int) {
+	for i := 0; i < n; i++ {
+		z.CyclotomicSquareCompressed(z)
+	}
+}
+
+// Expt set z to xᵗ (mod q¹²) and return z (t is the generator of the curve)
+//
+// x must be in the cyclotomic subgroup.
+func (z *E12) Expt(x *E12) *E12 {
+	return z.CyclotomicExp(*x, &xGen)
+}
+{{- if eq .Pairing.Twist "D"}}
+
+// MulBy34 multiplication by sparse element (1,0,0,c3,c4,0)
+func (z *E12) MulBy34(c3, c4 *E2) *E12 {
+
+	var a, b, d E6
+
+	a.Set(&z.C0)
+
+	b.Set(&z.C1)
+	b.MulBy01(c3, c4)
+
+	var d0 E2
+	d0.SetOne().Add(&d0, c3)
+	d.Add(&z.C0, &z.C1)
+	d.MulBy01(&d0, c4)
+
+	z.C1.Add(&a, &b).Neg(&z.C1).Add(&z.C1, &d)
+	z.C0.MulByNonResidue(&b).Add(&z.C0, &a)
+
+	return z
+}
+{{- else}}
+
+// MulBy01 multiplication by sparse element (c0, c1, 0, 0, 1)
+func (z *E12) MulBy01(c0, c1 *E2) *E12 {
+
+	var a, b E6
+	var d E2
+
+	a.Set(&z.C0)
+	a.MulBy01(c0, c1)
+
+	b.MulByNonResidue(&z.C1)
+	d.SetOne().Add(c1, &d)
+
+	z.C1.Add(&z.C1, &z.C0)
+	z.C1.MulBy01(c0, &d)
+	z.C1.Sub(&z.C1, &a)
+	z.C1.Sub(&z.C1, &b)
+	z.C0.MulByNonResidue(&b)
+	z.C0.Add(&z.C0, &a)
+
+	return z
+}
+{{- end}}
+`
+
+// Parameters is the template of the parameters of the curve used by the tower.
+const Parameters = `
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"{{.ImportPath}}/fr"
+)
+
+// seed x₀ of the curve
+var xGen big.Int
+
+// glvBasis stores R-linearly independent vectors (a,b), (c,d)
+// in ker((u,v) → u+vλ[r]), where λ = p mod r is the eigenvalue of the
+// Frobenius on GT
+var glvBasis ecc.Lattice
+
+func init() {
+	xGen.SetString("{{.Pairing.X}}", 10)
+	var lambda big.Int
+	lambda.SetString("{{.Pairing.FrobeniusEigenvalue}}", 10)
+	ecc.PrecomputeLattice(fr.Modulus(), &lambda, &glvBasis)
+}
+`
+
+// GeneratorsTest is the template of the gopter generators of the tower tests.
+const GeneratorsTest = `
+import (
+	"{{.ImportPath}}/fp"
+	"github.com/leanovate/gopter"
+)
+
+// GenFp generates an Fp element
+func GenFp() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fp.Element
+
+		if _, err := elmt.SetRandom(); err != nil {
+			panic(err)
+		}
+		genResult := gopter.NewGenResult(elmt, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// GenE2 generates an E2 elmt
+func GenE2() gopter.Gen {
+	return gopter.CombineGens(
+		GenFp(),
+		GenFp(),
+	).Map(func(values []interface{}) *E2 {
+		return &E2{A0: values[0].(fp.Element), A1: values[1].(fp.Element)}
+	})
+}
+
+// GenE6 generates an E6 elmt
+func GenE6() gopter.Gen {
+	return gopter.CombineGens(
+		GenE2(),
+		GenE2(),
+		GenE2(),
+	).Map(func(values []interface{}) *E6 {
+		return &E6{B0: *values[0].(*E2), B1: *values[1].(*E2), B2: *values[2].(*E2)}
+	})
+}
+
+// GenE12 generates an E12 elmt
+func GenE12() gopter.Gen {
+	return gopter.CombineGens(
+		GenE6(),
+		GenE6(),
+	).Map(func(values []interface{}) *E12 {
+		return &E12{C0: *values[0].(*E6), C1: *values[1].(*E6)}
+	})
+}
+`
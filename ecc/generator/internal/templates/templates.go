@@ -0,0 +1,312 @@
+// Package templates contains the templates of the files of a curve package which
+// are hand-written for the curves of gnark-crypto.
+package templates
+
+// Doc is the doc.go template of the curve package.
+const Doc = `
+// Package {{.Package}} efficient elliptic curve implementation for {{.Name}}.
+//
+// {{.Name}}: A {{if eq .A "0"}}j=0 {{end}}curve with
+//
+//	𝔽r: r={{.R}}
+//	𝔽p: p={{.P}}
+//	(E/𝔽p): Y²=X³{{if ne .A "0"}}+{{.A}}X{{end}}+{{.B}}
+{{- if .Pairing}}
+//	(Eₜ/𝔽p²): Y²=X³+b' ({{.Pairing.Twist}}-type twist)
+//	r ∣ #E(Fp) and r ∣ #Eₜ(𝔽p²)
+//
+// {{.Name}} is a {{if eq .Pairing.Family "bn"}}Barreto--Naehrig{{else}}Barreto--Lynn--Scott{{end}} curve of embedding degree 12 with seed x₀={{.Pairing.X}}.
+//
+// Extension fields tower:
+//
+//	𝔽p²[u] = 𝔽p/u²{{if .Pairing.BetaIsMinusOne}}+1{{else}}-β, β={{.Pairing.Beta}}{{end}}
+//	𝔽p⁶[v] = 𝔽p²/v³-ξ, ξ={{index .Pairing.Xi 0}}+{{index .Pairing.Xi 1}}u
+//	𝔽p¹²[w] = 𝔽p⁶/w²-v
+//
+// optimal Ate loop size:
+//
+//	{{if eq .Pairing.Family "bn"}}6x₀+2{{else}}x₀{{end}}
+{{- end}}
+//
+// Security: estimated {{.Security}}-bit level using Pollard's \rho attack
+// (r is {{.RBits}} bits)
+{{- if .Pairing}}
+// on the curve; the discrete logarithm in 𝔽p¹² may be easier, see
+// [https://eprint.iacr.org/2019/885.pdf]
+{{- end}}
+//
+// # Fixed-base scalar multiplication
+//
+// G1FixedBaseTable stores the multiples of a point which is used as a base many times,
+// with a window size trading memory for speed. ScalarMultiplicationBase uses such a
+// table for the generator, computed on first use.
+//
+// # Constant time
+//
+// ScalarMultiplicationCT, ScalarMultiplicationBaseCT and
+// ScalarMultiplicationFixedBaseCT don't branch on the scalar nor access memory
+// depending on it, and should be used for secret scalars. The other scalar
+// multiplications are faster but not constant time.
+//
+// # Warning
+//
+// This code has been generated from a description of the curve, and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package {{.Package}}
+`
+
+// Curve is the template of the file holding the parameters of the curve.
+const Curve = `
+import (
+	{{- if or .GLV .CofactorCleaning .Pairing}}
+	"math/big"
+	{{- end}}
+
+	{{- if .GLV}}
+	"github.com/consensys/gnark-crypto/ecc"
+	{{- end}}
+	"{{.ImportPath}}/fp"
+	{{- if .GLV}}
+	"{{.ImportPath}}/fr"
+	{{- end}}
+	{{- if .Pairing}}
+	"{{.ImportPath}}/internal/fptower"
+	{{- end}}
+)
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+{{- if .Pairing}}
+
+// bTwistCurveCoeff b coeff of the twist (defined over 𝔽p²) curve
+var bTwistCurveCoeff fptower.E2
+
+// generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+var g1Gen G1Jac
+var g2Gen G2Jac
+
+var g1GenAff G1Affine
+var g2GenAff G2Affine
+
+// point at infinity
+var g1Infinity G1Jac
+var g2Infinity G2Jac
+{{- else}}
+
+// generator of the r-torsion group
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+{{- end}}
+
+{{- if .CofactorCleaning}}
+
+// cofactorG1 is the index of the r-torsion group in E(𝔽p)
+var cofactorG1 big.Int
+{{- end}}
+
+{{- if .Pairing}}
+
+// cofactorG2 is the index of the r-torsion group in E'(𝔽p²)
+var cofactorG2 big.Int
+
+// optimal Ate loop counter
+var LoopCounter [{{len .Pairing.LoopCounter}}]int8
+
+// endo ψ o π o ψ⁻¹, where ψ:E → E' is the degree 6 iso defined over 𝔽p¹²
+var endo struct {
+	u fptower.E2
+	v fptower.E2
+}
+
+// seed x₀ of the curve
+var xGen big.Int
+
+// finalExpHardPart holds the base p digits of (p⁴-p²+1)/r, the exponent of the
+// hard part of the final exponentiation
+var finalExpHardPart [4]big.Int
+{{- end}}
+
+{{- if .GLV}}
+
+// Parameters useful for the GLV scalar multiplication. The third roots define the
+// endomorphisms ϕ₁ for <G1Affine>. lambda is such that <r, ϕ-λ> lies above
+// <r> in the ring Z[ϕ]. More concretely it's the associated eigenvalue
+// of ϕ₁ restricted to <G1Affine>
+// see https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independent vectors (a,b), (c,d)
+// in ker((u,v) → u+vλ[r]), and their determinant
+var glvBasis ecc.Lattice
+{{- end}}
+
+func init() {
+	{{- if eq .A "0"}}
+	aCurveCoeff.SetUint64(0)
+	{{- else}}
+	aCurveCoeff.SetString("{{.A}}")
+	{{- end}}
+	bCurveCoeff.SetString("{{.B}}")
+
+	g1Gen.X.SetString("{{.Gx}}")
+	g1Gen.Y.SetString("{{.Gy}}")
+	g1Gen.Z.SetOne()
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+
+	{{- if .Pairing}}
+
+	bTwistCurveCoeff.SetString("{{index .Pairing.BTwist 0}}", "{{index .Pairing.BTwist 1}}")
+
+	g2Gen.X.SetString("{{index .Pairing.G2X 0}}",
+		"{{index .Pairing.G2X 1}}")
+	g2Gen.Y.SetString("{{index .Pairing.G2Y 0}}",
+		"{{index .Pairing.G2Y 1}}")
+	g2Gen.Z.SetString("1", "0")
+
+	g2GenAff.FromJacobian(&g2Gen)
+
+	g2Infinity.X.SetOne()
+	g2Infinity.Y.SetOne()
+
+	cofactorG2.SetString("{{.Pairing.CofactorG2}}", 10)
+
+	endo.u.SetString("{{index .Pairing.EndoU 0}}",
+		"{{index .Pairing.EndoU 1}}")
+	endo.v.SetString("{{index .Pairing.EndoV 0}}",
+		"{{index .Pairing.EndoV 1}}")
+
+	{{- if eq .Pairing.Family "bn"}}
+
+	// 2-NAF decomposition of |6x₀+2| little endian
+	{{- else}}
+
+	// binary decomposition of |x₀| little endian
+	{{- end}}
+	LoopCounter = [{{len .Pairing.LoopCounter}}]int8{ {{- range $i, $d := .Pairing.LoopCounter}}{{if $i}}, {{end}}{{$d}}{{end -}} }
+
+	xGen.SetString("{{.Pairing.X}}", 10)
+
+	{{- range $i, $h := .Pairing.HardPart}}
+	finalExpHardPart[{{$i}}].SetString("{{$h}}", 10)
+	{{- end}}
+	{{- end}}
+
+	{{- if .CofactorCleaning}}
+
+	cofactorG1.SetString("{{.Cofactor}}", 10)
+	{{- end}}
+
+	{{- if .GLV}}
+
+	thirdRootOneG1.SetString("{{.ThirdRootOne}}")
+	lambdaGLV.SetString("{{.Lambda}}", 10)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+	{{- end}}
+}
+
+{{- if .Pairing}}
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Affine, g2Aff G2Affine) {
+	g1Aff = g1GenAff
+	g2Aff = g2GenAff
+	g1Jac = g1Gen
+	g2Jac = g2Gen
+	return
+}
+{{- else}}
+// Generators return the generators of the r-torsion group
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
+{{- end}}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
+{{- if .Pairing}}
+
+// expose the tower
+
+// 𝔽p²
+type E2 = fptower.E2
+
+// 𝔽p⁶
+type E6 = fptower.E6
+
+// 𝔽p¹²
+type E12 = fptower.E12
+{{- end}}
+`
+
+// Parallel is the template of the internal/parallel package of the curve package,
+// a copy of gnark-crypto's one, which is not importable from another module.
+const Parallel = `
+import (
+	"runtime"
+	"sync"
+)
+
+// Execute process in parallel the work function
+func Execute(nbIterations int, work func(int, int), maxCpus ...int) {
+
+	nbTasks := runtime.NumCPU()
+	if len(maxCpus) == 1 {
+		nbTasks = maxCpus[0]
+		if nbTasks < 1 {
+			nbTasks = 1
+		} else if nbTasks > 512 {
+			nbTasks = 512
+		}
+	}
+
+	if nbTasks == 1 {
+		// no go routines
+		work(0, nbIterations)
+		return
+	}
+
+	nbIterationsPerCpus := nbIterations / nbTasks
+
+	// more CPUs than tasks: a CPU will work on exactly one iteration
+	if nbIterationsPerCpus < 1 {
+		nbIterationsPerCpus = 1
+		nbTasks = nbIterations
+	}
+
+	var wg sync.WaitGroup
+
+	extraTasks := nbIterations - (nbTasks * nbIterationsPerCpus)
+	extraTasksOffset := 0
+
+	for i := 0; i < nbTasks; i++ {
+		wg.Add(1)
+		_start := i*nbIterationsPerCpus + extraTasksOffset
+		_end := _start + nbIterationsPerCpus
+		if extraTasks > 0 {
+			_end++
+			extraTasks--
+			extraTasksOffset++
+		}
+		go func() {
+			work(_start, _end)
+			wg.Done()
+		}()
+	}
+
+	wg.Wait()
+}
+`
@@ -0,0 +1,362 @@
+package generator
+
+import (
+	"math/big"
+)
+
+// Pairing describes the pairing-friendly structure of a curve of the BN or BLS12
+// families: the seed x₀ giving p and r, the tower
+//
+//	𝔽p² = 𝔽p[u]/(u²-β), 𝔽p⁶ = 𝔽p²[v]/(v³-ξ), 𝔽p¹² = 𝔽p⁶[w]/(w²-v)
+//
+// and the sextic twist E' of E over 𝔽p² holding G2. Integers are given as in Curve.
+type Pairing struct {
+	Family   string    // "bn" or "bls12"
+	X        string    // seed x₀ of the family
+	Beta     string    // β, a quadratic non-residue of 𝔽p; must be -1 if p ≡ 3 mod 4
+	Xi       [2]string // ξ = ξ₀+ξ₁u, neither a square nor a cube in 𝔽p²
+	Twist    string    // "D" for E': Y² = X³ + b/ξ, "M" for E': Y² = X³ + b·ξ
+	G2X, G2Y [2]string // coordinates of the generator of G2 ⊂ E'(𝔽p²)
+}
+
+// pairingData is the data used to execute the templates of the pairing and of the
+// parts of the tower which depend on the non-residues. Elements of 𝔽p² are stored
+// as [A0, A1].
+type pairingData struct {
+	Family, Twist       string
+	X                   string // seed x₀
+	Beta                string // β mod p
+	BetaIsMinusOne      bool   // β = -1, which saves a multiplication in 𝔽p²
+	Xi, XiInv           [2]string
+	BTwist              [2]string // b coefficient of E'
+	G2X, G2Y            [2]string
+	CofactorG2          string          // index of G2 in E'(𝔽p²)
+	EndoU, EndoV        [2]string       // ψ(x,y) = (u·x̄, v·ȳ) is the Frobenius of E on E'
+	Frobenius           [3][5][2]string // Frobenius[i-1][k-1] = ξ^(k(pⁱ-1)/6)
+	LoopCounter         []int           // NAF of |6x₀+2| (bn) or bits of |x₀| (bls12), little endian
+	LoopCounterNeg      bool            // 6x₀+2 < 0 (bn) or x₀ < 0 (bls12)
+	HardPart            [4]string       // base p digits of (p⁴-p²+1)/r
+	FrobeniusEigenvalue string          // p mod r, eigenvalue of the Frobenius on GT
+}
+
+// check checks the pairing description of a curve with parameters data and
+// returns the data of the pairing templates.
+func (pr *Pairing) check(data *curveData) (*pairingData, error) {
+	var p, r, b, cofactor, x, beta big.Int
+	p.SetString(data.P, 10)
+	r.SetString(data.R, 10)
+	b.SetString(data.B, 10)
+	cofactor.SetString(data.Cofactor, 10)
+	if data.A != "0" {
+		return nil, errPairingA
+	}
+	if err := parseInt(&x, "x₀", pr.X); err != nil {
+		return nil, err
+	}
+	if err := parseInt(&beta, "β", pr.Beta); err != nil {
+		return nil, err
+	}
+
+	// p(x₀), r(x₀) and the cofactors of the family
+	var fp, fr, h1, h2 big.Int
+	switch pr.Family {
+	case "bn":
+		// p = 36x⁴+36x³+24x²+6x+1, r = 36x⁴+36x³+18x²+6x+1
+		fp.Set(poly(&x, 1, 6, 24, 36, 36))
+		fr.Set(poly(&x, 1, 6, 18, 36, 36))
+		h1.SetUint64(1)
+		// #E'(𝔽p²) = r(2p-r)
+		h2.Lsh(&fp, 1).Sub(&h2, &fr)
+	case "bls12":
+		// r = x⁴-x²+1, p = (x-1)²r/3+x
+		var xm1 big.Int
+		xm1.Sub(&x, big.NewInt(1))
+		fr.Set(poly(&x, 1, 0, -1, 0, 1))
+		h1.Mul(&xm1, &xm1).Quo(&h1, big.NewInt(3))
+		fp.Mul(&h1, &fr).Add(&fp, &x)
+		// h₂ = (x⁸-4x⁷+5x⁶-4x⁴+6x³-4x²-4x+13)/9
+		h2.Quo(poly(&x, 13, -4, -4, 6, -4, 0, 5, -4, 1), big.NewInt(9))
+	default:
+		return nil, errFamily
+	}
+	if fp.Cmp(&p) != 0 || fr.Cmp(&r) != 0 {
+		return nil, errFamilyModuli
+	}
+	if h1.Cmp(&cofactor) != 0 {
+		return nil, errCofactor
+	}
+
+	// 𝔽p² = 𝔽p[u]/(u²-β): the square root of 𝔽p² assumes β = -1 if p ≡ 3 mod 4
+	F := fp2{p: &p, beta: new(big.Int).Mod(&beta, &p)}
+	minusOne := new(big.Int).Sub(&p, big.NewInt(1))
+	if big.Jacobi(F.beta, &p) != -1 || (p.Bit(1) == 1 && F.beta.Cmp(minusOne) != 0) {
+		return nil, errBeta
+	}
+
+	// ξ is neither a square nor a cube in 𝔽p²
+	xi, err := F.parse("ξ", pr.Xi)
+	if err != nil {
+		return nil, err
+	}
+	var p2m1, e big.Int
+	p2m1.Mul(&p, &p).Sub(&p2m1, big.NewInt(1))
+	if F.isZero(xi) || F.isOne(F.exp(xi, e.Rsh(&p2m1, 1))) || F.isOne(F.exp(xi, e.Quo(&p2m1, big.NewInt(3)))) {
+		return nil, errXi
+	}
+	xiInv := F.inverse(xi)
+
+	// E': Y² = X³ + b' over 𝔽p²
+	bE2 := e2{a0: new(big.Int).Set(&b), a1: new(big.Int)}
+	var bTwist e2
+	switch pr.Twist {
+	case "D":
+		bTwist = F.mul(bE2, xiInv)
+	case "M":
+		bTwist = F.mul(bE2, xi)
+	default:
+		return nil, errTwist
+	}
+	E := twist{F: &F, b: bTwist}
+
+	// Q ∈ E'(𝔽p²) of order r
+	var q point2
+	if q.x, err = F.parse("G2X", pr.G2X); err != nil {
+		return nil, err
+	}
+	if q.y, err = F.parse("G2Y", pr.G2Y); err != nil {
+		return nil, err
+	}
+	if !E.isOnCurve(&q) {
+		return nil, errGeneratorG2
+	}
+	if !E.mul(&q, &r).inf {
+		return nil, errOrderG2
+	}
+
+	res := &pairingData{
+		Family:         pr.Family,
+		Twist:          pr.Twist,
+		X:              x.String(),
+		Beta:           F.beta.String(),
+		BetaIsMinusOne: F.beta.Cmp(minusOne) == 0,
+		Xi:             xi.strings(),
+		XiInv:          xiInv.strings(),
+		BTwist:         bTwist.strings(),
+		G2X:            q.x.strings(),
+		G2Y:            q.y.strings(),
+		CofactorG2:     h2.String(),
+	}
+
+	// ψ(x,y) = π(x,y) on E': (x̄·ξ^((p-1)/3), ȳ·ξ^((p-1)/2)) for a D-twist, and with ξ⁻¹
+	// for an M-twist
+	xiEndo := xi
+	if pr.Twist == "M" {
+		xiEndo = xiInv
+	}
+	var pm1 big.Int
+	pm1.Sub(&p, big.NewInt(1))
+	res.EndoU = F.exp(xiEndo, e.Quo(&pm1, big.NewInt(3))).strings()
+	res.EndoV = F.exp(xiEndo, e.Rsh(&pm1, 1)).strings()
+
+	// x^(pⁱ) = Σₖ aₖ^(pⁱ)·ξ^(k(pⁱ-1)/6)·wᵏ for x = Σₖ aₖwᵏ, since w⁶ = ξ
+	pi := new(big.Int).Set(&p)
+	for i := 0; i < 3; i++ {
+		var pim1 big.Int
+		pim1.Sub(pi, big.NewInt(1))
+		for k := 1; k <= 5; k++ {
+			e.Mul(&pim1, big.NewInt(int64(k))).Quo(&e, big.NewInt(6))
+			res.Frobenius[i][k-1] = F.exp(xi, &e).strings()
+		}
+		pi.Mul(pi, &p)
+	}
+
+	// optimal ate loop: 6x₀+2 for BN, x₀ for BLS12
+	var loop big.Int
+	if pr.Family == "bn" {
+		loop.Mul(&x, big.NewInt(6)).Add(&loop, big.NewInt(2))
+		res.LoopCounter = naf(loop.Abs(&loop))
+	} else {
+		loop.Abs(&x)
+		for i := 0; i < loop.BitLen(); i++ {
+			res.LoopCounter = append(res.LoopCounter, int(loop.Bit(i)))
+		}
+	}
+	res.LoopCounterNeg = x.Sign() < 0
+
+	// (p⁴-p²+1)/r = h₀ + h₁p + h₂p² + h₃p³
+	var h, p2, digit big.Int
+	p2.Mul(&p, &p)
+	h.Mul(&p2, &p2).Sub(&h, &p2).Add(&h, big.NewInt(1)).Quo(&h, &r)
+	for i := range res.HardPart {
+		h.QuoRem(&h, &p, &digit)
+		res.HardPart[i] = digit.String()
+	}
+	res.FrobeniusEigenvalue = new(big.Int).Mod(&p, &r).String()
+
+	return res, nil
+}
+
+// poly returns Σᵢ cᵢxⁱ.
+func poly(x *big.Int, c ...int64) *big.Int {
+	res := new(big.Int)
+	for i := len(c) - 1; i >= 0; i-- {
+		res.Mul(res, x).Add(res, big.NewInt(c[i]))
+	}
+	return res
+}
+
+// naf returns the non-adjacent form of k ≥ 0, little endian.
+func naf(k *big.Int) []int {
+	var digits []int
+	e := new(big.Int).Set(k)
+	for e.Sign() > 0 {
+		d := 0
+		if e.Bit(0) == 1 {
+			// d = 2 - (e mod 4) ∈ {1, -1}
+			d = 2 - int(e.Bit(1)<<1|1)
+			e.Sub(e, big.NewInt(int64(d)))
+		}
+		digits = append(digits, d)
+		e.Rsh(e, 1)
+	}
+	return digits
+}
+
+// e2 is the element a0 + a1·u of 𝔽p².
+type e2 struct {
+	a0, a1 *big.Int
+}
+
+func (x e2) strings() [2]string {
+	return [2]string{x.a0.String(), x.a1.String()}
+}
+
+// fp2 implements the (slow) arithmetic of 𝔽p² = 𝔽p[u]/(u²-β), to check the
+// parameters of the pairing and compute its constants.
+type fp2 struct {
+	p, beta *big.Int
+}
+
+func (F *fp2) parse(name string, s [2]string) (e2, error) {
+	x := e2{new(big.Int), new(big.Int)}
+	if err := parseInt(x.a0, name, s[0]); err != nil {
+		return x, err
+	}
+	if err := parseInt(x.a1, name, s[1]); err != nil {
+		return x, err
+	}
+	x.a0.Mod(x.a0, F.p)
+	x.a1.Mod(x.a1, F.p)
+	return x, nil
+}
+
+func (F *fp2) isZero(x e2) bool {
+	return x.a0.Sign() == 0 && x.a1.Sign() == 0
+}
+
+func (F *fp2) isOne(x e2) bool {
+	return x.a0.Cmp(big.NewInt(1)) == 0 && x.a1.Sign() == 0
+}
+
+func (F *fp2) equal(x, y e2) bool {
+	return x.a0.Cmp(y.a0) == 0 && x.a1.Cmp(y.a1) == 0
+}
+
+func (F *fp2) add(x, y e2) e2 {
+	a0, a1 := new(big.Int).Add(x.a0, y.a0), new(big.Int).Add(x.a1, y.a1)
+	return e2{a0.Mod(a0, F.p), a1.Mod(a1, F.p)}
+}
+
+func (F *fp2) sub(x, y e2) e2 {
+	a0, a1 := new(big.Int).Sub(x.a0, y.a0), new(big.Int).Sub(x.a1, y.a1)
+	return e2{a0.Mod(a0, F.p), a1.Mod(a1, F.p)}
+}
+
+// mul returns (x₀+x₁u)(y₀+y₁u) = x₀y₀+βx₁y₁ + (x₀y₁+x₁y₀)u.
+func (F *fp2) mul(x, y e2) e2 {
+	var t big.Int
+	a0 := new(big.Int).Mul(x.a0, y.a0)
+	a0.Add(a0, t.Mul(x.a1, y.a1).Mul(&t, F.beta))
+	a1 := new(big.Int).Mul(x.a0, y.a1)
+	a1.Add(a1, t.Mul(x.a1, y.a0))
+	return e2{a0.Mod(a0, F.p), a1.Mod(a1, F.p)}
+}
+
+// inverse returns 1/x = (x₀-x₁u)/(x₀²-βx₁²), for x ≠ 0.
+func (F *fp2) inverse(x e2) e2 {
+	var n, t big.Int
+	n.Mul(x.a0, x.a0).Sub(&n, t.Mul(x.a1, x.a1).Mul(&t, F.beta)).Mod(&n, F.p)
+	n.ModInverse(&n, F.p)
+	a0 := new(big.Int).Mul(x.a0, &n)
+	a1 := new(big.Int).Neg(x.a1)
+	a1.Mul(a1, &n)
+	return e2{a0.Mod(a0, F.p), a1.Mod(a1, F.p)}
+}
+
+// exp returns xᵏ, for k ≥ 0.
+func (F *fp2) exp(x e2, k *big.Int) e2 {
+	res := e2{big.NewInt(1), new(big.Int)}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = F.mul(res, res)
+		if k.Bit(i) == 1 {
+			res = F.mul(res, x)
+		}
+	}
+	return res
+}
+
+// point2 is a point of the twist in affine coordinates; inf is set for the point
+// at infinity.
+type point2 struct {
+	x, y e2
+	inf  bool
+}
+
+// twist implements the (slow) arithmetic of the twist Y² = X³ + b over 𝔽p².
+type twist struct {
+	F *fp2
+	b e2
+}
+
+func (E *twist) isOnCurve(P *point2) bool {
+	F := E.F
+	return F.equal(F.mul(P.y, P.y), F.add(F.mul(F.mul(P.x, P.x), P.x), E.b))
+}
+
+// add returns P+Q.
+func (E *twist) add(P, Q *point2) *point2 {
+	if P.inf {
+		return Q
+	}
+	if Q.inf {
+		return P
+	}
+	F := E.F
+	var l e2
+	if F.equal(P.x, Q.x) {
+		if F.isZero(F.add(P.y, Q.y)) {
+			return &point2{inf: true}
+		}
+		// λ = 3x²/2y
+		xx := F.mul(P.x, P.x)
+		l = F.mul(F.add(F.add(xx, xx), xx), F.inverse(F.add(P.y, P.y)))
+	} else {
+		// λ = (y₂-y₁)/(x₂-x₁)
+		l = F.mul(F.sub(Q.y, P.y), F.inverse(F.sub(Q.x, P.x)))
+	}
+	x := F.sub(F.sub(F.mul(l, l), P.x), Q.x)
+	y := F.sub(F.mul(l, F.sub(P.x, x)), P.y)
+	return &point2{x: x, y: y}
+}
+
+// mul returns [k]P, for k ≥ 0.
+func (E *twist) mul(P *point2, k *big.Int) *point2 {
+	res := &point2{inf: true}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = E.add(res, res)
+		if k.Bit(i) == 1 {
+			res = E.add(res, P)
+		}
+	}
+	return res
+}
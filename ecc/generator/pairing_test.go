@@ -0,0 +1,219 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// bn254 returns the description of the BN curve bn254 of gnark-crypto.
+func bn254() Curve {
+	return Curve{
+		Name: "bn254-custom",
+		P:    "21888242871839275222246405745257275088696311157297823662689037894645226208583",
+		R:    "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+		A:    "0",
+		B:    "3",
+		Gx:   "1",
+		Gy:   "2",
+		GLV: &GLV{
+			ThirdRootOne: "2203960485148121921418603742825762020974279258880205651966",
+			Lambda:       "4407920970296243842393367215006156084916469457145843978461",
+		},
+		Pairing: &Pairing{
+			Family: "bn",
+			X:      "4965661367192848881",
+			Beta:   "-1",
+			Xi:     [2]string{"9", "1"},
+			Twist:  "D",
+			G2X: [2]string{
+				"10857046999023057135944570762232829481370756359578518086990519993285655852781",
+				"11559732032986387107991004021392285783925812861821192530917403151452391805634",
+			},
+			G2Y: [2]string{
+				"8495653923123431417604973247489272438418190587263600148770280649306958101930",
+				"4082367875863433681332203403145435568316851327593401208105741076214120093531",
+			},
+		},
+	}
+}
+
+// bls12381 returns the description of the BLS12 curve bls12-381 of gnark-crypto,
+// which has a negative seed and an M-twist.
+func bls12381() Curve {
+	return Curve{
+		Name:     "bls12-381-custom",
+		P:        "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
+		R:        "52435875175126190479447740508185965837690552500527637822603658699938581184513",
+		A:        "0",
+		B:        "4",
+		Gx:       "3685416753713387016781088315183077757961620795782546409894578378688607592378376318836054947676345821548104185464507",
+		Gy:       "1339506544944476473020471379941921221584933875938349620426543736416511423956333506472724655353366534992391756441569",
+		Cofactor: "0x396c8c005555e1568c00aaab0000aaab",
+		Pairing: &Pairing{
+			Family: "bls12",
+			X:      "-0xd201000000010000",
+			Beta:   "-1",
+			Xi:     [2]string{"1", "1"},
+			Twist:  "M",
+			G2X: [2]string{
+				"352701069587466618187139116011060144890029952792775240219908644239793785735715026873347600343865175952761926303160",
+				"3059144344244213709971259814753781636986470325476647558659373206291635324768958432433509563104347017837885763365758",
+			},
+			G2Y: [2]string{
+				"1985150602287291935568054521177171638300868978215655730859378665066344726373823718423869104263333984641494340347905",
+				"927553665492332455747201965776037880757740193453592970025027978793976877002675564980949289727957565575433344219582",
+			},
+		},
+	}
+}
+
+func TestCheckPairing(t *testing.T) {
+	c := bn254()
+	data, err := c.check()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 6x₀+2 = 29793968203157093288 has 66 digits in NAF, the last one is 1
+	pr := data.Pairing
+	if pr == nil || len(pr.LoopCounter) != 66 || pr.LoopCounter[65] != 1 || pr.LoopCounterNeg || !pr.BetaIsMinusOne {
+		t.Fatal("unexpected pairing data")
+	}
+
+	c = bls12381()
+	if data, err = c.check(); err != nil {
+		t.Fatal(err)
+	}
+	pr = data.Pairing
+	if len(pr.LoopCounter) != 64 || !pr.LoopCounterNeg {
+		t.Fatal("unexpected pairing data")
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*Curve)
+		err    error
+	}{
+		{"unknown family", func(c *Curve) { c.Pairing.Family = "bls24" }, errFamily},
+		{"wrong seed", func(c *Curve) { c.Pairing.X = "-0xd201000000010001" }, errFamilyModuli},
+		{"wrong family", func(c *Curve) { c.Pairing.Family = "bn" }, errFamilyModuli},
+		{"wrong cofactor", func(c *Curve) { c.Cofactor = "1" }, errCofactor},
+		{"β is a square", func(c *Curve) { c.Pairing.Beta = "4" }, errBeta},
+		{"β ≠ -1 with p ≡ 3 mod 4", func(c *Curve) { c.Pairing.Beta = "-5" }, errBeta},
+		{"ξ is a square", func(c *Curve) { c.Pairing.Xi = [2]string{"4", "0"} }, errXi},
+		{"unknown twist", func(c *Curve) { c.Pairing.Twist = "X" }, errTwist},
+		{"wrong twist", func(c *Curve) { c.Pairing.Twist = "D" }, errGeneratorG2},
+		{"generator of G2 not of order r", func(c *Curve) {
+			// the point of E'(𝔽p²) with x = 2
+			c.Pairing.G2X = [2]string{"2", "0"}
+			c.Pairing.G2Y = [2]string{
+				"188995492400578496451910581292546059920654572609832469388872107051048741028892423057992033888655218419282460458611",
+				"434381874456081807472298918693162486998243066160460423017297172308631992219110538691921044767658182807847155297615",
+			}
+		}, errOrderG2},
+	} {
+		c := bls12381()
+		tc.modify(&c)
+		if _, err := c.check(); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.err, err)
+		}
+	}
+
+	c = p256()
+	c.Pairing = bn254().Pairing
+	if _, err := c.check(); !errors.Is(err, errPairingA) {
+		t.Errorf("expected %v, got %v", errPairingA, err)
+	}
+}
+
+// TestGeneratePairing generates the packages of clones of bn254 and bls12-381 and
+// runs their tests.
+func TestGeneratePairing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the generation of the pairing-friendly curves in short mode")
+	}
+	const rootDir = "integration_test_pairing"
+	os.RemoveAll(rootDir)
+	err := os.MkdirAll(rootDir, 0700)
+	defer os.RemoveAll(rootDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		c   Curve
+		ref string // package of the curve in gnark-crypto
+	}{
+		{bn254(), "bn254"},
+		{bls12381(), "bls12-381"},
+	} {
+		pkg, err := tc.c.packageName()
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := filepath.Join(rootDir, pkg)
+		if err := Generate(tc.c, dir); err != nil {
+			t.Fatal(tc.c.Name, err)
+		}
+		// the final exponentiations of gnark-crypto compute a power of the pairing,
+		// so the Miller loops are compared through the final exponentiation of gnark-crypto
+		compat := fmt.Sprintf(compatTest, pkg, tc.ref, strings.ReplaceAll(tc.ref, "-", ""))
+		if err := os.WriteFile(filepath.Join(dir, "compat_test.go"), []byte(compat), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// run go test
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	packageDir := filepath.Join(wd, rootDir) + string(filepath.Separator) + "..."
+	cmd := exec.Command("go", "test", "-short", packageDir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatal(string(out))
+	}
+}
+
+// compatTest checks that the Miller loop of a generated curve matches the one of
+// the curve of gnark-crypto it is a copy of.
+const compatTest = `package %[1]s
+
+import (
+	"testing"
+
+	ref "github.com/consensys/gnark-crypto/ecc/%[2]s"
+)
+
+func TestMillerLoopCompat(t *testing.T) {
+	_, _, g1, g2 := ref.Generators()
+	expected, err := ref.Pair([]ref.G1Affine{g1}, []ref.G2Affine{g2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var P G1Affine
+	var Q G2Affine
+	if err := P.Unmarshal(g1.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if err := Q.Unmarshal(g2.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	ml, err := MillerLoop([]G1Affine{P}, []G2Affine{Q})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f ref.GT
+	if err := f.SetBytes(ml.Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if res := ref.FinalExponentiation(&f); !res.Equal(&expected) {
+		t.Fatal("the Miller loop doesn't match the one of %[3]s")
+	}
+}
+`
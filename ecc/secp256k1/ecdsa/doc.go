@@ -25,4 +25,22 @@
 // - Wikipedia: https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
 // - FIPS 186-4: https://nvlpubs.nist.gov/nistpubs/FIPS/NIST.FIPS.186-4.pdf
 // - SEC 1, v-2: https://www.secg.org/sec1-v2.pdf
+//
+// Key generation and signing handle the private key and the nonce with the
+// constant-time scalar multiplication and field arithmetic (ScalarMultiplicationBaseCT,
+// SetBigIntCT, MulCT, AddCT, InverseCT); verification only handles public values
+// and uses the faster variable-time algorithms.
+//
+// Sign derives the nonce from a CSPRNG keyed by the private key, fresh entropy
+// and the message. SignRFC6979 and SignDigestRFC6979 derive it
+// deterministically (RFC 6979), so that the signatures match the ones of other
+// implementations. Signatures are encoded as r||s (Bytes) or in ASN.1 DER
+// (BytesDER). Verify and VerifyDER accept both {r, s} and {r, r_mod-s}, as
+// crypto/ecdsa does; VerifyLowS and VerifyDERLowS only accept the signature
+// with a low s.
+// SignCompact returns deterministic signatures with a low s in the compact
+// format r||s||v, from which the public key can be recovered.
+//
+// EthereumAddress, SignEthereum and RecoverFromEthereum follow the conventions
+// of Ethereum (go-ethereum crypto.Sign and crypto.Ecrecover).
 package ecdsa
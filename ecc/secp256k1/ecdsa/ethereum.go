@@ -0,0 +1,76 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// SizeEthereumAddress is the size of an Ethereum address
+const SizeEthereumAddress = 20
+
+var errInvalidDigest = errors.New("digest must be 32 bytes long")
+
+// EthereumAddress returns the Ethereum address of the public key, the last 20
+// bytes of Keccak-256(x||y).
+func (pk *PublicKey) EthereumAddress() [SizeEthereumAddress]byte {
+	x := pk.A.X.Bytes()
+	y := pk.A.Y.Bytes()
+	h := sha3.NewLegacyKeccak256()
+	h.Write(x[:])
+	h.Write(y[:])
+	var res [SizeEthereumAddress]byte
+	copy(res[:], h.Sum(nil)[32-SizeEthereumAddress:])
+	return res
+}
+
+// SignEthereum signs the 32-byte hash digest (e.g. the Keccak-256 hash of a
+// transaction, or the EIP-191 hash of a message) in the format of go-ethereum
+// crypto.Sign: the 65 bytes r||s||v, with v ∈ {0, 1} and a low s. The nonce is
+// derived with RFC 6979 and HMAC-SHA256, as in libsecp256k1.
+func (privKey *PrivateKey) SignEthereum(digest []byte) ([]byte, error) {
+	if len(digest) != 32 {
+		return nil, errInvalidDigest
+	}
+	// v ∈ {2, 3} when x_P ≥ order, which happens with negligible probability;
+	// such signatures are rejected by Ethereum clients.
+	return privKey.SignCompact(digest, sha256.New)
+}
+
+// RecoverFromEthereum recovers the public key from the 32-byte hash digest
+// and the signature r||s||v in the format of go-ethereum crypto.Sign (the
+// legacy values 27 and 28 of v are also accepted).
+func (pk *PublicKey) RecoverFromEthereum(digest, sig []byte) error {
+	if len(digest) != 32 {
+		return errInvalidDigest
+	}
+	if len(sig) != SizeSignatureCompact {
+		return errWrongSize
+	}
+	compact := make([]byte, SizeSignatureCompact)
+	copy(compact, sig)
+	if v := compact[sizeSignature]; v == 27 || v == 28 {
+		compact[sizeSignature] -= 27
+	}
+	if compact[sizeSignature] > 1 {
+		return errInvalidV
+	}
+	return pk.RecoverFromCompact(digest, compact)
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestEthereumAddress(t *testing.T) {
+	for scalar, address := range map[string]string{
+		"1": "7e5f4552091a69125d5dfcb7b8c2659029395bdf",
+		"2": "2b5ad5c4795c026514f8317c7a215e218dccd6cf",
+		"289c2857d4598e37fb9647507e47a309d6133539bf21a8b9cb6df88fd5232032": "970e8128ab834e8eac17ab8e3812f010678cf791",
+	} {
+		privKey := privateKeyFromHex(t, scalar)
+		got := privKey.PublicKey.EthereumAddress()
+		if hex.EncodeToString(got[:]) != address {
+			t.Fatalf("address of %s: got %x, want %s", scalar, got, address)
+		}
+	}
+}
+
+func TestSignEthereum(t *testing.T) {
+	privKey := privateKeyFromHex(t, "289c2857d4598e37fb9647507e47a309d6133539bf21a8b9cb6df88fd5232032")
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte("foo"))
+	digest := h.Sum(nil)
+
+	sig, err := privKey.SignEthereum(digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sig) != 65 || sig[64] > 1 {
+		t.Fatalf("invalid signature format %x", sig)
+	}
+
+	var recovered PublicKey
+	if err := recovered.RecoverFromEthereum(digest, sig); err != nil {
+		t.Fatal(err)
+	}
+	if recovered.EthereumAddress() != privKey.PublicKey.EthereumAddress() {
+		t.Fatal("recovered address should match")
+	}
+
+	// legacy v
+	sig[64] += 27
+	if err := recovered.RecoverFromEthereum(digest, sig); err != nil {
+		t.Fatal(err)
+	}
+	if recovered.EthereumAddress() != privKey.PublicKey.EthereumAddress() {
+		t.Fatal("recovered address should match")
+	}
+	sig[64] = 2
+	if err := recovered.RecoverFromEthereum(digest, sig); err != errInvalidV {
+		t.Fatal("should raise error for an invalid v")
+	}
+
+	if _, err := privKey.SignEthereum(digest[1:]); err != errInvalidDigest {
+		t.Fatal("should raise error for a short digest")
+	}
+}
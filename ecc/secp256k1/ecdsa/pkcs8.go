@@ -0,0 +1,280 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+// The keys are encoded as in crypto/x509 and OpenSSL:
+//   - public keys as a SubjectPublicKeyInfo (RFC 5280, RFC 5480), with the
+//     uncompressed SEC 1 encoding of the point;
+//   - private keys as a PKCS #8 PrivateKeyInfo (RFC 5208) wrapping an
+//     ECPrivateKey (RFC 5915);
+//   - in PEM, as "PUBLIC KEY" and "PRIVATE KEY" blocks.
+
+const (
+	pemTypePublicKey  = "PUBLIC KEY"
+	pemTypePrivateKey = "PRIVATE KEY"
+
+	ecPrivKeyVersion = 1
+)
+
+var (
+	// oidPublicKeyECDSA is id-ecPublicKey (RFC 5480)
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	// oidNamedCurve is secp256k1 (SEC 2)
+	oidNamedCurve = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+var (
+	errInvalidPKIX  = errors.New("invalid SubjectPublicKeyInfo encoding")
+	errInvalidPKCS8 = errors.New("invalid PKCS #8 encoding")
+	errInvalidPEM   = errors.New("invalid PEM block")
+	errWrongCurve   = errors.New("the key is not on the secp256k1 curve")
+	errInvalidPoint = errors.New("invalid point encoding")
+)
+
+// addAlgorithmIdentifier adds the AlgorithmIdentifier of the ECDSA keys on the
+// curve to b.
+func addAlgorithmIdentifier(b *cryptobyte.Builder) {
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1ObjectIdentifier(oidPublicKeyECDSA)
+		b.AddASN1ObjectIdentifier(oidNamedCurve)
+	})
+}
+
+// readAlgorithmIdentifier reads the AlgorithmIdentifier of the ECDSA keys on
+// the curve from s.
+func readAlgorithmIdentifier(s *cryptobyte.String) error {
+	var algo cryptobyte.String
+	var algoOID, curveOID asn1.ObjectIdentifier
+	if !s.ReadASN1(&algo, cryptobyte_asn1.SEQUENCE) ||
+		!algo.ReadASN1ObjectIdentifier(&algoOID) ||
+		!algo.ReadASN1ObjectIdentifier(&curveOID) ||
+		!algo.Empty() {
+		return errInvalidPKIX
+	}
+	if !algoOID.Equal(oidPublicKeyECDSA) || !curveOID.Equal(oidNamedCurve) {
+		return errWrongCurve
+	}
+	return nil
+}
+
+// uncompressedBytes returns the uncompressed SEC 1 encoding 0x04||x||y of pk.
+func (pk *PublicKey) uncompressedBytes() []byte {
+	res := make([]byte, 1+2*fp.Bytes)
+	res[0] = 0x04
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[1:1+fp.Bytes]), pk.A.X)
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[1+fp.Bytes:]), pk.A.Y)
+	return res
+}
+
+// setUncompressedBytes sets pk from its uncompressed SEC 1 encoding, and
+// checks that it is on the curve.
+func (pk *PublicKey) setUncompressedBytes(buf []byte) error {
+	if len(buf) != 1+2*fp.Bytes || buf[0] != 0x04 {
+		return errInvalidPoint
+	}
+	var err error
+	if pk.A.X, err = fp.BigEndian.Element((*[fp.Bytes]byte)(buf[1 : 1+fp.Bytes])); err != nil {
+		return errInvalidPoint
+	}
+	if pk.A.Y, err = fp.BigEndian.Element((*[fp.Bytes]byte)(buf[1+fp.Bytes:])); err != nil {
+		return errInvalidPoint
+	}
+	if !pk.A.IsOnCurve() {
+		return errInvalidPoint
+	}
+	return nil
+}
+
+// BytesPKIX returns the DER encoding of pk as a SubjectPublicKeyInfo, as
+// x509.MarshalPKIXPublicKey.
+func (pk *PublicKey) BytesPKIX() []byte {
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		addAlgorithmIdentifier(b)
+		b.AddASN1BitString(pk.uncompressedBytes())
+	})
+	return b.BytesOrPanic()
+}
+
+// SetBytesPKIX sets pk from the DER encoding of a SubjectPublicKeyInfo, and
+// checks that it is a point of the curve. It returns the number of bytes
+// read from buf.
+func (pk *PublicKey) SetBytesPKIX(buf []byte) (int, error) {
+	input := cryptobyte.String(buf)
+	var spki cryptobyte.String
+	var point asn1.BitString
+	if !input.ReadASN1(&spki, cryptobyte_asn1.SEQUENCE) {
+		return 0, errInvalidPKIX
+	}
+	if err := readAlgorithmIdentifier(&spki); err != nil {
+		return 0, err
+	}
+	if !spki.ReadASN1BitString(&point) || !spki.Empty() || point.BitLength%8 != 0 {
+		return 0, errInvalidPKIX
+	}
+	if err := pk.setUncompressedBytes(point.Bytes); err != nil {
+		return 0, err
+	}
+	return len(buf) - len(input), nil
+}
+
+// BytesPEM returns the PEM encoding of pk, as a "PUBLIC KEY" block.
+func (pk *PublicKey) BytesPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: pk.BytesPKIX()})
+}
+
+// SetBytesPEM sets pk from the first PEM block of buf, which must be a
+// "PUBLIC KEY" block. It returns the number of bytes read from buf.
+func (pk *PublicKey) SetBytesPEM(buf []byte) (int, error) {
+	block, rest := pem.Decode(buf)
+	if block == nil || block.Type != pemTypePublicKey {
+		return 0, errInvalidPEM
+	}
+	n, err := pk.SetBytesPKIX(block.Bytes)
+	if err != nil {
+		return 0, err
+	}
+	if n != len(block.Bytes) {
+		return 0, errInvalidPKIX
+	}
+	return len(buf) - len(rest), nil
+}
+
+// BytesPKCS8 returns the DER encoding of privKey as a PKCS #8 PrivateKeyInfo,
+// as x509.MarshalPKCS8PrivateKey.
+func (privKey *PrivateKey) BytesPKCS8() []byte {
+	var b cryptobyte.Builder
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1Int64(0)
+		addAlgorithmIdentifier(b)
+		b.AddASN1(cryptobyte_asn1.OCTET_STRING, func(b *cryptobyte.Builder) {
+			// ECPrivateKey, without the parameters given by the AlgorithmIdentifier
+			b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				b.AddASN1Int64(ecPrivKeyVersion)
+				b.AddASN1OctetString(privKey.scalar[:])
+				b.AddASN1(cryptobyte_asn1.Tag(1).Constructed().ContextSpecific(), func(b *cryptobyte.Builder) {
+					b.AddASN1BitString(privKey.PublicKey.uncompressedBytes())
+				})
+			})
+		})
+	})
+	return b.BytesOrPanic()
+}
+
+// SetBytesPKCS8 sets privKey from the DER encoding of a PKCS #8
+// PrivateKeyInfo, and computes the public key. If the encoding contains the
+// public key, it must match the private key. It returns the number of bytes
+// read from buf.
+func (privKey *PrivateKey) SetBytesPKCS8(buf []byte) (int, error) {
+	input := cryptobyte.String(buf)
+	var info, der, ecPrivKey cryptobyte.String
+	var version int
+	if !input.ReadASN1(&info, cryptobyte_asn1.SEQUENCE) ||
+		!info.ReadASN1Integer(&version) || version != 0 {
+		return 0, errInvalidPKCS8
+	}
+	if err := readAlgorithmIdentifier(&info); err != nil {
+		return 0, err
+	}
+	// the optional attributes are ignored
+	if !info.ReadASN1(&der, cryptobyte_asn1.OCTET_STRING) ||
+		!der.ReadASN1(&ecPrivKey, cryptobyte_asn1.SEQUENCE) || !der.Empty() {
+		return 0, errInvalidPKCS8
+	}
+
+	var scalar []byte
+	if !ecPrivKey.ReadASN1Integer(&version) || version != ecPrivKeyVersion ||
+		!ecPrivKey.ReadASN1Bytes(&scalar, cryptobyte_asn1.OCTET_STRING) ||
+		len(scalar) > sizeFr {
+		return 0, errInvalidPKCS8
+	}
+	var params, publicKey cryptobyte.String
+	var hasParams, hasPublicKey bool
+	if !ecPrivKey.ReadOptionalASN1(&params, &hasParams, cryptobyte_asn1.Tag(0).Constructed().ContextSpecific()) ||
+		!ecPrivKey.ReadOptionalASN1(&publicKey, &hasPublicKey, cryptobyte_asn1.Tag(1).Constructed().ContextSpecific()) ||
+		!ecPrivKey.Empty() {
+		return 0, errInvalidPKCS8
+	}
+	if hasParams {
+		var curveOID asn1.ObjectIdentifier
+		if !params.ReadASN1ObjectIdentifier(&curveOID) || !params.Empty() {
+			return 0, errInvalidPKCS8
+		}
+		if !curveOID.Equal(oidNamedCurve) {
+			return 0, errWrongCurve
+		}
+	}
+
+	x := new(big.Int).SetBytes(scalar)
+	if x.Sign() == 0 || x.Cmp(order) >= 0 {
+		return 0, errInvalidPKCS8
+	}
+	var res PrivateKey
+	x.FillBytes(res.scalar[:])
+	res.PublicKey.A.ScalarMultiplicationBaseCT(x)
+	x.SetUint64(0)
+
+	if hasPublicKey {
+		var point asn1.BitString
+		var pub PublicKey
+		if !publicKey.ReadASN1BitString(&point) || !publicKey.Empty() || point.BitLength%8 != 0 {
+			return 0, errInvalidPKCS8
+		}
+		if err := pub.setUncompressedBytes(point.Bytes); err != nil {
+			return 0, err
+		}
+		if !pub.A.Equal(&res.PublicKey.A) {
+			return 0, errInvalidPKCS8
+		}
+	}
+
+	*privKey = res
+	return len(buf) - len(input), nil
+}
+
+// BytesPEM returns the PEM encoding of privKey, as a "PRIVATE KEY" block.
+func (privKey *PrivateKey) BytesPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: privKey.BytesPKCS8()})
+}
+
+// SetBytesPEM sets privKey from the first PEM block of buf, which must be a
+// "PRIVATE KEY" block. It returns the number of bytes read from buf.
+func (privKey *PrivateKey) SetBytesPEM(buf []byte) (int, error) {
+	block, rest := pem.Decode(buf)
+	if block == nil || block.Type != pemTypePrivateKey {
+		return 0, errInvalidPEM
+	}
+	n, err := privKey.SetBytesPKCS8(block.Bytes)
+	if err != nil {
+		return 0, err
+	}
+	if n != len(block.Bytes) {
+		return 0, errInvalidPKCS8
+	}
+	return len(buf) - len(rest), nil
+}
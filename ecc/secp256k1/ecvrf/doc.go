@@ -0,0 +1,32 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ecvrf provides the elliptic curve verifiable random function
+// ECVRF on secp256k1, built as in RFC 9381 although the RFC defines no suite on
+// secp256k1.
+//
+// A VRF is the public-key version of a keyed hash: only the owner of the private
+// key can compute the hash β of an input α, but anyone can check it with the
+// public key and the proof π returned by Prove.
+//
+// The suite of the package is not an RFC 9381 suite, and its proofs are not
+// interoperable: it follows ECVRF-P256-SHA256-SSWU with the encode_to_curve
+// secp256k1_XMD:SHA-256_SVDW_NU_ (as provided by EncodeToG1), and its name
+// "GNARK-ECVRF-SECP256K1-SHA256-SVDW" in place of the one-byte suite_string. The
+// nonces are generated following RFC 6979 and points are SEC 1 compressed.
+//
+// See https://www.rfc-editor.org/rfc/rfc9381
+package ecvrf
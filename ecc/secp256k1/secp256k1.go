@@ -23,6 +23,19 @@
 // Security: estimated 128-bit level using Pollard's \rho attack
 // (r is 256 bits)
 //
+// # Fixed-base scalar multiplication
+//
+// G1FixedBaseTable stores the multiples of a point which is used as a base many times,
+// with a window size trading memory for speed. ScalarMultiplicationBase uses such a
+// table for the generator, computed on first use.
+//
+// # Constant time
+//
+// ScalarMultiplicationCT, ScalarMultiplicationBaseCT and
+// ScalarMultiplicationFixedBaseCT don't branch on the scalar nor access memory
+// depending on it, and are used by the signature schemes for the secret scalars. The
+// other scalar multiplications are faster but not constant time.
+//
 // # Warning
 //
 // This code has been partially audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
@@ -0,0 +1,204 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+import (
+	"bufio"
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256r1/fr"
+)
+
+// TestSigVer checks Verify against the NIST CAVP SigVer vectors
+// for P-256 with SHA-256.
+func TestSigVer(t *testing.T) {
+	f, err := os.Open("test_vectors/SigVer.rsp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	nbTests := 0
+	fields := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' || line[0] == '[' {
+			continue
+		}
+		kv := strings.SplitN(line, " = ", 2)
+		if len(kv) != 2 {
+			t.Fatalf("invalid line %q", line)
+		}
+		if kv[0] != "Result" {
+			fields[kv[0]] = kv[1]
+			continue
+		}
+
+		msg := decodeHex(t, fields["Msg"])
+		var pkBin [sizePublicKey]byte
+		new(big.Int).SetBytes(decodeHex(t, fields["Qx"])).FillBytes(pkBin[:sizeFp])
+		new(big.Int).SetBytes(decodeHex(t, fields["Qy"])).FillBytes(pkBin[sizeFp:])
+		var sig Signature
+		new(big.Int).SetBytes(decodeHex(t, fields["R"])).FillBytes(sig.R[:])
+		new(big.Int).SetBytes(decodeHex(t, fields["S"])).FillBytes(sig.S[:])
+
+		valid := false
+		var publicKey PublicKey
+		if _, err := publicKey.SetBytes(pkBin[:]); err == nil {
+			valid, _ = publicKey.Verify(sig.Bytes(), msg, sha256.New())
+		}
+		if expected := kv[1][0] == 'P'; valid != expected {
+			t.Fatalf("vector %d: expected %q, got valid=%v", nbTests, kv[1], valid)
+		}
+		nbTests++
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if nbTests == 0 {
+		t.Fatal("no test vectors found")
+	}
+}
+
+func TestCrossCheckStdlib(t *testing.T) {
+	msg := []byte("testing ECDSA")
+	digest := sha256.Sum256(msg)
+
+	for i := 0; i < 10; i++ {
+		privKey, err := GenerateKey(crand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdPriv := &stdecdsa.PrivateKey{
+			PublicKey: stdecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     privKey.PublicKey.A.X.BigInt(new(big.Int)),
+				Y:     privKey.PublicKey.A.Y.BigInt(new(big.Int)),
+			},
+			D: new(big.Int).SetBytes(privKey.scalar[:]),
+		}
+
+		// signed here, verified by crypto/ecdsa
+		sigBin, err := privKey.Sign(msg, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := new(big.Int).SetBytes(sigBin[:sizeFr])
+		s := new(big.Int).SetBytes(sigBin[sizeFr:])
+		if !stdecdsa.Verify(&stdPriv.PublicKey, digest[:], r, s) {
+			t.Fatal("crypto/ecdsa rejects the signature")
+		}
+
+		// signed by crypto/ecdsa, verified here
+		r, s, err = stdecdsa.Sign(crand.Reader, stdPriv, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sig Signature
+		r.FillBytes(sig.R[:])
+		s.FillBytes(sig.S[:])
+		if ok, err := privKey.PublicKey.Verify(sig.Bytes(), msg, sha256.New()); err != nil || !ok {
+			t.Fatal("the signature of crypto/ecdsa should verify")
+		}
+		// pre-hashed message
+		if ok, err := privKey.PublicKey.Verify(sig.Bytes(), digest[:], nil); err != nil || !ok {
+			t.Fatal("the signature of crypto/ecdsa should verify on the digest")
+		}
+	}
+}
+
+// TestEdgeCases checks that malformed signatures are rejected: out of range r
+// and s, wrong sizes and modified signatures. The cases are derived from a fresh
+// signature; they are not the Wycheproof test vectors.
+func TestEdgeCases(t *testing.T) {
+	privKey, err := GenerateKey(crand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := privKey.PublicKey
+	msg := []byte("testing ECDSA")
+	sigBin, err := privKey.Sign(msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+
+	order := fr.Modulus()
+	withRS := func(r, s *big.Int) []byte {
+		var res Signature
+		r.FillBytes(res.R[:])
+		s.FillBytes(res.S[:])
+		return res.Bytes()
+	}
+	r := new(big.Int).SetBytes(sig.R[:])
+	s := new(big.Int).SetBytes(sig.S[:])
+
+	invalid := map[string][]byte{
+		"r=0":       withRS(big.NewInt(0), s),
+		"s=0":       withRS(r, big.NewInt(0)),
+		"r=n":       withRS(order, s),
+		"s=n":       withRS(r, order),
+		"r=n-1":     withRS(new(big.Int).Sub(order, big.NewInt(1)), s),
+		"r,s swap":  withRS(s, r),
+		"r=s=1":     withRS(big.NewInt(1), big.NewInt(1)),
+		"truncated": sigBin[:sizeSignature-1],
+		"appended":  append(append([]byte{}, sigBin...), 0),
+	}
+	for name, bin := range invalid {
+		if ok, _ := publicKey.Verify(bin, msg, sha256.New()); ok {
+			t.Fatalf("%s: invalid signature accepted", name)
+		}
+	}
+
+	// -s is a valid signature too, ECDSA does not normalize s
+	negS := new(big.Int).Sub(order, s)
+	if ok, err := publicKey.Verify(withRS(r, negS), msg, sha256.New()); err != nil || !ok {
+		t.Fatal("(r, n-s) should verify")
+	}
+
+	// the signature does not verify for another message
+	if ok, _ := publicKey.Verify(sigBin, []byte("wrong message"), sha256.New()); ok {
+		t.Fatal("signature accepted for the wrong message")
+	}
+
+	// public key not on the curve
+	pkBin := publicKey.Bytes()
+	pkBin[sizePublicKey-1] ^= 1
+	var wrongKey PublicKey
+	if _, err := wrongKey.SetBytes(pkBin); err == nil {
+		t.Fatal("public key not on the curve accepted")
+	}
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
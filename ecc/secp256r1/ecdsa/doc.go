@@ -0,0 +1,43 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ecdsa provides ECDSA signature scheme on the secp256r1 curve.
+//
+// The implementation is adapted from https://pkg.go.dev/crypto/ecdsa.
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+//
+// Documentation:
+// - Wikipedia: https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
+// - FIPS 186-4: https://nvlpubs.nist.gov/nistpubs/FIPS/NIST.FIPS.186-4.pdf
+// - SEC 1, v-2: https://www.secg.org/sec1-v2.pdf
+//
+// Key generation and signing handle the private key and the nonce with the
+// constant-time scalar multiplication and field arithmetic (ScalarMultiplicationBaseCT,
+// SetBigIntCT, MulCT, AddCT, InverseCT); verification only handles public values
+// and uses the faster variable-time algorithms.
+//
+// Sign derives the nonce from a CSPRNG keyed by the private key, fresh entropy
+// and the message. SignRFC6979 and SignDigestRFC6979 derive it
+// deterministically (RFC 6979), so that the signatures match the ones of other
+// implementations. Signatures are encoded as r||s (Bytes) or in ASN.1 DER
+// (BytesDER). Verify and VerifyDER accept both {r, s} and {r, r_mod-s}, as
+// crypto/ecdsa does; VerifyLowS and VerifyDERLowS only accept the signature
+// with a low s.
+// SignCompact returns deterministic signatures with a low s in the compact
+// format r||s||v, from which the public key can be recovered.
+package ecdsa
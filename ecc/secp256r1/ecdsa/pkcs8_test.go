@@ -0,0 +1,106 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPKCS8Stdlib(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	std := &ecdsa.PrivateKey{
+		PublicKey: *privKey.PublicKey.stdPublicKey(),
+		D:         new(big.Int).SetBytes(privKey.scalar[:]),
+	}
+
+	// our encodings are the ones of crypto/x509
+	der, err := x509.MarshalPKCS8PrivateKey(std)
+	require.NoError(t, err)
+	require.Equal(t, der, privKey.BytesPKCS8())
+	der, err = x509.MarshalPKIXPublicKey(&std.PublicKey)
+	require.NoError(t, err)
+	require.Equal(t, der, privKey.PublicKey.BytesPKIX())
+
+	parsed, err := x509.ParsePKCS8PrivateKey(privKey.BytesPKCS8())
+	require.NoError(t, err)
+	require.True(t, std.Equal(parsed))
+	parsedPub, err := x509.ParsePKIXPublicKey(privKey.PublicKey.BytesPKIX())
+	require.NoError(t, err)
+	require.True(t, privKey.PublicKey.EqualPublicKey(parsedPub))
+}
+
+func TestPKCS8(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var decoded PrivateKey
+	der := privKey.BytesPKCS8()
+	n, err := decoded.SetBytesPKCS8(der)
+	require.NoError(t, err)
+	require.Equal(t, len(der), n)
+	require.Equal(t, privKey.scalar, decoded.scalar)
+	require.True(t, decoded.PublicKey.Equal(&privKey.PublicKey))
+
+	var pub PublicKey
+	der = privKey.PublicKey.BytesPKIX()
+	n, err = pub.SetBytesPKIX(der)
+	require.NoError(t, err)
+	require.Equal(t, len(der), n)
+	require.True(t, pub.Equal(&privKey.PublicKey))
+
+	pemBytes := privKey.BytesPEM()
+	n, err = decoded.SetBytesPEM(append(pemBytes, privKey.PublicKey.BytesPEM()...))
+	require.NoError(t, err)
+	require.Equal(t, len(pemBytes), n)
+	require.Equal(t, privKey.scalar, decoded.scalar)
+	_, err = pub.SetBytesPEM(pemBytes)
+	require.ErrorIs(t, err, errInvalidPEM)
+
+	// the public key must match the private key
+	other, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der = privKey.BytesPKCS8()
+	copy(der[len(der)-len(other.PublicKey.uncompressedBytes()):], other.PublicKey.uncompressedBytes())
+	_, err = decoded.SetBytesPKCS8(der)
+	require.ErrorIs(t, err, errInvalidPKCS8)
+
+	// keys on another curve
+	std, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	der, err = x509.MarshalPKCS8PrivateKey(std)
+	require.NoError(t, err)
+	_, err = decoded.SetBytesPKCS8(der)
+	require.ErrorIs(t, err, errWrongCurve)
+	der, err = x509.MarshalPKIXPublicKey(&std.PublicKey)
+	require.NoError(t, err)
+	_, err = pub.SetBytesPKIX(der)
+	require.ErrorIs(t, err, errWrongCurve)
+
+	// point not on the curve
+	der = privKey.PublicKey.BytesPKIX()
+	der[len(der)-1] ^= 1
+	_, err = pub.SetBytesPKIX(der)
+	require.ErrorIs(t, err, errInvalidPoint)
+}
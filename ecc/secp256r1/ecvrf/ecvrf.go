@@ -0,0 +1,420 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecvrf
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256r1"
+	"github.com/consensys/gnark-crypto/ecc/secp256r1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256r1/fr"
+)
+
+var (
+	ErrInvalidProof     = errors.New("invalid proof")
+	ErrInvalidPublicKey = errors.New("invalid public key")
+	ErrInvalidEncoding  = errors.New("invalid encoding")
+	ErrUnknownSuite     = errors.New("unknown suite")
+)
+
+// Suite is an ECVRF ciphersuite of RFC 9381 on P-256, identified by its
+// suite_string. The suites only differ by encode_to_curve.
+type Suite byte
+
+const (
+	// SuiteTAI is ECVRF-P256-SHA256-TAI, with encode_to_curve_try_and_increment
+	SuiteTAI Suite = 0x01
+	// SuiteSSWU is ECVRF-P256-SHA256-SSWU, with the encode_to_curve
+	// P256_XMD:SHA-256_SSWU_NU_ of RFC 9380. It is the suite of PrivateKey.Prove,
+	// PublicKey.Verify and ProofToHash.
+	SuiteSSWU Suite = 0x02
+)
+
+func (suite Suite) suiteString() []byte {
+	return []byte{byte(suite)}
+}
+
+const (
+	h2cSuiteID = "P256_XMD:SHA-256_SSWU_NU_"
+
+	sizeFr    = fr.Bytes
+	sizePoint = 1 + fp.Bytes // ptLen, SEC 1 compressed
+	sizeC     = 16           // cLen
+	sizeProof = sizePoint + sizeC + sizeFr
+
+	// SizePublicKey is the size of the encoding of a public key
+	SizePublicKey = sizePoint
+	// SizePrivateKey is the size of the encoding of a private key
+	SizePrivateKey = sizeFr
+	// SizeProof is the size of a proof π
+	SizeProof = sizeProof
+	// SizeHash is the size of the VRF output β
+	SizeHash = sha256.Size
+)
+
+var order = fr.Modulus()
+
+// PublicKey is an ECVRF public key Y = [x]B
+type PublicKey struct {
+	A secp256r1.G1Affine
+}
+
+// PrivateKey is an ECVRF private key x
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	// procedure of FIPS 186-4, Appendix B.5.1
+	b := make([]byte, fr.Bits/8+8)
+	if _, err := io.ReadFull(rand, b); err != nil {
+		return nil, err
+	}
+	one := big.NewInt(1)
+	k := new(big.Int).SetBytes(b)
+	n := new(big.Int).Sub(order, one)
+	k.Mod(k, n).Add(k, one)
+
+	privateKey := new(PrivateKey)
+	k.FillBytes(privateKey.scalar[:])
+	privateKey.PublicKey.A.ScalarMultiplicationBaseCT(k)
+	return privateKey, nil
+}
+
+// Bytes returns the SEC 1 compressed encoding of the public key.
+func (pub *PublicKey) Bytes() []byte {
+	return pointToString(&pub.A)
+}
+
+// SetBytes sets pub from its SEC 1 compressed encoding, and checks that it is a
+// valid public key.
+func (pub *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	if err := stringToPoint(&pub.A, buf[:SizePublicKey]); err != nil {
+		return 0, err
+	}
+	if pub.A.IsInfinity() {
+		return 0, ErrInvalidPublicKey
+	}
+	return SizePublicKey, nil
+}
+
+// Bytes returns the big-endian encoding of the private scalar.
+func (privKey *PrivateKey) Bytes() []byte {
+	res := make([]byte, SizePrivateKey)
+	subtle.ConstantTimeCopy(1, res, privKey.scalar[:])
+	return res
+}
+
+// SetBytes sets privKey from the big-endian encoding of the private scalar, and
+// computes the public key.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizePrivateKey {
+		return 0, io.ErrShortBuffer
+	}
+	var x big.Int
+	x.SetBytes(buf[:SizePrivateKey])
+	if x.Sign() == 0 || x.Cmp(order) >= 0 {
+		return 0, ErrInvalidEncoding
+	}
+	copy(privKey.scalar[:], buf[:SizePrivateKey])
+	privKey.PublicKey.A.ScalarMultiplicationBaseCT(&x)
+	return SizePrivateKey, nil
+}
+
+// Prove returns the proof π of the VRF output of alpha (ECVRF_prove).
+func (privKey *PrivateKey) Prove(alpha []byte) ([]byte, error) {
+	return prove(SuiteSSWU, privKey, alpha)
+}
+
+// Verify checks the proof pi of the VRF output of alpha, and returns the output β
+// (ECVRF_verify). It returns ErrInvalidProof if the proof is invalid.
+func (pub *PublicKey) Verify(pi, alpha []byte) ([]byte, error) {
+	return verify(SuiteSSWU, pub, pi, alpha)
+}
+
+// ProofToHash returns the VRF output β of the proof pi (ECVRF_proof_to_hash). It
+// doesn't verify the proof: the output must not be used before Verify succeeds.
+func ProofToHash(pi []byte) ([]byte, error) {
+	return proofToHash(SuiteSSWU, pi)
+}
+
+// Prove returns the proof π of the VRF output of alpha with the suite.
+func (suite Suite) Prove(privKey *PrivateKey, alpha []byte) ([]byte, error) {
+	if suite != SuiteTAI && suite != SuiteSSWU {
+		return nil, ErrUnknownSuite
+	}
+	return prove(suite, privKey, alpha)
+}
+
+// Verify checks the proof pi of the VRF output of alpha with the suite, and
+// returns the output β. It returns ErrInvalidProof if the proof is invalid.
+func (suite Suite) Verify(pub *PublicKey, pi, alpha []byte) ([]byte, error) {
+	if suite != SuiteTAI && suite != SuiteSSWU {
+		return nil, ErrUnknownSuite
+	}
+	return verify(suite, pub, pi, alpha)
+}
+
+// ProofToHash returns the VRF output β of the proof pi with the suite. It
+// doesn't verify the proof: the output must not be used before Verify succeeds.
+func (suite Suite) ProofToHash(pi []byte) ([]byte, error) {
+	if suite != SuiteTAI && suite != SuiteSSWU {
+		return nil, ErrUnknownSuite
+	}
+	return proofToHash(suite, pi)
+}
+
+func prove(suite Suite, privKey *PrivateKey, alpha []byte) ([]byte, error) {
+	var x big.Int
+	x.SetBytes(privKey.scalar[:])
+
+	// H = encode_to_curve(Y, α), Γ = [x]H
+	h, err := encodeToCurve(suite, &privKey.PublicKey.A, alpha)
+	if err != nil {
+		return nil, err
+	}
+	var gamma secp256r1.G1Affine
+	gamma.ScalarMultiplicationCT(&h, &x)
+
+	// k = nonce(x, H), c = challenge(Y, H, Γ, [k]B, [k]H), s = k + c⋅x
+	hString := pointToString(&h)
+	k := nonceRFC6979(privKey.scalar[:], hString)
+	var kB, kH secp256r1.G1Affine
+	kB.ScalarMultiplicationBaseCT(k)
+	kH.ScalarMultiplicationCT(&h, k)
+	c := challenge(suite, &privKey.PublicKey.A, &h, &gamma, &kB, &kH)
+
+	// the secret values are handled with the constant-time arithmetic of fr
+	var xFr, kFr, sFr fr.Element
+	xFr.SetBigIntCT(&x)
+	kFr.SetBigIntCT(k)
+	sFr.SetBigInt(c)
+	sFr.MulCT(&sFr, &xFr).AddCT(&sFr, &kFr)
+	sBytes := sFr.Bytes()
+	k.SetUint64(0)
+	x.SetUint64(0)
+	xFr.SetZero()
+	kFr.SetZero()
+
+	pi := make([]byte, 0, sizeProof)
+	pi = append(pi, pointToString(&gamma)...)
+	pi = append(pi, c.FillBytes(make([]byte, sizeC))...)
+	pi = append(pi, sBytes[:]...)
+	return pi, nil
+}
+
+func verify(suite Suite, pub *PublicKey, pi, alpha []byte) ([]byte, error) {
+	if !pub.A.IsOnCurve() || pub.A.IsInfinity() {
+		return nil, ErrInvalidPublicKey
+	}
+	var gamma secp256r1.G1Affine
+	var c, s big.Int
+	if err := decodeProof(pi, &gamma, &c, &s); err != nil {
+		return nil, err
+	}
+
+	h, err := encodeToCurve(suite, &pub.A, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	// U = [s]B - [c]Y, V = [s]H - [c]Γ
+	var u, v, t secp256r1.G1Affine
+	u.ScalarMultiplicationBase(&s)
+	t.ScalarMultiplication(&pub.A, &c)
+	u.Sub(&u, &t)
+	v.ScalarMultiplication(&h, &s)
+	t.ScalarMultiplication(&gamma, &c)
+	v.Sub(&v, &t)
+
+	if challenge(suite, &pub.A, &h, &gamma, &u, &v).Cmp(&c) != 0 {
+		return nil, ErrInvalidProof
+	}
+	return gammaToHash(suite, &gamma), nil
+}
+
+func proofToHash(suite Suite, pi []byte) ([]byte, error) {
+	var gamma secp256r1.G1Affine
+	var c, s big.Int
+	if err := decodeProof(pi, &gamma, &c, &s); err != nil {
+		return nil, err
+	}
+	return gammaToHash(suite, &gamma), nil
+}
+
+// gammaToHash returns Hash(suite_string || 0x03 || point_to_string(Γ) || 0x00),
+// the cofactor being 1
+func gammaToHash(suite Suite, gamma *secp256r1.G1Affine) []byte {
+	h := sha256.New()
+	h.Write(suite.suiteString())
+	h.Write([]byte{0x03})
+	h.Write(pointToString(gamma))
+	h.Write([]byte{0x00})
+	return h.Sum(nil)
+}
+
+// decodeProof decodes π = point_to_string(Γ) || int_to_string(c, cLen) || int_to_string(s, qLen)
+func decodeProof(pi []byte, gamma *secp256r1.G1Affine, c, s *big.Int) error {
+	if len(pi) != sizeProof {
+		return ErrInvalidProof
+	}
+	if err := stringToPoint(gamma, pi[:sizePoint]); err != nil {
+		return ErrInvalidProof
+	}
+	c.SetBytes(pi[sizePoint : sizePoint+sizeC])
+	s.SetBytes(pi[sizePoint+sizeC:])
+	if s.Cmp(order) >= 0 {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// encodeToCurve returns encode_to_curve(point_to_string(Y) || α) with the domain
+// separation tag "ECVRF_" || h2c_suite_ID_string || suite_string
+func encodeToCurve(suite Suite, y *secp256r1.G1Affine, alpha []byte) (secp256r1.G1Affine, error) {
+	if suite == SuiteTAI {
+		return encodeToCurveTAI(y, alpha)
+	}
+	msg := append(pointToString(y), alpha...)
+	return secp256r1.EncodeToG1(msg, []byte("ECVRF_"+h2cSuiteID+string(suite.suiteString())))
+}
+
+// encodeToCurveTAI returns the first valid point string_to_point(0x02 || h) for
+// h = Hash(suite_string || 0x01 || point_to_string(Y) || α || ctr || 0x00) and
+// ctr = 0, 1, … (ECVRF_encode_to_curve_try_and_increment)
+func encodeToCurveTAI(y *secp256r1.G1Affine, alpha []byte) (secp256r1.G1Affine, error) {
+	var res secp256r1.G1Affine
+	yString := pointToString(y)
+	buf := make([]byte, 1, sizePoint)
+	buf[0] = 0x02
+	for ctr := 0; ctr < 256; ctr++ {
+		h := sha256.New()
+		h.Write([]byte{byte(SuiteTAI), 0x01})
+		h.Write(yString)
+		h.Write(alpha)
+		h.Write([]byte{byte(ctr), 0x00})
+		if stringToPoint(&res, h.Sum(buf[:1])) == nil {
+			return res, nil
+		}
+	}
+	// happens with probability 2⁻²⁵⁶
+	return res, ErrInvalidEncoding
+}
+
+// challenge returns the first cLen bytes of
+// Hash(suite_string || 0x02 || point_to_string(P₁) || … || point_to_string(P₅) || 0x00)
+func challenge(suite Suite, points ...*secp256r1.G1Affine) *big.Int {
+	h := sha256.New()
+	h.Write(suite.suiteString())
+	h.Write([]byte{0x02})
+	for _, p := range points {
+		h.Write(pointToString(p))
+	}
+	h.Write([]byte{0x00})
+	return new(big.Int).SetBytes(h.Sum(nil)[:sizeC])
+}
+
+// nonceRFC6979 returns the nonce of RFC 6979 (section 3.2) for the private key x
+// and the message hString, with HMAC-SHA256 (ECVRF_nonce_generation_RFC6979).
+func nonceRFC6979(x, hString []byte) *big.Int {
+	h1 := sha256.Sum256(hString)
+	// bits2octets(h1): qlen = hlen = 256, so that bits2int is the identity
+	z := new(big.Int).SetBytes(h1[:])
+	z.Mod(z, order)
+	bh := z.FillBytes(make([]byte, sizeFr))
+
+	v := make([]byte, sha256.Size)
+	k := make([]byte, sha256.Size)
+	for i := range v {
+		v[i] = 0x01
+	}
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(sha256.New, key)
+		for _, d := range data {
+			m.Write(d)
+		}
+		return m.Sum(nil)
+	}
+	k = mac(k, v, []byte{0x00}, x, bh)
+	v = mac(k, v)
+	k = mac(k, v, []byte{0x01}, x, bh)
+	v = mac(k, v)
+
+	res := new(big.Int)
+	for {
+		v = mac(k, v)
+		res.SetBytes(v)
+		if res.Sign() > 0 && res.Cmp(order) < 0 {
+			return res
+		}
+		k = mac(k, v, []byte{0x00})
+		v = mac(k, v)
+	}
+}
+
+// pointToString returns the SEC 1 compressed encoding of p. The point at infinity
+// is encoded as a single zero byte.
+func pointToString(p *secp256r1.G1Affine) []byte {
+	if p.IsInfinity() {
+		return []byte{0x00}
+	}
+	res := make([]byte, sizePoint)
+	res[0] = 0x02
+	if p.Y.Bits()[0]&1 == 1 {
+		res[0] = 0x03
+	}
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[1:]), p.X)
+	return res
+}
+
+// stringToPoint sets p from its SEC 1 compressed encoding, and checks that it is
+// on the curve.
+func stringToPoint(p *secp256r1.G1Affine, buf []byte) error {
+	if len(buf) != sizePoint || (buf[0] != 0x02 && buf[0] != 0x03) {
+		return ErrInvalidEncoding
+	}
+	var err error
+	if p.X, err = fp.BigEndian.Element((*[fp.Bytes]byte)(buf[1:])); err != nil {
+		return ErrInvalidEncoding
+	}
+	// y² = x³ + ax + b
+	a, b := secp256r1.CurveCoefficients()
+	var y2, t fp.Element
+	y2.Square(&p.X).Mul(&y2, &p.X)
+	t.Mul(&a, &p.X)
+	y2.Add(&y2, &t).Add(&y2, &b)
+	if p.Y.Sqrt(&y2) == nil {
+		return ErrInvalidEncoding
+	}
+	if byte(p.Y.Bits()[0]&1) != buf[0]&1 {
+		p.Y.Neg(&p.Y)
+	}
+	if !p.IsOnCurve() {
+		return ErrInvalidEncoding
+	}
+	return nil
+}
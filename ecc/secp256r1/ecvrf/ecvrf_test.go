@@ -0,0 +1,185 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecvrf
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProveVerify(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pub := privKey.PublicKey
+	alpha := []byte("ecvrf on secp256r1")
+
+	pi, err := privKey.Prove(alpha)
+	require.NoError(t, err)
+	require.Len(t, pi, SizeProof)
+
+	beta, err := pub.Verify(pi, alpha)
+	require.NoError(t, err)
+	require.Len(t, beta, SizeHash)
+
+	// the output doesn't depend on the proof randomness, there is none
+	pi2, err := privKey.Prove(alpha)
+	require.NoError(t, err)
+	require.Equal(t, pi, pi2)
+
+	beta2, err := ProofToHash(pi)
+	require.NoError(t, err)
+	require.Equal(t, beta, beta2)
+
+	t.Run("other input", func(t *testing.T) {
+		_, err := pub.Verify(pi, []byte("other"))
+		require.ErrorIs(t, err, ErrInvalidProof)
+
+		pi2, err := privKey.Prove([]byte("other"))
+		require.NoError(t, err)
+		beta2, err := pub.Verify(pi2, []byte("other"))
+		require.NoError(t, err)
+		require.NotEqual(t, beta, beta2)
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		other, err := GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		_, err = other.PublicKey.Verify(pi, alpha)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+
+	t.Run("tampered proof", func(t *testing.T) {
+		for _, i := range []int{1, sizePoint, sizePoint + sizeC, SizeProof - 1} {
+			tampered := append([]byte{}, pi...)
+			tampered[i] ^= 1
+			_, err := pub.Verify(tampered, alpha)
+			require.ErrorIs(t, err, ErrInvalidProof, "byte %d", i)
+		}
+		_, err := pub.Verify(pi[:SizeProof-1], alpha)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	})
+}
+
+func TestSerialization(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var pub PublicKey
+	n, err := pub.SetBytes(privKey.PublicKey.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, SizePublicKey, n)
+	require.True(t, pub.A.Equal(&privKey.PublicKey.A))
+
+	var priv PrivateKey
+	n, err = priv.SetBytes(privKey.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, SizePrivateKey, n)
+	require.Equal(t, privKey.Bytes(), priv.Bytes())
+	require.True(t, priv.PublicKey.A.Equal(&privKey.PublicKey.A))
+
+	_, err = priv.SetBytes(make([]byte, SizePrivateKey))
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+	b := pub.Bytes()
+	b[0] = 0x04
+	_, err = pub.SetBytes(b)
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+}
+
+// TestNonceRFC6979 checks the nonce generation against the P-256, SHA-256 test
+// vectors of RFC 6979, appendix A.2.5.
+func TestNonceRFC6979(t *testing.T) {
+	x, err := hex.DecodeString("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	require.NoError(t, err)
+
+	var priv PrivateKey
+	_, err = priv.SetBytes(x)
+	require.NoError(t, err)
+	pub, err := hex.DecodeString("0360fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6")
+	require.NoError(t, err)
+	require.Equal(t, pub, priv.PublicKey.Bytes())
+
+	for msg, k := range map[string]string{
+		"sample": "a6e3c57dd01abe90086538398355dd4c3b17aa873382b0f24d6129493d8aad60",
+		"test":   "d16b6ae827f17175e040871a1c7ec3500192c4c92677336ec2537acaee0008e0",
+	} {
+		expected, ok := new(big.Int).SetString(k, 16)
+		require.True(t, ok)
+		require.Equal(t, expected, nonceRFC6979(x, []byte(msg)), msg)
+	}
+}
+
+// TestRFC9381 checks the suite ECVRF-P256-SHA256-TAI against the examples 10 and
+// 11 of RFC 9381, appendix B.1. SuiteSSWU only differs by encode_to_curve, which
+// is EncodeToG1 and is checked against the vectors of RFC 9380.
+//
+// TODO: add the ECVRF-P256-SHA256-SSWU examples 13 to 15 of RFC 9381, appendix
+// B.2; until then SuiteSSWU is only covered by the round trips of TestProveVerify.
+func TestRFC9381(t *testing.T) {
+	const sk = "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721"
+	for _, v := range []struct {
+		alpha, pi, beta string
+	}{
+		{
+			alpha: "73616d706c65", // "sample"
+			pi:    "035b5c726e8c0e2c488a107c600578ee75cb702343c153cb1eb8dec77f4b5071b4a53f0a46f018bc2c56e58d383f2305e0975972c26feea0eb122fe7893c15af376b33edf7de17c6ea056d4d82de6bc02f",
+			beta:  "a3ad7b0ef73d8fc6655053ea22f9bede8c743f08bbed3d38821f0e16474b505e",
+		},
+		{
+			alpha: "74657374", // "test"
+			pi:    "034dac60aba508ba0c01aa9be80377ebd7562c4a52d74722e0abae7dc3080ddb56c19e067b15a8a8174905b13617804534214f935b94c2287f797e393eb0816969d864f37625b443f30f1a5a33f2b3c854",
+			beta:  "a284f94ceec2ff4b3794629da7cbafa49121972671b466cab4ce170aa365f26d",
+		},
+	} {
+		x, err := hex.DecodeString(sk)
+		require.NoError(t, err)
+		alpha, err := hex.DecodeString(v.alpha)
+		require.NoError(t, err)
+		expectedPi, err := hex.DecodeString(v.pi)
+		require.NoError(t, err)
+		expectedBeta, err := hex.DecodeString(v.beta)
+		require.NoError(t, err)
+
+		var priv PrivateKey
+		_, err = priv.SetBytes(x)
+		require.NoError(t, err)
+		pi, err := SuiteTAI.Prove(&priv, alpha)
+		require.NoError(t, err)
+		require.Equal(t, expectedPi, pi, v.alpha)
+
+		beta, err := SuiteTAI.Verify(&priv.PublicKey, pi, alpha)
+		require.NoError(t, err)
+		require.Equal(t, expectedBeta, beta, v.alpha)
+		beta, err = SuiteTAI.ProofToHash(pi)
+		require.NoError(t, err)
+		require.Equal(t, expectedBeta, beta, v.alpha)
+
+		// the proofs of a suite don't verify with the other one
+		_, err = SuiteSSWU.Verify(&priv.PublicKey, pi, alpha)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		pi, err = priv.Prove(alpha)
+		require.NoError(t, err)
+		_, err = SuiteTAI.Verify(&priv.PublicKey, pi, alpha)
+		require.ErrorIs(t, err, ErrInvalidProof)
+	}
+
+	_, err := Suite(0x03).ProofToHash(nil)
+	require.ErrorIs(t, err, ErrUnknownSuite)
+}
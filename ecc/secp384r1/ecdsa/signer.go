@@ -0,0 +1,80 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto"
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+)
+
+var errInvalidDigestSize = errors.New("digest size doesn't match the hash function")
+
+// CryptoSigner returns an adapter of privKey to the crypto.Signer interface of
+// the standard library. The signatures are ASN.1 DER encoded (see
+// Signature.BytesDER).
+// The public key is a *crypto/ecdsa.PublicKey, so that the key can be used
+// with crypto/tls and crypto/x509.
+func (privKey *PrivateKey) CryptoSigner() crypto.Signer {
+	return (*cryptoSigner)(privKey)
+}
+
+type cryptoSigner PrivateKey
+
+// Public returns the public key of the signer.
+func (s *cryptoSigner) Public() crypto.PublicKey {
+	return s.PublicKey.stdPublicKey()
+}
+
+// Sign signs digest, the hash of a message with opts.HashFunc(). The nonce is
+// derived with RFC 6979 and the HMAC of the same hash function (SHA-256 if
+// opts doesn't specify one), so that rand is not used.
+func (s *cryptoSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hFunc := sha256.New
+	if opts != nil && opts.HashFunc() != 0 {
+		h := opts.HashFunc()
+		if !h.Available() {
+			return nil, errors.New("hash function " + h.String() + " is not available")
+		}
+		if len(digest) != h.Size() {
+			return nil, errInvalidDigestSize
+		}
+		hFunc = h.New
+	}
+	sigBin, err := (*PrivateKey)(s).SignDigestRFC6979(digest, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return nil, err
+	}
+	return sig.BytesDER(), nil
+}
+
+// stdPublicKey returns pub as a *crypto/ecdsa.PublicKey.
+func (pub *PublicKey) stdPublicKey() *stdecdsa.PublicKey {
+	return &stdecdsa.PublicKey{
+		Curve: elliptic.P384(),
+		X:     pub.A.X.BigInt(new(big.Int)),
+		Y:     pub.A.Y.BigInt(new(big.Int)),
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+import (
+	"crypto/sha512"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// TestWycheproof checks VerifyDER and VerifyDERLowS against the Wycheproof
+// vectors for secp384r1 with SHA-384 (test_vectors/LICENSE.wycheproof). Most
+// of them are malformed or non-canonical DER encodings of a valid signature.
+func TestWycheproof(t *testing.T) {
+	f, err := os.Open("test_vectors/ecdsa_secp384r1_sha384_test.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var vectors struct {
+		NumberOfTests int `json:"numberOfTests"`
+		TestGroups    []struct {
+			PublicKeyDer string `json:"publicKeyDer"`
+			Sha          string `json:"sha"`
+			Tests        []struct {
+				TcID    int    `json:"tcId"`
+				Comment string `json:"comment"`
+				Msg     string `json:"msg"`
+				Sig     string `json:"sig"`
+				Result  string `json:"result"`
+			} `json:"tests"`
+		} `json:"testGroups"`
+	}
+	if err := json.NewDecoder(f).Decode(&vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	nbTests := 0
+	for _, group := range vectors.TestGroups {
+		if group.Sha != "SHA-384" {
+			t.Fatalf("unexpected hash function %s", group.Sha)
+		}
+		var publicKey PublicKey
+		if _, err := publicKey.SetBytesPKIX(decodeHex(t, group.PublicKeyDer)); err != nil {
+			t.Fatal(err)
+		}
+		for _, test := range group.Tests {
+			nbTests++
+			msg := decodeHex(t, test.Msg)
+			sigDER := decodeHex(t, test.Sig)
+			expected := test.Result == "valid"
+
+			valid, _ := publicKey.VerifyDER(sigDER, msg, sha512.New384())
+			if valid != expected {
+				t.Fatalf("tcId %d (%s): expected %s, got valid=%v", test.TcID, test.Comment, test.Result, valid)
+			}
+
+			validLowS, err := publicKey.VerifyDERLowS(sigDER, msg, sha512.New384())
+			if expected {
+				var sig Signature
+				if _, err := sig.SetBytesDER(sigDER); err != nil {
+					t.Fatal(err)
+				}
+				expected = sig.IsLowS()
+			}
+			if validLowS != expected {
+				t.Fatalf("tcId %d (%s): low-s verification returned %v, %v", test.TcID, test.Comment, validLowS, err)
+			}
+		}
+	}
+	if nbTests != vectors.NumberOfTests {
+		t.Fatalf("expected %d test vectors, got %d", vectors.NumberOfTests, nbTests)
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+// expBySqrtExp is equivalent to z.Exp(x, 3fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffbfffffffc00000000000000040000000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_110     = 2*_11
+	//	_111     = 1 + _110
+	//	_111000  = _111 << 3
+	//	_111111  = _111 + _111000
+	//	_1111110 = 2*_111111
+	//	_1111111 = 1 + _1111110
+	//	x12      = _1111110 << 5 + _111111
+	//	x24      = x12 << 12 + x12
+	//	x31      = x24 << 7 + _1111111
+	//	x32      = 2*x31 + 1
+	//	x63      = x32 << 31 + x31
+	//	x126     = x63 << 63 + x63
+	//	x252     = x126 << 126 + x126
+	//	x255     = x252 << 3 + _111
+	//	return     ((x255 << 33 + x32) << 64 + 1) << 30
+	//
+	// Operations: 381 squares 14 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+	)
+
+	// var t0,t1,t2 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, z)
+
+	// Step 3: z = x^0x6
+	z.Square(z)
+
+	// Step 4: t0 = x^0x7
+	t0.Mul(&x, z)
+
+	// Step 7: z = x^0x38
+	z.Square(t0)
+	for s := 1; s < 3; s++ {
+		z.Square(z)
+	}
+
+	// Step 8: t1 = x^0x3f
+	t1.Mul(t0, z)
+
+	// Step 9: t2 = x^0x7e
+	t2.Square(t1)
+
+	// Step 10: z = x^0x7f
+	z.Mul(&x, t2)
+
+	// Step 15: t2 = x^0xfc0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 16: t1 = x^0xfff
+	t1.Mul(t1, t2)
+
+	// Step 28: t2 = x^0xfff000
+	t2.Square(t1)
+	for s := 1; s < 12; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 29: t1 = x^0xffffff
+	t1.Mul(t1, t2)
+
+	// Step 36: t1 = x^0x7fffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 37: t1 = x^0x7fffffff
+	t1.Mul(z, t1)
+
+	// Step 38: z = x^0xfffffffe
+	z.Square(t1)
+
+	// Step 39: z = x^0xffffffff
+	z.Mul(&x, z)
+
+	// Step 70: t2 = x^0x7fffffff80000000
+	t2.Square(z)
+	for s := 1; s < 31; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 71: t1 = x^0x7fffffffffffffff
+	t1.Mul(t1, t2)
+
+	// Step 134: t2 = x^0x3fffffffffffffff8000000000000000
+	t2.Square(t1)
+	for s := 1; s < 63; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 135: t1 = x^0x3fffffffffffffffffffffffffffffff
+	t1.Mul(t1, t2)
+
+	// Step 261: t2 = x^0xfffffffffffffffffffffffffffffffc0000000000000000000000000000000
+	t2.Square(t1)
+	for s := 1; s < 126; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 262: t1 = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff
+	t1.Mul(t1, t2)
+
+	// Step 265: t1 = x^0x7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8
+	for s := 0; s < 3; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 266: t0 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff
+	t0.Mul(t0, t1)
+
+	// Step 299: t0 = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe00000000
+	for s := 0; s < 33; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 300: z = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffff
+	z.Mul(z, t0)
+
+	// Step 364: z = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffff0000000000000000
+	for s := 0; s < 64; s++ {
+		z.Square(z)
+	}
+
+	// Step 365: z = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffff0000000000000001
+	z.Mul(&x, z)
+
+	// Step 395: z = x^0x3fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffbfffffffc00000000000000040000000
+	for s := 0; s < 30; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7fffffff80000000000000007fffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_110     = 2*_11
+	//	_111     = 1 + _110
+	//	_111000  = _111 << 3
+	//	_111111  = _111 + _111000
+	//	_1111110 = 2*_111111
+	//	_1111111 = 1 + _1111110
+	//	x12      = _1111110 << 5 + _111111
+	//	x24      = x12 << 12 + x12
+	//	x31      = x24 << 7 + _1111111
+	//	x32      = 2*x31 + 1
+	//	x63      = x32 << 31 + x31
+	//	x126     = x63 << 63 + x63
+	//	x252     = x126 << 126 + x126
+	//	x255     = x252 << 3 + _111
+	//	return     (x255 << 33 + x32) << 95 + x31
+	//
+	// Operations: 382 squares 14 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+	)
+
+	// var t0,t1,t2,t3 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: z = x^0x3
+	z.Mul(&x, z)
+
+	// Step 3: z = x^0x6
+	z.Square(z)
+
+	// Step 4: t1 = x^0x7
+	t1.Mul(&x, z)
+
+	// Step 7: z = x^0x38
+	z.Square(t1)
+	for s := 1; s < 3; s++ {
+		z.Square(z)
+	}
+
+	// Step 8: t0 = x^0x3f
+	t0.Mul(t1, z)
+
+	// Step 9: t2 = x^0x7e
+	t2.Square(t0)
+
+	// Step 10: z = x^0x7f
+	z.Mul(&x, t2)
+
+	// Step 15: t2 = x^0xfc0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 16: t0 = x^0xfff
+	t0.Mul(t0, t2)
+
+	// Step 28: t2 = x^0xfff000
+	t2.Square(t0)
+	for s := 1; s < 12; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 29: t0 = x^0xffffff
+	t0.Mul(t0, t2)
+
+	// Step 36: t0 = x^0x7fffff80
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 37: z = x^0x7fffffff
+	z.Mul(z, t0)
+
+	// Step 38: t0 = x^0xfffffffe
+	t0.Square(z)
+
+	// Step 39: t0 = x^0xffffffff
+	t0.Mul(&x, t0)
+
+	// Step 70: t2 = x^0x7fffffff80000000
+	t2.Square(t0)
+	for s := 1; s < 31; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 71: t2 = x^0x7fffffffffffffff
+	t2.Mul(z, t2)
+
+	// Step 134: t3 = x^0x3fffffffffffffff8000000000000000
+	t3.Square(t2)
+	for s := 1; s < 63; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 135: t2 = x^0x3fffffffffffffffffffffffffffffff
+	t2.Mul(t2, t3)
+
+	// Step 261: t3 = x^0xfffffffffffffffffffffffffffffffc0000000000000000000000000000000
+	t3.Square(t2)
+	for s := 1; s < 126; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 262: t2 = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff
+	t2.Mul(t2, t3)
+
+	// Step 265: t2 = x^0x7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8
+	for s := 0; s < 3; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 266: t1 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff
+	t1.Mul(t1, t2)
+
+	// Step 299: t1 = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe00000000
+	for s := 0; s < 33; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 300: t0 = x^0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffff
+	t0.Mul(t0, t1)
+
+	// Step 395: t0 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7fffffff800000000000000000000000
+	for s := 0; s < 95; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 396: z = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7fffffff80000000000000007fffffff
+	z.Mul(z, t0)
+
+	return z
+}
@@ -0,0 +1,923 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab3314a5d)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_11110    = 2*_1111
+	//	_11111    = 1 + _11110
+	//	_1111100  = _11111 << 2
+	//	_11111000 = 2*_1111100
+	//	i14       = 2*_11111000
+	//	i20       = i14 << 5 + i14
+	//	i31       = i20 << 10 + i20
+	//	i58       = (i31 << 4 + _11111000) << 21 + i31
+	//	i110      = (i58 << 3 + _1111100) << 47 + i58
+	//	x194      = i110 << 95 + i110 + _1111
+	//	i225      = ((x194 << 6 + _111) << 3 + _11) << 7
+	//	i235      = 2*((_1101 + i225) << 6 + _1101) + 1
+	//	i258      = ((i235 << 11 + _11111) << 2 + 1) << 8
+	//	i269      = ((_1101 + i258) << 2 + _11) << 6 + _1011
+	//	i286      = ((i269 << 4 + _111) << 6 + _11111) << 5
+	//	i308      = ((_1011 + i286) << 10 + _1101) << 9 + _1101
+	//	i323      = ((i308 << 4 + _1011) << 6 + _1001) << 3
+	//	i340      = ((1 + i323) << 7 + _1011) << 7 + _101
+	//	i357      = ((i340 << 5 + _111) << 5 + _1111) << 5
+	//	i369      = ((_1011 + i357) << 4 + _1011) << 5 + _111
+	//	i387      = ((i369 << 3 + _11) << 7 + _11) << 6
+	//	i397      = ((_1011 + i387) << 4 + _101) << 3 + _11
+	//	i413      = ((i397 << 4 + _11) << 4 + _11) << 6
+	//	i427      = ((_101 + i413) << 5 + _101) << 6 + _1011
+	//	return      i427 << 3 + _101
+	//
+	// Operations: 379 squares 52 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10 Element
+	// Step 1: t3 = x^0x2
+	t3.Square(&x)
+
+	// Step 2: t1 = x^0x3
+	t1.Mul(&x, t3)
+
+	// Step 3: z = x^0x5
+	z.Mul(t3, t1)
+
+	// Step 4: t2 = x^0x7
+	t2.Mul(t3, z)
+
+	// Step 5: t4 = x^0x9
+	t4.Mul(t3, t2)
+
+	// Step 6: t0 = x^0xb
+	t0.Mul(t3, t4)
+
+	// Step 7: t5 = x^0xd
+	t5.Mul(t3, t0)
+
+	// Step 8: t3 = x^0xf
+	t3.Mul(t3, t5)
+
+	// Step 9: t6 = x^0x1e
+	t6.Square(t3)
+
+	// Step 10: t6 = x^0x1f
+	t6.Mul(&x, t6)
+
+	// Step 12: t8 = x^0x7c
+	t8.Square(t6)
+	for s := 1; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 13: t9 = x^0xf8
+	t9.Square(t8)
+
+	// Step 14: t7 = x^0x1f0
+	t7.Square(t9)
+
+	// Step 19: t10 = x^0x3e00
+	t10.Square(t7)
+	for s := 1; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 20: t7 = x^0x3ff0
+	t7.Mul(t7, t10)
+
+	// Step 30: t10 = x^0xffc000
+	t10.Square(t7)
+	for s := 1; s < 10; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 31: t7 = x^0xfffff0
+	t7.Mul(t7, t10)
+
+	// Step 35: t10 = x^0xfffff00
+	t10.Square(t7)
+	for s := 1; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 36: t9 = x^0xffffff8
+	t9.Mul(t9, t10)
+
+	// Step 57: t9 = x^0x1ffffff000000
+	for s := 0; s < 21; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 58: t7 = x^0x1fffffffffff0
+	t7.Mul(t7, t9)
+
+	// Step 61: t9 = x^0xfffffffffff80
+	t9.Square(t7)
+	for s := 1; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 62: t8 = x^0xffffffffffffc
+	t8.Mul(t8, t9)
+
+	// Step 109: t8 = x^0x7fffffffffffe000000000000
+	for s := 0; s < 47; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 110: t7 = x^0x7fffffffffffffffffffffff0
+	t7.Mul(t7, t8)
+
+	// Step 205: t8 = x^0x3fffffffffffffffffffffff8000000000000000000000000
+	t8.Square(t7)
+	for s := 1; s < 95; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 206: t7 = x^0x3fffffffffffffffffffffffffffffffffffffffffffffff0
+	t7.Mul(t7, t8)
+
+	// Step 207: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff
+	t7.Mul(t3, t7)
+
+	// Step 213: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 214: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7
+	t7.Mul(t2, t7)
+
+	// Step 217: t7 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe38
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 218: t7 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b
+	t7.Mul(t1, t7)
+
+	// Step 225: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 226: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d
+	t7.Mul(t5, t7)
+
+	// Step 232: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc76340
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 233: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d
+	t7.Mul(t5, t7)
+
+	// Step 234: t7 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69a
+	t7.Square(t7)
+
+	// Step 235: t7 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b
+	t7.Mul(&x, t7)
+
+	// Step 246: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d800
+	for s := 0; s < 11; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 247: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f
+	t7.Mul(t6, t7)
+
+	// Step 249: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 250: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d
+	t7.Mul(&x, t7)
+
+	// Step 258: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 259: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0d
+	t7.Mul(t5, t7)
+
+	// Step 261: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f434
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 262: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f437
+	t7.Mul(t1, t7)
+
+	// Step 268: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dc0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 269: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb
+	t7.Mul(t0, t7)
+
+	// Step 273: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb0
+	for s := 0; s < 4; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 274: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb7
+	t7.Mul(t2, t7)
+
+	// Step 280: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372dc0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 281: t6 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf
+	t6.Mul(t6, t7)
+
+	// Step 286: t6 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbe0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 287: t6 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb
+	t6.Mul(t0, t6)
+
+	// Step 297: t6 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac00
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 298: t6 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d
+	t6.Mul(t5, t6)
+
+	// Step 307: t6 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a00
+	for s := 0; s < 9; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 308: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0d
+	t5.Mul(t5, t6)
+
+	// Step 312: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0d0
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 313: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db
+	t5.Mul(t0, t5)
+
+	// Step 319: t5 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 320: t4 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c9
+	t4.Mul(t4, t5)
+
+	// Step 323: t4 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b648
+	for s := 0; s < 3; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 324: t4 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b649
+	t4.Mul(&x, t4)
+
+	// Step 331: t4 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db2480
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 332: t4 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b
+	t4.Mul(t0, t4)
+
+	// Step 339: t4 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d924580
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 340: t4 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d924585
+	t4.Mul(z, t4)
+
+	// Step 345: t4 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 346: t4 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a7
+	t4.Mul(t2, t4)
+
+	// Step 351: t4 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614e0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 352: t3 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef
+	t3.Mul(t3, t4)
+
+	// Step 357: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29de0
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 358: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29deb
+	t3.Mul(t0, t3)
+
+	// Step 362: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29deb0
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 363: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb
+	t3.Mul(t0, t3)
+
+	// Step 368: t3 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd760
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 369: t2 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd767
+	t2.Mul(t2, t3)
+
+	// Step 372: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb38
+	for s := 0; s < 3; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 373: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b
+	t2.Mul(t1, t2)
+
+	// Step 380: t2 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d80
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 381: t2 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d83
+	t2.Mul(t1, t2)
+
+	// Step 387: t2 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760c0
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 388: t2 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb
+	t2.Mul(t0, t2)
+
+	// Step 392: t2 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb0
+	for s := 0; s < 4; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 393: t2 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb5
+	t2.Mul(z, t2)
+
+	// Step 396: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065a8
+	for s := 0; s < 3; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 397: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab
+	t2.Mul(t1, t2)
+
+	// Step 401: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab0
+	for s := 0; s < 4; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 402: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab3
+	t2.Mul(t1, t2)
+
+	// Step 406: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab30
+	for s := 0; s < 4; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 407: t1 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab33
+	t1.Mul(t1, t2)
+
+	// Step 413: t1 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a77aecec196accc0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 414: t1 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a77aecec196accc5
+	t1.Mul(z, t1)
+
+	// Step 419: t1 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d832d5998a0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 420: t1 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d832d5998a5
+	t1.Mul(z, t1)
+
+	// Step 426: t1 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb56662940
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 427: t0 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb5666294b
+	t0.Mul(t0, t1)
+
+	// Step 430: t0 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab3314a58
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 431: z = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab3314a5d
+	z.Mul(z, t0)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb5666294b9)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_11110    = 2*_1111
+	//	_11111    = 1 + _11110
+	//	_1111100  = _11111 << 2
+	//	_11111000 = 2*_1111100
+	//	i14       = 2*_11111000
+	//	i20       = i14 << 5 + i14
+	//	i31       = i20 << 10 + i20
+	//	i58       = (i31 << 4 + _11111000) << 21 + i31
+	//	i110      = (i58 << 3 + _1111100) << 47 + i58
+	//	x194      = i110 << 95 + i110 + _1111
+	//	i225      = ((x194 << 6 + _111) << 3 + _11) << 7
+	//	i235      = 2*((_1101 + i225) << 6 + _1101) + 1
+	//	i258      = ((i235 << 11 + _11111) << 2 + 1) << 8
+	//	i269      = ((_1101 + i258) << 2 + _11) << 6 + _1011
+	//	i286      = ((i269 << 4 + _111) << 6 + _11111) << 5
+	//	i308      = ((_1011 + i286) << 10 + _1101) << 9 + _1101
+	//	i323      = ((i308 << 4 + _1011) << 6 + _1001) << 3
+	//	i340      = ((1 + i323) << 7 + _1011) << 7 + _101
+	//	i357      = ((i340 << 5 + _111) << 5 + _1111) << 5
+	//	i369      = ((_1011 + i357) << 4 + _1011) << 5 + _111
+	//	i387      = ((i369 << 3 + _11) << 7 + _11) << 6
+	//	i397      = ((_1011 + i387) << 4 + _101) << 3 + _11
+	//	i413      = ((i397 << 4 + _11) << 4 + _11) << 6
+	//	i427      = ((_101 + i413) << 5 + _101) << 6 + _1011
+	//	return      i427 << 4 + _1001
+	//
+	// Operations: 380 squares 52 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: t2 = x^0x3
+	t2.Mul(&x, t4)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(t4, t2)
+
+	// Step 4: t3 = x^0x7
+	t3.Mul(t4, t1)
+
+	// Step 5: z = x^0x9
+	z.Mul(t4, t3)
+
+	// Step 6: t0 = x^0xb
+	t0.Mul(t4, z)
+
+	// Step 7: t5 = x^0xd
+	t5.Mul(t4, t0)
+
+	// Step 8: t4 = x^0xf
+	t4.Mul(t4, t5)
+
+	// Step 9: t6 = x^0x1e
+	t6.Square(t4)
+
+	// Step 10: t6 = x^0x1f
+	t6.Mul(&x, t6)
+
+	// Step 12: t8 = x^0x7c
+	t8.Square(t6)
+	for s := 1; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 13: t9 = x^0xf8
+	t9.Square(t8)
+
+	// Step 14: t7 = x^0x1f0
+	t7.Square(t9)
+
+	// Step 19: t10 = x^0x3e00
+	t10.Square(t7)
+	for s := 1; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 20: t7 = x^0x3ff0
+	t7.Mul(t7, t10)
+
+	// Step 30: t10 = x^0xffc000
+	t10.Square(t7)
+	for s := 1; s < 10; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 31: t7 = x^0xfffff0
+	t7.Mul(t7, t10)
+
+	// Step 35: t10 = x^0xfffff00
+	t10.Square(t7)
+	for s := 1; s < 4; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 36: t9 = x^0xffffff8
+	t9.Mul(t9, t10)
+
+	// Step 57: t9 = x^0x1ffffff000000
+	for s := 0; s < 21; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 58: t7 = x^0x1fffffffffff0
+	t7.Mul(t7, t9)
+
+	// Step 61: t9 = x^0xfffffffffff80
+	t9.Square(t7)
+	for s := 1; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 62: t8 = x^0xffffffffffffc
+	t8.Mul(t8, t9)
+
+	// Step 109: t8 = x^0x7fffffffffffe000000000000
+	for s := 0; s < 47; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 110: t7 = x^0x7fffffffffffffffffffffff0
+	t7.Mul(t7, t8)
+
+	// Step 205: t8 = x^0x3fffffffffffffffffffffff8000000000000000000000000
+	t8.Square(t7)
+	for s := 1; s < 95; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 206: t7 = x^0x3fffffffffffffffffffffffffffffffffffffffffffffff0
+	t7.Mul(t7, t8)
+
+	// Step 207: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff
+	t7.Mul(t4, t7)
+
+	// Step 213: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 214: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7
+	t7.Mul(t3, t7)
+
+	// Step 217: t7 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe38
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 218: t7 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b
+	t7.Mul(t2, t7)
+
+	// Step 225: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 226: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d
+	t7.Mul(t5, t7)
+
+	// Step 232: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc76340
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 233: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d
+	t7.Mul(t5, t7)
+
+	// Step 234: t7 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69a
+	t7.Square(t7)
+
+	// Step 235: t7 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b
+	t7.Mul(&x, t7)
+
+	// Step 246: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d800
+	for s := 0; s < 11; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 247: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f
+	t7.Mul(t6, t7)
+
+	// Step 249: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 250: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d
+	t7.Mul(&x, t7)
+
+	// Step 258: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 259: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0d
+	t7.Mul(t5, t7)
+
+	// Step 261: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f434
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 262: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f437
+	t7.Mul(t2, t7)
+
+	// Step 268: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dc0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 269: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb
+	t7.Mul(t0, t7)
+
+	// Step 273: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb0
+	for s := 0; s < 4; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 274: t7 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb7
+	t7.Mul(t3, t7)
+
+	// Step 280: t7 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372dc0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 281: t6 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf
+	t6.Mul(t6, t7)
+
+	// Step 286: t6 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbe0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 287: t6 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb
+	t6.Mul(t0, t6)
+
+	// Step 297: t6 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac00
+	for s := 0; s < 10; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 298: t6 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d
+	t6.Mul(t5, t6)
+
+	// Step 307: t6 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a00
+	for s := 0; s < 9; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 308: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0d
+	t5.Mul(t5, t6)
+
+	// Step 312: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0d0
+	for s := 0; s < 4; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 313: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db
+	t5.Mul(t0, t5)
+
+	// Step 319: t5 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 320: t5 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c9
+	t5.Mul(z, t5)
+
+	// Step 323: t5 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b648
+	for s := 0; s < 3; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 324: t5 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b649
+	t5.Mul(&x, t5)
+
+	// Step 331: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db2480
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 332: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b
+	t5.Mul(t0, t5)
+
+	// Step 339: t5 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d924580
+	for s := 0; s < 7; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 340: t5 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d924585
+	t5.Mul(t1, t5)
+
+	// Step 345: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 346: t5 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a7
+	t5.Mul(t3, t5)
+
+	// Step 351: t5 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614e0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 352: t4 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef
+	t4.Mul(t4, t5)
+
+	// Step 357: t4 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29de0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 358: t4 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29deb
+	t4.Mul(t0, t4)
+
+	// Step 362: t4 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29deb0
+	for s := 0; s < 4; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 363: t4 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb
+	t4.Mul(t0, t4)
+
+	// Step 368: t4 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd760
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 369: t3 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd767
+	t3.Mul(t3, t4)
+
+	// Step 372: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb38
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 373: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b
+	t3.Mul(t2, t3)
+
+	// Step 380: t3 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d80
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 381: t3 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d83
+	t3.Mul(t2, t3)
+
+	// Step 387: t3 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760c0
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 388: t3 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb
+	t3.Mul(t0, t3)
+
+	// Step 392: t3 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb0
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 393: t3 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb5
+	t3.Mul(t1, t3)
+
+	// Step 396: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065a8
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 397: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab
+	t3.Mul(t2, t3)
+
+	// Step 401: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab0
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 402: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab3
+	t3.Mul(t2, t3)
+
+	// Step 406: t3 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab30
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 407: t2 = x^0x3ffffffffffffffffffffffffffffffffffffffffffffffff1d8d3607d0dcb77d606836c922c29debb3b065ab33
+	t2.Mul(t2, t3)
+
+	// Step 413: t2 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a77aecec196accc0
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 414: t2 = x^0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a77aecec196accc5
+	t2.Mul(t1, t2)
+
+	// Step 419: t2 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d832d5998a0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 420: t1 = x^0x1ffffffffffffffffffffffffffffffffffffffffffffffff8ec69b03e86e5bbeb0341b6491614ef5d9d832d5998a5
+	t1.Mul(t1, t2)
+
+	// Step 426: t1 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb56662940
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 427: t0 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb5666294b
+	t0.Mul(t0, t1)
+
+	// Step 431: t0 = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb5666294b0
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 432: z = x^0x7fffffffffffffffffffffffffffffffffffffffffffffffe3b1a6c0fa1b96efac0d06d9245853bd76760cb5666294b9
+	z.Mul(z, t0)
+
+	return z
+}
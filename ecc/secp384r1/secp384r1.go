@@ -0,0 +1,89 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secp384r1 efficient elliptic curve implementation for secp384r1, also known as P-384. This curve is defined in Standards for Efficient Cryptography (SEC) (Certicom Research, http://www.secg.org/sec2-v2.pdf) and FIPS 186-5, and appears in the ECDSA signatures of TLS.
+//
+// secp384r1: A prime order curve with
+//
+//	𝔽r: r=39402006196394479212279040100143613805079739270465446667946905279627659399113263569398956308152294913554433653942643
+//	𝔽p: p=39402006196394479212279040100143613805079739270465446667948293404245721771496870329047266088258938001861606973112319 (2^384 - 2^128 - 2^96 + 2^32 - 1)
+//	(E/𝔽p): Y²=X³-3X+b
+//
+// Security: estimated 192-bit level using Pollard's \rho attack
+// (r is 384 bits)
+//
+// # Fixed-base scalar multiplication
+//
+// G1FixedBaseTable stores the multiples of a point which is used as a base many times,
+// with a window size trading memory for speed. ScalarMultiplicationBase uses such a
+// table for the generator, computed on first use.
+//
+// # Constant time
+//
+// ScalarMultiplicationCT, ScalarMultiplicationBaseCT and
+// ScalarMultiplicationFixedBaseCT don't branch on the scalar nor access memory
+// depending on it, and are used by the signature schemes for the secret scalars. The
+// other scalar multiplications are faster but not constant time.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package secp384r1
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp384r1/fp"
+)
+
+// ID secp384r1 ID
+const ID = ecc.SECP384R1
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+
+// generator of the r-torsion group
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+
+func init() {
+	aCurveCoeff.SetInt64(-3)
+	bCurveCoeff.SetString("27580193559959705877849011840389048093056905856361568521428707301988689241309860865136260764883745107765439761230575")
+
+	g1Gen.X.SetString("26247035095799689268623156744566981891852923491109213387815615900925518854738050089022388053975719786650872476732087")
+	g1Gen.Y.SetString("8325710961489029985546751289520108179287853048861315594709205902480503199884419224438643760392947333078086511627871")
+	g1Gen.Z.SetOne()
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+}
+
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
@@ -0,0 +1,183 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
+)
+
+// The functions of this file follow the conventions of Starknet signatures, as
+// implemented in the [reference implementation] (and starknet.py):
+//   - the signed message is a hash, an integer 0 ≤ m < 2²⁵¹, which is not
+//     truncated;
+//   - the nonce is derived deterministically (RFC 6979 with HMAC-SHA256), from
+//     the message hash padded as in elliptic.js;
+//   - r and w = s⁻¹ are in [1, 2²⁵¹[;
+//   - the public key may be given by its x-coordinate only (stark key).
+//
+// [reference implementation]: https://github.com/starkware-libs/cairo-lang/blob/master/src/starkware/crypto/signature/signature.py
+
+// nbBitsStarknet is the bound on the size of the message hash, r and w.
+const nbBitsStarknet = 251
+
+var (
+	// ErrInvalidMessageHash is returned when the message hash is not in [0, 2²⁵¹[.
+	ErrInvalidMessageHash = errors.New("message hash must be smaller than 2^251")
+	// ErrInvalidStarkKey is returned when a stark key is not the x-coordinate
+	// of a point on the curve.
+	ErrInvalidStarkKey = errors.New("stark key is not the x-coordinate of a point on the curve")
+)
+
+var boundStarknet = new(big.Int).Lsh(big.NewInt(1), nbBitsStarknet)
+
+// inRangeStarknet returns true if 1 ≤ x < 2²⁵¹
+func inRangeStarknet(x *big.Int) bool {
+	return x.Sign() > 0 && x.Cmp(boundStarknet) < 0
+}
+
+// StarkKey returns the stark key, the x-coordinate of the public key.
+func (pub *PublicKey) StarkKey() fp.Element {
+	return pub.A.X
+}
+
+// SetStarkKey sets pub from a stark key. Of the two points of x-coordinate
+// starkKey, it chooses the one with the even y-coordinate. Signatures are
+// verified against both by VerifyStarknet.
+func (pub *PublicKey) SetStarkKey(starkKey *fp.Element) error {
+	// y² = x³ + ax + b
+	a, b := starkcurve.CurveCoefficients()
+	var y2, t fp.Element
+	y2.Square(starkKey).Mul(&y2, starkKey)
+	t.Mul(&a, starkKey)
+	y2.Add(&y2, &t).Add(&y2, &b)
+	if pub.A.Y.Sqrt(&y2) == nil {
+		return ErrInvalidStarkKey
+	}
+	if pub.A.Y.Bits()[0]&1 == 1 {
+		pub.A.Y.Neg(&pub.A.Y)
+	}
+	pub.A.X.Set(starkKey)
+	return nil
+}
+
+// SignStarknet signs the message hash msgHash with the conventions of Starknet
+// and returns the signature (r, s). The nonce is derived from the private key
+// and the message hash (RFC 6979). seed is optional extra entropy (nil if
+// none): the signature is then deterministic and matches the ones of
+// starknet.py.
+func (privKey *PrivateKey) SignStarknet(msgHash, seed *big.Int) (r, s *big.Int, err error) {
+	if msgHash.Sign() < 0 || msgHash.Cmp(boundStarknet) >= 0 {
+		return nil, nil, ErrInvalidMessageHash
+	}
+	// pad the message hash for consistency with elliptic.js, when it is one
+	// nibble short
+	data := new(big.Int).Set(msgHash)
+	if l := data.BitLen(); l >= 248 && l%8 >= 1 && l%8 <= 4 {
+		data.Lsh(data, 4)
+	}
+
+	// the secret values are handled with the constant-time arithmetic of fr and G1
+	var scalar, kFr, wFr, sFr, rFr, mFr fr.Element
+	scalar.SetBigIntCT(new(big.Int).SetBytes(privKey.scalar[:]))
+	mFr.SetBigInt(msgHash)
+	r, s = new(big.Int), new(big.Int)
+	// on failure, retry with the seed 1, 2, … or seed+1, seed+2, …
+	for ; ; seed = nextSeed(seed) {
+		var extraEntropy []byte
+		if seed != nil {
+			extraEntropy = seed.Bytes()
+		}
+		k, err := privKey.nonceRFC6979(data.Bytes(), extraEntropy, sha256.New)()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var P starkcurve.G1Affine
+		P.ScalarMultiplicationBaseCT(k)
+		P.X.BigInt(r)
+		if !inRangeStarknet(r) {
+			continue
+		}
+
+		// w = k / (m + r⋅sk), s = w⁻¹
+		rFr.SetBigInt(r)
+		wFr.MulCT(&rFr, &scalar).AddCT(&wFr, &mFr)
+		if wFr.IsZero() {
+			continue
+		}
+		kFr.SetBigIntCT(k)
+		wFr.InverseCT(&wFr).MulCT(&wFr, &kFr)
+		wFr.BigInt(s)
+		if !inRangeStarknet(s) {
+			continue
+		}
+		sFr.InverseCT(&wFr).BigInt(s)
+		k.SetUint64(0)
+		return r, s, nil
+	}
+}
+
+func nextSeed(seed *big.Int) *big.Int {
+	if seed == nil {
+		return big.NewInt(1)
+	}
+	return new(big.Int).Add(seed, big.NewInt(1))
+}
+
+// VerifyStarknet checks the signature (r, s) of the message hash msgHash with
+// the conventions of Starknet. As the public key may be known only by its
+// x-coordinate, the signature is accepted for both points of that
+// x-coordinate.
+func (pub *PublicKey) VerifyStarknet(msgHash, r, s *big.Int) bool {
+	if msgHash.Sign() < 0 || msgHash.Cmp(boundStarknet) >= 0 ||
+		!inRangeStarknet(r) || s.Sign() <= 0 || s.Cmp(order) >= 0 {
+		return false
+	}
+	w := new(big.Int).ModInverse(s, order)
+	if w == nil || !inRangeStarknet(w) {
+		return false
+	}
+	if !pub.A.IsOnCurve() || pub.A.IsInfinity() {
+		return false
+	}
+
+	// x(w⋅(m⋅G ± r⋅Q)) ?= r
+	u1 := new(big.Int).Mul(msgHash, w)
+	u1.Mod(u1, order)
+	u2 := new(big.Int).Mul(r, w)
+	u2.Mod(u2, order)
+	var Q starkcurve.G1Affine
+	for _, q := range []*starkcurve.G1Affine{&pub.A, Q.Neg(&pub.A)} {
+		var U starkcurve.G1Jac
+		U.JointScalarMultiplicationBase(q, u1, u2)
+		var x big.Int
+		U.Z.Square(&U.Z).
+			Inverse(&U.Z).
+			Mul(&U.Z, &U.X).
+			BigInt(&x)
+		if x.Cmp(r) == 0 {
+			return true
+		}
+	}
+	return false
+}
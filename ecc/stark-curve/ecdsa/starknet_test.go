@@ -0,0 +1,109 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+	"github.com/stretchr/testify/require"
+)
+
+func bigFromHex(t *testing.T, s string) *big.Int {
+	res, ok := new(big.Int).SetString(s, 0)
+	require.True(t, ok, s)
+	return res
+}
+
+func TestStarknetVectors(t *testing.T) {
+	// test vectors of starknet.py and starknet.js
+	var privKey PrivateKey
+	x := bigFromHex(t, "0x3c1e9550e66958296d11b60f8e8e7a7ad990d07fa65d5f7652c4a6c87d4e3cc")
+	x.FillBytes(privKey.scalar[:])
+	privKey.PublicKey.A.ScalarMultiplicationBase(x)
+	starkKey := privKey.PublicKey.StarkKey()
+	require.Equal(t, "77a3b314db07c45076d11f62b6f9e748a39790441823307743cf00d6597ea43", starkKey.Text(16))
+
+	msgHash := bigFromHex(t, "0x397e76d1667c4454bfb83514e120583af836f8e32a516765497823eabe16a3f")
+	r, s, err := privKey.SignStarknet(msgHash, nil)
+	require.NoError(t, err)
+	require.Equal(t, bigFromHex(t, "0x173fd03d8b008ee7432977ac27d1e9d1a1f6c98b1a2f05fa84a21c84c44e882"), r)
+	require.Equal(t, bigFromHex(t, "0x4b6d75385aed025aa222f28a0adc6d58db78ff17e51c3f59e259b131cd5a1cc"), s)
+
+	var pub PublicKey
+	require.NoError(t, pub.SetStarkKey(&starkKey))
+	require.True(t, pub.VerifyStarknet(msgHash, r, s))
+	require.False(t, pub.VerifyStarknet(new(big.Int).Add(msgHash, big.NewInt(1)), r, s))
+}
+
+func TestStarknetSignVerify(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pub := privKey.PublicKey
+
+	msgHash, err := rand.Int(rand.Reader, boundStarknet)
+	require.NoError(t, err)
+	r, s, err := privKey.SignStarknet(msgHash, nil)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyStarknet(msgHash, r, s))
+
+	// the signature is deterministic, unless a seed is given
+	r2, s2, err := privKey.SignStarknet(msgHash, nil)
+	require.NoError(t, err)
+	require.Equal(t, r, r2)
+	require.Equal(t, s, s2)
+	r2, s2, err = privKey.SignStarknet(msgHash, big.NewInt(1))
+	require.NoError(t, err)
+	require.NotEqual(t, r, r2)
+	require.True(t, pub.VerifyStarknet(msgHash, r2, s2))
+
+	// verification with the stark key only
+	var fromKey PublicKey
+	starkKey := pub.StarkKey()
+	require.NoError(t, fromKey.SetStarkKey(&starkKey))
+	require.True(t, fromKey.VerifyStarknet(msgHash, r, s))
+
+	// the signature is also a regular ECDSA signature of the message hash, when
+	// it is not truncated (less than 32 bytes)
+	shortHash := new(big.Int).Rsh(msgHash, 8)
+	r2, s2, err = privKey.SignStarknet(shortHash, nil)
+	require.NoError(t, err)
+	var sig Signature
+	r2.FillBytes(sig.R[:])
+	s2.FillBytes(sig.S[:])
+	ok, err := pub.Verify(sig.Bytes(), shortHash.FillBytes(make([]byte, sizeFr-1)), nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// out of range inputs
+	_, _, err = privKey.SignStarknet(boundStarknet, nil)
+	require.ErrorIs(t, err, ErrInvalidMessageHash)
+	require.False(t, pub.VerifyStarknet(new(big.Int).Add(msgHash, boundStarknet), r, s))
+	require.False(t, pub.VerifyStarknet(msgHash, new(big.Int).Add(r, boundStarknet), s))
+	require.False(t, pub.VerifyStarknet(msgHash, r, new(big.Int).Add(s, order)))
+
+	var x fp.Element
+	for {
+		x.SetRandom()
+		if fromKey.SetStarkKey(&x) != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, fromKey.SetStarkKey(&x), ErrInvalidStarkKey)
+}
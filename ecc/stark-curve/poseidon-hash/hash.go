@@ -0,0 +1,66 @@
+package poseidonhash
+
+import (
+	"errors"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+// BlockSize is the size of the field elements consumed by the hash.Hash.
+const BlockSize = fp.Bytes
+
+var errInvalidLength = errors.New("invalid input length: must represent a list of field elements, expects a []byte of len m*BlockSize")
+
+type digest struct {
+	data []*fp.Element
+}
+
+// New returns a hash.Hash computing PoseidonArray of the field elements written
+// to it. The input must be a list of canonical, big-endian encoded field
+// elements; a shorter input is left-padded to a single field element.
+func New() hash.Hash {
+	return new(digest)
+}
+
+// Write absorbs the field elements encoded in p.
+func (d *digest) Write(p []byte) (int, error) {
+	if len(p) > 0 && len(p) < BlockSize {
+		pp := make([]byte, BlockSize)
+		copy(pp[len(pp)-len(p):], p)
+		p = pp
+	}
+	if len(p)%BlockSize != 0 {
+		return 0, errInvalidLength
+	}
+	elems := make([]*fp.Element, 0, len(p)/BlockSize)
+	for start := 0; start < len(p); start += BlockSize {
+		elem, err := fp.BigEndian.Element((*[BlockSize]byte)(p[start : start+BlockSize]))
+		if err != nil {
+			return 0, err
+		}
+		elems = append(elems, &elem)
+	}
+	d.data = append(d.data, elems...)
+	return len(p), nil
+}
+
+// Sum appends the big-endian encoding of PoseidonArray of the absorbed elements
+// to b. It does not change the state of the hash.
+func (d *digest) Sum(b []byte) []byte {
+	h := PoseidonArray(d.data...)
+	res := h.Bytes()
+	return append(b, res[:]...)
+}
+
+func (d *digest) Reset() {
+	d.data = d.data[:0]
+}
+
+func (d *digest) Size() int {
+	return fp.Bytes
+}
+
+func (d *digest) BlockSize() int {
+	return BlockSize
+}
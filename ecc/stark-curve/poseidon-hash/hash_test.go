@@ -0,0 +1,54 @@
+package poseidonhash
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+func TestHash(t *testing.T) {
+	elems := make([]*fp.Element, 5)
+	var buf []byte
+	for i := range elems {
+		elems[i], _ = new(fp.Element).SetRandom()
+		b := elems[i].Bytes()
+		buf = append(buf, b[:]...)
+	}
+	want := PoseidonArray(elems...)
+
+	h := New()
+	if _, err := h.Write(buf[:2*BlockSize]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Write(buf[2*BlockSize:]); err != nil {
+		t.Fatal(err)
+	}
+	if got := h.Sum(nil); !bytes.Equal(got, want.Marshal()) {
+		t.Errorf("Sum = %x, want %x", got, want.Marshal())
+	}
+	// Sum doesn't change the state
+	if got := h.Sum(nil); !bytes.Equal(got, want.Marshal()) {
+		t.Errorf("Sum = %x, want %x", got, want.Marshal())
+	}
+
+	h.Reset()
+	if _, err := h.Write(buf[:BlockSize+1]); err == nil {
+		t.Error("expected an error for an invalid length")
+	}
+	h.Reset()
+	nonCanonical := fp.Modulus().FillBytes(make([]byte, BlockSize))
+	if _, err := h.Write(nonCanonical); err == nil {
+		t.Error("expected an error for a non canonical element")
+	}
+
+	// short inputs are left-padded
+	h.Reset()
+	if _, err := h.Write([]byte{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	want = PoseidonArray(new(fp.Element).SetUint64(0x0102))
+	if got := h.Sum(nil); !bytes.Equal(got, want.Marshal()) {
+		t.Errorf("Sum = %x, want %x", got, want.Marshal())
+	}
+}
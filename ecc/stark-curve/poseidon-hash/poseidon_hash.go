@@ -0,0 +1,135 @@
+package poseidonhash
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+// Parameters of the Starknet instance of the Hades permutation: a state of
+// width 3 (rate 2, capacity 1), 8 full rounds, 83 partial rounds and the x³
+// S-box.
+const (
+	width         = 3
+	nbFullRounds  = 8
+	nbPartRounds  = 83
+	nbRounds      = nbFullRounds + nbPartRounds
+	constantsSeed = "Hades"
+)
+
+var (
+	roundKeys [nbRounds][width]fp.Element
+	once      sync.Once
+)
+
+// initRoundKeys derives the round keys as in the [reference implementation]:
+// the i-th constant is sha256("Hades" || i) mod p, i written in decimal.
+//
+// [reference implementation]: https://github.com/starkware-industries/poseidon/blob/main/poseidon_params.py
+func initRoundKeys() {
+	for i := range roundKeys {
+		for j := range roundKeys[i] {
+			h := sha256.Sum256([]byte(constantsSeed + strconv.Itoa(i*width+j)))
+			roundKeys[i][j].SetBytes(h[:])
+		}
+	}
+}
+
+// HadesPermutation applies the Starknet [Hades permutation] to state, in place.
+//
+// [Hades permutation]: https://docs.starknet.io/architecture-and-concepts/cryptography/hash-functions/#poseidon_hash
+func HadesPermutation(state *[width]fp.Element) {
+	once.Do(initRoundKeys)
+
+	for i := 0; i < nbRounds; i++ {
+		// add round key
+		for j := range state {
+			state[j].Add(&state[j], &roundKeys[i][j])
+		}
+
+		// S-box, on the whole state in full rounds and on the last element in
+		// partial rounds
+		if i < nbFullRounds/2 || i >= nbFullRounds/2+nbPartRounds {
+			for j := range state {
+				cube(&state[j])
+			}
+		} else {
+			cube(&state[width-1])
+		}
+
+		mix(state)
+	}
+}
+
+// cube sets x to x³
+func cube(x *fp.Element) {
+	var t fp.Element
+	t.Square(x)
+	x.Mul(x, &t)
+}
+
+// mix multiplies the state by the MDS matrix
+//
+//	⎡3  1  1⎤
+//	⎢1 -1  1⎥
+//	⎣1  1 -2⎦
+func mix(state *[width]fp.Element) {
+	var s, t fp.Element
+	s.Add(&state[0], &state[1]).Add(&s, &state[2])
+
+	// 3a + b + c = s + 2a
+	t.Double(&state[0])
+	state[0].Add(&s, &t)
+	// a - b + c = s - 2b
+	t.Double(&state[1])
+	state[1].Sub(&s, &t)
+	// a + b - 2c = s - 3c
+	t.Double(&state[2]).Add(&t, &state[2])
+	state[2].Sub(&s, &t)
+}
+
+// Poseidon implements the Starknet [Poseidon hash] of two field elements
+// (poseidon_hash).
+//
+// [Poseidon hash]: https://docs.starknet.io/architecture-and-concepts/cryptography/hash-functions/#poseidon_hash
+func Poseidon(a, b *fp.Element) fp.Element {
+	state := [width]fp.Element{*a, *b}
+	state[2].SetUint64(2)
+	HadesPermutation(&state)
+	return state[0]
+}
+
+// PoseidonSingle implements the Starknet Poseidon hash of a single field element
+// (poseidon_hash_single).
+func PoseidonSingle(a *fp.Element) fp.Element {
+	state := [width]fp.Element{*a}
+	state[2].SetOne()
+	HadesPermutation(&state)
+	return state[0]
+}
+
+// PoseidonArray implements the Starknet Poseidon hash of an array of field
+// elements (poseidon_hash_many). The input is padded with 1 and then zeros to
+// an even length, and absorbed two elements at a time.
+func PoseidonArray(elems ...*fp.Element) fp.Element {
+	var state [width]fp.Element
+	var one fp.Element
+	one.SetOne()
+
+	for i := 0; i+1 < len(elems); i += 2 {
+		state[0].Add(&state[0], elems[i])
+		state[1].Add(&state[1], elems[i+1])
+		HadesPermutation(&state)
+	}
+	if len(elems)%2 == 1 {
+		state[0].Add(&state[0], elems[len(elems)-1])
+		state[1].Add(&state[1], &one)
+	} else {
+		state[0].Add(&state[0], &one)
+	}
+	HadesPermutation(&state)
+
+	return state[0]
+}
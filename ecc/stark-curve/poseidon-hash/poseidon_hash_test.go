@@ -0,0 +1,100 @@
+package poseidonhash
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+// The test vectors were generated with the reference implementation of
+// cairo-lang (v0.11.0), and are the ones of starknet-rs.
+
+func TestPoseidon(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{
+			"0xb662f9017fa7956fd70e26129b1833e10ad000fd37b4d9f4e0ce6884b7bbe",
+			"0x1fe356bf76102cdae1bfbdc173602ead228b12904c00dad9cf16e035468bea",
+			"0x75540825a6ecc5dc7d7c2f5f868164182742227f1367d66c43ee51ec7937a81",
+		},
+		{
+			"0xf4e01b2032298f86b539e3d3ac05ced20d2ef275273f9325f8827717156529",
+			"0x587bc46f5f58e0511b93c31134652a689d761a9e7f234f0f130c52e4679f3a",
+			"0xbdb3180fdcfd6d6f172beb401af54dd71b6569e6061767234db2b777adf98b",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("TestHash %d", i), func(t *testing.T) {
+			a, err := new(fp.Element).SetString(tt.a)
+			if err != nil {
+				t.Errorf("expected no error but got %s", err)
+			}
+			b, err := new(fp.Element).SetString(tt.b)
+			if err != nil {
+				t.Errorf("expected no error but got %s", err)
+			}
+			want, err := new(fp.Element).SetString(tt.want)
+			if err != nil {
+				t.Errorf("expected no error but got %s", err)
+			}
+
+			ans := Poseidon(a, b)
+			if !ans.Equal(want) {
+				t.Errorf("TestHash got %s, want %s", ans.Text(16), want.Text(16))
+			}
+		})
+	}
+}
+
+func TestPoseidonSingle(t *testing.T) {
+	a, _ := new(fp.Element).SetString("0x9dad5d6f502ccbcb6d34ede04f0337df3b98936aaf782f4cc07d147e3a4fd6")
+	want, _ := new(fp.Element).SetString("0x11222854783f17f1c580ff64671bc3868de034c236f956216e8ed4ab7533455")
+	if got := PoseidonSingle(a); !got.Equal(want) {
+		t.Errorf("PoseidonSingle(%x) = %x, want %x", a, got, want)
+	}
+}
+
+func TestPoseidonArray(t *testing.T) {
+	tests := [...]struct {
+		input []string
+		want  string
+	}{
+		{
+			input: []string{
+				"0x9bf52404586087391c5fbb42538692e7ca2149bac13c145ae4230a51a6fc47",
+				"0x40304159ee9d2d611120fbd7c7fb8020cc8f7a599bfa108e0e085222b862c0",
+				"0x46286e4f3c450761d960d6a151a9c0988f9e16f8a48d4c0a85817c009f806a",
+			},
+			want: "0x1ec38b38dc88bac7b0ed6ff6326f975a06a59ac601b417745fd412a5d38e4f7",
+		},
+	}
+	for _, test := range tests {
+		var data []*fp.Element
+		for _, item := range test.input {
+			elem, _ := new(fp.Element).SetString(item)
+			data = append(data, elem)
+		}
+		want, _ := new(fp.Element).SetString(test.want)
+		got := PoseidonArray(data...)
+		if !got.Equal(want) {
+			t.Errorf("PoseidonArray(%x) = %x, want %x", data, got, want)
+		}
+	}
+
+}
+
+var feltBench fp.Element
+
+func BenchmarkPoseidon(b *testing.B) {
+	e0, _ := new(fp.Element).SetRandom()
+	e1, _ := new(fp.Element).SetRandom()
+
+	var f fp.Element
+	for n := 0; n < b.N; n++ {
+		f = Poseidon(e0, e1)
+	}
+	feltBench = f
+}
@@ -0,0 +1,202 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	babybear "github.com/consensys/gnark-crypto/field/babybear"
+)
+
+// Domain with a power of 2 cardinality
+type Domain struct {
+	Cardinality            uint64
+	CardinalityInv         babybear.Element
+	Generator              babybear.Element
+	GeneratorInv           babybear.Element
+	FrMultiplicativeGen    babybear.Element // generator of Fr*
+	FrMultiplicativeGenInv babybear.Element
+
+	// the following slices are not serialized and are (re)computed through domain.preComputeTwiddles()
+
+	// twiddles[s] contains the twiddle factors of the stage s of the DIF transform
+	// (the stages of the DIT transform use the same tables in reverse order):
+	// twiddles[s][j] = Generator^(j·2ˢ), for j < Cardinality/2^(s+1)
+	twiddles [][]babybear.Element
+
+	// twiddlesInv contains the twiddle factors of the inverse transform (using GeneratorInv)
+	twiddlesInv [][]babybear.Element
+
+	// cosetTable[i] = FrMultiplicativeGen^i and cosetTableInv[i] = FrMultiplicativeGenInv^i
+	cosetTable    []babybear.Element
+	cosetTableInv []babybear.Element
+
+	withPrecompute bool
+}
+
+const (
+	maxOrderRoot = 27
+
+	// multiplicativeGen generates babybear.Elementˣ
+	multiplicativeGen = 31
+)
+
+// rootOfUnity is a root of unity of order 2^maxOrderRoot
+var rootOfUnity = babybear.Element{1476048622}
+
+// GeneratorFullMultiplicativeGroup returns a generator of babybear.Elementˣ
+func GeneratorFullMultiplicativeGroup() babybear.Element {
+	var res babybear.Element
+	res.SetUint64(multiplicativeGen)
+	return res
+}
+
+// NewDomain returns a subgroup with a power of 2 cardinality
+// cardinality >= m
+// shift: when specified, it's the element by which the set of root of unity is shifted.
+func NewDomain(m uint64, opts ...DomainOption) *Domain {
+	opt := domainOptions(opts...)
+	domain := &Domain{}
+	x := nextPowerOfTwo(m)
+	domain.Cardinality = x
+	domain.FrMultiplicativeGen = GeneratorFullMultiplicativeGroup()
+	if opt.shift != nil {
+		domain.FrMultiplicativeGen.Set(opt.shift)
+	}
+	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
+
+	var err error
+	domain.Generator, err = Generator(m)
+	if err != nil {
+		panic(err)
+	}
+	domain.GeneratorInv.Inverse(&domain.Generator)
+	domain.CardinalityInv.SetUint64(x).Inverse(&domain.CardinalityInv)
+
+	domain.withPrecompute = opt.withPrecompute
+	if domain.withPrecompute {
+		domain.preComputeTwiddles()
+	}
+
+	return domain
+}
+
+// Generator returns a generator for Z/2^(log(m))Z
+// or an error if m is too big (required root of unity doesn't exist)
+func Generator(m uint64) (babybear.Element, error) {
+	x := nextPowerOfTwo(m)
+	logx := uint64(bits.TrailingZeros64(x))
+	if logx > maxOrderRoot {
+		return babybear.Element{}, errors.New("m is too big: the required root of unity does not exist")
+	}
+
+	generator := rootOfUnity
+	for i := logx; i < maxOrderRoot; i++ {
+		generator.Square(&generator)
+	}
+	return generator, nil
+}
+
+// Twiddles returns the twiddles factor for the FFT using Generator for each stage of the FFT
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) Twiddles() ([][]babybear.Element, error) {
+	if d.twiddles == nil {
+		return nil, errors.New("twiddles not precomputed")
+	}
+	return d.twiddles, nil
+}
+
+// TwiddlesInv returns the twiddles factor for the FFT using GeneratorInv for each stage of the FFT
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) TwiddlesInv() ([][]babybear.Element, error) {
+	if d.twiddlesInv == nil {
+		return nil, errors.New("twiddles not precomputed")
+	}
+	return d.twiddlesInv, nil
+}
+
+// CosetTable returns the cosetTable u*<1,g,..,g^(n-1)>
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) CosetTable() ([]babybear.Element, error) {
+	if d.cosetTable == nil {
+		return nil, errors.New("cosetTable not precomputed")
+	}
+	return d.cosetTable, nil
+}
+
+// CosetTableInv returns the cosetTableInv u*<1,g,..,g^(n-1)>
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) CosetTableInv() ([]babybear.Element, error) {
+	if d.cosetTableInv == nil {
+		return nil, errors.New("cosetTableInv not precomputed")
+	}
+	return d.cosetTableInv, nil
+}
+
+func (d *Domain) preComputeTwiddles() {
+	d.twiddles = buildTwiddles(d.Generator, d.Cardinality)
+	d.twiddlesInv = buildTwiddles(d.GeneratorInv, d.Cardinality)
+
+	d.cosetTable = make([]babybear.Element, d.Cardinality)
+	d.cosetTableInv = make([]babybear.Element, d.Cardinality)
+	BuildExpTable(d.FrMultiplicativeGen, d.cosetTable)
+	BuildExpTable(d.FrMultiplicativeGenInv, d.cosetTableInv)
+}
+
+// buildTwiddles returns the twiddle factors of each stage of a transform of size n
+// using the root of unity omega. The table of a stage is contiguous, such that
+// the butterflies of a stage can be computed with the vector operations of the
+// field package.
+func buildTwiddles(omega babybear.Element, n uint64) [][]babybear.Element {
+	nbStages := bits.TrailingZeros64(n)
+	t := make([][]babybear.Element, nbStages)
+	if nbStages == 0 {
+		return t
+	}
+	t[0] = make([]babybear.Element, n/2)
+	BuildExpTable(omega, t[0])
+	for s := 1; s < nbStages; s++ {
+		t[s] = make([]babybear.Element, len(t[s-1])/2)
+		for j := range t[s] {
+			t[s][j] = t[s-1][2*j]
+		}
+	}
+	return t
+}
+
+// BuildExpTable precomputes the first n powers of w in parallel
+// table[0] = w^0
+// table[1] = w^1
+// ...
+func BuildExpTable(w babybear.Element, table []babybear.Element) {
+	execute(len(table), func(start, end int) {
+		table[start].Exp(w, new(big.Int).SetUint64(uint64(start)))
+		for i := start + 1; i < end; i++ {
+			table[i].Mul(&table[i-1], &w)
+		}
+	})
+}
+
+// nextPowerOfTwo returns the next power of 2 of n
+func nextPowerOfTwo(n uint64) uint64 {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << (64 - bits.LeadingZeros64(n-1))
+}
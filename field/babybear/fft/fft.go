@@ -0,0 +1,192 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/bits"
+
+	babybear "github.com/consensys/gnark-crypto/field/babybear"
+)
+
+// Decimation is used in the FFT call to select decimation in time or in frequency
+type Decimation uint8
+
+const (
+	DIT Decimation = iota
+	DIF
+)
+
+// vectorThreshold is the half size of the blocks from which the butterflies of a
+// stage are computed with the vector operations of the field package.
+const vectorThreshold = 16
+
+// FFT computes the discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+func (domain *Domain) FFT(a []babybear.Element, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+
+	if opt.coset {
+		cosetTable := domain.cosetTable
+		if !domain.withPrecompute {
+			cosetTable = make([]babybear.Element, len(a))
+			BuildExpTable(domain.FrMultiplicativeGen, cosetTable)
+		}
+		scale(a, cosetTable, decimation == DIT, opt.nbTasks)
+	}
+
+	twiddles := domain.twiddles
+	if !domain.withPrecompute {
+		twiddles = buildTwiddles(domain.Generator, uint64(len(a)))
+	}
+
+	switch decimation {
+	case DIF:
+		difFFT(a, twiddles, opt.nbTasks)
+	case DIT:
+		ditFFT(a, twiddles, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverse computes (recursively) the inverse discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// coset sets the shift of the fft (0 = no shift, standard fft)
+// len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+func (domain *Domain) FFTInverse(a []babybear.Element, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+
+	twiddlesInv := domain.twiddlesInv
+	if !domain.withPrecompute {
+		twiddlesInv = buildTwiddles(domain.GeneratorInv, uint64(len(a)))
+	}
+
+	switch decimation {
+	case DIF:
+		difFFT(a, twiddlesInv, opt.nbTasks)
+	case DIT:
+		ditFFT(a, twiddlesInv, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+
+	res := babybear.Vector(a)
+	if !opt.coset {
+		res.ScalarMul(a, &domain.CardinalityInv)
+		return
+	}
+
+	cosetTableInv := domain.cosetTableInv
+	if !domain.withPrecompute {
+		cosetTableInv = make([]babybear.Element, len(a))
+		BuildExpTable(domain.FrMultiplicativeGenInv, cosetTableInv)
+	}
+	// the output of the DIF transform is in bit reversed order
+	scale(a, cosetTableInv, decimation == DIF, opt.nbTasks)
+	res.ScalarMul(a, &domain.CardinalityInv)
+}
+
+// scale sets a[i] = a[i]·table[i], or a[i] = a[i]·table[bitReverse(i)] if bitReversed is set.
+func scale(a, table []babybear.Element, bitReversed bool, nbTasks int) {
+	if !bitReversed {
+		execute(len(a), func(start, end int) {
+			res := babybear.Vector(a[start:end])
+			res.Mul(a[start:end], table[start:end])
+		}, nbTasks)
+		return
+	}
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+	execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			iRev := bits.Reverse64(uint64(i)) >> nn
+			a[i].Mul(&a[i], &table[iRev])
+		}
+	}, nbTasks)
+}
+
+// difFFT is the decimation in frequency transform; the input is in natural order
+// and the output in bit reversed order.
+func difFFT(a []babybear.Element, twiddles [][]babybear.Element, nbTasks int) {
+	for s := 0; s < len(twiddles); s++ {
+		stage(a, twiddles[s], nbTasks, difButterflies)
+	}
+}
+
+// ditFFT is the decimation in time transform; the input is in bit reversed order
+// and the output in natural order.
+func ditFFT(a []babybear.Element, twiddles [][]babybear.Element, nbTasks int) {
+	for s := len(twiddles) - 1; s >= 0; s-- {
+		stage(a, twiddles[s], nbTasks, ditButterflies)
+	}
+}
+
+// stage applies the butterflies of a stage whose blocks have half size m = len(tw);
+// the n/2 butterflies are split among the tasks.
+func stage(a, tw []babybear.Element, nbTasks int, butterflies func(u, v, tw, tmp []babybear.Element)) {
+	m := len(tw)
+	execute(len(a)/2, func(start, end int) {
+		var tmp []babybear.Element
+		for start < end {
+			// butterfly j of the stage is in the block j/m, at offset j%m
+			block, offset := start/m, start%m
+			last := offset + end - start
+			if last > m {
+				last = m
+			}
+			if tmp == nil || len(tmp) < last-offset {
+				tmp = make([]babybear.Element, last-offset)
+			}
+			u := a[2*block*m : (2*block+1)*m]
+			v := a[(2*block+1)*m : (2*block+2)*m]
+			butterflies(u[offset:last], v[offset:last], tw[offset:last], tmp[:last-offset])
+			start += last - offset
+		}
+	}, nbTasks)
+}
+
+// difButterflies sets (u, v) = (u + v, (u - v)·tw)
+func difButterflies(u, v, tw, tmp []babybear.Element) {
+	if len(u) < vectorThreshold {
+		for j := range u {
+			babybear.Butterfly(&u[j], &v[j])
+			v[j].Mul(&v[j], &tw[j])
+		}
+		return
+	}
+	t, uu, vv := babybear.Vector(tmp), babybear.Vector(u), babybear.Vector(v)
+	t.Sub(u, v)
+	uu.Add(u, v)
+	vv.Mul(t, tw)
+}
+
+// ditButterflies sets (u, v) = (u + v·tw, u - v·tw)
+func ditButterflies(u, v, tw, tmp []babybear.Element) {
+	if len(u) < vectorThreshold {
+		for j := range u {
+			v[j].Mul(&v[j], &tw[j])
+			babybear.Butterfly(&u[j], &v[j])
+		}
+		return
+	}
+	t, uu, vv := babybear.Vector(tmp), babybear.Vector(u), babybear.Vector(v)
+	t.Mul(v, tw)
+	vv.Sub(u, t)
+	uu.Add(u, t)
+}
@@ -0,0 +1,158 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	babybear "github.com/consensys/gnark-crypto/field/babybear"
+)
+
+func randomVector(n int) []babybear.Element {
+	v := make([]babybear.Element, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+// evaluate returns p(x) where p is given by its coefficients
+func evaluate(p []babybear.Element, x babybear.Element) babybear.Element {
+	var res babybear.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &x).Add(&res, &p[i])
+	}
+	return res
+}
+
+func TestFFT(t *testing.T) {
+	for _, n := range []uint64{1, 2, 4, 32, 64, 256} {
+		for _, precompute := range []bool{true, false} {
+			var opts []DomainOption
+			if !precompute {
+				opts = append(opts, WithoutPrecompute())
+			}
+			domain := NewDomain(n, opts...)
+			name := fmt.Sprintf("n=%d/precompute=%v", n, precompute)
+
+			t.Run(name, func(t *testing.T) {
+				p := randomVector(int(n))
+
+				for _, coset := range []bool{false, true} {
+					var fftOpts []Option
+					shift := babybear.One()
+					if coset {
+						fftOpts = append(fftOpts, OnCoset())
+						shift = domain.FrMultiplicativeGen
+					}
+
+					// expected evaluations in natural order
+					expected := make([]babybear.Element, n)
+					x := shift
+					for i := range expected {
+						expected[i] = evaluate(p, x)
+						x.Mul(&x, &domain.Generator)
+					}
+
+					// DIF: natural -> bit reversed
+					v := append([]babybear.Element{}, p...)
+					domain.FFT(v, DIF, fftOpts...)
+					BitReverse(v)
+					checkEqual(t, "FFT DIF", v, expected)
+
+					// DIT: bit reversed -> natural
+					v = append([]babybear.Element{}, p...)
+					BitReverse(v)
+					domain.FFT(v, DIT, fftOpts...)
+					checkEqual(t, "FFT DIT", v, expected)
+
+					// inverse transforms
+					v = append([]babybear.Element{}, expected...)
+					domain.FFTInverse(v, DIF, fftOpts...)
+					BitReverse(v)
+					checkEqual(t, "FFTInverse DIF", v, p)
+
+					v = append([]babybear.Element{}, expected...)
+					BitReverse(v)
+					domain.FFTInverse(v, DIT, fftOpts...)
+					checkEqual(t, "FFTInverse DIT", v, p)
+
+					// single task
+					v = append([]babybear.Element{}, p...)
+					domain.FFT(v, DIF, append(fftOpts, WithNbTasks(1))...)
+					domain.FFTInverse(v, DIT, append(fftOpts, WithNbTasks(1))...)
+					checkEqual(t, "FFT round trip", v, p)
+				}
+			})
+		}
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	var order big.Int
+	order.SetUint64(1 << maxOrderRoot)
+	g, err := Generator(1 << maxOrderRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var x babybear.Element
+	x.Exp(g, &order)
+	if !x.IsOne() {
+		t.Fatal("generator has not the expected order")
+	}
+	order.Rsh(&order, 1)
+	x.Exp(g, &order)
+	if x.IsOne() {
+		t.Fatal("generator has not the expected order")
+	}
+
+	if _, err := Generator(1 << (maxOrderRoot + 1)); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func checkEqual(t *testing.T, name string, got, expected []babybear.Element) {
+	t.Helper()
+	for i := range expected {
+		if !got[i].Equal(&expected[i]) {
+			t.Fatalf("%s: mismatch at index %d", name, i)
+		}
+	}
+}
+
+func BenchmarkFFT(b *testing.B) {
+	for _, logn := range []int{10, 16, 20} {
+		if logn > maxOrderRoot {
+			continue
+		}
+		n := uint64(1) << logn
+		domain := NewDomain(n)
+		v := randomVector(int(n))
+		b.Run(fmt.Sprintf("DIF/2^%d", logn), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				domain.FFT(v, DIF)
+			}
+		})
+		b.Run(fmt.Sprintf("DIT/2^%d", logn), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				domain.FFT(v, DIT)
+			}
+		})
+	}
+}
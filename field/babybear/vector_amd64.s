@@ -0,0 +1,334 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+#include "textflag.h"
+
+#define const_q 2013265921
+#define const_qInv 2281701377
+
+// addVecAVX512(res, a, b *Element, n uint64)
+TEXT ·addVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	VPBROADCASTD R8, Z31
+	MOVL         $const_qInv, R8
+	VPBROADCASTD R8, Z30
+	MOVQ         $0xaaaa, R8
+	KMOVW        R8, K3
+
+loop_add512:
+	TESTQ     BX, BX
+	JEQ       done_add512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQU32 0(DX), Z1
+	VPADDD    Z1, Z0, Z0
+	VPSUBD    Z31, Z0, Z2
+	VPMINUD   Z2, Z0, Z0
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ      $64, AX
+	ADDQ      $64, DX
+	ADDQ      $64, CX
+	DECQ      BX
+	JMP       loop_add512
+
+done_add512:
+	VZEROUPPER
+	RET
+
+// subVecAVX512(res, a, b *Element, n uint64)
+TEXT ·subVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	VPBROADCASTD R8, Z31
+	MOVL         $const_qInv, R8
+	VPBROADCASTD R8, Z30
+	MOVQ         $0xaaaa, R8
+	KMOVW        R8, K3
+
+loop_sub512:
+	TESTQ     BX, BX
+	JEQ       done_sub512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQU32 0(DX), Z1
+	VPSUBD    Z1, Z0, Z0
+	VPADDD    Z31, Z0, Z2
+	VPMINUD   Z2, Z0, Z0
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ      $64, AX
+	ADDQ      $64, DX
+	ADDQ      $64, CX
+	DECQ      BX
+	JMP       loop_sub512
+
+done_sub512:
+	VZEROUPPER
+	RET
+
+// mulVecAVX512(res, a, b *Element, n uint64)
+TEXT ·mulVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	VPBROADCASTD R8, Z31
+	MOVL         $const_qInv, R8
+	VPBROADCASTD R8, Z30
+	MOVQ         $0xaaaa, R8
+	KMOVW        R8, K3
+
+loop_mul512:
+	TESTQ     BX, BX
+	JEQ       done_mul512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQU32 0(DX), Z1
+
+	// Z0 = Z0 · Z1 · 2⁻³² mod q
+	VPSRLQ    $32, Z0, Z2
+	VPSRLQ    $32, Z1, Z3
+	VPMULUDQ  Z0, Z1, Z4
+	VPMULUDQ  Z2, Z3, Z5
+	VPMULUDQ  Z4, Z30, Z6
+	VPMULUDQ  Z5, Z30, Z7
+	VPMULUDQ  Z6, Z31, Z6
+	VPMULUDQ  Z7, Z31, Z7
+	VPSRLQ    $32, Z4, Z4
+	VPSRLQ    $32, Z6, Z6
+	VPBLENDMD Z5, Z4, K3, Z4
+	VPBLENDMD Z7, Z6, K3, Z6
+	VPSUBD    Z6, Z4, Z0
+	VPADDD    Z31, Z0, Z2
+	VPMINUD   Z2, Z0, Z0
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ      $64, AX
+	ADDQ      $64, DX
+	ADDQ      $64, CX
+	DECQ      BX
+	JMP       loop_mul512
+
+done_mul512:
+	VZEROUPPER
+	RET
+
+// scalarMulVecAVX512(res, a, b *Element, n uint64)
+TEXT ·scalarMulVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	VPBROADCASTD R8, Z31
+	MOVL         $const_qInv, R8
+	VPBROADCASTD R8, Z30
+	MOVQ         $0xaaaa, R8
+	KMOVW        R8, K3
+	VPBROADCASTD 0(DX), Z29
+
+loop_scalarMul512:
+	TESTQ     BX, BX
+	JEQ       done_scalarMul512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQA32 Z29, Z1
+
+	// Z0 = Z0 · Z1 · 2⁻³² mod q
+	VPSRLQ    $32, Z0, Z2
+	VPSRLQ    $32, Z1, Z3
+	VPMULUDQ  Z0, Z1, Z4
+	VPMULUDQ  Z2, Z3, Z5
+	VPMULUDQ  Z4, Z30, Z6
+	VPMULUDQ  Z5, Z30, Z7
+	VPMULUDQ  Z6, Z31, Z6
+	VPMULUDQ  Z7, Z31, Z7
+	VPSRLQ    $32, Z4, Z4
+	VPSRLQ    $32, Z6, Z6
+	VPBLENDMD Z5, Z4, K3, Z4
+	VPBLENDMD Z7, Z6, K3, Z6
+	VPSUBD    Z6, Z4, Z0
+	VPADDD    Z31, Z0, Z2
+	VPMINUD   Z2, Z0, Z0
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ      $64, AX
+	ADDQ      $64, CX
+	DECQ      BX
+	JMP       loop_scalarMul512
+
+done_scalarMul512:
+	VZEROUPPER
+	RET
+
+// addVecAVX2(res, a, b *Element, n uint64)
+TEXT ·addVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	MOVD         R8, X15
+	VPBROADCASTD X15, Y15
+	MOVL         $const_qInv, R8
+	MOVD         R8, X14
+	VPBROADCASTD X14, Y14
+
+loop_add256:
+	TESTQ   BX, BX
+	JEQ     done_add256
+	VMOVDQU 0(AX), Y0
+	VMOVDQU 0(DX), Y1
+	VPADDD  Y1, Y0, Y0
+	VPSUBD  Y15, Y0, Y2
+	VPMINUD Y2, Y0, Y0
+	VMOVDQU Y0, 0(CX)
+	ADDQ    $32, AX
+	ADDQ    $32, DX
+	ADDQ    $32, CX
+	DECQ    BX
+	JMP     loop_add256
+
+done_add256:
+	VZEROUPPER
+	RET
+
+// subVecAVX2(res, a, b *Element, n uint64)
+TEXT ·subVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	MOVD         R8, X15
+	VPBROADCASTD X15, Y15
+	MOVL         $const_qInv, R8
+	MOVD         R8, X14
+	VPBROADCASTD X14, Y14
+
+loop_sub256:
+	TESTQ   BX, BX
+	JEQ     done_sub256
+	VMOVDQU 0(AX), Y0
+	VMOVDQU 0(DX), Y1
+	VPSUBD  Y1, Y0, Y0
+	VPADDD  Y15, Y0, Y2
+	VPMINUD Y2, Y0, Y0
+	VMOVDQU Y0, 0(CX)
+	ADDQ    $32, AX
+	ADDQ    $32, DX
+	ADDQ    $32, CX
+	DECQ    BX
+	JMP     loop_sub256
+
+done_sub256:
+	VZEROUPPER
+	RET
+
+// mulVecAVX2(res, a, b *Element, n uint64)
+TEXT ·mulVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	MOVD         R8, X15
+	VPBROADCASTD X15, Y15
+	MOVL         $const_qInv, R8
+	MOVD         R8, X14
+	VPBROADCASTD X14, Y14
+
+loop_mul256:
+	TESTQ   BX, BX
+	JEQ     done_mul256
+	VMOVDQU 0(AX), Y0
+	VMOVDQU 0(DX), Y1
+
+	// Y0 = Y0 · Y1 · 2⁻³² mod q
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y1, Y3
+	VPMULUDQ Y0, Y1, Y4
+	VPMULUDQ Y2, Y3, Y5
+	VPMULUDQ Y4, Y14, Y6
+	VPMULUDQ Y5, Y14, Y7
+	VPMULUDQ Y6, Y15, Y6
+	VPMULUDQ Y7, Y15, Y7
+	VPSRLQ   $32, Y4, Y4
+	VPSRLQ   $32, Y6, Y6
+	VPBLENDD $0xaa, Y5, Y4, Y4
+	VPBLENDD $0xaa, Y7, Y6, Y6
+	VPSUBD   Y6, Y4, Y0
+	VPADDD   Y15, Y0, Y2
+	VPMINUD  Y2, Y0, Y0
+	VMOVDQU  Y0, 0(CX)
+	ADDQ     $32, AX
+	ADDQ     $32, DX
+	ADDQ     $32, CX
+	DECQ     BX
+	JMP      loop_mul256
+
+done_mul256:
+	VZEROUPPER
+	RET
+
+// scalarMulVecAVX2(res, a, b *Element, n uint64)
+TEXT ·scalarMulVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), AX
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	MOVL         $const_q, R8
+	MOVD         R8, X15
+	VPBROADCASTD X15, Y15
+	MOVL         $const_qInv, R8
+	MOVD         R8, X14
+	VPBROADCASTD X14, Y14
+	VPBROADCASTD 0(DX), Y13
+
+loop_scalarMul256:
+	TESTQ   BX, BX
+	JEQ     done_scalarMul256
+	VMOVDQU 0(AX), Y0
+	VMOVDQA Y13, Y1
+
+	// Y0 = Y0 · Y1 · 2⁻³² mod q
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y1, Y3
+	VPMULUDQ Y0, Y1, Y4
+	VPMULUDQ Y2, Y3, Y5
+	VPMULUDQ Y4, Y14, Y6
+	VPMULUDQ Y5, Y14, Y7
+	VPMULUDQ Y6, Y15, Y6
+	VPMULUDQ Y7, Y15, Y7
+	VPSRLQ   $32, Y4, Y4
+	VPSRLQ   $32, Y6, Y6
+	VPBLENDD $0xaa, Y5, Y4, Y4
+	VPBLENDD $0xaa, Y7, Y6, Y6
+	VPSUBD   Y6, Y4, Y0
+	VPADDD   Y15, Y0, Y2
+	VPMINUD  Y2, Y0, Y0
+	VMOVDQU  Y0, 0(CX)
+	ADDQ     $32, AX
+	ADDQ     $32, CX
+	DECQ     BX
+	JMP      loop_scalarMul256
+
+done_scalarMul256:
+	VZEROUPPER
+	RET
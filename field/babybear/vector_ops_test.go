@@ -0,0 +1,134 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+import (
+	"fmt"
+	"testing"
+)
+
+func randomVector(t testing.TB, n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		if _, err := v[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// edge values
+	if n > 2 {
+		v[0].SetZero()
+		v[1].SetUint64(uint64(q0 - 1))
+	}
+	return v
+}
+
+func TestVectorOps(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 8, 15, 16, 17, 33, 64, 100, 257} {
+		a, b := randomVector(t, n), randomVector(t, n)
+		var scalar Element
+		scalar.SetRandom()
+
+		add, sub, mul, scalarMul := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+		add.Add(a, b)
+		sub.Sub(a, b)
+		mul.Mul(a, b)
+		scalarMul.ScalarMul(a, &scalar)
+
+		var sum, innerProduct Element
+		for i := 0; i < n; i++ {
+			var e, tmp Element
+			if !add[i].Equal(e.Add(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Add mismatch at %d", n, i)
+			}
+			if !sub[i].Equal(e.Sub(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Sub mismatch at %d", n, i)
+			}
+			if !mul[i].Equal(e.Mul(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Mul mismatch at %d", n, i)
+			}
+			if !scalarMul[i].Equal(e.Mul(&a[i], &scalar)) {
+				t.Fatalf("n=%d: ScalarMul mismatch at %d", n, i)
+			}
+			sum.Add(&sum, &a[i])
+			innerProduct.Add(&innerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+		if s := a.Sum(); !s.Equal(&sum) {
+			t.Fatalf("n=%d: Sum mismatch", n)
+		}
+		if ip := a.InnerProduct(b); !ip.Equal(&innerProduct) {
+			t.Fatalf("n=%d: InnerProduct mismatch", n)
+		}
+
+		// in place
+		a.Add(a, b)
+		if !vectorEqual(a, add) {
+			t.Fatalf("n=%d: in place Add mismatch", n)
+		}
+	}
+}
+
+func TestVectorOpsLargeInnerProduct(t *testing.T) {
+	// all elements set to q-1 maximizes the lazy accumulator
+	const n = 1 << 12
+	a := make(Vector, n)
+	for i := range a {
+		a[i].SetUint64(uint64(q0 - 1))
+	}
+	var expected Element
+	expected.SetUint64(n)
+	if ip := a.InnerProduct(a); !ip.Equal(&expected) {
+		t.Fatal("InnerProduct mismatch")
+	}
+	expected.Neg(&expected)
+	if s := a.Sum(); !s.Equal(&expected) {
+		t.Fatal("Sum mismatch")
+	}
+}
+
+func vectorEqual(a, b Vector) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	for _, n := range []int{1 << 10, 1 << 16} {
+		a, c := randomVector(b, n), randomVector(b, n)
+		res := make(Vector, n)
+		b.Run(fmt.Sprintf("add/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.Add(a, c)
+			}
+		})
+		b.Run(fmt.Sprintf("mul/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.Mul(a, c)
+			}
+		})
+		b.Run(fmt.Sprintf("innerProduct/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = a.InnerProduct(c)
+			}
+		})
+	}
+}
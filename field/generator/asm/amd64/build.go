@@ -179,6 +179,23 @@ func GenerateMul(w io.Writer, F *config.FieldConfig) error {
 	return nil
 }
 
+// GenerateIFMA generates the AVX-512 IFMA kernels of the vector operations
+func GenerateIFMA(w io.Writer, F *config.FieldConfig) error {
+	f := NewFFAmd64(w, F)
+	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("")
+	f.generateDefinesIFMA()
+
+	f.generateMulVecIFMA()
+	f.generateScalarMulVecIFMA()
+	f.generateButterflyVecIFMA()
+
+	return nil
+}
+
 func GenerateMulADX(w io.Writer, F *config.FieldConfig) error {
 	f := NewFFAmd64(w, F)
 	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
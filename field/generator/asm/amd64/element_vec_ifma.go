@@ -0,0 +1,393 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// The IFMA kernels process 8 elements at a time, one per 64-bit lane of the zmm
+// registers. Elements are converted (gather) from their 64-bit words to L limbs
+// of 52 bits, where L is the smallest number of limbs such that 2q < 2⁵²ᴸ;
+// register (or stack slot) k holds the k-th limb of the 8 elements.
+//
+// The multiplication is a Montgomery multiplication in radix 2⁵², with lazy
+// carries: VPMADD52LUQ (resp. VPMADD52HUQ) adds the low (resp. high) 52 bits of
+// the products to 64-bit accumulators, which can absorb many such additions.
+// The result x·y·2⁻⁵²ᴸ is converted back to the Montgomery form of the
+// elements (x·y·2⁻⁶⁴ᴺ) with a second multiplication by c = 2¹⁰⁴ᴸ⁻⁶⁴ᴺ (mod q).
+//
+// With x, y < 2q and 2q < 2⁵²ᴸ, the result of a multiplication is < 2q, and is
+// reduced with a conditional subtraction of q when it must be stored.
+
+// nbLimbsIFMA returns the number of 52-bit limbs used by the IFMA kernels
+func (f *FFAmd64) nbLimbsIFMA() int {
+	return (f.NbBits + 52) / 52
+}
+
+// zmm registers used by the IFMA kernels; Z0 to Z15 are reserved for the
+// accumulator (at most 16 = L+1 limbs, since NbWords <= 12).
+const (
+	zBi     = "Z16" // limb of y, broadcast or per lane
+	zM      = "Z17" // m = t[0]·qInvNeg mod 2⁵²
+	zTmp    = "Z18"
+	zBorrow = "Z19"
+	zW0     = "Z20" // words of the elements, during conversions
+	zW1     = "Z21"
+	zLimb   = "Z22"
+	zLimb1  = "Z23"
+	zIndex  = "Z30" // offsets of the 8 elements, for gather / scatter
+	zMask52 = "Z31"
+)
+
+func zmm(i int) string {
+	return fmt.Sprintf("Z%d", i)
+}
+
+// vop writes an AVX-512 instruction (not provided by bavard/amd64)
+func (f *FFAmd64) vop(instruction string, operands ...string) {
+	f.WriteLn(fmt.Sprintf("    %s %s", instruction, strings.Join(operands, ", ")))
+}
+
+// limbs52 returns the n limbs of v in radix 2⁵²
+func limbs52(v *big.Int, n int) []uint64 {
+	res := make([]uint64, n)
+	mask := new(big.Int).SetUint64(1<<52 - 1)
+	t := new(big.Int).Set(v)
+	for i := range res {
+		res[i] = new(big.Int).And(t, mask).Uint64()
+		t.Rsh(t, 52)
+	}
+	return res
+}
+
+// generateDefinesIFMA writes the constants used by the IFMA kernels
+func (f *FFAmd64) generateDefinesIFMA() {
+	L := f.nbLimbsIFMA()
+	q := f.ModulusBig
+
+	// c = 2¹⁰⁴ᴸ⁻⁶⁴ᴺ mod q
+	c := new(big.Int).Lsh(big.NewInt(1), uint(104*L-64*f.NbWords))
+	c.Mod(c, q)
+
+	// qInvNeg = -q⁻¹ mod 2⁵²
+	r := new(big.Int).Lsh(big.NewInt(1), 52)
+	qInvNeg := new(big.Int).ModInverse(q, r)
+	qInvNeg.Sub(r, qInvNeg)
+
+	data := func(name string, values []uint64) {
+		for i, v := range values {
+			f.WriteLn(fmt.Sprintf("DATA %s<>+%d(SB)/8, $%#016x", name, 8*i, v))
+		}
+		f.WriteLn(fmt.Sprintf("GLOBL %s<>(SB), (RODATA+NOPTR), $%d", name, 8*len(values)))
+		f.WriteLn("")
+	}
+
+	f.Comment("modulus q, in radix 2⁵²")
+	data("q52", limbs52(q, L))
+	f.Comment("c = 2¹⁰⁴ᴸ⁻⁶⁴ᴺ mod q, in radix 2⁵²")
+	data("c52", limbs52(c, L))
+	f.Comment("qInvNeg = -q⁻¹ mod 2⁵²")
+	data("qInvNeg52", []uint64{qInvNeg.Uint64()})
+	f.Comment("offsets of 8 consecutive elements")
+	offsets := make([]uint64, 8)
+	for i := range offsets {
+		offsets[i] = uint64(8 * f.NbWords * i)
+	}
+	data("offsets", offsets)
+}
+
+// fnHeaderIFMA writes the header of an IFMA kernel, and sets the constant registers.
+// The stack holds two sets of limbs (8·L words each).
+func (f *FFAmd64) fnHeaderIFMA(name string, argSize int) {
+	f.FnHeader(name, 2*64*f.nbLimbsIFMA(), argSize)
+	f.MOVQ(fmt.Sprintf("$%#x", uint64(1<<52-1)), amd64.R8)
+	f.vop("VPBROADCASTQ", string(amd64.R8), zMask52)
+	f.vop("VMOVDQU64", "offsets<>(SB)", zIndex)
+}
+
+// stackA returns the k-th limb of the first set of limbs on the stack
+func (f *FFAmd64) stackA(k int) string {
+	return fmt.Sprintf("%d(SP)", 64*k)
+}
+
+// stackB returns the k-th limb of the second set of limbs on the stack
+func (f *FFAmd64) stackB(k int) string {
+	return fmt.Sprintf("%d(SP)", 64*(f.nbLimbsIFMA()+k))
+}
+
+// gather loads the j-th word of 8 consecutive elements at ptr in dst
+func (f *FFAmd64) gather(ptr amd64.Register) func(j int, dst string) {
+	return func(j int, dst string) {
+		f.vop("KXNORW", "K1", "K1", "K1")
+		f.vop("VPGATHERQQ", fmt.Sprintf("%d(%s)(%s*1)", 8*j, ptr, zIndex), "K1", dst)
+	}
+}
+
+// broadcast loads the j-th word of the element at ptr in all the lanes of dst
+func (f *FFAmd64) broadcast(ptr amd64.Register) func(j int, dst string) {
+	return func(j int, dst string) {
+		f.vop("VPBROADCASTQ", fmt.Sprintf("%d(%s)", 8*j, ptr), dst)
+	}
+}
+
+// toLimbs converts words (loaded by load) to normalized limbs, stored at dst(k)
+func (f *FFAmd64) toLimbs(load func(j int, dst string), dst func(k int) string) {
+	w := []string{zW0, zW1}
+	loaded := -1
+	word := func(j int) string {
+		for loaded < j {
+			loaded++
+			load(loaded, w[loaded%2])
+		}
+		return w[j%2]
+	}
+	for k := 0; k < f.nbLimbsIFMA(); k++ {
+		j, s := (52*k)/64, (52*k)%64
+		if j >= f.NbWords {
+			// limb above the words of the elements
+			f.vop("VPXORQ", zLimb, zLimb, zLimb)
+		} else {
+			f.vop("VPSRLQ", fmt.Sprintf("$%d", s), word(j), zLimb)
+			if s > 12 && j+1 < f.NbWords {
+				f.vop("VPSLLQ", fmt.Sprintf("$%d", 64-s), word(j+1), zLimb1)
+				f.vop("VPORQ", zLimb1, zLimb, zLimb)
+			}
+			f.vop("VPANDQ", zMask52, zLimb, zLimb)
+		}
+		f.vop("VMOVDQU64", zLimb, dst(k))
+	}
+}
+
+// scatter stores the normalized limbs t, reduced mod q, as words of 8 consecutive elements at ptr
+func (f *FFAmd64) scatter(t []string, ptr amd64.Register) {
+	for j := 0; j < f.NbWords; j++ {
+		first := true
+		for k := range t {
+			// limb k covers the bits [52k, 52k+52), word j the bits [64j, 64j+64)
+			shift := 52*k - 64*j
+			if shift >= 64 || shift <= -52 {
+				continue
+			}
+			dst := zW0
+			if !first {
+				dst = zW1
+			}
+			if shift >= 0 {
+				f.vop("VPSLLQ", fmt.Sprintf("$%d", shift), t[k], dst)
+			} else {
+				f.vop("VPSRLQ", fmt.Sprintf("$%d", -shift), t[k], dst)
+			}
+			if !first {
+				f.vop("VPORQ", zW1, zW0, zW0)
+			}
+			first = false
+		}
+		f.vop("KXNORW", "K1", "K1", "K1")
+		f.vop("VPSCATTERQQ", zW0, "K1", fmt.Sprintf("%d(%s)(%s*1)", 8*j, ptr, zIndex))
+	}
+}
+
+// normalize propagates the carries of the limbs t; if signed is set, the limbs
+// may be negative (but not the value they represent).
+func (f *FFAmd64) normalize(t []string, signed bool) {
+	shift := "VPSRLQ"
+	if signed {
+		shift = "VPSRAQ"
+	}
+	for j := 0; j < len(t)-1; j++ {
+		f.vop(shift, "$52", t[j], zTmp)
+		f.vop("VPANDQ", zMask52, t[j], t[j])
+		f.vop("VPADDQ", zTmp, t[j+1], t[j+1])
+	}
+}
+
+// reduceIFMA sets t = t - q if t >= q, on normalized limbs.
+func (f *FFAmd64) reduceIFMA(t []string) {
+	f.Comment("t = t - q if t >= q")
+	f.vop("VPXORQ", zBorrow, zBorrow, zBorrow)
+	for j := range t {
+		f.vop("VPSUBQ.BCST", fmt.Sprintf("q52<>+%d(SB)", 8*j), t[j], zTmp)
+		f.vop("VPSUBQ", zBorrow, zTmp, zTmp)
+		f.vop("VPSRLQ", "$63", zTmp, zBorrow)
+	}
+	// K2 selects the lanes without borrow, i.e. t >= q
+	f.vop("VPTESTNMQ", zBorrow, zBorrow, "K2")
+	f.vop("VPXORQ", zBorrow, zBorrow, zBorrow)
+	for j := range t {
+		f.vop("VPSUBQ.BCST", fmt.Sprintf("q52<>+%d(SB)", 8*j), t[j], "K2", t[j])
+		f.vop("VPSUBQ", zBorrow, t[j], "K2", t[j])
+		f.vop("VPSRLQ", "$63", t[j], zBorrow)
+		f.vop("VPANDQ", zMask52, t[j], "K2", t[j])
+	}
+}
+
+// montMulIFMA sets t = x·y·2⁻⁵²ᴸ < 2q, for x, y < 2q. The limbs of x are read
+// from memory (xLimb), the limbs of y are loaded with loadY.
+// It returns the normalized limbs of t.
+func (f *FFAmd64) montMulIFMA(xLimb func(j int) string, loadY func(i int, dst string)) []string {
+	L := f.nbLimbsIFMA()
+	t := make([]string, L+1)
+	for j := range t {
+		t[j] = zmm(j)
+		f.vop("VPXORQ", t[j], t[j], t[j])
+	}
+	for i := 0; i < L; i++ {
+		f.Comment(fmt.Sprintf("t += x·y[%d], followed by a reduction round", i))
+		loadY(i, zBi)
+		for j := 0; j < L; j++ {
+			f.vop("VPMADD52LUQ", xLimb(j), zBi, t[j])
+			f.vop("VPMADD52HUQ", xLimb(j), zBi, t[j+1])
+		}
+		f.vop("VPXORQ", zM, zM, zM)
+		f.vop("VPMADD52LUQ.BCST", "qInvNeg52<>(SB)", t[0], zM)
+		for j := 0; j < L; j++ {
+			f.vop("VPMADD52LUQ.BCST", fmt.Sprintf("q52<>+%d(SB)", 8*j), zM, t[j])
+			f.vop("VPMADD52HUQ.BCST", fmt.Sprintf("q52<>+%d(SB)", 8*j), zM, t[j+1])
+		}
+		// the low 52 bits of t[0] are 0, it becomes the (zero) high limb of t
+		f.vop("VPSRLQ", "$52", t[0], zTmp)
+		f.vop("VPADDQ", zTmp, t[1], t[1])
+		f.vop("VPXORQ", t[0], t[0], t[0])
+		t = append(t[1:], t[0])
+	}
+	t = t[:L]
+	f.normalize(t, false)
+	return t
+}
+
+// loadStack returns a loader of the limbs at stack
+func (f *FFAmd64) loadStack(stack func(k int) string) func(i int, dst string) {
+	return func(i int, dst string) {
+		f.vop("VMOVDQU64", stack(i), dst)
+	}
+}
+
+// loadC loads the i-th limb of c in all the lanes of dst
+func (f *FFAmd64) loadC(i int, dst string) {
+	f.vop("VPBROADCASTQ", fmt.Sprintf("c52<>+%d(SB)", 8*i), dst)
+}
+
+// vecLoopIFMA writes a loop over n blocks of 8 elements; ptrs are incremented
+// after each block.
+func (f *FFAmd64) vecLoopIFMA(n amd64.Register, ptrs []amd64.Register, body func()) {
+	loop := f.NewLabel()
+	done := f.NewLabel()
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done)
+	body()
+	for _, p := range ptrs {
+		f.ADDQ(fmt.Sprintf("$%d", 64*f.NbWords), p)
+	}
+	f.DECQ(n)
+	f.JMP(loop)
+	f.LABEL(done)
+	f.WriteLn("    VZEROUPPER")
+	f.RET()
+}
+
+func (f *FFAmd64) generateMulVecIFMA() {
+	f.Comment("mulVecIFMA(res, a, b *Element, n uint64) res[i] = a[i]·b[i], for 8·n elements")
+	f.fnHeaderIFMA("mulVecIFMA", 32)
+
+	res, a, b, n := amd64.CX, amd64.AX, amd64.DX, amd64.BX
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	f.vecLoopIFMA(n, []amd64.Register{res, a, b}, func() {
+		f.toLimbs(f.gather(a), f.stackA)
+		f.toLimbs(f.gather(b), f.stackB)
+		t := f.montMulIFMA(f.stackA, f.loadStack(f.stackB))
+		for j := range t {
+			f.vop("VMOVDQU64", t[j], f.stackA(j))
+		}
+		f.Comment("back to the Montgomery form of the elements")
+		t = f.montMulIFMA(f.stackA, f.loadC)
+		f.reduceIFMA(t)
+		f.scatter(t, res)
+	})
+}
+
+func (f *FFAmd64) generateScalarMulVecIFMA() {
+	f.Comment("scalarMulVecIFMA(res, a, b *Element, n uint64) res[i] = a[i]·b, for 8·n elements")
+	f.fnHeaderIFMA("scalarMulVecIFMA", 32)
+
+	res, a, b, n := amd64.CX, amd64.AX, amd64.DX, amd64.BX
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	f.Comment("b·c·2⁻⁵²ᴸ = b·2⁵²ᴸ⁻⁶⁴ᴺ, such that a[i]·b·2⁻⁶⁴ᴺ is a single multiplication")
+	f.toLimbs(f.broadcast(b), f.stackA)
+	t := f.montMulIFMA(f.stackA, f.loadC)
+	for j := range t {
+		f.vop("VMOVDQU64", t[j], f.stackB(j))
+	}
+
+	f.vecLoopIFMA(n, []amd64.Register{res, a}, func() {
+		f.toLimbs(f.gather(a), f.stackA)
+		t := f.montMulIFMA(f.stackA, f.loadStack(f.stackB))
+		f.reduceIFMA(t)
+		f.scatter(t, res)
+	})
+}
+
+func (f *FFAmd64) generateButterflyVecIFMA() {
+	f.Comment("butterflyVecIFMA(a, b *Element, n uint64) a[i], b[i] = a[i]+b[i], a[i]-b[i], for 8·n elements")
+	f.fnHeaderIFMA("butterflyVecIFMA", 24)
+
+	a, b, n := amd64.AX, amd64.DX, amd64.BX
+	f.MOVQ("a+0(FP)", a)
+	f.MOVQ("b+8(FP)", b)
+	f.MOVQ("n+16(FP)", n)
+
+	L := f.nbLimbsIFMA()
+	t := make([]string, L)
+	for j := range t {
+		t[j] = zmm(j)
+	}
+
+	f.vecLoopIFMA(n, []amd64.Register{a, b}, func() {
+		f.toLimbs(f.gather(a), f.stackA)
+		f.toLimbs(f.gather(b), f.stackB)
+
+		f.Comment("a + b")
+		for j := range t {
+			f.vop("VMOVDQU64", f.stackA(j), t[j])
+			f.vop("VPADDQ", f.stackB(j), t[j], t[j])
+		}
+		f.normalize(t, false)
+		f.reduceIFMA(t)
+		f.scatter(t, a)
+
+		f.Comment("a - b + q")
+		for j := range t {
+			f.vop("VMOVDQU64", f.stackA(j), t[j])
+			f.vop("VPSUBQ", f.stackB(j), t[j], t[j])
+			f.vop("VPADDQ.BCST", fmt.Sprintf("q52<>+%d(SB)", 8*j), t[j], t[j])
+		}
+		f.normalize(t, true)
+		f.reduceIFMA(t)
+		f.scatter(t, b)
+	})
+}
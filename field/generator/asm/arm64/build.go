@@ -0,0 +1,213 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arm64 contains syntactic sugar to generate arm64 assembly code
+package arm64
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/bavard/arm64"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// registerTmp is used by the Go assembler to synthesize some instructions, it is
+// never allocated.
+const registerTmp = arm64.Register(27)
+
+// zr is the zero register
+const zr = "ZR"
+
+func NewFFArm64(w io.Writer, F *config.FieldConfig) *FFArm64 {
+	return &FFArm64{F, arm64.NewArm64(w)}
+}
+
+type FFArm64 struct {
+	*config.FieldConfig
+	*arm64.Arm64
+}
+
+// Generate generates assembly code for the base field provided to goff
+// see internal/templates/ops*
+func Generate(w io.Writer, F *config.FieldConfig) error {
+	f := NewFFArm64(w, F)
+	f.WriteLn(bavard.Apache2Header("ConsenSys Software Inc.", 2020))
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("")
+
+	// mul
+	f.generateMul()
+
+	// from mont
+	f.generateFromMont()
+
+	// reduce
+	f.generateReduce()
+
+	// fft butterflies
+	f.generateButterfly()
+
+	// vector operations
+	f.generateAddVec()
+	f.generateSubVec()
+	f.generateScalarMulVec()
+	f.generateMulVec()
+
+	return nil
+}
+
+// fnHeader writes the header of a function, and returns the available registers.
+func (f *FFArm64) fnHeader(funcName string, argSize int) arm64.Registers {
+	f.WriteLn("")
+	return f.FnHeader(funcName, 0, argSize, registerTmp)
+}
+
+// op writes an instruction which is not provided by bavard/arm64
+func (f *FFArm64) op(instruction string, operands ...interface{}) {
+	ops := make([]string, len(operands))
+	for i, o := range operands {
+		ops[i] = arm64.Operand(o)
+	}
+	f.WriteLn(fmt.Sprintf("    %s %s", instruction, strings.Join(ops, ", ")))
+}
+
+func (f *FFArm64) ADD(op1, op2, dst interface{})      { f.op("ADD", op1, op2, dst) }
+func (f *FFArm64) SUB(op1, op2, dst interface{})      { f.op("SUB", op1, op2, dst) }
+func (f *FFArm64) AND(op1, op2, dst interface{})      { f.op("AND", op1, op2, dst) }
+func (f *FFArm64) CSETM(cond string, dst interface{}) { f.op("CSETM", cond, dst) }
+func (f *FFArm64) CBZ(r interface{}, label string)    { f.op("CBZ", r, label) }
+func (f *FFArm64) CBNZ(r interface{}, label string)   { f.op("CBNZ", r, label) }
+
+// STP shadows bavard's STP, which writes the operands in the wrong order
+func (f *FFArm64) STP(x, y arm64.Register, address string) {
+	f.op("STP", fmt.Sprintf("(%s, %s)", x.Name(), y.Name()), address)
+}
+
+// imm returns the immediate operand of a 64 bits word
+func imm(v uint64) string {
+	return fmt.Sprintf("$%#016x", v)
+}
+
+// load loads the words at ptr into dst, using LDP when possible.
+func (f *FFArm64) load(ptr arm64.Register, dst []arm64.Register) {
+	for i := 0; i+1 < len(dst); i += 2 {
+		f.LDP(f.RegisterOffset(ptr, 8*i), dst[i], dst[i+1])
+	}
+	if len(dst)%2 == 1 {
+		i := len(dst) - 1
+		f.MOVD(f.RegisterOffset(ptr, 8*i), dst[i])
+	}
+}
+
+// store stores src at ptr, using STP when possible.
+func (f *FFArm64) store(src []arm64.Register, ptr arm64.Register) {
+	for i := 0; i+1 < len(src); i += 2 {
+		f.STP(src[i], src[i+1], f.RegisterOffset(ptr, 8*i))
+	}
+	if len(src)%2 == 1 {
+		i := len(src) - 1
+		f.MOVD(src[i], f.RegisterOffset(ptr, 8*i))
+	}
+}
+
+// addChain returns the add with carry instruction of the i-th word of a chain
+func addChain(i int) string {
+	if i == 0 {
+		return "ADDS"
+	}
+	return "ADCS"
+}
+
+// subChain returns the sub with borrow instruction of the i-th word of a chain
+func subChain(i int) string {
+	if i == 0 {
+		return "SUBS"
+	}
+	return "SBCS"
+}
+
+// words provides the words of a multi-precision operand, either from registers,
+// or loaded on demand (from memory or as immediates) in a scratch register.
+// Loads don't modify the flags, so they can be interleaved with carry chains.
+type words struct {
+	f         *FFArm64
+	registers []arm64.Register   // the words, if resident
+	source    func(j int) string // source operand of the j-th word, if not resident
+	scratch   arm64.Register
+}
+
+// at returns a register containing the j-th word
+func (w *words) at(j int) arm64.Register {
+	if w.registers != nil {
+		return w.registers[j]
+	}
+	w.f.MOVD(w.source(j), w.scratch)
+	return w.scratch
+}
+
+// q returns the words of the modulus; if enough registers are available they are
+// resident, otherwise they are loaded as immediates in scratch.
+func (f *FFArm64) q(registers *arm64.Registers, scratch func() arm64.Register) *words {
+	w := &words{f: f}
+	if registers.Available() >= f.NbWords {
+		w.registers = registers.PopN(f.NbWords)
+		for j := 0; j < f.NbWords; j++ {
+			f.MOVD(imm(f.Q[j]), w.registers[j])
+		}
+		return w
+	}
+	w.scratch = scratch()
+	w.source = func(j int) string { return imm(f.Q[j]) }
+	return w
+}
+
+// memory returns the words at ptr; if enough registers are available (keeping
+// reserve registers), they are resident and must be loaded with reload,
+// otherwise they are loaded on demand in scratch.
+func (f *FFArm64) memory(registers *arm64.Registers, ptr arm64.Register, scratch func() arm64.Register, reserve int) *words {
+	w := &words{f: f}
+	if registers.Available() >= f.NbWords+reserve {
+		w.registers = registers.PopN(f.NbWords)
+		return w
+	}
+	w.scratch = scratch()
+	w.source = func(j int) string { return f.RegisterOffset(ptr, 8*j) }
+	return w
+}
+
+// reload loads the words from ptr in the resident registers, if any
+func (w *words) reload(ptr arm64.Register) {
+	if w.registers != nil {
+		w.f.load(ptr, w.registers)
+	}
+}
+
+// reduce sets t = t - q if t >= q, in constant time.
+// mask and tmp are clobbered.
+func (f *FFArm64) reduce(t []arm64.Register, q *words, mask, tmp arm64.Register) {
+	f.Comment("t = t - q if t >= q")
+	for j := 0; j < f.NbWords; j++ {
+		f.op(subChain(j), q.at(j), t[j], tmp)
+	}
+	f.CSETM("CS", mask)
+	for j := 0; j < f.NbWords; j++ {
+		f.AND(mask, q.at(j), tmp)
+		f.op(subChain(j), tmp, t[j], t[j])
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/arm64"
+)
+
+// The multiplication is a CIOS Montgomery multiplication, written row by row:
+// for each word y[i],
+//
+//	t += x·y[i]
+//	m := t[0]·qInvNeg
+//	t += m·q
+//	t >>= 64
+//
+// Each product x·y[i] (resp. m·q) is added with two carry chains, one for the low
+// words (MUL) and one for the high words (UMULH); MUL and UMULH don't modify the
+// flags. Since q[N-1] < 2⁶³-1 (this backend is only used for such moduli),
+// t < 2q holds at the end of each round, and t fits on N+1 words; the shift is
+// a renaming of the registers (the low word of t is 0 after the reduction).
+
+// montgomery holds the registers of a Montgomery multiplication.
+type montgomery struct {
+	t    []arm64.Register // N+1 words accumulator
+	x    *words
+	q    *words
+	qInv arm64.Register
+	yi   arm64.Register // y[i], then m
+	tmp  arm64.Register
+}
+
+// newMontgomery allocates the registers of a Montgomery multiplication; x is
+// resident if possible.
+func (f *FFArm64) newMontgomery(registers *arm64.Registers, xPtr arm64.Register) *montgomery {
+	m := &montgomery{
+		t:    registers.PopN(f.NbWords + 1),
+		qInv: registers.Pop(),
+		yi:   registers.Pop(),
+		tmp:  registers.Pop(),
+	}
+	f.MOVD(imm(f.QInverse[0]), m.qInv)
+	// keep a register for the scratch of q, if it doesn't fit
+	m.x = f.memory(registers, xPtr, registers.Pop, 1)
+	m.q = f.q(registers, registers.Pop)
+	return m
+}
+
+// mulAdd sets t = x·y[i] if first is set, and t += x·y[i] otherwise.
+func (f *FFArm64) mulAdd(m *montgomery, first bool) {
+	N := f.NbWords
+	t := m.t
+	if first {
+		// t = x·y[0]
+		for j := 0; j < N; j++ {
+			f.MUL(m.x.at(j), m.yi, t[j])
+		}
+		f.UMULH(m.x.at(N-1), m.yi, t[N])
+		for j := 0; j < N-1; j++ {
+			f.UMULH(m.x.at(j), m.yi, m.tmp)
+			f.op(addChain(j), m.tmp, t[j+1], t[j+1])
+		}
+		f.ADC(zr, t[N], t[N])
+		return
+	}
+	// low words
+	for j := 0; j < N; j++ {
+		f.MUL(m.x.at(j), m.yi, m.tmp)
+		f.op(addChain(j), m.tmp, t[j], t[j])
+	}
+	f.ADC(zr, t[N], t[N])
+	// high words
+	for j := 0; j < N; j++ {
+		f.UMULH(m.x.at(j), m.yi, m.tmp)
+		if j == N-1 {
+			f.ADC(m.tmp, t[N], t[N])
+		} else {
+			f.op(addChain(j), m.tmp, t[j+1], t[j+1])
+		}
+	}
+}
+
+// reduceRound sets t = (t + m·q) / 2⁶⁴, with m = t[0]·qInvNeg mod 2⁶⁴.
+// The registers of t are rotated.
+func (f *FFArm64) reduceRound(m *montgomery) {
+	N := f.NbWords
+	t := m.t
+	f.MUL(t[0], m.qInv, m.yi)
+	// low words
+	for j := 0; j < N; j++ {
+		f.MUL(m.q.at(j), m.yi, m.tmp)
+		f.op(addChain(j), m.tmp, t[j], t[j])
+	}
+	f.ADC(zr, t[N], t[N])
+	// high words
+	for j := 0; j < N; j++ {
+		f.UMULH(m.q.at(j), m.yi, m.tmp)
+		if j == N-1 {
+			f.ADC(m.tmp, t[N], t[N])
+		} else {
+			f.op(addChain(j), m.tmp, t[j+1], t[j+1])
+		}
+	}
+	// t[0] is now 0, it becomes the high word of t
+	rotated := make([]arm64.Register, 0, N+1)
+	m.t = append(append(rotated, t[1:]...), t[0])
+}
+
+// mulBody sets t = x·y·R⁻¹ mod q, with t < q. The words of y are read from yPtr.
+func (f *FFArm64) mulBody(m *montgomery, yPtr arm64.Register) {
+	for i := 0; i < f.NbWords; i++ {
+		f.Comment(fmt.Sprintf("t += x·y[%d], followed by a reduction round", i))
+		f.MOVD(f.RegisterOffset(yPtr, 8*i), m.yi)
+		f.mulAdd(m, i == 0)
+		f.reduceRound(m)
+	}
+	f.reduce(m.t[:f.NbWords], m.q, m.yi, m.tmp)
+}
+
+func (f *FFArm64) generateMul() {
+	f.Comment("mul(res, x, y *Element) sets res = x·y·R⁻¹ (mod q)")
+	registers := f.fnHeader("mul", 24)
+
+	xPtr := registers.Pop()
+	yPtr := registers.Pop()
+	f.MOVD("x+8(FP)", xPtr)
+	f.MOVD("y+16(FP)", yPtr)
+
+	m := f.newMontgomery(&registers, xPtr)
+	m.x.reload(xPtr)
+	f.mulBody(m, yPtr)
+
+	res := xPtr
+	f.MOVD("res+0(FP)", res)
+	f.store(m.t[:f.NbWords], res)
+	f.RET()
+}
+
+func (f *FFArm64) generateFromMont() {
+	f.Comment("fromMont(res *Element) sets res = res·R⁻¹ (mod q)")
+	registers := f.fnHeader("fromMont", 8)
+
+	res := registers.Pop()
+	f.MOVD("res+0(FP)", res)
+
+	m := &montgomery{
+		t:    registers.PopN(f.NbWords + 1),
+		qInv: registers.Pop(),
+		yi:   registers.Pop(),
+		tmp:  registers.Pop(),
+	}
+	f.MOVD(imm(f.QInverse[0]), m.qInv)
+	m.q = f.q(&registers, registers.Pop)
+
+	f.load(res, m.t[:f.NbWords])
+	f.MOVD(zr, m.t[f.NbWords])
+	for i := 0; i < f.NbWords; i++ {
+		f.reduceRound(m)
+	}
+	f.reduce(m.t[:f.NbWords], m.q, m.yi, m.tmp)
+	f.store(m.t[:f.NbWords], res)
+	f.RET()
+}
+
+// vecLoop writes a loop over n elements; body is emitted once, and reads the
+// current elements at ptrs, which are then incremented. Since t is fully
+// (re)initialized by the first round of a multiplication, the renaming of its
+// registers doesn't need to be undone at the end of the body.
+func (f *FFArm64) vecLoop(n arm64.Register, ptrs []arm64.Register, body func()) {
+	f.CBZ(n, "done")
+	f.LABEL("loop")
+	body()
+	for _, p := range ptrs {
+		f.ADD(fmt.Sprintf("$%d", 8*f.NbWords), p, p)
+	}
+	f.SUB("$1", n, n)
+	f.CBNZ(n, "loop")
+	f.LABEL("done")
+	f.RET()
+}
+
+func (f *FFArm64) generateScalarMulVec() {
+	f.Comment("scalarMulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b")
+	registers := f.fnHeader("scalarMulVecARM64", 32)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	f.MOVD("res+0(FP)", res)
+	f.MOVD("a+8(FP)", a)
+	f.MOVD("b+16(FP)", b)
+	f.MOVD("n+24(FP)", n)
+
+	// x = b, y = a[i]
+	m := f.newMontgomery(&registers, b)
+	m.x.reload(b)
+	f.vecLoop(n, []arm64.Register{res, a}, func() {
+		f.mulBody(m, a)
+		f.store(m.t[:f.NbWords], res)
+	})
+}
+
+func (f *FFArm64) generateMulVec() {
+	f.Comment("mulVecARM64(res, a, b *Element, n uint64) res[i] = a[i]·b[i]")
+	registers := f.fnHeader("mulVecARM64", 32)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	f.MOVD("res+0(FP)", res)
+	f.MOVD("a+8(FP)", a)
+	f.MOVD("b+16(FP)", b)
+	f.MOVD("n+24(FP)", n)
+
+	// x = a[i], y = b[i]
+	m := f.newMontgomery(&registers, a)
+	f.vecLoop(n, []arm64.Register{res, a, b}, func() {
+		m.x.reload(a)
+		f.mulBody(m, b)
+		f.store(m.t[:f.NbWords], res)
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arm64
+
+import "github.com/consensys/bavard/arm64"
+
+func (f *FFArm64) generateReduce() {
+	f.Comment("reduce(res *Element) sets res = res - q if res >= q")
+	registers := f.fnHeader("reduce", 8)
+
+	res := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	mask := registers.Pop()
+	tmp := registers.Pop()
+
+	f.MOVD("res+0(FP)", res)
+	q := f.q(&registers, registers.Pop)
+	f.load(res, t)
+	f.reduce(t, q, mask, tmp)
+	f.store(t, res)
+	f.RET()
+}
+
+// add sets t = t + b (mod q); b is read from memory and t must be less than q.
+// Since q[N-1] < 2⁶³-1, t + b doesn't overflow.
+func (f *FFArm64) add(t []arm64.Register, b arm64.Register, q *words, scratch, mask, tmp arm64.Register) {
+	for j := 0; j < f.NbWords; j++ {
+		f.MOVD(f.RegisterOffset(b, 8*j), scratch)
+		f.op(addChain(j), scratch, t[j], t[j])
+	}
+	f.reduce(t, q, mask, tmp)
+}
+
+// sub sets t = t - b (mod q); b is read from memory.
+func (f *FFArm64) sub(t []arm64.Register, b arm64.Register, q *words, scratch, mask, tmp arm64.Register) {
+	for j := 0; j < f.NbWords; j++ {
+		f.MOVD(f.RegisterOffset(b, 8*j), scratch)
+		f.op(subChain(j), scratch, t[j], t[j])
+	}
+	f.Comment("t = t + q if there was a borrow")
+	f.CSETM("CC", mask)
+	for j := 0; j < f.NbWords; j++ {
+		f.AND(mask, q.at(j), tmp)
+		f.op(addChain(j), tmp, t[j], t[j])
+	}
+}
+
+// Butterfly sets
+// a = a + b
+// b = a - b
+//
+//	func Butterfly(a, b *{{.ElementName}}) {
+//		t := *a
+//		a.Add(a, b)
+//		b.Sub(&t, b)
+//	}
+func (f *FFArm64) generateButterfly() {
+	f.Comment("Butterfly(a, b *Element) sets a = a + b; b = a - b")
+	registers := f.fnHeader("Butterfly", 16)
+
+	a := registers.Pop()
+	b := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	scratch := registers.Pop()
+	mask := registers.Pop()
+	tmp := registers.Pop()
+
+	f.MOVD("a+0(FP)", a)
+	f.MOVD("b+8(FP)", b)
+	q := f.q(&registers, registers.Pop)
+
+	f.Comment("t = a + b, not reduced")
+	f.load(a, t)
+	for j := 0; j < f.NbWords; j++ {
+		f.MOVD(f.RegisterOffset(b, 8*j), scratch)
+		f.op(addChain(j), scratch, t[j], t[j])
+	}
+
+	f.Comment("b = a - b")
+	for j := 0; j < f.NbWords; j++ {
+		f.MOVD(f.RegisterOffset(a, 8*j), scratch)
+		f.MOVD(f.RegisterOffset(b, 8*j), tmp)
+		f.op(subChain(j), tmp, scratch, scratch)
+		f.MOVD(scratch, f.RegisterOffset(b, 8*j))
+	}
+	f.Comment("b = b + q if there was a borrow")
+	f.CSETM("CC", mask)
+	for j := 0; j < f.NbWords; j++ {
+		f.MOVD(f.RegisterOffset(b, 8*j), scratch)
+		f.AND(mask, q.at(j), tmp)
+		f.op(addChain(j), tmp, scratch, scratch)
+		f.MOVD(scratch, f.RegisterOffset(b, 8*j))
+	}
+
+	f.reduce(t, q, mask, tmp)
+	f.store(t, a)
+	f.RET()
+}
+
+func (f *FFArm64) generateAddVec() {
+	f.Comment("addVecARM64(res, a, b *Element, n uint64) res[i] = a[i] + b[i]")
+	f.generateAddSubVec("addVecARM64", f.add)
+}
+
+func (f *FFArm64) generateSubVec() {
+	f.Comment("subVecARM64(res, a, b *Element, n uint64) res[i] = a[i] - b[i]")
+	f.generateAddSubVec("subVecARM64", f.sub)
+}
+
+func (f *FFArm64) generateAddSubVec(name string, op func(t []arm64.Register, b arm64.Register, q *words, scratch, mask, tmp arm64.Register)) {
+	registers := f.fnHeader(name, 32)
+
+	res := registers.Pop()
+	a := registers.Pop()
+	b := registers.Pop()
+	n := registers.Pop()
+	t := registers.PopN(f.NbWords)
+	scratch := registers.Pop()
+	mask := registers.Pop()
+	tmp := registers.Pop()
+
+	f.MOVD("res+0(FP)", res)
+	f.MOVD("a+8(FP)", a)
+	f.MOVD("b+16(FP)", b)
+	f.MOVD("n+24(FP)", n)
+	q := f.q(&registers, registers.Pop)
+
+	f.vecLoop(n, []arm64.Register{res, a, b}, func() {
+		f.load(a, t)
+		op(t, b, q, scratch, mask, tmp)
+		f.store(t, res)
+	})
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"math/big"
+)
+
+// F31Config holds the precomputed values used to generate a small field, that is a
+// field with a modulus q < 2³¹. Elements are stored on a single uint32 word in
+// Montgomery form, with R = 2³². Since 2q < 2³², additions don't overflow and
+// sums of products can be reduced lazily.
+//
+// The quartic extension is built as a tower
+//
+//	E2 = F[u]/(u² - E2NonResidue)
+//	E4 = E2[v]/(v² - (E4C + u))
+//
+// which is the binomial extension F[v]/(v⁴ - E2NonResidue) when E4C = 0.
+type F31Config struct {
+	Q                uint32 // q
+	QInvNeg          uint32 // -q⁻¹ mod 2³²
+	QInv             uint32 // q⁻¹ mod 2³²
+	RSquare          uint32 // R² mod q
+	One              uint32 // R mod q
+	Thirteen         uint32 // 13·R mod q
+	QMinusOneHalvedP uint32 // (q-1)/2 + 1
+	SqrtG            uint32 // nonResidue^s, s being the odd part of q-1 (Montgomery form)
+
+	TwoAdicity        uint64 // largest e such that 2ᵉ divides q-1
+	MultiplicativeGen uint32 // smallest generator of F*
+	RootOfUnity       uint32 // MultiplicativeGen^((q-1)/2ᵉ), of order 2ᵉ (Montgomery form)
+	FFT               bool   // indicates if a FFT domain is generated
+
+	E2NonResidue     int64  // u² = E2NonResidue
+	E2NonResidueMont uint32 // E2NonResidue in Montgomery form
+	E4C              int64  // v² = E4C + u
+	E4CMont          uint32 // E4C in Montgomery form
+}
+
+// minTwoAdicityFFT is the minimum 2-adicity of q-1 for which we generate a FFT domain.
+const minTwoAdicityFFT = 8
+
+func newF31Config(q *big.Int) *F31Config {
+	c := &F31Config{Q: uint32(q.Uint64())}
+
+	// q⁻¹ mod 2³² using Newton iterations
+	inv := c.Q
+	for i := 0; i < 5; i++ {
+		inv *= 2 - c.Q*inv
+	}
+	c.QInv = inv
+	c.QInvNeg = -inv
+
+	toMont := func(x *big.Int) uint32 {
+		var t big.Int
+		t.Lsh(x, 32).Mod(&t, q)
+		return uint32(t.Uint64())
+	}
+
+	one := big.NewInt(1)
+	c.One = toMont(one)
+	c.Thirteen = toMont(big.NewInt(13))
+	var rSquare big.Int
+	rSquare.Lsh(one, 64).Mod(&rSquare, q)
+	c.RSquare = uint32(rSquare.Uint64())
+	c.QMinusOneHalvedP = (c.Q-1)/2 + 1
+
+	var qMinusOne big.Int
+	qMinusOne.Sub(q, one)
+	c.TwoAdicity = uint64(qMinusOne.TrailingZeroBits())
+
+	// g = nonResidue^s, s odd, used by Tonelli-Shanks
+	var s, nonResidue, g big.Int
+	s.Rsh(&qMinusOne, uint(c.TwoAdicity))
+	nonResidue.SetInt64(2)
+	for big.Jacobi(&nonResidue, q) != -1 {
+		nonResidue.Add(&nonResidue, one)
+	}
+	g.Exp(&nonResidue, &s, q)
+	c.SqrtG = toMont(&g)
+
+	// multiplicative generator
+	factors := primeFactors(qMinusOne.Uint64())
+	for x := uint64(2); ; x++ {
+		isGenerator := true
+		for _, p := range factors {
+			var e, t big.Int
+			e.SetUint64(qMinusOne.Uint64() / p)
+			if t.Exp(new(big.Int).SetUint64(x), &e, q).Cmp(one) == 0 {
+				isGenerator = false
+				break
+			}
+		}
+		if isGenerator {
+			c.MultiplicativeGen = uint32(x)
+			break
+		}
+	}
+	var root big.Int
+	root.Exp(new(big.Int).SetUint64(uint64(c.MultiplicativeGen)), &s, q)
+	c.RootOfUnity = toMont(&root)
+	c.FFT = c.TwoAdicity >= minTwoAdicityFFT
+
+	// tower parameters. If q ≡ 1 (mod 4), -4 is a fourth power, so v⁴ - W is
+	// irreducible iff W is not a square, and we pick E4C = 0. Otherwise, -1
+	// is not a square and we look for a non square E4C + u in E2, i.e. such
+	// that its norm E4C² + 1 is not a square in F.
+	isSquare := func(x int64) bool {
+		var t big.Int
+		t.SetInt64(x).Mod(&t, q)
+		return big.Jacobi(&t, q) != -1
+	}
+	if q.Bit(1) == 0 {
+		c.E2NonResidue = 2
+		for isSquare(c.E2NonResidue) {
+			c.E2NonResidue++
+		}
+	} else {
+		c.E2NonResidue = -1
+	}
+	for isSquare(c.E4C*c.E4C - c.E2NonResidue) {
+		c.E4C++
+	}
+	var w big.Int
+	w.SetInt64(c.E2NonResidue).Mod(&w, q)
+	c.E2NonResidueMont = toMont(&w)
+	c.E4CMont = toMont(big.NewInt(c.E4C))
+
+	return c
+}
+
+// primeFactors returns the distinct prime factors of n.
+func primeFactors(n uint64) []uint64 {
+	var res []uint64
+	for p := uint64(2); p*p <= n; p++ {
+		if n%p == 0 {
+			res = append(res, p)
+			for n%p == 0 {
+				n /= p
+			}
+		}
+	}
+	if n > 1 {
+		res = append(res, n)
+	}
+	return res
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"math/big"
+)
+
+// FFTConfig holds the parameters of the fft package of a field.
+type FFTConfig struct {
+	TwoAdicity        uint64   // largest e ≤ maxTwoAdicityFFT such that 2ᵉ divides q-1
+	MultiplicativeGen uint64   // coset shift of the domains
+	RootOfUnity       []uint64 // MultiplicativeGen^((q-1)/2ᵉ), of order 2ᵉ (Montgomery form)
+}
+
+// maxTwoAdicityFFT is the largest order of the domains, whose cardinality is a uint64.
+const maxTwoAdicityFFT = 62
+
+// NewFFTConfig returns the parameters of the fft package of F, or nil if the
+// 2-adicity of q-1 is too small for a FFT domain to be useful.
+//
+// For small fields, MultiplicativeGen generates Fˣ. For larger fields, q-1 can't
+// be factored in general; MultiplicativeGen is then the smallest non-square whose
+// order is not a power of 2: its power RootOfUnity generates the 2-adic subgroup,
+// and it doesn't belong to it, so that the cosets it shifts are disjoint from the domains.
+func NewFFTConfig(F *FieldConfig) *FFTConfig {
+	if F.F31 != nil {
+		if !F.F31.FFT {
+			return nil
+		}
+		return &FFTConfig{
+			TwoAdicity:        F.F31.TwoAdicity,
+			MultiplicativeGen: uint64(F.F31.MultiplicativeGen),
+			RootOfUnity:       []uint64{uint64(F.F31.RootOfUnity)},
+		}
+	}
+
+	q := F.ModulusBig
+	one := big.NewInt(1)
+	var qMinusOne, s big.Int
+	qMinusOne.Sub(q, one)
+	c := &FFTConfig{TwoAdicity: uint64(qMinusOne.TrailingZeroBits())}
+	if c.TwoAdicity < minTwoAdicityFFT {
+		return nil
+	}
+	if c.TwoAdicity > maxTwoAdicityFFT {
+		c.TwoAdicity = maxTwoAdicityFFT
+	}
+	s.Rsh(&qMinusOne, uint(c.TwoAdicity))
+
+	var g, t, twoE big.Int
+	twoE.Lsh(one, uint(c.TwoAdicity))
+	for c.MultiplicativeGen = 2; ; c.MultiplicativeGen++ {
+		g.SetUint64(c.MultiplicativeGen)
+		if big.Jacobi(&g, q) == -1 && t.Exp(&g, &twoE, q).Cmp(one) != 0 {
+			break
+		}
+	}
+
+	var root big.Int
+	root.Exp(&g, &s, q)
+	root = F.ToMont(root)
+	c.RootOfUnity = toUint64Slice(&root, F.NbWords)
+	return c
+}
@@ -51,6 +51,7 @@ type FieldConfig struct {
 	Q                         []uint64
 	QInverse                  []uint64
 	QMinusOneHalvedP          []uint64 // ((q-1) / 2 ) + 1
+	QMinusTwo                 []uint64 // q-2, the exponent of the constant time inverse
 	ASM                       bool
 	RSquare                   []uint64
 	One, Thirteen             []uint64
@@ -72,6 +73,7 @@ type FieldConfig struct {
 	SqrtSMinusOneOver2Data    *addchain.AddChainData
 	SqrtQ3Mod4ExponentData    *addchain.AddChainData
 	UseAddChain               bool
+	F31                       *F31Config // non-nil if q < 2³¹, in which case elements are stored on a uint32
 }
 
 // NewFieldConfig returns a data structure with needed information to generate apis for field element
@@ -107,6 +109,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	bOne := new(big.Int).SetUint64(1)
 	_qHalved.Sub(&bModulus, bOne).Rsh(_qHalved, 1).Add(_qHalved, bOne)
 	F.QMinusOneHalvedP = toUint64Slice(_qHalved, F.NbWords)
+	F.QMinusTwo = toUint64Slice(new(big.Int).Sub(&bModulus, big.NewInt(2)), F.NbWords)
 
 	//  setting qInverse
 	_r := big.NewInt(1)
@@ -261,6 +264,13 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// asm code generation for moduli with more than 6 words can be optimized further
 	F.ASM = F.NoCarry && F.NbWords <= 12 && F.NbWords > 1
 
+	// small fields are stored on a single 32 bits word; they don't use the
+	// generic 64 bits element templates.
+	if F.NbBits <= 31 {
+		F.F31 = newF31Config(&bModulus)
+		F.NbBytes = 4
+	}
+
 	return F, nil
 }
 
@@ -324,7 +334,7 @@ func (f *FieldConfig) StringToMont(str string) big.Int {
 
 func (f *FieldConfig) ToMont(nonMont big.Int) big.Int {
 	var mont big.Int
-	mont.Lsh(&nonMont, uint(f.NbWords)*64)
+	mont.Lsh(&nonMont, f.montgomeryBits())
 	mont.Mod(&mont, f.ModulusBig)
 	return mont
 }
@@ -336,13 +346,21 @@ func (f *FieldConfig) FromMont(nonMont *big.Int, mont *big.Int) *FieldConfig {
 		return f
 	}
 	f.halve(nonMont, mont)
-	for i := 1; i < f.NbWords*64; i++ {
+	for i := 1; i < int(f.montgomeryBits()); i++ {
 		f.halve(nonMont, nonMont)
 	}
 
 	return f
 }
 
+// montgomeryBits returns log₂(R), R being the Montgomery constant.
+func (f *FieldConfig) montgomeryBits() uint {
+	if f.F31 != nil {
+		return 32
+	}
+	return uint(f.NbWords) * 64
+}
+
 func (f *FieldConfig) Exp(res *big.Int, x *big.Int, pow *big.Int) *FieldConfig {
 	res.SetInt64(1)
 
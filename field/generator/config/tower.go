@@ -0,0 +1,139 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// TowerConfig holds the non-residues defining the extensions of a field Fp:
+//
+//	E2 = Fp[u]/(u² - E2NonResidue)
+//	E3 = Fp[v]/(v³ - E3NonResidue)
+//	E6 = E2[v]/(v³ - (E6NonResidue[0] + E6NonResidue[1]·u))
+//
+// E3 only exists if q ≡ 1 (mod 3), otherwise every element of Fp is a cube.
+type TowerConfig struct {
+	E2NonResidue     int64
+	E2NonResidueMont []uint64 // E2NonResidue in Montgomery form
+
+	E3               bool // indicates if the cubic extension E3 is generated
+	E3NonResidue     int64
+	E3NonResidueMont []uint64 // E3NonResidue in Montgomery form
+
+	E6NonResidue     [2]int64
+	E6NonResidueMont [2][]uint64 // E6NonResidue in Montgomery form
+}
+
+var (
+	errTowerSmallField  = errors.New("small fields come with their own quartic extension")
+	errTowerModulus     = errors.New("extension towers require a modulus q > 3")
+	errE2NonResidue     = errors.New("E2 non-residue is a square")
+	errE3NonResidue     = errors.New("E3 non-residue is a cube")
+	errE3NotIrreducible = errors.New("q ≢ 1 (mod 3): there is no binomial cubic extension of Fp")
+	errE6NonResidue     = errors.New("E6 non-residue is a cube in E2")
+)
+
+// NewTowerConfig returns the parameters of the extension tower of F.
+// Non-residues which are not provided (nil) are set to the smallest
+// suitable value; the provided ones are checked.
+func NewTowerConfig(F *FieldConfig, e2NonResidue, e3NonResidue *int64, e6NonResidue *[2]int64) (*TowerConfig, error) {
+	if F.F31 != nil {
+		return nil, errTowerSmallField
+	}
+	q := F.ModulusBig
+	if q.Cmp(big.NewInt(3)) <= 0 {
+		return nil, errTowerModulus
+	}
+	one := big.NewInt(1)
+	mod := func(x int64) *big.Int {
+		r := big.NewInt(x)
+		return r.Mod(r, q)
+	}
+	isSquare := func(x int64) bool {
+		return big.Jacobi(mod(x), q) != -1
+	}
+
+	c := &TowerConfig{}
+
+	// E2: u² - β is irreducible iff β is not a square
+	if e2NonResidue != nil {
+		c.E2NonResidue = *e2NonResidue
+		if isSquare(c.E2NonResidue) {
+			return nil, fmt.Errorf("%w: %d", errE2NonResidue, c.E2NonResidue)
+		}
+	} else {
+		c.E2NonResidue = -1
+		for isSquare(c.E2NonResidue) {
+			c.E2NonResidue = nextCandidate(c.E2NonResidue)
+		}
+	}
+
+	// E3: v³ - β is irreducible iff β is not a cube, i.e. β^((q-1)/3) ≠ 1
+	var qMinusOne, r big.Int
+	qMinusOne.Sub(q, one)
+	c.E3 = r.Mod(&qMinusOne, big.NewInt(3)).Sign() == 0
+	if c.E3 {
+		var e big.Int
+		e.Div(&qMinusOne, big.NewInt(3))
+		isCube := func(x int64) bool {
+			var t big.Int
+			return t.Exp(mod(x), &e, q).Cmp(one) == 0
+		}
+		if e3NonResidue != nil {
+			c.E3NonResidue = *e3NonResidue
+			if isCube(c.E3NonResidue) {
+				return nil, fmt.Errorf("%w: %d", errE3NonResidue, c.E3NonResidue)
+			}
+		} else {
+			c.E3NonResidue = 2
+			for isCube(c.E3NonResidue) {
+				c.E3NonResidue = nextCandidate(c.E3NonResidue)
+			}
+		}
+		c.E3NonResidueMont = F.montWords(c.E3NonResidue)
+	} else if e3NonResidue != nil {
+		return nil, errE3NotIrreducible
+	}
+
+	// E6: v³ - ξ is irreducible over E2 iff ξ^((q²-1)/3) ≠ 1
+	e2 := NewTower(F, 2, c.E2NonResidue)
+	var e big.Int
+	e.Mul(q, q).Sub(&e, one).Div(&e, big.NewInt(3))
+	isCube := func(xi [2]int64) bool {
+		return e2.Equal(e2.Exp(e2.FromInt64(xi[0], xi[1]), &e), e2.FromInt64(1))
+	}
+	if e6NonResidue != nil {
+		c.E6NonResidue = *e6NonResidue
+		if isCube(c.E6NonResidue) {
+			return nil, fmt.Errorf("%w: %d+%d·u", errE6NonResidue, c.E6NonResidue[0], c.E6NonResidue[1])
+		}
+	} else {
+		c.E6NonResidue = [2]int64{0, 1}
+		for isCube(c.E6NonResidue) {
+			c.E6NonResidue[0]++
+		}
+	}
+
+	c.E2NonResidueMont = F.montWords(c.E2NonResidue)
+	c.E6NonResidueMont[0] = F.montWords(c.E6NonResidue[0])
+	c.E6NonResidueMont[1] = F.montWords(c.E6NonResidue[1])
+
+	return c, nil
+}
+
+// nextCandidate enumerates the small non-residue candidates -1, 2, -2, 3, -3, ...
+func nextCandidate(x int64) int64 {
+	if x > 0 {
+		return -x
+	}
+	return -x + 1
+}
+
+// montWords returns x mod q in Montgomery form, as a little-endian slice of words.
+func (f *FieldConfig) montWords(x int64) []uint64 {
+	var t big.Int
+	t.SetInt64(x).Mod(&t, f.ModulusBig)
+	t = f.ToMont(t)
+	return toUint64Slice(&t, f.NbWords)
+}
@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/f31"
+)
+
+// generateF31 generates a field whose modulus is smaller than 2³¹; elements are
+// stored on a uint32, and the package comes with vectorized operations, a
+// quartic extension and, if the 2-adicity of q-1 allows it, a fft package.
+func generateF31(F *config.FieldConfig, outputDir string) error {
+	eName := strings.ToLower(F.ElementName)
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(F.PackageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+		bavard.Funcs(map[string]interface{}{"shorten": shorten}),
+	}
+	withBuildTag := func(tag string) []func(*bavard.Bavard) error {
+		opts := make([]func(*bavard.Bavard) error, len(bavardOpts), len(bavardOpts)+1)
+		copy(opts, bavardOpts)
+		return append(opts, bavard.BuildTag(tag))
+	}
+
+	entries := []struct {
+		file      string
+		templates []string
+		opts      []func(*bavard.Bavard) error
+	}{
+		{eName + ".go", []string{f31.Base}, bavardOpts},
+		{"vector.go", []string{element.Vector, f31.VectorOps}, bavardOpts},
+		{"vector_amd64.go", []string{f31.VectorAmd64}, withBuildTag("!purego")},
+		{"vector_purego.go", []string{f31.VectorPurego}, withBuildTag("!amd64 purego")},
+		{"e4.go", []string{f31.Extensions}, bavardOpts},
+		{"doc.go", []string{element.Doc}, bavardOpts},
+		{eName + "_test.go", []string{f31.Test}, bavardOpts},
+		{"vector_test.go", []string{element.TestVector}, bavardOpts},
+		{"vector_ops_test.go", []string{f31.TestVectorOps}, bavardOpts},
+		{"vector_amd64_test.go", []string{f31.TestVectorAmd64}, withBuildTag("!purego")},
+		{"e4_test.go", []string{f31.ExtensionsTests}, bavardOpts},
+	}
+	for _, e := range entries {
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, e.file), e.templates, F, e.opts...); err != nil {
+			return err
+		}
+	}
+
+	// the assembly file has no package clause
+	asmOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+		bavard.BuildTag("!purego"),
+	}
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, "vector_amd64.s"), []string{f31.VectorAsmAmd64}, F, asmOpts...); err != nil {
+		return err
+	}
+
+	// the fft package refers to the element type, which must be exported
+	if F.F31.FFT && token.IsExported(F.ElementName) {
+		if err := GenerateFFT(F, outputDir); err != nil {
+			return err
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,117 @@
+package generator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/fft"
+)
+
+var (
+	errFFTTwoAdicity = errors.New("the 2-adicity of q-1 is too small to generate a fft package")
+	errFFTElement    = errors.New("the fft package requires an exported element type")
+)
+
+// fftData is the data used to execute the templates of the fft package.
+type fftData struct {
+	*config.FieldConfig
+	FFT             *config.FFTConfig
+	FieldImportPath string
+}
+
+// GenerateFFT generates the fft package of the field F (generated in outputDir) in outputDir/fft.
+// The fft package imports the field package, whose import path is derived from
+// the closest go.mod; if there is none, the fft package is not generated.
+func GenerateFFT(F *config.FieldConfig, outputDir string) error {
+	fftConfig := config.NewFFTConfig(F)
+	if fftConfig == nil {
+		return errFFTTwoAdicity
+	}
+	if !token.IsExported(F.ElementName) {
+		return errFFTElement
+	}
+	importPath, err := ImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+	if importPath == "" {
+		fmt.Println("no go.mod found, skipping generation of", filepath.Join(outputDir, "fft"))
+		return nil
+	}
+	data := fftData{FieldConfig: F, FFT: fftConfig, FieldImportPath: importPath}
+
+	fftDir := filepath.Join(outputDir, "fft")
+	if err := os.MkdirAll(fftDir, 0700); err != nil {
+		return err
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package("fft"),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	entries := []struct {
+		file      string
+		templates []string
+	}{
+		{"doc.go", []string{fft.Doc}},
+		{"options.go", []string{fft.Options, fft.Imports}},
+		{"domain.go", []string{fft.Domain, fft.Imports}},
+		{"fft.go", []string{fft.FFT, fft.Imports}},
+		{"bitreverse.go", []string{fft.BitReverse, fft.Imports}},
+		{"parallel.go", []string{fft.Parallel}},
+		{"fft_test.go", []string{fft.Test, fft.Imports}},
+	}
+	for _, e := range entries {
+		if err := bavard.GenerateFromString(filepath.Join(fftDir, e.file), e.templates, data, bavardOpts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportPath returns the import path of dir, derived from the module path declared
+// in the closest go.mod, or "" if dir is not in a module.
+func ImportPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for d := abs; ; d = filepath.Dir(d) {
+		f, err := os.Open(filepath.Join(d, "go.mod"))
+		if err == nil {
+			module := ""
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				line := strings.TrimSpace(scanner.Text())
+				if strings.HasPrefix(line, "module ") {
+					module = strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module ")), "\"")
+					break
+				}
+			}
+			_ = f.Close()
+			if module == "" {
+				return "", fmt.Errorf("no module declaration in %s", filepath.Join(d, "go.mod"))
+			}
+			rel, err := filepath.Rel(d, abs)
+			if err != nil {
+				return "", err
+			}
+			if rel == "." {
+				return module, nil
+			}
+			return module + "/" + filepath.ToSlash(rel), nil
+		}
+		if filepath.Dir(d) == d {
+			return "", nil
+		}
+	}
+}
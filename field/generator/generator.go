@@ -11,6 +11,7 @@ import (
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator/asm/amd64"
+	"github.com/consensys/gnark-crypto/field/generator/asm/arm64"
 	"github.com/consensys/gnark-crypto/field/generator/config"
 	"github.com/consensys/gnark-crypto/field/generator/internal/addchain"
 	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
@@ -23,11 +24,16 @@ import (
 //	fp, _ = config.NewField("fp", "Element", fpModulus")
 //	generator.GenerateFF(fp, filepath.Join(baseDir, "fp"))
 func GenerateFF(F *config.FieldConfig, outputDir string) error {
+	if F.F31 != nil {
+		return generateF31(F, outputDir)
+	}
+
 	// source file templates
 	sourceFiles := []string{
 		element.Base,
 		element.Reduce,
 		element.Exp,
+		element.CT,
 		element.Conv,
 		element.MulDoc,
 		element.MulCIOS,
@@ -54,6 +60,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	pathSrcArith := filepath.Join(outputDir, "arith.go")
 	pathTest := filepath.Join(outputDir, eName+"_test.go")
 	pathTestVector := filepath.Join(outputDir, "vector_test.go")
+	pathTestVectorOps := filepath.Join(outputDir, "vector_ops_test.go")
 
 	// remove old format generated files
 	oldFiles := []string{"_mul.go", "_mul_amd64.go",
@@ -99,7 +106,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	}
 
 	// generate vector
-	if err := bavard.GenerateFromString(pathSrcVector, []string{element.Vector}, F, bavardOpts...); err != nil {
+	if err := bavard.GenerateFromString(pathSrcVector, []string{element.Vector, element.VectorOps}, F, bavardOpts...); err != nil {
 		return err
 	}
 
@@ -124,6 +131,10 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	if err := bavard.GenerateFromString(pathTestVectorOps, []string{element.TestVectorOps}, F, bavardOpts...); err != nil {
+		return err
+	}
+
 	// if we generate assembly code
 	if F.ASM {
 		// generate ops.s
@@ -179,6 +190,31 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 			}
 		}
 
+		{
+			pathSrc := filepath.Join(outputDir, eName+"_ifma_amd64.s")
+			fmt.Println("generating", pathSrc)
+			f, err := os.Create(pathSrc)
+			if err != nil {
+				return err
+			}
+
+			_, _ = io.WriteString(f, "// +build !purego\n")
+
+			if err := amd64.GenerateIFMA(f, F); err != nil {
+				_ = f.Close()
+				return err
+			}
+			_ = f.Close()
+
+			// run asmfmt
+			cmd := exec.Command("asmfmt", "-w", pathSrc)
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+			if err := cmd.Run(); err != nil {
+				return err
+			}
+		}
+
 	}
 
 	if F.ASM {
@@ -186,6 +222,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		src := []string{
 			element.MulDoc,
 			element.OpsAMD64,
+			element.VectorOpsAMD64,
 		}
 		pathSrc := filepath.Join(outputDir, eName+"_ops_amd64.go")
 		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
@@ -206,18 +243,58 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 			element.MulNoCarry,
 			element.Reduce,
 			element.MulDoc,
+			element.VectorOpsGeneric,
 		}
 		pathSrc := filepath.Join(outputDir, eName+"_ops_purego.go")
 		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
 		copy(bavardOptsCpy, bavardOpts)
 		if F.ASM {
-			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64 purego"))
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64,!arm64 purego"))
 		}
 		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
 			return err
 		}
 	}
 
+	if F.ASM {
+		// generate ops_arm64.go
+		src := []string{
+			element.MulDoc,
+			element.OpsARM64,
+		}
+		pathSrc := filepath.Join(outputDir, eName+"_ops_arm64.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+
+		// generate ops_arm64.s
+		pathSrc = filepath.Join(outputDir, eName+"_ops_arm64.s")
+		fmt.Println("generating", pathSrc)
+		f, err := os.Create(pathSrc)
+		if err != nil {
+			return err
+		}
+
+		_, _ = io.WriteString(f, "// +build !purego\n")
+
+		if err := arm64.Generate(f, F); err != nil {
+			_ = f.Close()
+			return err
+		}
+		_ = f.Close()
+
+		// run asmfmt
+		cmd := exec.Command("asmfmt", "-w", pathSrc)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return err
+		}
+	}
+
 	{
 		// generate doc.go
 		src := []string{
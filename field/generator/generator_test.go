@@ -64,6 +64,9 @@ func TestIntegration(t *testing.T) {
 	}
 
 	moduli["forty_seven"] = "47"
+	moduli["baby_bear"] = "2013265921"
+	moduli["koala_bear"] = "2130706433"
+	moduli["mersenne_31"] = "2147483647"
 	moduli["small"] = "9459143039767"
 	moduli["small_without_no_carry"] = "18446744073709551557" // 64bits
 
@@ -102,3 +105,63 @@ func TestIntegration(t *testing.T) {
 	}
 
 }
+
+// TestIntegrationExtensions generates the extension tower, fft and hash_to_field
+// packages of fields of various sizes and runs their tests.
+func TestIntegrationExtensions(t *testing.T) {
+	const rootDir = "integration_test_extensions"
+	os.RemoveAll(rootDir)
+	err := os.MkdirAll(rootDir, 0700)
+	defer os.RemoveAll(rootDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moduli := map[string]string{
+		"goldilocks":  "18446744069414584321",
+		"bn254fr":     "21888242871839275222246405745257275088548364400416034343698204186575808495617",
+		"bls12381fp":  "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
+		"secp256k1fp": "115792089237316195423570985008687907853269984665640564039457584007908834671663",
+		"babybear":    "2013265921",
+		"p384":        "39402006196394479212279040100143613805079739270465446667948293404245721771496870329047266088258938001861606973112319", // q ≡ 2 (mod 3)
+	}
+
+	for packageName, modulus := range moduli {
+		childDir := filepath.Join(rootDir, packageName)
+		F, err := field.NewFieldConfig(packageName, "Element", modulus, false)
+		if err != nil {
+			t.Fatal(packageName, err)
+		}
+		if err = GenerateFF(F, childDir); err != nil {
+			t.Fatal(packageName, err)
+		}
+		if F.F31 == nil {
+			T, err := field.NewTowerConfig(F, nil, nil, nil)
+			if err != nil {
+				t.Fatal(packageName, err)
+			}
+			if err = GenerateTower(F, T, childDir); err != nil {
+				t.Fatal(packageName, err)
+			}
+			if field.NewFFTConfig(F) != nil {
+				if err = GenerateFFT(F, childDir); err != nil {
+					t.Fatal(packageName, err)
+				}
+			}
+		}
+		if err = GenerateHashToField(F, childDir); err != nil {
+			t.Fatal(packageName, err)
+		}
+	}
+
+	// run go test
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	packageDir := filepath.Join(wd, rootDir) + string(filepath.Separator) + "..."
+	cmd := exec.Command("go", "test", packageDir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatal(string(out))
+	}
+}
@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/htf"
+)
+
+// htfData is the data used to execute the templates of the hash_to_field package.
+type htfData struct {
+	*config.FieldConfig
+	FieldImportPath string
+}
+
+// GenerateHashToField generates the hash_to_field package of the field F (generated
+// in outputDir) in outputDir/hash_to_field. As for the fft package, the import path
+// of the field package is derived from the closest go.mod.
+func GenerateHashToField(F *config.FieldConfig, outputDir string) error {
+	importPath, err := ImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+	if importPath == "" {
+		fmt.Println("no go.mod found, skipping generation of", filepath.Join(outputDir, "hash_to_field"))
+		return nil
+	}
+	data := htfData{FieldConfig: F, FieldImportPath: importPath}
+
+	htfDir := filepath.Join(outputDir, "hash_to_field")
+	if err := os.MkdirAll(htfDir, 0700); err != nil {
+		return err
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package("hash_to_field"),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	entries := []struct {
+		file      string
+		templates []string
+	}{
+		{"doc.go", []string{htf.Doc}},
+		{"hash_to_field.go", []string{htf.HashToField}},
+		{"hash_to_field_test.go", []string{htf.Test}},
+	}
+	for _, e := range entries {
+		if err := bavard.GenerateFromString(filepath.Join(htfDir, e.file), e.templates, data, bavardOpts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import 	"golang.org/x/sys/cpu"
 var (
 	supportAdx = cpu.X86.HasADX && cpu.X86.HasBMI2
 	_ = supportAdx
+	supportAvx512IFMA = cpu.X86.HasAVX512F && cpu.X86.HasAVX512IFMA
+	_ = supportAvx512IFMA
 )
 `
 
@@ -19,5 +21,7 @@ const AsmNoAdx = `
 var (
 	supportAdx = false
 	_ = supportAdx
+	supportAvx512IFMA = false
+	_ = supportAvx512IFMA
 )
 `
@@ -0,0 +1,174 @@
+package element
+
+// CT contains the constant time versions of the arithmetic operations, which don't
+// branch on the values of their operands. The default operations (Add, Mul, ...)
+// conditionally subtract the modulus, which is faster but leaks through timing.
+const CT = `
+{{ define "reduceCT" }}
+// if z ⩾ q → z -= q, without branching on z
+{
+	var b uint64
+	var t {{.ElementName}}
+	{{- range $i := .NbWordsIndexesFull}}
+		t[{{$i}}], b = bits.Sub64(z[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}b{{- end}})
+	{{- end}}
+	// the last subtraction borrows iff z < q
+	m := b - 1
+	{{- range $i := .NbWordsIndexesFull}}
+		z[{{$i}}] ^= m & (z[{{$i}}] ^ t[{{$i}}])
+	{{- end}}
+}
+{{- end }}
+
+{{ define "reduceCarryCT" }}
+// if we overflowed the last addition or if z ⩾ q → z -= q, without branching on z
+{
+	var b uint64
+	var t {{.ElementName}}
+	{{- range $i := .NbWordsIndexesFull}}
+		t[{{$i}}], b = bits.Sub64(z[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}b{{- end}})
+	{{- end}}
+	m := -(carry | (b ^ 1))
+	{{- range $i := .NbWordsIndexesFull}}
+		z[{{$i}}] ^= m & (z[{{$i}}] ^ t[{{$i}}])
+	{{- end}}
+}
+{{- end }}
+
+// AddCT z = x + y (mod q), in constant time: unlike Add, it doesn't branch on
+// the values of x and y.
+func (z *{{.ElementName}}) AddCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{ $hasCarry := or (not $.NoCarry) (gt $.NbWords 1)}}
+	{{- if $hasCarry}}
+		var carry uint64
+	{{- end}}
+	{{- range $i := iterate 0 $.NbWords}}
+		{{- $hasCarry := or (not $.NoCarry) (lt $i $.NbWordsLastIndex)}}
+		z[{{$i}}], {{- if $hasCarry}}carry{{- else}}_{{- end}} = bits.Add64(x[{{$i}}], y[{{$i}}], {{- if eq $i 0}}0{{- else}}carry{{- end}})
+	{{- end}}
+
+	{{- if .NoCarry}}
+		{{ template "reduceCT" .}}
+	{{- else}}
+		{{ template "reduceCarryCT" .}}
+	{{- end}}
+	return z
+}
+
+// DoubleCT z = x + x (mod q), in constant time.
+func (z *{{.ElementName}}) DoubleCT(x *{{.ElementName}}) *{{.ElementName}} {
+	{{ $hasCarry := or (not $.NoCarry) (gt $.NbWords 1)}}
+	{{- if $hasCarry}}
+		var carry uint64
+	{{- end}}
+	{{- range $i := iterate 0 $.NbWords}}
+		{{- $hasCarry := or (not $.NoCarry) (lt $i $.NbWordsLastIndex)}}
+		z[{{$i}}], {{- if $hasCarry}}carry{{- else}}_{{- end}} = bits.Add64(x[{{$i}}], x[{{$i}}], {{- if eq $i 0}}0{{- else}}carry{{- end}})
+	{{- end}}
+
+	{{- if .NoCarry}}
+		{{ template "reduceCT" .}}
+	{{- else}}
+		{{ template "reduceCarryCT" .}}
+	{{- end}}
+	return z
+}
+
+// SubCT z = x - y (mod q), in constant time.
+func (z *{{.ElementName}}) SubCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+		z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], b)
+	{{- end}}
+
+	// if we underflowed, z += q, without branching on b
+	m := -b
+	{{- if eq .NbWords 1}}
+		z[0] += q & m
+	{{- else}}
+		var c uint64
+		z[0], c = bits.Add64(z[0], q0&m, 0)
+		{{- range $i := .NbWordsIndexesNoZero}}
+			{{- if eq $i $.NbWordsLastIndex}}
+				z[{{$i}}], _ = bits.Add64(z[{{$i}}], q{{$i}}&m, c)
+			{{- else}}
+				z[{{$i}}], c = bits.Add64(z[{{$i}}], q{{$i}}&m, c)
+			{{- end}}
+		{{- end}}
+	{{- end}}
+	return z
+}
+
+// NegCT z = q - x, in constant time.
+func (z *{{.ElementName}}) NegCT(x *{{.ElementName}}) *{{.ElementName}} {
+	// m = 0 if x = 0, all ones otherwise
+	nz := x[0] {{- range $i := .NbWordsIndexesNoZero}} | x[{{$i}}] {{- end}}
+	m := uint64(int64(nz | -nz) >> 63)
+	{{- if eq .NbWords 1}}
+		z[0] = (q - x[0]) & m
+	{{- else}}
+		var borrow uint64
+		z[0], borrow = bits.Sub64(q0, x[0], 0)
+		{{- range $i := .NbWordsIndexesNoZero}}
+			{{- if eq $i $.NbWordsLastIndex}}
+				z[{{$i}}], _ = bits.Sub64(q{{$i}}, x[{{$i}}], borrow)
+			{{- else}}
+				z[{{$i}}], borrow = bits.Sub64(q{{$i}}, x[{{$i}}], borrow)
+			{{- end}}
+		{{- end}}
+		{{- range $i := .NbWordsIndexesFull}}
+			z[{{$i}}] &= m
+		{{- end}}
+	{{- end}}
+	return z
+}
+
+// MulCT z = x * y (mod q), in constant time: unlike Mul, it never uses the
+// assembly implementation and doesn't branch on the final reduction.
+{{- if $.NoCarry}}
+//
+// x and y must be less than q
+{{- end }}
+func (z *{{.ElementName}}) MulCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- if eq $.NbWords 1}}
+		{{ template "mul_cios_one_limb" dict "all" . "V1" "x" "V2" "y" "CT" true}}
+	{{- else }}
+		// see Mul for algorithm documentation
+		{{- if $.NoCarry}}
+			{{ template "mul_nocarry" dict "all" . "V1" "x" "V2" "y"}}
+			{{ template "reduceCT" . }}
+		{{- else}}
+			{{ template "mul_cios" dict "all" . "V1" "x" "V2" "y" "CT" true}}
+		{{- end}}
+	{{- end }}
+	return z
+}
+
+// SquareCT z = x * x (mod q), in constant time.
+{{- if $.NoCarry}}
+//
+// x must be less than q
+{{- end }}
+func (z *{{.ElementName}}) SquareCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.MulCT(x, x)
+}
+
+// SetBigIntCT sets z to v (mod q) and returns z. Unlike SetBigInt, it doesn't
+// branch on v if 0 ⩽ v < 2^Bits: v < 2q is reduced with a conditional
+// subtraction and converted to Montgomery form with MulCT. A negative or larger
+// v is first reduced with big.Int.Mod, which is not constant time.
+func (z *{{.ElementName}}) SetBigIntCT(v *big.Int) *{{.ElementName}} {
+	if v.Sign() < 0 || v.BitLen() > Bits {
+		v = new(big.Int).Mod(v, &_modulus)
+	}
+	var buf [Bytes]byte
+	v.FillBytes(buf[:])
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}] = binary.BigEndian.Uint64(buf[{{mul 8 (sub $.NbWordsLastIndex $i)}}:])
+	{{- end}}
+	{{ template "reduceCT" . }}
+	return z.MulCT(z, &rSquare)
+}
+
+`
@@ -8,7 +8,11 @@ const Doc = `
 // The modulus is hardcoded in all the operations.
 // 
 // Field elements are represented as an array, and assumed to be in Montgomery form in all methods:
+{{- if .F31}}
+// 	type {{.ElementName}} [1]uint32
+{{- else}}
 // 	type {{.ElementName}} [{{.NbWords}}]uint64
+{{- end}}
 //
 // Usage
 //
@@ -30,6 +34,16 @@ const Doc = `
 //
 // 	q[base10] = {{.Modulus}}
 // 	q[base16] = 0x{{.ModulusHex}}
+{{- if not .F31}}
+//
+// Constant time
+//
+// AddCT, SubCT, DoubleCT, NegCT, MulCT, SquareCT, ExpCT and InverseCT are the constant time
+// versions of Add, Sub, Double, Neg, Mul, Square, Exp and Inverse, to use on secret values; with
+// Select, they don't branch on the value of their operands. SetBigIntCT converts a secret big.Int
+// in [0, 2^Bits) without branching on it. The other methods are faster but not constant time:
+// Add, Mul, ... conditionally subtract the modulus.
+{{- end}}
 //
 // Warning
 //
@@ -71,6 +71,17 @@ const MulCIOS = `
 	{{- end}}
 
 
+	{{if $.CT}}
+	// copy t into z 
+	{{- range $i := $.all.NbWordsIndexesFull}}
+		z[{{$i}}] = t[{{$i}}]
+	{{- end}}
+	{
+		// the result is on {{add 1 $.all.NbWords}} words
+		carry := t[{{$.all.NbWords}}]
+		{{ template "reduceCarryCT" .all }}
+	}
+	{{- else}}
 	if t[{{$.all.NbWords}}] != 0 {
 		// we need to reduce, we have a result on {{add 1 $.all.NbWords}} words
 		{{- if gt $.all.NbWords 1}}
@@ -91,6 +102,7 @@ const MulCIOS = `
 	{{- range $i := $.all.NbWordsIndexesFull}}
 		z[{{$i}}] = t[{{$i}}]
 	{{- end}}
+	{{- end}}
 
 {{ end }}
 
@@ -109,17 +121,27 @@ const MulCIOS = `
 
 	var r uint64
 	hi, lo := bits.Mul64({{$.V1}}[0], {{$.V2}}[0])
+	{{- if $.CT}}
+	hi += (lo | -lo) >> 63 // hi++ if lo != 0, without branching on lo
+	{{- else}}
 	if lo != 0 {
 		hi++ // x[0] * y[0] ≤ 2¹²⁸ - 2⁶⁵ + 1, meaning hi ≤ 2⁶⁴ - 2 so no need to worry about overflow
 	}
+	{{- end}}
 	m := lo * qInvNeg
 	hi2, _ := bits.Mul64(m, q)
 	r, carry := bits.Add64(hi2, hi, 0)
 
+	{{if $.CT}}
+	// we need to reduce if carry != 0 || r >= q, without branching on r
+	t, b := bits.Sub64(r, q, 0)
+	r ^= -(carry | (b ^ 1)) & (r ^ t)
+	{{- else}}
 	if carry != 0 || r >= q  {
 		// we need to reduce
 		r -= q 
 	}
+	{{- end}}
 	z[0] = r 
 {{ end }}
 `
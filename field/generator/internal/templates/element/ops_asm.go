@@ -53,4 +53,107 @@ func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
 
 
 
+`
+
+// OpsARM64 is included with ARM64 builds if F.ASM is set
+const OpsARM64 = `
+
+//go:noescape
+func mul(res,x,y *{{.ElementName}})
+
+//go:noescape
+func fromMont(res *{{.ElementName}})
+
+//go:noescape
+func reduce(res *{{.ElementName}})
+
+// Butterfly sets
+//  a = a + b (mod q)
+//  b = a - b (mod q)
+//go:noescape
+func Butterfly(a, b *{{.ElementName}})
+
+//go:noescape
+func addVecARM64(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func subVecARM64(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func scalarMulVecARM64(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func mulVecARM64(res, a, b *{{.ElementName}}, n uint64)
+
+{{ $mulConsts := list 3 5 13 }}
+{{- range $i := $mulConsts }}
+
+// MulBy{{$i}} x *= {{$i}} (mod q)
+func MulBy{{$i}}(x *{{$.ElementName}}) {
+	{{- if eq $i 3}}
+		_x := *x
+		x.Double(x).Add(x, &_x)
+	{{- else if eq $i 5}}
+		_x := *x
+		x.Double(x).Double(x).Add(x, &_x)
+	{{- else if eq $i 13}}
+		var y = {{$.ElementName}}{
+			{{- range $i := $.Thirteen}}
+			{{$i}},{{end}}
+		}
+		x.Mul(x, &y)
+	{{- end}}
+}
+
+{{- end}}
+
+// Mul z = x * y (mod q)
+//
+// x and y must be less than q
+func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{ mul_doc $.NoCarry }}
+	mul(z, x, y)
+	return z
+}
+
+// Square z = x * x (mod q)
+//
+// x must be less than q
+func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
+	// see Mul for doc.
+	mul(z, x, x)
+	return z
+}
+
+func addVec(res, a, b Vector) {
+	if len(a) == 0 {
+		return
+	}
+	addVecARM64(&res[0], &a[0], &b[0], uint64(len(a)))
+}
+
+func subVec(res, a, b Vector) {
+	if len(a) == 0 {
+		return
+	}
+	subVecARM64(&res[0], &a[0], &b[0], uint64(len(a)))
+}
+
+func scalarMulVec(res, a Vector, b *{{.ElementName}}) {
+	if len(a) == 0 {
+		return
+	}
+	scalarMulVecARM64(&res[0], &a[0], b, uint64(len(a)))
+}
+
+func mulVec(res, a, b Vector) {
+	if len(a) == 0 {
+		return
+	}
+	mulVecARM64(&res[0], &a[0], &b[0], uint64(len(a)))
+}
+
+func butterflyVec(a, b Vector) {
+	butterflyVecGeneric(a, b)
+}
 `
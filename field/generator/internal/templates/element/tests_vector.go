@@ -81,3 +81,107 @@ func (vector *Vector) unmarshalBinaryAsync(data []byte) error {
 }
 
 `
+
+// TestVectorOps compares the vector operations to the element operations.
+const TestVectorOps = `
+
+import (
+	"fmt"
+	"testing"
+)
+
+func randomVector(t testing.TB, n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		if _, err := v[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// edge values
+	if n > 2 {
+		v[0].SetZero()
+		v[1].SetOne().Neg(&v[1])
+	}
+	return v
+}
+
+func TestVectorOps(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 8, 9, 16, 33, 64} {
+		a, b := randomVector(t, n), randomVector(t, n)
+		var scalar {{.ElementName}}
+		scalar.SetRandom()
+
+		add, sub, mul, scalarMul := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+		add.Add(a, b)
+		sub.Sub(a, b)
+		mul.Mul(a, b)
+		scalarMul.ScalarMul(a, &scalar)
+		u, v := make(Vector, n), make(Vector, n)
+		copy(u, a)
+		copy(v, b)
+		u.Butterfly(v)
+
+		var sum, innerProduct {{.ElementName}}
+		for i := 0; i < n; i++ {
+			var e, tmp {{.ElementName}}
+			if !add[i].Equal(e.Add(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Add mismatch at %d", n, i)
+			}
+			if !sub[i].Equal(e.Sub(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Sub mismatch at %d", n, i)
+			}
+			if !mul[i].Equal(e.Mul(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Mul mismatch at %d", n, i)
+			}
+			if !scalarMul[i].Equal(e.Mul(&a[i], &scalar)) {
+				t.Fatalf("n=%d: ScalarMul mismatch at %d", n, i)
+			}
+			if !u[i].Equal(&add[i]) || !v[i].Equal(&sub[i]) {
+				t.Fatalf("n=%d: Butterfly mismatch at %d", n, i)
+			}
+			sum.Add(&sum, &a[i])
+			innerProduct.Add(&innerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+		if s := a.Sum(); !s.Equal(&sum) {
+			t.Fatalf("n=%d: Sum mismatch", n)
+		}
+		if ip := a.InnerProduct(b); !ip.Equal(&innerProduct) {
+			t.Fatalf("n=%d: InnerProduct mismatch", n)
+		}
+
+		// in place
+		a.Mul(a, b)
+		for i := range a {
+			if !a[i].Equal(&mul[i]) {
+				t.Fatalf("n=%d: in place Mul mismatch at %d", n, i)
+			}
+		}
+	}
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 10
+	a, c := randomVector(b, n), randomVector(b, n)
+	res := make(Vector, n)
+	b.Run(fmt.Sprintf("add/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Add(a, c)
+		}
+	})
+	b.Run(fmt.Sprintf("mul/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Mul(a, c)
+		}
+	})
+	b.Run(fmt.Sprintf("scalarMul/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMul(a, &c[0])
+		}
+	})
+	b.Run(fmt.Sprintf("butterfly/%d", n), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.Butterfly(c)
+		}
+	})
+}
+`
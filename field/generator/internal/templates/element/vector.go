@@ -106,6 +106,9 @@ func (vector *Vector) AsyncReadFrom(r io.Reader) (int64, error, chan error) {
 				bstart := i*Bytes
 				bend := bstart + Bytes
 				b := bSlice[bstart:bend]
+				{{- if .F31}}
+				z[0] = binary.BigEndian.Uint32(b[0:4])
+				{{- else}}
 				{{- range $i := reverse .NbWordsIndexesFull}}
 					{{- $j := mul $i 8}}
 					{{- $k := sub $.NbWords 1}}
@@ -113,6 +116,7 @@ func (vector *Vector) AsyncReadFrom(r io.Reader) (int64, error, chan error) {
 					{{- $jj := add $j 8}}
 					z[{{$k}}] = binary.BigEndian.Uint64(b[{{$j}}:{{$jj}}])
 				{{- end}}
+				{{- end}}
 
 				if !z.smallerThanModulus() {
 					atomic.AddUint64(&cptErrors, 1)
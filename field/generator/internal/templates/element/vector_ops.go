@@ -0,0 +1,180 @@
+package element
+
+// VectorOps are the arithmetic operations on vectors; they are dispatched to
+// assembly implementations when available (see OpsARM64 and VectorOpsAMD64).
+const VectorOps = `
+
+// Add adds two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	addVec(*vector, a, b)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	subVec(*vector, a, b)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	scalarMulVec(*vector, a, b)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	mulVec(*vector, a, b)
+}
+
+// Butterfly sets vector[i], b[i] = vector[i] + b[i], vector[i] - b[i] element-wise.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Butterfly(b Vector) {
+	if len(b) != len(*vector) {
+		panic("vector.Butterfly: vectors don't have the same length")
+	}
+	butterflyVec(*vector, b)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	for i := 0; i < len(*vector); i++ {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of vector and other.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp {{.ElementName}}
+	for i := 0; i < len(other); i++ {
+		tmp.Mul(&(*vector)[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
+
+func addVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
+
+func subVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a Vector, b *{{.ElementName}}) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
+
+func mulVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
+
+func butterflyVecGeneric(a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		Butterfly(&a[i], &b[i])
+	}
+}
+`
+
+// VectorOpsGeneric dispatches the vector operations to the generic implementations.
+const VectorOpsGeneric = `
+
+func addVec(res, a, b Vector) {
+	addVecGeneric(res, a, b)
+}
+
+func subVec(res, a, b Vector) {
+	subVecGeneric(res, a, b)
+}
+
+func scalarMulVec(res, a Vector, b *{{.ElementName}}) {
+	scalarMulVecGeneric(res, a, b)
+}
+
+func mulVec(res, a, b Vector) {
+	mulVecGeneric(res, a, b)
+}
+
+func butterflyVec(a, b Vector) {
+	butterflyVecGeneric(a, b)
+}
+`
+
+// VectorOpsAMD64 dispatches the multiplications and butterflies to the AVX-512 IFMA
+// kernels when supported; they process blocks of 8 elements, the remaining
+// elements are processed by the generic implementations.
+const VectorOpsAMD64 = `
+
+//go:noescape
+func mulVecIFMA(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func scalarMulVecIFMA(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func butterflyVecIFMA(a, b *{{.ElementName}}, n uint64)
+
+func addVec(res, a, b Vector) {
+	addVecGeneric(res, a, b)
+}
+
+func subVec(res, a, b Vector) {
+	subVecGeneric(res, a, b)
+}
+
+func scalarMulVec(res, a Vector, b *{{.ElementName}}) {
+	n := len(a) / 8
+	if !supportAvx512IFMA || n == 0 {
+		scalarMulVecGeneric(res, a, b)
+		return
+	}
+	scalarMulVecIFMA(&res[0], &a[0], b, uint64(n))
+	scalarMulVecGeneric(res[8*n:], a[8*n:], b)
+}
+
+func mulVec(res, a, b Vector) {
+	n := len(a) / 8
+	if !supportAvx512IFMA || n == 0 {
+		mulVecGeneric(res, a, b)
+		return
+	}
+	mulVecIFMA(&res[0], &a[0], &b[0], uint64(n))
+	mulVecGeneric(res[8*n:], a[8*n:], b[8*n:])
+}
+
+func butterflyVec(a, b Vector) {
+	n := len(a) / 8
+	if !supportAvx512IFMA || n == 0 {
+		butterflyVecGeneric(a, b)
+		return
+	}
+	butterflyVecIFMA(&a[0], &b[0], uint64(n))
+	butterflyVecGeneric(a[8*n:], b[8*n:])
+}
+`
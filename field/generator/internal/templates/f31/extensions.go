@@ -0,0 +1,497 @@
+package f31
+
+// Extensions is the template of the quadratic and quartic extensions of a small field.
+const Extensions = `
+import (
+	"math/big"
+)
+
+// E2 is a degree two extension of {{.ElementName}}:
+//
+//	E2 = {{.ElementName}}[u]/(u² - ({{.F31.E2NonResidue}}))
+type E2 struct {
+	A0, A1 {{.ElementName}}
+}
+
+// E4 is a degree four extension of {{.ElementName}}, built as a degree two extension of E2:
+//
+//	E4 = E2[v]/(v² - ({{.F31.E4C}}+u))
+{{- if eq .F31.E4C 0}}
+//
+// it is the binomial extension {{.ElementName}}[v]/(v⁴ - ({{.F31.E2NonResidue}})).
+{{- end}}
+type E4 struct {
+	B0, B1 E2
+}
+
+{{- if ne .F31.E2NonResidue -1}}
+
+// e2NonResidue = {{.F31.E2NonResidue}} (Montgomery form)
+var e2NonResidue = {{.ElementName}}{ {{- .F31.E2NonResidueMont -}} }
+{{- end}}
+
+{{- if ne .F31.E4C 0}}
+
+// e4C = {{.F31.E4C}} (Montgomery form)
+var e4C = {{.ElementName}}{ {{- .F31.E4CMont -}} }
+{{- end}}
+
+// mulByE2NonResidue sets z = ({{.F31.E2NonResidue}})·x
+func mulByE2NonResidue(z, x *{{.ElementName}}) {
+	{{- if eq .F31.E2NonResidue -1}}
+	z.Neg(x)
+	{{- else}}
+	z.Mul(x, &e2NonResidue)
+	{{- end}}
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// Set sets z to x and returns z
+func (z *E2) Set(x *E2) *E2 {
+	*z = *x
+	return z
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E2) SetZero() *E2 {
+	*z = E2{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E2) SetOne() *E2 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E2) SetRandom() (*E2, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Conjugate sets z = x₀ - x₁u and returns z
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	var a, b, c {{.ElementName}}
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	mulByE2NonResidue(&c, &c)
+	z.A0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E2) Square(x *E2) *E2 {
+	var a, b {{.ElementName}}
+	a.Square(&x.A0)
+	b.Square(&x.A1)
+	mulByE2NonResidue(&b, &b)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y, y in {{.ElementName}}, and returns z
+func (z *E2) MulByElement(x *E2, y *{{.ElementName}}) *E2 {
+	z.A0.Mul(&x.A0, y)
+	z.A1.Mul(&x.A1, y)
+	return z
+}
+
+// Norm returns x₀² - ({{.F31.E2NonResidue}})·x₁²
+func (z *E2) Norm() {{.ElementName}} {
+	var a, b {{.ElementName}}
+	a.Square(&z.A0)
+	b.Square(&z.A1)
+	mulByE2NonResidue(&b, &b)
+	return *a.Sub(&a, &b)
+}
+
+// Inverse sets z = x⁻¹ and returns z; if x is 0, z is set to 0
+func (z *E2) Inverse(x *E2) *E2 {
+	n := x.Norm()
+	n.Inverse(&n)
+	z.Conjugate(x)
+	return z.MulByElement(z, &n)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.Sign() == -1 {
+		x.Inverse(&x)
+		k = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if k.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// String returns the string representation of z
+func (z *E2) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+}
+
+// mulByE4NonResidue sets z = ({{.F31.E4C}}+u)·x and returns z
+func (z *E2) mulByE4NonResidue(x *E2) *E2 {
+	{{- if eq .F31.E4C 0}}
+	var a {{.ElementName}}
+	mulByE2NonResidue(&a, &x.A1)
+	z.A1 = x.A0
+	z.A0 = a
+	{{- else}}
+	// (x₀ + x₁u)(c + u) = c·x₀ + ({{.F31.E2NonResidue}})·x₁ + (x₀ + c·x₁)u
+	var a, b {{.ElementName}}
+	mulByE2NonResidue(&a, &x.A1)
+	b.Mul(&x.A0, &e4C)
+	a.Add(&a, &b)
+	b.Mul(&x.A1, &e4C)
+	z.A1.Add(&x.A0, &b)
+	z.A0 = a
+	{{- end}}
+	return z
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// Set sets z to x and returns z
+func (z *E4) Set(x *E4) *E4 {
+	*z = *x
+	return z
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E4) SetZero() *E4 {
+	*z = E4{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E4) SetOne() *E4 {
+	z.B0.SetOne()
+	z.B1.SetZero()
+	return z
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E4) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E4) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E4) SetRandom() (*E4, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *E4) Add(x, y *E4) *E4 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E4) Double(x *E4) *E4 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E4) Neg(x *E4) *E4 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Conjugate sets z = x₀ - x₁v and returns z
+func (z *E4) Conjugate(x *E4) *E4 {
+	z.B0 = x.B0
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	var a, b, c E2
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	c.mulByE4NonResidue(&c)
+	z.B0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E4) Square(x *E4) *E4 {
+	var a, b E2
+	a.Square(&x.B0)
+	b.Square(&x.B1)
+	b.mulByE4NonResidue(&b)
+	z.B1.Mul(&x.B0, &x.B1).Double(&z.B1)
+	z.B0.Add(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y, y in {{.ElementName}}, and returns z
+func (z *E4) MulByElement(x *E4, y *{{.ElementName}}) *E4 {
+	z.B0.MulByElement(&x.B0, y)
+	z.B1.MulByElement(&x.B1, y)
+	return z
+}
+
+// MulByE2 sets z = x·y, y in E2, and returns z
+func (z *E4) MulByE2(x *E4, y *E2) *E4 {
+	z.B0.Mul(&x.B0, y)
+	z.B1.Mul(&x.B1, y)
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; if x is 0, z is set to 0
+func (z *E4) Inverse(x *E4) *E4 {
+	// x⁻¹ = (x₀ - x₁v) / (x₀² - ({{.F31.E4C}}+u)·x₁²)
+	var a, b E2
+	a.Square(&x.B0)
+	b.Square(&x.B1)
+	b.mulByE4NonResidue(&b)
+	a.Sub(&a, &b).Inverse(&a)
+	z.Conjugate(x)
+	return z.MulByE2(z, &a)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.Sign() == -1 {
+		x.Inverse(&x)
+		k = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if k.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// String returns the string representation of z
+func (z *E4) String() string {
+	return "(" + z.B0.String() + ")+(" + z.B1.String() + ")*v"
+}
+`
+
+// ExtensionsTests is the test template of the extensions of a small field.
+const ExtensionsTests = `
+import (
+	"math/big"
+	"testing"
+)
+
+func randomE4(t *testing.T) E4 {
+	var x E4
+	if _, err := x.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func TestE2(t *testing.T) {
+	var x, y, z, w E2
+	for i := 0; i < 100; i++ {
+		x.SetRandom()
+		y.SetRandom()
+
+		// (x·y)·x⁻¹ = y
+		z.Mul(&x, &y)
+		w.Inverse(&x)
+		z.Mul(&z, &w)
+		if !x.IsZero() && !z.Equal(&y) {
+			t.Fatal("x·y·x⁻¹ ≠ y")
+		}
+
+		z.Square(&x)
+		w.Mul(&x, &x)
+		if !z.Equal(&w) {
+			t.Fatal("x² ≠ x·x")
+		}
+	}
+
+	// u² = {{.F31.E2NonResidue}}
+	var u, expected E2
+	u.A1.SetOne()
+	u.Square(&u)
+	expected.A0.SetInt64({{.F31.E2NonResidue}})
+	if !u.Equal(&expected) {
+		t.Fatal("u² ≠ {{.F31.E2NonResidue}}")
+	}
+}
+
+func TestE4(t *testing.T) {
+	var one E4
+	one.SetOne()
+
+	// the multiplicative group of E4 has order q⁴-1
+	var order big.Int
+	order.Exp(Modulus(), big.NewInt(4), nil).Sub(&order, big.NewInt(1))
+
+	for i := 0; i < 20; i++ {
+		x, y, z := randomE4(t), randomE4(t), randomE4(t)
+
+		var a, b, c E4
+
+		// commutativity and associativity
+		a.Mul(&x, &y)
+		b.Mul(&y, &x)
+		if !a.Equal(&b) {
+			t.Fatal("x·y ≠ y·x")
+		}
+		a.Mul(&a, &z)
+		b.Mul(&y, &z).Mul(&b, &x)
+		if !a.Equal(&b) {
+			t.Fatal("(x·y)·z ≠ x·(y·z)")
+		}
+
+		// distributivity
+		a.Add(&y, &z).Mul(&a, &x)
+		b.Mul(&x, &y)
+		c.Mul(&x, &z)
+		b.Add(&b, &c)
+		if !a.Equal(&b) {
+			t.Fatal("x·(y+z) ≠ x·y + x·z")
+		}
+
+		a.Square(&x)
+		b.Mul(&x, &x)
+		if !a.Equal(&b) {
+			t.Fatal("x² ≠ x·x")
+		}
+
+		a.Inverse(&x).Mul(&a, &x)
+		if !a.Equal(&one) {
+			t.Fatal("x·x⁻¹ ≠ 1")
+		}
+
+		a.Exp(x, &order)
+		if !a.Equal(&one) {
+			t.Fatal("x^(q⁴-1) ≠ 1")
+		}
+	}
+
+	// v² = {{.F31.E4C}}+u
+	var v, expected E4
+	v.B1.A0.SetOne()
+	v.Square(&v)
+	expected.B0.A0.SetInt64({{.F31.E4C}})
+	expected.B0.A1.SetOne()
+	if !v.Equal(&expected) {
+		t.Fatal("v² ≠ {{.F31.E4C}}+u")
+	}
+}
+
+func BenchmarkE4Mul(b *testing.B) {
+	var x, y E4
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE4Inverse(b *testing.B) {
+	var x E4
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+`
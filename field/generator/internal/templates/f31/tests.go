@@ -0,0 +1,360 @@
+package f31
+
+// Test is the test template of the small field elements; results are compared
+// against math/big on random and edge values.
+const Test = `
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// testValues returns edge values and random values, along with their big.Int counterparts
+func testValues(t *testing.T) ([]{{.ElementName}}, []big.Int) {
+	q := Modulus()
+	var values []{{.ElementName}}
+	for _, v := range []uint32{0, 1, 2, 3, q0 - 2, q0 - 1, q0 / 2, q0/2 + 1} {
+		values = append(values, New{{.ElementName}}(uint64(v)))
+	}
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		values = append(values, New{{.ElementName}}(r.Uint64()))
+	}
+	var x {{.ElementName}}
+	if _, err := x.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	values = append(values, x)
+
+	bValues := make([]big.Int, len(values))
+	for i := range values {
+		values[i].BigInt(&bValues[i])
+		if bValues[i].Cmp(q) != -1 {
+			t.Fatal("element is not reduced")
+		}
+	}
+	return values, bValues
+}
+
+func TestElementArithmetic(t *testing.T) {
+	values, bValues := testValues(t)
+	q := Modulus()
+
+	check := func(op string, z {{.ElementName}}, expected *big.Int) {
+		t.Helper()
+		expected.Mod(expected, q)
+		var b big.Int
+		if z.BigInt(&b).Cmp(expected) != 0 {
+			t.Fatalf("%s: expected %s, got %s", op, expected.String(), b.String())
+		}
+	}
+
+	for i := range values {
+		a, bA := values[i], &bValues[i]
+		for j := range values {
+			b, bB := values[j], &bValues[j]
+			var z {{.ElementName}}
+			var e big.Int
+
+			check("Add", *z.Add(&a, &b), e.Add(bA, bB))
+			check("Sub", *z.Sub(&a, &b), e.Sub(bA, bB))
+			check("Mul", *z.Mul(&a, &b), e.Mul(bA, bB))
+			if !b.IsZero() {
+				var bInv big.Int
+				bInv.ModInverse(bB, q)
+				check("Div", *z.Div(&a, &b), e.Mul(bA, &bInv))
+			}
+		}
+
+		var z {{.ElementName}}
+		var e big.Int
+		check("Double", *z.Double(&a), e.Lsh(bA, 1))
+		check("Neg", *z.Neg(&a), e.Neg(bA))
+		check("Square", *z.Square(&a), e.Mul(bA, bA))
+		check("Exp", *z.Exp(a, big.NewInt(1234567)), e.Exp(bA, big.NewInt(1234567), q))
+
+		z = a
+		MulBy3(&z)
+		check("MulBy3", z, e.Mul(bA, big.NewInt(3)))
+		z = a
+		MulBy5(&z)
+		check("MulBy5", z, e.Mul(bA, big.NewInt(5)))
+		z = a
+		MulBy13(&z)
+		check("MulBy13", z, e.Mul(bA, big.NewInt(13)))
+
+		z = a
+		z.Halve()
+		check("Halve", *z.Double(&z), e.Set(bA))
+
+		if !a.IsZero() {
+			check("Inverse", *z.Inverse(&a), e.ModInverse(bA, q))
+		}
+
+		if a.Legendre() != big.Jacobi(bA, q) {
+			t.Fatal("Legendre doesn't match big.Jacobi")
+		}
+		if z.Sqrt(&a) != nil {
+			check("Sqrt", *z.Square(&z), e.Set(bA))
+		} else if a.Legendre() != -1 {
+			t.Fatal("Sqrt returned nil for a square")
+		}
+	}
+}
+
+func TestElementButterfly(t *testing.T) {
+	values, _ := testValues(t)
+	for i := 1; i < len(values); i++ {
+		a, b := values[i-1], values[i]
+		var s, d {{.ElementName}}
+		s.Add(&a, &b)
+		d.Sub(&a, &b)
+		Butterfly(&a, &b)
+		if !a.Equal(&s) || !b.Equal(&d) {
+			t.Fatal("Butterfly(a, b) ≠ (a+b, a-b)")
+		}
+	}
+}
+
+func TestElementBatchInvert(t *testing.T) {
+	values, _ := testValues(t)
+	inv := BatchInvert(values)
+	for i := range values {
+		var expected {{.ElementName}}
+		expected.Inverse(&values[i])
+		if !inv[i].Equal(&expected) {
+			t.Fatal("BatchInvert doesn't match Inverse")
+		}
+	}
+}
+
+func TestElementSerialization(t *testing.T) {
+	values, bValues := testValues(t)
+	for i := range values {
+		a := values[i]
+		var b {{.ElementName}}
+
+		bytes := a.Bytes()
+		b.SetBytes(bytes[:])
+		if !a.Equal(&b) {
+			t.Fatal("SetBytes(Bytes()) round trip failed")
+		}
+		if err := b.SetBytesCanonical(bytes[:]); err != nil || !a.Equal(&b) {
+			t.Fatal("SetBytesCanonical(Bytes()) round trip failed")
+		}
+
+		var buf [Bytes]byte
+		LittleEndian.PutElement(&buf, a)
+		if c, err := LittleEndian.Element(&buf); err != nil || !a.Equal(&c) {
+			t.Fatal("LittleEndian round trip failed")
+		}
+		BigEndian.PutElement(&buf, a)
+		if c, err := BigEndian.Element(&buf); err != nil || !a.Equal(&c) {
+			t.Fatal("BigEndian round trip failed")
+		}
+
+		if _, err := b.SetString(a.String()); err != nil || !a.Equal(&b) {
+			t.Fatal("SetString(String()) round trip failed")
+		}
+		b.SetBigInt(&bValues[i])
+		if !a.Equal(&b) {
+			t.Fatal("SetBigInt(BigInt()) round trip failed")
+		}
+
+		data, err := json.Marshal(&a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &b); err != nil || !a.Equal(&b) {
+			t.Fatal("JSON round trip failed")
+		}
+	}
+
+	// non canonical encodings are rejected
+	var buf [Bytes]byte
+	binary.BigEndian.PutUint32(buf[:], q0)
+	if _, err := BigEndian.Element(&buf); err == nil {
+		t.Fatal("BigEndian.Element accepted q")
+	}
+}
+
+func TestElementSetInt64(t *testing.T) {
+	q := Modulus()
+	for _, v := range []int64{0, 1, -1, 42, -42, 1 << 40, -(1 << 40), -1 << 63} {
+		var z {{.ElementName}}
+		var b, e big.Int
+		e.SetInt64(v).Mod(&e, q)
+		if z.SetInt64(v).BigInt(&b).Cmp(&e) != 0 {
+			t.Fatalf("SetInt64(%d): expected %s, got %s", v, e.String(), b.String())
+		}
+	}
+}
+
+func BenchmarkElementMul(b *testing.B) {
+	var x, y {{.ElementName}}
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkElementInverse(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+func BenchmarkElementSqrt(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	x.Square(&x)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var z {{.ElementName}}
+		z.Sqrt(&x)
+	}
+}
+`
+
+// TestVectorOps is the test template of the vector operations; the vectorized
+// kernels are compared against the generic implementations.
+const TestVectorOps = `
+import (
+	"fmt"
+	"testing"
+)
+
+func randomVector(t testing.TB, n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		if _, err := v[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// edge values
+	if n > 2 {
+		v[0].SetZero()
+		v[1].SetUint64(uint64(q0 - 1))
+	}
+	return v
+}
+
+func TestVectorOps(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 8, 15, 16, 17, 33, 64, 100, 257} {
+		a, b := randomVector(t, n), randomVector(t, n)
+		var scalar {{.ElementName}}
+		scalar.SetRandom()
+
+		add, sub, mul, scalarMul := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+		add.Add(a, b)
+		sub.Sub(a, b)
+		mul.Mul(a, b)
+		scalarMul.ScalarMul(a, &scalar)
+
+		var sum, innerProduct {{.ElementName}}
+		for i := 0; i < n; i++ {
+			var e, tmp {{.ElementName}}
+			if !add[i].Equal(e.Add(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Add mismatch at %d", n, i)
+			}
+			if !sub[i].Equal(e.Sub(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Sub mismatch at %d", n, i)
+			}
+			if !mul[i].Equal(e.Mul(&a[i], &b[i])) {
+				t.Fatalf("n=%d: Mul mismatch at %d", n, i)
+			}
+			if !scalarMul[i].Equal(e.Mul(&a[i], &scalar)) {
+				t.Fatalf("n=%d: ScalarMul mismatch at %d", n, i)
+			}
+			sum.Add(&sum, &a[i])
+			innerProduct.Add(&innerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+		if s := a.Sum(); !s.Equal(&sum) {
+			t.Fatalf("n=%d: Sum mismatch", n)
+		}
+		if ip := a.InnerProduct(b); !ip.Equal(&innerProduct) {
+			t.Fatalf("n=%d: InnerProduct mismatch", n)
+		}
+
+		// in place
+		a.Add(a, b)
+		if !vectorEqual(a, add) {
+			t.Fatalf("n=%d: in place Add mismatch", n)
+		}
+	}
+}
+
+func TestVectorOpsLargeInnerProduct(t *testing.T) {
+	// all elements set to q-1 maximizes the lazy accumulator
+	const n = 1 << 12
+	a := make(Vector, n)
+	for i := range a {
+		a[i].SetUint64(uint64(q0 - 1))
+	}
+	var expected {{.ElementName}}
+	expected.SetUint64(n)
+	if ip := a.InnerProduct(a); !ip.Equal(&expected) {
+		t.Fatal("InnerProduct mismatch")
+	}
+	expected.Neg(&expected)
+	if s := a.Sum(); !s.Equal(&expected) {
+		t.Fatal("Sum mismatch")
+	}
+}
+
+func vectorEqual(a, b Vector) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	for _, n := range []int{1 << 10, 1 << 16} {
+		a, c := randomVector(b, n), randomVector(b, n)
+		res := make(Vector, n)
+		b.Run(fmt.Sprintf("add/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.Add(a, c)
+			}
+		})
+		b.Run(fmt.Sprintf("mul/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.Mul(a, c)
+			}
+		})
+		b.Run(fmt.Sprintf("innerProduct/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = a.InnerProduct(c)
+			}
+		})
+	}
+}
+`
+
+// TestVectorAmd64 forces the AVX2 kernels when AVX-512 is available.
+const TestVectorAmd64 = `
+import "testing"
+
+func TestVectorOpsAVX2(t *testing.T) {
+	if !supportAvx2 {
+		t.Skip("AVX2 not supported")
+	}
+	defer func(b bool) { supportAvx512 = b }(supportAvx512)
+	supportAvx512 = false
+	TestVectorOps(t)
+	TestVectorOpsLargeInnerProduct(t)
+}
+`
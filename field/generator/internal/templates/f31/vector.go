@@ -0,0 +1,468 @@
+package f31
+
+// VectorOps are the arithmetic operations on vectors of small field elements.
+const VectorOps = `
+
+// Add adds two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	addVec(*vector, a, b)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	subVec(*vector, a, b)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	scalarMulVec(*vector, a, b)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	mulVec(*vector, a, b)
+}
+
+// Sum computes the sum of all elements in the vector.
+//
+// The elements are accumulated on 64 bits and reduced once at the end.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	// each element is < 2³¹, we can add 2³³ of them without overflow;
+	// since the elements are in Montgomery form, so is the reduced sum.
+	var acc uint64
+	for i := 0; i < len(*vector); i++ {
+		acc += uint64((*vector)[i][0])
+	}
+	res[0] = uint32(acc % uint64(q))
+	return
+}
+
+// InnerProduct computes the inner product of vector and other.
+// It panics if the vectors don't have the same length.
+//
+// The products are accumulated lazily and reduced once at the end.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	// each product is < q², and acc is kept below q·2³²; its Montgomery
+	// reduction is the Montgomery form of the inner product.
+	const qR = uint64(q) << 32
+	var acc uint64
+	for i := 0; i < len(other); i++ {
+		acc += uint64((*vector)[i][0]) * uint64(other[i][0])
+		if acc >= qR {
+			acc -= qR
+		}
+	}
+	res[0] = montReduce(acc)
+	return
+}
+
+func addVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
+
+func subVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a Vector, b *{{.ElementName}}) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
+
+func mulVecGeneric(res, a, b Vector) {
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
+`
+
+// VectorPurego dispatches the vector operations to the generic implementations.
+const VectorPurego = `
+
+func addVec(res, a, b Vector) {
+	addVecGeneric(res, a, b)
+}
+
+func subVec(res, a, b Vector) {
+	subVecGeneric(res, a, b)
+}
+
+func scalarMulVec(res, a Vector, b *{{.ElementName}}) {
+	scalarMulVecGeneric(res, a, b)
+}
+
+func mulVec(res, a, b Vector) {
+	mulVecGeneric(res, a, b)
+}
+`
+
+// VectorAmd64 dispatches the vector operations to the AVX-512 or AVX2 kernels
+// when available. The kernels process blocks of 16 (resp. 8) elements and the
+// remaining elements are processed by the generic implementation.
+const VectorAmd64 = `
+import "golang.org/x/sys/cpu"
+
+var (
+	supportAvx512 = cpu.X86.HasAVX512F
+	supportAvx2   = cpu.X86.HasAVX2
+)
+
+{{- range $op := (list "add" "sub" "mul")}}
+
+//go:noescape
+func {{$op}}VecAVX512(res, a, b *{{$.ElementName}}, n uint64)
+
+//go:noescape
+func {{$op}}VecAVX2(res, a, b *{{$.ElementName}}, n uint64)
+{{- end}}
+
+//go:noescape
+func scalarMulVecAVX512(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func scalarMulVecAVX2(res, a, b *{{.ElementName}}, n uint64)
+
+// blocks returns the number of elements processed by the vectorized kernels,
+// and the size of a block.
+func blocks(n int) (int, uint64) {
+	switch {
+	case supportAvx512:
+		return n - n%16, 16
+	case supportAvx2:
+		return n - n%8, 8
+	default:
+		return 0, 1
+	}
+}
+
+{{- range $op := (list "add" "sub" "mul")}}
+
+func {{$op}}Vec(res, a, b Vector) {
+	m, blockSize := blocks(len(a))
+	if m != 0 {
+		if supportAvx512 {
+			{{$op}}VecAVX512(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		} else {
+			{{$op}}VecAVX2(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		}
+	}
+	{{$op}}VecGeneric(res[m:], a[m:], b[m:])
+}
+{{- end}}
+
+func scalarMulVec(res, a Vector, b *{{.ElementName}}) {
+	m, blockSize := blocks(len(a))
+	if m != 0 {
+		if supportAvx512 {
+			scalarMulVecAVX512(&res[0], &a[0], b, uint64(m)/blockSize)
+		} else {
+			scalarMulVecAVX2(&res[0], &a[0], b, uint64(m)/blockSize)
+		}
+	}
+	scalarMulVecGeneric(res[m:], a[m:], b)
+}
+`
+
+// VectorAsmAmd64 contains the AVX-512 and AVX2 kernels. The elements are
+// processed as packed 32 bits lanes:
+//
+//   - additions and subtractions compute r and r ∓ q and keep the smallest
+//     (unsigned) of the two;
+//   - multiplications compute the 64 bits products of the even and odd lanes
+//     separately, and use a signed Montgomery reduction: with m = t·q⁻¹ mod 2³²,
+//     (t - m·q)/2³² = t_hi - (m·q)_hi lies in (-q, q), and q is added if
+//     it is negative.
+const VectorAsmAmd64 = `
+#include "textflag.h"
+
+{{- define "loadConstants512"}}
+	MOVL $const_q, R8
+	VPBROADCASTD R8, Z31
+	MOVL $const_qInv, R8
+	VPBROADCASTD R8, Z30
+	MOVQ $0xaaaa, R8
+	KMOVW R8, K3
+{{- end}}
+
+{{- define "loadConstants256"}}
+	MOVL $const_q, R8
+	MOVD R8, X15
+	VPBROADCASTD X15, Y15
+	MOVL $const_qInv, R8
+	MOVD R8, X14
+	VPBROADCASTD X14, Y14
+{{- end}}
+
+{{- define "mulMont512"}}
+	// Z0 = Z0 · Z1 · 2⁻³² mod q
+	VPSRLQ   $32, Z0, Z2
+	VPSRLQ   $32, Z1, Z3
+	VPMULUDQ Z0, Z1, Z4
+	VPMULUDQ Z2, Z3, Z5
+	VPMULUDQ Z4, Z30, Z6
+	VPMULUDQ Z5, Z30, Z7
+	VPMULUDQ Z6, Z31, Z6
+	VPMULUDQ Z7, Z31, Z7
+	VPSRLQ   $32, Z4, Z4
+	VPSRLQ   $32, Z6, Z6
+	VPBLENDMD Z5, Z4, K3, Z4
+	VPBLENDMD Z7, Z6, K3, Z6
+	VPSUBD   Z6, Z4, Z0
+	VPADDD   Z31, Z0, Z2
+	VPMINUD  Z2, Z0, Z0
+{{- end}}
+
+{{- define "mulMont256"}}
+	// Y0 = Y0 · Y1 · 2⁻³² mod q
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y1, Y3
+	VPMULUDQ Y0, Y1, Y4
+	VPMULUDQ Y2, Y3, Y5
+	VPMULUDQ Y4, Y14, Y6
+	VPMULUDQ Y5, Y14, Y7
+	VPMULUDQ Y6, Y15, Y6
+	VPMULUDQ Y7, Y15, Y7
+	VPSRLQ   $32, Y4, Y4
+	VPSRLQ   $32, Y6, Y6
+	VPBLENDD $0xaa, Y5, Y4, Y4
+	VPBLENDD $0xaa, Y7, Y6, Y6
+	VPSUBD   Y6, Y4, Y0
+	VPADDD   Y15, Y0, Y2
+	VPMINUD  Y2, Y0, Y0
+{{- end}}
+
+#define const_q {{.F31.Q}}
+#define const_qInv {{.F31.QInv}}
+
+// addVecAVX512(res, a, b *Element, n uint64)
+TEXT ·addVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants512"}}
+
+loop_add512:
+	TESTQ BX, BX
+	JEQ   done_add512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQU32 0(DX), Z1
+	VPADDD    Z1, Z0, Z0
+	VPSUBD    Z31, Z0, Z2
+	VPMINUD   Z2, Z0, Z0
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX
+	JMP  loop_add512
+
+done_add512:
+	VZEROUPPER
+	RET
+
+// subVecAVX512(res, a, b *Element, n uint64)
+TEXT ·subVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants512"}}
+
+loop_sub512:
+	TESTQ BX, BX
+	JEQ   done_sub512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQU32 0(DX), Z1
+	VPSUBD    Z1, Z0, Z0
+	VPADDD    Z31, Z0, Z2
+	VPMINUD   Z2, Z0, Z0
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX
+	JMP  loop_sub512
+
+done_sub512:
+	VZEROUPPER
+	RET
+
+// mulVecAVX512(res, a, b *Element, n uint64)
+TEXT ·mulVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants512"}}
+
+loop_mul512:
+	TESTQ BX, BX
+	JEQ   done_mul512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQU32 0(DX), Z1
+	{{- template "mulMont512"}}
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX
+	JMP  loop_mul512
+
+done_mul512:
+	VZEROUPPER
+	RET
+
+// scalarMulVecAVX512(res, a, b *Element, n uint64)
+TEXT ·scalarMulVecAVX512(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants512"}}
+	VPBROADCASTD 0(DX), Z29
+
+loop_scalarMul512:
+	TESTQ BX, BX
+	JEQ   done_scalarMul512
+	VMOVDQU32 0(AX), Z0
+	VMOVDQA32 Z29, Z1
+	{{- template "mulMont512"}}
+	VMOVDQU32 Z0, 0(CX)
+	ADDQ $64, AX
+	ADDQ $64, CX
+	DECQ BX
+	JMP  loop_scalarMul512
+
+done_scalarMul512:
+	VZEROUPPER
+	RET
+
+// addVecAVX2(res, a, b *Element, n uint64)
+TEXT ·addVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants256"}}
+
+loop_add256:
+	TESTQ BX, BX
+	JEQ   done_add256
+	VMOVDQU 0(AX), Y0
+	VMOVDQU 0(DX), Y1
+	VPADDD  Y1, Y0, Y0
+	VPSUBD  Y15, Y0, Y2
+	VPMINUD Y2, Y0, Y0
+	VMOVDQU Y0, 0(CX)
+	ADDQ $32, AX
+	ADDQ $32, DX
+	ADDQ $32, CX
+	DECQ BX
+	JMP  loop_add256
+
+done_add256:
+	VZEROUPPER
+	RET
+
+// subVecAVX2(res, a, b *Element, n uint64)
+TEXT ·subVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants256"}}
+
+loop_sub256:
+	TESTQ BX, BX
+	JEQ   done_sub256
+	VMOVDQU 0(AX), Y0
+	VMOVDQU 0(DX), Y1
+	VPSUBD  Y1, Y0, Y0
+	VPADDD  Y15, Y0, Y2
+	VPMINUD Y2, Y0, Y0
+	VMOVDQU Y0, 0(CX)
+	ADDQ $32, AX
+	ADDQ $32, DX
+	ADDQ $32, CX
+	DECQ BX
+	JMP  loop_sub256
+
+done_sub256:
+	VZEROUPPER
+	RET
+
+// mulVecAVX2(res, a, b *Element, n uint64)
+TEXT ·mulVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants256"}}
+
+loop_mul256:
+	TESTQ BX, BX
+	JEQ   done_mul256
+	VMOVDQU 0(AX), Y0
+	VMOVDQU 0(DX), Y1
+	{{- template "mulMont256"}}
+	VMOVDQU Y0, 0(CX)
+	ADDQ $32, AX
+	ADDQ $32, DX
+	ADDQ $32, CX
+	DECQ BX
+	JMP  loop_mul256
+
+done_mul256:
+	VZEROUPPER
+	RET
+
+// scalarMulVecAVX2(res, a, b *Element, n uint64)
+TEXT ·scalarMulVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+	{{- template "loadConstants256"}}
+	VPBROADCASTD 0(DX), Y13
+
+loop_scalarMul256:
+	TESTQ BX, BX
+	JEQ   done_scalarMul256
+	VMOVDQU 0(AX), Y0
+	VMOVDQA Y13, Y1
+	{{- template "mulMont256"}}
+	VMOVDQU Y0, 0(CX)
+	ADDQ $32, AX
+	ADDQ $32, CX
+	DECQ BX
+	JMP  loop_scalarMul256
+
+done_scalarMul256:
+	VZEROUPPER
+	RET
+`
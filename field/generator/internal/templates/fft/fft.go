@@ -0,0 +1,713 @@
+package fft
+
+// The FFT templates are executed on an fftData: the field package is imported
+// under its package name, from FieldImportPath.
+
+// Imports imports the field package.
+const Imports = `
+{{ define "import_field" }}
+	{{.PackageName}} "{{.FieldImportPath}}"
+{{ end }}
+`
+
+// Doc is the doc.go template of the fft package.
+const Doc = `
+// Package fft provides in-place discrete Fourier transform on powers-of-two subgroups
+// of {{.PackageName}}.{{.ElementName}}ˣ.
+//
+// The stages of the transform operate on contiguous slices of {{.PackageName}}.Vector, and
+// benefit from the vectorized kernels of the field package (SIMD kernels on amd64).
+package fft
+`
+
+// Options is the options.go template of the fft package.
+const Options = `
+import (
+	"runtime"
+	{{ template "import_field" . }}
+)
+
+// Option defines option for altering the behavior of FFT methods.
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type Option func(*fftConfig)
+
+type fftConfig struct {
+	coset   bool
+	nbTasks int
+}
+
+// OnCoset if provided, FFT(a) returns the evaluation of a on a coset.
+func OnCoset() Option {
+	return func(opt *fftConfig) {
+		opt.coset = true
+	}
+}
+
+// WithNbTasks sets the max number of task (go routine) to spawn. Must be between 1 and 512.
+func WithNbTasks(nbTasks int) Option {
+	if nbTasks < 1 {
+		nbTasks = 1
+	} else if nbTasks > 512 {
+		nbTasks = 512
+	}
+	return func(opt *fftConfig) {
+		opt.nbTasks = nbTasks
+	}
+}
+
+// default options
+func fftOptions(opts ...Option) fftConfig {
+	opt := fftConfig{
+		coset:   false,
+		nbTasks: runtime.NumCPU(),
+	}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
+
+// DomainOption defines option for altering the definition of the FFT domain
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type DomainOption func(*domainConfig)
+
+type domainConfig struct {
+	shift          *{{.PackageName}}.{{.ElementName}}
+	withPrecompute bool
+}
+
+// WithShift sets the FrMultiplicativeGen of the domain.
+// Default is generator of the largest 2-adic subgroup.
+func WithShift(shift {{.PackageName}}.{{.ElementName}}) DomainOption {
+	return func(opt *domainConfig) {
+		opt.shift = new({{.PackageName}}.{{.ElementName}}).Set(&shift)
+	}
+}
+
+// WithoutPrecompute disables precomputation of twiddles in the domain.
+// When this option is set, FFTs will be slower, but will use less memory.
+func WithoutPrecompute() DomainOption {
+	return func(opt *domainConfig) {
+		opt.withPrecompute = false
+	}
+}
+
+// default options
+func domainOptions(opts ...DomainOption) domainConfig {
+	opt := domainConfig{
+		withPrecompute: true,
+	}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
+`
+
+// Domain is the domain.go template of the fft package.
+const Domain = `
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	{{ template "import_field" . }}
+)
+
+// Domain with a power of 2 cardinality
+type Domain struct {
+	Cardinality            uint64
+	CardinalityInv         {{.PackageName}}.{{.ElementName}}
+	Generator              {{.PackageName}}.{{.ElementName}}
+	GeneratorInv           {{.PackageName}}.{{.ElementName}}
+	FrMultiplicativeGen    {{.PackageName}}.{{.ElementName}} // generator of Fr*
+	FrMultiplicativeGenInv {{.PackageName}}.{{.ElementName}}
+
+	// the following slices are not serialized and are (re)computed through domain.preComputeTwiddles()
+
+	// twiddles[s] contains the twiddle factors of the stage s of the DIF transform
+	// (the stages of the DIT transform use the same tables in reverse order):
+	// twiddles[s][j] = Generator^(j·2ˢ), for j < Cardinality/2^(s+1)
+	twiddles [][]{{.PackageName}}.{{.ElementName}}
+
+	// twiddlesInv contains the twiddle factors of the inverse transform (using GeneratorInv)
+	twiddlesInv [][]{{.PackageName}}.{{.ElementName}}
+
+	// cosetTable[i] = FrMultiplicativeGen^i and cosetTableInv[i] = FrMultiplicativeGenInv^i
+	cosetTable    []{{.PackageName}}.{{.ElementName}}
+	cosetTableInv []{{.PackageName}}.{{.ElementName}}
+
+	withPrecompute bool
+}
+
+const (
+	maxOrderRoot = {{.FFT.TwoAdicity}}
+
+	{{- if .F31}}
+
+	// multiplicativeGen generates {{.PackageName}}.{{.ElementName}}ˣ
+	{{- else}}
+
+	// multiplicativeGen is a non-square whose order is not a power of 2: it is
+	// the default shift of the cosets, which are disjoint from the domains
+	{{- end}}
+	multiplicativeGen = {{.FFT.MultiplicativeGen}}
+)
+
+// rootOfUnity is a root of unity of order 2^maxOrderRoot
+var rootOfUnity = {{.PackageName}}.{{.ElementName}}{ {{- range $i, $w := .FFT.RootOfUnity}}{{if $i}}, {{end}}{{$w}}{{end -}} }
+
+{{- if .F31}}
+
+// GeneratorFullMultiplicativeGroup returns a generator of {{.PackageName}}.{{.ElementName}}ˣ
+{{- else}}
+
+// GeneratorFullMultiplicativeGroup returns the default shift of the cosets
+{{- end}}
+func GeneratorFullMultiplicativeGroup() {{.PackageName}}.{{.ElementName}} {
+	var res {{.PackageName}}.{{.ElementName}}
+	res.SetUint64(multiplicativeGen)
+	return res
+}
+
+// NewDomain returns a subgroup with a power of 2 cardinality
+// cardinality >= m
+// shift: when specified, it's the element by which the set of root of unity is shifted.
+func NewDomain(m uint64, opts ...DomainOption) *Domain {
+	opt := domainOptions(opts...)
+	domain := &Domain{}
+	x := nextPowerOfTwo(m)
+	domain.Cardinality = x
+	domain.FrMultiplicativeGen = GeneratorFullMultiplicativeGroup()
+	if opt.shift != nil {
+		domain.FrMultiplicativeGen.Set(opt.shift)
+	}
+	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
+
+	var err error
+	domain.Generator, err = Generator(m)
+	if err != nil {
+		panic(err)
+	}
+	domain.GeneratorInv.Inverse(&domain.Generator)
+	domain.CardinalityInv.SetUint64(x).Inverse(&domain.CardinalityInv)
+
+	domain.withPrecompute = opt.withPrecompute
+	if domain.withPrecompute {
+		domain.preComputeTwiddles()
+	}
+
+	return domain
+}
+
+// Generator returns a generator for Z/2^(log(m))Z
+// or an error if m is too big (required root of unity doesn't exist)
+func Generator(m uint64) ({{.PackageName}}.{{.ElementName}}, error) {
+	x := nextPowerOfTwo(m)
+	logx := uint64(bits.TrailingZeros64(x))
+	if logx > maxOrderRoot {
+		return {{.PackageName}}.{{.ElementName}}{}, errors.New("m is too big: the required root of unity does not exist")
+	}
+
+	generator := rootOfUnity
+	for i := logx; i < maxOrderRoot; i++ {
+		generator.Square(&generator)
+	}
+	return generator, nil
+}
+
+// Twiddles returns the twiddles factor for the FFT using Generator for each stage of the FFT
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) Twiddles() ([][]{{.PackageName}}.{{.ElementName}}, error) {
+	if d.twiddles == nil {
+		return nil, errors.New("twiddles not precomputed")
+	}
+	return d.twiddles, nil
+}
+
+// TwiddlesInv returns the twiddles factor for the FFT using GeneratorInv for each stage of the FFT
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) TwiddlesInv() ([][]{{.PackageName}}.{{.ElementName}}, error) {
+	if d.twiddlesInv == nil {
+		return nil, errors.New("twiddles not precomputed")
+	}
+	return d.twiddlesInv, nil
+}
+
+// CosetTable returns the cosetTable u*<1,g,..,g^(n-1)>
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) CosetTable() ([]{{.PackageName}}.{{.ElementName}}, error) {
+	if d.cosetTable == nil {
+		return nil, errors.New("cosetTable not precomputed")
+	}
+	return d.cosetTable, nil
+}
+
+// CosetTableInv returns the cosetTableInv u*<1,g,..,g^(n-1)>
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *Domain) CosetTableInv() ([]{{.PackageName}}.{{.ElementName}}, error) {
+	if d.cosetTableInv == nil {
+		return nil, errors.New("cosetTableInv not precomputed")
+	}
+	return d.cosetTableInv, nil
+}
+
+func (d *Domain) preComputeTwiddles() {
+	d.twiddles = buildTwiddles(d.Generator, d.Cardinality)
+	d.twiddlesInv = buildTwiddles(d.GeneratorInv, d.Cardinality)
+
+	d.cosetTable = make([]{{.PackageName}}.{{.ElementName}}, d.Cardinality)
+	d.cosetTableInv = make([]{{.PackageName}}.{{.ElementName}}, d.Cardinality)
+	BuildExpTable(d.FrMultiplicativeGen, d.cosetTable)
+	BuildExpTable(d.FrMultiplicativeGenInv, d.cosetTableInv)
+}
+
+// buildTwiddles returns the twiddle factors of each stage of a transform of size n
+// using the root of unity omega. The table of a stage is contiguous, such that
+// the butterflies of a stage can be computed with the vector operations of the
+// field package.
+func buildTwiddles(omega {{.PackageName}}.{{.ElementName}}, n uint64) [][]{{.PackageName}}.{{.ElementName}} {
+	nbStages := bits.TrailingZeros64(n)
+	t := make([][]{{.PackageName}}.{{.ElementName}}, nbStages)
+	if nbStages == 0 {
+		return t
+	}
+	t[0] = make([]{{.PackageName}}.{{.ElementName}}, n/2)
+	BuildExpTable(omega, t[0])
+	for s := 1; s < nbStages; s++ {
+		t[s] = make([]{{.PackageName}}.{{.ElementName}}, len(t[s-1])/2)
+		for j := range t[s] {
+			t[s][j] = t[s-1][2*j]
+		}
+	}
+	return t
+}
+
+// BuildExpTable precomputes the first n powers of w in parallel
+// table[0] = w^0
+// table[1] = w^1
+// ...
+func BuildExpTable(w {{.PackageName}}.{{.ElementName}}, table []{{.PackageName}}.{{.ElementName}}) {
+	execute(len(table), func(start, end int) {
+		table[start].Exp(w, new(big.Int).SetUint64(uint64(start)))
+		for i := start + 1; i < end; i++ {
+			table[i].Mul(&table[i-1], &w)
+		}
+	})
+}
+
+// nextPowerOfTwo returns the next power of 2 of n
+func nextPowerOfTwo(n uint64) uint64 {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << (64 - bits.LeadingZeros64(n-1))
+}
+`
+
+// FFT is the fft.go template of the fft package.
+const FFT = `
+import (
+	"math/bits"
+	{{ template "import_field" . }}
+)
+
+// Decimation is used in the FFT call to select decimation in time or in frequency
+type Decimation uint8
+
+const (
+	DIT Decimation = iota
+	DIF
+)
+
+// vectorThreshold is the half size of the blocks from which the butterflies of a
+// stage are computed with the vector operations of the field package.
+const vectorThreshold = 16
+
+// FFT computes the discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+func (domain *Domain) FFT(a []{{.PackageName}}.{{.ElementName}}, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+
+	if opt.coset {
+		cosetTable := domain.cosetTable
+		if !domain.withPrecompute {
+			cosetTable = make([]{{.PackageName}}.{{.ElementName}}, len(a))
+			BuildExpTable(domain.FrMultiplicativeGen, cosetTable)
+		}
+		scale(a, cosetTable, decimation == DIT, opt.nbTasks)
+	}
+
+	twiddles := domain.twiddles
+	if !domain.withPrecompute {
+		twiddles = buildTwiddles(domain.Generator, uint64(len(a)))
+	}
+
+	switch decimation {
+	case DIF:
+		difFFT(a, twiddles, opt.nbTasks)
+	case DIT:
+		ditFFT(a, twiddles, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverse computes (recursively) the inverse discrete Fourier transform of a and stores the result in a
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// coset sets the shift of the fft (0 = no shift, standard fft)
+// len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
+func (domain *Domain) FFTInverse(a []{{.PackageName}}.{{.ElementName}}, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+
+	twiddlesInv := domain.twiddlesInv
+	if !domain.withPrecompute {
+		twiddlesInv = buildTwiddles(domain.GeneratorInv, uint64(len(a)))
+	}
+
+	switch decimation {
+	case DIF:
+		difFFT(a, twiddlesInv, opt.nbTasks)
+	case DIT:
+		ditFFT(a, twiddlesInv, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+
+	res := {{.PackageName}}.Vector(a)
+	if !opt.coset {
+		res.ScalarMul(a, &domain.CardinalityInv)
+		return
+	}
+
+	cosetTableInv := domain.cosetTableInv
+	if !domain.withPrecompute {
+		cosetTableInv = make([]{{.PackageName}}.{{.ElementName}}, len(a))
+		BuildExpTable(domain.FrMultiplicativeGenInv, cosetTableInv)
+	}
+	// the output of the DIF transform is in bit reversed order
+	scale(a, cosetTableInv, decimation == DIF, opt.nbTasks)
+	res.ScalarMul(a, &domain.CardinalityInv)
+}
+
+// scale sets a[i] = a[i]·table[i], or a[i] = a[i]·table[bitReverse(i)] if bitReversed is set.
+func scale(a, table []{{.PackageName}}.{{.ElementName}}, bitReversed bool, nbTasks int) {
+	if !bitReversed {
+		execute(len(a), func(start, end int) {
+			res := {{.PackageName}}.Vector(a[start:end])
+			res.Mul(a[start:end], table[start:end])
+		}, nbTasks)
+		return
+	}
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+	execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			iRev := bits.Reverse64(uint64(i)) >> nn
+			a[i].Mul(&a[i], &table[iRev])
+		}
+	}, nbTasks)
+}
+
+// difFFT is the decimation in frequency transform; the input is in natural order
+// and the output in bit reversed order.
+func difFFT(a []{{.PackageName}}.{{.ElementName}}, twiddles [][]{{.PackageName}}.{{.ElementName}}, nbTasks int) {
+	for s := 0; s < len(twiddles); s++ {
+		stage(a, twiddles[s], nbTasks, difButterflies)
+	}
+}
+
+// ditFFT is the decimation in time transform; the input is in bit reversed order
+// and the output in natural order.
+func ditFFT(a []{{.PackageName}}.{{.ElementName}}, twiddles [][]{{.PackageName}}.{{.ElementName}}, nbTasks int) {
+	for s := len(twiddles) - 1; s >= 0; s-- {
+		stage(a, twiddles[s], nbTasks, ditButterflies)
+	}
+}
+
+// stage applies the butterflies of a stage whose blocks have half size m = len(tw);
+// the n/2 butterflies are split among the tasks.
+func stage(a, tw []{{.PackageName}}.{{.ElementName}}, nbTasks int, butterflies func(u, v, tw, tmp []{{.PackageName}}.{{.ElementName}})) {
+	m := len(tw)
+	execute(len(a)/2, func(start, end int) {
+		var tmp []{{.PackageName}}.{{.ElementName}}
+		for start < end {
+			// butterfly j of the stage is in the block j/m, at offset j%m
+			block, offset := start/m, start%m
+			last := offset + end - start
+			if last > m {
+				last = m
+			}
+			if tmp == nil || len(tmp) < last-offset {
+				tmp = make([]{{.PackageName}}.{{.ElementName}}, last-offset)
+			}
+			u := a[2*block*m : (2*block+1)*m]
+			v := a[(2*block+1)*m : (2*block+2)*m]
+			butterflies(u[offset:last], v[offset:last], tw[offset:last], tmp[:last-offset])
+			start += last - offset
+		}
+	}, nbTasks)
+}
+
+// difButterflies sets (u, v) = (u + v, (u - v)·tw)
+func difButterflies(u, v, tw, tmp []{{.PackageName}}.{{.ElementName}}) {
+	if len(u) < vectorThreshold {
+		for j := range u {
+			{{.PackageName}}.Butterfly(&u[j], &v[j])
+			v[j].Mul(&v[j], &tw[j])
+		}
+		return
+	}
+	t, uu, vv := {{.PackageName}}.Vector(tmp), {{.PackageName}}.Vector(u), {{.PackageName}}.Vector(v)
+	t.Sub(u, v)
+	uu.Add(u, v)
+	vv.Mul(t, tw)
+}
+
+// ditButterflies sets (u, v) = (u + v·tw, u - v·tw)
+func ditButterflies(u, v, tw, tmp []{{.PackageName}}.{{.ElementName}}) {
+	if len(u) < vectorThreshold {
+		for j := range u {
+			v[j].Mul(&v[j], &tw[j])
+			{{.PackageName}}.Butterfly(&u[j], &v[j])
+		}
+		return
+	}
+	t, uu, vv := {{.PackageName}}.Vector(tmp), {{.PackageName}}.Vector(u), {{.PackageName}}.Vector(v)
+	t.Mul(v, tw)
+	vv.Sub(u, t)
+	uu.Add(u, t)
+}
+`
+
+// BitReverse is the bitreverse.go template of the fft package.
+const BitReverse = `
+import (
+	"math/bits"
+	{{ template "import_field" . }}
+)
+
+// BitReverse applies the bit-reversal permutation to v.
+// len(v) must be a power of 2
+func BitReverse(v []{{.PackageName}}.{{.ElementName}}) {
+	n := uint64(len(v))
+	if n <= 1 {
+		return
+	}
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		iRev := bits.Reverse64(i) >> nn
+		if iRev > i {
+			v[i], v[iRev] = v[iRev], v[i]
+		}
+	}
+}
+`
+
+// Parallel is the parallel.go template of the fft package.
+const Parallel = `
+import (
+	"runtime"
+	"sync"
+)
+
+// execute executes the work function in parallel.
+// this is copy paste from internal/parallel/parallel.go
+// as we don't want to generate code importing internal/
+func execute(nbIterations int, work func(int, int), maxCpus ...int) {
+
+	nbTasks := runtime.NumCPU()
+	if len(maxCpus) == 1 {
+		nbTasks = maxCpus[0]
+		if nbTasks < 1 {
+			nbTasks = 1
+		} else if nbTasks > 512 {
+			nbTasks = 512
+		}
+	}
+
+	if nbTasks == 1 {
+		// no go routines
+		work(0, nbIterations)
+		return
+	}
+
+	nbIterationsPerCpus := nbIterations / nbTasks
+
+	// more CPUs than tasks: a CPU will work on exactly one iteration
+	if nbIterationsPerCpus < 1 {
+		nbIterationsPerCpus = 1
+		nbTasks = nbIterations
+	}
+
+	var wg sync.WaitGroup
+
+	extraTasks := nbIterations - (nbTasks * nbIterationsPerCpus)
+	extraTasksOffset := 0
+
+	for i := 0; i < nbTasks; i++ {
+		wg.Add(1)
+		_start := i*nbIterationsPerCpus + extraTasksOffset
+		_end := _start + nbIterationsPerCpus
+		if extraTasks > 0 {
+			_end++
+			extraTasks--
+			extraTasksOffset++
+		}
+		go func() {
+			work(_start, _end)
+			wg.Done()
+		}()
+	}
+
+	wg.Wait()
+}
+`
+
+// Test is the fft_test.go template of the fft package.
+const Test = `
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	{{ template "import_field" . }}
+)
+
+func randomVector(n int) []{{.PackageName}}.{{.ElementName}} {
+	v := make([]{{.PackageName}}.{{.ElementName}}, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+// evaluate returns p(x) where p is given by its coefficients
+func evaluate(p []{{.PackageName}}.{{.ElementName}}, x {{.PackageName}}.{{.ElementName}}) {{.PackageName}}.{{.ElementName}} {
+	var res {{.PackageName}}.{{.ElementName}}
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &x).Add(&res, &p[i])
+	}
+	return res
+}
+
+func TestFFT(t *testing.T) {
+	for _, n := range []uint64{1, 2, 4, 32, 64, 256} {
+		for _, precompute := range []bool{true, false} {
+			var opts []DomainOption
+			if !precompute {
+				opts = append(opts, WithoutPrecompute())
+			}
+			domain := NewDomain(n, opts...)
+			name := fmt.Sprintf("n=%d/precompute=%v", n, precompute)
+
+			t.Run(name, func(t *testing.T) {
+				p := randomVector(int(n))
+
+				for _, coset := range []bool{false, true} {
+					var fftOpts []Option
+					shift := {{.PackageName}}.One()
+					if coset {
+						fftOpts = append(fftOpts, OnCoset())
+						shift = domain.FrMultiplicativeGen
+					}
+
+					// expected evaluations in natural order
+					expected := make([]{{.PackageName}}.{{.ElementName}}, n)
+					x := shift
+					for i := range expected {
+						expected[i] = evaluate(p, x)
+						x.Mul(&x, &domain.Generator)
+					}
+
+					// DIF: natural -> bit reversed
+					v := append([]{{.PackageName}}.{{.ElementName}}{}, p...)
+					domain.FFT(v, DIF, fftOpts...)
+					BitReverse(v)
+					checkEqual(t, "FFT DIF", v, expected)
+
+					// DIT: bit reversed -> natural
+					v = append([]{{.PackageName}}.{{.ElementName}}{}, p...)
+					BitReverse(v)
+					domain.FFT(v, DIT, fftOpts...)
+					checkEqual(t, "FFT DIT", v, expected)
+
+					// inverse transforms
+					v = append([]{{.PackageName}}.{{.ElementName}}{}, expected...)
+					domain.FFTInverse(v, DIF, fftOpts...)
+					BitReverse(v)
+					checkEqual(t, "FFTInverse DIF", v, p)
+
+					v = append([]{{.PackageName}}.{{.ElementName}}{}, expected...)
+					BitReverse(v)
+					domain.FFTInverse(v, DIT, fftOpts...)
+					checkEqual(t, "FFTInverse DIT", v, p)
+
+					// single task
+					v = append([]{{.PackageName}}.{{.ElementName}}{}, p...)
+					domain.FFT(v, DIF, append(fftOpts, WithNbTasks(1))...)
+					domain.FFTInverse(v, DIT, append(fftOpts, WithNbTasks(1))...)
+					checkEqual(t, "FFT round trip", v, p)
+				}
+			})
+		}
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	var order big.Int
+	order.SetUint64(1 << maxOrderRoot)
+	g, err := Generator(1 << maxOrderRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var x {{.PackageName}}.{{.ElementName}}
+	x.Exp(g, &order)
+	if !x.IsOne() {
+		t.Fatal("generator has not the expected order")
+	}
+	order.Rsh(&order, 1)
+	x.Exp(g, &order)
+	if x.IsOne() {
+		t.Fatal("generator has not the expected order")
+	}
+
+	if _, err := Generator(1 << (maxOrderRoot + 1)); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func checkEqual(t *testing.T, name string, got, expected []{{.PackageName}}.{{.ElementName}}) {
+	t.Helper()
+	for i := range expected {
+		if !got[i].Equal(&expected[i]) {
+			t.Fatalf("%s: mismatch at index %d", name, i)
+		}
+	}
+}
+
+func BenchmarkFFT(b *testing.B) {
+	for _, logn := range []int{10, 16, 20} {
+		if logn > maxOrderRoot {
+			continue
+		}
+		n := uint64(1) << logn
+		domain := NewDomain(n)
+		v := randomVector(int(n))
+		b.Run(fmt.Sprintf("DIF/2^%d", logn), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				domain.FFT(v, DIF)
+			}
+		})
+		b.Run(fmt.Sprintf("DIT/2^%d", logn), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				domain.FFT(v, DIT)
+			}
+		})
+	}
+}
+`
@@ -0,0 +1,103 @@
+package htf
+
+// The hash_to_field templates are executed on an htfData: the field package is
+// imported under its package name, from FieldImportPath.
+
+// Doc is the doc.go template of the hash_to_field package.
+const Doc = `
+// Package hash_to_field provides a hasher based on RFC 9380 Section 5.
+//
+// The [RFC 9380] defines a method for hashing bytes to elliptic curves. Section
+// 5 of the RFC describes a method for uniformly hashing bytes into a field
+// using a domain separation. The hashing is implemented in [{{.PackageName}}], but this
+// package provides a wrapper for the method which implements [hash.Hash] for
+// using the method recursively.
+//
+// [RFC 9380]: https://datatracker.ietf.org/doc/html/rfc9380
+package hash_to_field
+
+import (
+	_ "hash"
+
+	_ "{{.FieldImportPath}}"
+)
+`
+
+// HashToField is the hash_to_field.go template of the hash_to_field package.
+const HashToField = `
+import (
+	"fmt"
+	"hash"
+
+	"{{.FieldImportPath}}"
+)
+
+type wrappedHashToField struct {
+	domain []byte
+	toHash []byte
+}
+
+// New returns a new hasher instance which uses [{{.PackageName}}.Hash] to hash all the
+// written bytes to a field element, returning the byte representation of the
+// field element. The domain separator is passed as-is to hashing method.
+func New(domainSeparator []byte) hash.Hash {
+	return &wrappedHashToField{
+		domain: append([]byte{}, domainSeparator...), // copy in case the argument is modified
+	}
+}
+
+func (w *wrappedHashToField) Write(p []byte) (n int, err error) {
+	w.toHash = append(w.toHash, p...)
+	return len(p), nil
+}
+
+func (w *wrappedHashToField) Sum(b []byte) []byte {
+	res, err := {{.PackageName}}.Hash(w.toHash, w.domain, 1)
+	if err != nil {
+		// we want to follow the interface, cannot return error and have to panic
+		// but by default the method shouldn't return an error internally
+		panic(fmt.Sprintf("native field to hash: %v", err))
+	}
+	bts := res[0].Bytes()
+	return append(b, bts[:]...)
+}
+
+func (w *wrappedHashToField) Reset() {
+	w.toHash = nil
+}
+
+func (w *wrappedHashToField) Size() int {
+	return {{.PackageName}}.Bytes
+}
+
+func (w *wrappedHashToField) BlockSize() int {
+	return {{.PackageName}}.Bytes
+}
+`
+
+// Test is the hash_to_field_test.go template of the hash_to_field package.
+const Test = `
+import (
+	"testing"
+
+	"{{.FieldImportPath}}"
+)
+
+func TestHashInterface(t *testing.T) {
+	msg := []byte("test")
+	sep := []byte("separator")
+	res, err := {{.PackageName}}.Hash(msg, sep, 1)
+	if err != nil {
+		t.Fatal("hash to field", err)
+	}
+
+	htfFn := New(sep)
+	htfFn.Write(msg)
+	bts := htfFn.Sum(nil)
+	var res2 {{.PackageName}}.{{.ElementName}}
+	res2.SetBytes(bts[:{{.PackageName}}.Bytes])
+	if !res[0].Equal(&res2) {
+		t.Error("not equal")
+	}
+}
+`
@@ -0,0 +1,610 @@
+package tower
+
+// The extension templates are executed on a towerData; the cubic extension
+// templates are executed on a cubicData, once for E3 (over the base field) and
+// once for E6 (over E2).
+
+// E2 is the template of the quadratic extension.
+const E2 = `
+import (
+	"math/big"
+)
+
+// E2 is a degree two extension of {{.ElementName}}:
+//
+//	E2 = {{.ElementName}}[u]/(u² - ({{.Tower.E2NonResidue}}))
+type E2 struct {
+	A0, A1 {{.ElementName}}
+}
+
+{{- if ne .Tower.E2NonResidue -1}}
+
+// e2NonResidue = {{.Tower.E2NonResidue}} (Montgomery form)
+var e2NonResidue = {{.ElementName}}{ {{- range $i, $w := .Tower.E2NonResidueMont}}{{if $i}}, {{end}}{{$w}}{{end -}} }
+{{- end}}
+
+// mulByE2NonResidue sets z = ({{.Tower.E2NonResidue}})·x
+func mulByE2NonResidue(z, x *{{.ElementName}}) {
+	{{- if eq .Tower.E2NonResidue -1}}
+	z.Neg(x)
+	{{- else}}
+	z.Mul(x, &e2NonResidue)
+	{{- end}}
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// Set sets z to x and returns z
+func (z *E2) Set(x *E2) *E2 {
+	*z = *x
+	return z
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E2) SetZero() *E2 {
+	*z = E2{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E2) SetOne() *E2 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E2) SetRandom() (*E2, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Conjugate sets z = x₀ - x₁u and returns z
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	var a, b, c {{.ElementName}}
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	mulByE2NonResidue(&c, &c)
+	z.A0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E2) Square(x *E2) *E2 {
+	var a, b {{.ElementName}}
+	a.Square(&x.A0)
+	b.Square(&x.A1)
+	mulByE2NonResidue(&b, &b)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y, y in {{.ElementName}}, and returns z
+func (z *E2) MulByElement(x *E2, y *{{.ElementName}}) *E2 {
+	z.A0.Mul(&x.A0, y)
+	z.A1.Mul(&x.A1, y)
+	return z
+}
+
+// Norm returns x₀² - ({{.Tower.E2NonResidue}})·x₁²
+func (z *E2) Norm() {{.ElementName}} {
+	var a, b {{.ElementName}}
+	a.Square(&z.A0)
+	b.Square(&z.A1)
+	mulByE2NonResidue(&b, &b)
+	return *a.Sub(&a, &b)
+}
+
+// Inverse sets z = x⁻¹ and returns z; if x is 0, z is set to 0
+func (z *E2) Inverse(x *E2) *E2 {
+	n := x.Norm()
+	n.Inverse(&n)
+	z.Conjugate(x)
+	return z.MulByElement(z, &n)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.Sign() == -1 {
+		x.Inverse(&x)
+		k = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if k.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// String returns the string representation of z
+func (z *E2) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+}
+`
+
+// Cubic is the template of the cubic extensions E3 and E6.
+const Cubic = `
+import (
+	"math/big"
+)
+
+{{- if eq .Name "E3"}}
+
+// E3 is a degree three extension of {{.ElementName}}:
+//
+//	E3 = {{.ElementName}}[v]/(v³ - ({{.Tower.E3NonResidue}}))
+{{- else}}
+
+// E6 is a degree three extension of E2, and a degree six extension of {{.ElementName}}:
+//
+//	E6 = E2[v]/(v³ - ({{index .Tower.E6NonResidue 0}}+{{index .Tower.E6NonResidue 1}}u))
+{{- end}}
+type {{.Name}} struct {
+	{{.Coord}}0, {{.Coord}}1, {{.Coord}}2 {{.Base}}
+}
+
+{{- if eq .Name "E3"}}
+
+// e3NonResidue = {{.Tower.E3NonResidue}} (Montgomery form)
+var e3NonResidue = {{.ElementName}}{ {{- range $i, $w := .Tower.E3NonResidueMont}}{{if $i}}, {{end}}{{$w}}{{end -}} }
+
+// mulByE3NonResidue sets z = ({{.Tower.E3NonResidue}})·x
+func mulByE3NonResidue(z, x *{{.ElementName}}) {
+	z.Mul(x, &e3NonResidue)
+}
+{{- else}}
+
+// e6NonResidue = {{index .Tower.E6NonResidue 0}}+{{index .Tower.E6NonResidue 1}}u (Montgomery form)
+var e6NonResidue = E2{
+	A0: {{.ElementName}}{ {{- range $i, $w := index .Tower.E6NonResidueMont 0}}{{if $i}}, {{end}}{{$w}}{{end -}} },
+	A1: {{.ElementName}}{ {{- range $i, $w := index .Tower.E6NonResidueMont 1}}{{if $i}}, {{end}}{{$w}}{{end -}} },
+}
+
+// mulByE6NonResidue sets z = ({{index .Tower.E6NonResidue 0}}+{{index .Tower.E6NonResidue 1}}u)·x
+func mulByE6NonResidue(z, x *E2) {
+	z.Mul(x, &e6NonResidue)
+}
+{{- end}}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{.Name}}) Equal(x *{{.Name}}) bool {
+	return z.{{.Coord}}0.Equal(&x.{{.Coord}}0) && z.{{.Coord}}1.Equal(&x.{{.Coord}}1) && z.{{.Coord}}2.Equal(&x.{{.Coord}}2)
+}
+
+// Set sets z to x and returns z
+func (z *{{.Name}}) Set(x *{{.Name}}) *{{.Name}} {
+	*z = *x
+	return z
+}
+
+// SetZero sets z to 0 and returns z
+func (z *{{.Name}}) SetZero() *{{.Name}} {
+	*z = {{.Name}}{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *{{.Name}}) SetOne() *{{.Name}} {
+	z.{{.Coord}}0.SetOne()
+	z.{{.Coord}}1.SetZero()
+	z.{{.Coord}}2.SetZero()
+	return z
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *{{.Name}}) IsZero() bool {
+	return z.{{.Coord}}0.IsZero() && z.{{.Coord}}1.IsZero() && z.{{.Coord}}2.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *{{.Name}}) IsOne() bool {
+	return z.{{.Coord}}0.IsOne() && z.{{.Coord}}1.IsZero() && z.{{.Coord}}2.IsZero()
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *{{.Name}}) SetRandom() (*{{.Name}}, error) {
+	if _, err := z.{{.Coord}}0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.{{.Coord}}1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.{{.Coord}}2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *{{.Name}}) Add(x, y *{{.Name}}) *{{.Name}} {
+	z.{{.Coord}}0.Add(&x.{{.Coord}}0, &y.{{.Coord}}0)
+	z.{{.Coord}}1.Add(&x.{{.Coord}}1, &y.{{.Coord}}1)
+	z.{{.Coord}}2.Add(&x.{{.Coord}}2, &y.{{.Coord}}2)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *{{.Name}}) Sub(x, y *{{.Name}}) *{{.Name}} {
+	z.{{.Coord}}0.Sub(&x.{{.Coord}}0, &y.{{.Coord}}0)
+	z.{{.Coord}}1.Sub(&x.{{.Coord}}1, &y.{{.Coord}}1)
+	z.{{.Coord}}2.Sub(&x.{{.Coord}}2, &y.{{.Coord}}2)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *{{.Name}}) Double(x *{{.Name}}) *{{.Name}} {
+	z.{{.Coord}}0.Double(&x.{{.Coord}}0)
+	z.{{.Coord}}1.Double(&x.{{.Coord}}1)
+	z.{{.Coord}}2.Double(&x.{{.Coord}}2)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *{{.Name}}) Neg(x *{{.Name}}) *{{.Name}} {
+	z.{{.Coord}}0.Neg(&x.{{.Coord}}0)
+	z.{{.Coord}}1.Neg(&x.{{.Coord}}1)
+	z.{{.Coord}}2.Neg(&x.{{.Coord}}2)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *{{.Name}}) Mul(x, y *{{.Name}}) *{{.Name}} {
+	// Algorithm 13 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, c0, c1, c2, tmp {{.Base}}
+	t0.Mul(&x.{{.Coord}}0, &y.{{.Coord}}0)
+	t1.Mul(&x.{{.Coord}}1, &y.{{.Coord}}1)
+	t2.Mul(&x.{{.Coord}}2, &y.{{.Coord}}2)
+
+	// c₀ = t₀ + ξ((x₁+x₂)(y₁+y₂) - t₁ - t₂)
+	c0.Add(&x.{{.Coord}}1, &x.{{.Coord}}2)
+	tmp.Add(&y.{{.Coord}}1, &y.{{.Coord}}2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2)
+	mulBy{{.Name}}NonResidue(&c0, &c0)
+	c0.Add(&c0, &t0)
+
+	// c₁ = (x₀+x₁)(y₀+y₁) - t₀ - t₁ + ξt₂
+	c1.Add(&x.{{.Coord}}0, &x.{{.Coord}}1)
+	tmp.Add(&y.{{.Coord}}0, &y.{{.Coord}}1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	mulBy{{.Name}}NonResidue(&tmp, &t2)
+	c1.Add(&c1, &tmp)
+
+	// c₂ = (x₀+x₂)(y₀+y₂) - t₀ - t₂ + t₁
+	c2.Add(&x.{{.Coord}}0, &x.{{.Coord}}2)
+	tmp.Add(&y.{{.Coord}}0, &y.{{.Coord}}2)
+	c2.Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2).Add(&c2, &t1)
+
+	z.{{.Coord}}0 = c0
+	z.{{.Coord}}1 = c1
+	z.{{.Coord}}2 = c2
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *{{.Name}}) Square(x *{{.Name}}) *{{.Name}} {
+	// Algorithm 16 from https://eprint.iacr.org/2010/354.pdf
+	var c4, c5, c1, c2, c3, c0 {{.Base}}
+	c4.Mul(&x.{{.Coord}}0, &x.{{.Coord}}1).Double(&c4)
+	c5.Square(&x.{{.Coord}}2)
+	mulBy{{.Name}}NonResidue(&c1, &c5)
+	c1.Add(&c1, &c4)
+	c2.Sub(&c4, &c5)
+	c3.Square(&x.{{.Coord}}0)
+	c4.Sub(&x.{{.Coord}}0, &x.{{.Coord}}1).Add(&c4, &x.{{.Coord}}2)
+	c5.Mul(&x.{{.Coord}}1, &x.{{.Coord}}2).Double(&c5)
+	c4.Square(&c4)
+	mulBy{{.Name}}NonResidue(&c0, &c5)
+	c0.Add(&c0, &c3)
+	z.{{.Coord}}2.Add(&c2, &c4).Add(&z.{{.Coord}}2, &c5).Sub(&z.{{.Coord}}2, &c3)
+	z.{{.Coord}}0 = c0
+	z.{{.Coord}}1 = c1
+	return z
+}
+
+// MulBy{{.BaseName}} sets z = x·y, y in {{.Base}}, and returns z
+func (z *{{.Name}}) MulBy{{.BaseName}}(x *{{.Name}}, y *{{.Base}}) *{{.Name}} {
+	z.{{.Coord}}0.Mul(&x.{{.Coord}}0, y)
+	z.{{.Coord}}1.Mul(&x.{{.Coord}}1, y)
+	z.{{.Coord}}2.Mul(&x.{{.Coord}}2, y)
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; if x is 0, z is set to 0
+func (z *{{.Name}}) Inverse(x *{{.Name}}) *{{.Name}} {
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, t3, t4, t5, t6, c0, c1, c2, d1, d2 {{.Base}}
+	t0.Square(&x.{{.Coord}}0)
+	t1.Square(&x.{{.Coord}}1)
+	t2.Square(&x.{{.Coord}}2)
+	t3.Mul(&x.{{.Coord}}0, &x.{{.Coord}}1)
+	t4.Mul(&x.{{.Coord}}0, &x.{{.Coord}}2)
+	t5.Mul(&x.{{.Coord}}1, &x.{{.Coord}}2)
+
+	// c₀ = x₀² - ξx₁x₂, c₁ = ξx₂² - x₀x₁, c₂ = x₁² - x₀x₂
+	mulBy{{.Name}}NonResidue(&c0, &t5)
+	c0.Sub(&t0, &c0)
+	mulBy{{.Name}}NonResidue(&c1, &t2)
+	c1.Sub(&c1, &t3)
+	c2.Sub(&t1, &t4)
+
+	// t₆ = x₀c₀ + ξ(x₂c₁ + x₁c₂) is the norm of x
+	t6.Mul(&x.{{.Coord}}0, &c0)
+	d1.Mul(&x.{{.Coord}}2, &c1)
+	d2.Mul(&x.{{.Coord}}1, &c2)
+	d1.Add(&d1, &d2)
+	mulBy{{.Name}}NonResidue(&d1, &d1)
+	t6.Add(&t6, &d1)
+	t6.Inverse(&t6)
+
+	z.{{.Coord}}0.Mul(&c0, &t6)
+	z.{{.Coord}}1.Mul(&c1, &t6)
+	z.{{.Coord}}2.Mul(&c2, &t6)
+	return z
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *{{.Name}}) Exp(x {{.Name}}, k *big.Int) *{{.Name}} {
+	if k.Sign() == -1 {
+		x.Inverse(&x)
+		k = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if k.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// String returns the string representation of z
+func (z *{{.Name}}) String() string {
+	return "(" + z.{{.Coord}}0.String() + ")+(" + z.{{.Coord}}1.String() + ")*v+(" + z.{{.Coord}}2.String() + ")*v²"
+}
+`
+
+// E2Test is the test template of the quadratic extension.
+const E2Test = `
+import (
+	"math/big"
+	"testing"
+)
+
+func TestE2(t *testing.T) {
+	var one E2
+	one.SetOne()
+
+	// the multiplicative group of E2 has order q²-1
+	var order big.Int
+	order.Exp(Modulus(), big.NewInt(2), nil).Sub(&order, big.NewInt(1))
+
+	var x, y, z, w E2
+	for i := 0; i < 100; i++ {
+		x.SetRandom()
+		y.SetRandom()
+
+		// (x·y)·x⁻¹ = y
+		z.Mul(&x, &y)
+		w.Inverse(&x)
+		z.Mul(&z, &w)
+		if !x.IsZero() && !z.Equal(&y) {
+			t.Fatal("x·y·x⁻¹ ≠ y")
+		}
+
+		z.Square(&x)
+		w.Mul(&x, &x)
+		if !z.Equal(&w) {
+			t.Fatal("x² ≠ x·x")
+		}
+
+		if i < 10 {
+			z.Exp(x, &order)
+			if !x.IsZero() && !z.Equal(&one) {
+				t.Fatal("x^(q²-1) ≠ 1")
+			}
+		}
+	}
+
+	// u² = {{.Tower.E2NonResidue}}
+	var u, expected E2
+	u.A1.SetOne()
+	u.Square(&u)
+	expected.A0.SetInt64({{.Tower.E2NonResidue}})
+	if !u.Equal(&expected) {
+		t.Fatal("u² ≠ {{.Tower.E2NonResidue}}")
+	}
+}
+
+func BenchmarkE2Mul(b *testing.B) {
+	var x, y E2
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE2Inverse(b *testing.B) {
+	var x E2
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+`
+
+// CubicTest is the test template of the cubic extensions E3 and E6.
+const CubicTest = `
+import (
+	"math/big"
+	"testing"
+)
+
+func random{{.Name}}(t *testing.T) {{.Name}} {
+	var x {{.Name}}
+	if _, err := x.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func Test{{.Name}}(t *testing.T) {
+	var one {{.Name}}
+	one.SetOne()
+
+	// the multiplicative group of {{.Name}} has order q^{{.Degree}}-1
+	var order big.Int
+	order.Exp(Modulus(), big.NewInt({{.Degree}}), nil).Sub(&order, big.NewInt(1))
+
+	for i := 0; i < 20; i++ {
+		x, y, z := random{{.Name}}(t), random{{.Name}}(t), random{{.Name}}(t)
+
+		var a, b, c {{.Name}}
+
+		// commutativity and associativity
+		a.Mul(&x, &y)
+		b.Mul(&y, &x)
+		if !a.Equal(&b) {
+			t.Fatal("x·y ≠ y·x")
+		}
+		a.Mul(&a, &z)
+		b.Mul(&y, &z).Mul(&b, &x)
+		if !a.Equal(&b) {
+			t.Fatal("(x·y)·z ≠ x·(y·z)")
+		}
+
+		// distributivity
+		a.Add(&y, &z).Mul(&a, &x)
+		b.Mul(&x, &y)
+		c.Mul(&x, &z)
+		b.Add(&b, &c)
+		if !a.Equal(&b) {
+			t.Fatal("x·(y+z) ≠ x·y + x·z")
+		}
+
+		a.Square(&x)
+		b.Mul(&x, &x)
+		if !a.Equal(&b) {
+			t.Fatal("x² ≠ x·x")
+		}
+
+		a.Inverse(&x).Mul(&a, &x)
+		if !a.Equal(&one) {
+			t.Fatal("x·x⁻¹ ≠ 1")
+		}
+
+		if i < 5 {
+			a.Exp(x, &order)
+			if !a.Equal(&one) {
+				t.Fatal("x^(q^{{.Degree}}-1) ≠ 1")
+			}
+		}
+	}
+
+	// v³ = ξ
+	var v, expected {{.Name}}
+	v.{{.Coord}}1.SetOne()
+	v.Exp(v, big.NewInt(3))
+	{{- if eq .Name "E3"}}
+	expected.{{.Coord}}0.SetInt64({{.Tower.E3NonResidue}})
+	{{- else}}
+	expected.{{.Coord}}0.A0.SetInt64({{index .Tower.E6NonResidue 0}})
+	expected.{{.Coord}}0.A1.SetInt64({{index .Tower.E6NonResidue 1}})
+	{{- end}}
+	if !v.Equal(&expected) {
+		t.Fatal("v³ ≠ ξ")
+	}
+}
+
+func Benchmark{{.Name}}Mul(b *testing.B) {
+	var x, y {{.Name}}
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func Benchmark{{.Name}}Square(b *testing.B) {
+	var x {{.Name}}
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Square(&x)
+	}
+}
+
+func Benchmark{{.Name}}Inverse(b *testing.B) {
+	var x {{.Name}}
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+`
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/tower"
+)
+
+// towerData is the data used to execute the templates of the quadratic extension.
+type towerData struct {
+	*config.FieldConfig
+	Tower *config.TowerConfig
+}
+
+// cubicData is the data used to execute the templates of a cubic extension.
+type cubicData struct {
+	towerData
+	Name     string // name of the extension, E3 or E6
+	Base     string // type of the elements of the base field
+	BaseName string // suffix of the multiplication by an element of the base field
+	Coord    string // prefix of the coordinates
+	Degree   int    // degree of the extension over the field
+}
+
+// GenerateTower generates in outputDir, next to the field F, the extensions
+//
+//	E2 = F[u]/(u² - β), E3 = F[v]/(v³ - β') and E6 = E2[v]/(v³ - ξ)
+//
+// whose non-residues are given by T. E3 is only generated if T.E3 is set.
+func GenerateTower(F *config.FieldConfig, T *config.TowerConfig, outputDir string) error {
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(F.PackageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	data := towerData{FieldConfig: F, Tower: T}
+	e3 := cubicData{towerData: data, Name: "E3", Base: F.ElementName, BaseName: "Element", Coord: "A", Degree: 3}
+	e6 := cubicData{towerData: data, Name: "E6", Base: "E2", BaseName: "E2", Coord: "B", Degree: 6}
+
+	entries := []struct {
+		file      string
+		templates []string
+		data      interface{}
+	}{
+		{"e2.go", []string{tower.E2}, data},
+		{"e2_test.go", []string{tower.E2Test}, data},
+		{"e6.go", []string{tower.Cubic}, e6},
+		{"e6_test.go", []string{tower.CubicTest}, e6},
+	}
+	if T.E3 {
+		entries = append(entries, []struct {
+			file      string
+			templates []string
+			data      interface{}
+		}{
+			{"e3.go", []string{tower.Cubic}, e3},
+			{"e3_test.go", []string{tower.CubicTest}, e3},
+		}...)
+	}
+	for _, e := range entries {
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, e.file), e.templates, e.data, bavardOpts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
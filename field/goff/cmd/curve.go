@@ -0,0 +1,185 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/gnark-crypto/ecc/generator"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+var curveCmd = &cobra.Command{
+	Use:   "curve",
+	Short: "generates the package of a short Weierstrass curve (fields, G1, multiexp, marshal, hash-to-curve, and G2, pairing and KZG for BN and BLS12 curves)",
+	Run:   cmdCurve,
+}
+
+func init() {
+	curveCmd.Flags().StringVarP(&fConfig, "config", "c", "", "path of the YAML (or JSON) description of the curve")
+	rootCmd.AddCommand(curveCmd)
+}
+
+// curveSpec describes a curve; see ecc/generator for the meaning of the fields:
+//
+//	module: github.com/acme/k256
+//	name: k256
+//	p: "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"
+//	r: "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"
+//	a: 0
+//	b: 7
+//	g1:
+//	  x: "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+//	  y: "0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+//	glv:
+//	  omega: "0x7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee"
+//	  lambda: "0x5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72"
+//	output: ./k256
+//
+// A pairing-friendly curve also has a pairing section:
+//
+//	pairing:
+//	  family: bn
+//	  x: 4965661367192848881
+//	  beta: -1
+//	  xi: [9, 1]
+//	  twist: D
+//	  g2:
+//	    x: ["10857046999023057135944570762232829481370756359578518086990519993285655852781", "11559732032986387107991004021392285783925812861821192530917403151452391805634"]
+//	    y: ["8495653923123431417604973247489272438418190587263600148770280649306958101930", "4082367875863433681332203403145435568316851327593401208105741076214120093531"]
+type curveSpec struct {
+	Module      string `yaml:"module"`       // module path, used if the output directory is not in a module
+	GnarkCrypto string `yaml:"gnark-crypto"` // version of gnark-crypto required by the module, or path to a local copy
+	Name        string `yaml:"name"`
+	P           string `yaml:"p"`
+	R           string `yaml:"r"`
+	A           string `yaml:"a"`
+	B           string `yaml:"b"`
+	G1          struct {
+		X string `yaml:"x"`
+		Y string `yaml:"y"`
+	} `yaml:"g1"`
+	Cofactor string `yaml:"cofactor"`
+	GLV      *struct {
+		Omega  string `yaml:"omega"`
+		Lambda string `yaml:"lambda"`
+	} `yaml:"glv"`
+	Pairing *struct {
+		Family string    `yaml:"family"`
+		X      string    `yaml:"x"`
+		Beta   string    `yaml:"beta"`
+		Xi     [2]string `yaml:"xi"`
+		Twist  string    `yaml:"twist"`
+		G2     struct {
+			X [2]string `yaml:"x"`
+			Y [2]string `yaml:"y"`
+		} `yaml:"g2"`
+	} `yaml:"pairing"`
+	Output string `yaml:"output"` // destination path, relative to the spec file
+}
+
+func cmdCurve(cmd *cobra.Command, args []string) {
+	fmt.Println()
+	fmt.Println("running goff version", Version)
+	fmt.Println()
+
+	exit := func(err error) {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+
+	if fConfig == "" {
+		_ = cmd.Usage()
+		exit(errMissingArgument)
+	}
+	s, err := readCurveSpec(fConfig, fOutputDir)
+	if err != nil {
+		exit(err)
+	}
+
+	newModule, err := ensureModule(s.Output, s.Module, s.GnarkCrypto)
+	if err != nil {
+		exit(err)
+	}
+	if err := generator.Generate(s.curve(), s.Output); err != nil {
+		exit(err)
+	}
+	if newModule {
+		tidyModule(s.Output)
+	}
+}
+
+// readCurveSpec reads the curve spec in path. The output directory of the spec
+// is relative to the spec file, and is overridden by outputDir if set.
+func readCurveSpec(path, outputDir string) (*curveSpec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s curveSpec
+	if err := yaml.UnmarshalStrict(content, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if outputDir != "" {
+		s.Output = outputDir
+	} else if s.Output != "" && !filepath.IsAbs(s.Output) {
+		s.Output = filepath.Join(filepath.Dir(path), s.Output)
+	}
+	if s.Name == "" || s.P == "" || s.R == "" || s.B == "" || s.G1.X == "" || s.G1.Y == "" || s.Output == "" {
+		return nil, errMissingArgument
+	}
+	if s.A == "" {
+		s.A = "0"
+	}
+	s.Output = filepath.Clean(s.Output)
+
+	return &s, nil
+}
+
+// curve returns the description of the curve for the generator.
+func (s *curveSpec) curve() generator.Curve {
+	c := generator.Curve{
+		Name:     s.Name,
+		P:        s.P,
+		R:        s.R,
+		A:        s.A,
+		B:        s.B,
+		Gx:       s.G1.X,
+		Gy:       s.G1.Y,
+		Cofactor: s.Cofactor,
+	}
+	if s.GLV != nil {
+		c.GLV = &generator.GLV{
+			ThirdRootOne: s.GLV.Omega,
+			Lambda:       s.GLV.Lambda,
+		}
+	}
+	if s.Pairing != nil {
+		c.Pairing = &generator.Pairing{
+			Family: s.Pairing.Family,
+			X:      s.Pairing.X,
+			Beta:   s.Pairing.Beta,
+			Xi:     s.Pairing.Xi,
+			Twist:  s.Pairing.Twist,
+			G2X:    s.Pairing.G2.X,
+			G2Y:    s.Pairing.G2.Y,
+		}
+	}
+	return c
+}
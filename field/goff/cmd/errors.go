@@ -18,4 +18,7 @@ import "errors"
 
 var (
 	errMissingArgument = errors.New("missing argument")
+	errMissingModule   = errors.New("the output directory is not in a module: the spec must provide a module path")
+	errNoFFT           = errors.New("the 2-adicity of q-1 is too small to generate a fft package")
+	errE6NonResidue    = errors.New("the E6 non-residue must be given by its 2 coordinates")
 )
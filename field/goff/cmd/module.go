@@ -0,0 +1,204 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/spf13/cobra"
+)
+
+const gnarkCryptoModule = "github.com/consensys/gnark-crypto"
+
+// goVersion is the go directive of the generated go.mod
+const goVersion = "1.22"
+
+var fConfig string
+
+var moduleCmd = &cobra.Command{
+	Use:   "module",
+	Short: "generates a field, its extension tower, fft and hash_to_field packages in a self-contained module",
+	Run: runSpec(func(s *spec, F *field.FieldConfig) error {
+		if err := generator.GenerateFF(F, s.Output); err != nil {
+			return err
+		}
+		// small fields come with their quartic extension and fft package
+		if F.F31 != nil {
+			return generateHashToField(s, F)
+		}
+		if err := generateTower(s, F); err != nil {
+			return err
+		}
+		if s.FFT == nil || *s.FFT {
+			if err := generateFFT(s, F); err != nil && (s.FFT != nil || err != errNoFFT) {
+				return err
+			}
+		}
+		if s.HashToField == nil || *s.HashToField {
+			return generateHashToField(s, F)
+		}
+		return nil
+	}),
+}
+
+var towerCmd = &cobra.Command{
+	Use:   "tower",
+	Short: "generates the quadratic, cubic and sextic extensions of a field, next to it",
+	Run:   runSpec(generateTower),
+}
+
+var fftCmd = &cobra.Command{
+	Use:   "fft",
+	Short: "generates the fft package of a field, in the fft sub-directory",
+	Run:   runSpec(generateFFT),
+}
+
+var hashToFieldCmd = &cobra.Command{
+	Use:   "hash-to-field",
+	Short: "generates the hash_to_field package of a field, in the hash_to_field sub-directory",
+	Run:   runSpec(generateHashToField),
+}
+
+func init() {
+	for _, c := range []*cobra.Command{moduleCmd, towerCmd, fftCmd, hashToFieldCmd} {
+		c.Flags().StringVarP(&fConfig, "config", "c", "", "path of the YAML (or JSON) description of the field")
+		rootCmd.AddCommand(c)
+	}
+}
+
+func generateTower(s *spec, F *field.FieldConfig) error {
+	T, err := s.towerConfig(F)
+	if err != nil {
+		return err
+	}
+	return generator.GenerateTower(F, T, s.Output)
+}
+
+func generateFFT(s *spec, F *field.FieldConfig) error {
+	if field.NewFFTConfig(F) == nil {
+		return errNoFFT
+	}
+	return generator.GenerateFFT(F, s.Output)
+}
+
+func generateHashToField(s *spec, F *field.FieldConfig) error {
+	return generator.GenerateHashToField(F, s.Output)
+}
+
+// runSpec returns the Run function of a sub-command generating code from a spec.
+// If the output directory is not in a module, a go.mod is created in it, and
+// the module dependencies are resolved once the code is generated.
+func runSpec(generate func(*spec, *field.FieldConfig) error) func(*cobra.Command, []string) {
+	return func(cmd *cobra.Command, args []string) {
+		fmt.Println()
+		fmt.Println("running goff version", Version)
+		fmt.Println()
+
+		exit := func(err error) {
+			fmt.Printf("\n%s\n", err.Error())
+			os.Exit(-1)
+		}
+
+		if fConfig == "" {
+			_ = cmd.Usage()
+			exit(errMissingArgument)
+		}
+		s, err := readSpec(fConfig, fOutputDir)
+		if err != nil {
+			exit(err)
+		}
+		F, err := s.fieldConfig()
+		if err != nil {
+			exit(err)
+		}
+
+		newModule, err := ensureModule(s.Output, s.Module, s.GnarkCrypto)
+		if err != nil {
+			exit(err)
+		}
+		if err := generate(s, F); err != nil {
+			exit(err)
+		}
+		if newModule {
+			tidyModule(s.Output)
+		}
+	}
+}
+
+// ensureModule creates a go.mod declaring module in the output directory if it
+// is not in a module, and returns true if it did so. gnarkCrypto is the version
+// of gnark-crypto to require, or the path to a local copy.
+func ensureModule(output, module, gnarkCrypto string) (bool, error) {
+	abs, err := filepath.Abs(output)
+	if err != nil {
+		return false, err
+	}
+	for d := abs; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return false, nil
+		}
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+	if module == "" {
+		return false, errMissingModule
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "module %s\n\ngo %s\n", module, goVersion)
+	switch v := gnarkCrypto; {
+	case strings.HasPrefix(v, "v"):
+		fmt.Fprintf(&b, "\nrequire %s %s\n", gnarkCryptoModule, v)
+	case v != "":
+		// local copy of gnark-crypto
+		path, err := filepath.Abs(v)
+		if err != nil {
+			return false, err
+		}
+		fmt.Fprintf(&b, "\nrequire %s v0.0.0\n\nreplace %s => %s\n", gnarkCryptoModule, gnarkCryptoModule, path)
+	default:
+		// the version goff was installed at; otherwise, go mod tidy resolves the latest one
+		if info, ok := debug.ReadBuildInfo(); ok && strings.HasPrefix(info.Main.Version, "v") {
+			fmt.Fprintf(&b, "\nrequire %s %s\n", gnarkCryptoModule, info.Main.Version)
+		}
+	}
+
+	if err := os.MkdirAll(output, 0700); err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(filepath.Join(output, "go.mod"), []byte(b.String()), 0600); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// tidyModule resolves the dependencies of the module created in output.
+func tidyModule(output string) {
+	tidy := exec.Command("go", "mod", "tidy")
+	tidy.Dir = output
+	tidy.Stdout = os.Stdout
+	tidy.Stderr = os.Stderr
+	if err := tidy.Run(); err != nil {
+		fmt.Println("go mod tidy failed, dependencies of", output, "must be resolved manually")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"gopkg.in/yaml.v2"
+)
+
+// spec describes a field and the packages generated around it. It is read from
+// a YAML file; JSON being a subset of YAML, a JSON file works as well:
+//
+//	module: github.com/acme/field
+//	modulus: "0x40000000000000000000000000000000224698fc094cf91b992d30ed00000001"
+//	package: fp
+//	element: Element
+//	output: ./field
+//	tower:
+//	  e2: -1      # u² = -1
+//	  e3: 5       # v³ = 5 (only if q ≡ 1 mod 3)
+//	  e6: [1, 1]  # v³ = 1+u
+//
+// The non-residues of the tower are optional: when omitted, the smallest
+// suitable ones are used.
+type spec struct {
+	Module      string     `yaml:"module"`        // module path, used if the output directory is not in a module
+	GnarkCrypto string     `yaml:"gnark-crypto"`  // version of gnark-crypto required by the module, or path to a local copy
+	Modulus     string     `yaml:"modulus"`       // field modulus (base 10, or base 16 with the 0x prefix)
+	Package     string     `yaml:"package"`       // package name in generated files
+	Element     string     `yaml:"element"`       // name of the generated struct and file
+	Output      string     `yaml:"output"`        // destination path, relative to the spec file
+	Tower       *towerSpec `yaml:"tower"`         // extension tower; defaults to the smallest non-residues
+	FFT         *bool      `yaml:"fft"`           // generates the fft package (default: if the 2-adicity allows it)
+	HashToField *bool      `yaml:"hash-to-field"` // generates the hash_to_field package (default: true)
+}
+
+// towerSpec holds the non-residues of the extension tower.
+type towerSpec struct {
+	E2 *int64  `yaml:"e2"`
+	E3 *int64  `yaml:"e3"`
+	E6 []int64 `yaml:"e6"`
+}
+
+// readSpec reads and checks the spec in path. The output directory of the spec
+// is relative to the spec file, and is overridden by outputDir if set.
+func readSpec(path, outputDir string) (*spec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s spec
+	if err := yaml.UnmarshalStrict(content, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if outputDir != "" {
+		s.Output = outputDir
+	} else if s.Output != "" && !filepath.IsAbs(s.Output) {
+		s.Output = filepath.Join(filepath.Dir(path), s.Output)
+	}
+	if s.Modulus == "" || s.Output == "" || s.Package == "" || s.Element == "" {
+		return nil, errMissingArgument
+	}
+	if s.Tower != nil && s.Tower.E6 != nil && len(s.Tower.E6) != 2 {
+		return nil, errE6NonResidue
+	}
+
+	// clean inputs
+	s.Output = filepath.Clean(s.Output)
+	s.Package = strings.ToLower(s.Package)
+
+	return &s, nil
+}
+
+// fieldConfig returns the configuration of the field described by s.
+func (s *spec) fieldConfig() (*field.FieldConfig, error) {
+	return field.NewFieldConfig(s.Package, s.Element, s.Modulus, false)
+}
+
+// towerConfig returns the configuration of the extension tower described by s.
+func (s *spec) towerConfig(F *field.FieldConfig) (*field.TowerConfig, error) {
+	if s.Tower == nil {
+		return field.NewTowerConfig(F, nil, nil, nil)
+	}
+	var e6 *[2]int64
+	if s.Tower.E6 != nil {
+		e6 = &[2]int64{s.Tower.E6[0], s.Tower.E6[1]}
+	}
+	return field.NewTowerConfig(F, s.Tower.E2, s.Tower.E3, e6)
+}
@@ -105,6 +105,17 @@ func TestExpandMsgXmd(t *testing.T) {
 			0x30,
 			"1aaee90016547a85ab4dc55e4f78a364c2e239c0e58b05753453c63e6e818334005e90d9ce8f047bddab9fbb315f8722",
 		},
+		// shorter than the digest (hash to a small field)
+		{
+			"",
+			0x14,
+			"de30afeca160bfd544479ac0750ccc4166515643",
+		},
+		{
+			"abc",
+			0x14,
+			"c9c9c73ca9bc779cf93a9aa5c9c8560c6e66722d",
+		},
 	}
 
 	for _, testCase := range testCases {
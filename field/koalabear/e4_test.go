@@ -0,0 +1,142 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package koalabear
+
+import (
+	"math/big"
+	"testing"
+)
+
+func randomE4(t *testing.T) E4 {
+	var x E4
+	if _, err := x.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func TestE2(t *testing.T) {
+	var x, y, z, w E2
+	for i := 0; i < 100; i++ {
+		x.SetRandom()
+		y.SetRandom()
+
+		// (x·y)·x⁻¹ = y
+		z.Mul(&x, &y)
+		w.Inverse(&x)
+		z.Mul(&z, &w)
+		if !x.IsZero() && !z.Equal(&y) {
+			t.Fatal("x·y·x⁻¹ ≠ y")
+		}
+
+		z.Square(&x)
+		w.Mul(&x, &x)
+		if !z.Equal(&w) {
+			t.Fatal("x² ≠ x·x")
+		}
+	}
+
+	// u² = 3
+	var u, expected E2
+	u.A1.SetOne()
+	u.Square(&u)
+	expected.A0.SetInt64(3)
+	if !u.Equal(&expected) {
+		t.Fatal("u² ≠ 3")
+	}
+}
+
+func TestE4(t *testing.T) {
+	var one E4
+	one.SetOne()
+
+	// the multiplicative group of E4 has order q⁴-1
+	var order big.Int
+	order.Exp(Modulus(), big.NewInt(4), nil).Sub(&order, big.NewInt(1))
+
+	for i := 0; i < 20; i++ {
+		x, y, z := randomE4(t), randomE4(t), randomE4(t)
+
+		var a, b, c E4
+
+		// commutativity and associativity
+		a.Mul(&x, &y)
+		b.Mul(&y, &x)
+		if !a.Equal(&b) {
+			t.Fatal("x·y ≠ y·x")
+		}
+		a.Mul(&a, &z)
+		b.Mul(&y, &z).Mul(&b, &x)
+		if !a.Equal(&b) {
+			t.Fatal("(x·y)·z ≠ x·(y·z)")
+		}
+
+		// distributivity
+		a.Add(&y, &z).Mul(&a, &x)
+		b.Mul(&x, &y)
+		c.Mul(&x, &z)
+		b.Add(&b, &c)
+		if !a.Equal(&b) {
+			t.Fatal("x·(y+z) ≠ x·y + x·z")
+		}
+
+		a.Square(&x)
+		b.Mul(&x, &x)
+		if !a.Equal(&b) {
+			t.Fatal("x² ≠ x·x")
+		}
+
+		a.Inverse(&x).Mul(&a, &x)
+		if !a.Equal(&one) {
+			t.Fatal("x·x⁻¹ ≠ 1")
+		}
+
+		a.Exp(x, &order)
+		if !a.Equal(&one) {
+			t.Fatal("x^(q⁴-1) ≠ 1")
+		}
+	}
+
+	// v² = 0+u
+	var v, expected E4
+	v.B1.A0.SetOne()
+	v.Square(&v)
+	expected.B0.A0.SetInt64(0)
+	expected.B0.A1.SetOne()
+	if !v.Equal(&expected) {
+		t.Fatal("v² ≠ 0+u")
+	}
+}
+
+func BenchmarkE4Mul(b *testing.B) {
+	var x, y E4
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE4Inverse(b *testing.B) {
+	var x E4
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
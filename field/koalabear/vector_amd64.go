@@ -0,0 +1,112 @@
+//go:build !purego
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package koalabear
+
+import "golang.org/x/sys/cpu"
+
+var (
+	supportAvx512 = cpu.X86.HasAVX512F
+	supportAvx2   = cpu.X86.HasAVX2
+)
+
+//go:noescape
+func addVecAVX512(res, a, b *Element, n uint64)
+
+//go:noescape
+func addVecAVX2(res, a, b *Element, n uint64)
+
+//go:noescape
+func subVecAVX512(res, a, b *Element, n uint64)
+
+//go:noescape
+func subVecAVX2(res, a, b *Element, n uint64)
+
+//go:noescape
+func mulVecAVX512(res, a, b *Element, n uint64)
+
+//go:noescape
+func mulVecAVX2(res, a, b *Element, n uint64)
+
+//go:noescape
+func scalarMulVecAVX512(res, a, b *Element, n uint64)
+
+//go:noescape
+func scalarMulVecAVX2(res, a, b *Element, n uint64)
+
+// blocks returns the number of elements processed by the vectorized kernels,
+// and the size of a block.
+func blocks(n int) (int, uint64) {
+	switch {
+	case supportAvx512:
+		return n - n%16, 16
+	case supportAvx2:
+		return n - n%8, 8
+	default:
+		return 0, 1
+	}
+}
+
+func addVec(res, a, b Vector) {
+	m, blockSize := blocks(len(a))
+	if m != 0 {
+		if supportAvx512 {
+			addVecAVX512(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		} else {
+			addVecAVX2(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		}
+	}
+	addVecGeneric(res[m:], a[m:], b[m:])
+}
+
+func subVec(res, a, b Vector) {
+	m, blockSize := blocks(len(a))
+	if m != 0 {
+		if supportAvx512 {
+			subVecAVX512(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		} else {
+			subVecAVX2(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		}
+	}
+	subVecGeneric(res[m:], a[m:], b[m:])
+}
+
+func mulVec(res, a, b Vector) {
+	m, blockSize := blocks(len(a))
+	if m != 0 {
+		if supportAvx512 {
+			mulVecAVX512(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		} else {
+			mulVecAVX2(&res[0], &a[0], &b[0], uint64(m)/blockSize)
+		}
+	}
+	mulVecGeneric(res[m:], a[m:], b[m:])
+}
+
+func scalarMulVec(res, a Vector, b *Element) {
+	m, blockSize := blocks(len(a))
+	if m != 0 {
+		if supportAvx512 {
+			scalarMulVecAVX512(&res[0], &a[0], b, uint64(m)/blockSize)
+		} else {
+			scalarMulVecAVX2(&res[0], &a[0], b, uint64(m)/blockSize)
+		}
+	}
+	scalarMulVecGeneric(res[m:], a[m:], b)
+}
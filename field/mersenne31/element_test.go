@@ -0,0 +1,236 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mersenne31
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// testValues returns edge values and random values, along with their big.Int counterparts
+func testValues(t *testing.T) ([]Element, []big.Int) {
+	q := Modulus()
+	var values []Element
+	for _, v := range []uint32{0, 1, 2, 3, q0 - 2, q0 - 1, q0 / 2, q0/2 + 1} {
+		values = append(values, NewElement(uint64(v)))
+	}
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		values = append(values, NewElement(r.Uint64()))
+	}
+	var x Element
+	if _, err := x.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	values = append(values, x)
+
+	bValues := make([]big.Int, len(values))
+	for i := range values {
+		values[i].BigInt(&bValues[i])
+		if bValues[i].Cmp(q) != -1 {
+			t.Fatal("element is not reduced")
+		}
+	}
+	return values, bValues
+}
+
+func TestElementArithmetic(t *testing.T) {
+	values, bValues := testValues(t)
+	q := Modulus()
+
+	check := func(op string, z Element, expected *big.Int) {
+		t.Helper()
+		expected.Mod(expected, q)
+		var b big.Int
+		if z.BigInt(&b).Cmp(expected) != 0 {
+			t.Fatalf("%s: expected %s, got %s", op, expected.String(), b.String())
+		}
+	}
+
+	for i := range values {
+		a, bA := values[i], &bValues[i]
+		for j := range values {
+			b, bB := values[j], &bValues[j]
+			var z Element
+			var e big.Int
+
+			check("Add", *z.Add(&a, &b), e.Add(bA, bB))
+			check("Sub", *z.Sub(&a, &b), e.Sub(bA, bB))
+			check("Mul", *z.Mul(&a, &b), e.Mul(bA, bB))
+			if !b.IsZero() {
+				var bInv big.Int
+				bInv.ModInverse(bB, q)
+				check("Div", *z.Div(&a, &b), e.Mul(bA, &bInv))
+			}
+		}
+
+		var z Element
+		var e big.Int
+		check("Double", *z.Double(&a), e.Lsh(bA, 1))
+		check("Neg", *z.Neg(&a), e.Neg(bA))
+		check("Square", *z.Square(&a), e.Mul(bA, bA))
+		check("Exp", *z.Exp(a, big.NewInt(1234567)), e.Exp(bA, big.NewInt(1234567), q))
+
+		z = a
+		MulBy3(&z)
+		check("MulBy3", z, e.Mul(bA, big.NewInt(3)))
+		z = a
+		MulBy5(&z)
+		check("MulBy5", z, e.Mul(bA, big.NewInt(5)))
+		z = a
+		MulBy13(&z)
+		check("MulBy13", z, e.Mul(bA, big.NewInt(13)))
+
+		z = a
+		z.Halve()
+		check("Halve", *z.Double(&z), e.Set(bA))
+
+		if !a.IsZero() {
+			check("Inverse", *z.Inverse(&a), e.ModInverse(bA, q))
+		}
+
+		if a.Legendre() != big.Jacobi(bA, q) {
+			t.Fatal("Legendre doesn't match big.Jacobi")
+		}
+		if z.Sqrt(&a) != nil {
+			check("Sqrt", *z.Square(&z), e.Set(bA))
+		} else if a.Legendre() != -1 {
+			t.Fatal("Sqrt returned nil for a square")
+		}
+	}
+}
+
+func TestElementButterfly(t *testing.T) {
+	values, _ := testValues(t)
+	for i := 1; i < len(values); i++ {
+		a, b := values[i-1], values[i]
+		var s, d Element
+		s.Add(&a, &b)
+		d.Sub(&a, &b)
+		Butterfly(&a, &b)
+		if !a.Equal(&s) || !b.Equal(&d) {
+			t.Fatal("Butterfly(a, b) ≠ (a+b, a-b)")
+		}
+	}
+}
+
+func TestElementBatchInvert(t *testing.T) {
+	values, _ := testValues(t)
+	inv := BatchInvert(values)
+	for i := range values {
+		var expected Element
+		expected.Inverse(&values[i])
+		if !inv[i].Equal(&expected) {
+			t.Fatal("BatchInvert doesn't match Inverse")
+		}
+	}
+}
+
+func TestElementSerialization(t *testing.T) {
+	values, bValues := testValues(t)
+	for i := range values {
+		a := values[i]
+		var b Element
+
+		bytes := a.Bytes()
+		b.SetBytes(bytes[:])
+		if !a.Equal(&b) {
+			t.Fatal("SetBytes(Bytes()) round trip failed")
+		}
+		if err := b.SetBytesCanonical(bytes[:]); err != nil || !a.Equal(&b) {
+			t.Fatal("SetBytesCanonical(Bytes()) round trip failed")
+		}
+
+		var buf [Bytes]byte
+		LittleEndian.PutElement(&buf, a)
+		if c, err := LittleEndian.Element(&buf); err != nil || !a.Equal(&c) {
+			t.Fatal("LittleEndian round trip failed")
+		}
+		BigEndian.PutElement(&buf, a)
+		if c, err := BigEndian.Element(&buf); err != nil || !a.Equal(&c) {
+			t.Fatal("BigEndian round trip failed")
+		}
+
+		if _, err := b.SetString(a.String()); err != nil || !a.Equal(&b) {
+			t.Fatal("SetString(String()) round trip failed")
+		}
+		b.SetBigInt(&bValues[i])
+		if !a.Equal(&b) {
+			t.Fatal("SetBigInt(BigInt()) round trip failed")
+		}
+
+		data, err := json.Marshal(&a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &b); err != nil || !a.Equal(&b) {
+			t.Fatal("JSON round trip failed")
+		}
+	}
+
+	// non canonical encodings are rejected
+	var buf [Bytes]byte
+	binary.BigEndian.PutUint32(buf[:], q0)
+	if _, err := BigEndian.Element(&buf); err == nil {
+		t.Fatal("BigEndian.Element accepted q")
+	}
+}
+
+func TestElementSetInt64(t *testing.T) {
+	q := Modulus()
+	for _, v := range []int64{0, 1, -1, 42, -42, 1 << 40, -(1 << 40), -1 << 63} {
+		var z Element
+		var b, e big.Int
+		e.SetInt64(v).Mod(&e, q)
+		if z.SetInt64(v).BigInt(&b).Cmp(&e) != 0 {
+			t.Fatalf("SetInt64(%d): expected %s, got %s", v, e.String(), b.String())
+		}
+	}
+}
+
+func BenchmarkElementMul(b *testing.B) {
+	var x, y Element
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkElementInverse(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+func BenchmarkElementSqrt(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	x.Square(&x)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var z Element
+		z.Sqrt(&x)
+	}
+}
@@ -20,12 +20,15 @@ import (
 	bls377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/mimc"
 	bls378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fr/mimc"
 	bls381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr/mimc"
+	bls461 "github.com/consensys/gnark-crypto/ecc/bls12-461/fr/mimc"
 	bls315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr/mimc"
 	bls317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fr/mimc"
 	bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
 	bw633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr/mimc"
 	bw756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr/mimc"
 	bw761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr/mimc"
+	starkpedersen "github.com/consensys/gnark-crypto/ecc/stark-curve/pedersen-hash"
+	starkposeidon "github.com/consensys/gnark-crypto/ecc/stark-curve/poseidon-hash"
 )
 
 // Hash defines an unique identifier for a hash function.
@@ -50,6 +53,12 @@ const (
 	MIMC_BW6_633
 	// MIMC_BW6_756 is the MiMC hash function for the BW6-756 curve.
 	MIMC_BW6_756
+	// MIMC_BLS12_461 is the MiMC hash function for the BLS12-461 curve.
+	MIMC_BLS12_461
+	// PEDERSEN_STARK is the Starknet Pedersen array hash over the STARK field.
+	PEDERSEN_STARK
+	// POSEIDON_STARK is the Starknet Poseidon array hash over the STARK field.
+	POSEIDON_STARK
 )
 
 // size of digests in bytes
@@ -63,6 +72,9 @@ var digestSize = []uint8{
 	MIMC_BLS24_317: 48,
 	MIMC_BW6_633:   80,
 	MIMC_BW6_756:   96,
+	MIMC_BLS12_461: 64,
+	PEDERSEN_STARK: 32,
+	POSEIDON_STARK: 32,
 }
 
 // New initializes the hash function.
@@ -86,6 +98,12 @@ func (m Hash) New() hash.Hash {
 		return bw633.NewMiMC()
 	case MIMC_BW6_756:
 		return bw756.NewMiMC()
+	case MIMC_BLS12_461:
+		return bls461.NewMiMC()
+	case PEDERSEN_STARK:
+		return starkpedersen.New()
+	case POSEIDON_STARK:
+		return starkposeidon.New()
 	default:
 		panic("Unknown mimc ID")
 	}
@@ -112,6 +130,12 @@ func (m Hash) String() string {
 		return "MIMC_BW633"
 	case MIMC_BW6_756:
 		return "MIMC_BW756"
+	case MIMC_BLS12_461:
+		return "MIMC_BLS461"
+	case PEDERSEN_STARK:
+		return "PEDERSEN_STARK"
+	case POSEIDON_STARK:
+		return "POSEIDON_STARK"
 	default:
 		panic("Unknown mimc ID")
 	}
@@ -0,0 +1,59 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hash
+
+import (
+	"testing"
+
+	bls377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	bls378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
+	bls381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	bls461 "github.com/consensys/gnark-crypto/ecc/bls12-461/fp"
+	bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	bw633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fp"
+	bw756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+	bw761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	stark "github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+// TestSize checks that the size of the digests is the size of an element of the
+// base field of the curve, and that the digests fit in it.
+func TestSize(t *testing.T) {
+	for h, size := range map[Hash]int{
+		MIMC_BN254:     bn254.Bytes,
+		MIMC_BLS12_381: bls381.Bytes,
+		MIMC_BLS12_377: bls377.Bytes,
+		MIMC_BLS12_378: bls378.Bytes,
+		MIMC_BW6_761:   bw761.Bytes,
+		MIMC_BLS24_315: 48, // larger than fp.Bytes, kept for compatibility
+		MIMC_BLS24_317: 48,
+		MIMC_BW6_633:   bw633.Bytes,
+		MIMC_BW6_756:   bw756.Bytes,
+		MIMC_BLS12_461: bls461.Bytes,
+		PEDERSEN_STARK: stark.Bytes,
+		POSEIDON_STARK: stark.Bytes,
+	} {
+		if h.Size() != size {
+			t.Errorf("%s: expected a digest of %d bytes, got %d", h, size, h.Size())
+		}
+		// the digests fit in Size() bytes
+		if s := h.New().Size(); s > h.Size() {
+			t.Errorf("%s: the hash function returns digests of %d bytes, more than %d", h, s, h.Size())
+		}
+	}
+	if len(digestSize) != int(POSEIDON_STARK)+1 {
+		t.Errorf("expected the sizes of %d hash functions, got %d", POSEIDON_STARK+1, len(digestSize))
+	}
+}
@@ -0,0 +1,73 @@
+package config
+
+var BLS12_461 = Curve{
+	Name:         "bls12-461",
+	CurvePackage: "bls12461",
+	EnumID:       "BLS12_461",
+	FrModulus:    "521481194400158902870293791036394582812650143983424074083311820261824039635303638490268303361",
+	FpModulus:    "3969508375500863470560772059146634051800057393085754326046523646985852496169198543994841284697713271737768244168253401239242781720740276907",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              true,
+		CofactorCleaning: true,
+		CRange:           defaultCRange(),
+	},
+	G2: Point{
+		CoordType:        "fptower.E2",
+		CoordExtDegree:   2,
+		CoordExtRoot:     -1,
+		PointName:        "g2",
+		GLV:              true,
+		CofactorCleaning: true,
+		CRange:           defaultCRange(),
+		Projective:       true,
+	},
+	HashE1: &HashSuiteSvdw{
+		z:  []string{"3969508375500863470560772059146634051800057393085754326046523646985852496169198543994841284697713271737768244168253401239242781720740276904"},
+		c1: []string{"3969508375500863470560772059146634051800057393085754326046523646985852496169198543994841284697713271737768244168253401239242781720740276884"},
+		c2: []string{"1984754187750431735280386029573317025900028696542877163023261823492926248084599271997420642348856635868884122084126700619621390860370138455"},
+		c3: []string{"3346098237350306392045509907113301048577375842286337908430313120941812658303361588997084716035062232462019567978588316951203515646267583688"},
+		c4: []string{"2352301259556067241813790849864672030696330307013780341360902901917542219952117655700646687228274531400158959507113126660292018797475719652"},
+	},
+	HashE2: &HashSuiteSvdw{
+		z: []string{
+			"1",
+			"0",
+		},
+		c1: []string{
+			"5",
+			"4",
+		},
+		c2: []string{
+			"1984754187750431735280386029573317025900028696542877163023261823492926248084599271997420642348856635868884122084126700619621390860370138453",
+			"0",
+		},
+		c3: []string{
+			"1660776847374797856818167492652169088542701797540195287771702492511195547633360690021203916087710626649402973949701620121380230750804382010",
+			"1830107775740765889228967037385661539069724484743303792921142423043453596019049022033140083896435117778780925774223677016096038505131536667",
+		},
+		c4: []string{
+			"2646338917000575647040514706097756034533371595390502884031015764657234997446132362663227523131808847825178829445502267492828521147160184598",
+			"1323169458500287823520257353048878017266685797695251442015507882328617498723066181331613761565904423912589414722751133746414260573580092297",
+		},
+	},
+}
+
+var tBLS12_461 = TwistedEdwardsCurve{
+	Name:     BLS12_461.Name,
+	Package:  "twistededwards",
+	EnumID:   BLS12_461.EnumID,
+	A:        "-1",
+	D:        "12684952174626220506040436099186893752706393072872908005390223981622457925506295015640210670",
+	Cofactor: "4",
+	Order:    "130370298600039725717573447759098645703162536006144525041469660254739662404379850676697040523",
+	BaseX:    "207955063574944033059683916730863806131088104231638720011308060102786694697977676982655725858",
+	BaseY:    "359738252716639465315801876460282652284991200253242165886979365863817733918390647254037300754",
+}
+
+func init() {
+	addCurve(&BLS12_461)
+	addTwistedEdwardCurve(&tBLS12_461)
+}
@@ -14,6 +14,10 @@ type Curve struct {
 	EnumID       string
 	FpModulus    string
 	FrModulus    string
+	ImportPath   string // import path of the curve package
+	ParallelPath string // import path of the parallel package used by the curve package
+	Custom       bool   // curve described outside of gnark-crypto, see ecc/generator
+	Family       string // family of a pairing-friendly custom curve: "bn" or "bls12"
 
 	Fp           *config.FieldConfig
 	Fr           *config.FieldConfig
@@ -32,6 +36,10 @@ type TwistedEdwardsCurve struct {
 	Package string
 	EnumID  string
 
+	// package of the field the curve is defined over: "fr" for the companion
+	// curves of the SNARK-friendly curves, "fp" for curves defined over the base field
+	CoordField string
+
 	A, D, Cofactor, Order, BaseX, BaseY string
 
 	// set if endomorphism
@@ -50,6 +58,17 @@ func (c Curve) Equal(other Curve) bool {
 	return c.Name == other.Name
 }
 
+// HasG2 returns true if the curve has a second group, i.e. is pairing-friendly.
+func (c Curve) HasG2() bool {
+	return c.G2.PointName != ""
+}
+
+// HasMarshal returns true if the points of the curve can be serialized with the
+// compressed encoding, which needs 2 spare bits in the most significant word of fp.
+func (c Curve) HasMarshal() bool {
+	return c.Fp.NbBits%64 != 0 && c.Fp.NbBits%64 <= 62
+}
+
 type Point struct {
 	CoordType        string
 	CoordExtDegree   uint8 // value n, such that q = pⁿ
@@ -63,6 +82,15 @@ type Point struct {
 	B                []string //B constant term in Weierstrass form
 }
 
+// MaxC returns the largest window size of the multiexp bucket method.
+func (p Point) MaxC() int {
+	m := 0
+	for _, c := range p.CRange {
+		m = max(m, c)
+	}
+	return m
+}
+
 var Curves []Curve
 var TwistedEdwardsCurves []TwistedEdwardsCurve
 
@@ -71,7 +99,12 @@ func defaultCRange() []int {
 	return []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
 }
 
+// gnarkCryptoImportPath is the import path of the gnark-crypto module
+const gnarkCryptoImportPath = "github.com/consensys/gnark-crypto"
+
 func addCurve(c *Curve) {
+	c.ImportPath = gnarkCryptoImportPath + "/ecc/" + c.Name
+	c.ParallelPath = gnarkCryptoImportPath + "/internal/parallel"
 	// init FpInfo and FrInfo
 	c.FpInfo = newFieldInfo(c.FpModulus)
 	c.FrInfo = newFieldInfo(c.FrModulus)
@@ -79,6 +112,9 @@ func addCurve(c *Curve) {
 }
 
 func addTwistedEdwardCurve(c *TwistedEdwardsCurve) {
+	if c.CoordField == "" {
+		c.CoordField = "fr"
+	}
 	TwistedEdwardsCurves = append(TwistedEdwardsCurves, *c)
 }
 
@@ -0,0 +1,45 @@
+package config
+
+// CURVE25519 is the short Weierstrass model Wei25519 of Curve25519
+// (https://datatracker.ietf.org/doc/draft-ietf-lwig-curve-representations/),
+// on which the generic G1 arithmetic applies. Ed25519 is the birationally
+// equivalent twisted Edwards curve tCURVE25519, defined over fp.
+var CURVE25519 = Curve{
+	Name:         "curve25519",
+	CurvePackage: "curve25519",
+	EnumID:       "CURVE25519",
+	FrModulus:    "7237005577332262213973186563042994240857116359379907606001950938285454250989",
+	FpModulus:    "57896044618658097711785492504343953926634992332820282019728792003956564819949",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              false,
+		CofactorCleaning: true,
+		CRange:           defaultCRange(),
+		A:                []string{"19298681539552699237261830834781317975544997444273427339909597334573241639236"},
+		B:                []string{"55751746669818908907645289078257140818241103727901012315294400837956729358436"},
+	},
+	// Z is given by find_z_sswu, RFC 9380 section H.2
+	HashE1: &HashSuiteSswu{
+		Z: []int{8},
+	},
+}
+
+var tCURVE25519 = TwistedEdwardsCurve{
+	Name:       CURVE25519.Name,
+	Package:    "twistededwards",
+	EnumID:     CURVE25519.EnumID,
+	CoordField: "fp",
+	A:          "-1",
+	D:          "37095705934669439343138083508754565189542113879843219016388785533085940283555",
+	Cofactor:   "8",
+	Order:      "7237005577332262213973186563042994240857116359379907606001950938285454250989",
+	BaseX:      "15112221349535400772501151409588531511454012693041857206046113283949847762202",
+	BaseY:      "46316835694926478169428394003475163141307993866256225615783033603165251855960",
+}
+
+func init() {
+	addCurve(&CURVE25519)
+	addTwistedEdwardCurve(&tCURVE25519)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"math/big"
 
 	field "github.com/consensys/gnark-crypto/field/generator/config"
@@ -54,6 +55,214 @@ type HashSuiteSvdw struct {
 	c4 []string
 }
 
+// NewHashSuiteSvdw returns the parameters of the Shallue and van de Woestijne map to
+// the curve Y² = X³ + aX + b over 𝔽p, following RFC 9380, sections 6.6.1 and H.1:
+// Z is the first of 1, -1, 2, -2, ... satisfying the criteria of find_z_svdw.
+func NewHashSuiteSvdw(p, a, b *big.Int) (*HashSuiteSvdw, error) {
+	mod := func(x *big.Int) *big.Int {
+		return x.Mod(x, p)
+	}
+	isSquare := func(x *big.Int) bool {
+		return big.Jacobi(x, p) != -1
+	}
+	// g(x) = x³ + ax + b
+	g := func(x *big.Int) *big.Int {
+		var res, t big.Int
+		res.Mul(x, x).Mul(&res, x)
+		t.Mul(a, x)
+		res.Add(&res, &t).Add(&res, b)
+		return mod(&res)
+	}
+	var two, four, inv2 big.Int
+	two.SetInt64(2)
+	four.SetInt64(4)
+	inv2.ModInverse(&two, p)
+
+	var z, gz, h big.Int
+	for i := int64(1); ; {
+		if i > 1<<10 {
+			return nil, errors.New("no suitable Z for the SVDW map")
+		}
+		z.SetInt64(i)
+		mod(&z)
+		gz.Set(g(&z))
+
+		// h = 3Z² + 4a
+		var t big.Int
+		h.Mul(&z, &z).Mul(&h, big.NewInt(3))
+		t.Mul(&four, a)
+		mod(h.Add(&h, &t))
+
+		// -(3Z² + 4a)/(4g(Z)) must be a non-zero square, and g(Z) or g(-Z/2) a square
+		if gz.Sign() != 0 && h.Sign() != 0 {
+			var c, mz2 big.Int
+			c.Mul(&four, &gz).ModInverse(&c, p)
+			mod(c.Mul(&c, &h).Neg(&c))
+			mod(mz2.Mul(&z, &inv2).Neg(&mz2))
+			if isSquare(&c) && (isSquare(&gz) || isSquare(g(&mz2))) {
+				break
+			}
+		}
+
+		// next candidate in 1, -1, 2, -2, ...
+		if i > 0 {
+			i = -i
+		} else {
+			i = -i + 1
+		}
+	}
+
+	var c2, c3, c4, t big.Int
+	// c2 = -Z/2
+	mod(c2.Mul(&z, &inv2).Neg(&c2))
+	// c3 = sqrt(-g(Z) * (3Z² + 4a)), with sgn0(c3) = 0
+	mod(t.Mul(&gz, &h).Neg(&t))
+	if c3.ModSqrt(&t, p) == nil {
+		return nil, errors.New("-g(Z)·(3Z² + 4a) is not a square")
+	}
+	if c3.Bit(0) == 1 {
+		mod(c3.Neg(&c3))
+	}
+	// c4 = -4g(Z)/(3Z² + 4a)
+	t.ModInverse(&h, p)
+	mod(c4.Mul(&four, &gz).Mul(&c4, &t).Neg(&c4))
+
+	return &HashSuiteSvdw{
+		z:  []string{z.String()},
+		c1: []string{gz.String()},
+		c2: []string{c2.String()},
+		c3: []string{c3.String()},
+		c4: []string{c4.String()},
+	}, nil
+}
+
+// NewHashSuiteSswu returns the parameters of the simplified SWU map to the curve
+// Y² = X³ + aX + b over 𝔽p, with a·b ≠ 0, following RFC 9380, sections 6.6.2 and
+// H.2: Z is the first of 1, -1, 2, -2, ... satisfying the criteria of find_z_sswu.
+func NewHashSuiteSswu(p, a, b *big.Int) (*HashSuiteSswu, error) {
+	mod := func(x *big.Int) *big.Int {
+		return x.Mod(x, p)
+	}
+	isSquare := func(x *big.Int) bool {
+		return big.Jacobi(x, p) != -1
+	}
+	var aa, bb big.Int
+	mod(aa.Set(a))
+	mod(bb.Set(b))
+	if aa.Sign() == 0 || bb.Sign() == 0 {
+		return nil, errors.New("the SSWU map needs a·b ≠ 0")
+	}
+
+	var z, minusOne, c big.Int
+	mod(minusOne.SetInt64(-1))
+	for i := int64(1); i <= 1<<10; {
+		z.SetInt64(i)
+		mod(&z)
+
+		// Z is not a square, Z ≠ -1, g(x) - Z is irreducible over 𝔽p and
+		// g(b/(Z·a)) is a square
+		if !isSquare(&z) && z.Cmp(&minusOne) != 0 {
+			var c0 big.Int
+			mod(c0.Sub(&bb, &z))
+			if !hasRootCubic(p, &aa, &c0) {
+				c.Mul(&z, &aa).ModInverse(&c, p)
+				mod(c.Mul(&c, &bb))
+				var gc, t big.Int
+				gc.Mul(&c, &c).Mul(&gc, &c)
+				t.Mul(&aa, &c)
+				mod(gc.Add(&gc, &t).Add(&gc, &bb))
+				if isSquare(&gc) {
+					return &HashSuiteSswu{Z: []int{int(i)}}, nil
+				}
+			}
+		}
+
+		// next candidate in 1, -1, 2, -2, ...
+		if i > 0 {
+			i = -i
+		} else {
+			i = -i + 1
+		}
+	}
+	return nil, errors.New("no suitable Z for the SSWU map")
+}
+
+// hasRootCubic returns true if x³ + ax + c has a root in 𝔽p, that is if
+// gcd(x³ + ax + c, xᵖ - x) ≠ 1.
+func hasRootCubic(p, a, c *big.Int) bool {
+	// polynomials are slices of coefficients, of increasing degree
+	mulMod := func(u, v []big.Int) []big.Int {
+		var prod [5]big.Int
+		for i := range u {
+			for j := range v {
+				var t big.Int
+				t.Mul(&u[i], &v[j])
+				prod[i+j].Add(&prod[i+j], &t)
+			}
+		}
+		// x³ = -ax - c
+		for k := 4; k >= 3; k-- {
+			var t big.Int
+			t.Mul(&prod[k], a)
+			prod[k-2].Sub(&prod[k-2], &t)
+			t.Mul(&prod[k], c)
+			prod[k-3].Sub(&prod[k-3], &t)
+		}
+		res := make([]big.Int, 3)
+		for i := range res {
+			res[i].Mod(&prod[i], p)
+		}
+		return res
+	}
+
+	// r = xᵖ mod x³ + ax + c
+	r := make([]big.Int, 3)
+	r[0].SetInt64(1)
+	x := make([]big.Int, 3)
+	x[1].SetInt64(1)
+	for i := p.BitLen() - 1; i >= 0; i-- {
+		r = mulMod(r, r)
+		if p.Bit(i) == 1 {
+			r = mulMod(r, x)
+		}
+	}
+	r[1].Sub(&r[1], big.NewInt(1)).Mod(&r[1], p)
+
+	f := make([]big.Int, 4)
+	f[0].Set(c)
+	f[1].Set(a)
+	f[3].SetInt64(1)
+	return polyGcdDegree(f, r, p) > 0
+}
+
+// polyGcdDegree returns the degree of gcd(u, v) over 𝔽p, or -1 if u = v = 0.
+func polyGcdDegree(u, v []big.Int, p *big.Int) int {
+	degree := func(w []big.Int) int {
+		d := len(w) - 1
+		for d >= 0 && w[d].Sign() == 0 {
+			d--
+		}
+		return d
+	}
+	for degree(v) >= 0 {
+		// u = u mod v
+		dv := degree(v)
+		var inv big.Int
+		inv.ModInverse(&v[dv], p)
+		for du := degree(u); du >= dv; du = degree(u) {
+			var q big.Int
+			q.Mul(&u[du], &inv).Mod(&q, p)
+			for i := 0; i <= dv; i++ {
+				var t big.Int
+				t.Mul(&q, &v[i])
+				u[du-dv+i].Sub(&u[du-dv+i], &t).Mod(&u[du-dv+i], p)
+			}
+		}
+		u, v = v, u
+	}
+	return degree(u)
+}
+
 func (parameters *HashSuiteSvdw) GetInfo(baseField *field.FieldConfig, g *Point, name string) HashSuiteInfo {
 	f := field.NewTower(baseField, g.CoordExtDegree, g.CoordExtRoot)
 	c := []field.Element{
@@ -193,6 +402,7 @@ type HashSuiteInfo struct {
 	Field             *field.Extension
 	FieldCoordName    string
 	Name              string
+	ImportPath        string // import path of the curve package
 	FieldSizeMod256   uint8
 	PrecomputedParams []field.Element // PrecomputedParams[0][n] correspond to integer cₙ₋₁ in std doc
 	// PrecomputedParams[n≥1] correspond to field element c_( len(PrecomputedParams[0]) + n - 1 ) in std doc
@@ -0,0 +1,28 @@
+package config
+
+var SECP384R1 = Curve{
+	Name:         "secp384r1",
+	CurvePackage: "secp384r1",
+	EnumID:       "SECP384R1",
+	FrModulus:    "39402006196394479212279040100143613805079739270465446667946905279627659399113263569398956308152294913554433653942643",
+	FpModulus:    "39402006196394479212279040100143613805079739270465446667948293404245721771496870329047266088258938001861606973112319",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              false,
+		CofactorCleaning: false,
+		CRange:           []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		A:                []string{"-3"},
+		B:                []string{"27580193559959705877849011840389048093056905856361568521428707301988689241309860865136260764883745107765439761230575"},
+	},
+	// same map as P384_XMD:SHA-384_SSWU_RO_ (RFC 9380 section 8.3), but fp.Hash
+	// expands the message with SHA-256
+	HashE1: &HashSuiteSswu{
+		Z: []int{-12},
+	},
+}
+
+func init() {
+	addCurve(&SECP384R1)
+}
@@ -0,0 +1,9 @@
+package ecc
+
+import "embed"
+
+// templates are embedded in the binary, so that curves can be generated outside of
+// the gnark-crypto repository.
+//
+//go:embed template
+var templates embed.FS
@@ -2,6 +2,7 @@ package ecc
 
 import (
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -11,9 +12,25 @@ import (
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/embedded"
 )
 
+// generator generates files from the templates in baseTmplDir.
+type generator interface {
+	GenerateWithOptions(data interface{}, packageName string, baseTmplDir string, extraOptions []func(*bavard.Bavard) error, entries ...bavard.Entry) error
+}
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	return generate(conf, baseDir, "./ecc/template", bgen)
+}
+
+// GenerateCustom generates the package of a curve described outside of gnark-crypto
+// in baseDir, from the templates embedded in the binary.
+func GenerateCustom(conf config.Curve, baseDir string, opts ...func(*bavard.Bavard) error) error {
+	return generate(conf, baseDir, "template", embedded.Generator{FS: templates, DefaultOpts: opts})
+}
+
+func generate(conf config.Curve, baseDir, tmplDir string, bgen generator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
 
@@ -30,12 +47,19 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			{File: filepath.Join(baseDir, fmt.Sprintf("hash_to_%s_test.go", point.PointName)), Templates: []string{"tests/hash_to_curve.go.tmpl"}}}
 
 		hashConf := suite.GetInfo(conf.Fp, point, conf.Name)
+		hashConf.ImportPath = conf.ImportPath
 
 		funcs := make(template.FuncMap)
 		funcs["asElement"] = hashConf.Field.Base.WriteElement
+		// bavard's bits reads negative big.Int in two's complement
+		funcs["abs"] = func(x big.Int) big.Int {
+			var res big.Int
+			res.Abs(&x)
+			return res
+		}
 		bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
 
-		return bgen.GenerateWithOptions(hashConf, packageName, "./ecc/template", bavardOpts, entries...)
+		return bgen.GenerateWithOptions(hashConf, packageName, tmplDir, bavardOpts, entries...)
 	}
 
 	if err := genHashToCurve(&conf.G1, conf.HashE1); err != nil {
@@ -49,6 +73,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "g1.go"), Templates: []string{"point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "g1_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+		{File: filepath.Join(baseDir, "g1_fixedbase.go"), Templates: []string{"fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "g1_fixedbase_test.go"), Templates: []string{"tests/fixedbase.go.tmpl"}},
 	}
 	// if not secp256k1, generate the lagrange transform
 	if conf.Name != config.SECP256K1.Name {
@@ -59,7 +85,7 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	}
 
 	g1 := pconf{conf, conf.G1}
-	if err := bgen.Generate(g1, packageName, "./ecc/template", entries...); err != nil {
+	if err := bgen.GenerateWithOptions(g1, packageName, tmplDir, nil, entries...); err != nil {
 		return err
 	}
 
@@ -169,33 +195,47 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	}
 
 	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", bavardOpts, entries...); err != nil {
+	if err := bgen.GenerateWithOptions(conf, packageName, tmplDir, bavardOpts, entries...); err != nil {
 		return err
 	}
 
-	// No G2 for secp256k1
-	if conf.Equal(config.SECP256K1) {
-		return nil
-	}
-
 	// marshal
-	entries = []bavard.Entry{
-		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
-		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+	if conf.HasMarshal() {
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+			{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+		}
+
+		marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
+		if err := bgen.GenerateWithOptions(conf, packageName, tmplDir, marshal, entries...); err != nil {
+			return err
+		}
 	}
 
-	marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", marshal, entries...); err != nil {
-		return err
+	if !conf.HasG2() {
+		return nil
 	}
 
 	// G2
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "g2_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+		{File: filepath.Join(baseDir, "g2_fixedbase.go"), Templates: []string{"fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "g2_fixedbase_test.go"), Templates: []string{"tests/fixedbase.go.tmpl"}},
 	}
 	g2 := pconf{conf, conf.G2}
-	return bgen.Generate(g2, packageName, "./ecc/template", entries...)
+	return bgen.GenerateWithOptions(g2, packageName, tmplDir, nil, entries...)
+}
+
+// GenerateFixedBase generates the fixed-base scalar multiplication of G1, for the
+// curves whose G1 arithmetic is not generated.
+func GenerateFixedBase(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	packageName := strings.ReplaceAll(conf.Name, "-", "")
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "g1_fixedbase.go"), Templates: []string{"fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "g1_fixedbase_test.go"), Templates: []string{"tests/fixedbase.go.tmpl"}},
+	}
+	return bgen.GenerateWithOptions(pconf{conf, conf.G1}, packageName, "./ecc/template", nil, entries...)
 }
 
 type pconf struct {
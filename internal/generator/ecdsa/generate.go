@@ -18,6 +18,26 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "ecdsa_test.go"), Templates: []string{"ecdsa.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "signer.go"), Templates: []string{"signer.go.tmpl"}},
+		{File: filepath.Join(baseDir, "signer_test.go"), Templates: []string{"signer.test.go.tmpl"}},
+	}
+	if conf.Equal(config.SECP256K1) || conf.Equal(config.SECP256R1) || conf.Equal(config.SECP384R1) {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "pkcs8.go"), Templates: []string{"pkcs8.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "pkcs8_test.go"), Templates: []string{"pkcs8.test.go.tmpl"}},
+		)
+	}
+	if conf.Equal(config.STARK_CURVE) {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "starknet.go"), Templates: []string{"starknet.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "starknet_test.go"), Templates: []string{"starknet.test.go.tmpl"}},
+		)
+	}
+	if conf.Equal(config.SECP256K1) {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "ethereum.go"), Templates: []string{"ethereum.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "ethereum_test.go"), Templates: []string{"ethereum.test.go.tmpl"}},
+		)
 	}
 	return bgen.Generate(conf, conf.Package, "./ecdsa/template", entries...)
 
@@ -0,0 +1,51 @@
+package ecvrf
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// verifiable random function (RFC 9381)
+	data := struct {
+		config.Curve
+		// type of the suites, and suite of Prove, Verify and ProofToHash:
+		// RFC 9381 only defines suites on P-256
+		Suite, DefaultSuite string
+	}{Curve: conf}
+	if conf.Equal(config.SECP256R1) {
+		data.Suite, data.DefaultSuite = "Suite", "SuiteSSWU"
+	} else {
+		data.Suite, data.DefaultSuite = "suite", "suiteSVDW"
+	}
+	data.Package = "ecvrf"
+	baseDir = filepath.Join(baseDir, data.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecvrf.go"), Templates: []string{"ecvrf.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecvrf_test.go"), Templates: []string{"ecvrf.test.go.tmpl"}},
+	}
+	return bgen.Generate(data, data.Package, "./ecvrf/template", entries...)
+
+}
+
+func GenerateEdwards(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// verifiable random function on the twisted Edwards curve, with MiMC
+	data := struct {
+		config.TwistedEdwardsCurve
+		CurvePackage string
+	}{conf, conf.Package}
+	data.Package = "ecvrf"
+	baseDir = filepath.Join(baseDir, data.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"edwards/doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecvrf.go"), Templates: []string{"edwards/ecvrf.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ecvrf_test.go"), Templates: []string{"edwards/ecvrf.test.go.tmpl"}},
+	}
+	return bgen.Generate(data, data.Package, "./ecvrf/template", entries...)
+
+}
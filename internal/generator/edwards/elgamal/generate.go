@@ -0,0 +1,28 @@
+package elgamal
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// exponential ElGamal encryption with threshold decryption
+	data := struct {
+		config.TwistedEdwardsCurve
+		CurvePackage string
+	}{conf, conf.Package}
+	data.Package = "elgamal"
+	baseDir = filepath.Join(baseDir, data.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "elgamal.go"), Templates: []string{"elgamal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "threshold.go"), Templates: []string{"threshold.go.tmpl"}},
+		{File: filepath.Join(baseDir, "dlog.go"), Templates: []string{"dlog.go.tmpl"}},
+		{File: filepath.Join(baseDir, "elgamal_test.go"), Templates: []string{"elgamal.test.go.tmpl"}},
+	}
+	return bgen.Generate(data, data.Package, "./edwards/elgamal/template", entries...)
+
+}
@@ -0,0 +1,51 @@
+// Package embedded generates files from templates embedded in the binary, so that
+// the packages of the curves described outside of gnark-crypto (see ecc/generator)
+// are generated from the same templates as the ones of gnark-crypto.
+package embedded
+
+import (
+	"io/fs"
+	"path"
+
+	"github.com/consensys/bavard"
+)
+
+// Generator generates files from the templates of FS. It has the methods of
+// bavard.BatchGenerator used by the generators of gnark-crypto, but generates the
+// files sequentially.
+type Generator struct {
+	FS          fs.FS
+	DefaultOpts []func(*bavard.Bavard) error
+}
+
+// Generate generates the entries from the templates in baseTmplDir.
+func (g Generator) Generate(data interface{}, packageName string, baseTmplDir string, entries ...bavard.Entry) error {
+	return g.GenerateWithOptions(data, packageName, baseTmplDir, nil, entries...)
+}
+
+// GenerateWithOptions generates the entries from the templates in baseTmplDir, with
+// extraOptions added to the default options.
+func (g Generator) GenerateWithOptions(data interface{}, packageName string, baseTmplDir string, extraOptions []func(*bavard.Bavard) error, entries ...bavard.Entry) error {
+	for _, entry := range entries {
+		tmpls := make([]string, len(entry.Templates))
+		for i, t := range entry.Templates {
+			b, err := fs.ReadFile(g.FS, path.Join(baseTmplDir, t))
+			if err != nil {
+				return err
+			}
+			tmpls[i] = string(b)
+		}
+
+		opts := make([]func(*bavard.Bavard) error, 0, len(g.DefaultOpts)+len(extraOptions)+2)
+		opts = append(opts, g.DefaultOpts...)
+		opts = append(opts, extraOptions...)
+		if entry.BuildTag != "" {
+			opts = append(opts, bavard.BuildTag(entry.BuildTag))
+		}
+		opts = append(opts, bavard.Package(packageName))
+		if err := bavard.GenerateFromString(entry.File, tmpls, data, opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -21,6 +21,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "bitreverse.go"), Templates: []string{"bitreverse.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mixedradix.go"), Templates: []string{"mixedradix.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mixedradix_test.go"), Templates: []string{"tests/mixedradix.go.tmpl", "imports.go.tmpl"}},
 	}
 
 	funcs := make(map[string]interface{})
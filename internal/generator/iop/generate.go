@@ -22,6 +22,9 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "quotient.go"), Templates: []string{"quotient.go.tmpl"}},
 		{File: filepath.Join(baseDir, "quotient_test.go"), Templates: []string{"quotient.test.go.tmpl"}},
 
+		{File: filepath.Join(baseDir, "constraints.go"), Templates: []string{"constraints.go.tmpl"}},
+		{File: filepath.Join(baseDir, "constraints_test.go"), Templates: []string{"constraints.test.go.tmpl"}},
+
 		{File: filepath.Join(baseDir, "expressions.go"), Templates: []string{"expressions.go.tmpl"}},
 		{File: filepath.Join(baseDir, "expressions_test.go"), Templates: []string{"expressions.test.go.tmpl"}},
 
@@ -1,12 +1,20 @@
 package kzg
 
 import (
+	"embed"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/embedded"
 )
 
+// templates are embedded in the binary, so that the kzg package of a curve can be
+// generated outside of the gnark-crypto repository.
+//
+//go:embed template
+var templates embed.FS
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	// kzg commitment scheme
@@ -17,7 +25,32 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "kzg_test.go"), Templates: []string{"kzg.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ceremony.go"), Templates: []string{"ceremony.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ceremony_test.go"), Templates: []string{"ceremony.test.go.tmpl"}},
+	}
+	// transcripts of the public ceremonies
+	if conf.Equal(config.BN254) || conf.Equal(config.BLS12_381) {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "transcripts.go"), Templates: []string{"transcripts.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "transcripts_test.go"), Templates: []string{"transcripts.test.go.tmpl"}},
+		)
 	}
 	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
 
 }
+
+// GenerateCustom generates the kzg package of a curve described outside of
+// gnark-crypto in baseDir, from the templates embedded in the binary. The setup
+// ceremony is not generated: it needs the hash to G₂, see ecc/generator.
+func GenerateCustom(conf config.Curve, baseDir string, opts ...func(*bavard.Bavard) error) error {
+	conf.Package = "kzg"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "kzg.go"), Templates: []string{"kzg.go.tmpl"}},
+		{File: filepath.Join(baseDir, "kzg_test.go"), Templates: []string{"kzg.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
+	}
+	gen := embedded.Generator{FS: templates, DefaultOpts: opts}
+	return gen.Generate(conf, conf.Package, "template", entries...)
+}
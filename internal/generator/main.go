@@ -14,19 +14,25 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
+	"github.com/consensys/gnark-crypto/internal/generator/ecvrf"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards/eddsa"
+	"github.com/consensys/gnark-crypto/internal/generator/edwards/elgamal"
+	"github.com/consensys/gnark-crypto/internal/generator/edwards/frost"
 	"github.com/consensys/gnark-crypto/internal/generator/fft"
 	fri "github.com/consensys/gnark-crypto/internal/generator/fri/template"
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_field"
 	"github.com/consensys/gnark-crypto/internal/generator/iop"
+	"github.com/consensys/gnark-crypto/internal/generator/ipa"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
 	"github.com/consensys/gnark-crypto/internal/generator/pedersen"
 	"github.com/consensys/gnark-crypto/internal/generator/permutation"
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/generator/rangeproof"
+	"github.com/consensys/gnark-crypto/internal/generator/sigma"
 	"github.com/consensys/gnark-crypto/internal/generator/sis"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
@@ -70,13 +76,24 @@ func main() {
 			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
 
 			if conf.Equal(config.STARK_CURVE) {
+				assertNoError(ecc.GenerateFixedBase(conf, curveDir, bgen))
 				return // TODO @yelhousni
 			}
 
 			// generate G1, G2, multiExp, ...
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
-			if conf.Equal(config.SECP256K1) {
+			// generate ecvrf
+			if conf.Equal(config.SECP256K1) || conf.Equal(config.SECP256R1) {
+				assertNoError(ecvrf.Generate(conf, curveDir, bgen))
+			}
+
+			// generate sigma protocols on G1
+			if !conf.Equal(config.SECP256R1) && !conf.Equal(config.SECP384R1) && !conf.Equal(config.CURVE25519) {
+				assertNoError(sigma.Generate(conf, curveDir, bgen))
+			}
+
+			if conf.Equal(config.SECP256K1) || conf.Equal(config.SECP256R1) || conf.Equal(config.SECP384R1) || conf.Equal(config.CURVE25519) {
 				return
 			}
 
@@ -99,6 +116,12 @@ func main() {
 			// generate kzg on fr
 			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "kzg"), bgen))
 
+			// generate pedersen vector commitment and inner-product argument
+			assertNoError(ipa.Generate(conf, filepath.Join(curveDir, "ipa"), bgen))
+
+			// generate bulletproofs range proofs
+			assertNoError(rangeproof.Generate(conf, filepath.Join(curveDir, "rangeproof"), bgen))
+
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
@@ -166,8 +189,34 @@ func main() {
 			// generate twisted edwards companion curves
 			assertNoError(edwards.Generate(conf, curveDir, bgen))
 
+			if conf.Name == config.CURVE25519.Name {
+				// Ed25519 follows RFC 8032, see ecc/curve25519/twistededwards/eddsa
+				return
+			}
+
+			// generate the scalar field of companion curves, i.e. integers modulo
+			// the order of the prime subgroup
+			fr, err := field.NewFieldConfig("fr", "Element", conf.Order, true)
+			assertNoError(err)
+			assertNoError(generator.GenerateFF(fr, filepath.Join(curveDir, "fr")))
+
 			// generate eddsa on companion curves
 			assertNoError(eddsa.Generate(conf, curveDir, bgen))
+
+			// generate FROST threshold signatures on companion curves
+			assertNoError(frost.Generate(conf, curveDir, bgen))
+
+			// generate the verifiable random function on companion curves
+			assertNoError(ecvrf.GenerateEdwards(conf, curveDir, bgen))
+
+			// generate pedersen vector commitment and inner-product argument on companion curves
+			assertNoError(ipa.GenerateEdwards(conf, curveDir, bgen))
+
+			// generate sigma protocols on companion curves
+			assertNoError(sigma.GenerateEdwards(conf, curveDir, bgen))
+
+			// generate ElGamal encryption on companion curves
+			assertNoError(elgamal.Generate(conf, curveDir, bgen))
 		}(conf)
 
 	}
@@ -16,6 +16,15 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, b
 		{File: filepath.Join(baseDir, "pool.go"), Templates: []string{"pool.go.tmpl"}},
 	}
 
+	// the arithmetic relies on the fft package, which only exists for the
+	// scalar fields of the curves.
+	if conf.FieldPackageName == "fr" {
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "arithmetic.go"), Templates: []string{"arithmetic.go.tmpl"}})
+		if generateTests {
+			entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "arithmetic_test.go"), Templates: []string{"arithmetic.test.go.tmpl"}})
+		}
+	}
+
 	if generateTests {
 		entries = append(entries,
 			bavard.Entry{File: filepath.Join(baseDir, "polynomial_test.go"), Templates: []string{"polynomial.test.go.tmpl"}},
@@ -0,0 +1,22 @@
+package rangeproof
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// bulletproofs range proofs
+	conf.Package = "rangeproof"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "rangeproof.go"), Templates: []string{"rangeproof.go.tmpl"}},
+		{File: filepath.Join(baseDir, "rangeproof_test.go"), Templates: []string{"rangeproof.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./rangeproof/template/", entries...)
+
+}
@@ -0,0 +1,42 @@
+package sigma
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// sigma protocols on G1
+	conf.Package = "sigma"
+	baseDir = filepath.Join(baseDir, conf.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sigma.go"), Templates: []string{"sigma.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sigma_test.go"), Templates: []string{"sigma.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./sigma/template", entries...)
+
+}
+
+func GenerateEdwards(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// sigma protocols on the twisted Edwards curve
+	data := struct {
+		config.TwistedEdwardsCurve
+		CurvePackage string
+	}{conf, conf.Package}
+	data.Package = "sigma"
+	baseDir = filepath.Join(baseDir, data.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sigma.go"), Templates: []string{"edwards/sigma.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sigma_test.go"), Templates: []string{"edwards/sigma.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"edwards/marshal.go.tmpl"}},
+	}
+	return bgen.Generate(data, data.Package, "./sigma/template", entries...)
+
+}
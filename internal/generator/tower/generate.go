@@ -1,21 +1,46 @@
 package tower
 
 import (
+	"embed"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/embedded"
 	"github.com/consensys/gnark-crypto/internal/generator/tower/asm/amd64"
 )
 
+// generator generates files from the templates in baseTmplDir.
+type generator interface {
+	Generate(data interface{}, packageName string, baseTmplDir string, entries ...bavard.Entry) error
+}
+
+// templates are embedded in the binary, so that the tower of a curve can be
+// generated outside of the gnark-crypto repository.
+//
+//go:embed template
+var templates embed.FS
+
 // Generate generates a tower 2->6->12 over fp
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 	if conf.Equal(config.BW6_756) || conf.Equal(config.BW6_761) || conf.Equal(config.BW6_633) || conf.Equal(config.BLS24_315) || conf.Equal(config.BLS24_317) {
 		return nil
 	}
+	return generate(conf, baseDir, "./tower/template/fq12over6over2", bgen)
+}
+
+// GenerateCustom generates the tower 2->6->12 of a curve described outside of
+// gnark-crypto in baseDir, from the templates embedded in the binary. The
+// multiplication and the squaring in 𝔽p², the Frobenius maps and the sparse
+// multiplications used by the pairing are not generated: they depend on the
+// non-residues, see ecc/generator.
+func GenerateCustom(conf config.Curve, baseDir string, opts ...func(*bavard.Bavard) error) error {
+	return generate(conf, baseDir, "template/fq12over6over2", embedded.Generator{FS: templates, DefaultOpts: opts})
+}
 
+func generate(conf config.Curve, baseDir, tmplDir string, bgen generator) error {
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "e2_amd64.go"), Templates: []string{"amd64.fq2.go.tmpl"}},
 		{File: filepath.Join(baseDir, "e2_fallback.go"), Templates: []string{"fallback.fq2.go.tmpl"}, BuildTag: "!amd64"},
@@ -23,7 +48,20 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "asm_noadx.go"), Templates: []string{"asm_noadx.go.tmpl"}, BuildTag: "noadx"},
 	}
 
-	if err := bgen.Generate(conf, "fptower", "./tower/template/fq12over6over2", entries...); err != nil {
+	// the fq2 assembly keeps both operands in registers, which only works up to 6 words;
+	// larger base fields use the generic implementation on all architectures. The
+	// assembly of the custom curves is not generated.
+	withAsm := conf.Fp.NbWords <= 6 && !conf.Custom
+	if !withAsm {
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "e2_fallback.go"), Templates: []string{"fallback.fq2.go.tmpl"}},
+		}
+		for _, f := range []string{"e2_amd64.go", "e2_amd64.s", "asm.go", "asm_noadx.go"} {
+			os.Remove(filepath.Join(baseDir, f))
+		}
+	}
+
+	if err := bgen.Generate(conf, "fptower", tmplDir, entries...); err != nil {
 		return err
 	}
 
@@ -64,12 +102,12 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			},
 		}
 
-		if err := bgen.Generate(towerConf, "fptower", "./tower/template/fq12over6over2", entries...); err != nil {
+		if err := bgen.Generate(towerConf, "fptower", tmplDir, entries...); err != nil {
 			return err
 		}
 	}
 
-	{
+	if withAsm {
 		// fq2 assembly
 		fName := filepath.Join(baseDir, "e2_amd64.s")
 		f, err := os.Create(fName)
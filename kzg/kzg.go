@@ -11,6 +11,7 @@ import (
 	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
 	kzg_bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378/kzg"
 	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	kzg_bls12461 "github.com/consensys/gnark-crypto/ecc/bls12-461/kzg"
 	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
 	kzg_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/kzg"
 	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
@@ -47,6 +48,8 @@ func NewSRS(curveID ecc.ID) SRS {
 		return &kzg_bls12378.SRS{}
 	case ecc.BLS12_381:
 		return &kzg_bls12381.SRS{}
+	case ecc.BLS12_461:
+		return &kzg_bls12461.SRS{}
 	case ecc.BLS24_315:
 		return &kzg_bls24315.SRS{}
 	case ecc.BLS24_317:
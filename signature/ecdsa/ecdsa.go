@@ -23,13 +23,17 @@ import (
 	ecdsa_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/ecdsa"
 	ecdsa_bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378/ecdsa"
 	ecdsa_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/ecdsa"
+	ecdsa_bls12461 "github.com/consensys/gnark-crypto/ecc/bls12-461/ecdsa"
 	ecdsa_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/ecdsa"
 	ecdsa_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/ecdsa"
 	ecdsa_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/ecdsa"
 	ecdsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/ecdsa"
 	ecdsa_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/ecdsa"
 	ecdsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/ecdsa"
+	ecdsa_curve25519 "github.com/consensys/gnark-crypto/ecc/curve25519/ecdsa"
 	ecdsa_secp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/ecdsa"
+	ecdsa_secp256r1 "github.com/consensys/gnark-crypto/ecc/secp256r1/ecdsa"
+	ecdsa_secp384r1 "github.com/consensys/gnark-crypto/ecc/secp384r1/ecdsa"
 	ecdsa_starkcurve "github.com/consensys/gnark-crypto/ecc/stark-curve/ecdsa"
 	"github.com/consensys/gnark-crypto/signature"
 )
@@ -45,6 +49,8 @@ func New(ss ecc.ID, r io.Reader) (signature.Signer, error) {
 		return ecdsa_bls12377.GenerateKey(r)
 	case ecc.BLS12_378:
 		return ecdsa_bls12378.GenerateKey(r)
+	case ecc.BLS12_461:
+		return ecdsa_bls12461.GenerateKey(r)
 	case ecc.BW6_761:
 		return ecdsa_bw6761.GenerateKey(r)
 	case ecc.BW6_756:
@@ -59,6 +65,12 @@ func New(ss ecc.ID, r io.Reader) (signature.Signer, error) {
 		return ecdsa_secp256k1.GenerateKey(r)
 	case ecc.STARK_CURVE:
 		return ecdsa_starkcurve.GenerateKey(r)
+	case ecc.SECP256R1:
+		return ecdsa_secp256r1.GenerateKey(r)
+	case ecc.SECP384R1:
+		return ecdsa_secp384r1.GenerateKey(r)
+	case ecc.CURVE25519:
+		return ecdsa_curve25519.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}
@@ -23,12 +23,14 @@ import (
 	eddsa_bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378/twistededwards/eddsa"
 	eddsa_bls12381_bandersnatch "github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch/eddsa"
 	eddsa_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/twistededwards/eddsa"
+	eddsa_bls12461 "github.com/consensys/gnark-crypto/ecc/bls12-461/twistededwards/eddsa"
 	eddsa_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/twistededwards/eddsa"
 	eddsa_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/twistededwards/eddsa"
 	eddsa_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
 	eddsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/twistededwards/eddsa"
 	eddsa_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/twistededwards/eddsa"
 	eddsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/twistededwards/eddsa"
+	eddsa_curve25519 "github.com/consensys/gnark-crypto/ecc/curve25519/twistededwards/eddsa"
 	"github.com/consensys/gnark-crypto/ecc/twistededwards"
 	"github.com/consensys/gnark-crypto/signature"
 )
@@ -46,6 +48,8 @@ func New(ss twistededwards.ID, r io.Reader) (signature.Signer, error) {
 		return eddsa_bls12377.GenerateKey(r)
 	case twistededwards.BLS12_378:
 		return eddsa_bls12378.GenerateKey(r)
+	case twistededwards.BLS12_461:
+		return eddsa_bls12461.GenerateKey(r)
 	case twistededwards.BW6_761:
 		return eddsa_bw6761.GenerateKey(r)
 	case twistededwards.BW6_756:
@@ -56,6 +60,8 @@ func New(ss twistededwards.ID, r io.Reader) (signature.Signer, error) {
 		return eddsa_bls24317.GenerateKey(r)
 	case twistededwards.BW6_633:
 		return eddsa_bw6633.GenerateKey(r)
+	case twistededwards.CURVE25519:
+		return eddsa_curve25519.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}
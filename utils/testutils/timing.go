@@ -0,0 +1,76 @@
+package testutils
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+)
+
+// timingThreshold is the bound on Welch's t-statistic above which the running
+// times of the two classes are considered different. dudect uses 4.5; we take a
+// larger margin since the tests run concurrently with others.
+const timingThreshold = 10
+
+// ConstantTime checks that the running time of f does not depend on the class
+// of its input, with the method of dudect (https://eprint.iacr.org/2016/1123):
+// f(c, i) runs the operation on the i-th input of the class c ∈ {0, 1}, and is
+// called n times with a random class. The two distributions of the running times,
+// cropped at the 95th percentile, are compared with Welch's t-test.
+//
+// The inputs must be computed beforehand, for instance a fixed secret for the
+// class 0 and random secrets for the class 1. The test is skipped in short mode.
+func ConstantTime(t *testing.T, n int, f func(c, i int)) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	// warm up
+	for i := 0; i < n/10; i++ {
+		f(i&1, i)
+	}
+
+	classes := make([]int, n)
+	for i := range classes {
+		classes[i] = rand.IntN(2) //#nosec G404 weak rng is fine here
+	}
+	durations := make([]float64, n)
+	for i := range durations {
+		start := time.Now()
+		f(classes[i], i)
+		durations[i] = float64(time.Since(start))
+	}
+
+	// crop the outliers (interrupts, scheduling, GC)
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	threshold := sorted[n*95/100]
+
+	var count [2]float64
+	var mean, m2 [2]float64
+	for i, d := range durations {
+		if d > threshold {
+			continue
+		}
+		// Welford's online algorithm
+		c := classes[i]
+		count[c]++
+		delta := d - mean[c]
+		mean[c] += delta / count[c]
+		m2[c] += delta * (d - mean[c])
+	}
+	if count[0] < 2 || count[1] < 2 {
+		t.Fatal("not enough measurements")
+	}
+	v0 := m2[0] / (count[0] - 1) / count[0]
+	v1 := m2[1] / (count[1] - 1) / count[1]
+	if v0+v1 == 0 {
+		return
+	}
+	tStat := (mean[0] - mean[1]) / math.Sqrt(v0+v1)
+	if math.Abs(tStat) > timingThreshold {
+		t.Fatalf("running time depends on the input: t = %.2f (mean %.0fns vs %.0fns)", tStat, mean[0], mean[1])
+	}
+}